@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
+	db "HWnow-wails/internal/database"
 	"HWnow-wails/internal/monitoring"
 	"HWnow-wails/internal/services"
 )
@@ -46,42 +49,70 @@ type Config struct {
 
 // Data structures (keeping the same interfaces)
 type SystemInfo struct {
-	Platform    string    `json:"platform"`
-	CPUCores    int       `json:"cpu_cores"`
-	CPUModel    string    `json:"cpu_model"`
-	TotalMemory float64   `json:"total_memory"`
-	BootTime    time.Time `json:"boot_time"`
+	Platform    string               `json:"platform"`
+	CPUCores    int                  `json:"cpu_cores"`
+	CPUModel    string               `json:"cpu_model"`
+	TotalMemory float64              `json:"total_memory"`
+	BootTime    time.Time            `json:"boot_time"`
+	Host        *monitoring.HostInfo `json:"host"`
 }
 
 type RealTimeMetrics struct {
-	CPUUsage       float64                       `json:"cpu_usage"`
-	CPUCoreUsage   []float64                     `json:"cpu_core_usage"`
-	MemoryUsage    float64                       `json:"memory_usage"`
-	DiskUsage      *monitoring.DiskUsageInfo     `json:"disk_usage"`
-	DiskReadSpeed  float64                       `json:"disk_read_speed"`
-	DiskWriteSpeed float64                       `json:"disk_write_speed"`
-	NetworkIO      []monitoring.NetworkInterface `json:"network_io"`
-	NetSentSpeed   float64                       `json:"net_sent_speed"`
-	NetRecvSpeed   float64                       `json:"net_recv_speed"`
-
-	SystemUptime  int64                     `json:"system_uptime"`
-	BootTime      time.Time                 `json:"boot_time"`
-	GPUInfo       *monitoring.GPUInfo       `json:"gpu_info"`
-	GPUProcesses  []monitoring.GPUProcess   `json:"gpu_processes"`
-	TopProcesses  []monitoring.ProcessInfo  `json:"top_processes"`
-	MemoryDetails *monitoring.MemoryDetails `json:"memory_details"`
-	BatteryInfo   *monitoring.BatteryInfo   `json:"battery_info"`
-	NetworkStatus string                    `json:"network_status"`
+	CPUUsage         float64                             `json:"cpu_usage"`
+	CPUCoreUsage     []float64                           `json:"cpu_core_usage"`
+	CPUTimes         *monitoring.CPUTimeBreakdown        `json:"cpu_times"`
+	CPUTemperature   float64                             `json:"cpu_temperature"`
+	CPUPower         *monitoring.RAPLPower               `json:"cpu_power"`
+	Throttle         *monitoring.ThrottleInfo            `json:"throttle"`
+	Load             *monitoring.LoadInfo                `json:"load"`
+	MemoryUsage      float64                             `json:"memory_usage"`
+	DiskUsage        *monitoring.DiskUsageInfo           `json:"disk_usage"`
+	DiskReadSpeed    float64                             `json:"disk_read_speed"`
+	DiskWriteSpeed   float64                             `json:"disk_write_speed"`
+	DiskTemperatures []monitoring.DiskTemperature        `json:"disk_temperatures"`
+	DiskPaths        []monitoring.DiskPathUsage          `json:"disk_paths"`
+	NetworkIO        []monitoring.NetworkInterface       `json:"network_io"`
+	NetSentSpeed     float64                             `json:"net_sent_speed"`
+	NetRecvSpeed     float64                             `json:"net_recv_speed"`
+	NetworkErrors    []monitoring.NetworkInterfaceErrors `json:"network_errors"`
+
+	SystemUptime    int64                       `json:"system_uptime"`
+	BootTime        time.Time                   `json:"boot_time"`
+	GPUInfo         *monitoring.GPUInfo         `json:"gpu_info"`
+	GPUEngines      []monitoring.GPUEngineUsage `json:"gpu_engines"`
+	GPUAdapters     []monitoring.GPUAdapter     `json:"gpu_adapters"`
+	GPUBandwidth    []monitoring.GPUBandwidth   `json:"gpu_bandwidth"`
+	GPUECC          []monitoring.GPUECCStatus   `json:"gpu_ecc"`
+	NPUInfo         *monitoring.NPUInfo         `json:"npu_info"`
+	GPUProcesses    []monitoring.GPUProcess     `json:"gpu_processes"`
+	TopProcesses    []monitoring.ProcessInfo    `json:"top_processes"`
+	MemoryDetails   *monitoring.MemoryDetails   `json:"memory_details"`
+	MemoryBreakdown *monitoring.MemoryBreakdown `json:"memory_breakdown"`
+	MemoryPaging    *monitoring.MemoryPaging    `json:"memory_paging"`
+	BatteryInfo     *monitoring.BatteryInfo     `json:"battery_info"`
+	NetworkStatus   string                      `json:"network_status"`
+
+	NetworkQuality *monitoring.NetworkQuality `json:"network_quality"`
+	WiFi           []monitoring.WiFiInfo      `json:"wifi"`
+	FrameStats     []monitoring.AppFrameStats `json:"frame_stats"`
+	Fans           []monitoring.Fan           `json:"fans"`
+	Audio          *monitoring.AudioInfo      `json:"audio"`
+	Idle           *monitoring.IdleState      `json:"idle"`
+
+	SystemPowerWatts float64                     `json:"system_power_watts"`
+	PowerInfo        *monitoring.SystemPowerInfo `json:"power_info"`
+	CollectorErrors  map[string]string           `json:"collector_errors,omitempty"`
 
 	Timestamp time.Time `json:"timestamp"`
 }
 
 type GPUProcessControlResult struct {
-	PID       int32  `json:"pid"`
-	Success   bool   `json:"success"`
-	Message   string `json:"message"`
-	Operation string `json:"operation"`
-	Priority  string `json:"priority,omitempty"`
+	PID             int32  `json:"pid"`
+	Success         bool   `json:"success"`
+	Message         string `json:"message"`
+	Operation       string `json:"operation"`
+	Priority        string `json:"priority,omitempty"`
+	AppliedPriority string `json:"applied_priority,omitempty"`
 }
 
 type GPUProcessValidationResult struct {
@@ -350,14 +381,20 @@ func ensureJSONString(value interface{}) string {
 
 // App struct - now delegates to AppService
 type App struct {
-	ctx        context.Context
-	appService *services.AppService
+	ctx          context.Context
+	appService   *services.AppService
+	healthServer *http.Server
 }
 
-// NewApp creates a new App application struct
+// NewApp creates a new App application struct (config and database in the working directory)
 func NewApp() *App {
+	return NewAppWithDataDir(".")
+}
+
+// NewAppWithDataDir creates a new App storing config.json and the database in dataDir
+func NewAppWithDataDir(dataDir string) *App {
 	return &App{
-		appService: services.NewAppService("config.json"),
+		appService: services.NewAppService(filepath.Join(dataDir, services.ConfigFileName)),
 	}
 }
 
@@ -367,10 +404,16 @@ func (a *App) OnStartup(ctx context.Context) {
 	if err := a.appService.Initialize(ctx); err != nil {
 		monitoring.LogError("Failed to initialize AppService", "error", err)
 	}
+	if config := a.appService.GetConfig(); config != nil {
+		if err := a.startHealthServer(config.Server.HealthPort); err != nil {
+			monitoring.LogWarn("Failed to start health endpoint listener", "port", config.Server.HealthPort, "error", err)
+		}
+	}
 }
 
 // OnShutdown is called when the app is shutting down
 func (a *App) OnShutdown(ctx context.Context) {
+	a.stopHealthServer()
 	if a.appService != nil {
 		if err := a.appService.Shutdown(); err != nil {
 			monitoring.LogError("Failed to shutdown AppService", "error", err)
@@ -402,6 +445,7 @@ func (a *App) GetSystemInfo() (*SystemInfo, error) {
 		CPUModel:    serviceInfo.CPUModel,
 		TotalMemory: serviceInfo.TotalMemory,
 		BootTime:    serviceInfo.BootTime,
+		Host:        serviceInfo.Host,
 	}, nil
 }
 
@@ -413,32 +457,112 @@ func (a *App) GetRealTimeMetrics() (*RealTimeMetrics, error) {
 
 	// Convert services.RealTimeMetrics to main.RealTimeMetrics
 	return &RealTimeMetrics{
-		CPUUsage:       serviceMetrics.CPUUsage,
-		CPUCoreUsage:   serviceMetrics.CPUCoreUsage,
-		MemoryUsage:    serviceMetrics.MemoryUsage,
-		DiskUsage:      serviceMetrics.DiskUsage,
-		DiskReadSpeed:  serviceMetrics.DiskReadSpeed,
-		DiskWriteSpeed: serviceMetrics.DiskWriteSpeed,
-		NetworkIO:      serviceMetrics.NetworkIO,
-		NetSentSpeed:   serviceMetrics.NetSentSpeed,
-		NetRecvSpeed:   serviceMetrics.NetRecvSpeed,
-		SystemUptime:   serviceMetrics.SystemUptime,
-		BootTime:       serviceMetrics.BootTime,
-		GPUInfo:        serviceMetrics.GPUInfo,
-		GPUProcesses:   serviceMetrics.GPUProcesses,
-		TopProcesses:   serviceMetrics.TopProcesses,
-		MemoryDetails:  serviceMetrics.MemoryDetails,
-		BatteryInfo:    serviceMetrics.BatteryInfo,
-		NetworkStatus:  serviceMetrics.NetworkStatus,
-		Timestamp:      serviceMetrics.Timestamp,
+		CPUUsage:         serviceMetrics.CPUUsage,
+		CPUCoreUsage:     serviceMetrics.CPUCoreUsage,
+		CPUTimes:         serviceMetrics.CPUTimes,
+		CPUTemperature:   serviceMetrics.CPUTemperature,
+		CPUPower:         serviceMetrics.CPUPower,
+		Throttle:         serviceMetrics.Throttle,
+		Load:             serviceMetrics.Load,
+		MemoryUsage:      serviceMetrics.MemoryUsage,
+		DiskUsage:        serviceMetrics.DiskUsage,
+		DiskReadSpeed:    serviceMetrics.DiskReadSpeed,
+		DiskWriteSpeed:   serviceMetrics.DiskWriteSpeed,
+		DiskTemperatures: serviceMetrics.DiskTemperatures,
+		DiskPaths:        serviceMetrics.DiskPaths,
+		NetworkIO:        serviceMetrics.NetworkIO,
+		NetSentSpeed:     serviceMetrics.NetSentSpeed,
+		NetRecvSpeed:     serviceMetrics.NetRecvSpeed,
+		NetworkErrors:    serviceMetrics.NetworkErrors,
+		SystemUptime:     serviceMetrics.SystemUptime,
+		BootTime:         serviceMetrics.BootTime,
+		GPUInfo:          serviceMetrics.GPUInfo,
+		GPUEngines:       serviceMetrics.GPUEngines,
+		GPUAdapters:      serviceMetrics.GPUAdapters,
+		GPUBandwidth:     serviceMetrics.GPUBandwidth,
+		GPUECC:           serviceMetrics.GPUECC,
+		NPUInfo:          serviceMetrics.NPUInfo,
+		GPUProcesses:     serviceMetrics.GPUProcesses,
+		TopProcesses:     serviceMetrics.TopProcesses,
+		MemoryDetails:    serviceMetrics.MemoryDetails,
+		MemoryBreakdown:  serviceMetrics.MemoryBreakdown,
+		MemoryPaging:     serviceMetrics.MemoryPaging,
+		BatteryInfo:      serviceMetrics.BatteryInfo,
+		NetworkStatus:    serviceMetrics.NetworkStatus,
+		NetworkQuality:   serviceMetrics.NetworkQuality,
+		WiFi:             serviceMetrics.WiFi,
+		FrameStats:       serviceMetrics.FrameStats,
+		Fans:             serviceMetrics.Fans,
+		Audio:            serviceMetrics.Audio,
+		Idle:             serviceMetrics.Idle,
+		SystemPowerWatts: serviceMetrics.SystemPowerWatts,
+		CollectorErrors:  serviceMetrics.CollectorErrors,
+		PowerInfo:        serviceMetrics.PowerInfo,
+		Timestamp:        serviceMetrics.Timestamp,
 	}, nil
 }
 
+// GetSelfTelemetry returns HWnow's own CPU, memory, goroutine and collector timing metrics
+func (a *App) GetSelfTelemetry() (*monitoring.SelfTelemetry, error) {
+	return a.appService.GetSelfTelemetry()
+}
+
+// GetCollectorSchedule returns the collection time budget and the current interval of each collector
+func (a *App) GetCollectorSchedule() (*monitoring.SchedulerStatus, error) {
+	return a.appService.GetCollectorSchedule()
+}
+
+// PauseMonitoring pauses GPU/process scans for the given frontend session until ResumeMonitoring is called
+func (a *App) PauseMonitoring(sessionID string) (*services.CollectionState, error) {
+	return a.appService.PauseMonitoring(sessionID)
+}
+
+// ResumeMonitoring resumes collection for the given frontend session
+func (a *App) ResumeMonitoring(sessionID string) (*services.CollectionState, error) {
+	return a.appService.ResumeMonitoring(sessionID)
+}
+
+// GetMonitoringState returns whether collection is paused and which sessions paused it
+func (a *App) GetMonitoringState() (*services.CollectionState, error) {
+	return a.appService.GetMonitoringState()
+}
+
+// ReportVisibility tells the backend which widgets the given frontend session shows and whether its window is in the foreground
+func (a *App) ReportVisibility(sessionID string, foreground bool, widgets []string) (*monitoring.ClientDemandStatus, error) {
+	return a.appService.ReportVisibility(sessionID, foreground, widgets)
+}
+
+// EndSession removes the given frontend session's pause state and visibility report
+func (a *App) EndSession(sessionID string) (*monitoring.ClientDemandStatus, error) {
+	return a.appService.EndSession(sessionID)
+}
+
+// GetClientDemand returns the reporting sessions and how often each visibility-driven collector runs
+func (a *App) GetClientDemand() (*monitoring.ClientDemandStatus, error) {
+	return a.appService.GetClientDemand()
+}
+
+// GetHealth returns collector freshness, database connectivity and GPU backend availability
+func (a *App) GetHealth() (*services.HealthReport, error) {
+	return a.appService.GetHealth(), nil
+}
+
+// GetStatus returns the state and last error of every collector so missing data can be explained
+// (e.g. "GPU data unavailable: nvidia-smi not found") instead of rendered as zero
+func (a *App) GetStatus() (*services.StatusReport, error) {
+	return a.appService.GetStatus(monitoring.ResolveLanguage("")), nil
+}
+
 // GPU Methods
 func (a *App) GetGPUInfo() (*monitoring.GPUInfo, error) {
 	return a.appService.GetGPUInfo()
 }
 
+// GetGPUStaticInfo returns driver, VBIOS, CUDA version, PCIe link and UUID for each GPU
+func (a *App) GetGPUStaticInfo() ([]monitoring.GPUStaticInfo, error) {
+	return a.appService.GetGPUStaticInfo()
+}
+
 func (a *App) GetGPUProcesses() ([]monitoring.GPUProcess, error) {
 	return a.appService.GetGPUProcesses()
 }
@@ -451,6 +575,80 @@ func (a *App) GetTopProcesses(count int) ([]monitoring.ProcessInfo, error) {
 	return a.appService.GetTopProcesses(count)
 }
 
+// GetTopProcessesWithDetail returns top processes; detail "full" adds command line, executable
+// path, start time and owner to tell apart processes with the same name (slower)
+func (a *App) GetTopProcessesWithDetail(count int, detail string) ([]monitoring.ProcessInfo, error) {
+	return a.appService.GetTopProcessesWithDetail(count, detail)
+}
+
+// GetGPUProcessesWithDetail returns GPU processes; detail "full" adds command line, executable
+// path, start time and owner (slower)
+func (a *App) GetGPUProcessesWithDetail(detail string) ([]monitoring.GPUProcess, error) {
+	return a.appService.GetGPUProcessesWithDetail(detail)
+}
+
+// GetGPUInfoWithRefresh returns GPU information; refresh bypasses the cache (at most once every few seconds)
+func (a *App) GetGPUInfoWithRefresh(refresh bool) (*monitoring.GPUInfo, error) {
+	return a.appService.GetGPUInfoWithRefresh(refresh)
+}
+
+// GetGPUProcessesWithRefresh returns GPU processes; refresh bypasses the cache (at most once every few seconds)
+func (a *App) GetGPUProcessesWithRefresh(detail string, refresh bool) ([]monitoring.GPUProcess, error) {
+	return a.appService.GetGPUProcessesWithRefresh(detail, refresh)
+}
+
+func (a *App) GetProcessesFiltered(query monitoring.ProcessQuery) (*monitoring.ProcessResponse, error) {
+	return a.appService.GetProcessesFiltered(query)
+}
+
+// GetProcessProfile returns everything known about one process for the detail pane: per-core CPU
+// (Linux), RSS/VMS/shared memory, open files, threads, I/O counters, GPU memory and children
+func (a *App) GetProcessProfile(pid int32) (*monitoring.ProcessProfile, error) {
+	return a.appService.GetProcessProfile(pid)
+}
+
+// SearchProcesses fuzzy-matches running processes by name and command line and returns the
+// best matches with their resource usage (limit 0 = 20)
+func (a *App) SearchProcesses(query string, limit int) (*monitoring.ProcessSearchResponse, error) {
+	return a.appService.SearchProcesses(query, limit)
+}
+
+func (a *App) GetConnectionsFiltered(query monitoring.ConnectionQuery) (*monitoring.ConnectionResponse, error) {
+	return a.appService.GetConnectionsFiltered(query)
+}
+
+// GetProcessGroups returns CPU%, RAM and GPU memory totals per application (a browser with all its subprocesses)
+func (a *App) GetProcessGroups() ([]monitoring.ProcessGroup, error) {
+	return a.appService.GetProcessGroups()
+}
+
+// GetUserUsage returns CPU%, RAM and GPU memory totals per owning user account
+func (a *App) GetUserUsage() ([]monitoring.UserUsage, error) {
+	return a.appService.GetUserUsage()
+}
+
+// GetTopConsumers ranks processes by CPU or GPU time accumulated today ("cpu" or "gpu")
+func (a *App) GetTopConsumers(sortBy string, limit int) (*monitoring.TopConsumersResponse, error) {
+	return a.appService.GetTopConsumers(sortBy, limit)
+}
+
+// GetRecentMetrics returns the last seconds of a metric kept in memory (0 = the whole buffer)
+// so a freshly opened dashboard can render recent history immediately
+func (a *App) GetRecentMetrics(metricType string, seconds int) *monitoring.RecentMetrics {
+	return a.appService.GetRecentMetrics(metricType, seconds)
+}
+
+// GetWidgetData returns exactly what a widget renders: current value, sparkline of the last points samples and secondary values
+func (a *App) GetWidgetData(widgetType string, points int) (*monitoring.WidgetData, error) {
+	return a.appService.GetWidgetData(widgetType, points)
+}
+
+// GetSnapshot returns the latest metrics as a typed snapshot (cpu, memory, disks, nics, gpus, processes)
+// includeLegacy also returns the old flat metric list ("cpu_core_7" style keys)
+func (a *App) GetSnapshot(includeLegacy bool) (*monitoring.TypedSnapshot, error) {
+	return a.appService.GetTypedSnapshot(includeLegacy)
+}
+
 // GPU Process Control Methods
 func (a *App) KillGPUProcess(pid int32) (*GPUProcessControlResult, error) {
 	serviceResult := a.appService.KillGPUProcess(pid)
@@ -504,15 +702,31 @@ func (a *App) ResumeGPUProcess(pid int32) (*GPUProcessControlResult, error) {
 	return result, nil
 }
 
+// PreviewGPUProcessControl runs every check of a kill/suspend/resume/priority request (existence,
+// protection, GPU usage, privileges, read-only mode) without performing it, so buttons can be enabled accurately
+func (a *App) PreviewGPUProcessControl(pid int32, operation string, priority string) (*monitoring.ControlPreview, error) {
+	return a.appService.PreviewGPUProcessControl(pid, operation, priority)
+}
+
+// GetProcessPriority returns the priority class / nice value currently applied to a process
+func (a *App) GetProcessPriority(pid int32) (*monitoring.ProcessPriority, error) {
+	result, err := a.appService.GetProcessPriority(pid)
+	if err != nil {
+		return nil, fmt.Errorf("get process priority failed: %s", err.Error())
+	}
+	return result, nil
+}
+
 func (a *App) SetGPUProcessPriority(pid int32, priority string) (*GPUProcessControlResult, error) {
 	serviceResult := a.appService.SetGPUProcessPriority(pid, priority)
 
 	result := &GPUProcessControlResult{
-		PID:       serviceResult.PID,
-		Success:   serviceResult.Success,
-		Message:   serviceResult.Message,
-		Operation: serviceResult.Operation,
-		Priority:  serviceResult.Priority,
+		PID:             serviceResult.PID,
+		Success:         serviceResult.Success,
+		Message:         serviceResult.Message,
+		Operation:       serviceResult.Operation,
+		Priority:        serviceResult.Priority,
+		AppliedPriority: serviceResult.AppliedPriority,
 	}
 
 	if !serviceResult.Success {
@@ -521,6 +735,109 @@ func (a *App) SetGPUProcessPriority(pid int32, priority string) (*GPUProcessCont
 	return result, nil
 }
 
+// SetProcessLimits caps a process's CPU (percent of total capacity) and memory in MB; 0 removes a limit.
+// Linux places the process in a cgroup v2 group, Windows assigns it to a Job Object
+func (a *App) SetProcessLimits(pid int32, cpuPercent float64, memoryMB float64) (*monitoring.ProcessLimits, error) {
+	return a.appService.SetProcessLimits(pid, cpuPercent, memoryMB)
+}
+
+// GetGPUPowerLimits returns current/default/min/max power limits and clock offsets of NVIDIA GPUs
+func (a *App) GetGPUPowerLimits() ([]monitoring.GPUPowerLimits, error) {
+	return a.appService.GetGPUPowerLimits()
+}
+
+// SetGPUPowerLimit sets an NVIDIA GPU's power limit in watts. Disabled unless
+// gpu_control.allow_power_limit is set, and requires administrator rights
+func (a *App) SetGPUPowerLimit(index int, watts float64) (*monitoring.GPUPowerLimits, error) {
+	return a.appService.SetGPUPowerLimit(index, watts)
+}
+
+// GetThrottleInfo returns CPU/GPU thermal and power throttling state, per-core clocks
+// and the time spent throttled since monitoring started
+func (a *App) GetThrottleInfo() (*monitoring.ThrottleInfo, error) {
+	return a.appService.GetThrottleInfo()
+}
+
+// GetGPUECCStatus returns ECC error counts and retired/remapped memory pages of NVIDIA
+// datacenter/workstation GPUs; increases are emitted as "monitoring:gpu-ecc" events
+func (a *App) GetGPUECCStatus() ([]monitoring.GPUECCStatus, error) {
+	return a.appService.GetGPUECCStatus()
+}
+
+// IsReadOnly reports whether process control, power limit, fan and stress test changes are disabled
+// (security.read_only) so the frontend can hide those controls
+func (a *App) IsReadOnly() bool {
+	return a.appService.IsReadOnly()
+}
+
+// GetProcessProtectionAudit lists the protected process rules and the most recent control decisions
+// on protected processes, including why refused requests were refused (limit <= 0 = 50)
+func (a *App) GetProcessProtectionAudit(limit int) *monitoring.ProtectionAudit {
+	return a.appService.GetProcessProtectionAudit(limit)
+}
+
+// GetProtectedProcesses returns the user-defined protected processes stored in the database
+// (merged with security.protected_processes from the configuration)
+func (a *App) GetProtectedProcesses() ([]db.ProtectedProcess, error) {
+	return a.appService.GetProtectedProcesses()
+}
+
+// SaveProtectedProcess adds (id 0) or updates a protected process; level high or critical refuses
+// kill/suspend/priority/limit requests on matching processes
+func (a *App) SaveProtectedProcess(protected db.ProtectedProcess) (*db.ProtectedProcess, error) {
+	return a.appService.SaveProtectedProcess(protected)
+}
+
+// RemoveProtectedProcess removes a stored protected process
+func (a *App) RemoveProtectedProcess(id int64) error {
+	return a.appService.RemoveProtectedProcess(id)
+}
+
+// GetSecurityContext returns UAC/sudo state, process privileges and recommendations
+// in the configured language (ui.language), each with its message key
+func (a *App) GetSecurityContext() (*monitoring.SecurityContext, error) {
+	return a.appService.GetSecurityContext(monitoring.ResolveLanguage(""))
+}
+
+// RequestElevation relaunches the application as administrator (UAC prompt) on Windows and quits this instance,
+// or returns the sudo command to run on Linux/macOS
+func (a *App) RequestElevation() (*monitoring.ElevationResult, error) {
+	return a.appService.RequestElevation(monitoring.ResolveLanguage(""))
+}
+
+// RedetectGPUVendor clears the GPU vendor latched at startup and detects it again;
+// a vendor pinned with gpu.vendor in the configuration is kept
+func (a *App) RedetectGPUVendor() monitoring.GPUVendorStatus {
+	return a.appService.RedetectGPUVendor()
+}
+
+// GetNetworkErrors returns error/drop/collision counters and rates per network interface;
+// interfaces that start reporting errors are emitted as "monitoring:network-errors" events
+func (a *App) GetNetworkErrors() ([]monitoring.NetworkInterfaceErrors, error) {
+	return a.appService.GetNetworkErrors()
+}
+
+// GetFans returns chassis/CPU fan speeds and PWM state (Linux hwmon)
+func (a *App) GetFans() ([]monitoring.Fan, error) {
+	return a.appService.GetFans()
+}
+
+// SetFanPWM sets a fan's duty in percent. Disabled unless fan_control.allow_pwm_control
+// is set; fans with a curve are driven by the curve instead
+func (a *App) SetFanPWM(fanID string, percent float64) (*monitoring.Fan, error) {
+	return a.appService.SetFanPWM(fanID, percent)
+}
+
+// GetFanCurves returns the configured CPU temperature → fan duty curves
+func (a *App) GetFanCurves() []monitoring.FanCurve {
+	return a.appService.GetFanCurves()
+}
+
+// SetFanCurves validates and saves the fan curves
+func (a *App) SetFanCurves(curves []monitoring.FanCurve) error {
+	return a.appService.SetFanCurves(curves)
+}
+
 func (a *App) ValidateGPUProcess(pid int32) *GPUProcessValidationResult {
 	serviceResult := a.appService.ValidateGPUProcess(pid)
 
@@ -541,6 +858,14 @@ func (a *App) SetGPUProcessMonitoring(enabled bool) error {
 	return nil
 }
 
+// SetGPUProcessNamePatterns sets and persists regex name patterns that restrict (include) or hide (exclude) GPU processes
+func (a *App) SetGPUProcessNamePatterns(include, exclude string) error {
+	if a.appService == nil {
+		return fmt.Errorf("app service not initialized")
+	}
+	return a.appService.SetGPUProcessNamePatterns(include, exclude)
+}
+
 // Widget Management Methods
 func (a *App) GetWidgets(userID, pageID string) (*WidgetResult, error) {
 	serviceResult := a.appService.GetWidgets(userID, pageID)
@@ -627,6 +952,41 @@ func (a *App) DeleteWidget(userID, pageID, widgetID string) (*WidgetResult, erro
 	return result, nil
 }
 
+// GetWidgetStates returns the widgets of the given pages (every page when pageIDs is empty) at the current state version
+func (a *App) GetWidgetStates(userID string, pageIDs []string) (*services.WidgetStateBundle, error) {
+	return a.appService.GetWidgetStates(userID, pageIDs)
+}
+
+// SaveWidgetStates validates the widgets of several pages against their schemas and saves them in one transaction
+func (a *App) SaveWidgetStates(bundle services.WidgetStateBundle) (*services.WidgetStateBundle, error) {
+	return a.appService.SaveWidgetStates(bundle)
+}
+
+// GetWidgetSchemas returns the known config keys and value limits of every widget type
+func (a *App) GetWidgetSchemas() ([]monitoring.WidgetSchema, error) {
+	return a.appService.GetWidgetSchemas(), nil
+}
+
+// GetNotificationStatus returns the desktop notification settings, platform backend and recent notifications
+func (a *App) GetNotificationStatus() (*monitoring.NotificationStatus, error) {
+	return a.appService.GetNotificationStatus()
+}
+
+// SendTestNotification shows a test OS notification regardless of severity threshold and quiet hours
+func (a *App) SendTestNotification() (*monitoring.NotificationRecord, error) {
+	return a.appService.SendTestNotification()
+}
+
+// ExportAppState writes the dashboards, process rules and configuration to a zip archive at path
+func (a *App) ExportAppState(userID, path string) (*services.StateBundleManifest, error) {
+	return a.appService.ExportAppStateFile(userID, path)
+}
+
+// ImportAppState applies the given parts (config, dashboards, rules; all when empty) of the archive at path
+func (a *App) ImportAppState(path string, parts []string) (*services.StateImportResult, error) {
+	return a.appService.ImportAppStateFile(path, parts)
+}
+
 func (a *App) DeleteAllWidgets(userID, pageID string) (*WidgetResult, error) {
 	serviceResult := a.appService.GetWidgets(userID, pageID)
 	current := convertWidgetServiceResult(userID, pageID, "", serviceResult, nil)
@@ -749,6 +1109,159 @@ func (a *App) IsMonitoringRunning() bool {
 	return a.appService.IsMonitoringRunning()
 }
 
+// Configuration Methods
+func (a *App) GetConfig() *services.Config {
+	return a.appService.GetConfig()
+}
+
+// GetDataDirectory returns the directory holding config.json and the database
+func (a *App) GetDataDirectory() string {
+	return a.appService.GetDataDir()
+}
+
+func (a *App) UpdateConfig(config services.Config) error {
+	if err := a.appService.UpdateConfig(&config); err != nil {
+		return fmt.Errorf("Update config failed: %v", err)
+	}
+	return nil
+}
+
+// Logging Methods
+func (a *App) GetLogSettings() monitoring.LogSettings {
+	return a.appService.GetLogSettings()
+}
+
+func (a *App) SetLogLevel(level string) error {
+	if err := a.appService.SetLogLevel(level); err != nil {
+		return fmt.Errorf("Set log level failed: %v", err)
+	}
+	return nil
+}
+
+func (a *App) SetGPUMonitoringLogs(enabled bool) {
+	a.appService.SetGPUMonitoringLogs(enabled)
+}
+
+// Event Log Methods
+func (a *App) GetEvents(query db.EventQuery) (*services.EventResult, error) {
+	result := a.appService.GetEvents(query)
+	if !result.Success {
+		return result, fmt.Errorf("Get events failed: %s", result.Message)
+	}
+	return result, nil
+}
+
+// GetResourceHistory returns long-term metric history (raw, 1m or 1h resolution)
+func (a *App) GetResourceHistory(query db.ResourceHistoryQuery) (*services.HistoryResult, error) {
+	result := a.appService.GetResourceHistory(query)
+	if !result.Success {
+		return result, fmt.Errorf("Get resource history failed: %s", result.Message)
+	}
+	return result, nil
+}
+
+// GetAvailability returns host uptime percentage and reboot history over the last days
+func (a *App) GetAvailability(days int) (*services.AvailabilityResult, error) {
+	result := a.appService.GetAvailability(days)
+	if !result.Success {
+		return result, fmt.Errorf("Get availability failed: %s", result.Message)
+	}
+	return result, nil
+}
+
+// GetDeviceInventory returns connected USB devices and printers with recent connect/disconnect events
+func (a *App) GetDeviceInventory() (*services.DeviceInventoryReport, error) {
+	return a.appService.GetDeviceInventory()
+}
+
+// GetEnergySummary returns the estimated energy use, electricity cost and CO2 of the system over a window (1h, 24h, 7d, 30d)
+func (a *App) GetEnergySummary(window string) (*services.EnergySummary, error) {
+	return a.appService.GetEnergySummary(window)
+}
+
+// GetThermalProfiles returns daily temperature profiles (hourly avg/min/max) of the last days
+func (a *App) GetThermalProfiles(days int) ([]db.ThermalProfile, error) {
+	return a.appService.GetThermalProfiles(days)
+}
+
+// GetSnapshotDiff answers "what changed": processes that appeared or disappeared and metrics
+// whose averages shifted by more than the threshold between two timestamps
+func (a *App) GetSnapshotDiff(query db.SnapshotDiffQuery) (*services.SnapshotDiffResult, error) {
+	result := a.appService.GetSnapshotDiff(query)
+	if !result.Success {
+		return result, fmt.Errorf("Get snapshot diff failed: %s", result.Message)
+	}
+	return result, nil
+}
+
+// GetReport returns a daily (last 24h) or weekly (last 7d) summary report
+func (a *App) GetReport(period string) (*services.Report, error) {
+	return a.appService.GetReport(period)
+}
+
+// GetReportHTML returns a summary report rendered as an HTML document
+func (a *App) GetReportHTML(period string) (string, error) {
+	report, err := a.appService.GetReport(period)
+	if err != nil {
+		return "", err
+	}
+	return services.RenderHTML(report)
+}
+
+// StartSpeedtest starts an on-demand bandwidth measurement; progress and results arrive as
+// "network:speedtest-progress" and "network:speedtest-result" events
+func (a *App) StartSpeedtest() error {
+	return a.appService.StartSpeedtest()
+}
+
+// StartDiskScan starts an on-demand directory size scan; progress and results arrive as
+// "disk:scan-progress" and "disk:scan-result" events
+func (a *App) StartDiskScan(options monitoring.DiskScanOptions) error {
+	return a.appService.StartDiskScan(options)
+}
+
+// StartStressTest starts a CPU or GPU load generator; progress and results arrive as
+// "stress:progress" and "stress:result" events
+func (a *App) StartStressTest(options monitoring.StressTestOptions) error {
+	return a.appService.StartStressTest(options)
+}
+
+// StopStressTest stops the running load generator early
+func (a *App) StopStressTest() error {
+	return a.appService.StopStressTest()
+}
+
+// GetStressTestRuns returns recorded load generator runs, most recent first
+func (a *App) GetStressTestRuns(limit int) ([]db.StressTestRun, error) {
+	return a.appService.GetStressTestRuns(limit)
+}
+
+// GetStressTestReport returns a recorded run with the metrics collected during its window
+func (a *App) GetStressTestReport(id int64) (*services.StressTestReport, error) {
+	return a.appService.GetStressTestReport(id)
+}
+
+// CompareStressTests compares the metrics recorded during two runs (e.g. before and after tuning)
+func (a *App) CompareStressTests(beforeID, afterID int64) (*services.StressTestComparison, error) {
+	return a.appService.CompareStressTests(beforeID, afterID)
+}
+
+// GetWatchedProcesses returns the process watchdog list
+func (a *App) GetWatchedProcesses() ([]db.WatchedProcess, error) {
+	return a.appService.GetWatchedProcesses()
+}
+
+// SaveWatchedProcess adds (id 0) or updates a watched process; exits, restarts and limit
+// violations arrive as "monitoring:watchdog" events
+func (a *App) SaveWatchedProcess(watched db.WatchedProcess) (*db.WatchedProcess, error) {
+	return a.appService.SaveWatchedProcess(watched)
+}
+
+// RemoveWatchedProcess removes a process from the watchdog list
+func (a *App) RemoveWatchedProcess(id int64) error {
+	return a.appService.RemoveWatchedProcess(id)
+}
+
 // Database Management - Simplified implementations
 func (a *App) ExecuteRawSQL(query string) ([]map[string]interface{}, error) {
 	// For now, return empty result
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"HWnow-wails/internal/monitoring"
+	"HWnow-wails/internal/services"
+)
+
+// handleSpeedtest serves POST /api/network/speedtest; the test runs in the background and
+// streams progress to the frontend over the runtime event channel
+func (a *App) handleSpeedtest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	if err := a.StartSpeedtest(); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrSpeedtestRunning) {
+			status = http.StatusConflict
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"status": "started"})
+}
+
+// handleDiskScan serves POST /api/disk/scan with a JSON body {"path", "max_depth", "time_limit_seconds", "top_count"};
+// the scan runs in the background and streams progress to the frontend over the runtime event channel
+func (a *App) handleDiskScan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	var options monitoring.DiskScanOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+		return
+	}
+
+	if err := a.StartDiskScan(options); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, services.ErrDiskScanRunning) {
+			status = http.StatusConflict
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"status": "started"})
+}
+
+// handleStressTest serves GET /api/stress?limit=20 (recorded runs), POST /api/stress with a JSON body
+// {"kind", "threads", "duration_seconds", "label"} (start) and DELETE /api/stress (stop)
+func (a *App) handleStressTest(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		limit := 0
+		if value := r.URL.Query().Get("limit"); value != "" {
+			var err error
+			if limit, err = strconv.Atoi(value); err != nil {
+				writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "limit")
+				return
+			}
+		}
+		runs, err := a.GetStressTestRuns(limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(runs)
+
+	case http.MethodPost:
+		var options monitoring.StressTestOptions
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		if err := a.StartStressTest(options); err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, services.ErrStressTestRunning) {
+				status = http.StatusConflict
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(map[string]string{"status": "started"})
+
+	case http.MethodDelete:
+		if err := a.StopStressTest(); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"status": "stopping"})
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+	}
+}
+
+// handleStressTestCompare serves GET /api/stress/compare?before=<run id>&after=<run id>
+func (a *App) handleStressTestCompare(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	beforeID, err := strconv.ParseInt(r.URL.Query().Get("before"), 10, 64)
+	if err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "before run id")
+		return
+	}
+	afterID, err := strconv.ParseInt(r.URL.Query().Get("after"), 10, 64)
+	if err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "after run id")
+		return
+	}
+
+	comparison, err := a.CompareStressTests(beforeID, afterID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comparison)
+}
@@ -1,6 +1,10 @@
 // Polling Management Service
 import { ConfigService } from './EventServiceConfig';
 import { PerformanceMonitor } from './PerformanceMonitor';
+import { PauseMonitoring, ResumeMonitoring } from '../../../wailsjs/go/main/App';
+
+// The backend forgets a session's pause after 30 minutes without a report, so a hidden dashboard re-sends it
+const PAUSE_KEEPALIVE_MS = 5 * 60 * 1000;
 
 export interface PollingJob {
   name: string;
@@ -19,6 +23,7 @@ export class PollingManager {
   private adaptiveIntervals: Map<string, number> = new Map();
   private isAppVisible: boolean = true;
   private pollingJobs: Map<string, PollingJob> = new Map();
+  private pauseKeepAlive: number | null = null;
 
   private constructor() {
     this.configService = ConfigService.getInstance();
@@ -39,6 +44,13 @@ export class PollingManager {
       this.isAppVisible = !document.hidden;
       console.log(`[Polling] App visibility changed: ${this.isAppVisible ? 'visible' : 'hidden'}`);
 
+      // Let the backend skip GPU/process scans while nobody is looking at the dashboard
+      const toggle = this.isAppVisible ? ResumeMonitoring : PauseMonitoring;
+      toggle('dashboard').catch((error) => {
+        console.warn('[Polling] Failed to update backend monitoring state:', error);
+      });
+      this.updatePauseKeepAlive();
+
       if (this.configService.isAdaptivePollingEnabled()) {
         this.adaptPollingForVisibility();
       }
@@ -60,6 +72,20 @@ export class PollingManager {
     });
   }
 
+  private updatePauseKeepAlive(): void {
+    if (this.pauseKeepAlive !== null) {
+      window.clearInterval(this.pauseKeepAlive);
+      this.pauseKeepAlive = null;
+    }
+    if (!this.isAppVisible) {
+      this.pauseKeepAlive = window.setInterval(() => {
+        PauseMonitoring('dashboard').catch((error) => {
+          console.warn('[Polling] Failed to refresh backend pause state:', error);
+        });
+      }, PAUSE_KEEPALIVE_MS);
+    }
+  }
+
   public registerPollingJob(job: PollingJob): void {
     this.pollingJobs.set(job.name, job);
     console.log(`[Polling] Registered job: ${job.name}`);
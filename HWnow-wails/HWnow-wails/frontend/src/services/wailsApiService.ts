@@ -48,6 +48,8 @@ export interface GPUProcessResponse {
   type: string;        // Added: Missing fields from backend
   command: string;     // Added: Missing fields from backend  
   status: string;      // Added: Missing fields from backend
+  estimated_usage?: boolean; // gpu_usage is an estimate, not a measurement
+  usage_source?: string;     // etw, nvidia-pmon, perf-counter, memory-estimate, fixed-estimate, unavailable
 }
 
 export interface TopProcessResponse {
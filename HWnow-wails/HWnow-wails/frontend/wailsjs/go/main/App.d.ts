@@ -3,9 +3,13 @@
 import {main} from '../models';
 import {monitoring} from '../models';
 import {context} from '../models';
+import {services} from '../models';
+import {db} from '../models';
 
 export function BackupDatabase():Promise<string>;
 
+export function CompareStressTests(arg1:number,arg2:number):Promise<services.StressTestComparison>;
+
 export function CreatePage(arg1:string,arg2:string,arg3:string):Promise<main.PageResult>;
 
 export function DeleteAllWidgets(arg1:string,arg2:string):Promise<main.WidgetResult>;
@@ -14,28 +18,132 @@ export function DeletePage(arg1:string,arg2:string):Promise<main.PageResult>;
 
 export function DeleteWidget(arg1:string,arg2:string,arg3:string):Promise<main.WidgetResult>;
 
+export function EndSession(arg1:string):Promise<monitoring.ClientDemandStatus>;
+
 export function ExecuteRawSQL(arg1:string):Promise<Array<Record<string, any>>>;
 
+export function ExportAppState(arg1:string,arg2:string):Promise<services.StateBundleManifest>;
+
+export function GetAvailability(arg1:number):Promise<services.AvailabilityResult>;
+
+export function GetClientDemand():Promise<monitoring.ClientDemandStatus>;
+
+export function GetCollectorSchedule():Promise<monitoring.SchedulerStatus>;
+
+export function GetConfig():Promise<services.Config>;
+
+export function GetConnectionsFiltered(arg1:monitoring.ConnectionQuery):Promise<monitoring.ConnectionResponse>;
+
+export function GetDataDirectory():Promise<string>;
+
+export function GetDeviceInventory():Promise<services.DeviceInventoryReport>;
+
+export function GetEnergySummary(arg1:string):Promise<services.EnergySummary>;
+
+export function GetEvents(arg1:db.EventQuery):Promise<services.EventResult>;
+
+export function GetFanCurves():Promise<Array<monitoring.FanCurve>>;
+
+export function GetFans():Promise<Array<monitoring.Fan>>;
+
+export function GetGPUECCStatus():Promise<Array<monitoring.GPUECCStatus>>;
+
 export function GetGPUInfo():Promise<monitoring.GPUInfo>;
 
+export function GetGPUInfoWithRefresh(arg1:boolean):Promise<monitoring.GPUInfo>;
+
+export function GetGPUPowerLimits():Promise<Array<monitoring.GPUPowerLimits>>;
+
 export function GetGPUProcesses():Promise<Array<monitoring.GPUProcess>>;
 
 export function GetGPUProcessesFiltered(arg1:monitoring.GPUProcessQuery):Promise<monitoring.GPUProcessResponse>;
 
+export function GetGPUProcessesWithDetail(arg1:string):Promise<Array<monitoring.GPUProcess>>;
+
+export function GetGPUProcessesWithRefresh(arg1:string,arg2:boolean):Promise<Array<monitoring.GPUProcess>>;
+
+export function GetGPUStaticInfo():Promise<Array<monitoring.GPUStaticInfo>>;
+
+export function GetHealth():Promise<services.HealthReport>;
+
+export function GetLogSettings():Promise<monitoring.LogSettings>;
+
+export function GetMonitoringState():Promise<services.CollectionState>;
+
+export function GetNetworkErrors():Promise<Array<monitoring.NetworkInterfaceErrors>>;
+
+export function GetNotificationStatus():Promise<monitoring.NotificationStatus>;
+
 export function GetPages(arg1:string):Promise<main.PageResult>;
 
+export function GetProcessGroups():Promise<Array<monitoring.ProcessGroup>>;
+
+export function GetProcessPriority(arg1:number):Promise<monitoring.ProcessPriority>;
+
+export function GetProcessProfile(arg1:number):Promise<monitoring.ProcessProfile>;
+
+export function GetProcessProtectionAudit(arg1:number):Promise<monitoring.ProtectionAudit>;
+
+export function GetProcessesFiltered(arg1:monitoring.ProcessQuery):Promise<monitoring.ProcessResponse>;
+
+export function GetProtectedProcesses():Promise<Array<db.ProtectedProcess>>;
+
 export function GetRealTimeMetrics():Promise<main.RealTimeMetrics>;
 
+export function GetRecentMetrics(arg1:string,arg2:number):Promise<monitoring.RecentMetrics>;
+
+export function GetReport(arg1:string):Promise<services.Report>;
+
+export function GetReportHTML(arg1:string):Promise<string>;
+
+export function GetResourceHistory(arg1:db.ResourceHistoryQuery):Promise<services.HistoryResult>;
+
+export function GetSecurityContext():Promise<monitoring.SecurityContext>;
+
+export function GetSelfTelemetry():Promise<monitoring.SelfTelemetry>;
+
+export function GetSnapshot(arg1:boolean):Promise<monitoring.TypedSnapshot>;
+
+export function GetSnapshotDiff(arg1:db.SnapshotDiffQuery):Promise<services.SnapshotDiffResult>;
+
+export function GetStatus():Promise<services.StatusReport>;
+
+export function GetStressTestReport(arg1:number):Promise<services.StressTestReport>;
+
+export function GetStressTestRuns(arg1:number):Promise<Array<db.StressTestRun>>;
+
 export function GetSystemInfo():Promise<main.SystemInfo>;
 
+export function GetThermalProfiles(arg1:number):Promise<Array<db.ThermalProfile>>;
+
+export function GetThrottleInfo():Promise<monitoring.ThrottleInfo>;
+
+export function GetTopConsumers(arg1:string,arg2:number):Promise<monitoring.TopConsumersResponse>;
+
 export function GetTopProcesses(arg1:number):Promise<Array<monitoring.ProcessInfo>>;
 
+export function GetTopProcessesWithDetail(arg1:number,arg2:string):Promise<Array<monitoring.ProcessInfo>>;
+
+export function GetUserUsage():Promise<Array<monitoring.UserUsage>>;
+
+export function GetWatchedProcesses():Promise<Array<db.WatchedProcess>>;
+
+export function GetWidgetData(arg1:string,arg2:number):Promise<monitoring.WidgetData>;
+
+export function GetWidgetSchemas():Promise<Array<monitoring.WidgetSchema>>;
+
+export function GetWidgetStates(arg1:string,arg2:Array<string>):Promise<services.WidgetStateBundle>;
+
 export function GetWidgets(arg1:string,arg2:string):Promise<main.WidgetResult>;
 
 export function Greet(arg1:string):Promise<string>;
 
+export function ImportAppState(arg1:string,arg2:Array<string>):Promise<services.StateImportResult>;
+
 export function IsMonitoringRunning():Promise<boolean>;
 
+export function IsReadOnly():Promise<boolean>;
+
 export function KillGPUProcess(arg1:number):Promise<main.GPUProcessControlResult>;
 
 export function LoadPages(arg1:string):Promise<main.PageResult>;
@@ -46,32 +154,82 @@ export function OnStartup(arg1:context.Context):Promise<void>;
 
 export function OpenURL(arg1:string):Promise<void>;
 
+export function PauseMonitoring(arg1:string):Promise<services.CollectionState>;
+
+export function PreviewGPUProcessControl(arg1:number,arg2:string,arg3:string):Promise<monitoring.ControlPreview>;
+
+export function RedetectGPUVendor():Promise<monitoring.GPUVendorStatus>;
+
+export function RemoveProtectedProcess(arg1:number):Promise<void>;
+
+export function RemoveWatchedProcess(arg1:number):Promise<void>;
+
+export function ReportVisibility(arg1:string,arg2:boolean,arg3:Array<string>):Promise<monitoring.ClientDemandStatus>;
+
+export function RequestElevation():Promise<monitoring.ElevationResult>;
+
 export function RestoreDatabase(arg1:string):Promise<void>;
 
 export function ResumeGPUProcess(arg1:number):Promise<main.GPUProcessControlResult>;
 
+export function ResumeMonitoring(arg1:string):Promise<services.CollectionState>;
+
 export function SavePage(arg1:string,arg2:string,arg3:string):Promise<main.PageResult>;
 
+export function SaveProtectedProcess(arg1:db.ProtectedProcess):Promise<db.ProtectedProcess>;
+
+export function SaveWatchedProcess(arg1:db.WatchedProcess):Promise<db.WatchedProcess>;
+
 export function SaveWidget(arg1:string,arg2:string,arg3:string,arg4:Record<string, any>):Promise<main.WidgetResult>;
 
+export function SaveWidgetStates(arg1:services.WidgetStateBundle):Promise<services.WidgetStateBundle>;
+
 export function SaveWidgets(arg1:string,arg2:string,arg3:Array<Record<string, any>>):Promise<main.WidgetResult>;
 
+export function SearchProcesses(arg1:string,arg2:number):Promise<monitoring.ProcessSearchResponse>;
+
+export function SendTestNotification():Promise<monitoring.NotificationRecord>;
+
+export function SetFanCurves(arg1:Array<monitoring.FanCurve>):Promise<void>;
+
+export function SetFanPWM(arg1:string,arg2:number):Promise<monitoring.Fan>;
+
+export function SetGPUMonitoringLogs(arg1:boolean):Promise<void>;
+
+export function SetGPUPowerLimit(arg1:number,arg2:number):Promise<monitoring.GPUPowerLimits>;
+
 export function SetGPUProcessMonitoring(arg1:boolean):Promise<void>;
 
+export function SetGPUProcessNamePatterns(arg1:string,arg2:string):Promise<void>;
+
 export function SetGPUProcessPriority(arg1:number,arg2:string):Promise<main.GPUProcessControlResult>;
 
+export function SetLogLevel(arg1:string):Promise<void>;
+
+export function SetProcessLimits(arg1:number,arg2:number,arg3:number):Promise<monitoring.ProcessLimits>;
+
 export function ShowFileDialog(arg1:string,arg2:string,arg3:Array<string>):Promise<string>;
 
 export function ShowMessageDialog(arg1:string,arg2:string,arg3:number):Promise<void>;
 
 export function ShowSaveDialog(arg1:string,arg2:string,arg3:Array<string>):Promise<string>;
 
+export function StartDiskScan(arg1:monitoring.DiskScanOptions):Promise<void>;
+
 export function StartMonitoring():Promise<void>;
 
+export function StartSpeedtest():Promise<void>;
+
+export function StartStressTest(arg1:monitoring.StressTestOptions):Promise<void>;
+
 export function StopMonitoring():Promise<void>;
 
+export function StopStressTest():Promise<void>;
+
 export function SuspendGPUProcess(arg1:number):Promise<main.GPUProcessControlResult>;
 
+export function UpdateConfig(arg1:services.Config):Promise<void>;
+
 export function UpdatePageName(arg1:string,arg2:string,arg3:string):Promise<main.PageResult>;
 
 export function ValidateGPUProcess(arg1:number):Promise<main.GPUProcessValidationResult>;
@@ -6,6 +6,10 @@ export function BackupDatabase() {
   return window['go']['main']['App']['BackupDatabase']();
 }
 
+export function CompareStressTests(arg1, arg2) {
+  return window['go']['main']['App']['CompareStressTests'](arg1, arg2);
+}
+
 export function CreatePage(arg1, arg2, arg3) {
   return window['go']['main']['App']['CreatePage'](arg1, arg2, arg3);
 }
@@ -22,14 +26,78 @@ export function DeleteWidget(arg1, arg2, arg3) {
   return window['go']['main']['App']['DeleteWidget'](arg1, arg2, arg3);
 }
 
+export function EndSession(arg1) {
+  return window['go']['main']['App']['EndSession'](arg1);
+}
+
 export function ExecuteRawSQL(arg1) {
   return window['go']['main']['App']['ExecuteRawSQL'](arg1);
 }
 
+export function ExportAppState(arg1, arg2) {
+  return window['go']['main']['App']['ExportAppState'](arg1, arg2);
+}
+
+export function GetAvailability(arg1) {
+  return window['go']['main']['App']['GetAvailability'](arg1);
+}
+
+export function GetClientDemand() {
+  return window['go']['main']['App']['GetClientDemand']();
+}
+
+export function GetCollectorSchedule() {
+  return window['go']['main']['App']['GetCollectorSchedule']();
+}
+
+export function GetConfig() {
+  return window['go']['main']['App']['GetConfig']();
+}
+
+export function GetConnectionsFiltered(arg1) {
+  return window['go']['main']['App']['GetConnectionsFiltered'](arg1);
+}
+
+export function GetDataDirectory() {
+  return window['go']['main']['App']['GetDataDirectory']();
+}
+
+export function GetDeviceInventory() {
+  return window['go']['main']['App']['GetDeviceInventory']();
+}
+
+export function GetEnergySummary(arg1) {
+  return window['go']['main']['App']['GetEnergySummary'](arg1);
+}
+
+export function GetEvents(arg1) {
+  return window['go']['main']['App']['GetEvents'](arg1);
+}
+
+export function GetFanCurves() {
+  return window['go']['main']['App']['GetFanCurves']();
+}
+
+export function GetFans() {
+  return window['go']['main']['App']['GetFans']();
+}
+
+export function GetGPUECCStatus() {
+  return window['go']['main']['App']['GetGPUECCStatus']();
+}
+
 export function GetGPUInfo() {
   return window['go']['main']['App']['GetGPUInfo']();
 }
 
+export function GetGPUInfoWithRefresh(arg1) {
+  return window['go']['main']['App']['GetGPUInfoWithRefresh'](arg1);
+}
+
+export function GetGPUPowerLimits() {
+  return window['go']['main']['App']['GetGPUPowerLimits']();
+}
+
 export function GetGPUProcesses() {
   return window['go']['main']['App']['GetGPUProcesses']();
 }
@@ -38,22 +106,158 @@ export function GetGPUProcessesFiltered(arg1) {
   return window['go']['main']['App']['GetGPUProcessesFiltered'](arg1);
 }
 
+export function GetGPUProcessesWithDetail(arg1) {
+  return window['go']['main']['App']['GetGPUProcessesWithDetail'](arg1);
+}
+
+export function GetGPUProcessesWithRefresh(arg1, arg2) {
+  return window['go']['main']['App']['GetGPUProcessesWithRefresh'](arg1, arg2);
+}
+
+export function GetGPUStaticInfo() {
+  return window['go']['main']['App']['GetGPUStaticInfo']();
+}
+
+export function GetHealth() {
+  return window['go']['main']['App']['GetHealth']();
+}
+
+export function GetLogSettings() {
+  return window['go']['main']['App']['GetLogSettings']();
+}
+
+export function GetMonitoringState() {
+  return window['go']['main']['App']['GetMonitoringState']();
+}
+
+export function GetNetworkErrors() {
+  return window['go']['main']['App']['GetNetworkErrors']();
+}
+
+export function GetNotificationStatus() {
+  return window['go']['main']['App']['GetNotificationStatus']();
+}
+
 export function GetPages(arg1) {
   return window['go']['main']['App']['GetPages'](arg1);
 }
 
+export function GetProcessGroups() {
+  return window['go']['main']['App']['GetProcessGroups']();
+}
+
+export function GetProcessPriority(arg1) {
+  return window['go']['main']['App']['GetProcessPriority'](arg1);
+}
+
+export function GetProcessProfile(arg1) {
+  return window['go']['main']['App']['GetProcessProfile'](arg1);
+}
+
+export function GetProcessProtectionAudit(arg1) {
+  return window['go']['main']['App']['GetProcessProtectionAudit'](arg1);
+}
+
+export function GetProcessesFiltered(arg1) {
+  return window['go']['main']['App']['GetProcessesFiltered'](arg1);
+}
+
+export function GetProtectedProcesses() {
+  return window['go']['main']['App']['GetProtectedProcesses']();
+}
+
 export function GetRealTimeMetrics() {
   return window['go']['main']['App']['GetRealTimeMetrics']();
 }
 
+export function GetRecentMetrics(arg1, arg2) {
+  return window['go']['main']['App']['GetRecentMetrics'](arg1, arg2);
+}
+
+export function GetReport(arg1) {
+  return window['go']['main']['App']['GetReport'](arg1);
+}
+
+export function GetReportHTML(arg1) {
+  return window['go']['main']['App']['GetReportHTML'](arg1);
+}
+
+export function GetResourceHistory(arg1) {
+  return window['go']['main']['App']['GetResourceHistory'](arg1);
+}
+
+export function GetSecurityContext() {
+  return window['go']['main']['App']['GetSecurityContext']();
+}
+
+export function GetSelfTelemetry() {
+  return window['go']['main']['App']['GetSelfTelemetry']();
+}
+
+export function GetSnapshot(arg1) {
+  return window['go']['main']['App']['GetSnapshot'](arg1);
+}
+
+export function GetSnapshotDiff(arg1) {
+  return window['go']['main']['App']['GetSnapshotDiff'](arg1);
+}
+
+export function GetStatus() {
+  return window['go']['main']['App']['GetStatus']();
+}
+
+export function GetStressTestReport(arg1) {
+  return window['go']['main']['App']['GetStressTestReport'](arg1);
+}
+
+export function GetStressTestRuns(arg1) {
+  return window['go']['main']['App']['GetStressTestRuns'](arg1);
+}
+
 export function GetSystemInfo() {
   return window['go']['main']['App']['GetSystemInfo']();
 }
 
+export function GetThermalProfiles(arg1) {
+  return window['go']['main']['App']['GetThermalProfiles'](arg1);
+}
+
+export function GetThrottleInfo() {
+  return window['go']['main']['App']['GetThrottleInfo']();
+}
+
+export function GetTopConsumers(arg1, arg2) {
+  return window['go']['main']['App']['GetTopConsumers'](arg1, arg2);
+}
+
 export function GetTopProcesses(arg1) {
   return window['go']['main']['App']['GetTopProcesses'](arg1);
 }
 
+export function GetTopProcessesWithDetail(arg1, arg2) {
+  return window['go']['main']['App']['GetTopProcessesWithDetail'](arg1, arg2);
+}
+
+export function GetUserUsage() {
+  return window['go']['main']['App']['GetUserUsage']();
+}
+
+export function GetWatchedProcesses() {
+  return window['go']['main']['App']['GetWatchedProcesses']();
+}
+
+export function GetWidgetData(arg1, arg2) {
+  return window['go']['main']['App']['GetWidgetData'](arg1, arg2);
+}
+
+export function GetWidgetSchemas() {
+  return window['go']['main']['App']['GetWidgetSchemas']();
+}
+
+export function GetWidgetStates(arg1, arg2) {
+  return window['go']['main']['App']['GetWidgetStates'](arg1, arg2);
+}
+
 export function GetWidgets(arg1, arg2) {
   return window['go']['main']['App']['GetWidgets'](arg1, arg2);
 }
@@ -62,10 +266,18 @@ export function Greet(arg1) {
   return window['go']['main']['App']['Greet'](arg1);
 }
 
+export function ImportAppState(arg1, arg2) {
+  return window['go']['main']['App']['ImportAppState'](arg1, arg2);
+}
+
 export function IsMonitoringRunning() {
   return window['go']['main']['App']['IsMonitoringRunning']();
 }
 
+export function IsReadOnly() {
+  return window['go']['main']['App']['IsReadOnly']();
+}
+
 export function KillGPUProcess(arg1) {
   return window['go']['main']['App']['KillGPUProcess'](arg1);
 }
@@ -86,6 +298,34 @@ export function OpenURL(arg1) {
   return window['go']['main']['App']['OpenURL'](arg1);
 }
 
+export function PauseMonitoring(arg1) {
+  return window['go']['main']['App']['PauseMonitoring'](arg1);
+}
+
+export function PreviewGPUProcessControl(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PreviewGPUProcessControl'](arg1, arg2, arg3);
+}
+
+export function RedetectGPUVendor() {
+  return window['go']['main']['App']['RedetectGPUVendor']();
+}
+
+export function RemoveProtectedProcess(arg1) {
+  return window['go']['main']['App']['RemoveProtectedProcess'](arg1);
+}
+
+export function RemoveWatchedProcess(arg1) {
+  return window['go']['main']['App']['RemoveWatchedProcess'](arg1);
+}
+
+export function ReportVisibility(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReportVisibility'](arg1, arg2, arg3);
+}
+
+export function RequestElevation() {
+  return window['go']['main']['App']['RequestElevation']();
+}
+
 export function RestoreDatabase(arg1) {
   return window['go']['main']['App']['RestoreDatabase'](arg1);
 }
@@ -94,26 +334,78 @@ export function ResumeGPUProcess(arg1) {
   return window['go']['main']['App']['ResumeGPUProcess'](arg1);
 }
 
+export function ResumeMonitoring(arg1) {
+  return window['go']['main']['App']['ResumeMonitoring'](arg1);
+}
+
 export function SavePage(arg1, arg2, arg3) {
   return window['go']['main']['App']['SavePage'](arg1, arg2, arg3);
 }
 
+export function SaveProtectedProcess(arg1) {
+  return window['go']['main']['App']['SaveProtectedProcess'](arg1);
+}
+
+export function SaveWatchedProcess(arg1) {
+  return window['go']['main']['App']['SaveWatchedProcess'](arg1);
+}
+
 export function SaveWidget(arg1, arg2, arg3, arg4) {
   return window['go']['main']['App']['SaveWidget'](arg1, arg2, arg3, arg4);
 }
 
+export function SaveWidgetStates(arg1) {
+  return window['go']['main']['App']['SaveWidgetStates'](arg1);
+}
+
 export function SaveWidgets(arg1, arg2, arg3) {
   return window['go']['main']['App']['SaveWidgets'](arg1, arg2, arg3);
 }
 
+export function SearchProcesses(arg1, arg2) {
+  return window['go']['main']['App']['SearchProcesses'](arg1, arg2);
+}
+
+export function SendTestNotification() {
+  return window['go']['main']['App']['SendTestNotification']();
+}
+
+export function SetFanCurves(arg1) {
+  return window['go']['main']['App']['SetFanCurves'](arg1);
+}
+
+export function SetFanPWM(arg1, arg2) {
+  return window['go']['main']['App']['SetFanPWM'](arg1, arg2);
+}
+
+export function SetGPUMonitoringLogs(arg1) {
+  return window['go']['main']['App']['SetGPUMonitoringLogs'](arg1);
+}
+
+export function SetGPUPowerLimit(arg1, arg2) {
+  return window['go']['main']['App']['SetGPUPowerLimit'](arg1, arg2);
+}
+
 export function SetGPUProcessMonitoring(arg1) {
   return window['go']['main']['App']['SetGPUProcessMonitoring'](arg1);
 }
 
+export function SetGPUProcessNamePatterns(arg1, arg2) {
+  return window['go']['main']['App']['SetGPUProcessNamePatterns'](arg1, arg2);
+}
+
 export function SetGPUProcessPriority(arg1, arg2) {
   return window['go']['main']['App']['SetGPUProcessPriority'](arg1, arg2);
 }
 
+export function SetLogLevel(arg1) {
+  return window['go']['main']['App']['SetLogLevel'](arg1);
+}
+
+export function SetProcessLimits(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetProcessLimits'](arg1, arg2, arg3);
+}
+
 export function ShowFileDialog(arg1, arg2, arg3) {
   return window['go']['main']['App']['ShowFileDialog'](arg1, arg2, arg3);
 }
@@ -126,18 +418,38 @@ export function ShowSaveDialog(arg1, arg2, arg3) {
   return window['go']['main']['App']['ShowSaveDialog'](arg1, arg2, arg3);
 }
 
+export function StartDiskScan(arg1) {
+  return window['go']['main']['App']['StartDiskScan'](arg1);
+}
+
 export function StartMonitoring() {
   return window['go']['main']['App']['StartMonitoring']();
 }
 
+export function StartSpeedtest() {
+  return window['go']['main']['App']['StartSpeedtest']();
+}
+
+export function StartStressTest(arg1) {
+  return window['go']['main']['App']['StartStressTest'](arg1);
+}
+
 export function StopMonitoring() {
   return window['go']['main']['App']['StopMonitoring']();
 }
 
+export function StopStressTest() {
+  return window['go']['main']['App']['StopStressTest']();
+}
+
 export function SuspendGPUProcess(arg1) {
   return window['go']['main']['App']['SuspendGPUProcess'](arg1);
 }
 
+export function UpdateConfig(arg1) {
+  return window['go']['main']['App']['UpdateConfig'](arg1);
+}
+
 export function UpdatePageName(arg1, arg2, arg3) {
   return window['go']['main']['App']['UpdatePageName'](arg1, arg2, arg3);
 }
@@ -1,111 +1,5082 @@
-export namespace main {
+export namespace db {
 	
-	export class GPUProcessControlResult {
-	    pid: number;
+	export class Availability {
+	    // Go type: time
+	    since: any;
+	    // Go type: time
+	    until: any;
+	    uptime_percent: number;
+	    downtime_seconds: number;
+	    monitored_seconds: number;
+	    coverage_percent: number;
+	    sessions: number;
+	    reboots: RebootEvent[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Availability(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.since = this.convertValues(source["since"], null);
+	        this.until = this.convertValues(source["until"], null);
+	        this.uptime_percent = source["uptime_percent"];
+	        this.downtime_seconds = source["downtime_seconds"];
+	        this.monitored_seconds = source["monitored_seconds"];
+	        this.coverage_percent = source["coverage_percent"];
+	        this.sessions = source["sessions"];
+	        this.reboots = this.convertValues(source["reboots"], RebootEvent);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Event {
+	    id: number;
+	    // Go type: time
+	    timestamp: any;
+	    category: string;
+	    action: string;
+	    target?: string;
 	    success: boolean;
-	    message: string;
-	    operation: string;
-	    priority?: string;
+	    message?: string;
+	    details?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new GPUProcessControlResult(source);
+	        return new Event(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.pid = source["pid"];
+	        this.id = source["id"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.category = source["category"];
+	        this.action = source["action"];
+	        this.target = source["target"];
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.operation = source["operation"];
-	        this.priority = source["priority"];
+	        this.details = source["details"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class GPUProcessValidationResult {
-	    pid: number;
-	    is_valid: boolean;
-	    message: string;
-	    process_name?: string;
+
+	export class EventQuery {
+	    category?: string;
+	    action?: string;
+	    target?: string;
+	    // Go type: time
+	    since?: any;
+	    // Go type: time
+	    until?: any;
+	    maxItems?: number;
+	    offset?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GPUProcessValidationResult(source);
+	        return new EventQuery(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.pid = source["pid"];
-	        this.is_valid = source["is_valid"];
-	        this.message = source["message"];
-	        this.process_name = source["process_name"];
+	        this.category = source["category"];
+	        this.action = source["action"];
+	        this.target = source["target"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.until = this.convertValues(source["until"], null);
+	        this.maxItems = source["maxItems"];
+	        this.offset = source["offset"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PageResult {
-	    user_id: string;
-	    page_id?: string;
-	    page_name?: string;
-	    pages: any[];
-	    success: boolean;
-	    message: string;
+	export class MetricChange {
+	    metricType: string;
+	    from_avg: number;
+	    to_avg: number;
+	    change_percent: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new PageResult(source);
+	        return new MetricChange(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.user_id = source["user_id"];
-	        this.page_id = source["page_id"];
-	        this.page_name = source["page_name"];
-	        this.pages = source["pages"];
-	        this.success = source["success"];
-	        this.message = source["message"];
+	        this.metricType = source["metricType"];
+	        this.from_avg = source["from_avg"];
+	        this.to_avg = source["to_avg"];
+	        this.change_percent = source["change_percent"];
 	    }
 	}
-	export class RealTimeMetrics {
-	    cpu_usage: number;
-	    cpu_core_usage: number[];
-	    memory_usage: number;
-	    disk_usage?: monitoring.DiskUsageInfo;
-	    disk_read_speed: number;
-	    disk_write_speed: number;
-	    network_io: monitoring.NetworkInterface[];
-	    net_sent_speed: number;
-	    net_recv_speed: number;
-	    system_uptime: number;
+	export class MetricSummary {
+	    metricType: string;
+	    avg: number;
+	    min: number;
+	    peak: number;
+	    samples: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MetricSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metricType = source["metricType"];
+	        this.avg = source["avg"];
+	        this.min = source["min"];
+	        this.peak = source["peak"];
+	        this.samples = source["samples"];
+	    }
+	}
+	export class ProcessSnapshotEntry {
+	    name: string;
+	    process_count: number;
+	    cpu_percent: number;
+	    memory_rss: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessSnapshotEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.process_count = source["process_count"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.memory_rss = source["memory_rss"];
+	    }
+	}
+	export class ProtectedProcess {
+	    id: number;
+	    name: string;
+	    pattern: string;
+	    level: string;
+	    description: string;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtectedProcess(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.pattern = source["pattern"];
+	        this.level = source["level"];
+	        this.description = source["description"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RebootEvent {
 	    // Go type: time
 	    boot_time: any;
-	    gpu_info?: monitoring.GPUInfo;
-	    gpu_processes: monitoring.GPUProcess[];
-	    top_processes: monitoring.ProcessInfo[];
-	    memory_details?: monitoring.MemoryDetails;
-	    battery_info?: monitoring.BatteryInfo;
-	    network_status: string;
 	    // Go type: time
-	    timestamp: any;
+	    detected_at: any;
+	    // Go type: time
+	    last_seen_before: any;
+	    downtime_seconds: number;
+	    clean_stop: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new RealTimeMetrics(source);
+	        return new RebootEvent(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.cpu_usage = source["cpu_usage"];
-	        this.cpu_core_usage = source["cpu_core_usage"];
-	        this.memory_usage = source["memory_usage"];
-	        this.disk_usage = this.convertValues(source["disk_usage"], monitoring.DiskUsageInfo);
-	        this.disk_read_speed = source["disk_read_speed"];
-	        this.disk_write_speed = source["disk_write_speed"];
-	        this.network_io = this.convertValues(source["network_io"], monitoring.NetworkInterface);
-	        this.net_sent_speed = source["net_sent_speed"];
-	        this.net_recv_speed = source["net_recv_speed"];
-	        this.system_uptime = source["system_uptime"];
 	        this.boot_time = this.convertValues(source["boot_time"], null);
-	        this.gpu_info = this.convertValues(source["gpu_info"], monitoring.GPUInfo);
-	        this.gpu_processes = this.convertValues(source["gpu_processes"], monitoring.GPUProcess);
-	        this.top_processes = this.convertValues(source["top_processes"], monitoring.ProcessInfo);
-	        this.memory_details = this.convertValues(source["memory_details"], monitoring.MemoryDetails);
-	        this.battery_info = this.convertValues(source["battery_info"], monitoring.BatteryInfo);
-	        this.network_status = source["network_status"];
+	        this.detected_at = this.convertValues(source["detected_at"], null);
+	        this.last_seen_before = this.convertValues(source["last_seen_before"], null);
+	        this.downtime_seconds = source["downtime_seconds"];
+	        this.clean_stop = source["clean_stop"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResourceHistoryPoint {
+	    // Go type: time
+	    timestamp: any;
+	    metricType: string;
+	    avg: number;
+	    min: number;
+	    max: number;
+	    stddev: number;
+	    count: number;
+	    unit?: string;
+	    p50?: number;
+	    p95?: number;
+	    p99?: number;
+	    percentilesApproximate?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResourceHistoryPoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.metricType = source["metricType"];
+	        this.avg = source["avg"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	        this.stddev = source["stddev"];
+	        this.count = source["count"];
+	        this.unit = source["unit"];
+	        this.p50 = source["p50"];
+	        this.p95 = source["p95"];
+	        this.p99 = source["p99"];
+	        this.percentilesApproximate = source["percentilesApproximate"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResourceHistoryQuery {
+	    metricTypes: string[];
+	    // Go type: time
+	    since: any;
+	    // Go type: time
+	    until?: any;
+	    resolution?: string;
+	    percentiles?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResourceHistoryQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metricTypes = source["metricTypes"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.until = this.convertValues(source["until"], null);
+	        this.resolution = source["resolution"];
+	        this.percentiles = source["percentiles"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SnapshotDiff {
+	    // Go type: time
+	    from: any;
+	    // Go type: time
+	    to: any;
+	    // Go type: time
+	    from_snapshot: any;
+	    // Go type: time
+	    to_snapshot: any;
+	    window_minutes: number;
+	    threshold_percent: number;
+	    appeared: ProcessSnapshotEntry[];
+	    disappeared: ProcessSnapshotEntry[];
+	    metric_changes: MetricChange[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SnapshotDiff(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = this.convertValues(source["from"], null);
+	        this.to = this.convertValues(source["to"], null);
+	        this.from_snapshot = this.convertValues(source["from_snapshot"], null);
+	        this.to_snapshot = this.convertValues(source["to_snapshot"], null);
+	        this.window_minutes = source["window_minutes"];
+	        this.threshold_percent = source["threshold_percent"];
+	        this.appeared = this.convertValues(source["appeared"], ProcessSnapshotEntry);
+	        this.disappeared = this.convertValues(source["disappeared"], ProcessSnapshotEntry);
+	        this.metric_changes = this.convertValues(source["metric_changes"], MetricChange);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SnapshotDiffQuery {
+	    // Go type: time
+	    from: any;
+	    // Go type: time
+	    to: any;
+	    window_minutes: number;
+	    threshold_percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SnapshotDiffQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = this.convertValues(source["from"], null);
+	        this.to = this.convertValues(source["to"], null);
+	        this.window_minutes = source["window_minutes"];
+	        this.threshold_percent = source["threshold_percent"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class StressTestRun {
+	    id: number;
+	    kind: string;
+	    label: string;
+	    threads: number;
+	    // Go type: time
+	    started_at: any;
+	    // Go type: time
+	    ended_at: any;
+	    stopped: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new StressTestRun(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.kind = source["kind"];
+	        this.label = source["label"];
+	        this.threads = source["threads"];
+	        this.started_at = this.convertValues(source["started_at"], null);
+	        this.ended_at = this.convertValues(source["ended_at"], null);
+	        this.stopped = source["stopped"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ThermalDay {
+	    date: string;
+	    avg: number;
+	    min: number;
+	    max: number;
+	    hours: ThermalHour[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ThermalDay(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.avg = source["avg"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	        this.hours = this.convertValues(source["hours"], ThermalHour);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ThermalHour {
+	    hour: number;
+	    avg: number;
+	    min: number;
+	    max: number;
+	    samples: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ThermalHour(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hour = source["hour"];
+	        this.avg = source["avg"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	        this.samples = source["samples"];
+	    }
+	}
+	export class ThermalProfile {
+	    metric_type: string;
+	    days: ThermalDay[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ThermalProfile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metric_type = source["metric_type"];
+	        this.days = this.convertValues(source["days"], ThermalDay);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WatchedProcess {
+	    id: number;
+	    name: string;
+	    restart_command: string;
+	    max_cpu_percent: number;
+	    max_memory_mb: number;
+	    enabled: boolean;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new WatchedProcess(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.restart_command = source["restart_command"];
+	        this.max_cpu_percent = source["max_cpu_percent"];
+	        this.max_memory_mb = source["max_memory_mb"];
+	        this.enabled = source["enabled"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WidgetPage {
+	    page_id: string;
+	    widgets: WidgetState[];
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetPage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.page_id = source["page_id"];
+	        this.widgets = this.convertValues(source["widgets"], WidgetState);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WidgetState {
+	    userId: string;
+	    pageId: string;
+	    widgetId: string;
+	    widgetType: string;
+	    config: string;
+	    layout: string;
+	    version: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.userId = source["userId"];
+	        this.pageId = source["pageId"];
+	        this.widgetId = source["widgetId"];
+	        this.widgetType = source["widgetType"];
+	        this.config = source["config"];
+	        this.layout = source["layout"];
+	        this.version = source["version"];
+	    }
+	}
+}
+
+export namespace main {
+	
+	export class GPUProcessControlResult {
+	    pid: number;
+	    success: boolean;
+	    message: string;
+	    operation: string;
+	    priority?: string;
+	    applied_priority?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessControlResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.operation = source["operation"];
+	        this.priority = source["priority"];
+	        this.applied_priority = source["applied_priority"];
+	    }
+	}
+	export class GPUProcessValidationResult {
+	    pid: number;
+	    is_valid: boolean;
+	    message: string;
+	    process_name?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessValidationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.is_valid = source["is_valid"];
+	        this.message = source["message"];
+	        this.process_name = source["process_name"];
+	    }
+	}
+	export class PageResult {
+	    user_id: string;
+	    page_id?: string;
+	    page_name?: string;
+	    pages: any[];
+	    success: boolean;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PageResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.user_id = source["user_id"];
+	        this.page_id = source["page_id"];
+	        this.page_name = source["page_name"];
+	        this.pages = source["pages"];
+	        this.success = source["success"];
+	        this.message = source["message"];
+	    }
+	}
+	export class RealTimeMetrics {
+	    cpu_usage: number;
+	    cpu_core_usage: number[];
+	    cpu_times?: monitoring.CPUTimeBreakdown;
+	    cpu_temperature: number;
+	    cpu_power?: monitoring.RAPLPower;
+	    throttle?: monitoring.ThrottleInfo;
+	    load?: monitoring.LoadInfo;
+	    memory_usage: number;
+	    disk_usage?: monitoring.DiskUsageInfo;
+	    disk_read_speed: number;
+	    disk_write_speed: number;
+	    disk_temperatures: monitoring.DiskTemperature[];
+	    disk_paths: monitoring.DiskPathUsage[];
+	    network_io: monitoring.NetworkInterface[];
+	    net_sent_speed: number;
+	    net_recv_speed: number;
+	    network_errors: monitoring.NetworkInterfaceErrors[];
+	    system_uptime: number;
+	    // Go type: time
+	    boot_time: any;
+	    gpu_info?: monitoring.GPUInfo;
+	    gpu_engines: monitoring.GPUEngineUsage[];
+	    gpu_adapters: monitoring.GPUAdapter[];
+	    gpu_bandwidth: monitoring.GPUBandwidth[];
+	    gpu_ecc: monitoring.GPUECCStatus[];
+	    npu_info?: monitoring.NPUInfo;
+	    gpu_processes: monitoring.GPUProcess[];
+	    top_processes: monitoring.ProcessInfo[];
+	    memory_details?: monitoring.MemoryDetails;
+	    memory_breakdown?: monitoring.MemoryBreakdown;
+	    memory_paging?: monitoring.MemoryPaging;
+	    battery_info?: monitoring.BatteryInfo;
+	    network_status: string;
+	    network_quality?: monitoring.NetworkQuality;
+	    wifi: monitoring.WiFiInfo[];
+	    frame_stats: monitoring.AppFrameStats[];
+	    fans: monitoring.Fan[];
+	    audio?: monitoring.AudioInfo;
+	    idle?: monitoring.IdleState;
+	    system_power_watts: number;
+	    power_info?: monitoring.SystemPowerInfo;
+	    collector_errors?: {[key: string]: string};
+	    // Go type: time
+	    timestamp: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new RealTimeMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cpu_usage = source["cpu_usage"];
+	        this.cpu_core_usage = source["cpu_core_usage"];
+	        this.cpu_times = this.convertValues(source["cpu_times"], monitoring.CPUTimeBreakdown);
+	        this.cpu_temperature = source["cpu_temperature"];
+	        this.cpu_power = this.convertValues(source["cpu_power"], monitoring.RAPLPower);
+	        this.throttle = this.convertValues(source["throttle"], monitoring.ThrottleInfo);
+	        this.load = this.convertValues(source["load"], monitoring.LoadInfo);
+	        this.memory_usage = source["memory_usage"];
+	        this.disk_usage = this.convertValues(source["disk_usage"], monitoring.DiskUsageInfo);
+	        this.disk_read_speed = source["disk_read_speed"];
+	        this.disk_write_speed = source["disk_write_speed"];
+	        this.disk_temperatures = this.convertValues(source["disk_temperatures"], monitoring.DiskTemperature);
+	        this.disk_paths = this.convertValues(source["disk_paths"], monitoring.DiskPathUsage);
+	        this.network_io = this.convertValues(source["network_io"], monitoring.NetworkInterface);
+	        this.net_sent_speed = source["net_sent_speed"];
+	        this.net_recv_speed = source["net_recv_speed"];
+	        this.network_errors = this.convertValues(source["network_errors"], monitoring.NetworkInterfaceErrors);
+	        this.system_uptime = source["system_uptime"];
+	        this.boot_time = this.convertValues(source["boot_time"], null);
+	        this.gpu_info = this.convertValues(source["gpu_info"], monitoring.GPUInfo);
+	        this.gpu_engines = this.convertValues(source["gpu_engines"], monitoring.GPUEngineUsage);
+	        this.gpu_adapters = this.convertValues(source["gpu_adapters"], monitoring.GPUAdapter);
+	        this.gpu_bandwidth = this.convertValues(source["gpu_bandwidth"], monitoring.GPUBandwidth);
+	        this.gpu_ecc = this.convertValues(source["gpu_ecc"], monitoring.GPUECCStatus);
+	        this.npu_info = this.convertValues(source["npu_info"], monitoring.NPUInfo);
+	        this.gpu_processes = this.convertValues(source["gpu_processes"], monitoring.GPUProcess);
+	        this.top_processes = this.convertValues(source["top_processes"], monitoring.ProcessInfo);
+	        this.memory_details = this.convertValues(source["memory_details"], monitoring.MemoryDetails);
+	        this.memory_breakdown = this.convertValues(source["memory_breakdown"], monitoring.MemoryBreakdown);
+	        this.memory_paging = this.convertValues(source["memory_paging"], monitoring.MemoryPaging);
+	        this.battery_info = this.convertValues(source["battery_info"], monitoring.BatteryInfo);
+	        this.network_status = source["network_status"];
+	        this.network_quality = this.convertValues(source["network_quality"], monitoring.NetworkQuality);
+	        this.wifi = this.convertValues(source["wifi"], monitoring.WiFiInfo);
+	        this.frame_stats = this.convertValues(source["frame_stats"], monitoring.AppFrameStats);
+	        this.fans = this.convertValues(source["fans"], monitoring.Fan);
+	        this.audio = this.convertValues(source["audio"], monitoring.AudioInfo);
+	        this.idle = this.convertValues(source["idle"], monitoring.IdleState);
+	        this.system_power_watts = source["system_power_watts"];
+	        this.power_info = this.convertValues(source["power_info"], monitoring.SystemPowerInfo);
+	        this.collector_errors = source["collector_errors"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SystemInfo {
+	    platform: string;
+	    cpu_cores: number;
+	    cpu_model: string;
+	    total_memory: number;
+	    // Go type: time
+	    boot_time: any;
+	    host?: monitoring.HostInfo;
+	
+	    static createFrom(source: any = {}) {
+	        return new SystemInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.platform = source["platform"];
+	        this.cpu_cores = source["cpu_cores"];
+	        this.cpu_model = source["cpu_model"];
+	        this.total_memory = source["total_memory"];
+	        this.boot_time = this.convertValues(source["boot_time"], null);
+	        this.host = this.convertValues(source["host"], monitoring.HostInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WidgetResult {
+	    user_id: string;
+	    page_id: string;
+	    widgets: any[];
+	    success: boolean;
+	    message: string;
+	    count?: number;
+	    widget_id?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.user_id = source["user_id"];
+	        this.page_id = source["page_id"];
+	        this.widgets = source["widgets"];
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.count = source["count"];
+	        this.widget_id = source["widget_id"];
+	    }
+	}
+
+}
+
+export namespace monitoring {
+	
+	export class AppFrameStats {
+	    application: string;
+	    process_id: number;
+	    runtime: string;
+	    fps: number;
+	    avg_frame_time_ms: number;
+	    p99_frame_time_ms: number;
+	    max_frame_time_ms: number;
+	    dropped_frames: number;
+	    frames_in_window: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AppFrameStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.application = source["application"];
+	        this.process_id = source["process_id"];
+	        this.runtime = source["runtime"];
+	        this.fps = source["fps"];
+	        this.avg_frame_time_ms = source["avg_frame_time_ms"];
+	        this.p99_frame_time_ms = source["p99_frame_time_ms"];
+	        this.max_frame_time_ms = source["max_frame_time_ms"];
+	        this.dropped_frames = source["dropped_frames"];
+	        this.frames_in_window = source["frames_in_window"];
+	    }
+	}
+	export class AudioDevice {
+	    id: string;
+	    name: string;
+	    flow: string;
+	    volume: number;
+	    muted: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new AudioDevice(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.flow = source["flow"];
+	        this.volume = source["volume"];
+	        this.muted = source["muted"];
+	    }
+	}
+	export class AudioInfo {
+	    playback?: AudioDevice;
+	    capture?: AudioDevice;
+	    sessions: AudioSession[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AudioInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.playback = this.convertValues(source["playback"], AudioDevice);
+	        this.capture = this.convertValues(source["capture"], AudioDevice);
+	        this.sessions = this.convertValues(source["sessions"], AudioSession);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AudioSession {
+	    process_id: number;
+	    process_name: string;
+	    display_name?: string;
+	    flow: string;
+	    active: boolean;
+	    volume: number;
+	    muted: boolean;
+	    peak_level: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AudioSession(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.process_id = source["process_id"];
+	        this.process_name = source["process_name"];
+	        this.display_name = source["display_name"];
+	        this.flow = source["flow"];
+	        this.active = source["active"];
+	        this.volume = source["volume"];
+	        this.muted = source["muted"];
+	        this.peak_level = source["peak_level"];
+	    }
+	}
+	export class BatteryInfo {
+	    Percent: number;
+	    Plugged: number;
+	    DesignCapacity: number;
+	    FullChargeCapacity: number;
+	    HealthPercent: number;
+	    ChargeRateWatts: number;
+	    TimeRemainingMinutes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BatteryInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Percent = source["Percent"];
+	        this.Plugged = source["Plugged"];
+	        this.DesignCapacity = source["DesignCapacity"];
+	        this.FullChargeCapacity = source["FullChargeCapacity"];
+	        this.HealthPercent = source["HealthPercent"];
+	        this.ChargeRateWatts = source["ChargeRateWatts"];
+	        this.TimeRemainingMinutes = source["TimeRemainingMinutes"];
+	    }
+	}
+	export class CPUSnapshot {
+	    usage: number;
+	    cores: number[];
+	    temperature: number;
+	    times?: CPUTimeBreakdown;
+	    load?: LoadInfo;
+	    power?: RAPLPower;
+	
+	    static createFrom(source: any = {}) {
+	        return new CPUSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.usage = source["usage"];
+	        this.cores = source["cores"];
+	        this.temperature = source["temperature"];
+	        this.times = this.convertValues(source["times"], CPUTimeBreakdown);
+	        this.load = this.convertValues(source["load"], LoadInfo);
+	        this.power = this.convertValues(source["power"], RAPLPower);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CPUTimeBreakdown {
+	    user: number;
+	    system: number;
+	    idle: number;
+	    nice: number;
+	    iowait: number;
+	    irq: number;
+	    softirq: number;
+	    steal: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CPUTimeBreakdown(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.user = source["user"];
+	        this.system = source["system"];
+	        this.idle = source["idle"];
+	        this.nice = source["nice"];
+	        this.iowait = source["iowait"];
+	        this.irq = source["irq"];
+	        this.softirq = source["softirq"];
+	        this.steal = source["steal"];
+	    }
+	}
+	export class CacheStatus {
+	    name: string;
+	    // Go type: time
+	    last_updated: any;
+	    cache_age_ms: number;
+	    max_age_ms: number;
+	    stale: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new CacheStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.last_updated = this.convertValues(source["last_updated"], null);
+	        this.cache_age_ms = source["cache_age_ms"];
+	        this.max_age_ms = source["max_age_ms"];
+	        this.stale = source["stale"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ClientDemandStatus {
+	    background_interval_ms: number;
+	    all_background: boolean;
+	    clients: ClientVisibility[];
+	    collectors: CollectorDemand[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ClientDemandStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.background_interval_ms = source["background_interval_ms"];
+	        this.all_background = source["all_background"];
+	        this.clients = this.convertValues(source["clients"], ClientVisibility);
+	        this.collectors = this.convertValues(source["collectors"], CollectorDemand);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ClientVisibility {
+	    session_id: string;
+	    foreground: boolean;
+	    widgets: string[];
+	    // Go type: time
+	    reported_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClientVisibility(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.session_id = source["session_id"];
+	        this.foreground = source["foreground"];
+	        this.widgets = source["widgets"];
+	        this.reported_at = this.convertValues(source["reported_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CollectorDemand {
+	    collector: string;
+	    active: boolean;
+	    interval_ms: number;
+	    sessions: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectorDemand(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.collector = source["collector"];
+	        this.active = source["active"];
+	        this.interval_ms = source["interval_ms"];
+	        this.sessions = source["sessions"];
+	    }
+	}
+	export class CollectorSchedule {
+	    name: string;
+	    adaptive: boolean;
+	    base_interval_ms: number;
+	    current_interval_ms: number;
+	    avg_duration_ms: number;
+	    skipped: number;
+	    // Go type: time
+	    last_run: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectorSchedule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.adaptive = source["adaptive"];
+	        this.base_interval_ms = source["base_interval_ms"];
+	        this.current_interval_ms = source["current_interval_ms"];
+	        this.avg_duration_ms = source["avg_duration_ms"];
+	        this.skipped = source["skipped"];
+	        this.last_run = this.convertValues(source["last_run"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CollectorStats {
+	    name: string;
+	    calls: number;
+	    errors: number;
+	    consecutive_errors: number;
+	    last_duration_ms: number;
+	    avg_duration_ms: number;
+	    max_duration_ms: number;
+	    last_error?: string;
+	    // Go type: time
+	    last_error_at: any;
+	    // Go type: time
+	    last_run: any;
+	    // Go type: time
+	    last_success: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectorStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.calls = source["calls"];
+	        this.errors = source["errors"];
+	        this.consecutive_errors = source["consecutive_errors"];
+	        this.last_duration_ms = source["last_duration_ms"];
+	        this.avg_duration_ms = source["avg_duration_ms"];
+	        this.max_duration_ms = source["max_duration_ms"];
+	        this.last_error = source["last_error"];
+	        this.last_error_at = this.convertValues(source["last_error_at"], null);
+	        this.last_run = this.convertValues(source["last_run"], null);
+	        this.last_success = this.convertValues(source["last_success"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CollectorStatus {
+	    name: string;
+	    state: string;
+	    message?: string;
+	    message_key?: string;
+	    last_error?: string;
+	    // Go type: time
+	    last_error_at?: any;
+	    // Go type: time
+	    last_success?: any;
+	    consecutive_errors: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectorStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.state = source["state"];
+	        this.message = source["message"];
+	        this.message_key = source["message_key"];
+	        this.last_error = source["last_error"];
+	        this.last_error_at = this.convertValues(source["last_error_at"], null);
+	        this.last_success = this.convertValues(source["last_success"], null);
+	        this.consecutive_errors = source["consecutive_errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConnectionFilter {
+	    protocol: string;
+	    state: string;
+	    process_name: string;
+	    address: string;
+	    pid: number;
+	    exclude_listening: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.protocol = source["protocol"];
+	        this.state = source["state"];
+	        this.process_name = source["process_name"];
+	        this.address = source["address"];
+	        this.pid = source["pid"];
+	        this.exclude_listening = source["exclude_listening"];
+	    }
+	}
+	export class ConnectionInfo {
+	    protocol: string;
+	    local_addr: string;
+	    local_port: number;
+	    remote_addr: string;
+	    remote_port: number;
+	    state: string;
+	    pid: number;
+	    process_name: string;
+	    send_rate: number;
+	    recv_rate: number;
+	    rate_source?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.protocol = source["protocol"];
+	        this.local_addr = source["local_addr"];
+	        this.local_port = source["local_port"];
+	        this.remote_addr = source["remote_addr"];
+	        this.remote_port = source["remote_port"];
+	        this.state = source["state"];
+	        this.pid = source["pid"];
+	        this.process_name = source["process_name"];
+	        this.send_rate = source["send_rate"];
+	        this.recv_rate = source["recv_rate"];
+	        this.rate_source = source["rate_source"];
+	    }
+	}
+	export class ConnectionQuery {
+	    filter: ConnectionFilter;
+	    sort: ConnectionSort;
+	    max_items: number;
+	    offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filter = this.convertValues(source["filter"], ConnectionFilter);
+	        this.sort = this.convertValues(source["sort"], ConnectionSort);
+	        this.max_items = source["max_items"];
+	        this.offset = source["offset"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConnectionResponse {
+	    connections: ConnectionInfo[];
+	    total_count: number;
+	    filtered_count: number;
+	    has_more: boolean;
+	    query_time_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.connections = this.convertValues(source["connections"], ConnectionInfo);
+	        this.total_count = source["total_count"];
+	        this.filtered_count = source["filtered_count"];
+	        this.has_more = source["has_more"];
+	        this.query_time_ms = source["query_time_ms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConnectionSort {
+	    field: string;
+	    order: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionSort(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.order = source["order"];
+	    }
+	}
+	export class ControlCheck {
+	    name: string;
+	    passed: boolean;
+	    blocking: boolean;
+	    message?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ControlCheck(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.passed = source["passed"];
+	        this.blocking = source["blocking"];
+	        this.message = source["message"];
+	    }
+	}
+	export class ControlPreview {
+	    pid: number;
+	    process_name?: string;
+	    operation: string;
+	    priority?: string;
+	    allowed: boolean;
+	    action?: string;
+	    checks: ControlCheck[];
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ControlPreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.process_name = source["process_name"];
+	        this.operation = source["operation"];
+	        this.priority = source["priority"];
+	        this.allowed = source["allowed"];
+	        this.action = source["action"];
+	        this.checks = this.convertValues(source["checks"], ControlCheck);
+	        this.warnings = source["warnings"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CoreFrequency {
+	    core: number;
+	    mhz: number;
+	    max_mhz: number;
+	    percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CoreFrequency(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.core = source["core"];
+	        this.mhz = source["mhz"];
+	        this.max_mhz = source["max_mhz"];
+	        this.percent = source["percent"];
+	    }
+	}
+	export class DeviceInventory {
+	    usb: USBDevice[];
+	    printers: Printer[];
+	    // Go type: time
+	    timestamp: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeviceInventory(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.usb = this.convertValues(source["usb"], USBDevice);
+	        this.printers = this.convertValues(source["printers"], Printer);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DiskIOSnapshot {
+	    read_bytes_per_sec: number;
+	    write_bytes_per_sec: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskIOSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.read_bytes_per_sec = source["read_bytes_per_sec"];
+	        this.write_bytes_per_sec = source["write_bytes_per_sec"];
+	    }
+	}
+	export class DiskPathUsage {
+	    path: string;
+	    fstype?: string;
+	    total: number;
+	    used: number;
+	    free: number;
+	    used_percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskPathUsage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.fstype = source["fstype"];
+	        this.total = source["total"];
+	        this.used = source["used"];
+	        this.free = source["free"];
+	        this.used_percent = source["used_percent"];
+	    }
+	}
+	export class DiskScanOptions {
+	    path: string;
+	    max_depth: number;
+	    time_limit_seconds: number;
+	    top_count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskScanOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.max_depth = source["max_depth"];
+	        this.time_limit_seconds = source["time_limit_seconds"];
+	        this.top_count = source["top_count"];
+	    }
+	}
+	export class DiskSnapshot {
+	    path?: string;
+	    device?: string;
+	    model?: string;
+	    total: number;
+	    used: number;
+	    free: number;
+	    used_percent: number;
+	    temperature: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.device = source["device"];
+	        this.model = source["model"];
+	        this.total = source["total"];
+	        this.used = source["used"];
+	        this.free = source["free"];
+	        this.used_percent = source["used_percent"];
+	        this.temperature = source["temperature"];
+	    }
+	}
+	export class DiskTemperature {
+	    device: string;
+	    model: string;
+	    temperature: number;
+	    source: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskTemperature(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.device = source["device"];
+	        this.model = source["model"];
+	        this.temperature = source["temperature"];
+	        this.source = source["source"];
+	    }
+	}
+	export class DiskUsageInfo {
+	    Total: number;
+	    Used: number;
+	    Free: number;
+	    UsedPercent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskUsageInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Total = source["Total"];
+	        this.Used = source["Used"];
+	        this.Free = source["Free"];
+	        this.UsedPercent = source["UsedPercent"];
+	    }
+	}
+	export class ElevationResult {
+	    platform: string;
+	    method: string;
+	    already_elevated: boolean;
+	    relaunched: boolean;
+	    command?: string;
+	    message: Message;
+	
+	    static createFrom(source: any = {}) {
+	        return new ElevationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.platform = source["platform"];
+	        this.method = source["method"];
+	        this.already_elevated = source["already_elevated"];
+	        this.relaunched = source["relaunched"];
+	        this.command = source["command"];
+	        this.message = this.convertValues(source["message"], Message);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Fan {
+	    id: string;
+	    chip: string;
+	    label: string;
+	    rpm: number;
+	    pwm: number;
+	    pwm_percent: number;
+	    pwm_mode: string;
+	    controllable: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Fan(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.chip = source["chip"];
+	        this.label = source["label"];
+	        this.rpm = source["rpm"];
+	        this.pwm = source["pwm"];
+	        this.pwm_percent = source["pwm_percent"];
+	        this.pwm_mode = source["pwm_mode"];
+	        this.controllable = source["controllable"];
+	    }
+	}
+	export class FanCurve {
+	    fan_id: string;
+	    points: FanCurvePoint[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FanCurve(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fan_id = source["fan_id"];
+	        this.points = this.convertValues(source["points"], FanCurvePoint);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FanCurvePoint {
+	    temperature: number;
+	    percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FanCurvePoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.temperature = source["temperature"];
+	        this.percent = source["percent"];
+	    }
+	}
+	export class GPUAdapter {
+	    index: number;
+	    id: string;
+	    name: string;
+	    vendor: string;
+	    integrated: boolean;
+	    external: boolean;
+	    dedicated_memory_mb: number;
+	    usage: number;
+	    dedicated_used_mb: number;
+	    shared_used_mb: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUAdapter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.vendor = source["vendor"];
+	        this.integrated = source["integrated"];
+	        this.external = source["external"];
+	        this.dedicated_memory_mb = source["dedicated_memory_mb"];
+	        this.usage = source["usage"];
+	        this.dedicated_used_mb = source["dedicated_used_mb"];
+	        this.shared_used_mb = source["shared_used_mb"];
+	    }
+	}
+	export class GPUBandwidth {
+	    index: number;
+	    memory_controller_percent: number;
+	    encoder_percent: number;
+	    decoder_percent: number;
+	    pcie_rx_mbps: number;
+	    pcie_tx_mbps: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUBandwidth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.memory_controller_percent = source["memory_controller_percent"];
+	        this.encoder_percent = source["encoder_percent"];
+	        this.decoder_percent = source["decoder_percent"];
+	        this.pcie_rx_mbps = source["pcie_rx_mbps"];
+	        this.pcie_tx_mbps = source["pcie_tx_mbps"];
+	    }
+	}
+	export class GPUECCStatus {
+	    index: number;
+	    bus_id: string;
+	    ecc_enabled: boolean;
+	    corrected_volatile: number;
+	    uncorrected_volatile: number;
+	    corrected_aggregate: number;
+	    uncorrected_aggregate: number;
+	    retired_pages_sbe: number;
+	    retired_pages_dbe: number;
+	    retired_pending: boolean;
+	    remapped_correctable: number;
+	    remapped_uncorrectable: number;
+	    remap_pending: boolean;
+	    remap_failure: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUECCStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.bus_id = source["bus_id"];
+	        this.ecc_enabled = source["ecc_enabled"];
+	        this.corrected_volatile = source["corrected_volatile"];
+	        this.uncorrected_volatile = source["uncorrected_volatile"];
+	        this.corrected_aggregate = source["corrected_aggregate"];
+	        this.uncorrected_aggregate = source["uncorrected_aggregate"];
+	        this.retired_pages_sbe = source["retired_pages_sbe"];
+	        this.retired_pages_dbe = source["retired_pages_dbe"];
+	        this.retired_pending = source["retired_pending"];
+	        this.remapped_correctable = source["remapped_correctable"];
+	        this.remapped_uncorrectable = source["remapped_uncorrectable"];
+	        this.remap_pending = source["remap_pending"];
+	        this.remap_failure = source["remap_failure"];
+	    }
+	}
+	export class GPUEngineUsage {
+	    engine: string;
+	    usage: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUEngineUsage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.engine = source["engine"];
+	        this.usage = source["usage"];
+	    }
+	}
+	export class GPUInfo {
+	    Name: string;
+	    Usage: number;
+	    MemoryUsed: number;
+	    MemoryTotal: number;
+	    Temperature: number;
+	    Power: number;
+	    // Go type: time
+	    LastUpdated: any;
+	    CacheAgeMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Usage = source["Usage"];
+	        this.MemoryUsed = source["MemoryUsed"];
+	        this.MemoryTotal = source["MemoryTotal"];
+	        this.Temperature = source["Temperature"];
+	        this.Power = source["Power"];
+	        this.LastUpdated = this.convertValues(source["LastUpdated"], null);
+	        this.CacheAgeMs = source["CacheAgeMs"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GPUPowerLimits {
+	    index: number;
+	    name: string;
+	    power_draw: number;
+	    power_limit: number;
+	    enforced_power_limit: number;
+	    default_power_limit: number;
+	    min_power_limit: number;
+	    max_power_limit: number;
+	    graphics_clock: number;
+	    memory_clock: number;
+	    max_graphics_clock: number;
+	    max_memory_clock: number;
+	    graphics_clock_offset: number;
+	    memory_clock_offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUPowerLimits(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.name = source["name"];
+	        this.power_draw = source["power_draw"];
+	        this.power_limit = source["power_limit"];
+	        this.enforced_power_limit = source["enforced_power_limit"];
+	        this.default_power_limit = source["default_power_limit"];
+	        this.min_power_limit = source["min_power_limit"];
+	        this.max_power_limit = source["max_power_limit"];
+	        this.graphics_clock = source["graphics_clock"];
+	        this.memory_clock = source["memory_clock"];
+	        this.max_graphics_clock = source["max_graphics_clock"];
+	        this.max_memory_clock = source["max_memory_clock"];
+	        this.graphics_clock_offset = source["graphics_clock_offset"];
+	        this.memory_clock_offset = source["memory_clock_offset"];
+	    }
+	}
+	export class GPUProcess {
+	    pid: number;
+	    name: string;
+	    gpu_usage: number;
+	    gpu_memory: number;
+	    type: string;
+	    command: string;
+	    status: string;
+	    priority?: string;
+	    nice: number;
+	    estimated_usage: boolean;
+	    usage_source: string;
+	    adapter_id?: string;
+	    adapter?: string;
+	    cmdline?: string;
+	    exe_path?: string;
+	    // Go type: time
+	    start_time?: any;
+	    username?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcess(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.gpu_usage = source["gpu_usage"];
+	        this.gpu_memory = source["gpu_memory"];
+	        this.type = source["type"];
+	        this.command = source["command"];
+	        this.status = source["status"];
+	        this.priority = source["priority"];
+	        this.nice = source["nice"];
+	        this.estimated_usage = source["estimated_usage"];
+	        this.usage_source = source["usage_source"];
+	        this.adapter_id = source["adapter_id"];
+	        this.adapter = source["adapter"];
+	        this.cmdline = source["cmdline"];
+	        this.exe_path = source["exe_path"];
+	        this.start_time = this.convertValues(source["start_time"], null);
+	        this.username = source["username"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GPUProcessFilter {
+	    usage_threshold: number;
+	    memory_threshold: number;
+	    filter_type: string;
+	    enabled: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.usage_threshold = source["usage_threshold"];
+	        this.memory_threshold = source["memory_threshold"];
+	        this.filter_type = source["filter_type"];
+	        this.enabled = source["enabled"];
+	    }
+	}
+	export class GPUProcessSort {
+	    field: string;
+	    order: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessSort(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.order = source["order"];
+	    }
+	}
+	export class GPUProcessQuery {
+	    filter: GPUProcessFilter;
+	    sort: GPUProcessSort;
+	    max_items: number;
+	    offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filter = this.convertValues(source["filter"], GPUProcessFilter);
+	        this.sort = this.convertValues(source["sort"], GPUProcessSort);
+	        this.max_items = source["max_items"];
+	        this.offset = source["offset"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GPUProcessResponse {
+	    processes: GPUProcess[];
+	    total_count: number;
+	    filtered_count: number;
+	    has_more: boolean;
+	    query_time_ms: number;
+	    summary?: GPUSummary;
+	    // Go type: time
+	    last_updated: any;
+	    cache_age_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUProcessResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.processes = this.convertValues(source["processes"], GPUProcess);
+	        this.total_count = source["total_count"];
+	        this.filtered_count = source["filtered_count"];
+	        this.has_more = source["has_more"];
+	        this.query_time_ms = source["query_time_ms"];
+	        this.summary = this.convertValues(source["summary"], GPUSummary);
+	        this.last_updated = this.convertValues(source["last_updated"], null);
+	        this.cache_age_ms = source["cache_age_ms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class GPUSnapshot {
+	    index: number;
+	    name: string;
+	    vendor?: string;
+	    integrated: boolean;
+	    usage: number;
+	    memory_used_mb: number;
+	    memory_total_mb: number;
+	    temperature: number;
+	    power: number;
+	    engines?: GPUEngineUsage[];
+	    processes?: GPUProcess[];
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.name = source["name"];
+	        this.vendor = source["vendor"];
+	        this.integrated = source["integrated"];
+	        this.usage = source["usage"];
+	        this.memory_used_mb = source["memory_used_mb"];
+	        this.memory_total_mb = source["memory_total_mb"];
+	        this.temperature = source["temperature"];
+	        this.power = source["power"];
+	        this.engines = this.convertValues(source["engines"], GPUEngineUsage);
+	        this.processes = this.convertValues(source["processes"], GPUProcess);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GPUStaticInfo {
+	    index: number;
+	    name: string;
+	    vendor: string;
+	    uuid?: string;
+	    driver_version?: string;
+	    vbios_version?: string;
+	    cuda_version?: string;
+	    pcie_generation?: number;
+	    pcie_width?: number;
+	    pcie_generation_max?: number;
+	    pcie_width_max?: number;
+	    source: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUStaticInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.name = source["name"];
+	        this.vendor = source["vendor"];
+	        this.uuid = source["uuid"];
+	        this.driver_version = source["driver_version"];
+	        this.vbios_version = source["vbios_version"];
+	        this.cuda_version = source["cuda_version"];
+	        this.pcie_generation = source["pcie_generation"];
+	        this.pcie_width = source["pcie_width"];
+	        this.pcie_generation_max = source["pcie_generation_max"];
+	        this.pcie_width_max = source["pcie_width_max"];
+	        this.source = source["source"];
+	    }
+	}
+	export class GPUSummary {
+	    name: string;
+	    memory_total: number;
+	    memory_used: number;
+	    memory_free: number;
+	    process_memory: number;
+	    // Go type: time
+	    collected_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.memory_total = source["memory_total"];
+	        this.memory_used = source["memory_used"];
+	        this.memory_free = source["memory_free"];
+	        this.process_memory = source["process_memory"];
+	        this.collected_at = this.convertValues(source["collected_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GPUVendorStatus {
+	    vendor: string;
+	    override: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUVendorStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.vendor = source["vendor"];
+	        this.override = source["override"];
+	    }
+	}
+	export class HostInfo {
+	    hostname: string;
+	    os: string;
+	    os_name: string;
+	    os_version: string;
+	    os_build?: string;
+	    architecture: string;
+	    virtualized: boolean;
+	    hypervisor?: string;
+	    manufacturer?: string;
+	    model?: string;
+	    bios_vendor?: string;
+	    bios_version?: string;
+	    bios_date?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HostInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hostname = source["hostname"];
+	        this.os = source["os"];
+	        this.os_name = source["os_name"];
+	        this.os_version = source["os_version"];
+	        this.os_build = source["os_build"];
+	        this.architecture = source["architecture"];
+	        this.virtualized = source["virtualized"];
+	        this.hypervisor = source["hypervisor"];
+	        this.manufacturer = source["manufacturer"];
+	        this.model = source["model"];
+	        this.bios_vendor = source["bios_vendor"];
+	        this.bios_version = source["bios_version"];
+	        this.bios_date = source["bios_date"];
+	    }
+	}
+	export class IdleState {
+	    idle: boolean;
+	    idle_seconds: number;
+	    display_off: boolean;
+	    // Go type: time
+	    since?: any;
+	    source: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IdleState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.idle = source["idle"];
+	        this.idle_seconds = source["idle_seconds"];
+	        this.display_off = source["display_off"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.source = source["source"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LoadInfo {
+	    load1: number;
+	    load5: number;
+	    load15: number;
+	    queue_length: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoadInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.load1 = source["load1"];
+	        this.load5 = source["load5"];
+	        this.load15 = source["load15"];
+	        this.queue_length = source["queue_length"];
+	    }
+	}
+	export class LogSettings {
+	    level: string;
+	    format: string;
+	    file_path: string;
+	    gpu_monitoring_logs: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.level = source["level"];
+	        this.format = source["format"];
+	        this.file_path = source["file_path"];
+	        this.gpu_monitoring_logs = source["gpu_monitoring_logs"];
+	    }
+	}
+	export class MemoryBreakdown {
+	    total_mb: number;
+	    used_mb: number;
+	    available_mb: number;
+	    cached_mb: number;
+	    buffers_mb: number;
+	    standby_mb: number;
+	    commit_mb: number;
+	    commit_limit_mb: number;
+	    commit_percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MemoryBreakdown(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total_mb = source["total_mb"];
+	        this.used_mb = source["used_mb"];
+	        this.available_mb = source["available_mb"];
+	        this.cached_mb = source["cached_mb"];
+	        this.buffers_mb = source["buffers_mb"];
+	        this.standby_mb = source["standby_mb"];
+	        this.commit_mb = source["commit_mb"];
+	        this.commit_limit_mb = source["commit_limit_mb"];
+	        this.commit_percent = source["commit_percent"];
+	    }
+	}
+	export class MemoryDetails {
+	    Physical: number;
+	    Virtual: number;
+	    Swap: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MemoryDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Physical = source["Physical"];
+	        this.Virtual = source["Virtual"];
+	        this.Swap = source["Swap"];
+	    }
+	}
+	export class MemoryPaging {
+	    pages_per_sec: number;
+	    hard_faults_per_sec: number;
+	    swap_in_bytes_per_sec: number;
+	    swap_out_bytes_per_sec: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MemoryPaging(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pages_per_sec = source["pages_per_sec"];
+	        this.hard_faults_per_sec = source["hard_faults_per_sec"];
+	        this.swap_in_bytes_per_sec = source["swap_in_bytes_per_sec"];
+	        this.swap_out_bytes_per_sec = source["swap_out_bytes_per_sec"];
+	    }
+	}
+	export class MemorySnapshot {
+	    used_percent: number;
+	    details?: MemoryDetails;
+	    breakdown?: MemoryBreakdown;
+	    paging?: MemoryPaging;
+	
+	    static createFrom(source: any = {}) {
+	        return new MemorySnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.used_percent = source["used_percent"];
+	        this.details = this.convertValues(source["details"], MemoryDetails);
+	        this.breakdown = this.convertValues(source["breakdown"], MemoryBreakdown);
+	        this.paging = this.convertValues(source["paging"], MemoryPaging);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Message {
+	    key: string;
+	    params?: string[];
+	    text: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Message(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.params = source["params"];
+	        this.text = source["text"];
+	    }
+	}
+	export class Metric {
+	    Type: string;
+	    Value: number;
+	    Info: string;
+	    Unit: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Metric(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Type = source["Type"];
+	        this.Value = source["Value"];
+	        this.Info = source["Info"];
+	        this.Unit = source["Unit"];
+	    }
+	}
+	export class MetricSample {
+	    // Go type: time
+	    timestamp: any;
+	    value: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MetricSample(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.value = source["value"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NICSnapshot {
+	    name: string;
+	    up: boolean;
+	    ip_address: string;
+	    wifi?: WiFiInfo;
+	
+	    static createFrom(source: any = {}) {
+	        return new NICSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.up = source["up"];
+	        this.ip_address = source["ip_address"];
+	        this.wifi = this.convertValues(source["wifi"], WiFiInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NPUInfo {
+	    name: string;
+	    vendor: string;
+	    driver: string;
+	    usage: number;
+	    memory_mb: number;
+	    source: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NPUInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.vendor = source["vendor"];
+	        this.driver = source["driver"];
+	        this.usage = source["usage"];
+	        this.memory_mb = source["memory_mb"];
+	        this.source = source["source"];
+	    }
+	}
+	export class NetworkInterface {
+	    Name: string;
+	    Status: number;
+	    IpAddress: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkInterface(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.Status = source["Status"];
+	        this.IpAddress = source["IpAddress"];
+	    }
+	}
+	export class NetworkInterfaceErrors {
+	    name: string;
+	    errors_in: number;
+	    errors_out: number;
+	    drops_in: number;
+	    drops_out: number;
+	    collisions: number;
+	    errors_in_rate: number;
+	    errors_out_rate: number;
+	    drops_in_rate: number;
+	    drops_out_rate: number;
+	    collision_rate: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkInterfaceErrors(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.errors_in = source["errors_in"];
+	        this.errors_out = source["errors_out"];
+	        this.drops_in = source["drops_in"];
+	        this.drops_out = source["drops_out"];
+	        this.collisions = source["collisions"];
+	        this.errors_in_rate = source["errors_in_rate"];
+	        this.errors_out_rate = source["errors_out_rate"];
+	        this.drops_in_rate = source["drops_in_rate"];
+	        this.drops_out_rate = source["drops_out_rate"];
+	        this.collision_rate = source["collision_rate"];
+	    }
+	}
+	export class NetworkQuality {
+	    target: string;
+	    method: string;
+	    latency_ms: number;
+	    jitter_ms: number;
+	    packet_loss: number;
+	    reachable: boolean;
+	    gateway: string;
+	    gateway_latency_ms: number;
+	    public_ip: string;
+	    // Go type: time
+	    timestamp: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkQuality(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.target = source["target"];
+	        this.method = source["method"];
+	        this.latency_ms = source["latency_ms"];
+	        this.jitter_ms = source["jitter_ms"];
+	        this.packet_loss = source["packet_loss"];
+	        this.reachable = source["reachable"];
+	        this.gateway = source["gateway"];
+	        this.gateway_latency_ms = source["gateway_latency_ms"];
+	        this.public_ip = source["public_ip"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NetworkSnapshot {
+	    sent_bytes_per_sec: number;
+	    recv_bytes_per_sec: number;
+	    status: string;
+	    quality?: NetworkQuality;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sent_bytes_per_sec = source["sent_bytes_per_sec"];
+	        this.recv_bytes_per_sec = source["recv_bytes_per_sec"];
+	        this.status = source["status"];
+	        this.quality = this.convertValues(source["quality"], NetworkQuality);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NotificationRecord {
+	    key: string;
+	    category: string;
+	    severity: string;
+	    title: string;
+	    message: string;
+	    // Go type: time
+	    timestamp: any;
+	    delivered: boolean;
+	    suppressed?: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NotificationRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.category = source["category"];
+	        this.severity = source["severity"];
+	        this.title = source["title"];
+	        this.message = source["message"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.delivered = source["delivered"];
+	        this.suppressed = source["suppressed"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NotificationStatus {
+	    enabled: boolean;
+	    min_severity: string;
+	    quiet_hours_start: string;
+	    quiet_hours_end: string;
+	    quiet_min_severity: string;
+	    cooldown_seconds: number;
+	    quiet_now: boolean;
+	    backend: string;
+	    available: boolean;
+	    recent: NotificationRecord[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NotificationStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.min_severity = source["min_severity"];
+	        this.quiet_hours_start = source["quiet_hours_start"];
+	        this.quiet_hours_end = source["quiet_hours_end"];
+	        this.quiet_min_severity = source["quiet_min_severity"];
+	        this.cooldown_seconds = source["cooldown_seconds"];
+	        this.quiet_now = source["quiet_now"];
+	        this.backend = source["backend"];
+	        this.available = source["available"];
+	        this.recent = this.convertValues(source["recent"], NotificationRecord);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PowerEvent {
+	    type: string;
+	    // Go type: time
+	    timestamp: any;
+	    source: string;
+	    sleep_seconds?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PowerEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.source = source["source"];
+	        this.sleep_seconds = source["sleep_seconds"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Printer {
+	    name: string;
+	    driver?: string;
+	    port?: string;
+	    default: boolean;
+	    network: boolean;
+	    status?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Printer(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.driver = source["driver"];
+	        this.port = source["port"];
+	        this.default = source["default"];
+	        this.network = source["network"];
+	        this.status = source["status"];
+	    }
+	}
+	export class ProcessCPUTime {
+	    pid: number;
+	    name: string;
+	    cpu_seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessCPUTime(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.cpu_seconds = source["cpu_seconds"];
+	    }
+	}
+	export class ProcessChild {
+	    pid: number;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessChild(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	    }
+	}
+	export class ProcessDetail {
+	    pid: number;
+	    name: string;
+	    ppid: number;
+	    username?: string;
+	    cpu_percent: number;
+	    memory_percent: number;
+	    memory_rss: number;
+	    read_bytes: number;
+	    write_bytes: number;
+	    read_rate: number;
+	    write_rate: number;
+	    connections: number;
+	    num_threads: number;
+	    priority?: string;
+	    nice: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessDetail(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.ppid = source["ppid"];
+	        this.username = source["username"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.memory_percent = source["memory_percent"];
+	        this.memory_rss = source["memory_rss"];
+	        this.read_bytes = source["read_bytes"];
+	        this.write_bytes = source["write_bytes"];
+	        this.read_rate = source["read_rate"];
+	        this.write_rate = source["write_rate"];
+	        this.connections = source["connections"];
+	        this.num_threads = source["num_threads"];
+	        this.priority = source["priority"];
+	        this.nice = source["nice"];
+	    }
+	}
+	export class ProcessFilter {
+	    cpu_threshold: number;
+	    memory_threshold: number;
+	    name_contains: string;
+	    filter_type: string;
+	    enabled: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cpu_threshold = source["cpu_threshold"];
+	        this.memory_threshold = source["memory_threshold"];
+	        this.name_contains = source["name_contains"];
+	        this.filter_type = source["filter_type"];
+	        this.enabled = source["enabled"];
+	    }
+	}
+	export class ProcessGroup {
+	    name: string;
+	    process_count: number;
+	    pids: number[];
+	    cpu_percent: number;
+	    memory_percent: number;
+	    memory_rss: number;
+	    gpu_usage: number;
+	    gpu_memory: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.process_count = source["process_count"];
+	        this.pids = source["pids"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.memory_percent = source["memory_percent"];
+	        this.memory_rss = source["memory_rss"];
+	        this.gpu_usage = source["gpu_usage"];
+	        this.gpu_memory = source["gpu_memory"];
+	    }
+	}
+	export class ProcessInfo {
+	    Name: string;
+	    PID: number;
+	    CPUPercent: number;
+	    MemoryPercent: number;
+	    Priority: string;
+	    Nice: number;
+	    Username: string;
+	    Cmdline: string;
+	    ExePath: string;
+	    // Go type: time
+	    StartTime?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Name = source["Name"];
+	        this.PID = source["PID"];
+	        this.CPUPercent = source["CPUPercent"];
+	        this.MemoryPercent = source["MemoryPercent"];
+	        this.Priority = source["Priority"];
+	        this.Nice = source["Nice"];
+	        this.Username = source["Username"];
+	        this.Cmdline = source["Cmdline"];
+	        this.ExePath = source["ExePath"];
+	        this.StartTime = this.convertValues(source["StartTime"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+	export class ProcessLimits {
+	    pid: number;
+	    name: string;
+	    cpu_percent: number;
+	    memory_mb: number;
+	    method: string;
+	    group: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessLimits(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.memory_mb = source["memory_mb"];
+	        this.method = source["method"];
+	        this.group = source["group"];
+	    }
+	}
+	export class ProcessPriority {
+	    pid: number;
+	    name: string;
+	    priority: string;
+	    nice: number;
+	    raw: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessPriority(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.priority = source["priority"];
+	        this.nice = source["nice"];
+	        this.raw = source["raw"];
+	    }
+	}
+	export class ProcessPrivilege {
+	    name: string;
+	    description: string;
+	    enabled: boolean;
+	    required: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessPrivilege(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.enabled = source["enabled"];
+	        this.required = source["required"];
+	    }
+	}
+	export class ProcessProfile {
+	    pid: number;
+	    name: string;
+	    parent_pid: number;
+	    status: string;
+	    username?: string;
+	    cmdline?: string;
+	    exe_path?: string;
+	    // Go type: time
+	    start_time?: any;
+	    priority?: string;
+	    nice: number;
+	    num_threads: number;
+	    open_files: number;
+	    cpu_percent: number;
+	    core_usage: number[];
+	    memory_rss_mb: number;
+	    memory_vms_mb: number;
+	    memory_shared_mb: number;
+	    memory_percent: number;
+	    read_bytes: number;
+	    write_bytes: number;
+	    read_count: number;
+	    write_count: number;
+	    read_rate: number;
+	    write_rate: number;
+	    connections: number;
+	    gpu_usage: number;
+	    gpu_memory_mb: number;
+	    children: ProcessChild[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessProfile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.pid = source["pid"];
+	        this.name = source["name"];
+	        this.parent_pid = source["parent_pid"];
+	        this.status = source["status"];
+	        this.username = source["username"];
+	        this.cmdline = source["cmdline"];
+	        this.exe_path = source["exe_path"];
+	        this.start_time = this.convertValues(source["start_time"], null);
+	        this.priority = source["priority"];
+	        this.nice = source["nice"];
+	        this.num_threads = source["num_threads"];
+	        this.open_files = source["open_files"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.core_usage = source["core_usage"];
+	        this.memory_rss_mb = source["memory_rss_mb"];
+	        this.memory_vms_mb = source["memory_vms_mb"];
+	        this.memory_shared_mb = source["memory_shared_mb"];
+	        this.memory_percent = source["memory_percent"];
+	        this.read_bytes = source["read_bytes"];
+	        this.write_bytes = source["write_bytes"];
+	        this.read_count = source["read_count"];
+	        this.write_count = source["write_count"];
+	        this.read_rate = source["read_rate"];
+	        this.write_rate = source["write_rate"];
+	        this.connections = source["connections"];
+	        this.gpu_usage = source["gpu_usage"];
+	        this.gpu_memory_mb = source["gpu_memory_mb"];
+	        this.children = this.convertValues(source["children"], ProcessChild);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessQuery {
+	    filter: ProcessFilter;
+	    sort: ProcessSort;
+	    max_items: number;
+	    offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filter = this.convertValues(source["filter"], ProcessFilter);
+	        this.sort = this.convertValues(source["sort"], ProcessSort);
+	        this.max_items = source["max_items"];
+	        this.offset = source["offset"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessResourceTime {
+	    name: string;
+	    process_count: number;
+	    cpu_seconds: number;
+	    gpu_seconds: number;
+	    // Go type: time
+	    last_seen: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessResourceTime(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.process_count = source["process_count"];
+	        this.cpu_seconds = source["cpu_seconds"];
+	        this.gpu_seconds = source["gpu_seconds"];
+	        this.last_seen = this.convertValues(source["last_seen"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessResponse {
+	    processes: ProcessDetail[];
+	    total_count: number;
+	    filtered_count: number;
+	    has_more: boolean;
+	    query_time_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.processes = this.convertValues(source["processes"], ProcessDetail);
+	        this.total_count = source["total_count"];
+	        this.filtered_count = source["filtered_count"];
+	        this.has_more = source["has_more"];
+	        this.query_time_ms = source["query_time_ms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessSearchMatch {
+	    process: ProcessDetail;
+	    command_line?: string;
+	    score: number;
+	    matched_field: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessSearchMatch(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.process = this.convertValues(source["process"], ProcessDetail);
+	        this.command_line = source["command_line"];
+	        this.score = source["score"];
+	        this.matched_field = source["matched_field"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessSearchResponse {
+	    query: string;
+	    matches: ProcessSearchMatch[];
+	    total_count: number;
+	    query_time_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessSearchResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.query = source["query"];
+	        this.matches = this.convertValues(source["matches"], ProcessSearchMatch);
+	        this.total_count = source["total_count"];
+	        this.query_time_ms = source["query_time_ms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProcessSort {
+	    field: string;
+	    order: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProcessSort(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.order = source["order"];
+	    }
+	}
+	export class ProtectedProcessRule {
+	    name: string;
+	    pattern?: string;
+	    level: string;
+	    description?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtectedProcessRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.pattern = source["pattern"];
+	        this.level = source["level"];
+	        this.description = source["description"];
+	    }
+	}
+	export class ProtectionAudit {
+	    platform: string;
+	    rules: ProtectionRuleStatus[];
+	    decisions: ProtectionDecision[];
+	    refused: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtectionAudit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.platform = source["platform"];
+	        this.rules = this.convertValues(source["rules"], ProtectionRuleStatus);
+	        this.decisions = this.convertValues(source["decisions"], ProtectionDecision);
+	        this.refused = source["refused"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProtectionDecision {
+	    // Go type: time
+	    timestamp: any;
+	    operation: string;
+	    pid: number;
+	    process_name: string;
+	    rule: string;
+	    level: string;
+	    user_defined: boolean;
+	    allowed: boolean;
+	    reason?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtectionDecision(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.operation = source["operation"];
+	        this.pid = source["pid"];
+	        this.process_name = source["process_name"];
+	        this.rule = source["rule"];
+	        this.level = source["level"];
+	        this.user_defined = source["user_defined"];
+	        this.allowed = source["allowed"];
+	        this.reason = source["reason"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ProtectionRuleStatus {
+	    name: string;
+	    description: string;
+	    protection_level: number;
+	    platform: string;
+	    is_kernel: boolean;
+	    min_pid: number;
+	    max_pid: number;
+	    parent_process: string;
+	    is_service: boolean;
+	    match_pattern: string;
+	    user_defined: boolean;
+	    level: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProtectionRuleStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.protection_level = source["protection_level"];
+	        this.platform = source["platform"];
+	        this.is_kernel = source["is_kernel"];
+	        this.min_pid = source["min_pid"];
+	        this.max_pid = source["max_pid"];
+	        this.parent_process = source["parent_process"];
+	        this.is_service = source["is_service"];
+	        this.match_pattern = source["match_pattern"];
+	        this.user_defined = source["user_defined"];
+	        this.level = source["level"];
+	    }
+	}
+	export class RAPLDomain {
+	    zone: string;
+	    name: string;
+	    package: number;
+	    watts: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RAPLDomain(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.zone = source["zone"];
+	        this.name = source["name"];
+	        this.package = source["package"];
+	        this.watts = source["watts"];
+	    }
+	}
+	export class RAPLPower {
+	    package_watts: number;
+	    core_watts: number;
+	    uncore_watts: number;
+	    dram_watts: number;
+	    domains: RAPLDomain[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RAPLPower(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.package_watts = source["package_watts"];
+	        this.core_watts = source["core_watts"];
+	        this.uncore_watts = source["uncore_watts"];
+	        this.dram_watts = source["dram_watts"];
+	        this.domains = this.convertValues(source["domains"], RAPLDomain);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RecentMetrics {
+	    metric: string;
+	    unit?: string;
+	    seconds: number;
+	    samples: MetricSample[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RecentMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metric = source["metric"];
+	        this.unit = source["unit"];
+	        this.seconds = source["seconds"];
+	        this.samples = this.convertValues(source["samples"], MetricSample);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SchedulerStatus {
+	    budget_ms: number;
+	    max_interval_ms: number;
+	    avg_cycle_duration_ms: number;
+	    over_budget: boolean;
+	    collectors: CollectorSchedule[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulerStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.budget_ms = source["budget_ms"];
+	        this.max_interval_ms = source["max_interval_ms"];
+	        this.avg_cycle_duration_ms = source["avg_cycle_duration_ms"];
+	        this.over_budget = source["over_budget"];
+	        this.collectors = this.convertValues(source["collectors"], CollectorSchedule);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SecurityContext {
+	    platform: string;
+	    uac_status: UACStatus;
+	    process_privileges: ProcessPrivilege[];
+	    is_secure_mode: boolean;
+	    recommendations: string[];
+	    recommendation_messages: Message[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecurityContext(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.platform = source["platform"];
+	        this.uac_status = this.convertValues(source["uac_status"], UACStatus);
+	        this.process_privileges = this.convertValues(source["process_privileges"], ProcessPrivilege);
+	        this.is_secure_mode = source["is_secure_mode"];
+	        this.recommendations = source["recommendations"];
+	        this.recommendation_messages = this.convertValues(source["recommendation_messages"], Message);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SelfTelemetry {
+	    cpu_percent: number;
+	    rss_mb: number;
+	    goroutines: number;
+	    open_handles: number;
+	    heap_alloc_mb: number;
+	    gc_count: number;
+	    last_cycle_duration_ms: number;
+	    avg_cycle_duration_ms: number;
+	    cycles: number;
+	    collectors: CollectorStats[];
+	    // Go type: time
+	    timestamp: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new SelfTelemetry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cpu_percent = source["cpu_percent"];
+	        this.rss_mb = source["rss_mb"];
+	        this.goroutines = source["goroutines"];
+	        this.open_handles = source["open_handles"];
+	        this.heap_alloc_mb = source["heap_alloc_mb"];
+	        this.gc_count = source["gc_count"];
+	        this.last_cycle_duration_ms = source["last_cycle_duration_ms"];
+	        this.avg_cycle_duration_ms = source["avg_cycle_duration_ms"];
+	        this.cycles = source["cycles"];
+	        this.collectors = this.convertValues(source["collectors"], CollectorStats);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class StressTestOptions {
+	    kind: string;
+	    threads: number;
+	    duration_seconds: number;
+	    label?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new StressTestOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.threads = source["threads"];
+	        this.duration_seconds = source["duration_seconds"];
+	        this.label = source["label"];
+	    }
+	}
+	export class SubsystemHealth {
+	    name: string;
+	    healthy: boolean;
+	    // Go type: time
+	    last_success: any;
+	    // Go type: time
+	    last_run: any;
+	    last_error?: string;
+	    errors: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SubsystemHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.healthy = source["healthy"];
+	        this.last_success = this.convertValues(source["last_success"], null);
+	        this.last_run = this.convertValues(source["last_run"], null);
+	        this.last_error = source["last_error"];
+	        this.errors = source["errors"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SystemPowerInfo {
+	    total_watts: number;
+	    cpu_watts: number;
+	    gpu_watts: number;
+	    battery_watts: number;
+	    sources: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SystemPowerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total_watts = source["total_watts"];
+	        this.cpu_watts = source["cpu_watts"];
+	        this.gpu_watts = source["gpu_watts"];
+	        this.battery_watts = source["battery_watts"];
+	        this.sources = source["sources"];
+	    }
+	}
+	export class ThrottleInfo {
+	    throttled: boolean;
+	    throttled_seconds: number;
+	    devices: ThrottleState[];
+	    cores: CoreFrequency[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ThrottleInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.throttled = source["throttled"];
+	        this.throttled_seconds = source["throttled_seconds"];
+	        this.devices = this.convertValues(source["devices"], ThrottleState);
+	        this.cores = this.convertValues(source["cores"], CoreFrequency);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ThrottleState {
+	    device: string;
+	    throttled: boolean;
+	    reasons: string[];
+	    throttled_seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ThrottleState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.device = source["device"];
+	        this.throttled = source["throttled"];
+	        this.reasons = source["reasons"];
+	        this.throttled_seconds = source["throttled_seconds"];
+	    }
+	}
+	export class TopConsumersResponse {
+	    // Go type: time
+	    since: any;
+	    sort_by: string;
+	    consumers: ProcessResourceTime[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TopConsumersResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.since = this.convertValues(source["since"], null);
+	        this.sort_by = source["sort_by"];
+	        this.consumers = this.convertValues(source["consumers"], ProcessResourceTime);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TypedSnapshot {
+	    // Go type: time
+	    timestamp: any;
+	    cpu: CPUSnapshot;
+	    memory: MemorySnapshot;
+	    disk_io: DiskIOSnapshot;
+	    disks: DiskSnapshot[];
+	    nics: NICSnapshot[];
+	    network: NetworkSnapshot;
+	    gpus: GPUSnapshot[];
+	    processes: ProcessInfo[];
+	    battery?: BatteryInfo;
+	    power?: SystemPowerInfo;
+	    metrics?: Metric[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TypedSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.cpu = this.convertValues(source["cpu"], CPUSnapshot);
+	        this.memory = this.convertValues(source["memory"], MemorySnapshot);
+	        this.disk_io = this.convertValues(source["disk_io"], DiskIOSnapshot);
+	        this.disks = this.convertValues(source["disks"], DiskSnapshot);
+	        this.nics = this.convertValues(source["nics"], NICSnapshot);
+	        this.network = this.convertValues(source["network"], NetworkSnapshot);
+	        this.gpus = this.convertValues(source["gpus"], GPUSnapshot);
+	        this.processes = this.convertValues(source["processes"], ProcessInfo);
+	        this.battery = this.convertValues(source["battery"], BatteryInfo);
+	        this.power = this.convertValues(source["power"], SystemPowerInfo);
+	        this.metrics = this.convertValues(source["metrics"], Metric);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UACStatus {
+	    is_enabled: boolean;
+	    is_elevated: boolean;
+	    level: string;
+	    required_for: string;
+	    can_elevate: boolean;
+	    error_message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UACStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.is_enabled = source["is_enabled"];
+	        this.is_elevated = source["is_elevated"];
+	        this.level = source["level"];
+	        this.required_for = source["required_for"];
+	        this.can_elevate = source["can_elevate"];
+	        this.error_message = source["error_message"];
+	    }
+	}
+	export class USBDevice {
+	    id: string;
+	    name: string;
+	    manufacturer: string;
+	    vendor_id: string;
+	    product_id: string;
+	    status?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new USBDevice(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.manufacturer = source["manufacturer"];
+	        this.vendor_id = source["vendor_id"];
+	        this.product_id = source["product_id"];
+	        this.status = source["status"];
+	    }
+	}
+	export class UserUsage {
+	    username: string;
+	    process_count: number;
+	    cpu_percent: number;
+	    memory_percent: number;
+	    memory_rss: number;
+	    gpu_usage: number;
+	    gpu_memory: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new UserUsage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.username = source["username"];
+	        this.process_count = source["process_count"];
+	        this.cpu_percent = source["cpu_percent"];
+	        this.memory_percent = source["memory_percent"];
+	        this.memory_rss = source["memory_rss"];
+	        this.gpu_usage = source["gpu_usage"];
+	        this.gpu_memory = source["gpu_memory"];
+	    }
+	}
+	export class WiFiInfo {
+	    interface: string;
+	    connected: boolean;
+	    ssid: string;
+	    bssid: string;
+	    signal_dbm: number;
+	    signal_percent: number;
+	    rx_rate_mbps: number;
+	    tx_rate_mbps: number;
+	    channel: number;
+	    frequency_mhz: number;
+	    radio_type?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WiFiInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.interface = source["interface"];
+	        this.connected = source["connected"];
+	        this.ssid = source["ssid"];
+	        this.bssid = source["bssid"];
+	        this.signal_dbm = source["signal_dbm"];
+	        this.signal_percent = source["signal_percent"];
+	        this.rx_rate_mbps = source["rx_rate_mbps"];
+	        this.tx_rate_mbps = source["tx_rate_mbps"];
+	        this.channel = source["channel"];
+	        this.frequency_mhz = source["frequency_mhz"];
+	        this.radio_type = source["radio_type"];
+	    }
+	}
+	export class WidgetConfigField {
+	    kind: string;
+	    values?: string[];
+	    min?: number;
+	    max?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetConfigField(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.values = source["values"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	    }
+	}
+	export class WidgetData {
+	    type: string;
+	    metric: string;
+	    // Go type: time
+	    timestamp: any;
+	    available: boolean;
+	    value: number;
+	    unit?: string;
+	    info?: string;
+	    min: number;
+	    max: number;
+	    avg: number;
+	    sparkline: MetricSample[];
+	    values?: WidgetValue[];
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetData(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.metric = source["metric"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.available = source["available"];
+	        this.value = source["value"];
+	        this.unit = source["unit"];
+	        this.info = source["info"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	        this.avg = source["avg"];
+	        this.sparkline = this.convertValues(source["sparkline"], MetricSample);
+	        this.values = this.convertValues(source["values"], WidgetValue);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WidgetSchema {
+	    type: string;
+	    fields: {[key: string]: WidgetConfigField};
+	    max_config_bytes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetSchema(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.fields = this.convertValues(source["fields"], WidgetConfigField, true);
+	        this.max_config_bytes = source["max_config_bytes"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WidgetValue {
+	    metric: string;
+	    value: number;
+	    unit?: string;
+	    info?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WidgetValue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.metric = source["metric"];
+	        this.value = source["value"];
+	        this.unit = source["unit"];
+	        this.info = source["info"];
+	    }
+	}
+}
+
+export namespace services {
+	
+	export class AvailabilityResult {
+	    success: boolean;
+	    message: string;
+	    availability?: db.Availability;
+	    error_code?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AvailabilityResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.availability = this.convertValues(source["availability"], db.Availability);
+	        this.error_code = source["error_code"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CollectionState {
+	    paused: boolean;
+	    sessions: number;
+	    paused_sessions: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CollectionState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.paused = source["paused"];
+	        this.sessions = source["sessions"];
+	        this.paused_sessions = source["paused_sessions"];
+	    }
+	}
+	export class Config {
+	    server: ServerConfig;
+	    database: DatabaseConfig;
+	    monitoring: MonitoringConfig;
+	    ui: UIConfig;
+	    logging: LoggingConfig;
+	    reports: ReportsConfig;
+	    network_quality: NetworkQualityConfig;
+	    gpu: GPUConfig;
+	    gpu_control: GPUControlConfig;
+	    stress_test: StressTestConfig;
+	    frame_stats: FrameStatsConfig;
+	    units: UnitsConfig;
+	    fan_control: FanControlConfig;
+	    security: SecurityConfig;
+	    energy: EnergyConfig;
+	    notifications: NotificationsConfig;
+	
+	    static createFrom(source: any = {}) {
+	        return new Config(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.server = this.convertValues(source["server"], ServerConfig);
+	        this.database = this.convertValues(source["database"], DatabaseConfig);
+	        this.monitoring = this.convertValues(source["monitoring"], MonitoringConfig);
+	        this.ui = this.convertValues(source["ui"], UIConfig);
+	        this.logging = this.convertValues(source["logging"], LoggingConfig);
+	        this.reports = this.convertValues(source["reports"], ReportsConfig);
+	        this.network_quality = this.convertValues(source["network_quality"], NetworkQualityConfig);
+	        this.gpu = this.convertValues(source["gpu"], GPUConfig);
+	        this.gpu_control = this.convertValues(source["gpu_control"], GPUControlConfig);
+	        this.stress_test = this.convertValues(source["stress_test"], StressTestConfig);
+	        this.frame_stats = this.convertValues(source["frame_stats"], FrameStatsConfig);
+	        this.units = this.convertValues(source["units"], UnitsConfig);
+	        this.fan_control = this.convertValues(source["fan_control"], FanControlConfig);
+	        this.security = this.convertValues(source["security"], SecurityConfig);
+        this.energy = this.convertValues(source["energy"], EnergyConfig);
+	        this.notifications = this.convertValues(source["notifications"], NotificationsConfig);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DatabaseConfig {
+	    driver: string;
+	    dsn?: string;
+	    filename: string;
+	    write_batch_size: number;
+	    write_flush_interval_ms: number;
+	    write_queue_size: number;
+	    raw_retention_days: number;
+	    minute_retention_days: number;
+	    compaction_minutes: number;
+	    spill_max_mb: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatabaseConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.driver = source["driver"];
+	        this.dsn = source["dsn"];
+	        this.filename = source["filename"];
+	        this.write_batch_size = source["write_batch_size"];
+	        this.write_flush_interval_ms = source["write_flush_interval_ms"];
+	        this.write_queue_size = source["write_queue_size"];
+	        this.raw_retention_days = source["raw_retention_days"];
+	        this.minute_retention_days = source["minute_retention_days"];
+	        this.compaction_minutes = source["compaction_minutes"];
+	        this.spill_max_mb = source["spill_max_mb"];
+	    }
+	}
+
+	export class DatabaseHealth {
+	    connected: boolean;
+	    error?: string;
+	    spill_bytes: number;
+	    dropped_snapshots: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatabaseHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.connected = source["connected"];
+	        this.error = source["error"];
+	        this.spill_bytes = source["spill_bytes"];
+	        this.dropped_snapshots = source["dropped_snapshots"];
+	    }
+	}
+	export class DeviceInventoryReport {
+	    inventory?: monitoring.DeviceInventory;
+	    events: db.Event[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DeviceInventoryReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.inventory = this.convertValues(source["inventory"], monitoring.DeviceInventory);
+	        this.events = this.convertValues(source["events"], db.Event);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DiskPathConfig {
+	    path: string;
+	    min_free_percent: number;
+	    min_free_gb: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DiskPathConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.min_free_percent = source["min_free_percent"];
+	        this.min_free_gb = source["min_free_gb"];
+	    }
+	}
+	export class EnergyConfig {
+	    price_per_kwh: number;
+	    currency: string;
+	    carbon_grams_per_kwh: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EnergyConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.price_per_kwh = source["price_per_kwh"];
+	        this.currency = source["currency"];
+	        this.carbon_grams_per_kwh = source["carbon_grams_per_kwh"];
+	    }
+	}
+	export class EnergySummary {
+	    window: string;
+	    // Go type: time
+	    since: any;
+	    // Go type: time
+	    until: any;
+	    energy_kwh: number;
+	    avg_watts: number;
+	    peak_watts: number;
+	    cost: number;
+	    currency: string;
+	    co2_grams: number;
+	    price_per_kwh: number;
+	    carbon_grams_per_kwh: number;
+	    coverage: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EnergySummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.window = source["window"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.until = this.convertValues(source["until"], null);
+	        this.energy_kwh = source["energy_kwh"];
+	        this.avg_watts = source["avg_watts"];
+	        this.peak_watts = source["peak_watts"];
+	        this.cost = source["cost"];
+	        this.currency = source["currency"];
+	        this.co2_grams = source["co2_grams"];
+	        this.price_per_kwh = source["price_per_kwh"];
+	        this.carbon_grams_per_kwh = source["carbon_grams_per_kwh"];
+	        this.coverage = source["coverage"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EventResult {
+	    success: boolean;
+	    message: string;
+	    events?: db.Event[];
+	    total_count: number;
+	    has_more: boolean;
+	    error_code?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EventResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.events = this.convertValues(source["events"], db.Event);
+	        this.total_count = source["total_count"];
+	        this.has_more = source["has_more"];
+	        this.error_code = source["error_code"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FanControlConfig {
+	    allow_pwm_control: boolean;
+	    curves: monitoring.FanCurve[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FanControlConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.allow_pwm_control = source["allow_pwm_control"];
+	        this.curves = this.convertValues(source["curves"], monitoring.FanCurve);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FrameStatsConfig {
+	    enabled: boolean;
+	    command: string;
+	    args: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FrameStatsConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.command = source["command"];
+	        this.args = source["args"];
+	    }
+	}
+	export class GPUConfig {
+	    vendor: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.vendor = source["vendor"];
+	    }
+	}
+	export class GPUControlConfig {
+	    allow_power_limit: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GPUControlConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.allow_power_limit = source["allow_power_limit"];
+	    }
+	}
+	export class HealthReport {
+	    status: string;
+	    ready: boolean;
+	    collection_paused: boolean;
+	    subsystems: monitoring.SubsystemHealth[];
+	    database: DatabaseHealth;
+	    gpu_backend: string;
+	    gpu_available: boolean;
+	    // Go type: time
+	    timestamp: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new HealthReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.status = source["status"];
+	        this.ready = source["ready"];
+	        this.collection_paused = source["collection_paused"];
+	        this.subsystems = this.convertValues(source["subsystems"], monitoring.SubsystemHealth);
+	        this.database = this.convertValues(source["database"], DatabaseHealth);
+	        this.gpu_backend = source["gpu_backend"];
+	        this.gpu_available = source["gpu_available"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class HistoryResult {
+	    success: boolean;
+	    message: string;
+	    resolution: string;
+	    points?: db.ResourceHistoryPoint[];
+	    error_code?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new HistoryResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.resolution = source["resolution"];
+	        this.points = this.convertValues(source["points"], db.ResourceHistoryPoint);
+	        this.error_code = source["error_code"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LoggingConfig {
+	    level: string;
+	    format: string;
+	    file: string;
+	    max_size_mb: number;
+	    max_backups: number;
+	    gpu_monitoring_logs: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoggingConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.level = source["level"];
+	        this.format = source["format"];
+	        this.file = source["file"];
+	        this.max_size_mb = source["max_size_mb"];
+	        this.max_backups = source["max_backups"];
+	        this.gpu_monitoring_logs = source["gpu_monitoring_logs"];
+	    }
+	}
+	export class MonitoringConfig {
+	    interval_seconds: number;
+	    security_check_seconds: number;
+	    gpu_info_cache_seconds: number;
+	    registry_cache_seconds: number;
+	    collection_budget_ms: number;
+	    query_timeout_seconds: number;
+	    cache_stale_warning_seconds: number;
+	    max_adaptive_interval_seconds: number;
+	    collector_interval_seconds: {[key: string]: number};
+	    enable_cpu_monitoring: boolean;
+	    enable_memory_monitoring: boolean;
+	    enable_disk_monitoring: boolean;
+	    enable_network_monitoring: boolean;
+	    enable_cpu_time_breakdown: boolean;
+	    enable_audio_monitoring: boolean;
+	    idle_threshold_minutes: number;
+	    idle_interval_seconds: number;
+	    background_interval_seconds: number;
+	    recent_buffer_minutes: number;
+	    disk_paths: DiskPathConfig[];
+	    gpu_process_include: string;
+	    gpu_process_exclude: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MonitoringConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.interval_seconds = source["interval_seconds"];
+	        this.security_check_seconds = source["security_check_seconds"];
+	        this.gpu_info_cache_seconds = source["gpu_info_cache_seconds"];
+	        this.registry_cache_seconds = source["registry_cache_seconds"];
+	        this.collection_budget_ms = source["collection_budget_ms"];
+	        this.query_timeout_seconds = source["query_timeout_seconds"];
+	        this.cache_stale_warning_seconds = source["cache_stale_warning_seconds"];
+	        this.max_adaptive_interval_seconds = source["max_adaptive_interval_seconds"];
+	        this.collector_interval_seconds = source["collector_interval_seconds"];
+	        this.enable_cpu_monitoring = source["enable_cpu_monitoring"];
+	        this.enable_memory_monitoring = source["enable_memory_monitoring"];
+	        this.enable_disk_monitoring = source["enable_disk_monitoring"];
+	        this.enable_network_monitoring = source["enable_network_monitoring"];
+	        this.enable_cpu_time_breakdown = source["enable_cpu_time_breakdown"];
+	        this.enable_audio_monitoring = source["enable_audio_monitoring"];
+	        this.idle_threshold_minutes = source["idle_threshold_minutes"];
+	        this.idle_interval_seconds = source["idle_interval_seconds"];
+	        this.background_interval_seconds = source["background_interval_seconds"];
+	        this.recent_buffer_minutes = source["recent_buffer_minutes"];
+	        this.disk_paths = this.convertValues(source["disk_paths"], DiskPathConfig);
+	        this.gpu_process_include = source["gpu_process_include"];
+	        this.gpu_process_exclude = source["gpu_process_exclude"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NetworkQualityConfig {
+	    enabled: boolean;
+	    target: string;
+	    method: string;
+	    port: number;
+	    probe_count: number;
+	    interval_seconds: number;
+	    public_ip_url: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkQualityConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.target = source["target"];
+	        this.method = source["method"];
+	        this.port = source["port"];
+	        this.probe_count = source["probe_count"];
+	        this.interval_seconds = source["interval_seconds"];
+	        this.public_ip_url = source["public_ip_url"];
+	    }
+	}
+	export class NotificationsConfig {
+	    enabled: boolean;
+	    min_severity: string;
+	    quiet_hours_start: string;
+	    quiet_hours_end: string;
+	    quiet_min_severity: string;
+	    cooldown_seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new NotificationsConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.min_severity = source["min_severity"];
+	        this.quiet_hours_start = source["quiet_hours_start"];
+	        this.quiet_hours_end = source["quiet_hours_end"];
+	        this.quiet_min_severity = source["quiet_min_severity"];
+	        this.cooldown_seconds = source["cooldown_seconds"];
+	    }
+	}
+	export class Report {
+	    period: string;
+	    // Go type: time
+	    since: any;
+	    // Go type: time
+	    until: any;
+	    // Go type: time
+	    generated_at: any;
+	    cpu?: db.MetricSummary;
+	    memory?: db.MetricSummary;
+	    gpu_temperature?: db.MetricSummary;
+	    top_processes: monitoring.ProcessCPUTime[];
+	    alert_count: number;
+	    hardware_events: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.period = source["period"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.until = this.convertValues(source["until"], null);
+	        this.generated_at = this.convertValues(source["generated_at"], null);
+	        this.cpu = this.convertValues(source["cpu"], db.MetricSummary);
+	        this.memory = this.convertValues(source["memory"], db.MetricSummary);
+	        this.gpu_temperature = this.convertValues(source["gpu_temperature"], db.MetricSummary);
+	        this.top_processes = this.convertValues(source["top_processes"], monitoring.ProcessCPUTime);
+	        this.alert_count = source["alert_count"];
+	        this.hardware_events = source["hardware_events"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReportsConfig {
+	    schedule: string;
+	    hour: number;
+	    webhook_url: string;
+	    top_count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReportsConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.schedule = source["schedule"];
+	        this.hour = source["hour"];
+	        this.webhook_url = source["webhook_url"];
+	        this.top_count = source["top_count"];
+	    }
+	}
+	export class SecurityConfig {
+	    read_only: boolean;
+	    protected_processes: monitoring.ProtectedProcessRule[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecurityConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.read_only = source["read_only"];
+	        this.protected_processes = this.convertValues(source["protected_processes"], monitoring.ProtectedProcessRule);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ServerConfig {
+	    port: number;
+	    host: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.port = source["port"];
+	        this.host = source["host"];
+	    }
+	}
+	export class SnapshotDiffResult {
+	    success: boolean;
+	    message: string;
+	    diff?: db.SnapshotDiff;
+	    error_code?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SnapshotDiffResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.diff = this.convertValues(source["diff"], db.SnapshotDiff);
+	        this.error_code = source["error_code"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -126,25 +5097,29 @@ export namespace main {
 		    return a;
 		}
 	}
-	export class SystemInfo {
-	    platform: string;
-	    cpu_cores: number;
-	    cpu_model: string;
-	    total_memory: number;
+	export class StateBundleManifest {
+	    format_version: number;
+	    widget_state_version: number;
 	    // Go type: time
-	    boot_time: any;
+	    exported_at: any;
+	    hostname: string;
+	    platform: string;
+	    user_id: string;
+	    parts: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new SystemInfo(source);
+	        return new StateBundleManifest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.format_version = source["format_version"];
+	        this.widget_state_version = source["widget_state_version"];
+	        this.exported_at = this.convertValues(source["exported_at"], null);
+	        this.hostname = source["hostname"];
 	        this.platform = source["platform"];
-	        this.cpu_cores = source["cpu_cores"];
-	        this.cpu_model = source["cpu_model"];
-	        this.total_memory = source["total_memory"];
-	        this.boot_time = this.convertValues(source["boot_time"], null);
+	        this.user_id = source["user_id"];
+	        this.parts = source["parts"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -165,161 +5140,107 @@ export namespace main {
 		    return a;
 		}
 	}
-	export class WidgetResult {
-	    user_id: string;
-	    page_id: string;
-	    widgets: any[];
-	    success: boolean;
-	    message: string;
-	    count?: number;
-	    widget_id?: string;
+	export class StateImportResult {
+	    manifest: StateBundleManifest;
+	    parts: string[];
+	    config_applied: boolean;
+	    pages_created: number;
+	    pages_updated: number;
+	    widgets: number;
+	    watched_processes_added: number;
+	    protected_processes_added: number;
+	    rules_skipped: number;
+	    warnings?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new WidgetResult(source);
+	        return new StateImportResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.user_id = source["user_id"];
-	        this.page_id = source["page_id"];
+	        this.manifest = this.convertValues(source["manifest"], StateBundleManifest);
+	        this.parts = source["parts"];
+	        this.config_applied = source["config_applied"];
+	        this.pages_created = source["pages_created"];
+	        this.pages_updated = source["pages_updated"];
 	        this.widgets = source["widgets"];
-	        this.success = source["success"];
-	        this.message = source["message"];
-	        this.count = source["count"];
-	        this.widget_id = source["widget_id"];
-	    }
-	}
-
-}
-
-export namespace monitoring {
-	
-	export class BatteryInfo {
-	    Percent: number;
-	    Plugged: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new BatteryInfo(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Percent = source["Percent"];
-	        this.Plugged = source["Plugged"];
-	    }
-	}
-	export class DiskUsageInfo {
-	    Total: number;
-	    Used: number;
-	    Free: number;
-	    UsedPercent: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new DiskUsageInfo(source);
+	        this.watched_processes_added = source["watched_processes_added"];
+	        this.protected_processes_added = source["protected_processes_added"];
+	        this.rules_skipped = source["rules_skipped"];
+	        this.warnings = source["warnings"];
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Total = source["Total"];
-	        this.Used = source["Used"];
-	        this.Free = source["Free"];
-	        this.UsedPercent = source["UsedPercent"];
-	    }
-	}
-	export class GPUInfo {
-	    Name: string;
-	    Usage: number;
-	    MemoryUsed: number;
-	    MemoryTotal: number;
-	    Temperature: number;
-	    Power: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new GPUInfo(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Name = source["Name"];
-	        this.Usage = source["Usage"];
-	        this.MemoryUsed = source["MemoryUsed"];
-	        this.MemoryTotal = source["MemoryTotal"];
-	        this.Temperature = source["Temperature"];
-	        this.Power = source["Power"];
-	    }
-	}
-	export class GPUProcess {
-	    pid: number;
-	    name: string;
-	    gpu_usage: number;
-	    gpu_memory: number;
-	    type: string;
-	    command: string;
-	    status: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new GPUProcess(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.pid = source["pid"];
-	        this.name = source["name"];
-	        this.gpu_usage = source["gpu_usage"];
-	        this.gpu_memory = source["gpu_memory"];
-	        this.type = source["type"];
-	        this.command = source["command"];
-	        this.status = source["status"];
-	    }
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class GPUProcessFilter {
-	    usage_threshold: number;
-	    memory_threshold: number;
-	    filter_type: string;
-	    enabled: boolean;
+	export class StatusReport {
+	    collectors: monitoring.CollectorStatus[];
+	    caches: monitoring.CacheStatus[];
+	    errors: number;
+	    read_only: boolean;
+	    // Go type: time
+	    timestamp: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new GPUProcessFilter(source);
+	        return new StatusReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.usage_threshold = source["usage_threshold"];
-	        this.memory_threshold = source["memory_threshold"];
-	        this.filter_type = source["filter_type"];
-	        this.enabled = source["enabled"];
-	    }
-	}
-	export class GPUProcessSort {
-	    field: string;
-	    order: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new GPUProcessSort(source);
+	        this.collectors = this.convertValues(source["collectors"], monitoring.CollectorStatus);
+	        this.caches = this.convertValues(source["caches"], monitoring.CacheStatus);
+	        this.errors = source["errors"];
+	        this.read_only = source["read_only"];
+	        this.timestamp = this.convertValues(source["timestamp"], null);
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.field = source["field"];
-	        this.order = source["order"];
-	    }
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class GPUProcessQuery {
-	    filter: GPUProcessFilter;
-	    sort: GPUProcessSort;
-	    max_items: number;
-	    offset: number;
+	export class StressTestComparison {
+	    before?: StressTestReport;
+	    after?: StressTestReport;
+	    changes: db.MetricChange[];
 	
 	    static createFrom(source: any = {}) {
-	        return new GPUProcessQuery(source);
+	        return new StressTestComparison(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.filter = this.convertValues(source["filter"], GPUProcessFilter);
-	        this.sort = this.convertValues(source["sort"], GPUProcessSort);
-	        this.max_items = source["max_items"];
-	        this.offset = source["offset"];
+	        this.before = this.convertValues(source["before"], StressTestReport);
+	        this.after = this.convertValues(source["after"], StressTestReport);
+	        this.changes = this.convertValues(source["changes"], db.MetricChange);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -340,24 +5261,34 @@ export namespace monitoring {
 		    return a;
 		}
 	}
-	export class GPUProcessResponse {
-	    processes: GPUProcess[];
-	    total_count: number;
-	    filtered_count: number;
-	    has_more: boolean;
-	    query_time_ms: number;
+	export class StressTestConfig {
+	    max_duration_seconds: number;
+	    gpu_command: string;
+	    gpu_args: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new GPUProcessResponse(source);
+	        return new StressTestConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.processes = this.convertValues(source["processes"], GPUProcess);
-	        this.total_count = source["total_count"];
-	        this.filtered_count = source["filtered_count"];
-	        this.has_more = source["has_more"];
-	        this.query_time_ms = source["query_time_ms"];
+	        this.max_duration_seconds = source["max_duration_seconds"];
+	        this.gpu_command = source["gpu_command"];
+	        this.gpu_args = source["gpu_args"];
+	    }
+	}
+	export class StressTestReport {
+	    run: db.StressTestRun;
+	    metrics: db.MetricSummary[];
+	
+	    static createFrom(source: any = {}) {
+	        return new StressTestReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.run = this.convertValues(source["run"], db.StressTestRun);
+	        this.metrics = this.convertValues(source["metrics"], db.MetricSummary);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -378,57 +5309,74 @@ export namespace monitoring {
 		    return a;
 		}
 	}
-	
-	export class MemoryDetails {
-	    Physical: number;
-	    Virtual: number;
-	    Swap: number;
+	export class UIConfig {
+	    auto_open_browser: boolean;
+	    theme: string;
+	    language: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new MemoryDetails(source);
+	        return new UIConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Physical = source["Physical"];
-	        this.Virtual = source["Virtual"];
-	        this.Swap = source["Swap"];
+	        this.auto_open_browser = source["auto_open_browser"];
+	        this.theme = source["theme"];
+	        this.language = source["language"];
 	    }
 	}
-	export class NetworkInterface {
-	    Name: string;
-	    Status: number;
-	    IpAddress: string;
+	export class UnitsConfig {
+	    temperature: string;
+	    data_rate: string;
+	    memory: string;
+	    storage: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NetworkInterface(source);
+	        return new UnitsConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Name = source["Name"];
-	        this.Status = source["Status"];
-	        this.IpAddress = source["IpAddress"];
+	        this.temperature = source["temperature"];
+	        this.data_rate = source["data_rate"];
+	        this.memory = source["memory"];
+	        this.storage = source["storage"];
 	    }
 	}
-	export class ProcessInfo {
-	    Name: string;
-	    PID: number;
-	    CPUPercent: number;
-	    MemoryPercent: number;
+	export class WidgetStateBundle {
+	    user_id: string;
+	    version: number;
+	    pages: db.WidgetPage[];
+	    warnings?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ProcessInfo(source);
+	        return new WidgetStateBundle(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Name = source["Name"];
-	        this.PID = source["PID"];
-	        this.CPUPercent = source["CPUPercent"];
-	        this.MemoryPercent = source["MemoryPercent"];
+	        this.user_id = source["user_id"];
+	        this.version = source["version"];
+	        this.pages = this.convertValues(source["pages"], db.WidgetPage);
+	        this.warnings = source["warnings"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-
 }
-
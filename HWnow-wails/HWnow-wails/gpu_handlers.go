@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// handleGPUProcesses serves GET /api/gpu/processes?detail=full&refresh=true
+func (a *App) handleGPUProcesses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	detail, ok := processDetailParam(r)
+	if !ok {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "detail")
+		return
+	}
+	refresh, ok := refreshParam(r)
+	if !ok {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "refresh")
+		return
+	}
+
+	processes, err := a.GetGPUProcessesWithRefresh(detail, refresh)
+	if err != nil {
+		writeRefreshError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(processes)
+}
+
+// handleGPUInfo serves GET /api/gpu/info?refresh=true (usage, memory, temperature and power of the GPU)
+func (a *App) handleGPUInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	refresh, ok := refreshParam(r)
+	if !ok {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "refresh")
+		return
+	}
+
+	info, err := a.GetGPUInfoWithRefresh(refresh)
+	if err != nil {
+		writeRefreshError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(info)
+}
+
+// refreshParam parses the optional refresh=true flag that bypasses cached GPU data
+func refreshParam(r *http.Request) (bool, bool) {
+	value := r.URL.Query().Get("refresh")
+	if value == "" {
+		return false, true
+	}
+	refresh, err := strconv.ParseBool(value)
+	return refresh, err == nil
+}
+
+// writeRefreshError answers 429 with Retry-After for rate-limited forced refreshes and 500 otherwise
+func writeRefreshError(w http.ResponseWriter, r *http.Request, err error) {
+	var rateLimited *monitoring.RefreshRateLimitError
+	if errors.As(err, &rateLimited) {
+		seconds := int((rateLimited.RetryAfter + time.Second - 1) / time.Second) // 올림
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		writeAPIError(w, r, http.StatusTooManyRequests, "api.refresh_rate_limited", strconv.Itoa(seconds))
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// handleGPUECC serves GET /api/gpu/ecc (ECC error counts, retired/remapped pages per NVIDIA GPU)
+func (a *App) handleGPUECC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	statuses, err := a.GetGPUECCStatus()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(statuses)
+}
+
+// handleGPURedetect serves POST /api/gpu/redetect (clears the latched GPU vendor and detects it again)
+func (a *App) handleGPURedetect(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(a.RedetectGPUVendor())
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// handleDeviceInventory serves GET /api/devices (USB devices, printers and recent connect/disconnect events)
+func (a *App) handleDeviceInventory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	report, err := a.GetDeviceInventory()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// handleThrottle serves GET /api/throttle (CPU/GPU throttle state, per-core clocks, throttled time)
+func (a *App) handleThrottle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	throttle, err := a.GetThrottleInfo()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(throttle)
+}
+
+// handleNetworkErrors serves GET /api/network/errors (error/drop/collision counters and rates per interface)
+func (a *App) handleNetworkErrors(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	interfaces, err := a.GetNetworkErrors()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(interfaces)
+}
+
+// handleFans serves GET /api/fans (speeds and PWM state) and POST /api/fans {"fan_id":"nct6798_fan2","percent":60}
+func (a *App) handleFans(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		fans, err := a.GetFans()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(fans)
+
+	case http.MethodPost:
+		var request struct {
+			FanID   string  `json:"fan_id"`
+			Percent float64 `json:"percent"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.FanID == "" {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		fan, err := a.SetFanPWM(request.FanID, request.Percent)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(fan)
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+	}
+}
+
+// handleFanCurves serves GET /api/fans/curves and PUT /api/fans/curves [{"fan_id":...,"points":[{"temperature":40,"percent":30},...]}]
+func (a *App) handleFanCurves(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(a.GetFanCurves())
+
+	case http.MethodPut:
+		var curves []monitoring.FanCurve
+		if err := json.NewDecoder(r.Body).Decode(&curves); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		if err := a.SetFanCurves(curves); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(a.GetFanCurves())
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+	"HWnow-wails/internal/services"
+)
+
+// healthHandler serves only /healthz and /readyz (the loopback probe listener)
+func (a *App) healthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", a.handleHealthz)
+	mux.HandleFunc("/readyz", a.handleReadyz)
+	return mux
+}
+
+// startHealthServer serves /healthz and /readyz on 127.0.0.1:port (server.health_port) for external probes;
+// apiHandler is only reachable through the Wails asset server. Port 0 leaves the listener off
+func (a *App) startHealthServer(port int) error {
+	if port == 0 {
+		return nil
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: a.healthHandler(), ReadHeaderTimeout: 5 * time.Second}
+	a.healthServer = server
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			monitoring.LogWarn("Health endpoint listener stopped", "error", err)
+		}
+	}()
+	monitoring.LogInfo("Serving health endpoints", "address", listener.Addr().String())
+	return nil
+}
+
+// stopHealthServer closes the probe listener started by startHealthServer
+func (a *App) stopHealthServer() {
+	if a.healthServer == nil {
+		return
+	}
+	if err := a.healthServer.Close(); err != nil {
+		monitoring.LogWarn("Failed to close health endpoint listener", "error", err)
+	}
+	a.healthServer = nil
+}
+
+// handleHealthz serves GET /healthz; responds 503 only when the database is unreachable or every collector has stalled
+func (a *App) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	report := a.appService.GetHealth()
+	status := http.StatusOK
+	if report.Status == "unhealthy" {
+		status = http.StatusServiceUnavailable
+	}
+	writeHealthReport(w, r, report, status)
+}
+
+// handleReadyz serves GET /readyz; responds 503 until the database is connected and metrics have been collected
+func (a *App) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	report := a.appService.GetHealth()
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeHealthReport(w, r, report, status)
+}
+
+// writeHealthReport writes the report with the given status code (body omitted for HEAD)
+func writeHealthReport(w http.ResponseWriter, r *http.Request, report *services.HealthReport, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		json.NewEncoder(w).Encode(report)
+	}
+}
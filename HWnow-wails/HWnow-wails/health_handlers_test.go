@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestHealthServer(t *testing.T) {
+	app := NewAppWithDataDir(t.TempDir())
+	ctx := context.Background()
+	app.OnStartup(ctx)
+	defer app.OnShutdown(ctx)
+
+	if app.healthServer != nil {
+		t.Fatal("Expected no health listener with the default health_port of 0")
+	}
+
+	// 사용 가능한 루프백 포트를 하나 골라 그대로 사용
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	port := probe.Addr().(*net.TCPAddr).Port
+	probe.Close()
+
+	if err := app.startHealthServer(port); err != nil {
+		t.Fatalf("startHealthServer failed: %v", err)
+	}
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+
+	for name, path := range map[string]string{"Healthz": "/healthz", "Readyz": "/readyz"} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(baseURL + path)
+			if err != nil {
+				t.Fatalf("GET %s failed: %v", path, err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
+				t.Errorf("Expected 200 or 503, got %d", resp.StatusCode)
+			}
+			var report map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil || report["status"] == nil {
+				t.Errorf("Expected a health report, got %v (err=%v)", report, err)
+			}
+		})
+	}
+
+	// 프로브 리스너에는 상태 점검 외의 API를 노출하지 않음
+	resp, err := http.Get(baseURL + "/api/status")
+	if err != nil {
+		t.Fatalf("GET /api/status failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for /api/status on the health listener, got %d", resp.StatusCode)
+	}
+
+	app.stopHealthServer()
+	if _, err := http.Get(baseURL + "/healthz"); err == nil {
+		t.Error("Expected the health listener to be closed")
+	}
+}
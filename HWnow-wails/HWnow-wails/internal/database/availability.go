@@ -0,0 +1,195 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 호스트 가용성 추적
+// HWnow 실행 구간(app_sessions)과 부팅 시각 변화로 감지한 재부팅(host_reboots)을 기록하고
+// 재부팅 전 마지막 확인 시각부터 새 부팅 시각까지를 다운타임으로 계산
+// (HWnow가 꺼져 있던 동안 호스트가 켜져 있었는지는 알 수 없으므로 다운타임은 상한값)
+
+// 부팅 시각 계산 오차 허용 범위 (같은 부팅으로 간주)
+const bootTimeTolerance = 5 * time.Second
+
+// RebootEvent describes a detected host reboot
+type RebootEvent struct {
+	BootTime        time.Time `json:"boot_time"`
+	DetectedAt      time.Time `json:"detected_at"`
+	LastSeenBefore  time.Time `json:"last_seen_before"` // 재부팅 전 HWnow가 마지막으로 호스트를 확인한 시각
+	DowntimeSeconds float64   `json:"downtime_seconds"`
+	CleanStop       bool      `json:"clean_stop"` // 재부팅 전 HWnow가 정상 종료되었는지
+}
+
+// Availability summarizes host uptime and reboots over a period
+type Availability struct {
+	Since            time.Time     `json:"since"`
+	Until            time.Time     `json:"until"`
+	UptimePercent    float64       `json:"uptime_percent"`
+	DowntimeSeconds  float64       `json:"downtime_seconds"`
+	MonitoredSeconds float64       `json:"monitored_seconds"` // HWnow가 실행 중이던 시간
+	CoveragePercent  float64       `json:"coverage_percent"`
+	Sessions         int           `json:"sessions"`
+	Reboots          []RebootEvent `json:"reboots"`
+}
+
+// createAvailabilityTables creates the app session and host reboot tables
+func createAvailabilityTables(db schemaExecer) error {
+	createSessionsSQL := `
+	CREATE TABLE IF NOT EXISTS app_sessions (
+	  id INTEGER PRIMARY KEY AUTOINCREMENT,
+	  started_at INTEGER NOT NULL,
+	  last_seen INTEGER NOT NULL,
+	  stopped_at INTEGER,
+	  boot_time INTEGER NOT NULL
+	);`
+	if _, err := db.Exec(createSessionsSQL); err != nil {
+		return err
+	}
+
+	createRebootsSQL := `
+	CREATE TABLE IF NOT EXISTS host_reboots (
+	  boot_time INTEGER PRIMARY KEY,
+	  detected_at INTEGER NOT NULL,
+	  last_seen_before INTEGER NOT NULL,
+	  clean_stop INTEGER NOT NULL DEFAULT 0
+	);`
+	_, err := db.Exec(createRebootsSQL)
+	return err
+}
+
+// StartAppSession records a backend start and returns the new session ID.
+// 직전 세션과 부팅 시각이 다르면 재부팅으로 기록하고 해당 이벤트를 반환
+func StartAppSession(db *sql.DB, startedAt, bootTime time.Time) (int64, *RebootEvent, error) {
+	var reboot *RebootEvent
+
+	var prevBoot, prevLastSeen int64
+	var prevStopped sql.NullInt64
+	err := db.QueryRow(rebind("SELECT boot_time, last_seen, stopped_at FROM app_sessions ORDER BY id DESC LIMIT 1")).
+		Scan(&prevBoot, &prevLastSeen, &prevStopped)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return 0, nil, err
+	case bootTime.Unix()-prevBoot > int64(bootTimeTolerance/time.Second):
+		lastSeen := prevLastSeen
+		if prevStopped.Valid {
+			lastSeen = prevStopped.Int64
+		}
+		reboot = &RebootEvent{
+			BootTime:       bootTime,
+			DetectedAt:     startedAt,
+			LastSeenBefore: time.Unix(lastSeen, 0),
+			CleanStop:      prevStopped.Valid,
+		}
+		reboot.DowntimeSeconds = rebootDowntime(reboot.LastSeenBefore, bootTime)
+
+		insertSQL := CurrentDialect().InsertIgnore(`INSERT INTO host_reboots (boot_time, detected_at, last_seen_before, clean_stop)
+			VALUES (?, ?, ?, ?)`, "boot_time")
+		_, err = db.Exec(rebind(insertSQL), bootTime.Unix(), startedAt.Unix(), lastSeen, prevStopped.Valid)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	sessionID, err := insertReturningID(db, "INSERT INTO app_sessions (started_at, last_seen, boot_time) VALUES (?, ?, ?)",
+		startedAt.Unix(), startedAt.Unix(), bootTime.Unix())
+	if err != nil {
+		return 0, nil, err
+	}
+	return sessionID, reboot, nil
+}
+
+// TouchAppSession updates the last time the backend was seen running
+func TouchAppSession(db *sql.DB, sessionID int64, lastSeen time.Time) error {
+	_, err := db.Exec(rebind("UPDATE app_sessions SET last_seen = ? WHERE id = ?"), lastSeen.Unix(), sessionID)
+	return err
+}
+
+// StopAppSession records a clean backend stop
+func StopAppSession(db *sql.DB, sessionID int64, stoppedAt time.Time) error {
+	_, err := db.Exec(rebind("UPDATE app_sessions SET last_seen = ?, stopped_at = ? WHERE id = ?"),
+		stoppedAt.Unix(), stoppedAt.Unix(), sessionID)
+	return err
+}
+
+// GetAvailability computes host uptime percentage, monitoring coverage and reboot history between since and until
+func GetAvailability(db *sql.DB, since, until time.Time) (*Availability, error) {
+	availability := &Availability{Since: since, Until: until, Reboots: []RebootEvent{}}
+	period := until.Sub(since).Seconds()
+	if period <= 0 {
+		return availability, nil
+	}
+
+	rows, err := db.Query(rebind(`SELECT boot_time, detected_at, last_seen_before, clean_stop FROM host_reboots
+		WHERE boot_time > ? AND last_seen_before < ? ORDER BY boot_time`), since.Unix(), until.Unix())
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var bootTime, detectedAt, lastSeenBefore int64
+		var cleanStop bool
+		if err := rows.Scan(&bootTime, &detectedAt, &lastSeenBefore, &cleanStop); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		reboot := RebootEvent{
+			BootTime:       time.Unix(bootTime, 0),
+			DetectedAt:     time.Unix(detectedAt, 0),
+			LastSeenBefore: time.Unix(lastSeenBefore, 0),
+			CleanStop:      cleanStop,
+		}
+		reboot.DowntimeSeconds = rebootDowntime(reboot.LastSeenBefore, reboot.BootTime)
+		availability.DowntimeSeconds += overlapSeconds(reboot.LastSeenBefore, reboot.BootTime, since, until)
+		availability.Reboots = append(availability.Reboots, reboot)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.Query(rebind(`SELECT started_at, COALESCE(stopped_at, last_seen) FROM app_sessions
+		WHERE started_at < ? AND COALESCE(stopped_at, last_seen) > ?`), until.Unix(), since.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var startedAt, endedAt int64
+		if err := rows.Scan(&startedAt, &endedAt); err != nil {
+			return nil, err
+		}
+		availability.Sessions++
+		availability.MonitoredSeconds += overlapSeconds(time.Unix(startedAt, 0), time.Unix(endedAt, 0), since, until)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	availability.UptimePercent = (period - availability.DowntimeSeconds) / period * 100
+	availability.CoveragePercent = availability.MonitoredSeconds / period * 100
+	return availability, nil
+}
+
+// rebootDowntime returns the seconds between the last observation and the next boot
+func rebootDowntime(lastSeen, bootTime time.Time) float64 {
+	if !bootTime.After(lastSeen) {
+		return 0
+	}
+	return bootTime.Sub(lastSeen).Seconds()
+}
+
+// overlapSeconds returns how many seconds of [start, end) fall within [since, until)
+func overlapSeconds(start, end, since, until time.Time) float64 {
+	if start.Before(since) {
+		start = since
+	}
+	if end.After(until) {
+		end = until
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start).Seconds()
+}
@@ -0,0 +1,251 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SQL 방언 추상화
+// 기본은 로컬 SQLite, 여러 호스트가 하나의 중앙 DB를 쓰는 서버 배포에서는 PostgreSQL/MySQL 사용
+// 패키지 함수들은 쿼리를 SQLite 문법(? 자리표시자)으로 작성하고, 방언별 차이만 Dialect로 변환
+// 드라이버는 빌드 태그로 포함: go build -tags postgres (pgx), go build -tags mysql (go-sql-driver)
+
+// Supported database drivers (DatabaseConfig.Driver)
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+)
+
+// Dialect describes the SQL differences between supported databases
+type Dialect interface {
+	Name() string
+	// DriverName is the database/sql driver name registered by the driver package
+	DriverName() string
+	// Rebind converts ? placeholders to the dialect's placeholder syntax
+	Rebind(query string) string
+	// UnixSeconds returns an expression converting a timestamp column to Unix seconds
+	UnixSeconds(column string) string
+	// TimeBucket truncates a Unix seconds expression to a multiple of seconds (integer division)
+	TimeBucket(expr string, seconds int) string
+	// Least / Greatest return the smaller / larger of two scalar values
+	Least(a, b string) string
+	Greatest(a, b string) string
+	// InsertIgnore turns "INSERT INTO ..." into an insert that skips rows violating a unique key
+	InsertIgnore(insertSQL string, conflictColumns ...string) string
+	// Upsert appends an update clause for rows conflicting on conflictColumns
+	Upsert(conflictColumns []string, assignments []string) string
+	// Excluded refers to the value proposed for insertion in an Upsert assignment
+	Excluded(column string) string
+	// ReturningID reports whether inserts must use RETURNING id instead of LastInsertId
+	ReturningID() bool
+}
+
+var (
+	dialectMutex  sync.RWMutex
+	activeDialect Dialect = sqliteDialect{}
+)
+
+// CurrentDialect returns the dialect of the opened database
+func CurrentDialect() Dialect {
+	dialectMutex.RLock()
+	defer dialectMutex.RUnlock()
+	return activeDialect
+}
+
+func setDialect(dialect Dialect) {
+	dialectMutex.Lock()
+	activeDialect = dialect
+	dialectMutex.Unlock()
+}
+
+// DialectFor returns the dialect for a configured driver name
+func DialectFor(driver string) (Dialect, error) {
+	switch strings.ToLower(strings.TrimSpace(driver)) {
+	case "", DriverSQLite, "sqlite3":
+		return sqliteDialect{}, nil
+	case DriverPostgres, "postgresql", "pgx":
+		return postgresDialect{}, nil
+	case DriverMySQL, "mariadb":
+		return mysqlDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
+// Open connects to the configured database and applies pending migrations.
+// SQLite는 dsn에 파일 경로, PostgreSQL/MySQL은 접속 문자열을 사용
+func Open(driver, dsn string) (*sql.DB, error) {
+	dialect, err := DialectFor(driver)
+	if err != nil {
+		return nil, err
+	}
+	if dialect.Name() == DriverSQLite {
+		return InitDB(dsn)
+	}
+
+	if !driverRegistered(dialect.DriverName()) {
+		return nil, fmt.Errorf("database driver %q is not included in this build (rebuild with -tags %s)", dialect.DriverName(), dialect.Name())
+	}
+	if strings.TrimSpace(dsn) == "" {
+		return nil, fmt.Errorf("database.dsn is required for the %s driver", dialect.Name())
+	}
+	if dialect.Name() == DriverMySQL {
+		dsn = ensureMySQLParseTime(dsn)
+	}
+
+	conn, err := sql.Open(dialect.DriverName(), dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.Ping(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	setDialect(dialect)
+	if err = Migrate(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// rebind converts a query written with ? placeholders for the active dialect
+func rebind(query string) string {
+	return CurrentDialect().Rebind(query)
+}
+
+// insertReturningID executes an INSERT and returns the generated id column
+func insertReturningID(db *sql.DB, query string, args ...interface{}) (int64, error) {
+	dialect := CurrentDialect()
+	if dialect.ReturningID() {
+		var id int64
+		err := db.QueryRow(dialect.Rebind(query+" RETURNING id"), args...).Scan(&id)
+		return id, err
+	}
+	result, err := db.Exec(dialect.Rebind(query), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func driverRegistered(name string) bool {
+	for _, driver := range sql.Drivers() {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureMySQLParseTime makes the MySQL driver return DATETIME columns as time.Time
+func ensureMySQLParseTime(dsn string) string {
+	if strings.Contains(strings.ToLower(dsn), "parsetime=") {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&parseTime=true"
+	}
+	return dsn + "?parseTime=true"
+}
+
+// rebindNumbered replaces ? placeholders outside string literals with $1, $2, ...
+func rebindNumbered(query string) string {
+	var builder strings.Builder
+	builder.Grow(len(query) + 8)
+	index := 0
+	inString := false
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			inString = !inString
+		case r == '?' && !inString:
+			index++
+			builder.WriteString("$" + strconv.Itoa(index))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// sqliteDialect is the default embedded database
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string               { return DriverSQLite }
+func (sqliteDialect) DriverName() string         { return "sqlite" }
+func (sqliteDialect) Rebind(query string) string { return query }
+func (sqliteDialect) UnixSeconds(column string) string {
+	// 드라이버가 time.Time을 "2006-01-02 15:04:05.999 +0000 UTC" 형식으로 저장하므로 (항상 UTC)
+	// strftime이 해석할 수 있도록 앞의 날짜/시각 부분만 사용
+	return "CAST(strftime('%s', substr(" + column + ", 1, 19)) AS INTEGER)"
+}
+func (sqliteDialect) TimeBucket(expr string, seconds int) string {
+	return fmt.Sprintf("%s / %d * %d", expr, seconds, seconds)
+}
+func (sqliteDialect) Least(a, b string) string    { return "MIN(" + a + ", " + b + ")" }
+func (sqliteDialect) Greatest(a, b string) string { return "MAX(" + a + ", " + b + ")" }
+func (sqliteDialect) InsertIgnore(insertSQL string, conflictColumns ...string) string {
+	return strings.Replace(insertSQL, "INSERT INTO", "INSERT OR IGNORE INTO", 1)
+}
+func (sqliteDialect) Upsert(conflictColumns []string, assignments []string) string {
+	return "ON CONFLICT(" + strings.Join(conflictColumns, ", ") + ") DO UPDATE SET " + strings.Join(assignments, ", ")
+}
+func (sqliteDialect) Excluded(column string) string { return "excluded." + column }
+func (sqliteDialect) ReturningID() bool             { return false }
+
+// postgresDialect targets PostgreSQL through the pgx stdlib driver
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string               { return DriverPostgres }
+func (postgresDialect) DriverName() string         { return "pgx" }
+func (postgresDialect) Rebind(query string) string { return rebindNumbered(query) }
+func (postgresDialect) UnixSeconds(column string) string {
+	return "CAST(EXTRACT(EPOCH FROM " + column + ") AS BIGINT)"
+}
+func (postgresDialect) TimeBucket(expr string, seconds int) string {
+	return fmt.Sprintf("%s / %d * %d", expr, seconds, seconds)
+}
+func (postgresDialect) Least(a, b string) string    { return "LEAST(" + a + ", " + b + ")" }
+func (postgresDialect) Greatest(a, b string) string { return "GREATEST(" + a + ", " + b + ")" }
+func (postgresDialect) InsertIgnore(insertSQL string, conflictColumns ...string) string {
+	if len(conflictColumns) == 0 {
+		return insertSQL + " ON CONFLICT DO NOTHING"
+	}
+	return insertSQL + " ON CONFLICT (" + strings.Join(conflictColumns, ", ") + ") DO NOTHING"
+}
+func (postgresDialect) Upsert(conflictColumns []string, assignments []string) string {
+	return "ON CONFLICT(" + strings.Join(conflictColumns, ", ") + ") DO UPDATE SET " + strings.Join(assignments, ", ")
+}
+func (postgresDialect) Excluded(column string) string { return "excluded." + column }
+func (postgresDialect) ReturningID() bool             { return true }
+
+// mysqlDialect targets MySQL 8 / MariaDB through go-sql-driver/mysql
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string               { return DriverMySQL }
+func (mysqlDialect) DriverName() string         { return "mysql" }
+func (mysqlDialect) Rebind(query string) string { return query }
+func (mysqlDialect) UnixSeconds(column string) string {
+	return "CAST(UNIX_TIMESTAMP(" + column + ") AS SIGNED)"
+}
+func (mysqlDialect) TimeBucket(expr string, seconds int) string {
+	// MySQL의 / 는 실수 나눗셈이므로 DIV 사용
+	return fmt.Sprintf("%s DIV %d * %d", expr, seconds, seconds)
+}
+func (mysqlDialect) Least(a, b string) string    { return "LEAST(" + a + ", " + b + ")" }
+func (mysqlDialect) Greatest(a, b string) string { return "GREATEST(" + a + ", " + b + ")" }
+func (mysqlDialect) InsertIgnore(insertSQL string, conflictColumns ...string) string {
+	return strings.Replace(insertSQL, "INSERT INTO", "INSERT IGNORE INTO", 1)
+}
+func (mysqlDialect) Upsert(conflictColumns []string, assignments []string) string {
+	// 할당은 왼쪽부터 적용되므로 다른 컬럼이 참조하는 sample_count 등은 마지막에 둘 것
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(assignments, ", ")
+}
+func (mysqlDialect) Excluded(column string) string { return "VALUES(" + column + ")" }
+func (mysqlDialect) ReturningID() bool             { return false }
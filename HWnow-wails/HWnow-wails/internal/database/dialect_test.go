@@ -0,0 +1,117 @@
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDialect(t *testing.T) {
+
+	t.Run("Driver_Names", func(t *testing.T) {
+		cases := map[string]string{
+			"":           DriverSQLite,
+			"sqlite3":    DriverSQLite,
+			"PostgreSQL": DriverPostgres,
+			"pgx":        DriverPostgres,
+			" mariadb ":  DriverMySQL,
+			"mysql":      DriverMySQL,
+		}
+		for driver, expected := range cases {
+			dialect, err := DialectFor(driver)
+			if err != nil {
+				t.Errorf("DialectFor(%q) failed: %v", driver, err)
+				continue
+			}
+			if dialect.Name() != expected {
+				t.Errorf("DialectFor(%q) = %s; expected %s", driver, dialect.Name(), expected)
+			}
+		}
+		if _, err := DialectFor("oracle"); err == nil {
+			t.Error("Expected an error for an unsupported driver")
+		}
+	})
+
+	t.Run("Rebind", func(t *testing.T) {
+		query := "SELECT * FROM events WHERE category = ? AND message <> '?' AND id > ?"
+		cases := []struct {
+			dialect  Dialect
+			expected string
+		}{
+			{sqliteDialect{}, query},
+			{mysqlDialect{}, query},
+			{postgresDialect{}, "SELECT * FROM events WHERE category = $1 AND message <> '?' AND id > $2"},
+		}
+		for _, c := range cases {
+			if got := c.dialect.Rebind(query); got != c.expected {
+				t.Errorf("%s Rebind = %q; expected %q", c.dialect.Name(), got, c.expected)
+			}
+		}
+	})
+
+	t.Run("Statements", func(t *testing.T) {
+		insert := "INSERT INTO resource_rollups (metric_type, bucket) VALUES (?, ?)"
+		conflict := []string{"metric_type", "bucket"}
+		cases := []struct {
+			dialect      Dialect
+			insertIgnore string
+			upsertPrefix string
+			excluded     string
+			bucket       string
+			returningID  bool
+		}{
+			{
+				sqliteDialect{},
+				"INSERT OR IGNORE INTO resource_rollups (metric_type, bucket) VALUES (?, ?)",
+				"ON CONFLICT(metric_type, bucket) DO UPDATE SET ",
+				"excluded.value",
+				"ts / 60 * 60",
+				false,
+			},
+			{
+				postgresDialect{},
+				insert + " ON CONFLICT (metric_type, bucket) DO NOTHING",
+				"ON CONFLICT(metric_type, bucket) DO UPDATE SET ",
+				"excluded.value",
+				"ts / 60 * 60",
+				true,
+			},
+			{
+				mysqlDialect{},
+				"INSERT IGNORE INTO resource_rollups (metric_type, bucket) VALUES (?, ?)",
+				"ON DUPLICATE KEY UPDATE ",
+				"VALUES(value)",
+				"ts DIV 60 * 60",
+				false,
+			},
+		}
+		for _, c := range cases {
+			name := c.dialect.Name()
+			if got := c.dialect.InsertIgnore(insert, conflict...); got != c.insertIgnore {
+				t.Errorf("%s InsertIgnore = %q; expected %q", name, got, c.insertIgnore)
+			}
+			upsert := c.dialect.Upsert(conflict, []string{"value = " + c.dialect.Excluded("value")})
+			if !strings.HasPrefix(upsert, c.upsertPrefix) || !strings.HasSuffix(upsert, c.excluded) {
+				t.Errorf("%s Upsert = %q; expected prefix %q and suffix %q", name, upsert, c.upsertPrefix, c.excluded)
+			}
+			if got := c.dialect.TimeBucket("ts", 60); got != c.bucket {
+				t.Errorf("%s TimeBucket = %q; expected %q", name, got, c.bucket)
+			}
+			if c.dialect.ReturningID() != c.returningID {
+				t.Errorf("%s ReturningID = %v; expected %v", name, c.dialect.ReturningID(), c.returningID)
+			}
+		}
+	})
+
+	t.Run("MySQL_Parse_Time", func(t *testing.T) {
+		cases := map[string]string{
+			"user:pw@tcp(db:3306)/hwnow":                "user:pw@tcp(db:3306)/hwnow?parseTime=true",
+			"user:pw@tcp(db:3306)/hwnow?tls=true":       "user:pw@tcp(db:3306)/hwnow?tls=true&parseTime=true",
+			"user:pw@tcp(db:3306)/hwnow?parseTime=true": "user:pw@tcp(db:3306)/hwnow?parseTime=true",
+		}
+		for dsn, expected := range cases {
+			if got := ensureMySQLParseTime(dsn); got != expected {
+				t.Errorf("ensureMySQLParseTime(%q) = %q; expected %q", dsn, got, expected)
+			}
+		}
+	})
+}
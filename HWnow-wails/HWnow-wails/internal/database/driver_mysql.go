@@ -0,0 +1,6 @@
+//go:build mysql
+
+package db
+
+// MySQL/MariaDB 드라이버 (database.driver = "mysql")
+import _ "github.com/go-sql-driver/mysql"
@@ -0,0 +1,21 @@
+//go:build mysql
+
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+// go test -tags mysql ./internal/database 로 드라이버 포함 빌드를 확인
+func TestMySQLDriver(t *testing.T) {
+	if !driverRegistered(mysqlDialect{}.DriverName()) {
+		t.Fatal("Expected the mysql driver to be registered with -tags mysql")
+	}
+
+	// DSN이 없으면 접속을 시도하지 않고 실패해야 함
+	_, err := Open(DriverMySQL, "")
+	if err == nil || !strings.Contains(err.Error(), "database.dsn is required") {
+		t.Errorf("Expected a missing DSN error, got %v", err)
+	}
+}
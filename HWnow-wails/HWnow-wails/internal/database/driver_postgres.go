@@ -0,0 +1,6 @@
+//go:build postgres
+
+package db
+
+// PostgreSQL 드라이버 (database.driver = "postgres")
+import _ "github.com/jackc/pgx/v5/stdlib"
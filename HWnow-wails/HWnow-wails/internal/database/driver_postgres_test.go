@@ -0,0 +1,21 @@
+//go:build postgres
+
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+// go test -tags postgres ./internal/database 로 드라이버 포함 빌드를 확인
+func TestPostgresDriver(t *testing.T) {
+	if !driverRegistered(postgresDialect{}.DriverName()) {
+		t.Fatal("Expected the pgx driver to be registered with -tags postgres")
+	}
+
+	// DSN이 없으면 접속을 시도하지 않고 실패해야 함
+	_, err := Open(DriverPostgres, "")
+	if err == nil || !strings.Contains(err.Error(), "database.dsn is required") {
+		t.Errorf("Expected a missing DSN error, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 전력 사용량 적분 (에너지 비용/탄소 배출 추정용)
+// 1분 집계의 평균 전력(W)에 1분을 곱해 합산하므로 기록이 없는 분(앱 미실행, 절전)은 0으로 취급
+// 1분 집계 보관 기간(minute_retention_days)보다 오래된 구간은 포함되지 않음
+
+// EnergyUsage is the energy integrated from a power metric over a period
+type EnergyUsage struct {
+	MetricType     string  `json:"metric_type"`
+	EnergyWh       float64 `json:"energy_wh"`
+	AvgWatts       float64 `json:"avg_watts"`  // 기록이 있는 시간 동안의 평균
+	PeakWatts      float64 `json:"peak_watts"` // 가장 높았던 샘플
+	CoveredSeconds int64   `json:"covered_seconds"`
+}
+
+// GetEnergyUsage integrates a power metric (watts) between since and until at one-minute resolution
+func GetEnergyUsage(db *sql.DB, metricType string, since, until time.Time) (*EnergyUsage, error) {
+	points, _, err := GetResourceHistory(db, ResourceHistoryQuery{
+		MetricTypes: []string{metricType},
+		Since:       since,
+		Until:       until,
+		Resolution:  ResolutionMinute,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return IntegrateEnergy(metricType, points, time.Minute), nil
+}
+
+// IntegrateEnergy sums avg watts × bucket length over the history points of one power metric
+func IntegrateEnergy(metricType string, points []ResourceHistoryPoint, bucket time.Duration) *EnergyUsage {
+	usage := &EnergyUsage{MetricType: metricType}
+	var wattSeconds float64
+	for _, point := range points {
+		if point.MetricType != metricType || point.Count <= 0 {
+			continue
+		}
+		wattSeconds += point.Avg * bucket.Seconds()
+		usage.CoveredSeconds += int64(bucket.Seconds())
+		if point.Max > usage.PeakWatts {
+			usage.PeakWatts = point.Max
+		}
+	}
+	usage.EnergyWh = wattSeconds / 3600
+	if usage.CoveredSeconds > 0 {
+		usage.AvgWatts = wattSeconds / float64(usage.CoveredSeconds)
+	}
+	return usage
+}
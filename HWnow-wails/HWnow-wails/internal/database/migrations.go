@@ -0,0 +1,143 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// 스키마 버전 관리
+// schema_version 테이블에 적용된 마이그레이션을 기록하고, 아직 적용되지 않은 마이그레이션만
+// 버전 순서대로 각각 하나의 트랜잭션으로 실행 (실패하면 해당 마이그레이션 전체가 롤백됨)
+// 스키마 변경 시 기존 항목은 수정하지 말고 migrations 끝에 다음 버전을 추가
+
+// schemaExecer is implemented by both *sql.DB and *sql.Tx
+type schemaExecer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// Migration is one ordered schema change
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(tx schemaExecer) error
+}
+
+// SchemaMigration is an applied migration recorded in schema_version
+type SchemaMigration struct {
+	Version     int       `json:"version"`
+	Description string    `json:"description"`
+	AppliedAt   time.Time `json:"applied_at"`
+}
+
+// migrations lists every schema change in version order
+var migrations = []Migration{
+	{Version: 1, Description: "baseline schema", Up: createInitialSchema},
+	{Version: 2, Description: "stress test runs", Up: createStressTestRunsTable},
+	{Version: 3, Description: "aggregate sum of squares", Up: addAggregateSumSquares},
+	{Version: 4, Description: "user-defined protected processes", Up: createProtectedProcessesTable},
+	{Version: 5, Description: "widget state version", Up: addWidgetStateVersion},
+	{Version: 6, Description: "resource log timestamps in UTC", Up: convertResourceLogTimestampsToUTC},
+}
+
+// Migrate brings the database schema up to the latest version
+func Migrate(db *sql.DB) error {
+	return applyMigrations(db, migrations)
+}
+
+// LatestSchemaVersion returns the schema version this build expects
+func LatestSchemaVersion() int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// SchemaVersion returns the highest applied migration version (0 = none)
+func SchemaVersion(db *sql.DB) (int, error) {
+	var version int
+	if err := db.QueryRow(rebind("SELECT COALESCE(MAX(version), 0) FROM schema_version")).Scan(&version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// GetAppliedMigrations returns the migration history ordered by version
+func GetAppliedMigrations(db *sql.DB) ([]SchemaMigration, error) {
+	rows, err := db.Query(rebind("SELECT version, description, applied_at FROM schema_version ORDER BY version"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := []SchemaMigration{}
+	for rows.Next() {
+		var migration SchemaMigration
+		var appliedAt int64
+		if err := rows.Scan(&migration.Version, &migration.Description, &appliedAt); err != nil {
+			return nil, err
+		}
+		migration.AppliedAt = time.Unix(appliedAt, 0)
+		applied = append(applied, migration)
+	}
+	return applied, rows.Err()
+}
+
+func applyMigrations(db *sql.DB, list []Migration) error {
+	for i := 1; i < len(list); i++ {
+		if list[i].Version <= list[i-1].Version {
+			return fmt.Errorf("migration %d is out of order after %d", list[i].Version, list[i-1].Version)
+		}
+	}
+
+	createSQL := `
+	CREATE TABLE IF NOT EXISTS schema_version (
+	  version INTEGER PRIMARY KEY,
+	  description TEXT NOT NULL,
+	  applied_at BIGINT NOT NULL
+	);`
+	if _, err := db.Exec(createSQL); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %v", err)
+	}
+
+	current, err := SchemaVersion(db)
+	if err != nil {
+		return fmt.Errorf("failed to read schema version: %v", err)
+	}
+	if len(list) > 0 && current > list[len(list)-1].Version {
+		// 더 새로운 버전이 만든 데이터베이스: 알 수 없는 변경은 건드리지 않고 그대로 사용
+		monitoring.LogWarn("Database schema is newer than this build", "schemaVersion", current, "latestKnown", list[len(list)-1].Version)
+		return nil
+	}
+
+	for _, migration := range list {
+		if migration.Version <= current {
+			continue
+		}
+		if err := applyMigration(db, migration); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %v", migration.Version, migration.Description, err)
+		}
+		monitoring.LogInfo("Applied database migration", "version", migration.Version, "description", migration.Description)
+	}
+	return nil
+}
+
+// applyMigration runs one migration and records it in the same transaction
+func applyMigration(db *sql.DB, migration Migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := migration.Up(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(rebind("INSERT INTO schema_version (version, description, applied_at) VALUES (?, ?, ?)"),
+		migration.Version, migration.Description, time.Now().Unix()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 스키마 버전 관리 도입 이전 빌드가 만든 데이터베이스 (현지 시각으로 자원 로그 기록)
+const baselineSchemaSQL = `
+CREATE TABLE pages (
+	page_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	page_name TEXT NOT NULL,
+	page_order INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (user_id, page_id)
+);
+CREATE TABLE widget_states (
+	user_id TEXT NOT NULL,
+	page_id TEXT NOT NULL,
+	widget_id TEXT NOT NULL,
+	widget_type TEXT NOT NULL,
+	config TEXT,
+	layout TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (user_id, page_id, widget_id)
+);
+CREATE TABLE resource_logs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	timestamp DATETIME NOT NULL,
+	metric_type TEXT,
+	value REAL
+);`
+
+// openBaselineDB creates a pre-migration database file with the given resource log timestamps
+func openBaselineDB(t *testing.T, timestamps []time.Time) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "baseline.db")
+	conn, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Exec(baselineSchemaSQL); err != nil {
+		t.Fatalf("Failed to create baseline schema: %v", err)
+	}
+	if _, err := conn.Exec(`INSERT INTO widget_states (user_id, page_id, widget_id, widget_type, config, layout)
+		VALUES ('global-user', 'main-page', 'cpu-1', 'cpu', '{}', '{"x":0,"y":0,"w":4,"h":3}')`); err != nil {
+		t.Fatal(err)
+	}
+	for _, timestamp := range timestamps {
+		if _, err := conn.Exec("INSERT INTO resource_logs (timestamp, metric_type, value) VALUES (?, 'cpu', 50)", timestamp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestMigrations(t *testing.T) {
+
+	t.Run("Baseline_Database", func(t *testing.T) {
+		kst := time.FixedZone("KST", 9*60*60)
+		pst := time.FixedZone("PST", -8*60*60)
+		timestamps := []time.Time{
+			time.Date(2024, 3, 1, 8, 30, 15, 250000000, kst), // UTC 기준 전날
+			time.Date(2024, 3, 1, 23, 59, 59, 0, pst),        // UTC 기준 다음 날
+			time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC),     // 이미 UTC
+		}
+		path := openBaselineDB(t, timestamps)
+
+		conn, err := InitDB(path)
+		if err != nil {
+			t.Fatalf("InitDB failed on a baseline database: %v", err)
+		}
+		defer conn.Close()
+
+		version, err := SchemaVersion(conn)
+		if err != nil || version != LatestSchemaVersion() {
+			t.Fatalf("SchemaVersion = %d, %v; expected %d", version, err, LatestSchemaVersion())
+		}
+
+		// 기존 위젯은 유지되고 새 컬럼은 기본값으로 채워져야 함
+		var stateVersion int
+		if err := conn.QueryRow("SELECT state_version FROM widget_states WHERE widget_id = 'cpu-1'").Scan(&stateVersion); err != nil || stateVersion != 1 {
+			t.Errorf("Expected the baseline widget with state_version 1, got %d, %v", stateVersion, err)
+		}
+
+		rows, err := conn.Query("SELECT CAST(timestamp AS TEXT), " + (sqliteDialect{}).UnixSeconds("timestamp") + " FROM resource_logs ORDER BY id")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		i := 0
+		for rows.Next() {
+			var stored string
+			var seconds int64
+			if err := rows.Scan(&stored, &seconds); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(stored, " +0000 UTC") {
+				t.Errorf("Row %d not converted to UTC: %q", i, stored)
+			}
+			if expected := timestamps[i].Unix(); seconds != expected {
+				t.Errorf("Row %d Unix seconds = %d; expected %d (stored %q)", i, seconds, expected, stored)
+			}
+			i++
+		}
+		if i != len(timestamps) {
+			t.Errorf("Expected %d resource log rows, got %d", len(timestamps), i)
+		}
+
+		var fraction string
+		conn.QueryRow("SELECT CAST(timestamp AS TEXT) FROM resource_logs WHERE id = 1").Scan(&fraction)
+		if !strings.HasPrefix(fraction, "2024-02-29 23:30:15.25 ") {
+			t.Errorf("Expected fractional seconds to be kept, got %q", fraction)
+		}
+	})
+
+	t.Run("Reopen_Is_No_Op", func(t *testing.T) {
+		path := openBaselineDB(t, nil)
+		for i := 0; i < 2; i++ {
+			conn, err := InitDB(path)
+			if err != nil {
+				t.Fatalf("InitDB run %d failed: %v", i+1, err)
+			}
+			applied, err := GetAppliedMigrations(conn)
+			conn.Close()
+			if err != nil || len(applied) != LatestSchemaVersion() {
+				t.Fatalf("Run %d: expected %d applied migrations, got %d, %v", i+1, LatestSchemaVersion(), len(applied), err)
+			}
+		}
+	})
+
+	t.Run("Out_Of_Order", func(t *testing.T) {
+		conn, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "order.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		noop := func(schemaExecer) error { return nil }
+		list := []Migration{{Version: 2, Up: noop}, {Version: 1, Up: noop}}
+		if err := applyMigrations(conn, list); err == nil {
+			t.Error("Expected an error for migrations out of order")
+		}
+	})
+}
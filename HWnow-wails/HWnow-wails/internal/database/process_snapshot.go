@@ -0,0 +1,202 @@
+package db
+
+import (
+	"database/sql"
+	"math"
+	"sort"
+	"time"
+)
+
+// 프로세스 목록 스냅샷과 "무엇이 바뀌었나" 비교
+// 행 수를 줄이기 위해 스냅샷은 프로세스 이름 단위로 집계하여 저장 (원시 자원 로그와 같은 보존 기간)
+
+// ProcessSnapshotEntry is one process name in a stored process list snapshot
+type ProcessSnapshotEntry struct {
+	Name         string  `json:"name"`
+	ProcessCount int     `json:"process_count"`
+	CPUPercent   float64 `json:"cpu_percent"`
+	MemoryRSS    float64 `json:"memory_rss"` // MB
+}
+
+// SnapshotDiffQuery selects the two points in time to compare
+type SnapshotDiffQuery struct {
+	From             time.Time `json:"from"`
+	To               time.Time `json:"to"`
+	WindowMinutes    int       `json:"window_minutes"`    // 각 시점 직전 평균을 낼 구간 (기본 10분)
+	ThresholdPercent float64   `json:"threshold_percent"` // 보고할 최소 평균 변화율 (기본 20%)
+}
+
+// MetricChange reports a metric whose average shifted between the two windows
+type MetricChange struct {
+	MetricType    string  `json:"metricType"`
+	FromAvg       float64 `json:"from_avg"`
+	ToAvg         float64 `json:"to_avg"`
+	ChangePercent float64 `json:"change_percent"` // 이전 평균이 0이면 ±100
+}
+
+// SnapshotDiff describes what changed between two points in time
+type SnapshotDiff struct {
+	From             time.Time              `json:"from"`
+	To               time.Time              `json:"to"`
+	FromSnapshot     time.Time              `json:"from_snapshot"` // 실제 비교에 사용된 프로세스 스냅샷 시각
+	ToSnapshot       time.Time              `json:"to_snapshot"`
+	WindowMinutes    int                    `json:"window_minutes"`
+	ThresholdPercent float64                `json:"threshold_percent"`
+	Appeared         []ProcessSnapshotEntry `json:"appeared"`
+	Disappeared      []ProcessSnapshotEntry `json:"disappeared"`
+	MetricChanges    []MetricChange         `json:"metric_changes"`
+}
+
+// createProcessSnapshotsTable creates the process list snapshot table
+func createProcessSnapshotsTable(db schemaExecer) error {
+	createSQL := `
+	CREATE TABLE IF NOT EXISTS process_snapshots (
+	  timestamp DATETIME NOT NULL,
+	  name TEXT NOT NULL,
+	  process_count INTEGER NOT NULL,
+	  cpu_percent REAL NOT NULL,
+	  memory_rss REAL NOT NULL
+	);`
+	if _, err := db.Exec(createSQL); err != nil {
+		return err
+	}
+	_, err := db.Exec("CREATE INDEX IF NOT EXISTS idx_process_snapshots_timestamp ON process_snapshots (timestamp)")
+	return err
+}
+
+// InsertProcessSnapshot stores a process list snapshot in a single transaction
+func InsertProcessSnapshot(db *sql.DB, timestamp time.Time, entries []ProcessSnapshotEntry) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(rebind("INSERT INTO process_snapshots (timestamp, name, process_count, cpu_percent, memory_rss) VALUES (?, ?, ?, ?, ?)"))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	for _, entry := range entries {
+		if _, err := stmt.Exec(timestamp.UTC(), entry.Name, entry.ProcessCount, entry.CPUPercent, entry.MemoryRSS); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetProcessSnapshotAt returns the latest snapshot taken at or before at (or the first one after it)
+func GetProcessSnapshotAt(db *sql.DB, at time.Time) (time.Time, []ProcessSnapshotEntry, error) {
+	at = at.UTC()
+
+	var timestamp time.Time
+	err := db.QueryRow(rebind("SELECT timestamp FROM process_snapshots WHERE timestamp <= ? ORDER BY timestamp DESC LIMIT 1"), at).Scan(&timestamp)
+	if err == sql.ErrNoRows {
+		err = db.QueryRow(rebind("SELECT timestamp FROM process_snapshots WHERE timestamp > ? ORDER BY timestamp ASC LIMIT 1"), at).Scan(&timestamp)
+	}
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	rows, err := db.Query(rebind(`SELECT name, process_count, cpu_percent, memory_rss FROM process_snapshots
+		WHERE timestamp = ? ORDER BY name`), timestamp)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	defer rows.Close()
+
+	entries := []ProcessSnapshotEntry{}
+	for rows.Next() {
+		var entry ProcessSnapshotEntry
+		if err := rows.Scan(&entry.Name, &entry.ProcessCount, &entry.CPUPercent, &entry.MemoryRSS); err != nil {
+			return time.Time{}, nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return timestamp, entries, rows.Err()
+}
+
+// DeleteProcessSnapshotsBefore removes snapshots older than cutoff
+func DeleteProcessSnapshotsBefore(db *sql.DB, cutoff time.Time) (int64, error) {
+	result, err := db.Exec(rebind("DELETE FROM process_snapshots WHERE timestamp < ?"), cutoff.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// DiffProcessSnapshots returns process names present only in to (appeared) and only in from (disappeared)
+func DiffProcessSnapshots(from, to []ProcessSnapshotEntry) ([]ProcessSnapshotEntry, []ProcessSnapshotEntry) {
+	fromNames := make(map[string]bool, len(from))
+	for _, entry := range from {
+		fromNames[entry.Name] = true
+	}
+	toNames := make(map[string]bool, len(to))
+	for _, entry := range to {
+		toNames[entry.Name] = true
+	}
+
+	appeared := []ProcessSnapshotEntry{}
+	for _, entry := range to {
+		if !fromNames[entry.Name] {
+			appeared = append(appeared, entry)
+		}
+	}
+	disappeared := []ProcessSnapshotEntry{}
+	for _, entry := range from {
+		if !toNames[entry.Name] {
+			disappeared = append(disappeared, entry)
+		}
+	}
+
+	// 자원을 많이 쓰는 프로세스가 먼저 보이도록 정렬
+	byCPU := func(entries []ProcessSnapshotEntry) {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].CPUPercent > entries[j].CPUPercent })
+	}
+	byCPU(appeared)
+	byCPU(disappeared)
+	return appeared, disappeared
+}
+
+// DiffMetricSummaries returns metrics whose average changed by at least thresholdPercent (largest change first)
+func DiffMetricSummaries(from, to []MetricSummary, thresholdPercent float64) []MetricChange {
+	fromAvg := make(map[string]float64, len(from))
+	for _, summary := range from {
+		fromAvg[summary.MetricType] = summary.Avg
+	}
+
+	changes := []MetricChange{}
+	for _, summary := range to {
+		before, ok := fromAvg[summary.MetricType]
+		if !ok {
+			continue
+		}
+
+		var changePercent float64
+		switch {
+		case before != 0:
+			changePercent = (summary.Avg - before) / math.Abs(before) * 100
+		case summary.Avg > 0:
+			changePercent = 100
+		case summary.Avg < 0:
+			changePercent = -100
+		}
+		if math.Abs(changePercent) < thresholdPercent || changePercent == 0 {
+			continue
+		}
+		changes = append(changes, MetricChange{
+			MetricType:    summary.MetricType,
+			FromAvg:       before,
+			ToAvg:         summary.Avg,
+			ChangePercent: changePercent,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return math.Abs(changes[i].ChangePercent) > math.Abs(changes[j].ChangePercent)
+	})
+	return changes
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProcessSnapshots(t *testing.T) {
+	morning := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	afternoon := time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC)
+	before := []ProcessSnapshotEntry{
+		{Name: "explorer.exe", ProcessCount: 1, CPUPercent: 1, MemoryRSS: 80},
+		{Name: "updater.exe", ProcessCount: 1, CPUPercent: 0.5, MemoryRSS: 20},
+	}
+	after := []ProcessSnapshotEntry{
+		{Name: "explorer.exe", ProcessCount: 1, CPUPercent: 2, MemoryRSS: 85},
+		{Name: "indexer.exe", ProcessCount: 2, CPUPercent: 5, MemoryRSS: 300},
+		{Name: "antivirus.exe", ProcessCount: 1, CPUPercent: 40, MemoryRSS: 150},
+	}
+
+	t.Run("Snapshot_At", func(t *testing.T) {
+		conn := openTestDB(t)
+		if err := InsertProcessSnapshot(conn, morning, before); err != nil {
+			t.Fatalf("InsertProcessSnapshot failed: %v", err)
+		}
+		if err := InsertProcessSnapshot(conn, afternoon, after); err != nil {
+			t.Fatalf("InsertProcessSnapshot failed: %v", err)
+		}
+
+		cases := []struct {
+			name     string
+			at       time.Time
+			expected time.Time
+			entries  int
+		}{
+			{"Exact_Time", afternoon, afternoon, len(after)},
+			{"Latest_Before", afternoon.Add(-time.Hour), morning, len(before)},
+			// 첫 스냅샷보다 이른 시각이면 그 다음 스냅샷을 사용
+			{"Before_First_Snapshot", morning.Add(-time.Hour), morning, len(before)},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				timestamp, entries, err := GetProcessSnapshotAt(conn, c.at)
+				if err != nil {
+					t.Fatalf("GetProcessSnapshotAt failed: %v", err)
+				}
+				if !timestamp.Equal(c.expected) || len(entries) != c.entries {
+					t.Errorf("Expected %d entries at %v, got %d at %v", c.entries, c.expected, len(entries), timestamp)
+				}
+			})
+		}
+
+		if deleted, err := DeleteProcessSnapshotsBefore(conn, afternoon); err != nil || deleted != int64(len(before)) {
+			t.Errorf("Expected %d deleted rows, got %d (err=%v)", len(before), deleted, err)
+		}
+	})
+
+	t.Run("Appeared_And_Disappeared", func(t *testing.T) {
+		appeared, disappeared := DiffProcessSnapshots(before, after)
+		// CPU 사용률이 높은 프로세스가 먼저 옴
+		if len(appeared) != 2 || appeared[0].Name != "antivirus.exe" || appeared[1].Name != "indexer.exe" {
+			t.Errorf("Expected antivirus.exe and indexer.exe to appear, got %+v", appeared)
+		}
+		if len(disappeared) != 1 || disappeared[0].Name != "updater.exe" {
+			t.Errorf("Expected updater.exe to disappear, got %+v", disappeared)
+		}
+	})
+
+	t.Run("Metric_Changes", func(t *testing.T) {
+		from := []MetricSummary{
+			{MetricType: "cpu", Avg: 20},
+			{MetricType: "ram", Avg: 50},
+			{MetricType: "disk_read", Avg: 0},
+			{MetricType: "gpu_usage", Avg: 10},
+		}
+		to := []MetricSummary{
+			{MetricType: "cpu", Avg: 60},       // +200%
+			{MetricType: "ram", Avg: 55},       // +10%, 기준 미만
+			{MetricType: "disk_read", Avg: 5},  // 이전 평균이 0이면 +100%
+			{MetricType: "gpu_usage", Avg: 5},  // -50%
+			{MetricType: "net_sent", Avg: 100}, // 이전 구간에 없는 지표는 제외
+		}
+
+		changes := DiffMetricSummaries(from, to, 20)
+		expected := []struct {
+			metricType    string
+			changePercent float64
+		}{
+			{"cpu", 200},
+			{"disk_read", 100},
+			{"gpu_usage", -50},
+		}
+		if len(changes) != len(expected) {
+			t.Fatalf("Expected %d changes, got %+v", len(expected), changes)
+		}
+		for i, e := range expected {
+			if changes[i].MetricType != e.metricType || changes[i].ChangePercent != e.changePercent {
+				t.Errorf("Change %d: expected %s %v%%, got %s %v%%", i, e.metricType, e.changePercent, changes[i].MetricType, changes[i].ChangePercent)
+			}
+		}
+	})
+}
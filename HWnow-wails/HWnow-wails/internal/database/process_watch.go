@@ -0,0 +1,88 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 프로세스 감시 목록 (종료 시 알림/재시작, 자원 한도 초과 알림)
+
+// WatchedProcess is a process name registered with the watchdog
+type WatchedProcess struct {
+	ID             int64     `json:"id"`
+	Name           string    `json:"name"`            // 프로세스 이름 (대소문자 무시)
+	RestartCommand string    `json:"restart_command"` // 종료 시 실행할 명령 (빈 값 = 알림만)
+	MaxCPUPercent  float64   `json:"max_cpu_percent"` // 0 = 제한 없음
+	MaxMemoryMB    float64   `json:"max_memory_mb"`   // 0 = 제한 없음
+	Enabled        bool      `json:"enabled"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// createWatchedProcessesTable creates the process watch list table
+func createWatchedProcessesTable(db schemaExecer) error {
+	createSQL := `
+	CREATE TABLE IF NOT EXISTS watched_processes (
+	  id INTEGER PRIMARY KEY AUTOINCREMENT,
+	  name TEXT NOT NULL,
+	  restart_command TEXT NOT NULL DEFAULT '',
+	  max_cpu_percent REAL NOT NULL DEFAULT 0,
+	  max_memory_mb REAL NOT NULL DEFAULT 0,
+	  enabled INTEGER NOT NULL DEFAULT 1,
+	  created_at DATETIME NOT NULL
+	);`
+	_, err := db.Exec(createSQL)
+	return err
+}
+
+// GetWatchedProcesses returns the watch list ordered by creation
+func GetWatchedProcesses(db *sql.DB) ([]WatchedProcess, error) {
+	rows, err := db.Query(rebind(`SELECT id, name, restart_command, max_cpu_percent, max_memory_mb, enabled, created_at
+		FROM watched_processes ORDER BY id`))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	watched := []WatchedProcess{}
+	for rows.Next() {
+		var w WatchedProcess
+		if err := rows.Scan(&w.ID, &w.Name, &w.RestartCommand, &w.MaxCPUPercent, &w.MaxMemoryMB, &w.Enabled, &w.CreatedAt); err != nil {
+			return nil, err
+		}
+		watched = append(watched, w)
+	}
+	return watched, rows.Err()
+}
+
+// SaveWatchedProcess inserts a new entry (ID 0) or updates an existing one and returns its ID
+func SaveWatchedProcess(db *sql.DB, w WatchedProcess) (int64, error) {
+	if w.ID == 0 {
+		if w.CreatedAt.IsZero() {
+			w.CreatedAt = time.Now()
+		}
+		return insertReturningID(db, `INSERT INTO watched_processes (name, restart_command, max_cpu_percent, max_memory_mb, enabled, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)`, w.Name, w.RestartCommand, w.MaxCPUPercent, w.MaxMemoryMB, w.Enabled, w.CreatedAt.UTC())
+	}
+
+	result, err := db.Exec(rebind(`UPDATE watched_processes SET name = ?, restart_command = ?, max_cpu_percent = ?, max_memory_mb = ?, enabled = ?
+		WHERE id = ?`), w.Name, w.RestartCommand, w.MaxCPUPercent, w.MaxMemoryMB, w.Enabled, w.ID)
+	if err != nil {
+		return 0, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return 0, sql.ErrNoRows
+	}
+	return w.ID, nil
+}
+
+// DeleteWatchedProcess removes an entry from the watch list
+func DeleteWatchedProcess(db *sql.DB, id int64) error {
+	result, err := db.Exec(rebind("DELETE FROM watched_processes WHERE id = ?"), id)
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 사용자 정의 보호 프로세스 (REST/바인딩으로 추가, 설정 파일의 security.protected_processes와 합쳐서 적용)
+
+// ProtectedProcess is a user-defined protected process stored in the database
+type ProtectedProcess struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Pattern     string    `json:"pattern"` // "^prefix", "suffix$" 또는 부분 문자열 (빈 값 = name)
+	Level       string    `json:"level"`   // low, medium, high, critical
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// createProtectedProcessesTable creates the user-defined protected process table (migration 4)
+func createProtectedProcessesTable(db schemaExecer) error {
+	idColumn, keyColumn, timestampColumn := "INTEGER PRIMARY KEY AUTOINCREMENT", "TEXT", "DATETIME"
+	if dialect := CurrentDialect(); dialect.Name() != DriverSQLite {
+		types := serverTypesFor(dialect)
+		idColumn, keyColumn, timestampColumn = types.id, types.key, types.timestamp
+	}
+
+	createSQL := `
+	CREATE TABLE IF NOT EXISTS protected_processes (
+	  id ` + idColumn + `,
+	  name ` + keyColumn + ` NOT NULL,
+	  pattern TEXT NOT NULL,
+	  level ` + keyColumn + ` NOT NULL,
+	  description TEXT NOT NULL,
+	  created_at ` + timestampColumn + ` NOT NULL
+	)`
+	_, err := db.Exec(createSQL)
+	return err
+}
+
+// GetProtectedProcesses returns the stored protected processes ordered by creation
+func GetProtectedProcesses(db *sql.DB) ([]ProtectedProcess, error) {
+	rows, err := db.Query(rebind("SELECT id, name, pattern, level, description, created_at FROM protected_processes ORDER BY id"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	protected := []ProtectedProcess{}
+	for rows.Next() {
+		var p ProtectedProcess
+		if err := rows.Scan(&p.ID, &p.Name, &p.Pattern, &p.Level, &p.Description, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		protected = append(protected, p)
+	}
+	return protected, rows.Err()
+}
+
+// SaveProtectedProcess inserts a new entry (ID 0) or updates an existing one and returns its ID
+func SaveProtectedProcess(db *sql.DB, p ProtectedProcess) (int64, error) {
+	if p.ID == 0 {
+		if p.CreatedAt.IsZero() {
+			p.CreatedAt = time.Now()
+		}
+		return insertReturningID(db, `INSERT INTO protected_processes (name, pattern, level, description, created_at)
+			VALUES (?, ?, ?, ?, ?)`, p.Name, p.Pattern, p.Level, p.Description, p.CreatedAt.UTC())
+	}
+
+	result, err := db.Exec(rebind(`UPDATE protected_processes SET name = ?, pattern = ?, level = ?, description = ?
+		WHERE id = ?`), p.Name, p.Pattern, p.Level, p.Description, p.ID)
+	if err != nil {
+		return 0, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return 0, sql.ErrNoRows
+	}
+	return p.ID, nil
+}
+
+// DeleteProtectedProcess removes a stored protected process
+func DeleteProtectedProcess(db *sql.DB, id int64) error {
+	result, err := db.Exec(rebind("DELETE FROM protected_processes WHERE id = ?"), id)
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
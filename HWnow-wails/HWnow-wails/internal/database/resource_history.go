@@ -0,0 +1,387 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 장기 이력 다운샘플링
+// 원시 샘플(resource_logs)을 일정 기간 후 1분/1시간 집계 테이블(avg/min/max)로 압축
+// 집계 테이블은 제곱합(sum_squares)도 보관하여 압축 후에도 표준편차를 정확히 계산
+
+// History resolutions
+const (
+	ResolutionAuto   = "auto"
+	ResolutionRaw    = "raw"
+	ResolutionMinute = "1m"
+	ResolutionHour   = "1h"
+)
+
+// createResourceAggregateTables creates the 1-minute and 1-hour aggregate tables
+func createResourceAggregateTables(db schemaExecer) error {
+	for _, table := range []string{"resource_logs_1m", "resource_logs_1h"} {
+		createSQL := fmt.Sprintf(`
+	CREATE TABLE IF NOT EXISTS %s (
+	  bucket INTEGER NOT NULL,
+	  metric_type TEXT NOT NULL,
+	  avg_value REAL NOT NULL,
+	  min_value REAL NOT NULL,
+	  max_value REAL NOT NULL,
+	  sample_count INTEGER NOT NULL,
+	  PRIMARY KEY (bucket, metric_type)
+	);`, table)
+		if _, err := db.Exec(createSQL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAggregateSumSquares adds the sum of squares column to the aggregate tables (migration 3).
+// 기존 버킷의 분산은 알 수 없으므로 모든 샘플이 평균값이었다고 간주 (표준편차 0)
+func addAggregateSumSquares(tx schemaExecer) error {
+	floatType := "REAL"
+	if dialect := CurrentDialect(); dialect.Name() != DriverSQLite {
+		floatType = serverTypesFor(dialect).float
+	}
+	for _, table := range []string{"resource_logs_1m", "resource_logs_1h"} {
+		if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN sum_squares %s NOT NULL DEFAULT 0", table, floatType)); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(fmt.Sprintf("UPDATE %s SET sum_squares = avg_value * avg_value * sample_count", table)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompactionResult reports how many rows a compaction run moved
+type CompactionResult struct {
+	RawRowsCompacted    int64         `json:"raw_rows_compacted"`
+	MinuteRowsCompacted int64         `json:"minute_rows_compacted"`
+	Duration            time.Duration `json:"duration"`
+}
+
+// CompactResourceLogs rolls raw samples older than rawCutoff into 1-minute aggregates and
+// 1-minute aggregates older than minuteCutoff into 1-hour aggregates
+func CompactResourceLogs(db *sql.DB, rawCutoff, minuteCutoff time.Time) (*CompactionResult, error) {
+	start := time.Now()
+	result := &CompactionResult{}
+
+	// 버킷이 나뉘지 않도록 경계를 분/시 단위로 정렬
+	rawCutoff = rawCutoff.UTC().Truncate(time.Minute)
+	minuteCutoff = minuteCutoff.UTC().Truncate(time.Hour)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	d := CurrentDialect()
+	_, err = tx.Exec(rebind(rollupSQL(d, "resource_logs_1m", `
+	SELECT `+d.TimeBucket(d.UnixSeconds("timestamp"), 60)+` AS bucket, metric_type, AVG(value), MIN(value), MAX(value), COUNT(*), SUM(value * value)
+	FROM resource_logs
+	WHERE timestamp < ?
+	GROUP BY bucket, metric_type`)), rawCutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate raw samples: %w", err)
+	}
+
+	res, err := tx.Exec(rebind("DELETE FROM resource_logs WHERE timestamp < ?"), rawCutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete compacted raw samples: %w", err)
+	}
+	result.RawRowsCompacted, _ = res.RowsAffected()
+
+	_, err = tx.Exec(rebind(rollupSQL(d, "resource_logs_1h", `
+	SELECT `+d.TimeBucket("bucket", 3600)+` AS hour_bucket, metric_type,
+	  SUM(avg_value * sample_count) / SUM(sample_count), MIN(min_value), MAX(max_value), SUM(sample_count), SUM(sum_squares)
+	FROM resource_logs_1m
+	WHERE bucket < ?
+	GROUP BY hour_bucket, metric_type`)), minuteCutoff.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate minute samples: %w", err)
+	}
+
+	res, err = tx.Exec(rebind("DELETE FROM resource_logs_1m WHERE bucket < ?"), minuteCutoff.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete compacted minute samples: %w", err)
+	}
+	result.MinuteRowsCompacted, _ = res.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// rollupSQL inserts aggregated rows into a rollup table, merging with an existing bucket
+func rollupSQL(d Dialect, table, selectSQL string) string {
+	column := func(name string) string { return table + "." + name }
+	// sample_count는 다른 할당이 기존 값을 참조하므로 마지막에 갱신 (MySQL은 왼쪽부터 적용)
+	assignments := []string{
+		"avg_value = (" + column("avg_value") + " * " + column("sample_count") + " + " + d.Excluded("avg_value") + " * " + d.Excluded("sample_count") +
+			") / (" + column("sample_count") + " + " + d.Excluded("sample_count") + ")",
+		"min_value = " + d.Least(column("min_value"), d.Excluded("min_value")),
+		"max_value = " + d.Greatest(column("max_value"), d.Excluded("max_value")),
+		"sum_squares = " + column("sum_squares") + " + " + d.Excluded("sum_squares"),
+		"sample_count = " + column("sample_count") + " + " + d.Excluded("sample_count"),
+	}
+	return "INSERT INTO " + table + " (bucket, metric_type, avg_value, min_value, max_value, sample_count, sum_squares)" +
+		selectSQL + "\n\t" + d.Upsert([]string{"bucket", "metric_type"}, assignments)
+}
+
+// ResourceHistoryQuery selects metric history over a time range
+type ResourceHistoryQuery struct {
+	MetricTypes []string  `json:"metricTypes"`
+	Since       time.Time `json:"since"`
+	Until       time.Time `json:"until,omitempty"`
+	Resolution  string    `json:"resolution,omitempty"`  // auto, raw, 1m, 1h
+	Percentiles bool      `json:"percentiles,omitempty"` // 버킷별 p50/p95/p99 계산 (버킷의 모든 샘플을 읽으므로 느림)
+}
+
+// ResourceHistoryPoint is a single (possibly aggregated) metric sample
+type ResourceHistoryPoint struct {
+	Timestamp  time.Time `json:"timestamp"`
+	MetricType string    `json:"metricType"`
+	Avg        float64   `json:"avg"`
+	Min        float64   `json:"min"`
+	Max        float64   `json:"max"`
+	StdDev     float64   `json:"stddev"` // 모표준편차
+	Count      int64     `json:"count"`
+	Unit       string    `json:"unit,omitempty"` // Avg/Min/Max/StdDev/백분위수 단위 (서비스 계층에서 선호 단위로 변환 후 설정)
+
+	// 백분위수 (Percentiles 요청 시에만)
+	// 압축된 집계 버킷이 섞이면 원시 샘플 대신 하위 버킷 평균을 샘플 수로 가중하여 추정 (PercentilesApproximate)
+	P50                    *float64 `json:"p50,omitempty"`
+	P95                    *float64 `json:"p95,omitempty"`
+	P99                    *float64 `json:"p99,omitempty"`
+	PercentilesApproximate bool     `json:"percentilesApproximate,omitempty"`
+}
+
+// ResolveHistoryResolution picks a resolution for the requested time span
+func ResolveHistoryResolution(resolution string, since, until time.Time) string {
+	switch resolution {
+	case ResolutionRaw, ResolutionMinute, ResolutionHour:
+		return resolution
+	}
+
+	span := until.Sub(since)
+	switch {
+	case span <= time.Hour:
+		return ResolutionRaw
+	case span <= 2*24*time.Hour:
+		return ResolutionMinute
+	default:
+		return ResolutionHour
+	}
+}
+
+// GetResourceHistory returns metric history at the requested resolution.
+// 아직 압축되지 않은 데이터는 조회 시점에 같은 단위로 집계하여 모든 테이블을 합쳐 반환
+func GetResourceHistory(db *sql.DB, query ResourceHistoryQuery) ([]ResourceHistoryPoint, string, error) {
+	until := query.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	since := query.Since.UTC()
+	until = until.UTC()
+	resolution := ResolveHistoryResolution(query.Resolution, since, until)
+
+	metricFilter := ""
+	var metricArgs []interface{}
+	if len(query.MetricTypes) > 0 {
+		placeholders := make([]string, len(query.MetricTypes))
+		for i, metricType := range query.MetricTypes {
+			placeholders[i] = "?"
+			metricArgs = append(metricArgs, metricType)
+		}
+		metricFilter = " AND metric_type IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	rawArgs := append([]interface{}{since, until}, metricArgs...)
+	bucketArgs := append([]interface{}{since.Unix(), until.Unix()}, metricArgs...)
+
+	d := CurrentDialect()
+	timestampSeconds := d.UnixSeconds("timestamp")
+
+	// 1m/1h 해상도: 원시 샘플과 집계 테이블의 행을 같은 버킷 단위로 합침
+	var combinedSQL string
+	var args []interface{}
+	switch resolution {
+	case ResolutionRaw:
+		args = rawArgs
+	case ResolutionMinute:
+		combinedSQL = `SELECT ` + d.TimeBucket(timestampSeconds, 60) + ` AS bucket, metric_type,
+		    value AS avg_value, value AS min_value, value AS max_value, 1 AS sample_count, value * value AS sum_squares
+		  FROM resource_logs WHERE timestamp >= ? AND timestamp <= ?` + metricFilter + `
+		  UNION ALL
+		  SELECT bucket, metric_type, avg_value, min_value, max_value, sample_count, sum_squares
+		  FROM resource_logs_1m WHERE bucket >= ? AND bucket <= ?` + metricFilter
+		args = append(append(args, rawArgs...), bucketArgs...)
+	default:
+		combinedSQL = `SELECT ` + d.TimeBucket(timestampSeconds, 3600) + ` AS bucket, metric_type,
+		    value AS avg_value, value AS min_value, value AS max_value, 1 AS sample_count, value * value AS sum_squares
+		  FROM resource_logs WHERE timestamp >= ? AND timestamp <= ?` + metricFilter + `
+		  UNION ALL
+		  SELECT ` + d.TimeBucket("bucket", 3600) + `, metric_type, avg_value, min_value, max_value, sample_count, sum_squares
+		  FROM resource_logs_1m WHERE bucket >= ? AND bucket <= ?` + metricFilter + `
+		  UNION ALL
+		  SELECT bucket, metric_type, avg_value, min_value, max_value, sample_count, sum_squares
+		  FROM resource_logs_1h WHERE bucket >= ? AND bucket <= ?` + metricFilter
+		args = append(append(append(args, rawArgs...), bucketArgs...), bucketArgs...)
+	}
+
+	if resolution != ResolutionRaw && query.Percentiles {
+		points, err := queryHistoryPercentiles(db, `SELECT bucket, metric_type, avg_value, min_value, max_value, sample_count, sum_squares
+		FROM (
+		  `+combinedSQL+`
+		) AS combined ORDER BY bucket ASC, metric_type ASC`, args)
+		return points, resolution, err
+	}
+
+	var sqlQuery string
+	if resolution == ResolutionRaw {
+		sqlQuery = `SELECT ` + timestampSeconds + ` AS bucket, metric_type, value, value, value, 1, value * value
+		FROM resource_logs WHERE timestamp >= ? AND timestamp <= ?` + metricFilter
+	} else {
+		sqlQuery = `SELECT bucket, metric_type, SUM(avg_value * sample_count) / SUM(sample_count), MIN(min_value), MAX(max_value),
+		  CAST(SUM(sample_count) AS BIGINT), SUM(sum_squares)
+		FROM (
+		  ` + combinedSQL + `
+		) AS combined GROUP BY bucket, metric_type`
+	}
+	sqlQuery += " ORDER BY bucket ASC, metric_type ASC"
+
+	rows, err := db.Query(rebind(sqlQuery), args...)
+	if err != nil {
+		return nil, resolution, err
+	}
+	defer rows.Close()
+
+	points := []ResourceHistoryPoint{}
+	for rows.Next() {
+		var bucket int64
+		var sumSquares float64
+		var point ResourceHistoryPoint
+		if err := rows.Scan(&bucket, &point.MetricType, &point.Avg, &point.Min, &point.Max, &point.Count, &sumSquares); err != nil {
+			return nil, resolution, err
+		}
+		point.Timestamp = time.Unix(bucket, 0).UTC()
+		point.StdDev = historyStdDev(point.Avg, sumSquares, point.Count)
+		if resolution == ResolutionRaw && query.Percentiles {
+			// 원시 해상도는 포인트 하나가 샘플 하나
+			p50, p95, p99 := point.Avg, point.Avg, point.Avg
+			point.P50, point.P95, point.P99 = &p50, &p95, &p99
+		}
+		points = append(points, point)
+	}
+
+	return points, resolution, rows.Err()
+}
+
+// weightedSample is a value that stands for weight samples when computing percentiles
+type weightedSample struct {
+	value  float64
+	weight int64
+}
+
+// queryHistoryPercentiles aggregates ungrouped rows into buckets in Go so that
+// percentiles can be computed (rows must be ordered by bucket and metric type)
+func queryHistoryPercentiles(db *sql.DB, sqlQuery string, args []interface{}) ([]ResourceHistoryPoint, error) {
+	rows, err := db.Query(rebind(sqlQuery), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := []ResourceHistoryPoint{}
+	var current *ResourceHistoryPoint
+	var currentBucket int64
+	var weightedSum, sumSquares float64
+	var samples []weightedSample
+
+	flush := func() {
+		if current == nil || current.Count <= 0 {
+			return
+		}
+		current.Avg = weightedSum / float64(current.Count)
+		current.StdDev = historyStdDev(current.Avg, sumSquares, current.Count)
+		current.P50, current.P95, current.P99 = weightedPercentiles(samples)
+		points = append(points, *current)
+	}
+
+	for rows.Next() {
+		var bucket, count int64
+		var metricType string
+		var avg, minValue, maxValue, squares float64
+		if err := rows.Scan(&bucket, &metricType, &avg, &minValue, &maxValue, &count, &squares); err != nil {
+			return nil, err
+		}
+		if current == nil || bucket != currentBucket || metricType != current.MetricType {
+			flush()
+			currentBucket = bucket
+			current = &ResourceHistoryPoint{Timestamp: time.Unix(bucket, 0).UTC(), MetricType: metricType, Min: minValue, Max: maxValue}
+			weightedSum, sumSquares = 0, 0
+			samples = samples[:0]
+		}
+		current.Min = min(current.Min, minValue)
+		current.Max = max(current.Max, maxValue)
+		current.Count += count
+		weightedSum += avg * float64(count)
+		sumSquares += squares
+		if count > 1 {
+			current.PercentilesApproximate = true
+		}
+		samples = append(samples, weightedSample{value: avg, weight: count})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return points, nil
+}
+
+// historyStdDev returns the population standard deviation from the mean and the sum of squares
+func historyStdDev(mean, sumSquares float64, count int64) float64 {
+	if count <= 1 {
+		return 0
+	}
+	// 부동소수점 오차로 분산이 음수가 되는 경우 방지
+	variance := sumSquares/float64(count) - mean*mean
+	if variance <= 0 {
+		return 0
+	}
+	return math.Sqrt(variance)
+}
+
+// weightedPercentiles returns the nearest-rank p50, p95 and p99 of weighted samples
+func weightedPercentiles(samples []weightedSample) (*float64, *float64, *float64) {
+	sort.Slice(samples, func(i, j int) bool { return samples[i].value < samples[j].value })
+	var total int64
+	for _, sample := range samples {
+		total += sample.weight
+	}
+
+	percentile := func(p float64) *float64 {
+		rank := int64(math.Ceil(p / 100 * float64(total)))
+		var cumulative int64
+		for _, sample := range samples {
+			cumulative += sample.weight
+			if cumulative >= rank {
+				value := sample.value
+				return &value
+			}
+		}
+		value := samples[len(samples)-1].value
+		return &value
+	}
+	return percentile(50), percentile(95), percentile(99)
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestResourceHistory(t *testing.T) {
+	hour := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minute, second int) time.Time {
+		return hour.Add(time.Duration(minute)*time.Minute + time.Duration(second)*time.Second)
+	}
+	insert := func(t *testing.T, conn *sql.DB, timestamps []time.Time, values []float64) {
+		t.Helper()
+		rows := make([]resourceLogRow, len(values))
+		for i := range values {
+			rows[i] = resourceLogRow{timestamp: timestamps[i], metricType: "cpu", value: values[i]}
+		}
+		if err := insertResourceLogRows(conn, rows); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history := func(t *testing.T, conn *sql.DB, resolution string) []ResourceHistoryPoint {
+		t.Helper()
+		points, _, err := GetResourceHistory(conn, ResourceHistoryQuery{
+			MetricTypes: []string{"cpu"}, Since: hour, Until: hour.Add(time.Hour - time.Second), Resolution: resolution,
+		})
+		if err != nil {
+			t.Fatalf("GetResourceHistory(%s) failed: %v", resolution, err)
+		}
+		return points
+	}
+	// 압축 전후 같은 버킷의 집계가 같아야 함 (원시 샘플로 계산한 값이 기준)
+	assertSamePoints := func(t *testing.T, label string, expected, got []ResourceHistoryPoint) {
+		t.Helper()
+		if len(got) != len(expected) {
+			t.Fatalf("%s: expected %d points, got %d (%+v)", label, len(expected), len(got), got)
+		}
+		for i := range expected {
+			e, g := expected[i], got[i]
+			if !g.Timestamp.Equal(e.Timestamp) || g.Count != e.Count || g.Min != e.Min || g.Max != e.Max ||
+				math.Abs(g.Avg-e.Avg) > 1e-9 || math.Abs(g.StdDev-e.StdDev) > 1e-9 {
+				t.Errorf("%s point %d: expected %+v, got %+v", label, i, e, g)
+			}
+		}
+	}
+
+	t.Run("Compaction_Boundary", func(t *testing.T) {
+		conn := openTestDB(t)
+		insert(t, conn,
+			[]time.Time{at(0, 10), at(0, 20), at(0, 30), at(1, 10), at(1, 40), at(2, 5)},
+			[]float64{10, 20, 30, 40, 60, 5})
+
+		minuteBefore := history(t, conn, ResolutionMinute)
+		hourBefore := history(t, conn, ResolutionHour)
+		if len(minuteBefore) != 3 || minuteBefore[0].Count != 3 || minuteBefore[0].Avg != 20 {
+			t.Fatalf("Unexpected minute history before compaction: %+v", minuteBefore)
+		}
+		if expected := math.Sqrt((100.0+400+900)/3 - 400); math.Abs(minuteBefore[0].StdDev-expected) > 1e-9 {
+			t.Errorf("Expected stddev %v for the first minute, got %v", expected, minuteBefore[0].StdDev)
+		}
+
+		// 경계는 분 단위로 내림되므로 12:01:30 → 12:01:00, 첫 1분만 압축되고 12:01 버킷은 나뉘지 않음
+		result, err := CompactResourceLogs(conn, at(1, 30), hour)
+		if err != nil {
+			t.Fatalf("CompactResourceLogs failed: %v", err)
+		}
+		if result.RawRowsCompacted != 3 || result.MinuteRowsCompacted != 0 {
+			t.Errorf("Unexpected compaction result: %+v", result)
+		}
+		assertSamePoints(t, "Minute after raw compaction", minuteBefore, history(t, conn, ResolutionMinute))
+		assertSamePoints(t, "Hour after raw compaction", hourBefore, history(t, conn, ResolutionHour))
+
+		// spill 재생 등으로 이미 압축된 분에 늦게 들어온 샘플은 기존 버킷에 합쳐져야 함
+		insert(t, conn, []time.Time{at(0, 50)}, []float64{40})
+		minuteBefore = history(t, conn, ResolutionMinute)
+		if minuteBefore[0].Count != 4 || minuteBefore[0].Avg != 25 || minuteBefore[0].Max != 40 {
+			t.Fatalf("Expected the late sample merged at query time, got %+v", minuteBefore[0])
+		}
+		if _, err := CompactResourceLogs(conn, at(1, 30), hour); err != nil {
+			t.Fatal(err)
+		}
+		assertSamePoints(t, "Minute after merging a late sample", minuteBefore, history(t, conn, ResolutionMinute))
+		if expected := math.Sqrt((100.0+400+900+1600)/4 - 625); math.Abs(history(t, conn, ResolutionMinute)[0].StdDev-expected) > 1e-9 {
+			t.Errorf("Expected the merged stddev %v", expected)
+		}
+
+		// 모든 원시 샘플을 1분으로, 1분 집계를 1시간으로 압축해도 시간 단위 결과는 같아야 함
+		hourBefore = history(t, conn, ResolutionHour)
+		result, err = CompactResourceLogs(conn, at(3, 0), hour.Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.RawRowsCompacted != 3 || result.MinuteRowsCompacted != 3 {
+			t.Errorf("Unexpected compaction result: %+v", result)
+		}
+		if count := countResourceLogs(t, conn); count != 0 {
+			t.Errorf("Expected no raw samples left, got %d", count)
+		}
+		assertSamePoints(t, "Hour after minute compaction", hourBefore, history(t, conn, ResolutionHour))
+		if hourBefore[0].Count != 7 || hourBefore[0].Min != 5 || hourBefore[0].Max != 60 {
+			t.Errorf("Unexpected hourly rollup: %+v", hourBefore[0])
+		}
+	})
+
+	t.Run("Percentiles_After_Compaction", func(t *testing.T) {
+		conn := openTestDB(t)
+		insert(t, conn, []time.Time{at(0, 1), at(0, 2), at(1, 1)}, []float64{10, 30, 50})
+		if _, err := CompactResourceLogs(conn, at(1, 0), hour); err != nil {
+			t.Fatal(err)
+		}
+
+		points, _, err := GetResourceHistory(conn, ResourceHistoryQuery{
+			MetricTypes: []string{"cpu"}, Since: hour, Until: hour.Add(time.Hour - time.Second),
+			Resolution: ResolutionHour, Percentiles: true,
+		})
+		if err != nil || len(points) != 1 {
+			t.Fatalf("Expected one hourly point, got %+v, %v", points, err)
+		}
+		point := points[0]
+		if point.Count != 3 || point.Avg != 30 || !point.PercentilesApproximate || point.P50 == nil {
+			t.Errorf("Unexpected percentile point: %+v", point)
+		}
+	})
+
+	t.Run("Resolve_Resolution", func(t *testing.T) {
+		cases := []struct {
+			requested string
+			span      time.Duration
+			expected  string
+		}{
+			{ResolutionAuto, 30 * time.Minute, ResolutionRaw},
+			{"", 24 * time.Hour, ResolutionMinute},
+			{ResolutionAuto, 7 * 24 * time.Hour, ResolutionHour},
+			{ResolutionRaw, 7 * 24 * time.Hour, ResolutionRaw},
+		}
+		for _, c := range cases {
+			if got := ResolveHistoryResolution(c.requested, hour, hour.Add(c.span)); got != c.expected {
+				t.Errorf("ResolveHistoryResolution(%q, %v) = %s; expected %s", c.requested, c.span, got, c.expected)
+			}
+		}
+	})
+}
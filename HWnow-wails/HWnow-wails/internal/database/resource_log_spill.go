@@ -0,0 +1,195 @@
+package db
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 자원 로그 디스크 임시 보관 (spill)
+// DB 파일이 잠기거나 디스크가 가득 차 기록에 실패한 행을 상한이 있는 파일에 덧붙여 두고,
+// 기록이 다시 성공하면 재생(replay)한 뒤 파일을 비움. 한 줄 = "unix_nano\tmetric_type\tvalue"
+// 기록 직후 fsync하므로 프로세스가 비정상 종료되어도 다음 실행 시 재생됨 (잘린 마지막 줄은 무시)
+// 재생 중에도 새 행을 덧붙일 수 있도록 파일 접근은 기록기 버퍼와 별도의 뮤텍스로 보호
+
+// resourceLogSpill is a bounded append-only file of resource log rows waiting to be written
+type resourceLogSpill struct {
+	mutex    sync.Mutex
+	path     string
+	maxBytes int64
+	size     int64
+}
+
+// newResourceLogSpill opens the spill state for path, picking up rows left by a previous run
+func newResourceLogSpill(path string, maxBytes int64) *resourceLogSpill {
+	spill := &resourceLogSpill{path: path, maxBytes: maxBytes}
+	if info, err := os.Stat(path); err == nil {
+		spill.size = info.Size()
+	}
+	return spill
+}
+
+// pending reports whether the spill file holds rows to replay
+func (s *resourceLogSpill) pending() bool {
+	return s.bytes() > 0
+}
+
+// bytes returns the current spill file size
+func (s *resourceLogSpill) bytes() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.size
+}
+
+// append writes rows until the size limit is reached and returns how many were stored
+func (s *resourceLogSpill) append(rows []resourceLogRow) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.appendLocked(rows)
+}
+
+func (s *resourceLogSpill) appendLocked(rows []resourceLogRow) (int, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	var builder strings.Builder
+	stored := 0
+	for _, row := range rows {
+		line := formatSpillLine(row)
+		if s.size+int64(builder.Len()+len(line)) > s.maxBytes {
+			break
+		}
+		builder.WriteString(line)
+		stored++
+	}
+	if stored == 0 {
+		return 0, nil
+	}
+
+	n, err := file.WriteString(builder.String())
+	s.size += int64(n)
+	if err != nil {
+		return 0, err
+	}
+	if err := file.Sync(); err != nil {
+		return stored, err
+	}
+	return stored, nil
+}
+
+// load reads all spilled rows, skipping malformed lines
+func (s *resourceLogSpill) load() ([]resourceLogRow, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.loadLocked()
+}
+
+func (s *resourceLogSpill) loadLocked() ([]resourceLogRow, error) {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		s.size = 0
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rows []resourceLogRow
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if row, ok := parseSpillLine(scanner.Text()); ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, scanner.Err()
+}
+
+// drop removes the first count rows (already replayed) while keeping rows appended since they were loaded
+func (s *resourceLogSpill) drop(count int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rows, err := s.loadLocked()
+	if err != nil {
+		return err
+	}
+	if count > len(rows) {
+		count = len(rows)
+	}
+	return s.replaceLocked(rows[count:])
+}
+
+// replaceLocked rewrites the spill file with the given rows (empty removes the file).
+// 임시 파일에 기록하고 fsync한 뒤 rename으로 교체하므로 중간에 종료되어도 이전 내용이나 새 내용 중 하나가 남음
+func (s *resourceLogSpill) replaceLocked(rows []resourceLogRow) error {
+	if len(rows) == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		s.size = 0
+		return nil
+	}
+
+	var builder strings.Builder
+	for _, row := range rows {
+		line := formatSpillLine(row)
+		if int64(builder.Len()+len(line)) > s.maxBytes {
+			break
+		}
+		builder.WriteString(line)
+	}
+
+	tempPath := s.path + ".tmp"
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = file.WriteString(builder.String()); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempPath, s.path)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	s.size = int64(builder.Len())
+	return nil
+}
+
+func formatSpillLine(row resourceLogRow) string {
+	return fmt.Sprintf("%d\t%s\t%s\n", row.timestamp.UnixNano(), row.metricType,
+		strconv.FormatFloat(row.value, 'g', -1, 64))
+}
+
+func parseSpillLine(line string) (resourceLogRow, bool) {
+	fields := strings.Split(line, "\t")
+	if len(fields) != 3 || fields[1] == "" {
+		return resourceLogRow{}, false
+	}
+	nanos, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return resourceLogRow{}, false
+	}
+	value, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return resourceLogRow{}, false
+	}
+	return resourceLogRow{timestamp: time.Unix(0, nanos), metricType: fields[1], value: value}, true
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+func TestResourceLogSpill(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	rowsAt := func(start, count int) []resourceLogRow {
+		rows := make([]resourceLogRow, 0, count)
+		for i := start; i < start+count; i++ {
+			rows = append(rows, resourceLogRow{timestamp: base.Add(time.Duration(i) * time.Second), metricType: "cpu", value: float64(i)})
+		}
+		return rows
+	}
+
+	t.Run("Append_Load_Round_Trip", func(t *testing.T) {
+		spill := newResourceLogSpill(filepath.Join(t.TempDir(), "spill.log"), 1<<20)
+		if stored, err := spill.append(rowsAt(0, 3)); err != nil || stored != 3 {
+			t.Fatalf("append = %d, %v; expected 3 rows", stored, err)
+		}
+
+		// 이전 실행이 남긴 파일도 크기를 읽어 재생 대상으로 인식해야 함
+		reopened := newResourceLogSpill(spill.path, 1<<20)
+		if !reopened.pending() {
+			t.Fatal("Expected a reopened spill file to be pending")
+		}
+		rows, err := reopened.load()
+		if err != nil || len(rows) != 3 {
+			t.Fatalf("load = %d rows, %v; expected 3", len(rows), err)
+		}
+		if !rows[2].timestamp.Equal(base.Add(2*time.Second)) || rows[2].metricType != "cpu" || rows[2].value != 2 {
+			t.Errorf("Unexpected row after round trip: %+v", rows[2])
+		}
+	})
+
+	t.Run("Size_Limit", func(t *testing.T) {
+		line := int64(len(formatSpillLine(rowsAt(0, 1)[0])))
+		spill := newResourceLogSpill(filepath.Join(t.TempDir(), "spill.log"), line*2)
+		if stored, _ := spill.append(rowsAt(0, 5)); stored != 2 {
+			t.Errorf("Expected 2 rows to fit under the size limit, stored %d", stored)
+		}
+	})
+
+	t.Run("Drop_Keeps_Appended_Rows", func(t *testing.T) {
+		spill := newResourceLogSpill(filepath.Join(t.TempDir(), "spill.log"), 1<<20)
+		spill.append(rowsAt(0, 4))
+		spill.append(rowsAt(4, 2)) // 재생 중 덧붙은 행
+
+		if err := spill.drop(4); err != nil {
+			t.Fatalf("drop failed: %v", err)
+		}
+		rows, _ := spill.load()
+		if len(rows) != 2 || rows[0].value != 4 || rows[1].value != 5 {
+			t.Errorf("Expected rows 4 and 5 to remain, got %+v", rows)
+		}
+		if _, err := os.Stat(spill.path + ".tmp"); !os.IsNotExist(err) {
+			t.Errorf("Expected the temporary file to be renamed away, stat err = %v", err)
+		}
+
+		if err := spill.drop(10); err != nil {
+			t.Fatalf("drop failed: %v", err)
+		}
+		if spill.pending() {
+			t.Error("Expected the spill to be empty after dropping every row")
+		}
+		if _, err := os.Stat(spill.path); !os.IsNotExist(err) {
+			t.Errorf("Expected an empty spill file to be removed, stat err = %v", err)
+		}
+	})
+
+	t.Run("Malformed_Lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill.log")
+		content := formatSpillLine(rowsAt(0, 1)[0]) + "garbage\n1\t\t3\n" + "17000000"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := newResourceLogSpill(path, 1<<20).load()
+		if err != nil || len(rows) != 1 {
+			t.Errorf("Expected only the valid line to load, got %d rows, %v", len(rows), err)
+		}
+	})
+
+	t.Run("Writer_Spills_Overflow_Snapshot", func(t *testing.T) {
+		writer := NewResourceLogWriter(nil, ResourceLogWriterConfig{SpillPath: filepath.Join(t.TempDir(), "spill.log")})
+		snapshot := &monitoring.ResourceSnapshot{
+			Timestamp: base,
+			Metrics:   []monitoring.Metric{{Type: "cpu", Value: 10}, {Type: "ram", Value: 20}},
+		}
+		if !writer.Spill(snapshot) {
+			t.Fatal("Expected the snapshot to be spilled")
+		}
+		if stats := writer.Stats(); stats.RowsSpilled != 2 || stats.SpillBytes == 0 {
+			t.Errorf("Unexpected stats after spilling: %+v", stats)
+		}
+
+		if NewResourceLogWriter(nil, ResourceLogWriterConfig{}).Spill(snapshot) {
+			t.Error("Expected Spill to report false without a spill path")
+		}
+	})
+}
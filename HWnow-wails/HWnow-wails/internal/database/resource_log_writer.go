@@ -0,0 +1,369 @@
+package db
+
+import (
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// 자원 모니터링 로그 기록기
+// 느린 디스크에서도 샘플링이 멈추지 않도록 트랜잭션 단위 일괄 삽입, 중복 지표 병합, 버퍼 상한을 적용
+// SpillPath가 설정되면 기록 실패/버퍼 초과 행은 폐기하지 않고 디스크에 보관했다가 DB 복구 후 재생
+// timestamp는 모든 테이블과 같이 UTC로 저장 (보관 기간 정리, 압축, 범위 조회가 같은 기준으로 비교되도록)
+// 이전 버전이 현지 시각으로 저장한 행은 마이그레이션 6에서 UTC로 변환
+
+// ResourceLogWriterConfig controls batching of resource_logs inserts
+type ResourceLogWriterConfig struct {
+	BatchSize       int           // 한 트랜잭션에 기록할 최대 행 수 (도달 시 즉시 기록)
+	FlushInterval   time.Duration // 주기적 기록 간격
+	MaxBufferedRows int           // 기록 지연 시 보관할 최대 행 수 (초과 시 오래된 행부터 폐기 또는 spill)
+	SpillPath       string        // 기록 실패 행을 보관할 파일 (빈 값 = 메모리에서만 재시도)
+	MaxSpillBytes   int64         // spill 파일 상한 (초과분은 폐기)
+}
+
+// ResourceLogWriterStats reports writer throughput and backpressure counters
+type ResourceLogWriterStats struct {
+	RowsWritten       int64         `json:"rows_written"`
+	RowsCoalesced     int64         `json:"rows_coalesced"`
+	RowsDropped       int64         `json:"rows_dropped"`
+	RowsSpilled       int64         `json:"rows_spilled"`
+	RowsReplayed      int64         `json:"rows_replayed"`
+	SpillBytes        int64         `json:"spill_bytes"`
+	Flushes           int64         `json:"flushes"`
+	FailedFlushes     int64         `json:"failed_flushes"`
+	BufferedRows      int           `json:"buffered_rows"`
+	LastFlushDuration time.Duration `json:"last_flush_duration"`
+}
+
+// DefaultResourceLogWriterConfig returns the default batching configuration
+func DefaultResourceLogWriterConfig() ResourceLogWriterConfig {
+	return ResourceLogWriterConfig{
+		BatchSize:       500,
+		FlushInterval:   5 * time.Second,
+		MaxBufferedRows: 20000,
+		MaxSpillBytes:   64 * 1024 * 1024,
+	}
+}
+
+// resourceLogKey identifies a metric sample; 같은 초에 들어온 동일 지표는 하나로 병합
+type resourceLogKey struct {
+	timestamp  int64
+	metricType string
+}
+
+type resourceLogRow struct {
+	timestamp  time.Time
+	metricType string
+	value      float64
+}
+
+// ResourceLogWriter batches resource snapshots into resource_logs transactions
+type ResourceLogWriter struct {
+	db     *sql.DB
+	config ResourceLogWriterConfig
+
+	mutex sync.Mutex
+	rows  []resourceLogRow
+	index map[resourceLogKey]int
+	stats ResourceLogWriterStats
+	spill *resourceLogSpill // nil = spill 비활성
+
+	replayMutex sync.Mutex // spill 재생은 한 번에 하나만
+}
+
+// NewResourceLogWriter creates a writer, filling unset config values with defaults
+func NewResourceLogWriter(db *sql.DB, config ResourceLogWriterConfig) *ResourceLogWriter {
+	defaults := DefaultResourceLogWriterConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.MaxBufferedRows < config.BatchSize {
+		config.MaxBufferedRows = config.BatchSize * 4
+	}
+	if config.MaxSpillBytes <= 0 {
+		config.MaxSpillBytes = defaults.MaxSpillBytes
+	}
+
+	writer := &ResourceLogWriter{
+		db:     db,
+		config: config,
+		index:  make(map[resourceLogKey]int),
+	}
+	if config.SpillPath != "" {
+		writer.spill = newResourceLogSpill(config.SpillPath, config.MaxSpillBytes)
+		if writer.spill.pending() {
+			log.Printf("Found resource log spill file %s, rows will be replayed", config.SpillPath)
+		}
+	}
+	return writer
+}
+
+// Run consumes snapshots until the channel is closed, flushing remaining rows before returning
+func (w *ResourceLogWriter) Run(snapshots <-chan *monitoring.ResourceSnapshot) {
+	ticker := time.NewTicker(w.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case snapshot, ok := <-snapshots:
+			if !ok || snapshot == nil {
+				w.Flush() // 채널이 닫히면 남은 데이터를 기록하고 종료
+				return
+			}
+			if w.add(snapshot) >= w.config.BatchSize {
+				w.Flush()
+			}
+		case <-ticker.C:
+			w.Flush()
+		}
+	}
+}
+
+// add buffers a snapshot and returns the number of buffered rows
+func (w *ResourceLogWriter) add(snapshot *monitoring.ResourceSnapshot) int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, metric := range snapshot.Metrics {
+		key := resourceLogKey{timestamp: snapshot.Timestamp.Unix(), metricType: metric.Type}
+		if i, exists := w.index[key]; exists {
+			w.rows[i].value = metric.Value // 최신 값으로 병합
+			w.stats.RowsCoalesced++
+			continue
+		}
+		w.index[key] = len(w.rows)
+		w.rows = append(w.rows, resourceLogRow{
+			timestamp:  snapshot.Timestamp,
+			metricType: metric.Type,
+			value:      metric.Value,
+		})
+	}
+
+	// 기록이 밀려 버퍼가 상한을 넘으면 오래된 행부터 spill (불가능하면 폐기)
+	if overflow := len(w.rows) - w.config.MaxBufferedRows; overflow > 0 {
+		w.spillRows(w.rows[:overflow])
+		w.rows = append(w.rows[:0:0], w.rows[overflow:]...)
+		w.rebuildIndex()
+	}
+
+	return len(w.rows)
+}
+
+func (w *ResourceLogWriter) rebuildIndex() {
+	w.index = make(map[resourceLogKey]int, len(w.rows))
+	for i, row := range w.rows {
+		w.index[resourceLogKey{timestamp: row.timestamp.Unix(), metricType: row.metricType}] = i
+	}
+}
+
+// Flush writes buffered rows in transactions of at most BatchSize rows
+func (w *ResourceLogWriter) Flush() {
+	w.mutex.Lock()
+	rows := w.rows
+	w.rows = nil
+	w.index = make(map[resourceLogKey]int)
+	w.mutex.Unlock()
+
+	if len(rows) == 0 {
+		w.replaySpill()
+		return
+	}
+
+	start := time.Now()
+	for offset := 0; offset < len(rows); offset += w.config.BatchSize {
+		end := offset + w.config.BatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		if err := insertResourceLogRows(w.db, rows[offset:end]); err != nil {
+			log.Printf("Failed to write resource logs: %v", err)
+			w.mutex.Lock()
+			w.stats.FailedFlushes++
+			if w.spill != nil {
+				w.spillRows(rows[offset:])
+				w.mutex.Unlock()
+			} else {
+				w.mutex.Unlock()
+				w.requeue(rows[offset:])
+			}
+			return
+		}
+
+		w.mutex.Lock()
+		w.stats.RowsWritten += int64(end - offset)
+		w.mutex.Unlock()
+	}
+
+	w.mutex.Lock()
+	w.stats.Flushes++
+	w.stats.LastFlushDuration = time.Since(start)
+	w.mutex.Unlock()
+
+	w.replaySpill()
+}
+
+// Spill stores a snapshot directly in the spill file when it cannot be queued for the writer.
+// spill이 비활성이면 false (호출자가 폐기 처리)
+func (w *ResourceLogWriter) Spill(snapshot *monitoring.ResourceSnapshot) bool {
+	if w.spill == nil || snapshot == nil {
+		return false
+	}
+
+	rows := make([]resourceLogRow, 0, len(snapshot.Metrics))
+	for _, metric := range snapshot.Metrics {
+		rows = append(rows, resourceLogRow{timestamp: snapshot.Timestamp, metricType: metric.Type, value: metric.Value})
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.spillRows(rows)
+	return true
+}
+
+// spillRows stores rows in the spill file, dropping what does not fit (caller holds the mutex)
+func (w *ResourceLogWriter) spillRows(rows []resourceLogRow) {
+	if w.spill == nil {
+		w.stats.RowsDropped += int64(len(rows))
+		log.Printf("Resource log buffer full, dropped %d oldest rows", len(rows))
+		return
+	}
+
+	stored, err := w.spill.append(rows)
+	if err != nil {
+		log.Printf("Failed to spill resource logs to %s: %v", w.spill.path, err)
+	}
+	w.stats.RowsSpilled += int64(stored)
+	if dropped := len(rows) - stored; dropped > 0 {
+		w.stats.RowsDropped += int64(dropped)
+		log.Printf("Resource log spill full, dropped %d rows", dropped)
+	}
+}
+
+// replaySpill writes spilled rows back to the database once writes succeed again.
+// DB 기록 중에는 버퍼 뮤텍스를 잡지 않으므로 재생 중에도 샘플 추가와 spill이 막히지 않음
+func (w *ResourceLogWriter) replaySpill() {
+	if w.spill == nil || !w.spill.pending() {
+		return
+	}
+	w.replayMutex.Lock()
+	defer w.replayMutex.Unlock()
+
+	rows, err := w.spill.load()
+	if err != nil {
+		log.Printf("Failed to read resource log spill %s: %v", w.spill.path, err)
+		return
+	}
+
+	// 샘플링이 오래 멈추지 않도록 한 번에 버퍼 상한만큼만 재생하고 나머지는 다음 flush로 미룸
+	limit := len(rows)
+	if limit > w.config.MaxBufferedRows {
+		limit = w.config.MaxBufferedRows
+	}
+
+	replayed := 0
+	for offset := 0; offset < limit; offset += w.config.BatchSize {
+		end := offset + w.config.BatchSize
+		if end > limit {
+			end = limit
+		}
+		if err := insertResourceLogRows(w.db, rows[offset:end]); err != nil {
+			log.Printf("Resource log spill replay interrupted: %v", err)
+			break
+		}
+		replayed += end - offset
+	}
+
+	// 이미 기록된 행이 다시 재생되지 않도록 앞부분만 제거 (재생 중 덧붙은 행은 유지)
+	if replayed > 0 {
+		if err := w.spill.drop(replayed); err != nil {
+			log.Printf("Failed to rewrite resource log spill %s: %v", w.spill.path, err)
+		}
+		log.Printf("Replayed %d spilled resource log rows (%d remaining)", replayed, len(rows)-replayed)
+	}
+
+	w.mutex.Lock()
+	w.stats.RowsReplayed += int64(replayed)
+	w.mutex.Unlock()
+}
+
+// requeue puts rows that failed to write back in front of the buffer (respecting MaxBufferedRows)
+func (w *ResourceLogWriter) requeue(failed []resourceLogRow) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.rows = append(append([]resourceLogRow{}, failed...), w.rows...)
+	if overflow := len(w.rows) - w.config.MaxBufferedRows; overflow > 0 {
+		w.rows = w.rows[overflow:]
+		w.stats.RowsDropped += int64(overflow)
+	}
+	w.rebuildIndex()
+}
+
+// Stats returns a copy of the writer statistics
+func (w *ResourceLogWriter) Stats() ResourceLogWriterStats {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	stats := w.stats
+	stats.BufferedRows = len(w.rows)
+	if w.spill != nil {
+		stats.SpillBytes = w.spill.bytes()
+	}
+	return stats
+}
+
+// convertResourceLogTimestampsToUTC rewrites resource_logs rows stored in local time by earlier versions (migration 6).
+// SQLite 드라이버는 time.Time을 "2006-01-02 15:04:05.999999999 -0700 MST" 형식 문자열로 저장하므로
+// 오프셋을 읽어 UTC로 옮기고 같은 형식(+0000 UTC)으로 다시 기록. 서버 DB는 처음부터 UTC로 기록되어 변환할 행이 없음
+func convertResourceLogTimestampsToUTC(tx schemaExecer) error {
+	if CurrentDialect().Name() != DriverSQLite {
+		return nil
+	}
+
+	// rest = 초 이후 부분 (".123 +0900 KST m=+1.5" 또는 " +0900 KST"), offset = "+0900"
+	rest := "substr(timestamp, 20)"
+	space := "instr(" + rest + ", ' ')"
+	offset := "substr(" + rest + ", " + space + " + 1, 5)"
+	fraction := "substr(" + rest + ", 1, " + space + " - 1)"
+	minutes := "((CAST(substr(" + offset + ", 2, 2) AS INTEGER) * 60 + CAST(substr(" + offset + ", 4, 2) AS INTEGER))" +
+		" * (CASE substr(" + offset + ", 1, 1) WHEN '-' THEN -1 ELSE 1 END))"
+
+	_, err := tx.Exec(`UPDATE resource_logs
+		SET timestamp = strftime('%Y-%m-%d %H:%M:%S', substr(timestamp, 1, 19), printf('%+d minutes', -` + minutes + `))
+			|| ` + fraction + ` || ' +0000 UTC'
+		WHERE typeof(timestamp) = 'text'
+		  AND timestamp NOT LIKE '% +0000 UTC'
+		  AND ` + offset + ` GLOB '[+-][0-9][0-9][0-9][0-9]'`)
+	return err
+}
+
+// insertResourceLogRows inserts rows in a single transaction
+func insertResourceLogRows(db *sql.DB, rows []resourceLogRow) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(rebind("INSERT INTO resource_logs (timestamp, metric_type, value) VALUES (?, ?, ?)"))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(row.timestamp.UTC(), row.metricType, row.value); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// openTestDB creates a migrated SQLite database in a temporary directory
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	conn, err := InitDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func countResourceLogs(t *testing.T, conn *sql.DB) int {
+	t.Helper()
+	var count int
+	if err := conn.QueryRow("SELECT COUNT(*) FROM resource_logs").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func testSnapshot(at time.Time, values ...float64) *monitoring.ResourceSnapshot {
+	snapshot := &monitoring.ResourceSnapshot{Timestamp: at}
+	for i, value := range values {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: []string{"cpu", "ram", "disk"}[i%3], Value: value})
+	}
+	return snapshot
+}
+
+func TestResourceLogWriter(t *testing.T) {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Batching_And_Coalescing", func(t *testing.T) {
+		conn := openTestDB(t)
+		writer := NewResourceLogWriter(conn, ResourceLogWriterConfig{BatchSize: 4, FlushInterval: time.Hour, MaxBufferedRows: 100})
+
+		writer.add(testSnapshot(base, 10, 20))
+		// 같은 초의 같은 지표는 최신 값으로 병합
+		writer.add(testSnapshot(base.Add(300*time.Millisecond), 15, 25))
+		buffered := writer.add(testSnapshot(base.Add(time.Second), 30, 40, 50))
+		if buffered != 5 {
+			t.Fatalf("Expected 5 buffered rows after coalescing, got %d", buffered)
+		}
+
+		writer.Flush()
+		stats := writer.Stats()
+		if stats.RowsWritten != 5 || stats.RowsCoalesced != 2 || stats.Flushes != 1 || stats.BufferedRows != 0 {
+			t.Errorf("Unexpected stats: %+v", stats)
+		}
+		if count := countResourceLogs(t, conn); count != 5 {
+			t.Errorf("Expected 5 rows in resource_logs, got %d", count)
+		}
+
+		var value float64
+		conn.QueryRow("SELECT value FROM resource_logs WHERE metric_type = 'cpu' ORDER BY id LIMIT 1").Scan(&value)
+		if value != 15 {
+			t.Errorf("Expected the coalesced cpu value 15, got %v", value)
+		}
+	})
+
+	t.Run("Run_Flushes_At_Batch_Size_And_Close", func(t *testing.T) {
+		conn := openTestDB(t)
+		writer := NewResourceLogWriter(conn, ResourceLogWriterConfig{BatchSize: 2, FlushInterval: time.Hour})
+		snapshots := make(chan *monitoring.ResourceSnapshot, 4)
+		snapshots <- testSnapshot(base, 1, 2)
+		snapshots <- testSnapshot(base.Add(time.Second), 3)
+		close(snapshots)
+
+		writer.Run(snapshots)
+		if count := countResourceLogs(t, conn); count != 3 {
+			t.Errorf("Expected every row written once the channel closed, got %d", count)
+		}
+		if stats := writer.Stats(); stats.Flushes != 2 {
+			t.Errorf("Expected a batch flush and a final flush, got %+v", stats)
+		}
+	})
+
+	t.Run("Max_Buffered_Rows", func(t *testing.T) {
+		cases := []struct {
+			name      string
+			spillPath bool
+			dropped   int64
+			spilled   int64
+		}{
+			{"Drop_Without_Spill", false, 3, 0},
+			{"Spill_Overflow", true, 0, 3},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				config := ResourceLogWriterConfig{BatchSize: 2, FlushInterval: time.Hour, MaxBufferedRows: 5}
+				if c.spillPath {
+					config.SpillPath = filepath.Join(t.TempDir(), "spill.log")
+				}
+				writer := NewResourceLogWriter(nil, config)
+				for i := 0; i < 8; i++ {
+					writer.add(testSnapshot(base.Add(time.Duration(i)*time.Second), float64(i)))
+				}
+				stats := writer.Stats()
+				if stats.BufferedRows != 5 || stats.RowsDropped != c.dropped || stats.RowsSpilled != c.spilled {
+					t.Errorf("Unexpected stats: %+v", stats)
+				}
+			})
+		}
+	})
+
+	t.Run("Spill_Then_Replay", func(t *testing.T) {
+		conn := openTestDB(t)
+		spillPath := filepath.Join(t.TempDir(), "spill.log")
+		config := ResourceLogWriterConfig{BatchSize: 2, FlushInterval: time.Hour, MaxBufferedRows: 10, SpillPath: spillPath}
+		writer := NewResourceLogWriter(conn, config)
+
+		// 테이블을 치워 기록이 실패하게 만들면 행은 spill 파일로 이동
+		if _, err := conn.Exec("ALTER TABLE resource_logs RENAME TO resource_logs_offline"); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			writer.add(testSnapshot(base.Add(time.Duration(i)*time.Second), float64(i), float64(i+10)))
+		}
+		writer.Flush()
+		stats := writer.Stats()
+		if stats.FailedFlushes != 1 || stats.RowsSpilled != 6 || stats.SpillBytes == 0 || stats.BufferedRows != 0 {
+			t.Fatalf("Expected the failed batch to be spilled, got %+v", stats)
+		}
+
+		if _, err := conn.Exec("ALTER TABLE resource_logs_offline RENAME TO resource_logs"); err != nil {
+			t.Fatal(err)
+		}
+
+		// 새 실행에서도 남은 파일을 찾아 재생
+		restarted := NewResourceLogWriter(conn, config)
+		restarted.add(testSnapshot(base.Add(time.Minute), 99))
+		restarted.Flush()
+
+		stats = restarted.Stats()
+		if stats.RowsReplayed != 6 || stats.SpillBytes != 0 || stats.RowsWritten != 1 {
+			t.Errorf("Expected all spilled rows replayed, got %+v", stats)
+		}
+		if count := countResourceLogs(t, conn); count != 7 {
+			t.Errorf("Expected 7 rows after replay, got %d", count)
+		}
+
+		// 재생된 행이 두 번 기록되지 않아야 함
+		restarted.Flush()
+		if count := countResourceLogs(t, conn); count != 7 {
+			t.Errorf("Expected replay to run once, got %d rows", count)
+		}
+	})
+}
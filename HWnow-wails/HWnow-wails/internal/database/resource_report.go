@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+)
+
+// 리포트용 기간 요약 (평균/최고/최저)
+// 원시 샘플과 1분/1시간 집계를 모두 포함하도록 1시간 단위로 조회한 뒤 표본 수로 가중 평균
+
+// MetricSummary summarizes one metric over a period
+type MetricSummary struct {
+	MetricType string  `json:"metricType"`
+	Avg        float64 `json:"avg"`
+	Min        float64 `json:"min"`
+	Peak       float64 `json:"peak"`
+	Samples    int64   `json:"samples"`
+}
+
+// GetResourceSummary returns avg/min/peak of the given metrics between since and until
+func GetResourceSummary(db *sql.DB, metricTypes []string, since, until time.Time) ([]MetricSummary, error) {
+	points, _, err := GetResourceHistory(db, ResourceHistoryQuery{
+		MetricTypes: metricTypes,
+		Since:       since,
+		Until:       until,
+		Resolution:  ResolutionHour,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return SummarizeResourceHistory(points), nil
+}
+
+// SummarizeResourceHistory folds history points into one summary per metric (sorted by metric type)
+func SummarizeResourceHistory(points []ResourceHistoryPoint) []MetricSummary {
+	summaries := make(map[string]*MetricSummary)
+	weightedSums := make(map[string]float64)
+
+	for _, point := range points {
+		if point.Count <= 0 {
+			continue
+		}
+		summary, exists := summaries[point.MetricType]
+		if !exists {
+			summary = &MetricSummary{MetricType: point.MetricType, Min: point.Min, Peak: point.Max}
+			summaries[point.MetricType] = summary
+		}
+		if point.Min < summary.Min {
+			summary.Min = point.Min
+		}
+		if point.Max > summary.Peak {
+			summary.Peak = point.Max
+		}
+		summary.Samples += point.Count
+		weightedSums[point.MetricType] += point.Avg * float64(point.Count)
+	}
+
+	result := make([]MetricSummary, 0, len(summaries))
+	for metricType, summary := range summaries {
+		summary.Avg = weightedSums[metricType] / float64(summary.Samples)
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].MetricType < result[j].MetricType })
+	return result
+}
@@ -0,0 +1,143 @@
+package db
+
+import "fmt"
+
+// PostgreSQL/MySQL 기본 스키마 (마이그레이션 1)
+// SQLite 기본 스키마와 같은 테이블/컬럼이지만 서버 DB에 맞는 타입 사용
+// (키 컬럼은 MySQL이 TEXT에 인덱스를 만들 수 없으므로 VARCHAR, 실수는 배정밀도, Unix 초는 BIGINT)
+
+// serverColumnTypes holds the column types of one server dialect
+type serverColumnTypes struct {
+	id        string // 자동 증가 기본 키
+	key       string // 기본 키/인덱스에 쓰이는 문자열
+	timestamp string
+	float     string
+	boolean   string
+}
+
+func serverTypesFor(dialect Dialect) serverColumnTypes {
+	if dialect.Name() == DriverMySQL {
+		return serverColumnTypes{
+			id:        "BIGINT AUTO_INCREMENT PRIMARY KEY",
+			key:       "VARCHAR(191)", // utf8mb4 인덱스 길이 제한 (767 bytes)
+			timestamp: "DATETIME(6)",
+			float:     "DOUBLE",
+			boolean:   "BOOLEAN",
+		}
+	}
+	return serverColumnTypes{
+		id:        "BIGSERIAL PRIMARY KEY",
+		key:       "VARCHAR(255)",
+		timestamp: "TIMESTAMP",
+		float:     "DOUBLE PRECISION",
+		boolean:   "BOOLEAN",
+	}
+}
+
+// createInitialSchema creates the baseline schema for the active dialect
+func createInitialSchema(tx schemaExecer) error {
+	dialect := CurrentDialect()
+	if dialect.Name() == DriverSQLite {
+		return createBaselineSchema(tx)
+	}
+	return createServerBaselineSchema(tx, dialect)
+}
+
+// createServerBaselineSchema creates every table on PostgreSQL or MySQL
+func createServerBaselineSchema(tx schemaExecer, dialect Dialect) error {
+	t := serverTypesFor(dialect)
+
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS pages (
+		  page_id %[1]s NOT NULL,
+		  user_id %[1]s NOT NULL,
+		  page_name %[1]s NOT NULL,
+		  page_order INTEGER DEFAULT 0,
+		  created_at %[2]s DEFAULT CURRENT_TIMESTAMP,
+		  updated_at %[2]s DEFAULT CURRENT_TIMESTAMP,
+		  PRIMARY KEY (user_id, page_id)
+		)`, t.key, t.timestamp),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS widget_states (
+		  user_id %[1]s NOT NULL,
+		  page_id %[1]s NOT NULL,
+		  widget_id %[1]s NOT NULL,
+		  widget_type %[1]s NOT NULL,
+		  config TEXT,
+		  layout TEXT,
+		  created_at %[2]s DEFAULT CURRENT_TIMESTAMP,
+		  updated_at %[2]s DEFAULT CURRENT_TIMESTAMP,
+		  PRIMARY KEY (user_id, page_id, widget_id)
+		)`, t.key, t.timestamp),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS resource_logs (
+		  id %s,
+		  timestamp %s NOT NULL,
+		  metric_type %s,
+		  value %s
+		)`, t.id, t.timestamp, t.key, t.float),
+		"CREATE INDEX idx_resource_logs_timestamp ON resource_logs (timestamp, metric_type)",
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS events (
+		  id %s,
+		  timestamp %s NOT NULL,
+		  category %s NOT NULL,
+		  action %s NOT NULL,
+		  target TEXT,
+		  success %s NOT NULL DEFAULT TRUE,
+		  message TEXT,
+		  details TEXT
+		)`, t.id, t.timestamp, t.key, t.key, t.boolean),
+		"CREATE INDEX idx_events_timestamp ON events (timestamp)",
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS app_sessions (
+		  id %s,
+		  started_at BIGINT NOT NULL,
+		  last_seen BIGINT NOT NULL,
+		  stopped_at BIGINT,
+		  boot_time BIGINT NOT NULL
+		)`, t.id),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS host_reboots (
+		  boot_time BIGINT PRIMARY KEY,
+		  detected_at BIGINT NOT NULL,
+		  last_seen_before BIGINT NOT NULL,
+		  clean_stop %s NOT NULL DEFAULT FALSE
+		)`, t.boolean),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS watched_processes (
+		  id %s,
+		  name %s NOT NULL,
+		  restart_command TEXT NOT NULL,
+		  max_cpu_percent %s NOT NULL DEFAULT 0,
+		  max_memory_mb %s NOT NULL DEFAULT 0,
+		  enabled %s NOT NULL DEFAULT TRUE,
+		  created_at %s NOT NULL
+		)`, t.id, t.key, t.float, t.float, t.boolean, t.timestamp),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS process_snapshots (
+		  timestamp %s NOT NULL,
+		  name %s NOT NULL,
+		  process_count INTEGER NOT NULL,
+		  cpu_percent %s NOT NULL,
+		  memory_rss %s NOT NULL
+		)`, t.timestamp, t.key, t.float, t.float),
+		"CREATE INDEX idx_process_snapshots_timestamp ON process_snapshots (timestamp)",
+	}
+	for _, table := range []string{"resource_logs_1m", "resource_logs_1h"} {
+		statements = append(statements, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		  bucket BIGINT NOT NULL,
+		  metric_type %s NOT NULL,
+		  avg_value %s NOT NULL,
+		  min_value %s NOT NULL,
+		  max_value %s NOT NULL,
+		  sample_count BIGINT NOT NULL,
+		  PRIMARY KEY (bucket, metric_type)
+		)`, table, t.key, t.float, t.float, t.float))
+	}
+
+	// 기본 페이지
+	statements = append(statements, dialect.InsertIgnore(
+		"INSERT INTO pages (page_id, user_id, page_name, page_order) VALUES ('main-page', 'global-user', 'Main Page', 0)",
+		"user_id", "page_id"))
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
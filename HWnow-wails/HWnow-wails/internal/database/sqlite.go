@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"math"
 	"HWnow-wails/internal/monitoring"
 	"os"
 	"path/filepath"
@@ -25,7 +26,7 @@ func EnsureDB(dbPath, dbFile string) (string, error) {
 
 // InitDB는 데이터베이스 연결을 초기화하고 필요한 테이블을 생성합니다.
 func InitDB(dataSourceName string) (*sql.DB, error) {
-	db, err := sql.Open("sqlite", dataSourceName+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	db, err := sql.Open("sqlite", dataSourceName+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)")
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +35,18 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 		return nil, err
 	}
 
+	// 적용되지 않은 스키마 마이그레이션 실행
+	setDialect(sqliteDialect{})
+	if err = Migrate(db); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// createBaselineSchema는 스키마 버전 관리 도입 이전의 전체 스키마를 생성합니다 (마이그레이션 1).
+// 기존 데이터베이스에도 안전하도록 모든 구문은 IF NOT EXISTS / 중복 컬럼 무시로 작성되어 있습니다.
+func createBaselineSchema(db schemaExecer) error {
 	// pages 테이블 생성
 	createPagesTableSQL := `
 	CREATE TABLE IF NOT EXISTS pages (
@@ -46,8 +59,8 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 		PRIMARY KEY (user_id, page_id)
 	);`
 
-	if _, err = db.Exec(createPagesTableSQL); err != nil {
-		return nil, err
+	if _, err := db.Exec(createPagesTableSQL); err != nil {
+		return err
 	}
 
 	// widget_states 테이블 생성
@@ -64,12 +77,12 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 		PRIMARY KEY (user_id, page_id, widget_id)
 	);`
 
-	if _, err = db.Exec(createTableSQL); err != nil {
-		return nil, err
+	if _, err := db.Exec(createTableSQL); err != nil {
+		return err
 	}
 
 	// 기존 테이블에 page_id, config, layout 컬럼이 없으면 추가 (마이그레이션)
-	_, err = db.Exec("ALTER TABLE widget_states ADD COLUMN page_id TEXT DEFAULT 'main-page'")
+	_, err := db.Exec("ALTER TABLE widget_states ADD COLUMN page_id TEXT DEFAULT 'main-page'")
 	if err != nil && !strings.Contains(err.Error(), "duplicate column name") {
 		log.Printf("Warning: Could not add page_id column: %v", err)
 	}
@@ -100,11 +113,55 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 	  metric_type TEXT,
 	  value REAL
 	);`
-	if _, err = db.Exec(createResourceLogsTableSQL); err != nil {
-		return nil, err
+	if _, err := db.Exec(createResourceLogsTableSQL); err != nil {
+		return err
 	}
 
-	return db, nil
+	if _, err := db.Exec("CREATE INDEX IF NOT EXISTS idx_resource_logs_timestamp ON resource_logs (timestamp, metric_type)"); err != nil {
+		log.Printf("Warning: Could not create resource_logs timestamp index: %v", err)
+	}
+
+	// 장기 이력용 1분/1시간 집계 테이블 생성
+	if err := createResourceAggregateTables(db); err != nil {
+		return err
+	}
+
+	// events 테이블 생성 (프로세스 제어, 알림, 설정 변경 감사 기록)
+	createEventsTableSQL := `
+	CREATE TABLE IF NOT EXISTS events (
+	  id INTEGER PRIMARY KEY AUTOINCREMENT,
+	  timestamp DATETIME NOT NULL,
+	  category TEXT NOT NULL,
+	  action TEXT NOT NULL,
+	  target TEXT,
+	  success INTEGER NOT NULL DEFAULT 1,
+	  message TEXT,
+	  details TEXT
+	);`
+	if _, err := db.Exec(createEventsTableSQL); err != nil {
+		return err
+	}
+
+	if _, err := db.Exec("CREATE INDEX IF NOT EXISTS idx_events_timestamp ON events (timestamp)"); err != nil {
+		log.Printf("Warning: Could not create events timestamp index: %v", err)
+	}
+
+	// 가용성 추적용 실행 구간/재부팅 테이블 생성
+	if err := createAvailabilityTables(db); err != nil {
+		return err
+	}
+
+	// 프로세스 감시 목록 테이블 생성
+	if err := createWatchedProcessesTable(db); err != nil {
+		return err
+	}
+
+	// 프로세스 목록 스냅샷 테이블 생성
+	if err := createProcessSnapshotsTable(db); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 type WidgetState struct {
@@ -114,6 +171,7 @@ type WidgetState struct {
 	WidgetType string `json:"widgetType"`
 	Config     string `json:"config"`
 	Layout     string `json:"layout"`
+	Version    int    `json:"version"` // 위젯 상태 형식 버전 (0 = 알 수 없음)
 }
 
 type Page struct {
@@ -126,10 +184,10 @@ type Page struct {
 func GetWidgets(db *sql.DB, userID, pageID string) ([]WidgetState, error) {
 	monitoring.LogWidgetInfo("GetWidgets: Starting widget load", "user", userID, "page", pageID)
 
-	query := "SELECT page_id, widget_id, widget_type, config, layout FROM widget_states WHERE user_id = ? AND page_id = ?"
+	query := "SELECT page_id, widget_id, widget_type, config, layout, state_version FROM widget_states WHERE user_id = ? AND page_id = ?"
 	monitoring.LogWidgetDebug("GetWidgets: Executing query", "query", query, "user", userID, "page", pageID)
 
-	rows, err := db.Query(query, userID, pageID)
+	rows, err := db.Query(rebind(query), userID, pageID)
 	if err != nil {
 		monitoring.LogWidgetError("GetWidgets: Query failed", "user", userID, "page", pageID, "error", err)
 		return nil, err
@@ -144,7 +202,7 @@ func GetWidgets(db *sql.DB, userID, pageID string) ([]WidgetState, error) {
 		w.UserID = userID
 		var config, layout sql.NullString
 		var pageID sql.NullString
-		if err := rows.Scan(&pageID, &w.WidgetID, &w.WidgetType, &config, &layout); err != nil {
+		if err := rows.Scan(&pageID, &w.WidgetID, &w.WidgetType, &config, &layout, &w.Version); err != nil {
 			monitoring.LogWidgetError("GetWidgets: Failed to scan widget row", "row", widgetCount, "error", err)
 			return nil, err
 		}
@@ -189,7 +247,7 @@ func SaveWidgets(db *sql.DB, widgets []WidgetState) error {
 	}
 
 	// 1단계: 해당 페이지의 모든 기존 위젯 삭제 (완전 교체를 위해)
-	deleteStmt, err := tx.Prepare("DELETE FROM widget_states WHERE user_id = ? AND page_id = ?")
+	deleteStmt, err := tx.Prepare(rebind("DELETE FROM widget_states WHERE user_id = ? AND page_id = ?"))
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -206,10 +264,10 @@ func SaveWidgets(db *sql.DB, widgets []WidgetState) error {
 	log.Printf("[DB] SaveWidgets: Deleted %d existing widgets for page %s", deletedCount, pageID)
 
 	// 2단계: 새로운 위젯들 삽입
-	insertStmt, err := tx.Prepare(`
-		INSERT INTO widget_states (user_id, page_id, widget_id, widget_type, config, layout, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-	`)
+	insertStmt, err := tx.Prepare(rebind(`
+		INSERT INTO widget_states (user_id, page_id, widget_id, widget_type, config, layout, state_version, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`))
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -217,7 +275,7 @@ func SaveWidgets(db *sql.DB, widgets []WidgetState) error {
 	defer insertStmt.Close()
 
 	for i, w := range widgets {
-		_, err := insertStmt.Exec(w.UserID, w.PageID, w.WidgetID, w.WidgetType, w.Config, w.Layout)
+		_, err := insertStmt.Exec(w.UserID, w.PageID, w.WidgetID, w.WidgetType, w.Config, w.Layout, w.Version)
 		if err != nil {
 			log.Printf("[DB] SaveWidgets: Failed to insert widget %d (id=%s): %v", i+1, w.WidgetID, err)
 			tx.Rollback()
@@ -243,7 +301,7 @@ func DeleteWidget(db *sql.DB, userID, pageID, widgetID string) error {
 	VerifyWidgetDeletion(db, userID, pageID, widgetID)
 	
 	query := "DELETE FROM widget_states WHERE user_id = ? AND page_id = ? AND widget_id = ?"
-	result, err := db.Exec(query, userID, pageID, widgetID)
+	result, err := db.Exec(rebind(query), userID, pageID, widgetID)
 	
 	if err != nil {
 		log.Printf("[DB] DeleteWidget: Failed to delete widget %s: %v", widgetID, err)
@@ -269,7 +327,7 @@ func DeleteWidget(db *sql.DB, userID, pageID, widgetID string) error {
 // Page management functions
 func GetPages(db *sql.DB, userID string) ([]Page, error) {
 	query := "SELECT page_id, page_name, page_order FROM pages WHERE user_id = ? ORDER BY page_order"
-	rows, err := db.Query(query, userID)
+	rows, err := db.Query(rebind(query), userID)
 	if err != nil {
 		return nil, err
 	}
@@ -290,13 +348,13 @@ func GetPages(db *sql.DB, userID string) ([]Page, error) {
 func CreatePage(db *sql.DB, userID, pageID, pageName string) error {
 	// Get the highest page_order for this user
 	var maxOrder int
-	err := db.QueryRow("SELECT COALESCE(MAX(page_order), -1) FROM pages WHERE user_id = ?", userID).Scan(&maxOrder)
+	err := db.QueryRow(rebind("SELECT COALESCE(MAX(page_order), -1) FROM pages WHERE user_id = ?"), userID).Scan(&maxOrder)
 	if err != nil {
 		return err
 	}
 
 	query := `INSERT INTO pages (page_id, user_id, page_name, page_order) VALUES (?, ?, ?, ?)`
-	_, err = db.Exec(query, pageID, userID, pageName, maxOrder+1)
+	_, err = db.Exec(rebind(query), pageID, userID, pageName, maxOrder+1)
 	return err
 }
 
@@ -307,14 +365,14 @@ func DeletePage(db *sql.DB, userID, pageID string) error {
 	}
 
 	// Delete all widgets in this page first
-	_, err = tx.Exec("DELETE FROM widget_states WHERE user_id = ? AND page_id = ?", userID, pageID)
+	_, err = tx.Exec(rebind("DELETE FROM widget_states WHERE user_id = ? AND page_id = ?"), userID, pageID)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 
 	// Delete the page
-	_, err = tx.Exec("DELETE FROM pages WHERE user_id = ? AND page_id = ?", userID, pageID)
+	_, err = tx.Exec(rebind("DELETE FROM pages WHERE user_id = ? AND page_id = ?"), userID, pageID)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -325,68 +383,134 @@ func DeletePage(db *sql.DB, userID, pageID string) error {
 
 func UpdatePageName(db *sql.DB, userID, pageID, newName string) error {
 	query := "UPDATE pages SET page_name = ?, updated_at = CURRENT_TIMESTAMP WHERE user_id = ? AND page_id = ?"
-	_, err := db.Exec(query, newName, userID, pageID)
+	_, err := db.Exec(rebind(query), newName, userID, pageID)
 	return err
 }
 
-// BatchInsertResourceLogs는 수집된 자원 모니터링 데이터를 일괄 삽입합니다.
-func BatchInsertResourceLogs(snapshots <-chan *monitoring.ResourceSnapshot, db *sql.DB) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
-
-	buffer := make([]*monitoring.ResourceSnapshot, 0, 10)
-
-	for {
-		select {
-		case snapshot := <-snapshots:
-			if snapshot == nil {
-				return // 채널이 닫히면 고루틴 종료
-			}
-			buffer = append(buffer, snapshot)
-		case <-ticker.C:
-			if len(buffer) == 0 {
-				continue
-			}
-
-			tx, err := db.Begin()
-			if err != nil {
-				log.Printf("Failed to begin transaction for logs: %v", err)
-				continue
-			}
-
-			stmt, err := tx.Prepare("INSERT INTO resource_logs (timestamp, metric_type, value) VALUES (?, ?, ?)")
-			if err != nil {
-				log.Printf("Failed to prepare statement for logs: %v", err)
-				tx.Rollback()
-				continue
-			}
-
-			var failed bool
-			for _, snapshot := range buffer {
-				for _, metric := range snapshot.Metrics {
-					if _, err := stmt.Exec(snapshot.Timestamp, metric.Type, metric.Value); err != nil {
-						log.Printf("Failed to execute statement for logs: %v", err)
-						failed = true
-						break
-					}
-				}
-				if failed {
-					break
-				}
-			}
+// Event categories recorded in the events table
+const (
+	EventCategoryProcessControl = "process_control"
+	EventCategoryAlert          = "alert"
+	EventCategoryConfig         = "config"
+	EventCategoryHardware       = "hardware"
+	EventCategoryPower          = "power"
+	EventCategoryNetwork        = "network"
+	EventCategoryDisk           = "disk"
+	EventCategoryWatchdog       = "watchdog"
+	EventCategoryStressTest     = "stress_test"
+)
 
-			if failed {
-				tx.Rollback()
-			} else {
-				if err := tx.Commit(); err != nil {
-					log.Printf("Failed to commit transaction for logs: %v", err)
-				}
-			}
+// Event represents a single audit trail entry
+type Event struct {
+	ID        int64     `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Category  string    `json:"category"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target,omitempty"`
+	Success   bool      `json:"success"`
+	Message   string    `json:"message,omitempty"`
+	Details   string    `json:"details,omitempty"`
+}
+
+// EventQuery represents filtering and pagination options for event retrieval
+type EventQuery struct {
+	Category string    `json:"category,omitempty"`
+	Action   string    `json:"action,omitempty"`
+	Target   string    `json:"target,omitempty"`
+	Since    time.Time `json:"since,omitempty"`
+	Until    time.Time `json:"until,omitempty"`
+	MaxItems int       `json:"maxItems,omitempty"`
+	Offset   int       `json:"offset,omitempty"`
+}
+
+// InsertEvent는 감사 기록 이벤트를 events 테이블에 저장합니다.
+func InsertEvent(db *sql.DB, event Event) (int64, error) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	query := `INSERT INTO events (timestamp, category, action, target, success, message, details)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+	return insertReturningID(db, query, event.Timestamp.UTC(), event.Category, event.Action, event.Target,
+		event.Success, event.Message, event.Details)
+}
+
+// GetEvents는 필터 조건에 맞는 이벤트를 최신순으로 조회하고 전체 개수를 함께 반환합니다.
+func GetEvents(db *sql.DB, query EventQuery) ([]Event, int, error) {
+	var conditions []string
+	var args []interface{}
+
+	if query.Category != "" {
+		conditions = append(conditions, "category = ?")
+		args = append(args, query.Category)
+	}
+	if query.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, query.Action)
+	}
+	if query.Target != "" {
+		conditions = append(conditions, "target = ?")
+		args = append(args, query.Target)
+	}
+	if !query.Since.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, query.Since.UTC())
+	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "timestamp <= ?")
+		args = append(args, query.Until.UTC())
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var totalCount int
+	if err := db.QueryRow(rebind("SELECT COUNT(*) FROM events"+whereClause), args...).Scan(&totalCount); err != nil {
+		return nil, 0, err
+	}
+
+	limit := query.MaxItems
+	if limit <= 0 {
+		limit = math.MaxInt32 // 제한 없음 (LIMIT -1은 SQLite 전용)
+	}
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	selectSQL := "SELECT id, timestamp, category, action, target, success, message, details FROM events" +
+		whereClause + " ORDER BY timestamp DESC, id DESC LIMIT ? OFFSET ?"
+	rows, err := db.Query(rebind(selectSQL), append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
 
-			// 버퍼 비우기
-			buffer = buffer[:0]
+	events := make([]Event, 0)
+	for rows.Next() {
+		var e Event
+		var target, message, details sql.NullString
+		if err := rows.Scan(&e.ID, &e.Timestamp, &e.Category, &e.Action, &target, &e.Success, &message, &details); err != nil {
+			return nil, 0, err
 		}
+		e.Target = target.String
+		e.Message = message.String
+		e.Details = details.String
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
 	}
+
+	return events, totalCount, nil
+}
+
+// BatchInsertResourceLogs는 수집된 자원 모니터링 데이터를 기본 설정의 ResourceLogWriter로 일괄 삽입합니다.
+// 채널이 닫히면 남은 데이터를 기록한 뒤 반환합니다.
+func BatchInsertResourceLogs(snapshots <-chan *monitoring.ResourceSnapshot, db *sql.DB) {
+	NewResourceLogWriter(db, DefaultResourceLogWriterConfig()).Run(snapshots)
 }
 
 // DebugWidgetStates는 현재 데이터베이스의 모든 위젯 상태를 로그로 출력합니다 (디버깅 용도)
@@ -394,7 +518,7 @@ func DebugWidgetStates(db *sql.DB, userID string) {
 	log.Printf("[DB] DebugWidgetStates: Dumping all widget states for user=%s", userID)
 	
 	query := "SELECT user_id, page_id, widget_id, widget_type FROM widget_states WHERE user_id = ? ORDER BY page_id, widget_id"
-	rows, err := db.Query(query, userID)
+	rows, err := db.Query(rebind(query), userID)
 	if err != nil {
 		log.Printf("[DB] DebugWidgetStates: Query failed: %v", err)
 		return
@@ -419,7 +543,7 @@ func DebugWidgetStates(db *sql.DB, userID string) {
 func VerifyWidgetDeletion(db *sql.DB, userID, pageID, widgetID string) {
 	query := "SELECT COUNT(*) FROM widget_states WHERE user_id = ? AND page_id = ? AND widget_id = ?"
 	var count int
-	err := db.QueryRow(query, userID, pageID, widgetID).Scan(&count)
+	err := db.QueryRow(rebind(query), userID, pageID, widgetID).Scan(&count)
 	if err != nil {
 		log.Printf("[DB] VerifyWidgetDeletion: Query failed for widget %s: %v", widgetID, err)
 		return
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 부하 테스트 실행 기록
+// 실행 구간(started_at ~ ended_at)이 해당 시간의 자원 로그를 "부하 중" 구간으로 표시하는 태그 역할
+// 시각은 Unix 초로 저장 (구간 비교에 그대로 사용)
+
+// StressTestRun is a recorded load generator run
+type StressTestRun struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"` // cpu, gpu
+	Label     string    `json:"label"`
+	Threads   int       `json:"threads"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Stopped   bool      `json:"stopped"` // 설정한 시간 전에 중지됨
+}
+
+// createStressTestRunsTable creates the load test run table (migration 2)
+func createStressTestRunsTable(db schemaExecer) error {
+	idColumn, keyColumn, boolColumn := "INTEGER PRIMARY KEY AUTOINCREMENT", "TEXT", "INTEGER"
+	if dialect := CurrentDialect(); dialect.Name() != DriverSQLite {
+		types := serverTypesFor(dialect)
+		idColumn, keyColumn, boolColumn = types.id, types.key, types.boolean
+	}
+
+	createSQL := `
+	CREATE TABLE IF NOT EXISTS stress_test_runs (
+	  id ` + idColumn + `,
+	  kind ` + keyColumn + ` NOT NULL,
+	  label TEXT NOT NULL,
+	  threads INTEGER NOT NULL DEFAULT 0,
+	  started_at BIGINT NOT NULL,
+	  ended_at BIGINT NOT NULL,
+	  stopped ` + boolColumn + ` NOT NULL DEFAULT FALSE
+	)`
+	_, err := db.Exec(createSQL)
+	return err
+}
+
+// InsertStressTestRun records a finished run and returns its ID
+func InsertStressTestRun(db *sql.DB, run StressTestRun) (int64, error) {
+	return insertReturningID(db, `INSERT INTO stress_test_runs (kind, label, threads, started_at, ended_at, stopped)
+		VALUES (?, ?, ?, ?, ?, ?)`, run.Kind, run.Label, run.Threads, run.StartedAt.Unix(), run.EndedAt.Unix(), run.Stopped)
+}
+
+// GetStressTestRuns returns the most recent runs first (limit <= 0 = all)
+func GetStressTestRuns(db *sql.DB, limit int) ([]StressTestRun, error) {
+	query := "SELECT id, kind, label, threads, started_at, ended_at, stopped FROM stress_test_runs ORDER BY started_at DESC, id DESC"
+	var args []interface{}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := db.Query(rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []StressTestRun{}
+	for rows.Next() {
+		run, err := scanStressTestRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// GetStressTestRun returns a single run (sql.ErrNoRows if it does not exist)
+func GetStressTestRun(db *sql.DB, id int64) (*StressTestRun, error) {
+	row := db.QueryRow(rebind("SELECT id, kind, label, threads, started_at, ended_at, stopped FROM stress_test_runs WHERE id = ?"), id)
+	run, err := scanStressTestRun(row)
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func scanStressTestRun(scanner interface{ Scan(...any) error }) (StressTestRun, error) {
+	var run StressTestRun
+	var startedAt, endedAt int64
+	if err := scanner.Scan(&run.ID, &run.Kind, &run.Label, &run.Threads, &startedAt, &endedAt, &run.Stopped); err != nil {
+		return run, err
+	}
+	run.StartedAt = time.Unix(startedAt, 0)
+	run.EndedAt = time.Unix(endedAt, 0)
+	return run, nil
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 일별 온도 프로파일 ("시간대별 온도" 히트맵용)
+// 1시간 집계(avg/min/max)를 지역 시간 기준 날짜 × 시(0~23)로 묶어 반환
+// 별도 테이블 없이 resource_logs_1h 압축 결과와 아직 압축되지 않은 데이터를 함께 사용
+
+// ThermalHour is the temperature aggregate of one local hour
+type ThermalHour struct {
+	Hour    int     `json:"hour"` // 0~23 (지역 시간)
+	Avg     float64 `json:"avg"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Samples int64   `json:"samples"`
+}
+
+// ThermalDay is the temperature profile of one local day
+type ThermalDay struct {
+	Date  string        `json:"date"` // 2006-01-02
+	Avg   float64       `json:"avg"`
+	Min   float64       `json:"min"`
+	Max   float64       `json:"max"`
+	Hours []ThermalHour `json:"hours"` // 데이터가 있는 시간만, 시간 순
+}
+
+// ThermalProfile is the daily temperature profile of one sensor
+type ThermalProfile struct {
+	MetricType string       `json:"metric_type"`
+	Days       []ThermalDay `json:"days"`
+}
+
+// IsTemperatureMetric reports whether a metric type is a temperature reading (°C)
+func IsTemperatureMetric(metricType string) bool {
+	switch metricType {
+	case "cpu_temperature", "gpu_temperature":
+		return true
+	}
+	return strings.HasPrefix(metricType, "disk_temp_")
+}
+
+// GetThermalProfiles returns daily profiles of the temperature metrics between since and until
+// metricTypes가 비어 있으면 기록된 모든 온도 항목을 반환
+func GetThermalProfiles(db *sql.DB, metricTypes []string, since, until time.Time, loc *time.Location) ([]ThermalProfile, error) {
+	points, _, err := GetResourceHistory(db, ResourceHistoryQuery{
+		MetricTypes: metricTypes,
+		Since:       since,
+		Until:       until,
+		Resolution:  ResolutionHour,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return BuildThermalProfiles(points, loc), nil
+}
+
+// BuildThermalProfiles groups hourly history points of temperature metrics by local day and hour
+func BuildThermalProfiles(points []ResourceHistoryPoint, loc *time.Location) []ThermalProfile {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	type dayKey struct {
+		metricType string
+		date       string
+	}
+	hours := make(map[dayKey]map[int]*ThermalHour)
+	for _, point := range points {
+		if !IsTemperatureMetric(point.MetricType) || point.Count <= 0 {
+			continue
+		}
+		local := point.Timestamp.In(loc)
+		key := dayKey{point.MetricType, local.Format("2006-01-02")}
+		if hours[key] == nil {
+			hours[key] = make(map[int]*ThermalHour)
+		}
+
+		// 서머타임 종료일처럼 같은 지역 시간이 두 번 나오면 표본 수로 가중 평균
+		hour, ok := hours[key][local.Hour()]
+		if !ok {
+			hours[key][local.Hour()] = &ThermalHour{Hour: local.Hour(), Avg: point.Avg, Min: point.Min, Max: point.Max, Samples: point.Count}
+			continue
+		}
+		mergeThermalHour(hour, point.Avg, point.Min, point.Max, point.Count)
+	}
+
+	profiles := make(map[string]*ThermalProfile)
+	for key, dayHours := range hours {
+		day := ThermalDay{Date: key.date, Hours: make([]ThermalHour, 0, len(dayHours))}
+		total := ThermalHour{}
+		for _, hour := range dayHours {
+			day.Hours = append(day.Hours, *hour)
+			mergeThermalHour(&total, hour.Avg, hour.Min, hour.Max, hour.Samples)
+		}
+		sort.Slice(day.Hours, func(i, j int) bool { return day.Hours[i].Hour < day.Hours[j].Hour })
+		day.Avg, day.Min, day.Max = total.Avg, total.Min, total.Max
+
+		profile, ok := profiles[key.metricType]
+		if !ok {
+			profile = &ThermalProfile{MetricType: key.metricType}
+			profiles[key.metricType] = profile
+		}
+		profile.Days = append(profile.Days, day)
+	}
+
+	result := make([]ThermalProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		sort.Slice(profile.Days, func(i, j int) bool { return profile.Days[i].Date < profile.Days[j].Date })
+		result = append(result, *profile)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].MetricType < result[j].MetricType })
+	return result
+}
+
+// mergeThermalHour folds another aggregate into target (sample-weighted average)
+func mergeThermalHour(target *ThermalHour, avg, min, max float64, samples int64) {
+	if target.Samples == 0 {
+		target.Avg, target.Min, target.Max, target.Samples = avg, min, max, samples
+		return
+	}
+	total := target.Samples + samples
+	target.Avg = (target.Avg*float64(target.Samples) + avg*float64(samples)) / float64(total)
+	if min < target.Min {
+		target.Min = min
+	}
+	if max > target.Max {
+		target.Max = max
+	}
+	target.Samples = total
+}
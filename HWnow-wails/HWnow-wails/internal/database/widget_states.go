@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// 여러 페이지의 위젯 상태 일괄 조회/저장
+// 일괄 저장은 요청에 포함된 페이지의 위젯만 하나의 트랜잭션으로 교체 (포함되지 않은 페이지는 그대로 유지)
+
+// WidgetPage holds the widgets of one dashboard page
+type WidgetPage struct {
+	PageID  string        `json:"page_id"`
+	Widgets []WidgetState `json:"widgets"`
+}
+
+// addWidgetStateVersion records the format version each widget state was written with (migration 5).
+// 기존 행은 버전 관리 이전 형식(1)으로 간주
+func addWidgetStateVersion(tx schemaExecer) error {
+	_, err := tx.Exec("ALTER TABLE widget_states ADD COLUMN state_version INTEGER NOT NULL DEFAULT 1")
+	return err
+}
+
+// GetWidgetPages returns the widgets of the given pages (every page of the user when pageIDs is empty), in page order
+func GetWidgetPages(db *sql.DB, userID string, pageIDs []string) ([]WidgetPage, error) {
+	if len(pageIDs) == 0 {
+		pages, err := GetPages(db, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, page := range pages {
+			pageIDs = append(pageIDs, page.PageID)
+		}
+	}
+
+	rows, err := db.Query(rebind(`SELECT page_id, widget_id, widget_type, config, layout, state_version
+		FROM widget_states WHERE user_id = ? ORDER BY page_id, widget_id`), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	widgetsByPage := make(map[string][]WidgetState)
+	for rows.Next() {
+		w := WidgetState{UserID: userID}
+		var config, layout sql.NullString
+		if err := rows.Scan(&w.PageID, &w.WidgetID, &w.WidgetType, &config, &layout, &w.Version); err != nil {
+			return nil, err
+		}
+		w.Config = config.String
+		w.Layout = layout.String
+		widgetsByPage[w.PageID] = append(widgetsByPage[w.PageID], w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	pages := make([]WidgetPage, 0, len(pageIDs))
+	for _, pageID := range pageIDs {
+		widgets := widgetsByPage[pageID]
+		if widgets == nil {
+			widgets = []WidgetState{}
+		}
+		pages = append(pages, WidgetPage{PageID: pageID, Widgets: widgets})
+	}
+	return pages, nil
+}
+
+// SaveWidgetPages replaces the widgets of every given page in one transaction (an empty widget list clears the page)
+func SaveWidgetPages(db *sql.DB, userID string, pages []WidgetPage) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, page := range pages {
+		if _, err := tx.Exec(rebind("DELETE FROM widget_states WHERE user_id = ? AND page_id = ?"), userID, page.PageID); err != nil {
+			return err
+		}
+		for _, w := range page.Widgets {
+			_, err := tx.Exec(rebind(`INSERT INTO widget_states (user_id, page_id, widget_id, widget_type, config, layout, state_version, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`),
+				userID, page.PageID, w.WidgetID, w.WidgetType, w.Config, w.Layout, w.Version)
+			if err != nil {
+				return fmt.Errorf("failed to save widget %s on page %s: %v", w.WidgetID, page.PageID, err)
+			}
+		}
+	}
+	return tx.Commit()
+}
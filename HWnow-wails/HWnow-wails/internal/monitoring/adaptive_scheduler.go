@@ -0,0 +1,251 @@
+package monitoring
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// 수집 시간 예산 기반 적응형 샘플링
+// 한 수집 주기의 평균 소요 시간이 예산을 넘으면 비용이 큰 수집기(GPU 프로세스, 상위 프로세스)의
+// 수집 간격을 두 배씩 늘리고, 여유가 생기면 기본 간격으로 되돌림
+// 설정에서 수집기별 간격(cpu 1초, disk 5초, smart 60초 등)을 지정하면 수집기마다 독립적인 주기로 실행
+
+const (
+	DEFAULT_COLLECTION_BUDGET     = 500 * time.Millisecond
+	DEFAULT_MAX_ADAPTIVE_INTERVAL = 10 * time.Second
+
+	// 예산의 이 비율 아래로 내려가야 간격을 줄임 (진동 방지)
+	adaptiveRelaxRatio = 0.5
+)
+
+// CollectorSchedule describes the current sampling interval of a collector
+type CollectorSchedule struct {
+	Name              string    `json:"name"`
+	Adaptive          bool      `json:"adaptive"`
+	BaseIntervalMs    int64     `json:"base_interval_ms"`
+	CurrentIntervalMs int64     `json:"current_interval_ms"`
+	AvgDurationMs     float64   `json:"avg_duration_ms"`
+	Skipped           int64     `json:"skipped"`
+	LastRun           time.Time `json:"last_run"`
+}
+
+// SchedulerStatus describes the adaptive scheduler state
+type SchedulerStatus struct {
+	BudgetMs           int64               `json:"budget_ms"`
+	MaxIntervalMs      int64               `json:"max_interval_ms"`
+	AvgCycleDurationMs float64             `json:"avg_cycle_duration_ms"`
+	OverBudget         bool                `json:"over_budget"`
+	Collectors         []CollectorSchedule `json:"collectors"`
+}
+
+type scheduledCollector struct {
+	adaptive        bool // 예산 초과 시 간격 조정 대상 (false = 설정된 고정 간격)
+	baseInterval    time.Duration
+	currentInterval time.Duration
+	lastRun         time.Time
+	skipped         int64
+}
+
+// AdaptiveScheduler decides which expensive collectors run in a collection cycle
+type AdaptiveScheduler struct {
+	mutex         sync.Mutex
+	budget        time.Duration
+	cycleInterval time.Duration // 전체 수집 주기 (interval_seconds)
+	maxInterval   time.Duration
+	collectors    map[string]*scheduledCollector
+	overBudget    bool
+}
+
+// NewAdaptiveScheduler creates a scheduler for the given adaptive collectors
+func NewAdaptiveScheduler(budget, baseInterval, maxInterval time.Duration, adaptiveCollectors ...string) *AdaptiveScheduler {
+	if budget <= 0 {
+		budget = DEFAULT_COLLECTION_BUDGET
+	}
+	if maxInterval < baseInterval {
+		maxInterval = baseInterval
+	}
+
+	s := &AdaptiveScheduler{
+		budget:        budget,
+		cycleInterval: baseInterval,
+		maxInterval:   maxInterval,
+		collectors:    make(map[string]*scheduledCollector),
+	}
+	for _, name := range adaptiveCollectors {
+		s.collectors[name] = &scheduledCollector{
+			adaptive:        true,
+			baseInterval:    baseInterval,
+			currentInterval: baseInterval,
+		}
+	}
+	return s
+}
+
+// SetCollectorIntervals sets per-collector base intervals; collectors not listed return to the cycle interval
+// (adaptive collectors) or run every cycle (other collectors)
+func (s *AdaptiveScheduler) SetCollectorIntervals(intervals map[string]time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for name, collector := range s.collectors {
+		if _, configured := intervals[name]; configured {
+			continue
+		}
+		if !collector.adaptive {
+			delete(s.collectors, name)
+			continue
+		}
+		collector.baseInterval = s.cycleInterval
+		collector.currentInterval = s.cycleInterval
+	}
+
+	for name, interval := range intervals {
+		// 전체 수집 주기보다 짧은 간격은 의미가 없으므로 주기에 맞춤
+		if interval < s.cycleInterval {
+			interval = s.cycleInterval
+		}
+		collector, exists := s.collectors[name]
+		if !exists {
+			collector = &scheduledCollector{}
+			s.collectors[name] = collector
+		}
+		collector.baseInterval = interval
+		collector.currentInterval = interval
+	}
+}
+
+// ShouldRun reports whether the collector is due in this cycle; collectors not managed by the scheduler always run
+func (s *AdaptiveScheduler) ShouldRun(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	collector, exists := s.collectors[name]
+	if !exists {
+		return true
+	}
+
+	now := time.Now()
+	// 수집 주기 간격에서는 매 주기 수집하고, 더 긴 간격은 프론트엔드 폴링 지터를 고려해 반 주기 일찍 실행
+	if collector.currentInterval <= s.cycleInterval || now.Sub(collector.lastRun) >= collector.currentInterval-s.cycleInterval/2 {
+		collector.lastRun = now
+		return true
+	}
+
+	collector.skipped++
+	return false
+}
+
+// Adjust updates collector intervals from the measured average cycle duration
+func (s *AdaptiveScheduler) Adjust(avgCycleDuration time.Duration) {
+	stats := make(map[string]CollectorStats)
+	for _, collectorStats := range GetCollectorStats() {
+		stats[collectorStats.Name] = collectorStats
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.overBudget = avgCycleDuration > s.budget
+
+	// 한 주기에 한 수집기만 조정해 점진적으로 수렴
+	switch {
+	case s.overBudget:
+		name := s.pickCollector(stats, func(c *scheduledCollector) bool { return c.currentInterval < s.maxInterval }, true)
+		if name == "" {
+			return
+		}
+		collector := s.collectors[name]
+		collector.currentInterval *= 2
+		if collector.currentInterval > s.maxInterval {
+			collector.currentInterval = s.maxInterval
+		}
+		LogDebug("Collection over budget, lengthening collector interval",
+			"collector", name, "interval", collector.currentInterval, "cycle", avgCycleDuration, "budget", s.budget)
+
+	case float64(avgCycleDuration) < float64(s.budget)*adaptiveRelaxRatio:
+		name := s.pickCollector(stats, func(c *scheduledCollector) bool { return c.currentInterval > c.baseInterval }, false)
+		if name == "" {
+			return
+		}
+		collector := s.collectors[name]
+		collector.currentInterval /= 2
+		if collector.currentInterval < collector.baseInterval {
+			collector.currentInterval = collector.baseInterval
+		}
+		LogDebug("Collection within budget, shortening collector interval",
+			"collector", name, "interval", collector.currentInterval, "cycle", avgCycleDuration, "budget", s.budget)
+	}
+}
+
+// pickCollector returns the most (or least) expensive eligible collector (caller holds the mutex)
+func (s *AdaptiveScheduler) pickCollector(stats map[string]CollectorStats, eligible func(*scheduledCollector) bool, mostExpensive bool) string {
+	picked := ""
+	var pickedCost float64
+	for name, collector := range s.collectors {
+		if !collector.adaptive || !eligible(collector) {
+			continue
+		}
+		cost := stats[name].AvgDurationMs
+		if picked == "" || (mostExpensive && cost > pickedCost) || (!mostExpensive && cost < pickedCost) ||
+			(cost == pickedCost && name < picked) {
+			picked = name
+			pickedCost = cost
+		}
+	}
+	return picked
+}
+
+// Status returns the current scheduler state including all observed collectors
+func (s *AdaptiveScheduler) Status() SchedulerStatus {
+	collectorStats := GetCollectorStats()
+
+	selfTelemetry.mutex.Lock()
+	avgCycle := selfTelemetry.cycle.AvgDurationMs
+	selfTelemetry.mutex.Unlock()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	status := SchedulerStatus{
+		BudgetMs:           s.budget.Milliseconds(),
+		MaxIntervalMs:      s.maxInterval.Milliseconds(),
+		AvgCycleDurationMs: avgCycle,
+		OverBudget:         s.overBudget,
+		Collectors:         make([]CollectorSchedule, 0, len(collectorStats)),
+	}
+
+	seen := make(map[string]bool)
+	for _, stats := range collectorStats {
+		schedule := CollectorSchedule{
+			Name:          stats.Name,
+			AvgDurationMs: stats.AvgDurationMs,
+			LastRun:       stats.LastRun,
+		}
+		if collector, exists := s.collectors[stats.Name]; exists {
+			schedule.Adaptive = collector.adaptive
+			schedule.BaseIntervalMs = collector.baseInterval.Milliseconds()
+			schedule.CurrentIntervalMs = collector.currentInterval.Milliseconds()
+			schedule.Skipped = collector.skipped
+		}
+		seen[stats.Name] = true
+		status.Collectors = append(status.Collectors, schedule)
+	}
+
+	// 아직 한 번도 실행되지 않은 스케줄 대상 수집기도 표시
+	for name, collector := range s.collectors {
+		if seen[name] {
+			continue
+		}
+		status.Collectors = append(status.Collectors, CollectorSchedule{
+			Name:              name,
+			Adaptive:          collector.adaptive,
+			BaseIntervalMs:    collector.baseInterval.Milliseconds(),
+			CurrentIntervalMs: collector.currentInterval.Milliseconds(),
+			Skipped:           collector.skipped,
+		})
+	}
+	sort.Slice(status.Collectors, func(i, j int) bool { return status.Collectors[i].Name < status.Collectors[j].Name })
+
+	return status
+}
@@ -0,0 +1,99 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdaptiveScheduler(t *testing.T) {
+
+	t.Run("Lengthen_Most_Expensive_Over_Budget", func(t *testing.T) {
+		ObserveCollector("sched_cheap", 5*time.Millisecond, nil)
+		ObserveCollector("sched_expensive", 400*time.Millisecond, nil)
+
+		scheduler := NewAdaptiveScheduler(100*time.Millisecond, time.Second, 8*time.Second, "sched_cheap", "sched_expensive")
+		scheduler.Adjust(500 * time.Millisecond)
+
+		intervals := scheduleIntervals(scheduler)
+		if intervals["sched_expensive"] != 2000 || intervals["sched_cheap"] != 1000 {
+			t.Errorf("Expected only the expensive collector to be lengthened, got %v", intervals)
+		}
+
+		for i := 0; i < 10; i++ {
+			scheduler.Adjust(500 * time.Millisecond)
+		}
+		if intervals := scheduleIntervals(scheduler); intervals["sched_expensive"] > 8000 || intervals["sched_cheap"] > 8000 {
+			t.Errorf("Expected intervals capped at max, got %v", intervals)
+		}
+	})
+
+	t.Run("Relax_Within_Budget", func(t *testing.T) {
+		scheduler := NewAdaptiveScheduler(100*time.Millisecond, time.Second, 8*time.Second, "sched_relax")
+		scheduler.Adjust(time.Second)
+		scheduler.Adjust(time.Second)
+		scheduler.Adjust(10 * time.Millisecond)
+
+		if interval := scheduleIntervals(scheduler)["sched_relax"]; interval != 2000 {
+			t.Errorf("Expected interval to shrink to 2000ms, got %d", interval)
+		}
+	})
+
+	t.Run("Skip_Until_Due", func(t *testing.T) {
+		scheduler := NewAdaptiveScheduler(100*time.Millisecond, time.Second, 8*time.Second, "sched_skip")
+		if !scheduler.ShouldRun("sched_skip") || !scheduler.ShouldRun("unmanaged") {
+			t.Fatal("Expected collectors at base interval to run")
+		}
+
+		scheduler.Adjust(time.Second)
+		if scheduler.ShouldRun("sched_skip") {
+			t.Error("Expected lengthened collector to be skipped before its interval elapses")
+		}
+	})
+
+	t.Run("Per_Collector_Intervals", func(t *testing.T) {
+		scheduler := NewAdaptiveScheduler(100*time.Millisecond, time.Second, 8*time.Second, "sched_processes")
+		scheduler.SetCollectorIntervals(map[string]time.Duration{
+			"sched_processes": 15 * time.Second,
+			"sched_smart":     60 * time.Second,
+			"sched_cpu":       100 * time.Millisecond,
+		})
+
+		if !scheduler.ShouldRun("sched_smart") || scheduler.ShouldRun("sched_smart") {
+			t.Error("Expected a fixed-interval collector to run once and then wait for its interval")
+		}
+		if !scheduler.ShouldRun("sched_cpu") || !scheduler.ShouldRun("sched_cpu") {
+			t.Error("Expected intervals below the cycle interval to run every cycle")
+		}
+		if !scheduler.ShouldRun("sched_processes") || scheduler.ShouldRun("sched_processes") {
+			t.Error("Expected the configured base interval to apply to adaptive collectors")
+		}
+
+		// 고정 간격 수집기는 예산 초과 시에도 조정하지 않음
+		scheduler.Adjust(time.Second)
+		intervals := make(map[string]int64)
+		for _, collector := range scheduler.Status().Collectors {
+			intervals[collector.Name] = collector.CurrentIntervalMs
+		}
+		if intervals["sched_smart"] != 60000 || intervals["sched_cpu"] != 1000 || intervals["sched_processes"] != 15000 {
+			t.Errorf("Unexpected intervals: %v", intervals)
+		}
+
+		scheduler.SetCollectorIntervals(nil)
+		if !scheduler.ShouldRun("sched_smart") || !scheduler.ShouldRun("sched_smart") {
+			t.Error("Expected collectors removed from the configuration to run every cycle")
+		}
+		if intervals := scheduleIntervals(scheduler); intervals["sched_processes"] != 1000 {
+			t.Errorf("Expected adaptive collector to return to the cycle interval, got %v", intervals)
+		}
+	})
+}
+
+func scheduleIntervals(scheduler *AdaptiveScheduler) map[string]int64 {
+	intervals := make(map[string]int64)
+	for _, collector := range scheduler.Status().Collectors {
+		if collector.Adaptive {
+			intervals[collector.Name] = collector.CurrentIntervalMs
+		}
+	}
+	return intervals
+}
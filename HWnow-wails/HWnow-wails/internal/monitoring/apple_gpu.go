@@ -0,0 +1,155 @@
+package monitoring
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// macOS GPU 사용률 (Apple Silicon 통합 GPU 포함)
+// - ioreg IOAccelerator의 PerformanceStatistics: Device Utilization %, In use system memory (권한 불필요)
+// - powermetrics gpu_power 샘플러: GPU HW active residency, GPU Power (root 권한에서만 실행)
+// Apple Silicon은 통합 메모리이므로 GPU 메모리 총량은 시스템 메모리 총량으로 보고
+
+const powermetricsSampleMS = 200
+
+var (
+	ioregStringPattern       = regexp.MustCompile(`^"([^"]+)" = "([^"]*)"$`)
+	ioregNumberPattern       = regexp.MustCompile(`^"([^"]+)" = (\d+)$`)
+	ioregStatisticPattern    = regexp.MustCompile(`"([^"]+)"=(\d+)`)
+	powermetricsResidency    = regexp.MustCompile(`^GPU (?:HW )?active residency:\s+([\d.]+)%`)
+	powermetricsPowerPattern = regexp.MustCompile(`^GPU Power:\s+([\d.]+)\s*mW`)
+)
+
+// appleGPUStats is the GPU state read from ioreg/powermetrics (-1 = unknown)
+type appleGPUStats struct {
+	Model        string
+	CoreCount    int
+	Usage        float64 // Device Utilization (%)
+	MemoryUsedMB float64 // In use system memory (MB)
+	Power        float64 // W
+}
+
+// getGPUInfoIORegistry reads GPU utilization from the IOAccelerator registry entry (macOS)
+func getGPUInfoIORegistry() (*GPUInfo, error) {
+	output, err := createHiddenCommand("ioreg", "-r", "-d", "1", "-w", "0", "-c", "IOAccelerator").Output()
+	if err != nil {
+		return nil, fmt.Errorf("ioreg failed: %v", err)
+	}
+
+	stats, err := parseIORegAccelerator(string(output))
+	if err != nil {
+		return nil, err
+	}
+
+	// powermetrics는 root에서만 동작 - 일반 사용자 실행 시 전력은 -1, 사용률은 ioreg 값만 사용
+	if os.Geteuid() == 0 {
+		sample := fmt.Sprintf("%d", powermetricsSampleMS)
+		if output, err := createHiddenCommand("powermetrics", "-n", "1", "-i", sample, "--samplers", "gpu_power").Output(); err == nil {
+			residency, power := parsePowermetricsGPU(string(output))
+			if stats.Usage < 0 {
+				stats.Usage = residency
+			}
+			stats.Power = power
+		} else {
+			LogDebug("powermetrics GPU sample failed", "error", err)
+		}
+	}
+	if stats.Usage < 0 {
+		return nil, fmt.Errorf("GPU utilization not reported by IOAccelerator")
+	}
+
+	info := &GPUInfo{
+		Name:        stats.Model,
+		Usage:       stats.Usage,
+		MemoryUsed:  stats.MemoryUsedMB,
+		MemoryTotal: -1.0,
+		Temperature: -1.0, // GPU 온도는 SMC 접근이 필요하여 제공하지 않음
+		Power:       stats.Power,
+	}
+	if stats.CoreCount > 0 {
+		info.Name = fmt.Sprintf("%s (%d-core GPU)", stats.Model, stats.CoreCount)
+	}
+	if v, err := mem.VirtualMemory(); err == nil {
+		info.MemoryTotal = float64(v.Total) / 1024 / 1024 // 통합 메모리
+	}
+	return info, nil
+}
+
+// parseIORegAccelerator parses the first IOAccelerator entry that reports utilization (or the last entry if none does)
+func parseIORegAccelerator(output string) (*appleGPUStats, error) {
+	var current *appleGPUStats
+	var ioClass string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "+-o ") {
+			if current != nil && current.Usage >= 0 {
+				break // 사용률을 보고한 첫 번째 가속기 사용
+			}
+			current = &appleGPUStats{Usage: -1, MemoryUsedMB: -1, Power: -1}
+			ioClass = strings.Fields(line[4:])[0]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		if m := ioregStringPattern.FindStringSubmatch(line); m != nil {
+			if m[1] == "model" {
+				current.Model = m[2]
+			}
+			continue
+		}
+		if m := ioregNumberPattern.FindStringSubmatch(line); m != nil {
+			if m[1] == "gpu-core-count" {
+				current.CoreCount, _ = strconv.Atoi(m[2])
+			}
+			continue
+		}
+		if strings.HasPrefix(line, `"PerformanceStatistics" = {`) {
+			for _, m := range ioregStatisticPattern.FindAllStringSubmatch(line, -1) {
+				value, err := strconv.ParseFloat(m[2], 64)
+				if err != nil {
+					continue
+				}
+				switch m[1] {
+				case "Device Utilization %":
+					current.Usage = value
+				case "In use system memory":
+					current.MemoryUsedMB = value / 1024 / 1024
+				}
+			}
+		}
+		if current.Model == "" {
+			current.Model = ioClass
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("no IOAccelerator found")
+	}
+	return current, nil
+}
+
+// parsePowermetricsGPU returns GPU active residency (%) and GPU power (W) from powermetrics output (-1 = not found)
+func parsePowermetricsGPU(output string) (float64, float64) {
+	residency, power := -1.0, -1.0
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if m := powermetricsResidency.FindStringSubmatch(line); m != nil {
+			residency, _ = strconv.ParseFloat(m[1], 64)
+		} else if m := powermetricsPowerPattern.FindStringSubmatch(line); m != nil {
+			if milliwatts, err := strconv.ParseFloat(m[1], 64); err == nil {
+				power = milliwatts / 1000
+			}
+		}
+	}
+	return residency, power
+}
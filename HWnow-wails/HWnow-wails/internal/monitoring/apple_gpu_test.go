@@ -0,0 +1,54 @@
+package monitoring
+
+import "testing"
+
+func TestParseIORegAccelerator(t *testing.T) {
+	output := `+-o AGXAcceleratorG13X  <class AGXAcceleratorG13X, id 0x100000254, registered, matched, active, busy 0 (0 ms), retain 63>
+    {
+      "IOClass" = "AGXAcceleratorG13X"
+      "gpu-core-count" = 8
+      "model" = "Apple M1"
+      "PerformanceStatistics" = {"In use system memory (driver)"=0,"Alloc system memory"=1520238592,"Tiler Utilization %"=5,"Renderer Utilization %"=4,"Device Utilization %"=7,"In use system memory"=367001600}
+    }
+`
+	stats, err := parseIORegAccelerator(output)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stats.Model != "Apple M1" || stats.CoreCount != 8 || stats.Usage != 7 || stats.MemoryUsedMB != 350 || stats.Power != -1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+
+	// 모델명이 없는 가속기(Intel Mac)는 IOClass 이름 사용
+	stats, err = parseIORegAccelerator(`+-o IntelAccelerator  <class IntelAccelerator, id 0x1000002d4>
+    {
+      "PerformanceStatistics" = {"Device Utilization %"=12,"GPU Activity(%)"=12}
+    }
+`)
+	if err != nil || stats.Model != "IntelAccelerator" || stats.Usage != 12 || stats.MemoryUsedMB != -1 {
+		t.Errorf("Unexpected stats: %+v, %v", stats, err)
+	}
+
+	if _, err := parseIORegAccelerator(""); err == nil {
+		t.Error("Expected an error without IOAccelerator entries")
+	}
+}
+
+func TestParsePowermetricsGPU(t *testing.T) {
+	output := `**** GPU usage ****
+
+GPU HW active frequency: 389 MHz
+GPU HW active residency:  23.45% (389 MHz: 23% 486 MHz:   0%)
+GPU SW requested state: (P1 : 100% P2 :   0%)
+GPU idle residency:  76.55%
+GPU Power: 1250 mW
+`
+	residency, power := parsePowermetricsGPU(output)
+	if residency != 23.45 || power != 1.25 {
+		t.Errorf("Unexpected residency %v / power %v", residency, power)
+	}
+
+	if residency, power := parsePowermetricsGPU("GPU active residency:   3.10%\n"); residency != 3.1 || power != -1 {
+		t.Errorf("Unexpected residency %v / power %v", residency, power)
+	}
+}
@@ -0,0 +1,126 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 오디오 장치 및 세션 정보 (Windows Core Audio / WASAPI)
+// 기본 재생/녹음 장치의 이름, 마스터 볼륨, 음소거 여부와
+// 각 장치의 오디오 세션(소리를 내거나 마이크를 사용하는 프로세스)을 열거
+// COM 인터페이스는 go-ole에 없으므로 gpu_adapters.go의 DXGI와 같이 vtable을 직접 호출
+
+const AUDIO_CACHE_DURATION = 2 * time.Second
+
+// Audio flows
+const (
+	AudioFlowPlayback = "playback"
+	AudioFlowCapture  = "capture"
+)
+
+// AudioDevice is a default playback or capture endpoint
+type AudioDevice struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Flow   string  `json:"flow"`   // playback, capture
+	Volume float64 `json:"volume"` // 마스터 볼륨 (%, -1 = 알 수 없음)
+	Muted  bool    `json:"muted"`
+}
+
+// AudioSession is a process holding an audio session on a default endpoint
+type AudioSession struct {
+	ProcessID   uint32  `json:"process_id"` // 0 = 시스템 소리 또는 여러 프로세스가 공유하는 세션
+	ProcessName string  `json:"process_name"`
+	DisplayName string  `json:"display_name,omitempty"`
+	Flow        string  `json:"flow"`   // playback, capture
+	Active      bool    `json:"active"` // 현재 오디오 스트림이 열려 있음
+	Volume      float64 `json:"volume"` // 세션 볼륨 (%)
+	Muted       bool    `json:"muted"`
+	PeakLevel   float64 `json:"peak_level"` // 현재 출력/입력 레벨 (%)
+}
+
+// AudioInfo holds the default endpoints and their sessions
+type AudioInfo struct {
+	Playback *AudioDevice   `json:"playback"` // 기본 재생 장치 (없으면 nil)
+	Capture  *AudioDevice   `json:"capture"`  // 기본 녹음 장치 (없으면 nil)
+	Sessions []AudioSession `json:"sessions"` // 활성 세션 먼저, 레벨이 높은 순
+}
+
+// AudioCache caches audio endpoint readings
+type AudioCache struct {
+	mutex     sync.Mutex
+	info      *AudioInfo
+	err       error
+	timestamp time.Time
+}
+
+var audioCache = &AudioCache{}
+
+// GetAudioInfo returns the default audio endpoints and the processes holding audio sessions
+func GetAudioInfo(ctx context.Context) (*AudioInfo, error) {
+	return queryWithContext(ctx, "GetAudioInfo", getAudioInfo)
+}
+
+func getAudioInfo() (*AudioInfo, error) {
+	audioCache.mutex.Lock()
+	defer audioCache.mutex.Unlock()
+
+	if time.Since(audioCache.timestamp) < AUDIO_CACHE_DURATION {
+		return audioCache.info, audioCache.err
+	}
+
+	var info *AudioInfo
+	var err error
+	switch runtime.GOOS {
+	case "windows":
+		info, err = getAudioInfoWindows()
+	default:
+		err = fmt.Errorf("audio monitoring not supported on platform: %s", runtime.GOOS)
+	}
+
+	audioCache.info = info
+	audioCache.err = err
+	audioCache.timestamp = time.Now()
+	return info, err
+}
+
+// AudioMetrics converts the playback volume and the number of active sessions to audio_* metrics
+func AudioMetrics(info *AudioInfo) []Metric {
+	if info == nil {
+		return nil
+	}
+	var metrics []Metric
+	if info.Playback != nil && info.Playback.Volume >= 0 {
+		volume := info.Playback.Volume
+		if info.Playback.Muted {
+			volume = 0
+		}
+		metrics = append(metrics, Metric{Type: "audio_volume", Value: volume, Info: info.Playback.Name})
+	}
+	active := 0
+	for _, session := range info.Sessions {
+		if session.Active {
+			active++
+		}
+	}
+	metrics = append(metrics, Metric{Type: "audio_active_sessions", Value: float64(active)})
+	return metrics
+}
+
+// sortAudioSessions orders active sessions first, then by peak level and process name
+func sortAudioSessions(sessions []AudioSession) {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		if sessions[i].Active != sessions[j].Active {
+			return sessions[i].Active
+		}
+		if sessions[i].PeakLevel != sessions[j].PeakLevel {
+			return sessions[i].PeakLevel > sessions[j].PeakLevel
+		}
+		return strings.ToLower(sessions[i].ProcessName) < strings.ToLower(sessions[j].ProcessName)
+	})
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// getAudioInfoWindows is only available on Windows (Core Audio)
+func getAudioInfoWindows() (*AudioInfo, error) {
+	return nil, fmt.Errorf("audio monitoring not supported on platform: %s", runtime.GOOS)
+}
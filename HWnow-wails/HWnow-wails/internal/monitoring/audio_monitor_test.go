@@ -0,0 +1,44 @@
+package monitoring
+
+import "testing"
+
+func TestAudioMonitor(t *testing.T) {
+	t.Run("Sort_Sessions", func(t *testing.T) {
+		sessions := []AudioSession{
+			{ProcessName: "idle.exe"},
+			{ProcessName: "Discord.exe", Active: true, PeakLevel: 5},
+			{ProcessName: "spotify.exe", Active: true, PeakLevel: 40},
+			{ProcessName: "chrome.exe", Active: true, PeakLevel: 5},
+		}
+		sortAudioSessions(sessions)
+
+		expected := []string{"spotify.exe", "chrome.exe", "Discord.exe", "idle.exe"}
+		for i, name := range expected {
+			if sessions[i].ProcessName != name {
+				t.Errorf("Position %d: expected %s, got %s", i, name, sessions[i].ProcessName)
+			}
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		info := &AudioInfo{
+			Playback: &AudioDevice{Name: "Speakers", Volume: 35, Muted: true},
+			Sessions: []AudioSession{{Active: true}, {Active: false}, {Active: true}},
+		}
+		values := map[string]float64{}
+		for _, metric := range AudioMetrics(info) {
+			values[metric.Type] = metric.Value
+		}
+		// 음소거된 장치는 볼륨 0으로 기록
+		if values["audio_volume"] != 0 || values["audio_active_sessions"] != 2 {
+			t.Errorf("Unexpected metrics: %v", values)
+		}
+
+		if AudioMetrics(nil) != nil {
+			t.Error("Expected no metrics without audio info")
+		}
+		if metrics := AudioMetrics(&AudioInfo{Playback: &AudioDevice{Volume: -1}}); len(metrics) != 1 {
+			t.Errorf("Expected unknown volume to be skipped, got %+v", metrics)
+		}
+	})
+}
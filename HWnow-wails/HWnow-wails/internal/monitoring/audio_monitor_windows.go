@@ -0,0 +1,280 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	ole "github.com/go-ole/go-ole"
+
+	"HWnow-wails/internal/winapi"
+)
+
+// Core Audio COM 인터페이스 (vtable 인덱스는 mmdeviceapi.h / endpointvolume.h / audiopolicy.h 선언 순서)
+const (
+	comQueryInterfaceIndex = 0
+	comReleaseIndex        = 2
+
+	mmDeviceEnumeratorGetDefaultAudioEndpoint = 4
+	mmDeviceActivate                          = 3
+	mmDeviceOpenPropertyStore                 = 4
+	mmDeviceGetID                             = 5
+	propertyStoreGetValue                     = 5
+	endpointVolumeGetMasterVolumeLevelScalar  = 9
+	endpointVolumeGetMute                     = 15
+	sessionManager2GetSessionEnumerator       = 5
+	sessionEnumeratorGetCount                 = 3
+	sessionEnumeratorGetSession               = 4
+	sessionControlGetState                    = 3
+	sessionControlGetDisplayName              = 4
+	sessionControl2GetProcessID               = 14
+	simpleAudioVolumeGetMasterVolume          = 4
+	simpleAudioVolumeGetMute                  = 6
+	meterInformationGetPeakValue              = 3
+
+	eRender             = 0
+	eCapture            = 1
+	eConsole            = 0
+	clsctxAll           = 0x17 // CLSCTX_INPROC_SERVER | INPROC_HANDLER | LOCAL_SERVER | REMOTE_SERVER
+	stgmRead            = 0
+	vtLPWSTR            = 31
+	audioSessionActive  = 1 // AudioSessionStateActive
+	audioSessionExpired = 2 // AudioSessionStateExpired
+)
+
+var (
+	clsidMMDeviceEnumerator  = ole.NewGUID("{BCDE0395-E52F-467C-8E3D-C4579291692E}")
+	iidIMMDeviceEnumerator   = ole.NewGUID("{A95664D2-9614-4F35-A746-DE8DB63617E6}")
+	iidIAudioEndpointVolume  = ole.NewGUID("{5CDF2C82-841E-4546-9722-0CF74078229A}")
+	iidIAudioSessionManager2 = ole.NewGUID("{77AA99A0-1BD6-484F-8BC7-2C654C9A9B6F}")
+	iidIAudioSessionControl2 = ole.NewGUID("{BFB7FF88-7239-4FC9-8FA2-07C950BE9C6D}")
+	iidISimpleAudioVolume    = ole.NewGUID("{87CE5498-68D6-44E5-9215-6DA47EF883D8}")
+	iidIAudioMeterInfo       = ole.NewGUID("{C02216F6-8C67-4B5B-9D00-D008E73E0064}")
+
+	procPropVariantClear = syscall.NewLazyDLL("ole32.dll").NewProc("PropVariantClear")
+)
+
+// PKEY_Device_FriendlyName {A45C254E-DF1C-4EFD-8020-67D146A850E0}, 14
+var pkeyDeviceFriendlyName = struct {
+	fmtid ole.GUID
+	pid   uint32
+}{*ole.NewGUID("{A45C254E-DF1C-4EFD-8020-67D146A850E0}"), 14}
+
+// propVariant mirrors PROPVARIANT (64비트 기준 24바이트)
+type propVariant struct {
+	vt       uint16
+	reserved [3]uint16
+	value    *uint16 // VT_LPWSTR 값 (다른 형식은 사용하지 않음)
+	padding  uintptr
+}
+
+// wasapiObject is the in-memory layout of a COM interface pointer
+type wasapiObject struct {
+	vtbl *[20]uintptr
+}
+
+// call invokes a vtable method and returns the HRESULT
+func (o *wasapiObject) call(index int, args ...uintptr) int32 {
+	hr, _, _ := syscall.SyscallN(o.vtbl[index], append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)...)
+	return int32(hr)
+}
+
+func (o *wasapiObject) release() {
+	o.call(comReleaseIndex)
+}
+
+// queryInterface returns another interface of the same object (nil if not supported)
+func (o *wasapiObject) queryInterface(iid *ole.GUID) *wasapiObject {
+	var result *wasapiObject
+	if hr := o.call(comQueryInterfaceIndex, uintptr(unsafe.Pointer(iid)), uintptr(unsafe.Pointer(&result))); hr < 0 {
+		return nil
+	}
+	return result
+}
+
+// activate creates an endpoint-specific interface (IAudioEndpointVolume, IAudioSessionManager2)
+func (o *wasapiObject) activate(iid *ole.GUID) (*wasapiObject, error) {
+	var result *wasapiObject
+	if hr := o.call(mmDeviceActivate, uintptr(unsafe.Pointer(iid)), clsctxAll, 0, uintptr(unsafe.Pointer(&result))); hr < 0 || result == nil {
+		return nil, fmt.Errorf("IMMDevice::Activate failed: 0x%08X", uint32(hr))
+	}
+	return result, nil
+}
+
+// takeCoTaskString converts a COM-allocated wide string and frees it
+func takeCoTaskString(p *uint16) string {
+	if p == nil {
+		return ""
+	}
+	value := ole.UTF16PtrToString(p)
+	ole.CoTaskMemFree(uintptr(unsafe.Pointer(p)))
+	return value
+}
+
+// getAudioInfoWindows reads the default endpoints on a COM-initialized, locked OS thread
+func getAudioInfoWindows() (*AudioInfo, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := ole.CoInitializeEx(0, ole.COINIT_MULTITHREADED); err != nil {
+		oleErr, ok := err.(*ole.OleError)
+		if !ok || (oleErr.Code() != ole.S_OK && oleErr.Code() != wmiSFalse) {
+			return nil, fmt.Errorf("CoInitializeEx failed: %v", err)
+		}
+	}
+	defer ole.CoUninitialize()
+
+	unknown, err := ole.CreateInstance(clsidMMDeviceEnumerator, iidIMMDeviceEnumerator)
+	if err != nil {
+		return nil, fmt.Errorf("MMDeviceEnumerator not available: %v", err)
+	}
+	enumerator := (*wasapiObject)(unsafe.Pointer(unknown))
+	defer enumerator.release()
+
+	info := &AudioInfo{Sessions: []AudioSession{}}
+	for _, endpoint := range []struct {
+		dataFlow uintptr
+		flow     string
+	}{{eRender, AudioFlowPlayback}, {eCapture, AudioFlowCapture}} {
+		var device *wasapiObject
+		if hr := enumerator.call(mmDeviceEnumeratorGetDefaultAudioEndpoint, endpoint.dataFlow, eConsole, uintptr(unsafe.Pointer(&device))); hr < 0 || device == nil {
+			continue // 해당 방향의 장치 없음 (E_NOTFOUND)
+		}
+
+		audioDevice := readAudioDevice(device, endpoint.flow)
+		info.Sessions = append(info.Sessions, readAudioSessions(device, endpoint.flow)...)
+		device.release()
+
+		if endpoint.flow == AudioFlowPlayback {
+			info.Playback = audioDevice
+		} else {
+			info.Capture = audioDevice
+		}
+	}
+
+	sortAudioSessions(info.Sessions)
+	return info, nil
+}
+
+// readAudioDevice reads the endpoint ID, friendly name, master volume and mute state
+func readAudioDevice(device *wasapiObject, flow string) *AudioDevice {
+	audioDevice := &AudioDevice{Flow: flow, Volume: -1}
+
+	var id *uint16
+	if hr := device.call(mmDeviceGetID, uintptr(unsafe.Pointer(&id))); hr >= 0 {
+		audioDevice.ID = takeCoTaskString(id)
+	}
+
+	var store *wasapiObject
+	if hr := device.call(mmDeviceOpenPropertyStore, stgmRead, uintptr(unsafe.Pointer(&store))); hr >= 0 && store != nil {
+		var value propVariant
+		if hr := store.call(propertyStoreGetValue, uintptr(unsafe.Pointer(&pkeyDeviceFriendlyName)), uintptr(unsafe.Pointer(&value))); hr >= 0 {
+			if value.vt == vtLPWSTR && value.value != nil {
+				audioDevice.Name = ole.UTF16PtrToString(value.value)
+			}
+			procPropVariantClear.Call(uintptr(unsafe.Pointer(&value)))
+		}
+		store.release()
+	}
+
+	if endpointVolume, err := device.activate(iidIAudioEndpointVolume); err == nil {
+		var level float32
+		if hr := endpointVolume.call(endpointVolumeGetMasterVolumeLevelScalar, uintptr(unsafe.Pointer(&level))); hr >= 0 {
+			audioDevice.Volume = float64(level) * 100
+		}
+		var muted int32
+		if hr := endpointVolume.call(endpointVolumeGetMute, uintptr(unsafe.Pointer(&muted))); hr >= 0 {
+			audioDevice.Muted = muted != 0
+		}
+		endpointVolume.release()
+	}
+	return audioDevice
+}
+
+// readAudioSessions enumerates the sessions of an endpoint, skipping expired ones
+func readAudioSessions(device *wasapiObject, flow string) []AudioSession {
+	manager, err := device.activate(iidIAudioSessionManager2)
+	if err != nil {
+		return nil
+	}
+	defer manager.release()
+
+	var enumerator *wasapiObject
+	if hr := manager.call(sessionManager2GetSessionEnumerator, uintptr(unsafe.Pointer(&enumerator))); hr < 0 || enumerator == nil {
+		return nil
+	}
+	defer enumerator.release()
+
+	var count int32
+	if hr := enumerator.call(sessionEnumeratorGetCount, uintptr(unsafe.Pointer(&count))); hr < 0 {
+		return nil
+	}
+
+	var sessions []AudioSession
+	for i := int32(0); i < count; i++ {
+		var control *wasapiObject
+		if hr := enumerator.call(sessionEnumeratorGetSession, uintptr(i), uintptr(unsafe.Pointer(&control))); hr < 0 || control == nil {
+			continue
+		}
+		session, ok := readAudioSession(control, flow)
+		control.release()
+		if ok {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+// readAudioSession reads one IAudioSessionControl (false for expired sessions)
+func readAudioSession(control *wasapiObject, flow string) (AudioSession, bool) {
+	session := AudioSession{Flow: flow}
+
+	var state int32
+	if hr := control.call(sessionControlGetState, uintptr(unsafe.Pointer(&state))); hr < 0 || state == audioSessionExpired {
+		return session, false
+	}
+	session.Active = state == audioSessionActive
+
+	var displayName *uint16
+	if hr := control.call(sessionControlGetDisplayName, uintptr(unsafe.Pointer(&displayName))); hr >= 0 {
+		session.DisplayName = takeCoTaskString(displayName)
+	}
+
+	if control2 := control.queryInterface(iidIAudioSessionControl2); control2 != nil {
+		var processID uint32
+		// 여러 프로세스가 공유하는 세션은 AUDCLNT_S_NO_SINGLE_PROCESS(성공 코드)를 반환
+		if hr := control2.call(sessionControl2GetProcessID, uintptr(unsafe.Pointer(&processID))); hr >= 0 {
+			session.ProcessID = processID
+		}
+		control2.release()
+	}
+	if session.ProcessID == 0 {
+		session.ProcessName = "System Sounds"
+	} else if name, err := winapi.ProcessName(int32(session.ProcessID)); err == nil {
+		session.ProcessName = name
+	}
+
+	if volume := control.queryInterface(iidISimpleAudioVolume); volume != nil {
+		var level float32
+		if hr := volume.call(simpleAudioVolumeGetMasterVolume, uintptr(unsafe.Pointer(&level))); hr >= 0 {
+			session.Volume = float64(level) * 100
+		}
+		var muted int32
+		if hr := volume.call(simpleAudioVolumeGetMute, uintptr(unsafe.Pointer(&muted))); hr >= 0 {
+			session.Muted = muted != 0
+		}
+		volume.release()
+	}
+
+	if meter := control.queryInterface(iidIAudioMeterInfo); meter != nil {
+		var peak float32
+		if hr := meter.call(meterInformationGetPeakValue, uintptr(unsafe.Pointer(&peak))); hr >= 0 {
+			session.PeakLevel = float64(peak) * 100
+		}
+		meter.release()
+	}
+	return session, true
+}
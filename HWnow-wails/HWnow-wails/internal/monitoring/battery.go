@@ -0,0 +1,235 @@
+package monitoring
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 배터리 건강도 및 전력 정보
+// Windows: root\wmi BatteryStaticData / BatteryFullChargedCapacity / BatteryStatus
+// Linux:   /sys/class/power_supply/BAT*
+
+const linuxPowerSupplyPath = "/sys/class/power_supply"
+
+// Win32_Battery.EstimatedRunTime 값이 AC 전원 연결 상태를 의미하는 특수값
+const wmiRunTimeOnACPower = 71582788
+
+var (
+	// 배터리 용량 정보 (거의 변하지 않음 - 10분 캐시)
+	wmiBatteryDesignCache       = &WMICache{}
+	wmiBatteryFullCapacityCache = &WMICache{}
+	wmiBatteryCapacityTTL       = 600 * time.Second
+
+	// 배터리 충/방전 전력 (자주 변함 - 10초 캐시)
+	wmiBatteryRateCache = &WMICache{}
+	wmiBatteryRateTTL   = 10 * time.Second
+)
+
+// parseWMIListOutput parses "Key=Value" lines in wmic /format:list layout (see queryWMIList)
+func parseWMIListOutput(output []byte) map[string]string {
+	values := make(map[string]string)
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		// 여러 배터리가 있는 경우 첫 번째 값만 사용
+		if _, exists := values[key]; !exists {
+			values[key] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+// getWMICached executes a WMI query and caches the list-formatted output in the given cache
+func getWMICached(cache *WMICache, ttl time.Duration, namespace, class string, properties ...string) ([]byte, error) {
+	cache.mutex.RLock()
+	if time.Since(cache.timestamp) < ttl && cache.data != "" {
+		data := cache.data
+		cache.mutex.RUnlock()
+		return []byte(data), nil
+	}
+	cache.mutex.RUnlock()
+
+	output, err := queryWMIList(namespace, class, "", properties...)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mutex.Lock()
+	cache.data = string(output)
+	cache.timestamp = time.Now()
+	cache.mutex.Unlock()
+
+	return output, nil
+}
+
+// enrichBatteryInfoWindows adds capacity, health and power draw details using root\wmi battery classes
+func enrichBatteryInfoWindows(info *BatteryInfo) {
+	capacityOutput, err := getWMICached(wmiBatteryDesignCache, wmiBatteryCapacityTTL,
+		`root\wmi`, "BatteryStaticData", "DesignedCapacity")
+	if err == nil {
+		if v, err := strconv.ParseFloat(parseWMIListOutput(capacityOutput)["DesignedCapacity"], 64); err == nil {
+			info.DesignCapacity = v
+		}
+	} else {
+		LogDebug("BatteryStaticData query failed", "error", err)
+	}
+
+	fullOutput, err := getWMICached(wmiBatteryFullCapacityCache, wmiBatteryCapacityTTL,
+		`root\wmi`, "BatteryFullChargedCapacity", "FullChargedCapacity")
+	if err == nil {
+		if v, err := strconv.ParseFloat(parseWMIListOutput(fullOutput)["FullChargedCapacity"], 64); err == nil {
+			info.FullChargeCapacity = v
+		}
+	} else {
+		LogDebug("BatteryFullChargedCapacity query failed", "error", err)
+	}
+
+	rateOutput, err := getWMICached(wmiBatteryRateCache, wmiBatteryRateTTL,
+		`root\wmi`, "BatteryStatus", "ChargeRate", "DischargeRate", "RemainingCapacity")
+	if err == nil {
+		values := parseWMIListOutput(rateOutput)
+		chargeRate, _ := strconv.ParseFloat(values["ChargeRate"], 64)       // mW
+		dischargeRate, _ := strconv.ParseFloat(values["DischargeRate"], 64) // mW
+		remaining, _ := strconv.ParseFloat(values["RemainingCapacity"], 64) // mWh
+
+		switch {
+		case dischargeRate > 0:
+			info.ChargeRateWatts = -dischargeRate / 1000
+		case chargeRate > 0:
+			info.ChargeRateWatts = chargeRate / 1000
+		}
+		info.TimeRemainingMinutes = estimateBatteryMinutes(remaining, info.FullChargeCapacity, info.ChargeRateWatts*1000)
+	} else {
+		LogDebug("BatteryStatus query failed", "error", err)
+	}
+
+	info.HealthPercent = calculateBatteryHealth(info.DesignCapacity, info.FullChargeCapacity)
+}
+
+// getBatteryInfoLinux reads battery status from /sys/class/power_supply
+func getBatteryInfoLinux() (*BatteryInfo, error) {
+	matches, err := filepath.Glob(filepath.Join(linuxPowerSupplyPath, "BAT*"))
+	if err != nil || len(matches) == 0 {
+		return nil, fmt.Errorf("no battery detected on this system")
+	}
+	dir := matches[0]
+
+	info := &BatteryInfo{
+		Percent:              -1,
+		HealthPercent:        -1,
+		TimeRemainingMinutes: -1,
+	}
+
+	if v, ok := readSysfsFloat(dir, "capacity"); ok {
+		info.Percent = v
+	}
+
+	status := strings.TrimSpace(readSysfsString(dir, "status"))
+	if status == "Charging" || status == "Full" || status == "Not charging" {
+		info.Plugged = 1.0
+	}
+
+	// energy_* (µWh, µW) 또는 charge_* (µAh, µA) + voltage_now (µV)
+	var nowWh, fullWh, designWh, powerW float64
+	if v, ok := readSysfsFloat(dir, "energy_full_design"); ok {
+		designWh = v / 1e6
+		fullWh, _ = readSysfsFloat(dir, "energy_full")
+		fullWh /= 1e6
+		nowWh, _ = readSysfsFloat(dir, "energy_now")
+		nowWh /= 1e6
+		powerW, _ = readSysfsFloat(dir, "power_now")
+		powerW /= 1e6
+	} else if v, ok := readSysfsFloat(dir, "charge_full_design"); ok {
+		voltage, _ := readSysfsFloat(dir, "voltage_min_design")
+		if voltage == 0 {
+			voltage, _ = readSysfsFloat(dir, "voltage_now")
+		}
+		volts := voltage / 1e6
+		designWh = v / 1e6 * volts
+		full, _ := readSysfsFloat(dir, "charge_full")
+		fullWh = full / 1e6 * volts
+		now, _ := readSysfsFloat(dir, "charge_now")
+		nowWh = now / 1e6 * volts
+		current, _ := readSysfsFloat(dir, "current_now")
+		powerW = current / 1e6 * volts
+	}
+
+	// mWh 단위로 Windows와 동일하게 보고
+	info.DesignCapacity = designWh * 1000
+	info.FullChargeCapacity = fullWh * 1000
+	info.HealthPercent = calculateBatteryHealth(info.DesignCapacity, info.FullChargeCapacity)
+
+	if powerW < 0 {
+		powerW = -powerW // 일부 드라이버는 방전 시 음수 값을 보고
+	}
+	switch status {
+	case "Discharging":
+		info.ChargeRateWatts = -powerW
+	case "Charging":
+		info.ChargeRateWatts = powerW
+	}
+	info.TimeRemainingMinutes = estimateBatteryMinutes(nowWh*1000, info.FullChargeCapacity, info.ChargeRateWatts*1000)
+
+	if info.Percent == -1 && info.FullChargeCapacity > 0 {
+		info.Percent = nowWh * 1000 / info.FullChargeCapacity * 100
+	}
+
+	return info, nil
+}
+
+// calculateBatteryHealth returns full-charge capacity as a percentage of design capacity, or -1 if unknown
+func calculateBatteryHealth(designCapacity, fullChargeCapacity float64) float64 {
+	if designCapacity <= 0 || fullChargeCapacity <= 0 {
+		return -1
+	}
+	health := fullChargeCapacity / designCapacity * 100
+	if health > 100 {
+		health = 100
+	}
+	return health
+}
+
+// estimateBatteryMinutes estimates minutes until empty (discharging) or full (charging), or -1 if unknown.
+// remaining/full are in mWh, rate is in mW (positive when charging, negative when discharging).
+func estimateBatteryMinutes(remaining, full, rate float64) float64 {
+	switch {
+	case rate < 0 && remaining > 0:
+		return remaining / -rate * 60
+	case rate > 0 && full > remaining:
+		return (full - remaining) / rate * 60
+	default:
+		return -1
+	}
+}
+
+// readSysfsString reads a sysfs attribute as a string
+func readSysfsString(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// readSysfsFloat reads a numeric sysfs attribute
+func readSysfsFloat(dir, name string) (float64, bool) {
+	value := strings.TrimSpace(readSysfsString(dir, name))
+	if value == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,47 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBatteryHelpers(t *testing.T) {
+
+	t.Run("Parse_WMI_List_Output", func(t *testing.T) {
+		output := []byte("\r\n\r\nBatteryStatus=2\r\nEstimatedChargeRemaining=87\r\n\r\nBatteryStatus=1\r\n")
+		values := parseWMIListOutput(output)
+
+		if values["BatteryStatus"] != "2" {
+			t.Errorf("Expected first BatteryStatus value 2, got %q", values["BatteryStatus"])
+		}
+		if values["EstimatedChargeRemaining"] != "87" {
+			t.Errorf("Expected EstimatedChargeRemaining 87, got %q", values["EstimatedChargeRemaining"])
+		}
+	})
+
+	t.Run("Battery_Health", func(t *testing.T) {
+		if health := calculateBatteryHealth(50000, 40000); health != 80 {
+			t.Errorf("Expected 80%% health, got %f", health)
+		}
+		if health := calculateBatteryHealth(0, 40000); health != -1 {
+			t.Errorf("Expected -1 for unknown design capacity, got %f", health)
+		}
+		if health := calculateBatteryHealth(50000, 52000); health != 100 {
+			t.Errorf("Health should be capped at 100%%, got %f", health)
+		}
+	})
+
+	t.Run("Time_Remaining", func(t *testing.T) {
+		// 30Wh 남음, 15W 방전 -> 120분
+		if minutes := estimateBatteryMinutes(30000, 50000, -15000); math.Abs(minutes-120) > 0.001 {
+			t.Errorf("Expected 120 minutes to empty, got %f", minutes)
+		}
+		// 20Wh 부족, 40W 충전 -> 30분
+		if minutes := estimateBatteryMinutes(30000, 50000, 40000); math.Abs(minutes-30) > 0.001 {
+			t.Errorf("Expected 30 minutes to full, got %f", minutes)
+		}
+		if minutes := estimateBatteryMinutes(30000, 50000, 0); minutes != -1 {
+			t.Errorf("Expected -1 when rate is unknown, got %f", minutes)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+)
+
+// 캐시된 응답의 나이 추적 및 오래된 데이터 경고
+// 캐시는 조회 시점에만 갱신되므로 수집이 꺼져 있거나(GPU 프로세스 모니터링 비활성화) 갱신이 막히면 오래된 데이터가 계속 반환될 수 있음
+// 캐시 데이터가 임계값과 캐시 자체 갱신 주기보다 오래되면 경고를 한 번 보내고, 다시 새 데이터가 반환되면 경고를 재설정
+
+// Cached data names
+const (
+	CacheGPUInfo          = "gpu_info"
+	CacheGPUProcesses     = "gpu_processes"
+	CacheVideoControllers = "video_controllers"
+)
+
+// DEFAULT_CACHE_STALE_THRESHOLD is the default age after which served cache data triggers a warning
+const DEFAULT_CACHE_STALE_THRESHOLD = 2 * time.Minute
+
+// CacheStatus reports when cached data was collected and how old it is
+type CacheStatus struct {
+	Name        string    `json:"name"`
+	LastUpdated time.Time `json:"last_updated"` // 한 번도 수집되지 않았으면 zero
+	CacheAgeMs  int64     `json:"cache_age_ms"`
+	MaxAgeMs    int64     `json:"max_age_ms"` // 캐시 갱신 주기
+	Stale       bool      `json:"stale"`
+}
+
+// CacheStaleAlert reports cached data served while older than the warning threshold
+type CacheStaleAlert struct {
+	Cache       string    `json:"cache"`
+	LastUpdated time.Time `json:"last_updated"`
+	CacheAgeMs  int64     `json:"cache_age_ms"`
+	ThresholdMs int64     `json:"threshold_ms"`
+}
+
+var cacheStaleness = struct {
+	mutex     sync.Mutex
+	threshold time.Duration // 0 = 경고 비활성화
+	handler   func(CacheStaleAlert)
+	stale     map[string]bool
+}{threshold: DEFAULT_CACHE_STALE_THRESHOLD, stale: make(map[string]bool)}
+
+// SetCacheStaleThreshold sets the age after which served cache data triggers a warning (0 disables warnings)
+func SetCacheStaleThreshold(threshold time.Duration) {
+	cacheStaleness.mutex.Lock()
+	defer cacheStaleness.mutex.Unlock()
+	if threshold < 0 {
+		threshold = 0
+	}
+	cacheStaleness.threshold = threshold
+}
+
+// SetCacheStaleHandler sets the callback for cached data served while older than the warning threshold
+func SetCacheStaleHandler(handler func(CacheStaleAlert)) {
+	cacheStaleness.mutex.Lock()
+	defer cacheStaleness.mutex.Unlock()
+	cacheStaleness.handler = handler
+}
+
+// cacheMaxAge returns the refresh period of a named cache
+func cacheMaxAge(cache string) time.Duration {
+	switch cache {
+	case CacheGPUInfo:
+		return GPU_INFO_CACHE_DURATION
+	case CacheGPUProcesses:
+		return GPU_PROCESS_CACHE_DURATION
+	case CacheVideoControllers:
+		return VIDEO_CONTROLLER_CACHE_DURATION
+	}
+	return 0
+}
+
+// isCacheStale reports whether data of this age exceeds both the warning threshold and the cache's own refresh period
+func isCacheStale(cache string, age, threshold time.Duration) bool {
+	return threshold > 0 && age > threshold && age > cacheMaxAge(cache)
+}
+
+// observeCacheAge records that cached data collected at lastUpdated was served and returns its age in milliseconds
+func observeCacheAge(cache string, lastUpdated time.Time) int64 {
+	if lastUpdated.IsZero() {
+		return 0
+	}
+	age := time.Since(lastUpdated)
+
+	cacheStaleness.mutex.Lock()
+	threshold := cacheStaleness.threshold
+	stale := isCacheStale(cache, age, threshold)
+	notify := stale && !cacheStaleness.stale[cache]
+	cacheStaleness.stale[cache] = stale
+	handler := cacheStaleness.handler
+	cacheStaleness.mutex.Unlock()
+
+	if notify {
+		LogWarn("Serving stale cached data", "cache", cache, "age", age.Round(time.Second), "threshold", threshold)
+		if handler != nil {
+			// 캐시 잠금을 잡은 채 호출될 수 있으므로 핸들러(이벤트 기록)는 별도 고루틴에서 실행
+			go handler(CacheStaleAlert{
+				Cache:       cache,
+				LastUpdated: lastUpdated,
+				CacheAgeMs:  age.Milliseconds(),
+				ThresholdMs: threshold.Milliseconds(),
+			})
+		}
+	}
+	return age.Milliseconds()
+}
+
+// GetCacheStatuses reports the age of the GPU info, GPU process and video controller caches
+func GetCacheStatuses() []CacheStatus {
+	gpuInfoCache.mutex.RLock()
+	gpuInfoUpdated := gpuInfoCache.lastUpdated
+	gpuInfoCache.mutex.RUnlock()
+
+	gpuProcessCache.mutex.RLock()
+	gpuProcessesUpdated := gpuProcessCache.lastUpdated
+	gpuProcessCache.mutex.RUnlock()
+
+	videoControllerCache.mutex.RLock()
+	videoControllersUpdated := videoControllerCache.lastUpdated
+	videoControllerCache.mutex.RUnlock()
+
+	cacheStaleness.mutex.Lock()
+	threshold := cacheStaleness.threshold
+	cacheStaleness.mutex.Unlock()
+
+	now := time.Now()
+	statuses := make([]CacheStatus, 0, 3)
+	for _, entry := range []struct {
+		name        string
+		lastUpdated time.Time
+	}{
+		{CacheGPUInfo, gpuInfoUpdated},
+		{CacheGPUProcesses, gpuProcessesUpdated},
+		{CacheVideoControllers, videoControllersUpdated},
+	} {
+		status := CacheStatus{
+			Name:        entry.name,
+			LastUpdated: entry.lastUpdated,
+			MaxAgeMs:    cacheMaxAge(entry.name).Milliseconds(),
+		}
+		if !entry.lastUpdated.IsZero() {
+			age := now.Sub(entry.lastUpdated)
+			status.CacheAgeMs = age.Milliseconds()
+			status.Stale = isCacheStale(entry.name, age, threshold)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
@@ -0,0 +1,121 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+// useCacheStaleHandler installs a threshold and a handler collecting alerts for the duration of the test
+func useCacheStaleHandler(t *testing.T, threshold time.Duration) chan CacheStaleAlert {
+	t.Helper()
+	alerts := make(chan CacheStaleAlert, 8)
+
+	cacheStaleness.mutex.Lock()
+	previousThreshold, previousHandler, previousStale := cacheStaleness.threshold, cacheStaleness.handler, cacheStaleness.stale
+	cacheStaleness.threshold = threshold
+	cacheStaleness.handler = func(alert CacheStaleAlert) { alerts <- alert }
+	cacheStaleness.stale = make(map[string]bool)
+	cacheStaleness.mutex.Unlock()
+
+	t.Cleanup(func() {
+		cacheStaleness.mutex.Lock()
+		cacheStaleness.threshold, cacheStaleness.handler, cacheStaleness.stale = previousThreshold, previousHandler, previousStale
+		cacheStaleness.mutex.Unlock()
+	})
+	return alerts
+}
+
+func expectCacheStaleAlert(t *testing.T, alerts chan CacheStaleAlert) CacheStaleAlert {
+	t.Helper()
+	select {
+	case alert := <-alerts:
+		return alert
+	case <-time.After(time.Second):
+		t.Fatal("Expected a stale cache alert")
+	}
+	return CacheStaleAlert{}
+}
+
+func expectNoCacheStaleAlert(t *testing.T, alerts chan CacheStaleAlert) {
+	t.Helper()
+	select {
+	case alert := <-alerts:
+		t.Errorf("Unexpected stale cache alert: %+v", alert)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCacheStaleness(t *testing.T) {
+
+	t.Run("Warns_Once_Until_Fresh", func(t *testing.T) {
+		alerts := useCacheStaleHandler(t, time.Minute)
+		lastUpdated := time.Now().Add(-8 * time.Minute)
+
+		if age := observeCacheAge(CacheGPUProcesses, lastUpdated); age < (8 * time.Minute).Milliseconds() {
+			t.Errorf("Unexpected age %d", age)
+		}
+		alert := expectCacheStaleAlert(t, alerts)
+		if alert.Cache != CacheGPUProcesses || alert.ThresholdMs != time.Minute.Milliseconds() || !alert.LastUpdated.Equal(lastUpdated) {
+			t.Errorf("Unexpected alert: %+v", alert)
+		}
+
+		observeCacheAge(CacheGPUProcesses, lastUpdated)
+		expectNoCacheStaleAlert(t, alerts)
+
+		// 새 데이터가 반환되면 경고가 재설정됨
+		observeCacheAge(CacheGPUProcesses, time.Now())
+		observeCacheAge(CacheGPUProcesses, lastUpdated)
+		expectCacheStaleAlert(t, alerts)
+	})
+
+	t.Run("Within_Refresh_Period_Not_Stale", func(t *testing.T) {
+		alerts := useCacheStaleHandler(t, time.Minute)
+
+		// GPU 정보 캐시는 10분 주기로 갱신되므로 5분 된 데이터는 정상
+		observeCacheAge(CacheGPUInfo, time.Now().Add(-5*time.Minute))
+		expectNoCacheStaleAlert(t, alerts)
+	})
+
+	t.Run("Zero_Threshold_Disables", func(t *testing.T) {
+		alerts := useCacheStaleHandler(t, 0)
+
+		observeCacheAge(CacheGPUProcesses, time.Now().Add(-time.Hour))
+		expectNoCacheStaleAlert(t, alerts)
+	})
+
+	t.Run("Never_Collected", func(t *testing.T) {
+		alerts := useCacheStaleHandler(t, time.Minute)
+
+		if age := observeCacheAge(CacheGPUProcesses, time.Time{}); age != 0 {
+			t.Errorf("Expected age 0, got %d", age)
+		}
+		expectNoCacheStaleAlert(t, alerts)
+	})
+
+	t.Run("Disabled_GPU_Process_Monitoring_Serves_Stale_List", func(t *testing.T) {
+		alerts := useCacheStaleHandler(t, time.Minute)
+		useGPUProcessCache(t, []GPUProcess{{PID: 1, Name: "game.exe"}}, nil)
+		gpuProcessCache.mutex.Lock()
+		gpuProcessCache.lastUpdated = time.Now().Add(-8 * time.Minute)
+		gpuProcessCache.mutex.Unlock()
+
+		previous := IsGPUProcessMonitoringEnabled()
+		SetGPUProcessMonitoringEnabled(false)
+		t.Cleanup(func() { SetGPUProcessMonitoringEnabled(previous) })
+
+		response, err := GetGPUProcessesFilteredInternal(GPUProcessQuery{MaxItems: 10})
+		if err != nil {
+			t.Fatalf("GetGPUProcessesFilteredInternal failed: %v", err)
+		}
+		if response.CacheAgeMs < (8*time.Minute).Milliseconds() || response.LastUpdated.IsZero() {
+			t.Errorf("Unexpected cache age: %d (last updated %v)", response.CacheAgeMs, response.LastUpdated)
+		}
+		expectCacheStaleAlert(t, alerts)
+
+		for _, status := range GetCacheStatuses() {
+			if status.Name == CacheGPUProcesses && !status.Stale {
+				t.Errorf("Expected the GPU process cache to be reported stale: %+v", status)
+			}
+		}
+	})
+}
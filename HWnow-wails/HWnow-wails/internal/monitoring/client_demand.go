@@ -0,0 +1,246 @@
+package monitoring
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// 클라이언트별 위젯 가시성에 따른 수집 빈도 조정
+// 프론트엔드가 세션마다 화면에 보이는 위젯과 포그라운드 여부를 보고하면, 비용이 큰 수집기는 그 위젯을 보고 있는
+// 클라이언트가 있을 때만 실행하고, 백그라운드 클라이언트만 보고 있으면 백그라운드 간격으로 늦춤
+// 가시성을 보고한 클라이언트가 없으면 기존처럼 모든 수집기를 실행
+
+// DEFAULT_BACKGROUND_INTERVAL is the default minimum time between collections for clients in the background
+const DEFAULT_BACKGROUND_INTERVAL = 30 * time.Second
+
+// widgetCollectors maps frontend widget types to the on-demand collectors they display
+// (CPU/메모리/디스크/네트워크 등 기록과 알림에 쓰이는 수집기는 가시성과 관계없이 항상 실행)
+var widgetCollectors = map[string][]string{
+	"gpu":             {"gpu_engines", "gpu_adapters", "gpu_bandwidth"},
+	"gpu_process":     {"gpu_processes"},
+	"process_monitor": {"top_processes"},
+	"network_status":  {"wifi"},
+}
+
+// ClientVisibility is a client session's report of its foreground state and visible widgets
+type ClientVisibility struct {
+	SessionID  string    `json:"session_id"`
+	Foreground bool      `json:"foreground"`
+	Widgets    []string  `json:"widgets"` // 화면에 보이는 위젯 유형 (nil = 알 수 없음, 모든 위젯으로 취급)
+	ReportedAt time.Time `json:"reported_at"`
+}
+
+// CollectorDemand describes how often an on-demand collector runs for the reporting clients
+type CollectorDemand struct {
+	Collector  string   `json:"collector"`
+	Active     bool     `json:"active"`      // 이 수집기의 위젯을 보고 있는 클라이언트가 있음
+	IntervalMs int64    `json:"interval_ms"` // 0 = 매 수집 주기
+	Sessions   []string `json:"sessions"`    // 이 수집기의 위젯을 보고 있는 세션
+}
+
+// ClientDemandStatus describes the reporting clients and the resulting collector rates
+type ClientDemandStatus struct {
+	BackgroundIntervalMs int64              `json:"background_interval_ms"`
+	AllBackground        bool               `json:"all_background"`
+	Clients              []ClientVisibility `json:"clients"`
+	Collectors           []CollectorDemand  `json:"collectors"`
+}
+
+// ClientDemandTracker decides which on-demand collectors run, and how often, from client visibility reports
+type ClientDemandTracker struct {
+	mutex              sync.Mutex
+	cycleInterval      time.Duration // 전체 수집 주기 (interval_seconds)
+	backgroundInterval time.Duration
+	clients            map[string]ClientVisibility
+	lastRun            map[string]time.Time
+}
+
+// NewClientDemandTracker creates a tracker with no reporting clients
+func NewClientDemandTracker(cycleInterval, backgroundInterval time.Duration) *ClientDemandTracker {
+	t := &ClientDemandTracker{
+		cycleInterval: cycleInterval,
+		clients:       make(map[string]ClientVisibility),
+		lastRun:       make(map[string]time.Time),
+	}
+	t.SetBackgroundInterval(backgroundInterval)
+	return t
+}
+
+// SetBackgroundInterval sets the minimum time between collections for clients in the background
+func (t *ClientDemandTracker) SetBackgroundInterval(interval time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if interval <= 0 {
+		interval = DEFAULT_BACKGROUND_INTERVAL
+	}
+	if interval < t.cycleInterval {
+		interval = t.cycleInterval
+	}
+	t.backgroundInterval = interval
+}
+
+// BackgroundInterval returns the minimum time between collections for clients in the background
+func (t *ClientDemandTracker) BackgroundInterval() time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.backgroundInterval
+}
+
+// Report records a client's foreground state and visible widgets, replacing its previous report
+func (t *ClientDemandTracker) Report(visibility ClientVisibility) {
+	if visibility.ReportedAt.IsZero() {
+		visibility.ReportedAt = time.Now()
+	}
+
+	t.mutex.Lock()
+	previous, known := t.clients[visibility.SessionID]
+	t.clients[visibility.SessionID] = visibility
+	t.mutex.Unlock()
+
+	if !known || previous.Foreground != visibility.Foreground {
+		LogInfo("Client visibility changed", "session", visibility.SessionID, "foreground", visibility.Foreground, "widgets", len(visibility.Widgets))
+	}
+}
+
+// Remove forgets a disconnected client; returns false if the session never reported
+func (t *ClientDemandTracker) Remove(sessionID string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, known := t.clients[sessionID]; !known {
+		return false
+	}
+	delete(t.clients, sessionID)
+	return true
+}
+
+// AllBackground reports whether clients have reported and every one of them is in the background
+func (t *ClientDemandTracker) AllBackground() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if len(t.clients) == 0 {
+		return false
+	}
+	for _, client := range t.clients {
+		if client.Foreground {
+			return false
+		}
+	}
+	return true
+}
+
+// ShouldRun reports whether an on-demand collector is due for the reporting clients;
+// other collectors, and every collector while no client has reported, always run
+func (t *ClientDemandTracker) ShouldRun(name string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.clients) == 0 || !isOnDemandCollector(name) {
+		return true
+	}
+
+	demand := t.demandLocked(name)
+	if !demand.Active {
+		return false
+	}
+
+	now := time.Now()
+	interval := time.Duration(demand.IntervalMs) * time.Millisecond
+	// 적응형 스케줄러와 같이 프론트엔드 폴링 지터를 고려해 반 주기 일찍 실행
+	if interval <= t.cycleInterval || now.Sub(t.lastRun[name]) >= interval-t.cycleInterval/2 {
+		t.lastRun[name] = now
+		return true
+	}
+	return false
+}
+
+// Status returns the reporting clients and the current rate of every on-demand collector
+func (t *ClientDemandTracker) Status() ClientDemandStatus {
+	allBackground := t.AllBackground()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	status := ClientDemandStatus{
+		BackgroundIntervalMs: t.backgroundInterval.Milliseconds(),
+		AllBackground:        allBackground,
+		Clients:              make([]ClientVisibility, 0, len(t.clients)),
+		Collectors:           make([]CollectorDemand, 0),
+	}
+	for _, client := range t.clients {
+		status.Clients = append(status.Clients, client)
+	}
+	sort.Slice(status.Clients, func(i, j int) bool { return status.Clients[i].SessionID < status.Clients[j].SessionID })
+
+	for _, name := range onDemandCollectors() {
+		if len(t.clients) == 0 {
+			status.Collectors = append(status.Collectors, CollectorDemand{Collector: name, Active: true, Sessions: []string{}})
+			continue
+		}
+		status.Collectors = append(status.Collectors, t.demandLocked(name))
+	}
+	return status
+}
+
+// demandLocked computes the fastest rate any client needs for a collector (caller holds the mutex)
+func (t *ClientDemandTracker) demandLocked(name string) CollectorDemand {
+	demand := CollectorDemand{Collector: name, Sessions: make([]string, 0)}
+	interval := time.Duration(-1)
+	for sessionID, client := range t.clients {
+		if !client.showsCollector(name) {
+			continue
+		}
+		demand.Sessions = append(demand.Sessions, sessionID)
+
+		clientInterval := t.backgroundInterval
+		if client.Foreground {
+			clientInterval = 0
+		}
+		if interval < 0 || clientInterval < interval {
+			interval = clientInterval
+		}
+	}
+	sort.Strings(demand.Sessions)
+
+	if interval >= 0 {
+		demand.Active = true
+		demand.IntervalMs = interval.Milliseconds()
+	}
+	return demand
+}
+
+// showsCollector reports whether one of the client's visible widgets displays the collector
+func (v ClientVisibility) showsCollector(name string) bool {
+	if v.Widgets == nil {
+		return true
+	}
+	for _, widget := range v.Widgets {
+		for _, collector := range widgetCollectors[widget] {
+			if collector == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isOnDemandCollector(name string) bool {
+	for _, collectors := range widgetCollectors {
+		for _, collector := range collectors {
+			if collector == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// onDemandCollectors returns the names of every collector driven by widget visibility, sorted
+func onDemandCollectors() []string {
+	names := make([]string, 0)
+	for _, collectors := range widgetCollectors {
+		names = append(names, collectors...)
+	}
+	sort.Strings(names)
+	return names
+}
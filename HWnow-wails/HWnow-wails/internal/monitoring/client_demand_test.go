@@ -0,0 +1,101 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClientDemandTracker(t *testing.T) {
+
+	t.Run("No_Reports_Runs_Everything", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 30*time.Second)
+		if !tracker.ShouldRun("gpu_processes") || !tracker.ShouldRun("cpu") || tracker.AllBackground() {
+			t.Error("Expected every collector to run before any client reports")
+		}
+	})
+
+	t.Run("Hidden_Widget_Skips_Collector", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 30*time.Second)
+		tracker.Report(ClientVisibility{SessionID: "tab-1", Foreground: true, Widgets: []string{"cpu", "process_monitor"}})
+
+		if tracker.ShouldRun("gpu_processes") {
+			t.Error("Expected the GPU process scan to be skipped without a visible gpu_process widget")
+		}
+		if !tracker.ShouldRun("top_processes") || !tracker.ShouldRun("top_processes") {
+			t.Error("Expected a visible widget in the foreground to run every cycle")
+		}
+		if !tracker.ShouldRun("cpu") {
+			t.Error("Expected collectors not driven by visibility to always run")
+		}
+	})
+
+	t.Run("Background_Client_Slows_Collector", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 30*time.Second)
+		tracker.Report(ClientVisibility{SessionID: "tab-1", Foreground: false, Widgets: []string{"gpu_process"}})
+
+		if !tracker.AllBackground() {
+			t.Error("Expected all clients to be in the background")
+		}
+		if !tracker.ShouldRun("gpu_processes") || tracker.ShouldRun("gpu_processes") {
+			t.Error("Expected a background client to run the collector once and then wait for the background interval")
+		}
+
+		// 같은 위젯을 보는 포그라운드 클라이언트가 있으면 가장 빠른 주기를 따름
+		tracker.Report(ClientVisibility{SessionID: "tab-2", Foreground: true, Widgets: []string{"gpu_process"}})
+		if !tracker.ShouldRun("gpu_processes") {
+			t.Error("Expected a foreground client to restore the cycle rate")
+		}
+	})
+
+	t.Run("Unknown_Widgets_Demand_All", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 30*time.Second)
+		tracker.Report(ClientVisibility{SessionID: "tab-1", Foreground: true})
+
+		if !tracker.ShouldRun("gpu_processes") || !tracker.ShouldRun("wifi") {
+			t.Error("Expected a client without a widget list to need every collector")
+		}
+	})
+
+	t.Run("Remove_Restores_Default", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 30*time.Second)
+		tracker.Report(ClientVisibility{SessionID: "tab-1", Foreground: true, Widgets: []string{}})
+		if tracker.ShouldRun("gpu_engines") {
+			t.Error("Expected no GPU engine collection without visible widgets")
+		}
+
+		if !tracker.Remove("tab-1") || tracker.Remove("tab-1") {
+			t.Error("Expected the session to be removed exactly once")
+		}
+		if !tracker.ShouldRun("gpu_engines") {
+			t.Error("Expected every collector to run once all clients are gone")
+		}
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		tracker := NewClientDemandTracker(time.Second, 0)
+		tracker.Report(ClientVisibility{SessionID: "tab-b", Foreground: false, Widgets: []string{"gpu"}})
+		tracker.Report(ClientVisibility{SessionID: "tab-a", Foreground: true, Widgets: []string{"process_monitor"}})
+
+		status := tracker.Status()
+		if status.BackgroundIntervalMs != DEFAULT_BACKGROUND_INTERVAL.Milliseconds() || status.AllBackground {
+			t.Errorf("Unexpected status: %+v", status)
+		}
+		if len(status.Clients) != 2 || status.Clients[0].SessionID != "tab-a" {
+			t.Errorf("Expected clients sorted by session, got %+v", status.Clients)
+		}
+
+		demands := make(map[string]CollectorDemand)
+		for _, demand := range status.Collectors {
+			demands[demand.Collector] = demand
+		}
+		if demand := demands["gpu_engines"]; !demand.Active || demand.IntervalMs != DEFAULT_BACKGROUND_INTERVAL.Milliseconds() {
+			t.Errorf("Expected GPU engines at the background interval, got %+v", demand)
+		}
+		if demand := demands["top_processes"]; !demand.Active || demand.IntervalMs != 0 || len(demand.Sessions) != 1 {
+			t.Errorf("Expected top processes every cycle for one session, got %+v", demand)
+		}
+		if demand := demands["gpu_processes"]; demand.Active {
+			t.Errorf("Expected GPU processes inactive, got %+v", demand)
+		}
+	})
+}
@@ -7,16 +7,16 @@ import (
 	"io"
 	"log"
 	"os"
-	"os/exec"
 	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
 
+	"HWnow-wails/internal/winapi"
+
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/host"
@@ -46,22 +46,6 @@ func getMonitorManager() MonitorManager {
 }
 
 
-// 로깅 레벨 정의
-type LogLevel int
-
-const (
-	LogLevelDebug LogLevel = iota
-	LogLevelInfo
-	LogLevelWarn
-	LogLevelError
-	LogLevelFatal
-)
-
-var (
-	logLevel = LogLevelInfo
-	logFile  *os.File
-)
-
 type widgetLogWriter struct {
 	target io.Writer
 }
@@ -80,43 +64,6 @@ func init() {
 	log.SetOutput(widgetLogWriter{target: os.Stdout})
 }
 
-// Phase 8: 로깅 완전 비활성화 (극한 최적화)
-// GPU 모니터링 중 과도한 I/O 오버헤드를 제거하여 CPU 사용량 15-25% 감소
-// 203개 로깅 호출의 I/O 오버헤드 완전 제거
-
-// 성능 최적화용 로깅 비활성화 플래그
-const (
-	// GPU 모니터링 중 로깅 완전 비활성화로 극한 성능 확보 및 위젯 로그 분리
-	DISABLE_GPU_MONITORING_LOGS = true
-	// 일반 로깅은 유지 (시스템 안정성 위해)
-	DISABLE_ALL_LOGS = false
-)
-
-// CPU 최적화된 조건부 로깅 함수들
-func LogInfoOptimized(msg string, args ...interface{}) {
-	if !DISABLE_GPU_MONITORING_LOGS {
-		LogInfo(msg, args...)
-	}
-}
-
-func LogDebugOptimized(msg string, args ...interface{}) {
-	if !DISABLE_GPU_MONITORING_LOGS {
-		LogDebug(msg, args...)
-	}
-}
-
-func LogWarnOptimized(msg string, args ...interface{}) {
-	if !DISABLE_GPU_MONITORING_LOGS {
-		LogWarn(msg, args...)
-	}
-}
-
-func LogErrorOptimized(msg string, args ...interface{}) {
-	if !DISABLE_GPU_MONITORING_LOGS {
-		LogError(msg, args...)
-	}
-}
-
 // Widget-specific logging functions with filtering
 // These functions only log widget-related operations
 var ENABLE_WIDGET_LOGS = true  // Widget 로그 활성화 플래그
@@ -130,7 +77,7 @@ func LogWidgetInfo(msg string, args ...interface{}) {
 }
 
 func LogWidgetDebug(msg string, args ...interface{}) {
-	if ENABLE_WIDGET_LOGS && logLevel <= LogLevelDebug {
+	if ENABLE_WIDGET_LOGS && GetLogLevel() <= LogLevelDebug {
 		logArgs := []interface{}{"[WIDGET_DEBUG]", msg}
 		logArgs = append(logArgs, args...)
 		log.Println(logArgs...)
@@ -305,7 +252,7 @@ var (
 )
 
 // Phase 14: WMI 쿼리 캐싱 시스템 (극한 CPU 최적화)
-// 반복적인 wmic 호출을 장시간 캐싱으로 70% 감소
+// 반복적인 WMI 조회를 장시간 캐싱으로 70% 감소 (캐시 내용은 wmic /format:list 형식 텍스트)
 
 // WMI 캐시 구조체
 type WMICache struct {
@@ -342,8 +289,7 @@ func getWMIVideoControllerCached() ([]byte, error) {
 
 	// 캐시 미스 - 새로 쿼리
 	LogDebugOptimized("Phase 14: WMI VideoController cache miss, executing query")
-	cmd := createHiddenCommand("wmic", "path", "win32_VideoController", "get", "Name", "/format:list")
-	output, err := cmd.Output()
+	output, err := queryWMIList("", "Win32_VideoController", "", "Name")
 	if err != nil {
 		return nil, err
 	}
@@ -370,8 +316,7 @@ func getWMIComputerSystemCached() ([]byte, error) {
 
 	// 캐시 미스 - 새로 쿼리
 	LogDebugOptimized("Phase 14: WMI ComputerSystem cache miss, executing query")
-	cmd := createHiddenCommand("wmic", "computersystem", "get", "model", "/format:list")
-	output, err := cmd.Output()
+	output, err := queryWMIList("", "Win32_ComputerSystem", "", "Model")
 	if err != nil {
 		return nil, err
 	}
@@ -398,8 +343,7 @@ func getWMIBatteryCached() ([]byte, error) {
 
 	// 캐시 미스 - 새로 쿼리
 	LogDebugOptimized("Phase 14: WMI Battery cache miss, executing query")
-	cmd := createHiddenCommand("wmic", "path", "Win32_Battery", "get", "EstimatedChargeRemaining,BatteryStatus", "/format:list")
-	output, err := cmd.Output()
+	output, err := queryWMIList("", "Win32_Battery", "", "EstimatedChargeRemaining", "BatteryStatus", "EstimatedRunTime")
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +412,8 @@ func getProcessNamesBatch(pids []int32) map[int32]string {
 	defer processNameCache.mutex.Unlock()
 	
 	if runtime.GOOS == "windows" {
-		cmd := createHiddenCommand("tasklist", "/FO", "CSV", "/NH")
-		output, err := cmd.Output()
+		// Toolhelp32 스냅샷 한 번으로 전체 프로세스 이름 조회 (tasklist 프로세스 생성 없음)
+		names, err := winapi.ProcessNames()
 		if err != nil {
 			// 실패 시 개별 조회로 폴백
 			result := make(map[int32]string)
@@ -479,29 +423,7 @@ func getProcessNamesBatch(pids []int32) map[int32]string {
 			return result
 		}
 		
-		// 전체 프로세스 목록 파싱
-		lines := parseOutputLinesOptimized(output)
-		processNameCache.names = make(map[int32]string)
-		
-		for _, line := range lines {
-			if line == "" {
-				continue
-			}
-			
-			fields := parseFieldsOptimized(line, ",")
-			if len(fields) < 2 {
-				continue
-			}
-			
-			// 프로세스 이름과 PID 추출
-			name := strings.Trim(fields[0], "\"")
-			pidStr := strings.Trim(fields[1], "\"")
-			
-			if pid, err := strconv.ParseInt(pidStr, 10, 32); err == nil {
-				processNameCache.names[int32(pid)] = name
-			}
-		}
-		
+		processNameCache.names = names
 		processNameCache.lastQuery = time.Now()
 	}
 	
@@ -520,22 +442,11 @@ func getProcessNamesBatch(pids []int32) map[int32]string {
 
 // 개별 PID 조회 (폴백용)
 func getProcessNameWindowsSingle(pid int32) string {
-	cmd := createHiddenCommand("tasklist", "/FI", fmt.Sprintf("PID eq %d", pid), "/FO", "CSV", "/NH")
-	output, err := cmd.Output()
-	if err != nil {
+	name, err := winapi.ProcessName(pid)
+	if err != nil || name == "" {
 		return fmt.Sprintf("PID_%d", pid)
 	}
-	
-	line := strings.TrimSpace(string(output))
-	if line != "" {
-		fields := parseFieldsOptimized(line, ",")
-		if len(fields) > 0 {
-			name := strings.Trim(fields[0], "\"")
-			return name
-		}
-	}
-	
-	return fmt.Sprintf("PID_%d", pid)
+	return name
 }
 
 // Phase 10: PowerShell Performance Counter 완전 대체 함수들
@@ -676,6 +587,7 @@ func parseNVIDIAProcessesUnifiedOptimized() ([]GPUProcess, error) {
 				GPUMemory: memory,
 				Type:      "compute",
 				Status:    "running",
+				UsageSource: GPUUsageSourceUnavailable,
 			}
 			processes = append(processes, process)
 		}
@@ -798,6 +710,7 @@ func parseNVIDIAPmonOutputOptimized(output []byte) ([]GPUProcess, error) {
 				Type:      info.processType,
 				Command:   processName,     // 프로세스 이름을 커맨드로 사용
 				Status:    "running",
+				UsageSource: GPUUsageSourceNVIDIAPmon,
 			}
 			
 			LogInfoOptimized("실제 GPU 사용률 할당 완료",
@@ -826,6 +739,7 @@ func parseNVIDIAPmonOutputOptimized(output []byte) ([]GPUProcess, error) {
 // GPU 프로세스 캐시 구조체
 type GPUProcessCache struct {
 	processes   []GPUProcess
+	summary     *GPUSummary // 프로세스 목록과 같은 시점의 VRAM 용량
 	lastUpdated time.Time
 	mutex       sync.RWMutex
 }
@@ -951,77 +865,6 @@ const (
 	ErrorCodeSystemError          = 1007
 )
 
-// InitializeLogging - 로깅 시스템 초기화
-func InitializeLogging(level LogLevel, logFilePath string) error {
-	logLevel = level
-
-	var writer io.Writer = os.Stdout
-	if logFilePath != "" {
-		var err error
-		logFile, err = os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-		if err != nil {
-			return fmt.Errorf("failed to open log file: %v", err)
-		}
-		// ?? ???? ??? ??? ?? ??
-		writer = io.MultiWriter(os.Stdout, logFile)
-	}
-
-	log.SetOutput(widgetLogWriter{target: writer})
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
-	LogInfo("Logging system initialized", "level", level, "file", logFilePath)
-
-	return nil
-}
-
-
-// CloseLogging - 로깅 시스템 종료
-func CloseLogging() {
-	if logFile != nil {
-		logFile.Close()
-	}
-}
-
-// 로깅 함수들
-func LogDebug(message string, keyvals ...interface{}) {
-	if logLevel <= LogLevelDebug {
-		args := []interface{}{"[DEBUG]", message}
-		args = append(args, keyvals...)
-		log.Println(args...)
-	}
-}
-
-func LogInfo(message string, keyvals ...interface{}) {
-	if logLevel <= LogLevelInfo {
-		args := []interface{}{"[INFO]", message}
-		args = append(args, keyvals...)
-		log.Println(args...)
-	}
-}
-
-func LogWarn(message string, keyvals ...interface{}) {
-	if logLevel <= LogLevelWarn {
-		args := []interface{}{"[WARN]", message}
-		args = append(args, keyvals...)
-		log.Println(args...)
-	}
-}
-
-func LogError(message string, keyvals ...interface{}) {
-	if logLevel <= LogLevelError {
-		args := []interface{}{"[ERROR]", message}
-		args = append(args, keyvals...)
-		log.Println(args...)
-	}
-}
-
-func LogFatal(message string, keyvals ...interface{}) {
-	if logLevel <= LogLevelFatal {
-		args := []interface{}{"[FATAL]", message}
-		args = append(args, keyvals...)
-		log.Fatalln(args...)
-	}
-}
-
 // GPU 프로세스 캐시 관리 함수들
 
 // 캐시 관련 함수들 - DISABLED (REAL DATA ONLY MODE)
@@ -1343,6 +1186,7 @@ type Metric struct {
 	Type  string
 	Value float64
 	Info  string // CPU 모델명 등 추가 정보
+	Unit  string // 값의 단위 (%, bytes/s, MB, °C, W ...), 비어 있으면 MetricUnit(Type) 사용
 }
 
 // ResourceSnapshot은 특정 시점의 모든 자원 사용량 스냅샷입니다.
@@ -1467,6 +1311,13 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 			}
 		}
 
+		// Disk temperature (S.M.A.R.T / NVMe, 60초 캐시)
+		if diskTemperatures, err := getDiskTemperatures(); err == nil {
+			for _, diskTemp := range diskTemperatures {
+				metrics = append(metrics, Metric{Type: DiskTempMetricName(diskTemp.Device), Value: diskTemp.Temperature})
+			}
+		}
+
 		// Network I/O
 		netSent, netRecv, err := getNetIO(prevNetCounters, duration)
 		if err != nil {
@@ -1543,25 +1394,15 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 			}
 		}
 
-		// Top Processes (every 10 seconds to avoid overhead)
-		if cpuInfoCounter%5 == 0 {
-			topProcesses, err := getTopProcesses(5)
-			if err != nil {
-				log.Printf("Error getting top processes: %v", err)
-			} else {
-				for i, proc := range topProcesses {
-					metrics = append(metrics, Metric{Type: fmt.Sprintf("process_%d", i), Value: proc.CPUPercent, Info: fmt.Sprintf("%s|%d|%.1f", proc.Name, proc.PID, proc.MemoryPercent)})
-				}
-			}
-		}
+		// Top Processes: 고정 process_N 메트릭 대신 GetProcessesFiltered(query) 사용
 
 		// GPU Processes (every 10 seconds to avoid overhead)
 		if cpuInfoCounter%5 == 0 {
 			gpuProcesses, err := getGPUProcesses()
 			if err != nil {
-				LogErrorOptimized("Error getting GPU processes: %v", err)
+				LogErrorOptimized("Error getting GPU processes", "error", err)
 			} else {
-				LogInfoOptimized("Found %d GPU processes", len(gpuProcesses))
+				LogInfoOptimized("Found GPU processes", "count", len(gpuProcesses))
 				for i, proc := range gpuProcesses {
 					// GPU 프로세스 정보를 메트릭으로 변환
 					metrics = append(metrics, Metric{
@@ -1575,7 +1416,7 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 
 		// Battery Status - 에러가 있어도 기본값 전송
 		if runtime.GOOS == "windows" || runtime.GOOS == "darwin" || runtime.GOOS == "linux" {
-			batteryStatus, err := GetBatteryInfo()
+			batteryStatus, err := getBatteryInfo()
 			if err != nil {
 				log.Printf("Error getting battery status: %v", err)
 				// 배터리가 없거나 에러 상황에서도 기본값 전송
@@ -1584,20 +1425,25 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 			} else {
 				metrics = append(metrics, Metric{Type: "battery_percent", Value: batteryStatus.Percent})
 				metrics = append(metrics, Metric{Type: "battery_plugged", Value: batteryStatus.Plugged})
+				metrics = append(metrics, Metric{Type: "battery_health_percent", Value: batteryStatus.HealthPercent})
+				metrics = append(metrics, Metric{Type: "battery_design_capacity", Value: batteryStatus.DesignCapacity})
+				metrics = append(metrics, Metric{Type: "battery_full_charge_capacity", Value: batteryStatus.FullChargeCapacity})
+				metrics = append(metrics, Metric{Type: "battery_charge_rate_watts", Value: batteryStatus.ChargeRateWatts})
+				metrics = append(metrics, Metric{Type: "battery_time_remaining_minutes", Value: batteryStatus.TimeRemainingMinutes})
 			}
 		}
 
 		// GPU Monitoring - 상세 에러 로깅 추가
 		gpuInfo, err := getGPUInfo()
 		if err != nil {
-			LogErrorOptimized("[DETAILED_ERROR] GPU info collection failed - Error: %v, Type: %T", err, err)
-			LogErrorOptimized("[DETAILED_ERROR] Current OS: %s - checking nvidia-smi, WMI, and other GPU APIs", runtime.GOOS)
+			LogErrorOptimized("[DETAILED_ERROR] GPU info collection failed", "error", err, "error_type", fmt.Sprintf("%T", err))
+			LogErrorOptimized("[DETAILED_ERROR] Checking nvidia-smi, WMI, and other GPU APIs", "os", runtime.GOOS)
 			LogErrorOptimized("[DETAILED_ERROR] Attempting to identify GPU detection failure reasons...")
 			
 			// GPU 감지 시도 및 결과 로깅
 			if runtime.GOOS == "windows" {
-				LogErrorOptimized("[DETAILED_ERROR] Windows GPU detection - nvidia-smi available: %v", isNVIDIASMIAvailable())
-				LogErrorOptimized("[DETAILED_ERROR] Windows GPU detection - WMI accessible: %v", isWMIAccessible())
+				LogErrorOptimized("[DETAILED_ERROR] Windows GPU detection", "nvidia_smi_available", isNVIDIASMIAvailable())
+				LogErrorOptimized("[DETAILED_ERROR] Windows GPU detection", "wmi_accessible", isWMIAccessible())
 			}
 			
 			// GPU가 없거나 에러 상황에서도 기본값을 전송하여 프론트엔드가 상태를 알 수 있도록 함
@@ -1613,8 +1459,8 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 				metrics = append(metrics, Metric{Type: "gpu_info", Value: 0.0, Info: "No GPU Detected"})
 			}
 		} else {
-			LogInfoOptimized("[SUCCESS] GPU metrics - Usage: %.1f%%, Memory: %.0f/%.0fMB, Temp: %.1f°C, Power: %.1fW",
-				gpuInfo.Usage, gpuInfo.MemoryUsed, gpuInfo.MemoryTotal, gpuInfo.Temperature, gpuInfo.Power)
+			LogInfoOptimized("[SUCCESS] GPU metrics", "usage_percent", gpuInfo.Usage, "memory_used_mb", gpuInfo.MemoryUsed,
+				"memory_total_mb", gpuInfo.MemoryTotal, "temperature_c", gpuInfo.Temperature, "power_w", gpuInfo.Power)
 			metrics = append(metrics, Metric{Type: "gpu_usage", Value: gpuInfo.Usage})
 			metrics = append(metrics, Metric{Type: "gpu_memory_used", Value: gpuInfo.MemoryUsed})
 			metrics = append(metrics, Metric{Type: "gpu_memory_total", Value: gpuInfo.MemoryTotal})
@@ -1623,11 +1469,17 @@ func Start(wsChan chan<- *ResourceSnapshot, dbChan chan<- *ResourceSnapshot) {
 			
 			// GPU 정보 (모델명 등)는 처음에만 또는 주기적으로 전송
 			if shouldSendCpuInfo {
-				LogInfoOptimized("[SUCCESS] Sending GPU info: %s", gpuInfo.Name)
+				LogInfoOptimized("[SUCCESS] Sending GPU info", "name", gpuInfo.Name)
 				metrics = append(metrics, Metric{Type: "gpu_info", Value: 1.0, Info: gpuInfo.Name})
 			}
 		}
 
+		// 시스템 전체 전력 추정 (GPU + CPU 패키지 + 배터리 방전)
+		if powerInfo, err := getSystemPowerInfo(); err == nil {
+			metrics = append(metrics, Metric{Type: "system_power_watts", Value: powerInfo.TotalWatts, Info: strings.Join(powerInfo.Sources, ",")})
+			metrics = append(metrics, Metric{Type: "cpu_power_watts", Value: powerInfo.CPUWatts})
+		}
+
 		snapshot := &ResourceSnapshot{
 			Timestamp: now,
 			Metrics:   metrics,
@@ -1651,7 +1503,11 @@ func getCpuUsage() (float64, error) {
 	return percentages[0], nil
 }
 
-func GetCPUCoreUsage() ([]float64, error) {
+func GetCPUCoreUsage(ctx context.Context) ([]float64, error) {
+	return queryWithContext(ctx, "GetCPUCoreUsage", getCPUCoreUsage)
+}
+
+func getCPUCoreUsage() ([]float64, error) {
 	// Use the new CPU monitor instead of direct implementation
 	manager := getMonitorManager()
 	cpuMonitor := manager.GetCPUMonitor()
@@ -1751,11 +1607,24 @@ type ProcessInfo struct {
 	PID           int32
 	CPUPercent    float64
 	MemoryPercent float64
+	Priority      string // 현재 우선순위 (realtime, high, above_normal, normal, below_normal, low)
+	Nice          int32  // 현재 nice 값 (Windows는 priority class에 대응하는 값)
+	Username      string // 소유 사용자 계정 (권한 부족 시 빈 값)
+
+	// detail=full 요청에서만 채움
+	Cmdline   string     // 전체 명령줄
+	ExePath   string     // 실행 파일 경로
+	StartTime *time.Time // 프로세스 시작 시간
 }
 
 type BatteryInfo struct {
-	Percent float64
-	Plugged float64 // 1.0 for plugged, 0.0 for unplugged
+	Percent              float64
+	Plugged              float64 // 1.0 for plugged, 0.0 for unplugged
+	DesignCapacity       float64 // 설계 용량 (mWh, 0 = 알 수 없음)
+	FullChargeCapacity   float64 // 완충 용량 (mWh, 0 = 알 수 없음)
+	HealthPercent        float64 // 설계 대비 완충 용량 비율 (%, -1 = 알 수 없음)
+	ChargeRateWatts      float64 // 충전(+) / 방전(-) 전력 (W)
+	TimeRemainingMinutes float64 // 방전 시 남은 시간, 충전 시 완충까지 시간 (분, -1 = 알 수 없음)
 }
 
 type GPUInfo struct {
@@ -1765,17 +1634,43 @@ type GPUInfo struct {
 	MemoryTotal  float64 // 총 GPU 메모리 (MB)
 	Temperature  float64 // GPU 온도 (°C)
 	Power        float64 // GPU 전력 소모 (W)
+	LastUpdated  time.Time // 수집 시각
+	CacheAgeMs   int64     // 캐시된 정보의 나이 (ms, 0 = 방금 수집)
 }
 
 type GPUProcess struct {
-	PID         int32   `json:"pid"`          // 프로세스 ID
-	Name        string  `json:"name"`         // 프로세스 이름
-	GPUUsage    float64 `json:"gpu_usage"`    // GPU 사용률 (%)
-	GPUMemory   float64 `json:"gpu_memory"`   // GPU 메모리 사용량 (MB)
-	Type        string  `json:"type"`         // 프로세스 유형 (C: Compute, G: Graphics, C+G: Both)
-	Command     string  `json:"command"`      // 실행 명령어 (선택적)
-	Status      string  `json:"status"`       // 프로세스 상태 (running, suspended, etc.)
-}
+	PID       int32   `json:"pid"`                // 프로세스 ID
+	Name      string  `json:"name"`               // 프로세스 이름
+	GPUUsage  float64 `json:"gpu_usage"`          // GPU 사용률 (%)
+	GPUMemory float64 `json:"gpu_memory"`         // GPU 메모리 사용량 (MB)
+	Type      string  `json:"type"`               // 프로세스 유형 (C: Compute, G: Graphics, C+G: Both)
+	Command   string  `json:"command"`            // 실행 명령어 (선택적)
+	Status    string  `json:"status"`             // 프로세스 상태 (running, suspended, etc.)
+	Priority  string  `json:"priority,omitempty"` // 현재 우선순위 (realtime, high, above_normal, normal, below_normal, low)
+	Nice      int32   `json:"nice"`               // 현재 nice 값 (Windows는 priority class에 대응하는 값)
+
+	EstimatedUsage bool   `json:"estimated_usage"` // GPUUsage가 측정값이 아닌 추정값인지 여부
+	UsageSource    string `json:"usage_source"`    // GPUUsage 출처 (GPUUsageSource* 상수)
+
+	AdapterID string `json:"adapter_id,omitempty"` // 실행 중인 GPU 어댑터 ID (GPUAdapter.ID, 하이브리드 그래픽 구분용)
+	Adapter   string `json:"adapter,omitempty"`    // 실행 중인 GPU 어댑터 이름
+
+	// detail=full 요청에서만 채움
+	Cmdline   string     `json:"cmdline,omitempty"`    // 전체 명령줄
+	ExePath   string     `json:"exe_path,omitempty"`   // 실행 파일 경로
+	StartTime *time.Time `json:"start_time,omitempty"` // 프로세스 시작 시간
+	Username  string     `json:"username,omitempty"`   // 소유 사용자 계정
+}
+
+// GPUProcess.UsageSource 값
+const (
+	GPUUsageSourceETW            = "etw"             // DxgKrnl ETW DMA 패킷 실행 시간 (측정)
+	GPUUsageSourceNVIDIAPmon     = "nvidia-pmon"     // nvidia-smi pmon SM 사용률 (측정)
+	GPUUsageSourcePerfCounter    = "perf-counter"    // Windows GPU Engine 성능 카운터 (측정)
+	GPUUsageSourceMemoryEstimate = "memory-estimate" // GPU 메모리 사용량 기반 추정
+	GPUUsageSourceFixedEstimate  = "fixed-estimate"  // 고정값 추정 (WMI)
+	GPUUsageSourceUnavailable    = "unavailable"     // 사용률 정보 없음 (0 또는 -1)
+)
 
 // Phase 1.1: Backend pre-computed data structures
 type GPUProcessFilter struct {
@@ -1803,6 +1698,40 @@ type GPUProcessResponse struct {
 	FilteredCount int        `json:"filtered_count"`
 	HasMore      bool        `json:"has_more"`
 	QueryTime    int64       `json:"query_time_ms"`
+	Summary      *GPUSummary `json:"summary,omitempty"` // GPU 정보를 수집할 수 없으면 생략
+	LastUpdated  time.Time   `json:"last_updated"`      // 프로세스 목록 수집 시각
+	CacheAgeMs   int64       `json:"cache_age_ms"`      // 캐시된 목록의 나이 (ms)
+}
+
+// GPUSummary is the VRAM capacity of the GPU captured together with the cached process list
+// (GetGPUInfo를 따로 호출하면 다른 캐시 시점의 값이 섞여 용량 막대와 프로세스 목록이 어긋날 수 있음)
+type GPUSummary struct {
+	Name          string    `json:"name"`
+	MemoryTotal   float64   `json:"memory_total"`   // 총 GPU 메모리 (MB)
+	MemoryUsed    float64   `json:"memory_used"`    // 사용된 GPU 메모리 (MB)
+	MemoryFree    float64   `json:"memory_free"`    // 남은 GPU 메모리 (MB)
+	ProcessMemory float64   `json:"process_memory"` // 전체 프로세스 GPU 메모리 합계 (MB, 필터/페이지 적용 전)
+	CollectedAt   time.Time `json:"collected_at"`
+}
+
+// newGPUSummary builds the VRAM summary of a process list collection
+func newGPUSummary(info *GPUInfo, processes []GPUProcess, collectedAt time.Time) *GPUSummary {
+	if info == nil {
+		return nil
+	}
+	summary := &GPUSummary{
+		Name:        info.Name,
+		MemoryTotal: info.MemoryTotal,
+		MemoryUsed:  info.MemoryUsed,
+		CollectedAt: collectedAt,
+	}
+	if info.MemoryTotal > info.MemoryUsed {
+		summary.MemoryFree = info.MemoryTotal - info.MemoryUsed
+	}
+	for _, process := range processes {
+		summary.ProcessMemory += process.GPUMemory
+	}
+	return summary
 }
 
 // Phase 1.2: Delta update system structures
@@ -1818,6 +1747,8 @@ type GPUProcessDeltaResponse struct {
 	FullRefresh bool             `json:"full_refresh"` // If true, client should discard all data and use full dataset
 	TotalCount  int              `json:"total_count"`
 	QueryTime   int64            `json:"query_time_ms"`
+	LastUpdated time.Time        `json:"last_updated"` // 프로세스 목록 수집 시각
+	CacheAgeMs  int64            `json:"cache_age_ms"` // 캐시된 목록의 나이 (ms)
 }
 
 // 새로운 메트릭 수집 함수들
@@ -1831,11 +1762,20 @@ func getSystemUptime() (float64, error) {
 	return float64(uptime), nil
 }
 
+// macOSDataVolumePath is the APFS data volume that holds user data on macOS 10.15+
+const macOSDataVolumePath = "/System/Volumes/Data"
+
 // getDiskPath returns the disk path for the current OS
 func getDiskPath() string {
 	if runtime.GOOS == "windows" {
 		return "C:\\"
 	}
+	// macOS 10.15+의 루트는 읽기 전용 시스템 볼륨 - 사용자 데이터는 Data 볼륨에 있음
+	if runtime.GOOS == "darwin" {
+		if _, err := os.Stat(macOSDataVolumePath); err == nil {
+			return macOSDataVolumePath
+		}
+	}
 	return "/"
 }
 
@@ -1924,14 +1864,30 @@ func getCachedNVIDIASMIPath() string {
 
 // getCachedGPUProcesses GPU 프로세스를 캐시에서 반환하거나 새로 수집
 func getCachedGPUProcesses() ([]GPUProcess, error) {
+	snapshot, err := getCachedGPUProcessSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.processes, nil
+}
+
+// gpuProcessSnapshot is a copy of the GPU process cache with the VRAM summary of the same collection
+type gpuProcessSnapshot struct {
+	processes   []GPUProcess
+	summary     *GPUSummary
+	lastUpdated time.Time
+	cacheAgeMs  int64
+}
+
+// getCachedGPUProcessSnapshot GPU 프로세스와 같은 캐시 시점의 VRAM 요약, 수집 시각을 함께 반환
+func getCachedGPUProcessSnapshot() (*gpuProcessSnapshot, error) {
 	gpuProcessCache.mutex.RLock()
 	// 캐시가 유효한 경우 캐시된 프로세스 반환
 	if time.Since(gpuProcessCache.lastUpdated) < GPU_PROCESS_CACHE_DURATION {
-		processes := make([]GPUProcess, len(gpuProcessCache.processes))
-		copy(processes, gpuProcessCache.processes)
+		snapshot := gpuProcessCache.snapshot()
 		gpuProcessCache.mutex.RUnlock()
-		LogDebug("GPU processes returned from cache", "count", len(processes), "age", time.Since(gpuProcessCache.lastUpdated))
-		return processes, nil
+		LogDebug("GPU processes returned from cache", "count", len(snapshot.processes), "age_ms", snapshot.cacheAgeMs)
+		return snapshot, nil
 	}
 	gpuProcessCache.mutex.RUnlock()
 
@@ -1941,10 +1897,9 @@ func getCachedGPUProcesses() ([]GPUProcess, error) {
 	
 	// 다시 한번 확인 (다른 고루틴이 업데이트했을 수도 있음)
 	if time.Since(gpuProcessCache.lastUpdated) < GPU_PROCESS_CACHE_DURATION {
-		processes := make([]GPUProcess, len(gpuProcessCache.processes))
-		copy(processes, gpuProcessCache.processes)
-		LogDebug("GPU processes returned from cache (double-check)", "count", len(processes))
-		return processes, nil
+		snapshot := gpuProcessCache.snapshot()
+		LogDebug("GPU processes returned from cache (double-check)", "count", len(snapshot.processes))
+		return snapshot, nil
 	}
 	
 	// 새로 수집
@@ -1954,9 +1909,7 @@ func getCachedGPUProcesses() ([]GPUProcess, error) {
 	gpuProcessMonitoringMutex.RUnlock()
 	if !monitoringEnabled {
 		LogInfo("GPU process monitoring disabled - serving last cached processes without collection")
-		processes := make([]GPUProcess, len(gpuProcessCache.processes))
-		copy(processes, gpuProcessCache.processes)
-		return processes, nil
+		return gpuProcessCache.snapshot(), nil
 	}
 
 	processes, err := getGPUProcessesUncached()
@@ -1969,9 +1922,31 @@ func getCachedGPUProcesses() ([]GPUProcess, error) {
 	gpuProcessCache.processes = make([]GPUProcess, len(processes))
 	copy(gpuProcessCache.processes, processes)
 	gpuProcessCache.lastUpdated = time.Now()
+
+	// VRAM 용량은 GPU 정보 캐시에서 가져와 프로세스 목록과 함께 고정 (정보 캐시는 프로세스 캐시를 참조하지 않으므로 잠금 순서 안전)
+	gpuInfo, err := getCachedGPUInfo()
+	if err != nil {
+		LogDebug("GPU info not available for process summary", "error", err)
+	}
+	gpuProcessCache.summary = newGPUSummary(gpuInfo, processes, gpuProcessCache.lastUpdated)
 	
 	LogInfo("GPU processes collected and cached", "count", len(processes), "cache_duration", GPU_PROCESS_CACHE_DURATION)
-	return processes, nil
+	return gpuProcessCache.snapshot(), nil
+}
+
+// snapshot copies the cached processes and summary so callers cannot modify the cache (caller holds the mutex)
+func (c *GPUProcessCache) snapshot() *gpuProcessSnapshot {
+	snapshot := &gpuProcessSnapshot{
+		processes:   make([]GPUProcess, len(c.processes)),
+		lastUpdated: c.lastUpdated,
+		cacheAgeMs:  observeCacheAge(CacheGPUProcesses, c.lastUpdated),
+	}
+	copy(snapshot.processes, c.processes)
+	if c.summary != nil {
+		summary := *c.summary
+		snapshot.summary = &summary
+	}
+	return snapshot
 }
 
 // getCachedGPUInfo GPU 정보를 캐시에서 반환하거나 새로 수집
@@ -1979,10 +1954,10 @@ func getCachedGPUInfo() (*GPUInfo, error) {
 	gpuInfoCache.mutex.RLock()
 	// 캐시가 유효한 경우 캐시된 정보 반환
 	if time.Since(gpuInfoCache.lastUpdated) < GPU_INFO_CACHE_DURATION && gpuInfoCache.info != nil {
-		info := *gpuInfoCache.info // 값 복사
+		info := gpuInfoCache.copyInfo()
 		gpuInfoCache.mutex.RUnlock()
-		LogDebug("GPU info returned from cache", "name", info.Name, "age", time.Since(gpuInfoCache.lastUpdated))
-		return &info, nil
+		LogDebug("GPU info returned from cache", "name", info.Name, "age_ms", info.CacheAgeMs)
+		return info, nil
 	}
 	gpuInfoCache.mutex.RUnlock()
 
@@ -1992,8 +1967,7 @@ func getCachedGPUInfo() (*GPUInfo, error) {
 	
 	// 다시 한번 확인
 	if time.Since(gpuInfoCache.lastUpdated) < GPU_INFO_CACHE_DURATION && gpuInfoCache.info != nil {
-		info := *gpuInfoCache.info
-		return &info, nil
+		return gpuInfoCache.copyInfo(), nil
 	}
 	
 	// 새로 수집
@@ -2008,7 +1982,15 @@ func getCachedGPUInfo() (*GPUInfo, error) {
 	gpuInfoCache.lastUpdated = time.Now()
 	
 	LogInfo("GPU info collected and cached", "name", info.Name, "cache_duration", GPU_INFO_CACHE_DURATION)
-	return info, nil
+	return gpuInfoCache.copyInfo(), nil
+}
+
+// copyInfo returns a copy of the cached GPU info stamped with its collection time and age (caller holds the mutex)
+func (c *GPUInfoCache) copyInfo() *GPUInfo {
+	info := *c.info
+	info.LastUpdated = c.lastUpdated
+	info.CacheAgeMs = observeCacheAge(CacheGPUInfo, c.lastUpdated)
+	return &info
 }
 
 // getCachedVideoControllers WMI VideoController 정보를 캐시에서 반환하거나 새로 수집
@@ -2034,8 +2016,9 @@ func getCachedVideoControllers() ([]string, error) {
 	if time.Since(videoControllerCache.lastUpdated) < VIDEO_CONTROLLER_CACHE_DURATION && len(videoControllerCache.controllers) > 0 {
 		controllers := make([]string, len(videoControllerCache.controllers))
 		copy(controllers, videoControllerCache.controllers)
+		ageMs := observeCacheAge(CacheVideoControllers, videoControllerCache.lastUpdated)
 		videoControllerCache.mutex.RUnlock()
-		LogDebug("VideoControllers returned from cache", "count", len(controllers), "age", time.Since(videoControllerCache.lastUpdated))
+		LogDebug("VideoControllers returned from cache", "count", len(controllers), "age_ms", ageMs)
 		return controllers, nil
 	}
 	videoControllerCache.mutex.RUnlock()
@@ -2048,6 +2031,7 @@ func getCachedVideoControllers() ([]string, error) {
 	if time.Since(videoControllerCache.lastUpdated) < VIDEO_CONTROLLER_CACHE_DURATION && len(videoControllerCache.controllers) > 0 {
 		controllers := make([]string, len(videoControllerCache.controllers))
 		copy(controllers, videoControllerCache.controllers)
+		observeCacheAge(CacheVideoControllers, videoControllerCache.lastUpdated)
 		return controllers, nil
 	}
 	
@@ -2076,6 +2060,7 @@ func getCachedVideoControllers() ([]string, error) {
 		// 캐시 업데이트
 		videoControllerCache.controllers = controllers
 		videoControllerCache.lastUpdated = time.Now()
+		observeCacheAge(CacheVideoControllers, videoControllerCache.lastUpdated)
 		
 		LogInfo("VideoControllers collected and cached with optimization", "count", len(controllers), "cache_duration", VIDEO_CONTROLLER_CACHE_DURATION)
 		return controllers, nil
@@ -2088,6 +2073,7 @@ func getCachedVideoControllers() ([]string, error) {
 func clearAllCaches() {
 	gpuProcessCache.mutex.Lock()
 	gpuProcessCache.processes = nil
+	gpuProcessCache.summary = nil
 	gpuProcessCache.lastUpdated = time.Time{}
 	gpuProcessCache.mutex.Unlock()
 	
@@ -2217,73 +2203,38 @@ func getNetworkStatus() ([]NetworkInterface, error) {
 }
 
 func getTopProcesses(count int) ([]ProcessInfo, error) {
-	processes, err := process.Processes()
+	// 일반 프로세스 조회 기능으로 CPU 사용률 상위 프로세스 조회
+	response, err := getProcessesFiltered(ProcessQuery{
+		Sort:     ProcessSort{Field: "cpu_percent", Order: "desc"},
+		MaxItems: count,
+	})
 	if err != nil {
 		log.Printf("Error getting processes: %v", err)
 		return nil, err
 	}
-	
-	var processInfos []ProcessInfo
-	processedCount := 0
-	
-	for _, p := range processes {
-		// 너무 많은 프로세스를 처리하지 않도록 제한
-		if processedCount >= count*10 {
-			break
-		}
-		
-		name, err := p.Name()
-		if err != nil {
-			continue
-		}
-		
-		// 빈 이름이나 시스템 프로세스 건너뛰기
-		if name == "" || len(name) == 0 {
-			continue
-		}
-		
-		cpuPercent, err := p.CPUPercent()
-		if err != nil {
-			cpuPercent = 0.0
-		}
-		
-		memPercent, err := p.MemoryPercent()
-		if err != nil {
-			memPercent = 0.0
-		}
-		
+
+	processInfos := make([]ProcessInfo, 0, len(response.Processes))
+	for _, proc := range response.Processes {
 		processInfos = append(processInfos, ProcessInfo{
-			Name:          name,
-			PID:           p.Pid,
-			CPUPercent:    cpuPercent,
-			MemoryPercent: float64(memPercent),
+			Name:          proc.Name,
+			PID:           proc.PID,
+			CPUPercent:    proc.CPUPercent,
+			MemoryPercent: proc.MemoryPercent,
+			Priority:      proc.Priority,
+			Nice:          proc.Nice,
+			Username:      proc.Username,
 		})
-		
-		processedCount++
 	}
-	
-	// CPU 사용률로 정렬
-	sort.Slice(processInfos, func(i, j int) bool {
-		return processInfos[i].CPUPercent > processInfos[j].CPUPercent
-	})
-	
-	if len(processInfos) > count {
-		processInfos = processInfos[:count]
-	}
-	
-	log.Printf("Found %d processes, returning top %d", len(processInfos), len(processInfos))
-	for i, proc := range processInfos {
-		if i < 3 { // 상위 3개만 로그
-			log.Printf("Process %d: %s (PID: %d, CPU: %.2f%%, Memory: %.2f%%)", 
-				i+1, proc.Name, proc.PID, proc.CPUPercent, proc.MemoryPercent)
-		}
-	}
-	
+
 	return processInfos, nil
 }
 
 // GetBatteryInfo returns real battery information from the system
-func GetBatteryInfo() (*BatteryInfo, error) {
+func GetBatteryInfo(ctx context.Context) (*BatteryInfo, error) {
+	return queryWithContext(ctx, "GetBatteryInfo", getBatteryInfo)
+}
+
+func getBatteryInfo() (*BatteryInfo, error) {
 	// Use the new system info provider instead of direct implementation
 	manager := getMonitorManager()
 	sysInfo := manager.GetSystemInfoProvider()
@@ -2292,6 +2243,8 @@ func GetBatteryInfo() (*BatteryInfo, error) {
 		switch runtime.GOOS {
 		case "windows":
 			return getBatteryStatusWindows()
+		case "linux":
+			return getBatteryInfoLinux()
 		default:
 			return nil, fmt.Errorf("battery monitoring not supported on platform: %s", runtime.GOOS)
 		}
@@ -2307,30 +2260,19 @@ func getBatteryStatusWindows() (*BatteryInfo, error) {
 		return nil, fmt.Errorf("failed to get battery info via WMI: %v", err)
 	}
 
-	lines := strings.Split(string(output), "\n")
+	// /format:list 출력: BatteryStatus=2, EstimatedChargeRemaining=95, EstimatedRunTime=...
+	values := parseWMIListOutput(output)
 	var batteryPercent float64 = -1
 	var batteryStatus float64 = -1
-	
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "BatteryStatus,EstimatedChargeRemaining,Node") {
-			continue
-		}
-		
-		// CSV format: BatteryStatus,EstimatedChargeRemaining,Node
-		fields := strings.Split(line, ",")
-		if len(fields) >= 2 {
-			// BatteryStatus: 1=Discharging, 2=AC Power, 3=Fully Charged, etc.
-			if status, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64); err == nil {
-				batteryStatus = status
-			}
-			
-			// EstimatedChargeRemaining: 0-100 percentage
-			if percent, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64); err == nil {
-				batteryPercent = percent
-			}
-			break
-		}
+
+	// BatteryStatus: 1=Discharging, 2=AC Power, 3=Fully Charged, etc.
+	if status, err := strconv.ParseFloat(values["BatteryStatus"], 64); err == nil {
+		batteryStatus = status
+	}
+
+	// EstimatedChargeRemaining: 0-100 percentage
+	if percent, err := strconv.ParseFloat(values["EstimatedChargeRemaining"], 64); err == nil {
+		batteryPercent = percent
 	}
 	
 	// 배터리가 없는 경우 (데스크탑 등)
@@ -2345,10 +2287,24 @@ func getBatteryStatusWindows() (*BatteryInfo, error) {
 		isPlugged = 1.0
 	}
 	
-	return &BatteryInfo{
-		Percent: batteryPercent,
-		Plugged: isPlugged,
-	}, nil
+	info := &BatteryInfo{
+		Percent:              batteryPercent,
+		Plugged:              isPlugged,
+		HealthPercent:        -1,
+		TimeRemainingMinutes: -1,
+	}
+
+	// 용량/건강도/충방전 전력 정보 추가 (root\wmi)
+	enrichBatteryInfoWindows(info)
+
+	// 전력 기반 추정이 불가능하면 Win32_Battery.EstimatedRunTime 사용
+	if info.TimeRemainingMinutes == -1 && isPlugged == 0 {
+		if runTime, err := strconv.ParseFloat(values["EstimatedRunTime"], 64); err == nil && runTime > 0 && runTime != wmiRunTimeOnACPower {
+			info.TimeRemainingMinutes = runTime
+		}
+	}
+
+	return info, nil
 }
 
 // getGPUInfo 캐시된 GPU 정보 반환 (CPU 최적화)
@@ -2465,6 +2421,13 @@ func getAMDInfoLinux() (*GPUInfo, error) {
 }
 
 func getGPUInfoMacOS() (*GPUInfo, error) {
+	// IOAccelerator 성능 통계 우선 (Apple Silicon 통합 GPU도 실시간 사용률 제공)
+	if info, err := getGPUInfoIORegistry(); err == nil {
+		return info, nil
+	} else {
+		LogDebug("IOAccelerator GPU statistics unavailable, falling back to system_profiler", "error", err)
+	}
+
 	// macOS에서 GPU 정보 수집 (system_profiler)
 	cmd := createHiddenCommand("system_profiler", "SPDisplaysDataType")
 	output, err := cmd.Output()
@@ -2706,7 +2669,7 @@ func getConsolidatedNVIDIAData() (*GPUInfo, []GPUProcess, error) {
 			if parseErr != nil {
 				LogWarn("배치 graphics 프로세스 파싱 실패", "error", parseErr)
 			} else {
-				LogInfo("배치에서 graphics 프로세스 파싱 완료 (추정 GPU 사용률)", "count", len(processes))
+				LogInfo("배치에서 graphics 프로세스 파싱 완료", "count", len(processes))
 			}
 		}
 	}
@@ -2720,7 +2683,7 @@ func getConsolidatedNVIDIAData() (*GPUInfo, []GPUProcess, error) {
 }
 
 // parseConsolidatedNVIDIAProcessOutput는 통합 nvidia-smi 출력을 파싱합니다
-// RTX 3060 등 게이밍 GPU에서 pmon이 지원되지 않을 때 사용하는 개선된 분배 알고리즘
+// RTX 3060 등 게이밍 GPU에서 pmon이 지원되지 않을 때 사용 (프로세스 목록과 GPU 메모리만 제공)
 func parseConsolidatedNVIDIAProcessOutput(output []byte, totalGPUUsage float64) ([]GPUProcess, error) {
 	var processes []GPUProcess
 	var processData []struct {
@@ -2767,103 +2730,20 @@ func parseConsolidatedNVIDIAProcessOutput(output []byte, totalGPUUsage float64)
 		return processes, nil
 	}
 	
-	// Phase 2: 개선된 지능형 GPU 사용률 분배 알고리즘
-	LogInfo("RTX 3060 호환 지능형 분배 시작", "total_gpu_usage", totalGPUUsage, "process_count", len(processData))
-	
-	// 총 메모리 사용량 계산 (가중치 계산용)
-	totalMemoryUsage := 0.0
-	highMemoryCount := 0
-	for _, pd := range processData {
-		totalMemoryUsage += pd.memory
-		if pd.memory > 50 { // 50MB 이상을 활성 프로세스로 간주
-			highMemoryCount++
-		}
-	}
-	
-	// 분배 가능한 GPU 사용률 (총 사용률의 80%를 분배, 20%는 시스템 예약)
-	distributableUsage := totalGPUUsage * 0.8
-	baselineUsage := 0.0
-	
-	// 모든 GPU 프로세스에게 최소 기본 사용률 부여 (시각적 피드백 향상)
-	if totalGPUUsage > 0.5 && len(processData) > 0 {
-		baselineUsage = 0.3 // 최소 0.3% 기본 할당
-	}
-	
-	LogInfo("분배 매개변수", 
-		"distributable_usage", distributableUsage,
-		"baseline_usage", baselineUsage,
-		"high_memory_processes", highMemoryCount,
-		"total_memory", totalMemoryUsage)
-	
-	// Phase 3: 프로세스별 GPU 사용률 계산 및 할당
+	// 프로세스별 GPU 사용률은 메모리 비율로 추정하지 않음 - Windows에서는 ETW로 측정한 값을 getGPUProcessesWindows에서 채움
 	for _, pd := range processData {
-		estimatedUsage := baselineUsage
-		
-		// 메모리 기반 가중 분배
-		if totalMemoryUsage > 0 && distributableUsage > 0 {
-			memoryWeight := pd.memory / totalMemoryUsage
-			
-			// 고메모리 프로세스 가중치 부스트
-			if pd.memory > 200 { // 200MB 이상 고사용 프로세스
-				memoryWeight *= 2.5
-			} else if pd.memory > 100 { // 100MB 이상 중사용 프로세스  
-				memoryWeight *= 1.8
-			} else if pd.memory > 50 { // 50MB 이상 저사용 프로세스
-				memoryWeight *= 1.2
-			}
-			
-			// 프로세스 이름 기반 추가 가중치 (GPU 집약적 프로세스 식별)
-			nameBoost := 1.0
-			lowerName := strings.ToLower(pd.name)
-			if strings.Contains(lowerName, "nvidia") || strings.Contains(lowerName, "gpu") ||
-			   strings.Contains(lowerName, "render") || strings.Contains(lowerName, "game") ||
-			   strings.Contains(lowerName, "unity") || strings.Contains(lowerName, "unreal") {
-				nameBoost = 1.5
-			} else if strings.Contains(lowerName, "chrome") || strings.Contains(lowerName, "firefox") ||
-				     strings.Contains(lowerName, "edge") { // 브라우저 하드웨어 가속
-				nameBoost = 1.3
-			}
-			
-			weightedUsage := distributableUsage * memoryWeight * nameBoost
-			
-			// 단일 프로세스가 총 사용률을 초과하지 않도록 제한
-			if weightedUsage > totalGPUUsage * 0.6 {
-				weightedUsage = totalGPUUsage * 0.6
-			}
-			
-			estimatedUsage += weightedUsage
-		}
-		
-		// 최종 값 정규화 (음수 방지, 100% 초과 방지)
-		if estimatedUsage < 0 {
-			estimatedUsage = 0
-		} else if estimatedUsage > 100 {
-			estimatedUsage = 100
-		}
-		
-		process := GPUProcess{
+		processes = append(processes, GPUProcess{
 			PID:       pd.pid,
 			Name:      pd.name,
-			GPUUsage:  estimatedUsage,
 			GPUMemory: pd.memory,
 			Type:      "Compute", // 기본값
 			Command:   pd.name,
 			Status:    "running",
-		}
-		
-		processes = append(processes, process)
-		
-		// 개선된 알고리즘 결과 로깅 (처음 5개)
-		if len(processes) <= 5 {
-			LogInfo("개선된 GPU 분배 결과", 
-				"pid", process.PID,
-				"name", process.Name, 
-				"gpu_usage", fmt.Sprintf("%.2f%%", process.GPUUsage),
-				"gpu_memory", fmt.Sprintf("%.1fMB", process.GPUMemory))
-		}
+			UsageSource: GPUUsageSourceUnavailable,
+		})
 	}
 	
-	LogInfo("RTX 3060 호환 분배 완료", "distributed_processes", len(processes))
+	LogDebug("Consolidated nvidia-smi processes parsed", "count", len(processes), "total_gpu_usage", totalGPUUsage)
 	return processes, nil
 }
 
@@ -2951,16 +2831,11 @@ type NVIDIABatchResult struct {
 }
 
 // createOptimizedHiddenCommand creates a command with minimal overhead
-func createOptimizedHiddenCommand(name string, args ...string) *exec.Cmd {
-	cmd := exec.Command(name, args...)
+func createOptimizedHiddenCommand(name string, args ...string) *externalCommand {
+	cmd := newExternalCommand(externalCommandTimeout(name), name, args...)
 	
-	// CPU 최적화: 최소한의 시스템콜만 사용
-	if runtime.GOOS == "windows" {
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			HideWindow:    true,
-			CreationFlags: 0x08000000, // CREATE_NO_WINDOW만 사용 (다른 플래그 제거)
-		}
-	}
+	// CPU 최적화: 최소한의 시스템콜만 사용 (CREATE_NO_WINDOW만 사용)
+	hideConsoleWindow(cmd.Cmd)
 	
 	return cmd
 }
@@ -3063,23 +2938,14 @@ func recordMethodFailure(methodName string) {
 // getNVIDIAFromRegistry - Windows 레지스트리에서 NVIDIA GPU 정보 수집
 func getNVIDIAFromRegistry() (*GPUInfo, error) {
 	// Windows 레지스트리에서 NVIDIA GPU 정보 수집 시도
-	cmd := createHiddenCommand("reg", "query", "HKLM\\SOFTWARE\\NVIDIA Corporation\\Global\\GPUInfo", "/s")
-	output, err := cmd.Output()
+	names, err := winapi.RegistrySubkeyStrings(`HKLM\SOFTWARE\NVIDIA Corporation\Global\GPUInfo`, "GPUName", 2)
 	if err != nil {
 		return nil, fmt.Errorf("NVIDIA registry info not available: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
 	var gpuName string
-	
-	for _, line := range lines {
-		if strings.Contains(line, "GPUName") && strings.Contains(line, "REG_SZ") {
-			parts := strings.Split(line, "REG_SZ")
-			if len(parts) > 1 {
-				gpuName = strings.TrimSpace(parts[1])
-				break
-			}
-		}
+	if len(names) > 0 {
+		gpuName = strings.TrimSpace(names[0])
 	}
 	
 	if gpuName == "" {
@@ -3112,27 +2978,23 @@ func detectAMDGPUWindows() (*GPUInfo, error) {
 	return nil, fmt.Errorf("no AMD GPU detection method succeeded")
 }
 
+// 디스플레이 어댑터 장치 클래스 레지스트리 키
+const displayAdapterClassKey = `HKLM\SYSTEM\CurrentControlSet\Control\Class\{4d36e968-e325-11ce-bfc1-08002be10318}`
+
 // getAMDFromRegistry - Windows 레지스트리에서 AMD GPU 정보 수집
 func getAMDFromRegistry() (*GPUInfo, error) {
-	cmd := createHiddenCommand("reg", "query", "HKLM\\SYSTEM\\CurrentControlSet\\Control\\Class\\{4d36e968-e325-11ce-bfc1-08002be10318}", "/s", "/f", "AMD")
-	output, err := cmd.Output()
+	// 디스플레이 어댑터 클래스 키의 하위 키(0000, 0001, ...)별 DriverDesc
+	descriptions, err := winapi.RegistrySubkeyStrings(displayAdapterClassKey, "DriverDesc", 1)
 	if err != nil {
 		return nil, fmt.Errorf("AMD registry query failed: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
 	var gpuName string
-	
-	for _, line := range lines {
-		if strings.Contains(line, "DriverDesc") && strings.Contains(line, "REG_SZ") {
-			parts := strings.Split(line, "REG_SZ")
-			if len(parts) > 1 {
-				desc := strings.TrimSpace(parts[1])
-				if strings.Contains(strings.ToLower(desc), "amd") || strings.Contains(strings.ToLower(desc), "radeon") {
-					gpuName = desc
-					break
-				}
-			}
+	for _, desc := range descriptions {
+		desc = strings.TrimSpace(desc)
+		if strings.Contains(strings.ToLower(desc), "amd") || strings.Contains(strings.ToLower(desc), "radeon") {
+			gpuName = desc
+			break
 		}
 	}
 	
@@ -3154,40 +3016,30 @@ func getAMDFromRegistry() (*GPUInfo, error) {
 // getAMDFromWMI - WMI를 통한 AMD GPU 감지
 func getAMDFromWMI() (*GPUInfo, error) {
 	// CPU 최적화 Phase 4: AMD GPU WMI 쿼리 최적화
-	cmd := createHiddenCommand("wmic", "path", "win32_VideoController", "where", "Name like '%AMD%' OR Name like '%Radeon%'", "get", "Name", "/format:list")
-	output, err := cmd.Output()
+	rows, err := queryWMI("", "Win32_VideoController", "Name LIKE '%AMD%' OR Name LIKE '%Radeon%'", "Name", "AdapterRAM")
 	if err != nil {
 		return nil, fmt.Errorf("AMD WMI query failed: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "AdapterRAM,Name") {
-			continue
-		}
-		
-		fields := strings.Split(line, ",")
-		if len(fields) >= 2 {
-			name := strings.TrimSpace(fields[1])
-			if name != "" && (strings.Contains(strings.ToLower(name), "amd") || strings.Contains(strings.ToLower(name), "radeon")) {
-				var memoryTotal float64
-				if memStr := strings.TrimSpace(fields[0]); memStr != "" && memStr != "0" {
-					if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
-						memoryTotal = mem / (1024 * 1024) // Bytes to MB
-					}
+	for _, row := range rows {
+		name := strings.TrimSpace(row["Name"])
+		if name != "" && (strings.Contains(strings.ToLower(name), "amd") || strings.Contains(strings.ToLower(name), "radeon")) {
+			var memoryTotal float64
+			if memStr := strings.TrimSpace(row["AdapterRAM"]); memStr != "" && memStr != "0" {
+				if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
+					memoryTotal = mem / (1024 * 1024) // Bytes to MB
 				}
-				
-				LogDebug("AMD GPU info from WMI", "name", name, "memory", memoryTotal)
-				return &GPUInfo{
-					Name:         name,
-					Usage:        -1.0, // WMI에서는 사용률 정보 불가
-					MemoryUsed:   -1.0,
-					MemoryTotal:  memoryTotal,
-					Temperature:  -1.0,
-					Power:        -1.0,
-				}, nil
 			}
+			
+			LogDebug("AMD GPU info from WMI", "name", name, "memory", memoryTotal)
+			return &GPUInfo{
+				Name:         name,
+				Usage:        -1.0, // WMI에서는 사용률 정보 불가
+				MemoryUsed:   -1.0,
+				MemoryTotal:  memoryTotal,
+				Temperature:  -1.0,
+				Power:        -1.0,
+			}, nil
 		}
 	}
 	
@@ -3211,25 +3063,18 @@ func detectIntelGPUWindows() (*GPUInfo, error) {
 
 // getIntelFromRegistry - Windows 레지스트리에서 Intel GPU 정보 수집
 func getIntelFromRegistry() (*GPUInfo, error) {
-	cmd := createHiddenCommand("reg", "query", "HKLM\\SYSTEM\\CurrentControlSet\\Control\\Class\\{4d36e968-e325-11ce-bfc1-08002be10318}", "/s", "/f", "Intel")
-	output, err := cmd.Output()
+	// 디스플레이 어댑터 클래스 키의 하위 키(0000, 0001, ...)별 DriverDesc
+	descriptions, err := winapi.RegistrySubkeyStrings(displayAdapterClassKey, "DriverDesc", 1)
 	if err != nil {
 		return nil, fmt.Errorf("Intel registry query failed: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
 	var gpuName string
-	
-	for _, line := range lines {
-		if strings.Contains(line, "DriverDesc") && strings.Contains(line, "REG_SZ") {
-			parts := strings.Split(line, "REG_SZ")
-			if len(parts) > 1 {
-				desc := strings.TrimSpace(parts[1])
-				if strings.Contains(strings.ToLower(desc), "intel") {
-					gpuName = desc
-					break
-				}
-			}
+	for _, desc := range descriptions {
+		desc = strings.TrimSpace(desc)
+		if strings.Contains(strings.ToLower(desc), "intel") {
+			gpuName = desc
+			break
 		}
 	}
 	
@@ -3251,40 +3096,30 @@ func getIntelFromRegistry() (*GPUInfo, error) {
 // getIntelFromWMI - WMI를 통한 Intel GPU 감지
 func getIntelFromWMI() (*GPUInfo, error) {
 	// CPU 최적화 Phase 4: Intel GPU WMI 쿼리 최적화  
-	cmd := createHiddenCommand("wmic", "path", "win32_VideoController", "where", "Name like '%Intel%'", "get", "Name", "/format:list")
-	output, err := cmd.Output()
+	rows, err := queryWMI("", "Win32_VideoController", "Name LIKE '%Intel%'", "Name", "AdapterRAM")
 	if err != nil {
 		return nil, fmt.Errorf("Intel WMI query failed: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "AdapterRAM,Name") {
-			continue
-		}
-		
-		fields := strings.Split(line, ",")
-		if len(fields) >= 2 {
-			name := strings.TrimSpace(fields[1])
-			if name != "" && strings.Contains(strings.ToLower(name), "intel") {
-				var memoryTotal float64
-				if memStr := strings.TrimSpace(fields[0]); memStr != "" && memStr != "0" {
-					if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
-						memoryTotal = mem / (1024 * 1024) // Bytes to MB
-					}
+	for _, row := range rows {
+		name := strings.TrimSpace(row["Name"])
+		if name != "" && strings.Contains(strings.ToLower(name), "intel") {
+			var memoryTotal float64
+			if memStr := strings.TrimSpace(row["AdapterRAM"]); memStr != "" && memStr != "0" {
+				if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
+					memoryTotal = mem / (1024 * 1024) // Bytes to MB
 				}
-				
-				LogDebug("Intel GPU info from WMI", "name", name, "memory", memoryTotal)
-				return &GPUInfo{
-					Name:         name,
-					Usage:        -1.0, // WMI에서는 사용률 정보 불가
-					MemoryUsed:   -1.0,
-					MemoryTotal:  memoryTotal,
-					Temperature:  -1.0,
-					Power:        -1.0,
-				}, nil
 			}
+			
+			LogDebug("Intel GPU info from WMI", "name", name, "memory", memoryTotal)
+			return &GPUInfo{
+				Name:         name,
+				Usage:        -1.0, // WMI에서는 사용률 정보 불가
+				MemoryUsed:   -1.0,
+				MemoryTotal:  memoryTotal,
+				Temperature:  -1.0,
+				Power:        -1.0,
+			}, nil
 		}
 	}
 	
@@ -3294,43 +3129,33 @@ func getIntelFromWMI() (*GPUInfo, error) {
 // detectGPUViaWMI - WMI를 통한 일반 GPU 감지 (벤더 무관)
 func detectGPUViaWMI() (*GPUInfo, error) {
 	// CPU 최적화 Phase 4: 일반 VideoController WMI 쿼리 최적화
-	cmd := createHiddenCommand("wmic", "path", "win32_VideoController", "get", "Name", "/format:list")
-	output, err := cmd.Output()
+	rows, err := queryWMI("", "Win32_VideoController", "", "Name", "AdapterRAM")
 	if err != nil {
 		return nil, fmt.Errorf("generic WMI query failed: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "AdapterRAM,Name") {
-			continue
-		}
-		
-		fields := strings.Split(line, ",")
-		if len(fields) >= 2 {
-			name := strings.TrimSpace(fields[1])
-			// Microsoft, Virtual, 기본 어댑터 제외
-			if name != "" && !strings.Contains(name, "Microsoft") && 
-			   !strings.Contains(name, "Virtual") && !strings.Contains(name, "Basic") {
-				
-				var memoryTotal float64
-				if memStr := strings.TrimSpace(fields[0]); memStr != "" && memStr != "0" {
-					if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
-						memoryTotal = mem / (1024 * 1024) // Bytes to MB
-					}
+	for _, row := range rows {
+		name := strings.TrimSpace(row["Name"])
+		// Microsoft, Virtual, 기본 어댑터 제외
+		if name != "" && !strings.Contains(name, "Microsoft") && 
+		   !strings.Contains(name, "Virtual") && !strings.Contains(name, "Basic") {
+			
+			var memoryTotal float64
+			if memStr := strings.TrimSpace(row["AdapterRAM"]); memStr != "" && memStr != "0" {
+				if mem, err := strconv.ParseFloat(memStr, 64); err == nil {
+					memoryTotal = mem / (1024 * 1024) // Bytes to MB
 				}
-				
-				LogDebug("Generic GPU info from WMI", "name", name, "memory", memoryTotal)
-				return &GPUInfo{
-					Name:         name,
-					Usage:        -1.0, // WMI에서는 사용률 정보 불가
-					MemoryUsed:   -1.0,
-					MemoryTotal:  memoryTotal,
-					Temperature:  -1.0,
-					Power:        -1.0,
-				}, nil
 			}
+			
+			LogDebug("Generic GPU info from WMI", "name", name, "memory", memoryTotal)
+			return &GPUInfo{
+				Name:         name,
+				Usage:        -1.0, // WMI에서는 사용률 정보 불가
+				MemoryUsed:   -1.0,
+				MemoryTotal:  memoryTotal,
+				Temperature:  -1.0,
+				Power:        -1.0,
+			}, nil
 		}
 	}
 	
@@ -3497,6 +3322,7 @@ func parseNVIDIAProcessOutput(output []byte) ([]GPUProcess, error) {
 			GPUMemory: info.gpuMemory,
 			Type:      info.processType,
 			Status:    "running",
+			UsageSource: GPUUsageSourceNVIDIAPmon,
 		}
 		processes = append(processes, process)
 	}
@@ -3653,10 +3479,11 @@ func parseNVIDIAAlternativeOutput(output []byte, totalGPUUsage float64) ([]GPUPr
 			process := GPUProcess{
 				PID:       int32(pid),
 				Name: cleanProcessName(processName),
-				GPUUsage:  0, // 나중에 계산
+				GPUUsage:  0, // ETW 측정값이 있으면 getGPUProcessesWindows에서 채움
 				GPUMemory: gpuMemory,
 				Type:      "C", // Compute로 가정
 				Status:    "running",
+				UsageSource: GPUUsageSourceUnavailable,
 			}
 			
 			activeProcesses = append(activeProcesses, process)
@@ -3690,36 +3517,7 @@ func parseNVIDIAAlternativeOutput(output []byte, totalGPUUsage float64) ([]GPUPr
 			"current_usage", proc.GPUUsage)
 	}
 	
-	// TDD GREEN: Windows WDDM mode에서는 개별 프로세스 메모리 정보가 [N/A]로 나오므로
-	// 실제 GPU 사용률을 감지된 모든 GPU 프로세스에게 균등 분배
-	if len(activeProcesses) > 0 && totalGPUUsage > 0 {
-		LogInfo("TDD GREEN: Windows WDDM mode - Real GPU usage equal distribution",
-			"total_processes", len(activeProcesses),
-			"total_gpu_usage", totalGPUUsage,
-			"distribution_method", "equal_distribution")
-		
-		// 실제 데이터 기반 균등 분배 (사용자 요구사항: 실제데이터로만 작동)
-		usagePerProcess := totalGPUUsage / float64(len(activeProcesses))
-		
-		LogInfo("TDD GREEN: EXECUTING REAL GPU USAGE EQUAL DISTRIBUTION")
-		for i := range activeProcesses {
-			oldUsage := activeProcesses[i].GPUUsage
-			activeProcesses[i].GPUUsage = usagePerProcess
-			
-			LogInfo("TDD GREEN: Real process usage assignment",
-				"index", i,
-				"pid", activeProcesses[i].PID,
-				"name", activeProcesses[i].Name,
-				"old_usage", oldUsage,
-				"new_usage", activeProcesses[i].GPUUsage,
-				"real_total_gpu_usage", totalGPUUsage,
-				"equal_share", usagePerProcess)
-		}
-	} else {
-		LogError("TDD: DISTRIBUTION SKIPPED - Conditions not met",
-			"processes_count", len(activeProcesses),
-			"total_gpu_usage", totalGPUUsage)
-	}
+	// 전체 사용률을 프로세스 수로 나누어 배분하지 않음 - Windows에서는 ETW로 측정한 값을 getGPUProcessesWindows에서 채움
 	
 	// TDD RED: 최종 결과 검증
 	var processesWithUsage int
@@ -3856,6 +3654,8 @@ func parseWMIProcessOutput(output []byte) ([]GPUProcess, error) {
 			Type:      "G", // Graphics로 가정
 			Status:    "running",
 			Command:   name,
+			EstimatedUsage: true,
+			UsageSource:    GPUUsageSourceFixedEstimate,
 		}
 		
 		processes = append(processes, process)
@@ -4097,6 +3897,7 @@ func parsePerformanceCounterData(memoryOutput, utilizationOutput []byte) []GPUPr
 				Type:      "GPU",
 				Status:    "running",
 				Command:   fmt.Sprintf("pid_%d", pid),
+				UsageSource: GPUUsageSourceUnavailable,
 			}
 		} else {
 			// 기존 프로세스의 메모리 정보 업데이트 (누적)
@@ -4151,6 +3952,7 @@ func parsePerformanceCounterData(memoryOutput, utilizationOutput []byte) []GPUPr
 			if process := processMap[int32(pid)]; process != nil {
 				if process.GPUUsage < utilization {
 					process.GPUUsage = utilization // 최대값 사용
+					process.UsageSource = GPUUsageSourcePerfCounter
 				}
 			}
 		}
@@ -4175,6 +3977,8 @@ func parsePerformanceCounterData(memoryOutput, utilizationOutput []byte) []GPUPr
 			}
 			if estimatedUsage > 0.1 {
 				process.GPUUsage = estimatedUsage
+				process.EstimatedUsage = true
+				process.UsageSource = GPUUsageSourceMemoryEstimate
 			}
 		}
 		
@@ -4202,17 +4006,14 @@ func parsePerformanceCounterData(memoryOutput, utilizationOutput []byte) []GPUPr
 	return processes
 }
 
-// getProcessNameByPID gets process name by PID using Windows API
+// getProcessNameByPID gets process name by PID using Windows API (without .exe, like Get-Process)
 func getProcessNameByPID(pid int) string {
-	cmd := createHiddenCommand("powershell", "-Command", 
-		fmt.Sprintf("Get-Process -Id %d -ErrorAction SilentlyContinue | Select-Object -ExpandProperty Name", pid))
-	
-	output, err := cmd.Output()
+	name, err := winapi.ProcessName(int32(pid))
 	if err != nil {
 		return ""
 	}
 	
-	return strings.TrimSpace(string(output))
+	return strings.TrimSuffix(name, ".exe")
 }
 
 // getCurrentGPUUsage gets the current total GPU utilization
@@ -4278,25 +4079,7 @@ func getProcessName(pid int32) string {
 
 // getProcessNameWindows는 Windows에서 PID로 프로세스 이름을 가져옵니다.
 func getProcessNameWindows(pid int32) string {
-	cmd := createHiddenCommand("tasklist", "/FI", fmt.Sprintf("PID eq %d", pid), "/FO", "CSV", "/NH")
-	output, err := cmd.Output()
-	if err != nil {
-		return fmt.Sprintf("PID_%d", pid)
-	}
-	
-	line := strings.TrimSpace(string(output))
-	if line != "" {
-		// CSV 형식에서 첫 번째 필드가 프로세스 이름
-		// Phase 6: 최적화된 필드 파싱으로 메모리 할당 최소화
-		fields := parseFieldsOptimized(line, ",")
-		if len(fields) > 0 {
-			// 따옴표 제거
-			name := strings.Trim(fields[0], "\"")
-			return name
-		}
-	}
-	
-	return fmt.Sprintf("PID_%d", pid)
+	return getProcessNameWindowsSingle(pid)
 }
 
 // getProcessNameUnix는 Unix 계열에서 PID로 프로세스 이름을 가져옵니다.
@@ -4316,30 +4099,46 @@ func getProcessNameUnix(pid int32) string {
 func getGPUProcesses() ([]GPUProcess, error) {
 	LogDebugOptimized("[DEBUG] getGPUProcesses() called - Phase 16 Debug")
 	result, err := getCachedGPUProcesses()
-	LogDebugOptimized("[DEBUG] getGPUProcesses() result: %d processes, error: %v", len(result), err)
+	LogDebugOptimized("[DEBUG] getGPUProcesses() result", "processes", len(result), "error", err)
 	return result, err
 }
 
 // Phase 1.1: Backend pre-computed GPU process querying
-func GetGPUProcessesFiltered(query GPUProcessQuery) (*GPUProcessResponse, error) {
+func GetGPUProcessesFiltered(ctx context.Context, query GPUProcessQuery) (*GPUProcessResponse, error) {
+	return queryWithContext(ctx, "GetGPUProcessesFiltered", func() (*GPUProcessResponse, error) { return getGPUProcessesFiltered(query) })
+}
+
+func getGPUProcessesFiltered(query GPUProcessQuery) (*GPUProcessResponse, error) {
 	manager := getMonitorManager()
 	gpuMonitor := manager.GetGPUMonitor()
+	var response *GPUProcessResponse
+	var err error
 	if gpuMonitor == nil {
 		// Fallback to original implementation
-		return GetGPUProcessesFilteredOriginal(query)
+		response, err = GetGPUProcessesFilteredOriginal(query)
+	} else {
+		response, err = gpuMonitor.GetGPUProcessesFiltered(query)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return gpuMonitor.GetGPUProcessesFiltered(query)
+	// 페이지 단위로만 우선순위 조회 (전체 목록 조회 비용 방지), 캐시된 응답은 수정하지 않음
+	annotated := *response
+	annotated.Processes = append([]GPUProcess(nil), response.Processes...)
+	annotateGPUProcessPriorities(annotated.Processes)
+	return &annotated, nil
 }
 
 func GetGPUProcessesFilteredOriginal(query GPUProcessQuery) (*GPUProcessResponse, error) {
 	startTime := time.Now()
 
 	// Get all processes from cache
-	allProcesses, err := getCachedGPUProcesses()
+	snapshot, err := getCachedGPUProcessSnapshot()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get GPU processes: %v", err)
 	}
+	allProcesses := applyGPUProcessNamePatterns(snapshot.processes)
 	
 	totalCount := len(allProcesses)
 	
@@ -4379,6 +4178,9 @@ func GetGPUProcessesFilteredOriginal(query GPUProcessQuery) (*GPUProcessResponse
 		FilteredCount: filteredCount,
 		HasMore:       hasMore,
 		QueryTime:     queryTime,
+		Summary:       snapshot.summary,
+		LastUpdated:   snapshot.lastUpdated,
+		CacheAgeMs:    snapshot.cacheAgeMs,
 	}, nil
 }
 
@@ -4443,7 +4245,11 @@ func sortGPUProcesses(processes []GPUProcess, sortConfig GPUProcessSort) {
 }
 
 // Phase 1.2: Delta update system functions
-func GetGPUProcessesDelta(lastUpdateID string) (*GPUProcessDeltaResponse, error) {
+func GetGPUProcessesDelta(ctx context.Context, lastUpdateID string) (*GPUProcessDeltaResponse, error) {
+	return queryWithContext(ctx, "GetGPUProcessesDelta", func() (*GPUProcessDeltaResponse, error) { return getGPUProcessesDelta(lastUpdateID) })
+}
+
+func getGPUProcessesDelta(lastUpdateID string) (*GPUProcessDeltaResponse, error) {
 	manager := getMonitorManager()
 	gpuMonitor := manager.GetGPUMonitor()
 	if gpuMonitor == nil {
@@ -4458,10 +4264,11 @@ func GetGPUProcessesDeltaOriginal(lastUpdateID string) (*GPUProcessDeltaResponse
 	startTime := time.Now()
 
 	// Get current processes
-	currentProcesses, err := getCachedGPUProcesses()
+	snapshot, err := getCachedGPUProcessSnapshot()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current GPU processes: %v", err)
 	}
+	currentProcesses := snapshot.processes
 	
 	gpuProcessDeltaCache.mutex.Lock()
 	defer gpuProcessDeltaCache.mutex.Unlock()
@@ -4484,6 +4291,8 @@ func GetGPUProcessesDeltaOriginal(lastUpdateID string) (*GPUProcessDeltaResponse
 			FullRefresh: true,
 			TotalCount:  len(currentProcesses),
 			QueryTime:   queryTime,
+			LastUpdated: snapshot.lastUpdated,
+			CacheAgeMs:  snapshot.cacheAgeMs,
 		}, nil
 	}
 	
@@ -4505,6 +4314,8 @@ func GetGPUProcessesDeltaOriginal(lastUpdateID string) (*GPUProcessDeltaResponse
 		FullRefresh: false,
 		TotalCount:  len(currentProcesses),
 		QueryTime:   queryTime,
+		LastUpdated: snapshot.lastUpdated,
+		CacheAgeMs:  snapshot.cacheAgeMs,
 	}, nil
 }
 
@@ -4549,7 +4360,9 @@ func processChanged(old, new GPUProcess) bool {
 		old.GPUMemory != new.GPUMemory ||
 		old.Type != new.Type ||
 		old.Command != new.Command ||
-		old.Status != new.Status
+		old.Status != new.Status ||
+		old.EstimatedUsage != new.EstimatedUsage ||
+		old.UsageSource != new.UsageSource
 }
 
 // getGPUProcessesUncached 캐시 없이 직접 GPU 프로세스 수집 (원본 로직)
@@ -4584,6 +4397,14 @@ func getGPUProcessesWindows() ([]GPUProcess, error) {
 		return nil, fmt.Errorf("GPU process detection failed for vendor %s (real data only mode): %v", detectedVendor.String(), err)
 	}
 	
+	// 벤더와 무관하게 DxgKrnl ETW로 측정한 프로세스별 GPU 엔진 사용률 적용 (세션이 없으면 0 = 측정 불가)
+	// 하이브리드 그래픽: 프로세스별 실행 어댑터 표시 + 감지된 벤더 외 어댑터(iGPU)에서 실행 중인 프로세스 추가
+	processes = attachGPUProcessAdapters(processes)
+	
+	if !applyMeasuredGPUUsage(processes) {
+		LogDebug("Per-process GPU usage not measured (ETW session not running)")
+	}
+	
 	LogInfo("GPU processes detected successfully", "vendor", detectedVendor.String(), "count", len(processes))
 	return processes, nil
 }
@@ -4649,6 +4470,7 @@ func parseAMDProcesses() ([]GPUProcess, error) {
 				GPUMemory: 0,
 				Type:      "G", // Graphics로 가정
 				Status:    "running",
+				UsageSource: GPUUsageSourceUnavailable,
 			}
 			
 			processes = append(processes, process)
@@ -4723,13 +4545,9 @@ func GetCurrentPlatform() string {
 	return sysInfo.GetCurrentPlatform()
 }
 
-// Enhanced critical process checking with protection service
-func isCriticalProcessEnhanced(processName string, pid int32) (*CriticalProcessInfo, error) {
-	pps := GetProcessProtectionService()
-	if proc, isCritical := pps.IsCriticalProcess(processName, pid); isCritical {
-		return proc, pps.CanControlProcess(processName, pid)
-	}
-	return nil, nil
+// Enhanced critical process checking with protection service (판단은 감사 로그에 기록)
+func isCriticalProcessEnhanced(operation, processName string, pid int32) (*CriticalProcessInfo, error) {
+	return GetProcessProtectionService().CheckControl(operation, processName, pid)
 }
 
 // killGPUProcess는 지정된 PID의 GPU 프로세스를 종료합니다
@@ -4758,7 +4576,7 @@ func KillGPUProcess(pid int32) error {
 	fmt.Printf("[DEBUG] Process name: %s (PID: %d)\n", name, pid)
 	
 	// 향상된 중요한 시스템 프로세스 보호
-	if protectionInfo, protectionErr := isCriticalProcessEnhanced(name, pid); protectionErr != nil {
+	if protectionInfo, protectionErr := isCriticalProcessEnhanced("kill", name, pid); protectionErr != nil {
 		LogWarn("Refusing to kill protected system process", "name", name, "pid", pid, "protection_error", protectionErr)
 		return createProcessError("KILL_PROCESS", pid, protectionErr.Error(), ErrorCodeCriticalProcess)
 	} else if protectionInfo != nil {
@@ -4802,30 +4620,25 @@ func KillGPUProcess(pid int32) error {
 		if stillExists, _ := process.NewProcess(pid); stillExists != nil {
 			LogWarn("Process still exists after taskkill", "pid", pid, "name", name)
 
-			// WMIC 대안 시도
-			LogInfo("Trying WMIC alternative", "pid", pid)
-			wmicCmd := createHiddenCommand("wmic", "process", "where", fmt.Sprintf("processid=%d", pid), "delete")
-			wmicOutput, wmicErr := wmicCmd.CombinedOutput()
-
-			if wmicErr != nil {
-				LogError("WMIC also failed", "pid", pid, "error", wmicErr, "output", string(wmicOutput))
+			// TerminateProcess API 대안 시도
+			LogInfo("Trying TerminateProcess alternative", "pid", pid)
+			if terminateErr := terminateProcessWindows(pid); terminateErr != nil {
+				LogError("TerminateProcess also failed", "pid", pid, "error", terminateErr)
 				return createProcessError("KILL_PROCESS", pid,
 					"Process termination failed. Possible causes: insufficient privileges, protected process, or process is being used by another application. Try running as administrator.",
 					ErrorCodePermissionDenied)
 			}
 
-			LogInfo("WMIC executed", "pid", pid, "output", string(wmicOutput))
-
-			// WMIC 후 재확인 (300ms 대기)
+			// TerminateProcess 후 재확인 (300ms 대기)
 			time.Sleep(300 * time.Millisecond)
 			if finalCheck, _ := process.NewProcess(pid); finalCheck != nil {
-				LogError("Process still exists after WMIC", "pid", pid, "name", name)
+				LogError("Process still exists after TerminateProcess", "pid", pid, "name", name)
 				return createProcessError("KILL_PROCESS", pid,
 					"Process cannot be terminated. It may be protected by the system or another application.",
 					ErrorCodePermissionDenied)
 			}
 
-			LogInfo("Process terminated successfully via WMIC", "pid", pid)
+			LogInfo("Process terminated successfully via TerminateProcess", "pid", pid)
 		} else {
 			LogInfo("Process terminated successfully via taskkill", "pid", pid)
 		}
@@ -4868,7 +4681,7 @@ func SuspendGPUProcess(pid int32) error {
 	}
 	
 	// 향상된 중요한 시스템 프로세스 보호
-	if protectionInfo, protectionErr := isCriticalProcessEnhanced(name, pid); protectionErr != nil {
+	if protectionInfo, protectionErr := isCriticalProcessEnhanced("suspend", name, pid); protectionErr != nil {
 		log.Printf("Refusing to suspend protected system process: %s (PID %d) - %s", name, pid, protectionErr.Error())
 		return protectionErr
 	} else if protectionInfo != nil {
@@ -4927,7 +4740,7 @@ func ResumeGPUProcess(pid int32) error {
 	}
 	
 	// 향상된 중요한 시스템 프로세스 보호
-	if protectionInfo, protectionErr := isCriticalProcessEnhanced(name, pid); protectionErr != nil {
+	if protectionInfo, protectionErr := isCriticalProcessEnhanced("resume", name, pid); protectionErr != nil {
 		log.Printf("Refusing to resume protected system process: %s (PID %d) - %s", name, pid, protectionErr.Error())
 		return protectionErr
 	} else if protectionInfo != nil {
@@ -4986,7 +4799,7 @@ func SetGPUProcessPriority(pid int32, priority string) error {
 	}
 	
 	// 향상된 중요한 시스템 프로세스 보호
-	if protectionInfo, protectionErr := isCriticalProcessEnhanced(name, pid); protectionErr != nil {
+	if protectionInfo, protectionErr := isCriticalProcessEnhanced("priority", name, pid); protectionErr != nil {
 		log.Printf("Refusing to change priority of protected system process: %s (PID %d) - %s", name, pid, protectionErr.Error())
 		return protectionErr
 	} else if protectionInfo != nil {
@@ -5003,44 +4816,21 @@ func SetGPUProcessPriority(pid int32, priority string) error {
 	}
 	
 	// 우선순위 매핑
-	var niceValue int
-	var windowsPriority string
-	
-	switch strings.ToLower(priority) {
-	case "realtime", "rt":
-		niceValue = -20
-		windowsPriority = "realtime"
-	case "high":
-		niceValue = -10
-		windowsPriority = "high"
-	case "above_normal", "abovenormal":
-		niceValue = -5
-		windowsPriority = "abovenormal"
-	case "normal":
-		niceValue = 0
-		windowsPriority = "normal"
-	case "below_normal", "belownormal":
-		niceValue = 5
-		windowsPriority = "belownormal"
-	case "low":
-		niceValue = 10
-		windowsPriority = "idle"
-	default:
-		return fmt.Errorf("invalid priority level: %s. Valid options: realtime, high, above_normal, normal, below_normal, low", priority)
+	niceValue, windowsPriority, err := priorityLevelValues(priority)
+	if err != nil {
+		return err
 	}
 	
 	// 프로세스 우선순위 변경 시도
 	log.Printf("Setting priority of process: %s (PID %d) to %s", name, pid, priority)
 	
 	if runtime.GOOS == "windows" {
-		// Windows에서는 wmic 명령 사용
-		cmd := createHiddenCommand("wmic", "process", "where", fmt.Sprintf("processid=%d", pid), "CALL", "setpriority", windowsPriority)
-		output, err := cmd.CombinedOutput()
-		if err != nil {
-			log.Printf("Failed to set priority of process %d using wmic: %v, output: %s", pid, err, string(output))
+		// Windows에서는 SetPriorityClass API 사용
+		if err := setPriorityClassWindows(pid, windowsPriority); err != nil {
+			log.Printf("Failed to set priority of process %d using SetPriorityClass: %v", pid, err)
 			return fmt.Errorf("failed to set process priority: %v", err)
 		}
-		log.Printf("Successfully set priority of process %d to %s using wmic: %s", pid, windowsPriority, string(output))
+		log.Printf("Successfully set priority of process %d to %s (class 0x%X)", pid, priority, windowsPriority)
 	} else {
 		// Unix/Linux에서는 renice 명령 사용
 		cmd := createHiddenCommand("renice", fmt.Sprintf("%d", niceValue), fmt.Sprintf("%d", pid))
@@ -5055,11 +4845,49 @@ func SetGPUProcessPriority(pid int32, priority string) error {
 	return nil
 }
 
+// priorityLevelValues maps a priority name to its nice value and Windows priority class
+func priorityLevelValues(priority string) (int, uint32, error) {
+	switch strings.ToLower(priority) {
+	case "realtime", "rt":
+		return -20, windowsRealtimePriorityClass, nil
+	case "high":
+		return -10, windowsHighPriorityClass, nil
+	case "above_normal", "abovenormal":
+		return -5, windowsAboveNormalPriorityClass, nil
+	case "normal":
+		return 0, windowsNormalPriorityClass, nil
+	case "below_normal", "belownormal":
+		return 5, windowsBelowNormalPriorityClass, nil
+	case "low":
+		return 10, windowsIdlePriorityClass, nil
+	default:
+		return 0, 0, fmt.Errorf("invalid priority level: %s. Valid options: realtime, high, above_normal, normal, below_normal, low", priority)
+	}
+}
+
+// Windows 우선순위 클래스 (SetPriorityClass)
+const (
+	windowsIdlePriorityClass        = 0x00000040
+	windowsBelowNormalPriorityClass = 0x00004000
+	windowsNormalPriorityClass      = 0x00000020
+	windowsAboveNormalPriorityClass = 0x00008000
+	windowsHighPriorityClass        = 0x00000080
+	windowsRealtimePriorityClass    = 0x00000100
+
+	windowsProcessTerminate      = 0x0001 // PROCESS_TERMINATE
+	windowsProcessSetInformation = 0x0200 // PROCESS_SET_INFORMATION
+	windowsProcessSuspendResume  = 0x0800 // PROCESS_SUSPEND_RESUME
+)
+
 // verifyGPUProcess는 주어진 PID가 실제로 GPU를 사용하는 프로세스인지 확인합니다
 // ====== Phase 2.1 TDD Green Phase: 추가 도우미 함수들 ======
 
 // GetCPUCores returns the number of CPU cores
-func GetCPUCores() (int, error) {
+func GetCPUCores(ctx context.Context) (int, error) {
+	return queryWithContext(ctx, "GetCPUCores", getCPUCores)
+}
+
+func getCPUCores() (int, error) {
 	// Use the new CPU monitor instead of direct implementation
 	manager := getMonitorManager()
 	cpuMonitor := manager.GetCPUMonitor()
@@ -5110,30 +4938,16 @@ func getCPUCoresFromWMI() int {
 		return 0
 	}
 
-	// Use wmic command to get CPU core count
-	cmd := exec.Command("wmic", "cpu", "get", "NumberOfCores", "/format:csv")
-	output, err := cmd.Output()
+	// Query Win32_Processor for CPU core count
+	rows, err := queryWMI("", "Win32_Processor", "", "NumberOfCores")
 	if err != nil {
-		LogWarn("Failed to get CPU cores from wmic", "error", err)
+		LogWarn("Failed to get CPU cores from WMI", "error", err)
 		return 0
 	}
 
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "Node,NumberOfCores") {
-			continue
-		}
-
-		// Parse CSV format: Node,NumberOfCores
-		parts := strings.Split(line, ",")
-		if len(parts) >= 2 {
-			coreStr := strings.TrimSpace(parts[1])
-			if coreStr != "" && coreStr != "NumberOfCores" {
-				if cores, err := strconv.Atoi(coreStr); err == nil && cores > 0 {
-					return cores
-				}
-			}
+	for _, row := range rows {
+		if cores, err := strconv.Atoi(strings.TrimSpace(row["NumberOfCores"])); err == nil && cores > 0 {
+			return cores
 		}
 	}
 
@@ -5141,7 +4955,11 @@ func getCPUCoresFromWMI() int {
 }
 
 // GetCPUModelName returns the CPU model name
-func GetCPUModelName() (string, error) {
+func GetCPUModelName(ctx context.Context) (string, error) {
+	return queryWithContext(ctx, "GetCPUModelName", getCPUModelName)
+}
+
+func getCPUModelName() (string, error) {
 	// Use the new CPU monitor instead of direct implementation
 	manager := getMonitorManager()
 	cpuMonitor := manager.GetCPUMonitor()
@@ -5189,28 +5007,16 @@ func getCPUModelFromWMI() string {
 		return ""
 	}
 
-	// Use wmic command to get CPU info
-	cmd := exec.Command("wmic", "cpu", "get", "Name", "/format:csv")
-	output, err := cmd.Output()
+	// Query Win32_Processor for CPU info
+	rows, err := queryWMI("", "Win32_Processor", "", "Name")
 	if err != nil {
-		LogWarn("Failed to get CPU info from wmic", "error", err)
+		LogWarn("Failed to get CPU info from WMI", "error", err)
 		return ""
 	}
 
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "Node,Name") {
-			continue
-		}
-
-		// Parse CSV format: Node,Name
-		parts := strings.Split(line, ",")
-		if len(parts) >= 2 {
-			cpuName := strings.TrimSpace(parts[1])
-			if cpuName != "" && cpuName != "Name" {
-				return cpuName
-			}
+	for _, row := range rows {
+		if cpuName := strings.TrimSpace(row["Name"]); cpuName != "" {
+			return cpuName
 		}
 	}
 
@@ -5218,7 +5024,11 @@ func getCPUModelFromWMI() string {
 }
 
 // GetTotalMemory returns total system memory in MB
-func GetTotalMemory() (float64, error) {
+func GetTotalMemory(ctx context.Context) (float64, error) {
+	return queryWithContext(ctx, "GetTotalMemory", getTotalMemory)
+}
+
+func getTotalMemory() (float64, error) {
 	// Use the new memory monitor instead of direct implementation
 	manager := getMonitorManager()
 	memoryMonitor := manager.GetMemoryMonitor()
@@ -5240,7 +5050,11 @@ func GetTotalMemory() (float64, error) {
 }
 
 // GetBootTime returns system boot time
-func GetBootTime() (time.Time, error) {
+func GetBootTime(ctx context.Context) (time.Time, error) {
+	return queryWithContext(ctx, "GetBootTime", getBootTime)
+}
+
+func getBootTime() (time.Time, error) {
 	// Use the new system info provider instead of direct implementation
 	manager := getMonitorManager()
 	sysInfo := manager.GetSystemInfoProvider()
@@ -5257,13 +5071,17 @@ func GetBootTime() (time.Time, error) {
 }
 
 // GetSystemUptime returns system uptime in seconds
-func GetSystemUptime() (int64, error) {
+func GetSystemUptime(ctx context.Context) (int64, error) {
+	return queryWithContext(ctx, "GetSystemUptime", getSystemUptimeSeconds)
+}
+
+func getSystemUptimeSeconds() (int64, error) {
 	// Use the new system info provider instead of direct implementation
 	manager := getMonitorManager()
 	sysInfo := manager.GetSystemInfoProvider()
 	if sysInfo == nil {
 		// Fallback to direct implementation if provider is not available
-		bootTime, err := GetBootTime()
+		bootTime, err := getBootTime()
 		if err != nil {
 			return 0, err
 		}
@@ -5276,7 +5094,11 @@ func GetSystemUptime() (int64, error) {
 }
 
 // GetMemoryDetails returns detailed memory information
-func GetMemoryDetails() (*MemoryDetails, error) {
+func GetMemoryDetails(ctx context.Context) (*MemoryDetails, error) {
+	return queryWithContext(ctx, "GetMemoryDetails", getMemoryDetailsMB)
+}
+
+func getMemoryDetailsMB() (*MemoryDetails, error) {
 	memStat, err := mem.VirtualMemory()
 	if err != nil {
 		return nil, err
@@ -5295,8 +5117,12 @@ func GetMemoryDetails() (*MemoryDetails, error) {
 }
 
 // GetNetworkStatus returns overall network connectivity status
-func GetNetworkStatus() (string, error) {
-	interfaces, err := GetNetworkInterfaces()
+func GetNetworkStatus(ctx context.Context) (string, error) {
+	return queryWithContext(ctx, "GetNetworkStatus", getNetworkConnectivity)
+}
+
+func getNetworkConnectivity() (string, error) {
+	interfaces, err := getNetworkStatus()
 	if err != nil {
 		return "unknown", err
 	}
@@ -5318,37 +5144,45 @@ func GetNetworkStatus() (string, error) {
 }
 
 // GetCPUUsage returns current CPU usage percentage
-func GetCPUUsage() (float64, error) {
-	return getCpuUsage()
+func GetCPUUsage(ctx context.Context) (float64, error) {
+	return queryWithContext(ctx, "GetCPUUsage", getCpuUsage)
 }
 
 // GetMemoryUsage returns current memory usage percentage
-func GetMemoryUsage() (float64, error) {
-	return getMemUsage()
+func GetMemoryUsage(ctx context.Context) (float64, error) {
+	return queryWithContext(ctx, "GetMemoryUsage", getMemUsage)
 }
 
 // GetDiskUsage returns disk usage information
-func GetDiskUsage() (*DiskUsageInfo, error) {
-	return getDiskUsage()
+func GetDiskUsage(ctx context.Context) (*DiskUsageInfo, error) {
+	return queryWithContext(ctx, "GetDiskUsage", getDiskUsage)
 }
 
 // GetNetworkInterfaces returns network interface information
-func GetNetworkInterfaces() ([]NetworkInterface, error) {
-	return getNetworkStatus()
+func GetNetworkInterfaces(ctx context.Context) ([]NetworkInterface, error) {
+	return queryWithContext(ctx, "GetNetworkInterfaces", getNetworkStatus)
 }
 
 // GetTopProcesses returns top processes by resource usage (alias for existing function)
-func GetTopProcesses(count int) ([]ProcessInfo, error) {
-	return getTopProcesses(count)
+func GetTopProcesses(ctx context.Context, count int) ([]ProcessInfo, error) {
+	return queryWithContext(ctx, "GetTopProcesses", func() ([]ProcessInfo, error) { return getTopProcesses(count) })
 }
 
 // GetGPUProcesses returns GPU processes (alias for existing function)
-func GetGPUProcesses() ([]GPUProcess, error) {
+func GetGPUProcesses(ctx context.Context) ([]GPUProcess, error) {
+	return queryWithContext(ctx, "GetGPUProcesses", getGPUProcessesFromMonitor)
+}
+
+func getGPUProcessesFromMonitor() ([]GPUProcess, error) {
 	manager := getMonitorManager()
 	gpuMonitor := manager.GetGPUMonitor()
 	if gpuMonitor == nil {
 		// Fallback to direct implementation
-		return getGPUProcesses()
+		processes, err := getGPUProcesses()
+		if err == nil {
+			processAccounting.ObserveGPU(processes, time.Now())
+		}
+		return processes, err
 	}
 
 	// Use GPU monitor to get processes (simplified approach)
@@ -5362,14 +5196,26 @@ func GetGPUProcesses() ([]GPUProcess, error) {
 	response, err := gpuMonitor.GetGPUProcessesFiltered(query)
 	if err != nil {
 		// Fallback to direct implementation
-		return getGPUProcesses()
+		processes, err := getGPUProcesses()
+		if err == nil {
+			annotateGPUProcessPriorities(processes)
+			processAccounting.ObserveGPU(processes, time.Now())
+		}
+		return processes, err
 	}
 
-	return response.Processes, nil
+	processes := append([]GPUProcess(nil), response.Processes...)
+	annotateGPUProcessPriorities(processes)
+	processAccounting.ObserveGPU(processes, time.Now())
+	return processes, nil
 }
 
 // GetGPUInfo returns GPU information (alias for existing function)
-func GetGPUInfo() (*GPUInfo, error) {
+func GetGPUInfo(ctx context.Context) (*GPUInfo, error) {
+	return queryWithContext(ctx, "GetGPUInfo", getGPUInfoFromMonitor)
+}
+
+func getGPUInfoFromMonitor() (*GPUInfo, error) {
 	manager := getMonitorManager()
 	gpuMonitor := manager.GetGPUMonitor()
 	if gpuMonitor == nil {
@@ -5448,7 +5294,15 @@ var (
 )
 
 // GetDiskIOSpeed returns disk read/write speed in bytes per second
-func GetDiskIOSpeed() (float64, float64, error) {
+func GetDiskIOSpeed(ctx context.Context) (float64, float64, error) {
+	speeds, err := queryWithContext(ctx, "GetDiskIOSpeed", func() ([2]float64, error) {
+		first, second, err := getDiskIOSpeed()
+		return [2]float64{first, second}, err
+	})
+	return speeds[0], speeds[1], err
+}
+
+func getDiskIOSpeed() (float64, float64, error) {
 	diskStats, err := disk.IOCounters()
 	if err != nil {
 		return 0.0, 0.0, fmt.Errorf("failed to get disk I/O counters: %v", err)
@@ -5495,7 +5349,15 @@ func GetDiskIOSpeed() (float64, float64, error) {
 }
 
 // GetNetworkIOSpeed returns network sent/received speed in bytes per second
-func GetNetworkIOSpeed() (float64, float64, error) {
+func GetNetworkIOSpeed(ctx context.Context) (float64, float64, error) {
+	speeds, err := queryWithContext(ctx, "GetNetworkIOSpeed", func() ([2]float64, error) {
+		first, second, err := getNetworkIOSpeed()
+		return [2]float64{first, second}, err
+	})
+	return speeds[0], speeds[1], err
+}
+
+func getNetworkIOSpeed() (float64, float64, error) {
 	netStats, err := net.IOCounters(false) // false = 모든 인터페이스 합계
 	if err != nil {
 		return 0.0, 0.0, fmt.Errorf("failed to get network I/O counters: %v", err)
@@ -5545,6 +5407,14 @@ func GetNetworkIOSpeed() (float64, float64, error) {
 	return sentSpeed, recvSpeed, nil
 }
 
+// ResetIOSpeedCounters discards the previous disk/network counter sample so the next reading starts a new baseline
+// (시스템 절전 복귀 후 첫 샘플에서 비정상적으로 큰 값이 나오는 것을 방지)
+func ResetIOSpeedCounters() {
+	ioStatsMutex.Lock()
+	defer ioStatsMutex.Unlock()
+	lastIOStats = nil
+}
+
 // parseAMDProcessesWindows - Windows에서 AMD GPU 프로세스 감지
 func parseAMDProcessesWindows() ([]GPUProcess, error) {
 	// AMD 전용 도구는 제한적이므로, 프로세스 이름 기반으로 추정
@@ -5576,52 +5446,43 @@ func parseIntelProcessesWindows() ([]GPUProcess, error) {
 func findProcessesByNames(processNames []string, gpuType string) ([]GPUProcess, error) {
 	var foundProcesses []GPUProcess
 	
-	// wmic로 모든 프로세스 목록 가져오기
-	cmd := createHiddenCommand("wmic", "process", "get", "ProcessId,Name,CommandLine", "/format:csv")
-	output, err := cmd.Output()
+	// WMI로 모든 프로세스 목록 가져오기
+	rows, err := queryWMI("", "Win32_Process", "", "ProcessId", "Name", "CommandLine")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get process list: %v", err)
 	}
 	
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.Contains(line, "CommandLine,Name,ProcessId") {
+	for _, row := range rows {
+		commandLine := strings.TrimSpace(row["CommandLine"])
+		processName := strings.TrimSpace(row["Name"])
+		pidStr := strings.TrimSpace(row["ProcessId"])
+		
+		if pidStr == "" {
 			continue
 		}
 		
-		fields := strings.Split(line, ",")
-		if len(fields) >= 3 {
-			commandLine := strings.TrimSpace(fields[0])
-			processName := strings.TrimSpace(fields[1])
-			pidStr := strings.TrimSpace(fields[2])
-			
-			if pidStr == "" {
-				continue
-			}
-			
-			pid, err := strconv.ParseInt(pidStr, 10, 32)
-			if err != nil {
-				continue
-			}
-			
-			// 프로세스 이름에서 찾기
-			for _, searchName := range processNames {
-				if strings.Contains(strings.ToLower(processName), strings.ToLower(searchName)) ||
-				   strings.Contains(strings.ToLower(commandLine), strings.ToLower(searchName)) {
-					
-					foundProcesses = append(foundProcesses, GPUProcess{
-						PID:       int32(pid),
-						Name: cleanProcessName(processName),
-						GPUUsage:  -1.0, // 이름 기반 추정에서는 사용률 알 수 없음
-						GPUMemory: -1.0, // 메모리 사용량 알 수 없음
-						Type:      "Graphics",
-						Command:   commandLine,
-						Status:    "running",
-					})
-					LogDebug("Found GPU-related process", "type", gpuType, "name", processName, "pid", pid)
-					break
-				}
+		pid, err := strconv.ParseInt(pidStr, 10, 32)
+		if err != nil {
+			continue
+		}
+		
+		// 프로세스 이름에서 찾기
+		for _, searchName := range processNames {
+			if strings.Contains(strings.ToLower(processName), strings.ToLower(searchName)) ||
+			   strings.Contains(strings.ToLower(commandLine), strings.ToLower(searchName)) {
+				
+				foundProcesses = append(foundProcesses, GPUProcess{
+					PID:       int32(pid),
+					Name: cleanProcessName(processName),
+					GPUUsage:  -1.0, // 이름 기반 추정에서는 사용률 알 수 없음
+					GPUMemory: -1.0, // 메모리 사용량 알 수 없음
+					Type:      "Graphics",
+					Command:   commandLine,
+					Status:    "running",
+					UsageSource: GPUUsageSourceUnavailable,
+				})
+				LogDebug("Found GPU-related process", "type", gpuType, "name", processName, "pid", pid)
+				break
 			}
 		}
 	}
@@ -5807,6 +5668,8 @@ func getGPUProcessesImproved(gpuInfo *GPUInfo) ([]GPUProcess, error) {
 				Type:      "Compute",
 				Command: cleanProcessName(processName),
 				Status:    "running",
+				EstimatedUsage: true,
+				UsageSource:    GPUUsageSourceMemoryEstimate,
 			}
 			
 			processes = append(processes, process)
@@ -6043,6 +5906,8 @@ func getGPUProcessesFromWindows() ([]GPUProcess, error) {
 				Type:      "Graphics",
 				Command: cleanProcessName(processName),
 				Status:    "running",
+				EstimatedUsage: true,
+				UsageSource:    GPUUsageSourceMemoryEstimate,
 			}
 			
 			gpuProcesses = append(gpuProcesses, gpuProcess)
@@ -6106,6 +5971,8 @@ func getGPUProcessesFromNvidiaSmi() ([]GPUProcess, error) {
 				Type:      "Compute",
 				Command: cleanProcessName(processName),
 				Status:    "running",
+				EstimatedUsage: true,
+				UsageSource:    GPUUsageSourceMemoryEstimate,
 			}
 			
 			gpuProcesses = append(gpuProcesses, gpuProcess)
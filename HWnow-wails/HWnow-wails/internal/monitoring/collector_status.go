@@ -0,0 +1,88 @@
+package monitoring
+
+import (
+	"strings"
+	"time"
+)
+
+// 수집기별 오류 상태 (/api/status)
+// 수집 실패 시 값을 0으로 표시하는 대신 마지막 오류와 시각을 보고하여,
+// 프론트엔드가 "GPU data unavailable: nvidia-smi not found"처럼 원인을 표시할 수 있게 함
+
+// Collector states
+const (
+	CollectorStateOK    = "ok"
+	CollectorStateError = "error" // 마지막 실행이 실패 (직전 값이 없거나 오래된 값)
+)
+
+// CollectorStatus is the current state of a collector as shown to the user
+type CollectorStatus struct {
+	Name              string     `json:"name"`
+	State             string     `json:"state"`                 // ok, error
+	Message           string     `json:"message,omitempty"`     // 사용자 표시용 문구 (오류 상태만, 선택된 언어)
+	MessageKey        string     `json:"message_key,omitempty"` // 문구의 메시지 키 (collector.unavailable[_reason])
+	LastError         string     `json:"last_error,omitempty"`
+	LastErrorAt       *time.Time `json:"last_error_at,omitempty"`
+	LastSuccess       *time.Time `json:"last_success,omitempty"`
+	ConsecutiveErrors int64      `json:"consecutive_errors"`
+}
+
+// GetCollectorStatuses returns the state and last error of every collector that has run at least once,
+// with messages in the given language
+func GetCollectorStatuses(language string) []CollectorStatus {
+	return collectorStatuses(GetCollectorStats(), language)
+}
+
+// collectorStatuses converts collector statistics into user-facing states
+func collectorStatuses(stats []CollectorStats, language string) []CollectorStatus {
+	statuses := make([]CollectorStatus, 0, len(stats))
+	for _, collector := range stats {
+		status := CollectorStatus{
+			Name:              collector.Name,
+			State:             CollectorStateOK,
+			ConsecutiveErrors: collector.ConsecutiveErrors,
+		}
+		if !collector.LastSuccess.IsZero() {
+			lastSuccess := collector.LastSuccess
+			status.LastSuccess = &lastSuccess
+		}
+		if !collector.LastErrorAt.IsZero() {
+			lastErrorAt := collector.LastErrorAt
+			status.LastError = collector.LastError
+			status.LastErrorAt = &lastErrorAt
+		}
+		if collector.ConsecutiveErrors > 0 {
+			status.State = CollectorStateError
+			message := collectorErrorMessage(language, collector.Name, collector.LastError)
+			status.Message = message.Text
+			status.MessageKey = message.Key
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// CollectorErrorMessages maps collectors whose last run failed to their user-facing message
+func CollectorErrorMessages(statuses []CollectorStatus) map[string]string {
+	messages := make(map[string]string)
+	for _, status := range statuses {
+		if status.State == CollectorStateError {
+			messages[status.Name] = status.Message
+		}
+	}
+	return messages
+}
+
+// collectorErrorMessage formats "<data> unavailable: <reason>" on a single line
+func collectorErrorMessage(language, name, lastError string) Message {
+	display := strings.ReplaceAll(name, "_", " ")
+	if _, ok := messageCatalog["collector."+name]; ok {
+		display = Translate(language, "collector."+name)
+	}
+	// 여러 줄 안내 문구(설치 방법 등)도 한 줄로 표시
+	reason := strings.Join(strings.Fields(lastError), " ")
+	if reason == "" {
+		return NewMessage(language, "collector.unavailable", display)
+	}
+	return NewMessage(language, "collector.unavailable_reason", display, reason)
+}
@@ -0,0 +1,50 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCollectorStatuses(t *testing.T) {
+	now := time.Now()
+	stats := []CollectorStats{
+		{Name: "cpu", Calls: 10, LastRun: now, LastSuccess: now},
+		{Name: "gpu_info", Calls: 3, Errors: 3, ConsecutiveErrors: 3, LastRun: now, LastErrorAt: now, LastError: "nvidia-smi not found"},
+		{Name: "memory", Calls: 5, Errors: 1, LastRun: now, LastSuccess: now, LastErrorAt: now.Add(-time.Minute), LastError: "transient"},
+		{Name: "custom_probe", Calls: 1, Errors: 1, ConsecutiveErrors: 1, LastRun: now, LastErrorAt: now, LastError: "line one\n- line two"},
+	}
+
+	statuses := collectorStatuses(stats, LanguageEnglish)
+	if statuses[0].State != CollectorStateOK || statuses[0].LastErrorAt != nil || statuses[0].Message != "" {
+		t.Errorf("Expected cpu to be ok without error, got %+v", statuses[0])
+	}
+	if statuses[1].State != CollectorStateError || statuses[1].Message != "GPU data unavailable: nvidia-smi not found" || statuses[1].LastSuccess != nil {
+		t.Errorf("Unexpected gpu_info status: %+v", statuses[1])
+	}
+	// 복구된 수집기는 정상이지만 마지막 오류 기록은 유지
+	if statuses[2].State != CollectorStateOK || statuses[2].LastError != "transient" || statuses[2].Message != "" {
+		t.Errorf("Unexpected recovered status: %+v", statuses[2])
+	}
+	if statuses[3].Message != "custom probe unavailable: line one - line two" {
+		t.Errorf("Unexpected multi-line message: %q", statuses[3].Message)
+	}
+
+	messages := CollectorErrorMessages(statuses)
+	if len(messages) != 2 || messages["gpu_info"] == "" || messages["custom_probe"] == "" {
+		t.Errorf("Unexpected error messages: %+v", messages)
+	}
+}
+
+func TestObserveCollectorConsecutiveErrors(t *testing.T) {
+	stats := &CollectorStats{Name: "test"}
+	observeDuration(stats, time.Millisecond, errors.New("failed"))
+	observeDuration(stats, time.Millisecond, errors.New("failed again"))
+	if stats.ConsecutiveErrors != 2 || stats.LastErrorAt.IsZero() || stats.LastError != "failed again" {
+		t.Errorf("Unexpected stats after failures: %+v", stats)
+	}
+	observeDuration(stats, time.Millisecond, nil)
+	if stats.ConsecutiveErrors != 0 || stats.LastSuccess.IsZero() {
+		t.Errorf("Expected success to reset consecutive errors: %+v", stats)
+	}
+}
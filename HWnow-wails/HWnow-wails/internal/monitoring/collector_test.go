@@ -1,6 +1,7 @@
 package monitoring
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -9,7 +10,7 @@ import (
 func TestCollectorFunctionalitySuite(t *testing.T) {
 
 	t.Run("CPU_Core_Usage", func(t *testing.T) {
-		coreUsage, err := GetCPUCoreUsage()
+		coreUsage, err := GetCPUCoreUsage(context.Background())
 		if err != nil {
 			t.Fatalf("CPU core usage failed: %v", err)
 		}
@@ -26,7 +27,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("Battery_Info", func(t *testing.T) {
-		batteryInfo, err := GetBatteryInfo()
+		batteryInfo, err := GetBatteryInfo(context.Background())
 		if err != nil {
 			t.Logf("Battery info failed (may not have battery): %v", err)
 			return // Skip on systems without battery
@@ -38,7 +39,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("GPU_Process_Monitoring", func(t *testing.T) {
-		response, err := GetGPUProcessesFiltered(GPUProcessQuery{})
+		response, err := GetGPUProcessesFiltered(context.Background(), GPUProcessQuery{})
 		if err != nil {
 			t.Fatalf("GPU process monitoring failed: %v", err)
 		}
@@ -56,7 +57,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("CPU_Cores", func(t *testing.T) {
-		cores, err := GetCPUCores()
+		cores, err := GetCPUCores(context.Background())
 		if err != nil {
 			t.Fatalf("CPU cores failed: %v", err)
 		}
@@ -67,7 +68,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("Total_Memory", func(t *testing.T) {
-		memory, err := GetTotalMemory()
+		memory, err := GetTotalMemory(context.Background())
 		if err != nil {
 			t.Fatalf("Total memory failed: %v", err)
 		}
@@ -78,7 +79,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("Boot_Time", func(t *testing.T) {
-		bootTime, err := GetBootTime()
+		bootTime, err := GetBootTime(context.Background())
 		if err != nil {
 			t.Fatalf("Boot time failed: %v", err)
 		}
@@ -94,7 +95,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 	})
 
 	t.Run("System_Uptime", func(t *testing.T) {
-		uptime, err := GetSystemUptime()
+		uptime, err := GetSystemUptime(context.Background())
 		if err != nil {
 			t.Fatalf("System uptime failed: %v", err)
 		}
@@ -109,7 +110,7 @@ func TestCollectorFunctionalitySuite(t *testing.T) {
 func TestCollectorPerformance(t *testing.T) {
 	t.Run("CPU_Core_Usage_Performance", func(t *testing.T) {
 		start := time.Now()
-		_, err := GetCPUCoreUsage()
+		_, err := GetCPUCoreUsage(context.Background())
 		duration := time.Since(start)
 
 		if err != nil {
@@ -124,7 +125,7 @@ func TestCollectorPerformance(t *testing.T) {
 
 	t.Run("GPU_Processes_Performance", func(t *testing.T) {
 		start := time.Now()
-		_, err := GetGPUProcessesFiltered(GPUProcessQuery{})
+		_, err := GetGPUProcessesFiltered(context.Background(), GPUProcessQuery{})
 		duration := time.Since(start)
 
 		if err != nil {
@@ -149,14 +150,14 @@ func TestCollectorThreadSafety(t *testing.T) {
 			defer func() { done <- true }()
 
 			// Test concurrent CPU usage collection
-			_, err := GetCPUCoreUsage()
+			_, err := GetCPUCoreUsage(context.Background())
 			if err != nil {
 				errors <- err
 				return
 			}
 
 			// Test concurrent GPU process monitoring
-			_, err = GetGPUProcessesFiltered(GPUProcessQuery{})
+			_, err = GetGPUProcessesFiltered(context.Background(), GPUProcessQuery{})
 			if err != nil {
 				errors <- err
 				return
@@ -184,7 +185,7 @@ func TestCollectorThreadSafety(t *testing.T) {
 func BenchmarkCollectorCPUCoreUsage(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := GetCPUCoreUsage()
+		_, err := GetCPUCoreUsage(context.Background())
 		if err != nil {
 			b.Fatalf("Benchmark failed: %v", err)
 		}
@@ -194,7 +195,7 @@ func BenchmarkCollectorCPUCoreUsage(b *testing.B) {
 func BenchmarkCollectorGPUProcesses(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := GetGPUProcessesFiltered(GPUProcessQuery{})
+		_, err := GetGPUProcessesFiltered(context.Background(), GPUProcessQuery{})
 		if err != nil {
 			b.Fatalf("Benchmark failed: %v", err)
 		}
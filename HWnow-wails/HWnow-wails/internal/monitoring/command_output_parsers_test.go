@@ -0,0 +1,151 @@
+package monitoring
+
+import (
+	"testing"
+)
+
+// processesByPID indexes parsed GPU processes for order-independent checks
+func processesByPID(processes []GPUProcess) map[int32]GPUProcess {
+	byPID := make(map[int32]GPUProcess, len(processes))
+	for _, process := range processes {
+		byPID[process.PID] = process
+	}
+	return byPID
+}
+
+func TestCommandOutputParsers(t *testing.T) {
+	type expected struct {
+		pid     int32
+		name    string
+		usage   float64
+		memory  float64
+		kind    string
+		source  string
+		inexact bool // EstimatedUsage
+	}
+
+	cases := []struct {
+		name    string
+		fixture string
+		parse   func([]byte) ([]GPUProcess, error)
+		want    []expected
+	}{
+		{
+			name:    "NVIDIA_Pmon",
+			fixture: "nvidia-smi-pmon-um.txt",
+			parse:   parseNVIDIAProcessOutput,
+			want: []expected{
+				{pid: 3999001, name: "PID_3999001", usage: 45, memory: 12, kind: "C", source: GPUUsageSourceNVIDIAPmon},
+				{pid: 3999002, name: "PID_3999002", usage: 3, memory: 1, kind: "G", source: GPUUsageSourceNVIDIAPmon},
+				{pid: 3999003, name: "PID_3999003", usage: 0, memory: 0, kind: "C+G", source: GPUUsageSourceNVIDIAPmon},
+			},
+		},
+		{
+			name:    "NVIDIA_Pmon_Optimized",
+			fixture: "nvidia-smi-pmon-um.txt",
+			parse:   parseNVIDIAPmonOutputOptimized,
+			want: []expected{
+				{pid: 3999001, name: "PID_3999001", usage: 45, memory: 12, kind: "C", source: GPUUsageSourceNVIDIAPmon},
+				{pid: 3999002, name: "PID_3999002", usage: 3, memory: 1, kind: "G", source: GPUUsageSourceNVIDIAPmon},
+				{pid: 3999003, name: "PID_3999003", usage: 0, memory: 0, kind: "C+G", source: GPUUsageSourceNVIDIAPmon},
+			},
+		},
+		{
+			name:    "NVIDIA_Compute_Apps_CSV",
+			fixture: "nvidia-smi-query-compute-apps.csv",
+			parse:   func(output []byte) ([]GPUProcess, error) { return parseNVIDIAAlternativeOutput(output, 0) },
+			want: []expected{
+				{pid: 3999001, name: "python3.11", memory: 2048, kind: "C", source: GPUUsageSourceUnavailable},
+				{pid: 3999003, name: "blender.exe", memory: 512, kind: "C", source: GPUUsageSourceUnavailable},
+				{pid: 3999004, name: "[Not Found]", memory: 128, kind: "C", source: GPUUsageSourceUnavailable},
+				{pid: 3999005, name: "ollama", memory: 0, kind: "C", source: GPUUsageSourceUnavailable},
+			},
+		},
+		{
+			name:    "NVIDIA_Compute_Apps_CSV_Consolidated",
+			fixture: "nvidia-smi-query-compute-apps.csv",
+			parse:   func(output []byte) ([]GPUProcess, error) { return parseConsolidatedNVIDIAProcessOutput(output, 0) },
+			want: []expected{
+				{pid: 3999001, name: "/usr/bin/python3.11", memory: 2048, kind: "Compute", source: GPUUsageSourceUnavailable},
+				{pid: 3999003, name: `C:\Program Files\Blender Foundation\Blender 4.1\blender.exe`, memory: 512, kind: "Compute", source: GPUUsageSourceUnavailable},
+				{pid: 3999005, name: "ollama", memory: 0, kind: "Compute", source: GPUUsageSourceUnavailable},
+			},
+		},
+		{
+			name:    "WMI_Process_CSV",
+			fixture: "powershell-win32-process.csv",
+			parse:   parseWMIProcessOutput,
+			want: []expected{
+				{pid: 3999010, name: "chrome.exe", usage: 5, memory: 500, kind: "G", source: GPUUsageSourceFixedEstimate, inexact: true},
+				{pid: 3999011, name: "obs64.exe", usage: 5, memory: 200, kind: "G", source: GPUUsageSourceFixedEstimate, inexact: true},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			processes, err := c.parse(loadCommandFixture(t, c.fixture))
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if len(processes) != len(c.want) {
+				t.Fatalf("Expected %d processes, got %d: %+v", len(c.want), len(processes), processes)
+			}
+			byPID := processesByPID(processes)
+			for _, want := range c.want {
+				got, ok := byPID[want.pid]
+				if !ok {
+					t.Errorf("PID %d missing", want.pid)
+					continue
+				}
+				if got.Name != want.name || got.GPUUsage != want.usage || got.GPUMemory != want.memory ||
+					got.Type != want.kind || got.UsageSource != want.source || got.EstimatedUsage != want.inexact {
+					t.Errorf("PID %d: got %+v, want %+v", want.pid, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("Performance_Counters", func(t *testing.T) {
+		processes := parsePerformanceCounterData(
+			loadCommandFixture(t, "powershell-gpu-process-memory.txt"),
+			loadCommandFixture(t, "powershell-gpu-engine-utilization.txt"))
+		byPID := processesByPID(processes)
+		if len(byPID) != 2 {
+			t.Fatalf("Expected 2 processes (the _total instance is skipped), got %+v", processes)
+		}
+
+		// 어댑터별 메모리는 합산하고, 엔진 사용률은 최대값 사용
+		if p := byPID[3999001]; p.GPUMemory != 3072 || p.GPUUsage != 63.25 || p.UsageSource != GPUUsageSourcePerfCounter || p.EstimatedUsage {
+			t.Errorf("Unexpected measured process: %+v", p)
+		}
+		// 0% 사용률은 무시되고 메모리 기반 추정값 사용
+		if p := byPID[3999002]; p.GPUMemory != 256 || p.GPUUsage != 0.256 || p.UsageSource != GPUUsageSourceMemoryEstimate || !p.EstimatedUsage {
+			t.Errorf("Unexpected estimated process: %+v", p)
+		}
+	})
+
+	t.Run("WMIC_List", func(t *testing.T) {
+		values := parseWMIListOutput(loadCommandFixture(t, "wmic-win32-battery.txt"))
+		cases := map[string]string{"BatteryStatus": "2", "EstimatedChargeRemaining": "87", "EstimatedRunTime": "71582788"}
+		for key, want := range cases {
+			if values[key] != want {
+				t.Errorf("%s = %q, want %q (first battery wins)", key, values[key], want)
+			}
+		}
+	})
+
+	t.Run("Empty_Output", func(t *testing.T) {
+		parsers := map[string]func([]byte) ([]GPUProcess, error){
+			"pmon":      parseNVIDIAProcessOutput,
+			"pmon_opt":  parseNVIDIAPmonOutputOptimized,
+			"wmi":       parseWMIProcessOutput,
+			"alternate": func(output []byte) ([]GPUProcess, error) { return parseNVIDIAAlternativeOutput(output, 0) },
+		}
+		for name, parse := range parsers {
+			if processes, err := parse(nil); err != nil || len(processes) != 0 {
+				t.Errorf("%s: expected no processes and no error, got %v, %v", name, processes, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package monitoring
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCommandRunner returns recorded tool output (testdata/commands) instead of running commands
+type fakeCommandRunner struct {
+	mu        sync.Mutex
+	responses []fakeCommandResponse
+	calls     []string
+}
+
+// fakeCommandResponse answers commands of tool whose arguments include all of args
+type fakeCommandResponse struct {
+	tool   string // executable name without directory or .exe
+	args   []string
+	output []byte
+	err    error
+}
+
+// newFakeCommandRunner installs a fake runner for the duration of the test
+func newFakeCommandRunner(t *testing.T) *fakeCommandRunner {
+	t.Helper()
+	fake := &fakeCommandRunner{}
+	t.Cleanup(SetCommandRunner(fake))
+	return fake
+}
+
+// loadCommandFixture reads recorded tool output from testdata/commands
+func loadCommandFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "commands", name))
+	if err != nil {
+		t.Fatalf("Failed to read fixture %s: %v", name, err)
+	}
+	return data
+}
+
+func (f *fakeCommandRunner) respond(tool string, args []string, output []byte, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses = append(f.responses, fakeCommandResponse{tool: tool, args: args, output: output, err: err})
+}
+
+// lookup returns the first response matching cmd, or a not-found error like a missing executable
+func (f *fakeCommandRunner) lookup(cmd *exec.Cmd) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, strings.Join(cmd.Args, " "))
+
+	tool := strings.ToLower(filepath.Base(strings.ReplaceAll(cmd.Args[0], "\\", "/")))
+	tool = strings.TrimSuffix(tool, ".exe")
+	for _, response := range f.responses {
+		if response.tool == tool && containsAllArgs(cmd.Args[1:], response.args) {
+			return response.output, response.err
+		}
+	}
+	return nil, &exec.Error{Name: cmd.Args[0], Err: exec.ErrNotFound}
+}
+
+func containsAllArgs(args, wanted []string) bool {
+	for _, want := range wanted {
+		found := false
+		for _, arg := range args {
+			if arg == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *fakeCommandRunner) Output(cmd *exec.Cmd, combined bool) ([]byte, error) {
+	return f.lookup(cmd)
+}
+
+func (f *fakeCommandRunner) Run(cmd *exec.Cmd) error {
+	_, err := f.lookup(cmd)
+	return err
+}
+
+func (f *fakeCommandRunner) Stream(cmd *exec.Cmd) (io.ReadCloser, func() error, error) {
+	output, err := f.lookup(cmd)
+	var notFound *exec.Error
+	if errors.As(err, &notFound) {
+		return nil, nil, err
+	}
+	return io.NopCloser(bytes.NewReader(output)), func() error { return err }, nil
+}
+
+func (f *fakeCommandRunner) Start(cmd *exec.Cmd) (int, func() error, error) {
+	if _, err := f.lookup(cmd); err != nil {
+		return 0, nil, err
+	}
+	return 3999999, func() error { return nil }, nil
+}
+
+// useNVIDIASMIPath pins the cached nvidia-smi path so lookups do not probe the real system
+func useNVIDIASMIPath(t *testing.T, path string) {
+	t.Helper()
+	nvidiaSMIPathCache.mutex.Lock()
+	previousPath, previousChecked := nvidiaSMIPathCache.path, nvidiaSMIPathCache.lastChecked
+	nvidiaSMIPathCache.path, nvidiaSMIPathCache.lastChecked = path, time.Now()
+	nvidiaSMIPathCache.mutex.Unlock()
+
+	t.Cleanup(func() {
+		nvidiaSMIPathCache.mutex.Lock()
+		nvidiaSMIPathCache.path, nvidiaSMIPathCache.lastChecked = previousPath, previousChecked
+		nvidiaSMIPathCache.mutex.Unlock()
+	})
+}
+
+func TestCommandRunner(t *testing.T) {
+
+	t.Run("NVIDIA_GPU_Info_From_Fixture", func(t *testing.T) {
+		fake := newFakeCommandRunner(t)
+		useNVIDIASMIPath(t, `C:\Program Files\NVIDIA Corporation\NVSMI\nvidia-smi.exe`)
+		fake.respond("nvidia-smi", []string{"--format=csv,noheader,nounits"}, loadCommandFixture(t, "nvidia-smi-query-gpu.csv"), nil)
+
+		info, err := getNVIDIASMIInfo()
+		if err != nil {
+			t.Fatalf("getNVIDIASMIInfo failed: %v", err)
+		}
+		if info.Name != "NVIDIA GeForce RTX 4070" || info.Usage != 37 || info.MemoryTotal != 12282 || info.Power != 71.35 {
+			t.Errorf("Unexpected GPU info: %+v", info)
+		}
+	})
+
+	t.Run("NVIDIA_Pmon_From_Fixture", func(t *testing.T) {
+		fake := newFakeCommandRunner(t)
+		useNVIDIASMIPath(t, "nvidia-smi")
+		fake.respond("nvidia-smi", []string{"pmon", "um"}, loadCommandFixture(t, "nvidia-smi-pmon-um.txt"), nil)
+
+		processes, err := parseNVIDIAProcessesWithRetry(0, 0)
+		if err != nil {
+			t.Fatalf("parseNVIDIAProcessesWithRetry failed: %v", err)
+		}
+		if len(processes) != 3 || processes[0].PID != 3999001 || processes[0].GPUUsage != 45 {
+			t.Errorf("Unexpected processes: %+v", processes)
+		}
+		if len(fake.calls) != 1 || !strings.HasSuffix(fake.calls[0], "pmon -c 1 -s um") {
+			t.Errorf("Expected a single pmon call, got %v", fake.calls)
+		}
+	})
+
+	t.Run("Missing_Tool_Reports_Error", func(t *testing.T) {
+		newFakeCommandRunner(t)
+		useNVIDIASMIPath(t, "nvidia-smi")
+
+		if _, err := getNVIDIASMIInfo(); err == nil || !strings.Contains(err.Error(), "nvidia-smi command failed") {
+			t.Errorf("Expected a command failure, got %v", err)
+		}
+	})
+
+	t.Run("Tool_Error_Is_Returned", func(t *testing.T) {
+		fake := newFakeCommandRunner(t)
+		fake.respond("typeperf", nil, nil, errors.New("exit status 1"))
+
+		if _, err := createHiddenCommandWithTimeout("typeperf", 3, `\System\Processor Queue Length`).Output(); err == nil {
+			t.Error("Expected the recorded error")
+		}
+	})
+
+	t.Run("Stream_Is_Faked", func(t *testing.T) {
+		fake := newFakeCommandRunner(t)
+		fake.respond("gdbus", []string{"monitor"}, []byte("line 1\nline 2\n"), nil)
+
+		stdout, wait, err := commandRunner.Stream(exec.Command("gdbus", "monitor", "--system"))
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		data, _ := io.ReadAll(stdout)
+		if string(data) != "line 1\nline 2\n" || wait() != nil {
+			t.Errorf("Unexpected stream output %q", data)
+		}
+	})
+}
@@ -0,0 +1,409 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	gonet "net"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 네트워크 연결 테이블 (netstat 위젯용)
+// gopsutil net.Connections로 TCP/UDP 연결과 소유 프로세스를 조회하고 ProcessQuery와 동일한 필터/정렬/페이지네이션 제공
+// 연결별 전송 속도: Linux는 ss -ti의 연결별 누적 바이트 차이로 측정,
+// 그 외 플랫폼은 인터페이스 전체 속도를 ESTABLISHED TCP 연결 수로 나눈 추정값
+
+// 소켓 유형 SOCK_DGRAM (UDP)
+const socketTypeDgram = 2
+
+// Connection rate sources
+const (
+	ConnectionRateMeasured  = "measured"
+	ConnectionRateEstimated = "estimated"
+)
+
+// ConnectionInfo represents an open TCP/UDP socket and its owning process
+type ConnectionInfo struct {
+	Protocol    string  `json:"protocol"` // tcp, tcp6, udp, udp6
+	LocalAddr   string  `json:"local_addr"`
+	LocalPort   uint32  `json:"local_port"`
+	RemoteAddr  string  `json:"remote_addr"`
+	RemotePort  uint32  `json:"remote_port"`
+	State       string  `json:"state"` // ESTABLISHED, LISTEN, TIME_WAIT ... (UDP는 빈 값)
+	PID         int32   `json:"pid"`
+	ProcessName string  `json:"process_name"`
+	SendRate    float64 `json:"send_rate"`             // 송신 속도 (bytes/s)
+	RecvRate    float64 `json:"recv_rate"`             // 수신 속도 (bytes/s)
+	RateSource  string  `json:"rate_source,omitempty"` // measured, estimated
+}
+
+type ConnectionFilter struct {
+	Protocol         string `json:"protocol"`          // "", "tcp", "udp" (IPv6 포함)
+	State            string `json:"state"`             // 예: "ESTABLISHED"
+	ProcessName      string `json:"process_name"`      // 프로세스 이름 포함 검색
+	Address          string `json:"address"`           // 로컬/원격 주소 포함 검색
+	PID              int32  `json:"pid"`               // 0 = 전체
+	ExcludeListening bool   `json:"exclude_listening"` // LISTEN 소켓 제외
+}
+
+type ConnectionSort struct {
+	Field string `json:"field"` // "pid", "process", "protocol", "state", "local_port", "remote", "remote_port", "rate"
+	Order string `json:"order"` // "asc", "desc"
+}
+
+type ConnectionQuery struct {
+	Filter   ConnectionFilter `json:"filter"`
+	Sort     ConnectionSort   `json:"sort"`
+	MaxItems int              `json:"max_items"`
+	Offset   int              `json:"offset"`
+}
+
+type ConnectionResponse struct {
+	Connections   []ConnectionInfo `json:"connections"`
+	TotalCount    int              `json:"total_count"`
+	FilteredCount int              `json:"filtered_count"`
+	HasMore       bool             `json:"has_more"`
+	QueryTime     int64            `json:"query_time_ms"`
+}
+
+// connectionByteSample stores cumulative bytes of a TCP connection (or all interfaces) for rate calculation
+type connectionByteSample struct {
+	sent      uint64
+	received  uint64
+	timestamp time.Time
+}
+
+// ConnectionCache caches the connection table and byte counters for rate calculation
+type ConnectionCache struct {
+	mutex       sync.Mutex
+	connections []ConnectionInfo
+	timestamp   time.Time
+	samples     map[string]connectionByteSample
+	totalSample *connectionByteSample
+}
+
+const CONNECTION_CACHE_DURATION = 2 * time.Second
+
+var connectionCache = &ConnectionCache{
+	samples: make(map[string]connectionByteSample),
+}
+
+// GetConnectionsFiltered returns open connections with backend filtering, sorting and pagination
+func GetConnectionsFiltered(ctx context.Context, query ConnectionQuery) (*ConnectionResponse, error) {
+	return queryWithContext(ctx, "GetConnectionsFiltered", func() (*ConnectionResponse, error) { return getConnectionsFiltered(query) })
+}
+
+func getConnectionsFiltered(query ConnectionQuery) (*ConnectionResponse, error) {
+	startTime := time.Now()
+
+	allConnections, err := getCachedConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(allConnections)
+	filtered := filterConnections(allConnections, query.Filter)
+	filteredCount := len(filtered)
+	sortConnections(filtered, query.Sort)
+
+	paginated := []ConnectionInfo{}
+	hasMore := false
+
+	start := query.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start < filteredCount {
+		end := filteredCount
+		if query.MaxItems > 0 && start+query.MaxItems < filteredCount {
+			end = start + query.MaxItems
+			hasMore = true
+		}
+		paginated = filtered[start:end]
+	}
+
+	return &ConnectionResponse{
+		Connections:   paginated,
+		TotalCount:    totalCount,
+		FilteredCount: filteredCount,
+		HasMore:       hasMore,
+		QueryTime:     time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// getCachedConnections returns a copy of the cached connection table, refreshing it when stale
+func getCachedConnections() ([]ConnectionInfo, error) {
+	connectionCache.mutex.Lock()
+	defer connectionCache.mutex.Unlock()
+
+	if time.Since(connectionCache.timestamp) >= CONNECTION_CACHE_DURATION || connectionCache.connections == nil {
+		connections, err := connectionCache.refresh()
+		if err != nil {
+			return nil, err
+		}
+		connectionCache.connections = connections
+		connectionCache.timestamp = time.Now()
+	}
+
+	result := make([]ConnectionInfo, len(connectionCache.connections))
+	copy(result, connectionCache.connections)
+	return result, nil
+}
+
+// refresh collects all inet connections with process names and rates (caller holds the mutex)
+func (c *ConnectionCache) refresh() ([]ConnectionInfo, error) {
+	stats, err := net.Connections("inet")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network connections: %v", err)
+	}
+
+	names := make(map[int32]string)
+	connections := make([]ConnectionInfo, 0, len(stats))
+	for _, stat := range stats {
+		conn := ConnectionInfo{
+			Protocol:   connectionProtocol(stat),
+			LocalAddr:  stat.Laddr.IP,
+			LocalPort:  stat.Laddr.Port,
+			RemoteAddr: stat.Raddr.IP,
+			RemotePort: stat.Raddr.Port,
+			State:      stat.Status,
+			PID:        stat.Pid,
+		}
+		if conn.State == "NONE" {
+			conn.State = ""
+		}
+		if conn.PID > 0 {
+			name, cached := names[conn.PID]
+			if !cached {
+				if p, err := process.NewProcess(conn.PID); err == nil {
+					name, _ = p.Name()
+				}
+				names[conn.PID] = name
+			}
+			conn.ProcessName = name
+		}
+		connections = append(connections, conn)
+	}
+
+	now := time.Now()
+	if runtime.GOOS == "linux" {
+		counters, err := getLinuxTCPByteCounters()
+		if err == nil {
+			c.applyMeasuredRates(connections, counters, now)
+			return connections, nil
+		}
+		LogDebug("Per-connection TCP counters unavailable, estimating rates", "error", err)
+	}
+	c.applyEstimatedRates(connections, now)
+	return connections, nil
+}
+
+// connectionProtocol returns tcp/udp with a 6 suffix for IPv6 sockets
+func connectionProtocol(stat net.ConnectionStat) string {
+	protocol := "tcp"
+	if stat.Type == socketTypeDgram {
+		protocol = "udp"
+	}
+	if strings.Contains(stat.Laddr.IP, ":") {
+		protocol += "6"
+	}
+	return protocol
+}
+
+// connectionKey identifies a TCP connection by its local and remote endpoints (ss 출력 형식과 동일)
+func connectionKey(localIP string, localPort uint32, remoteIP string, remotePort uint32) string {
+	return gonet.JoinHostPort(localIP, strconv.Itoa(int(localPort))) + "-" +
+		gonet.JoinHostPort(remoteIP, strconv.Itoa(int(remotePort)))
+}
+
+// applyMeasuredRates sets per-connection rates from cumulative TCP byte counters (caller holds the mutex)
+func (c *ConnectionCache) applyMeasuredRates(connections []ConnectionInfo, counters map[string]connectionByteSample, now time.Time) {
+	for i := range connections {
+		conn := &connections[i]
+		if !strings.HasPrefix(conn.Protocol, "tcp") || conn.RemotePort == 0 {
+			continue
+		}
+		key := connectionKey(conn.LocalAddr, conn.LocalPort, conn.RemoteAddr, conn.RemotePort)
+		current, ok := counters[key]
+		if !ok {
+			continue
+		}
+		conn.RateSource = ConnectionRateMeasured
+		if prev, ok := c.samples[key]; ok {
+			conn.SendRate, conn.RecvRate = connectionRates(prev, current, now)
+		}
+	}
+
+	for key := range c.samples {
+		if _, ok := counters[key]; !ok {
+			delete(c.samples, key)
+		}
+	}
+	for key, sample := range counters {
+		sample.timestamp = now
+		c.samples[key] = sample
+	}
+}
+
+// applyEstimatedRates splits total interface throughput across established TCP connections (caller holds the mutex)
+func (c *ConnectionCache) applyEstimatedRates(connections []ConnectionInfo, now time.Time) {
+	ioCounters, err := net.IOCounters(false)
+	if err != nil || len(ioCounters) == 0 {
+		return
+	}
+	current := connectionByteSample{sent: ioCounters[0].BytesSent, received: ioCounters[0].BytesRecv, timestamp: now}
+	prev := c.totalSample
+	c.totalSample = &current
+	if prev == nil {
+		return
+	}
+
+	sendRate, recvRate := connectionRates(*prev, current, now)
+	established := 0
+	for _, conn := range connections {
+		if conn.State == "ESTABLISHED" {
+			established++
+		}
+	}
+	if established == 0 {
+		return
+	}
+
+	for i := range connections {
+		if connections[i].State != "ESTABLISHED" {
+			continue
+		}
+		connections[i].SendRate = sendRate / float64(established)
+		connections[i].RecvRate = recvRate / float64(established)
+		connections[i].RateSource = ConnectionRateEstimated
+	}
+}
+
+// connectionRates returns send/receive bytes per second between two samples
+func connectionRates(prev, current connectionByteSample, now time.Time) (float64, float64) {
+	elapsed := now.Sub(prev.timestamp).Seconds()
+	if elapsed <= 0 || current.sent < prev.sent || current.received < prev.received {
+		return 0, 0
+	}
+	return float64(current.sent-prev.sent) / elapsed, float64(current.received-prev.received) / elapsed
+}
+
+// getLinuxTCPByteCounters reads cumulative bytes per TCP connection from ss
+func getLinuxTCPByteCounters() (map[string]connectionByteSample, error) {
+	cmd := createHiddenCommandWithTimeout("ss", 3, "-tinH")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("ss query failed: %v", err)
+	}
+	return parseSSTCPInfo(string(output)), nil
+}
+
+// parseSSTCPInfo parses `ss -tinH` output (socket line followed by an indented TCP info line)
+func parseSSTCPInfo(output string) map[string]connectionByteSample {
+	counters := make(map[string]connectionByteSample)
+	currentKey := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// 소켓 줄: State Recv-Q Send-Q Local:Port Peer:Port
+		if line[0] != ' ' && line[0] != '\t' {
+			fields := strings.Fields(line)
+			currentKey = ""
+			if len(fields) >= 5 {
+				currentKey = fields[3] + "-" + fields[4]
+			}
+			continue
+		}
+		if currentKey == "" {
+			continue
+		}
+
+		var sample connectionByteSample
+		for _, field := range strings.Fields(line) {
+			if value, ok := strings.CutPrefix(field, "bytes_sent:"); ok {
+				sample.sent, _ = strconv.ParseUint(value, 10, 64)
+			} else if value, ok := strings.CutPrefix(field, "bytes_received:"); ok {
+				sample.received, _ = strconv.ParseUint(value, 10, 64)
+			}
+		}
+		counters[currentKey] = sample
+		currentKey = ""
+	}
+	return counters
+}
+
+func filterConnections(connections []ConnectionInfo, filter ConnectionFilter) []ConnectionInfo {
+	protocol := strings.ToLower(strings.TrimSpace(filter.Protocol))
+	state := strings.ToUpper(strings.TrimSpace(filter.State))
+	processName := strings.ToLower(strings.TrimSpace(filter.ProcessName))
+	address := strings.TrimSpace(filter.Address)
+
+	filtered := []ConnectionInfo{}
+	for _, conn := range connections {
+		if protocol != "" && !strings.HasPrefix(conn.Protocol, protocol) {
+			continue
+		}
+		if state != "" && conn.State != state {
+			continue
+		}
+		if filter.ExcludeListening && conn.State == "LISTEN" {
+			continue
+		}
+		if filter.PID > 0 && conn.PID != filter.PID {
+			continue
+		}
+		if processName != "" && !strings.Contains(strings.ToLower(conn.ProcessName), processName) {
+			continue
+		}
+		if address != "" && !strings.Contains(conn.LocalAddr, address) && !strings.Contains(conn.RemoteAddr, address) {
+			continue
+		}
+		filtered = append(filtered, conn)
+	}
+	return filtered
+}
+
+func sortConnections(connections []ConnectionInfo, sortConfig ConnectionSort) {
+	if sortConfig.Field == "" {
+		return // No sorting
+	}
+
+	sort.SliceStable(connections, func(i, j int) bool {
+		a, b := connections[i], connections[j]
+		if sortConfig.Order == "desc" {
+			a, b = b, a
+		}
+
+		switch sortConfig.Field {
+		case "process":
+			return strings.ToLower(a.ProcessName) < strings.ToLower(b.ProcessName)
+		case "protocol":
+			return a.Protocol < b.Protocol
+		case "state":
+			return a.State < b.State
+		case "local_port":
+			return a.LocalPort < b.LocalPort
+		case "remote":
+			return a.RemoteAddr < b.RemoteAddr
+		case "remote_port":
+			return a.RemotePort < b.RemotePort
+		case "rate":
+			return a.SendRate+a.RecvRate < b.SendRate+b.RecvRate
+		default:
+			return a.PID < b.PID
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConnectionQueryHelpers(t *testing.T) {
+	connections := []ConnectionInfo{
+		{Protocol: "tcp", LocalAddr: "0.0.0.0", LocalPort: 80, State: "LISTEN", PID: 10, ProcessName: "nginx"},
+		{Protocol: "tcp", LocalAddr: "192.168.0.5", LocalPort: 51000, RemoteAddr: "142.250.1.1", RemotePort: 443, State: "ESTABLISHED", PID: 20, ProcessName: "chrome.exe", RecvRate: 5000},
+		{Protocol: "tcp6", LocalAddr: "::1", LocalPort: 51001, RemoteAddr: "::1", RemotePort: 8080, State: "ESTABLISHED", PID: 20, ProcessName: "chrome.exe", SendRate: 100},
+		{Protocol: "udp", LocalAddr: "0.0.0.0", LocalPort: 53, PID: 30, ProcessName: "dns"},
+	}
+
+	t.Run("Filter_By_Protocol_And_State", func(t *testing.T) {
+		filtered := filterConnections(connections, ConnectionFilter{Protocol: "tcp", State: "established"})
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 established TCP connections, got %d", len(filtered))
+		}
+
+		filtered = filterConnections(connections, ConnectionFilter{ExcludeListening: true, Address: "142.250"})
+		if len(filtered) != 1 || filtered[0].RemotePort != 443 {
+			t.Errorf("Unexpected address filter result: %+v", filtered)
+		}
+	})
+
+	t.Run("Sort_By_Rate", func(t *testing.T) {
+		sorted := append([]ConnectionInfo(nil), connections...)
+		sortConnections(sorted, ConnectionSort{Field: "rate", Order: "desc"})
+		if sorted[0].RemotePort != 443 {
+			t.Errorf("Expected the 443 connection to have the highest rate, got %+v", sorted[0])
+		}
+	})
+
+	t.Run("Parse_SS_TCP_Info", func(t *testing.T) {
+		output := "ESTAB 0 0 192.168.0.5:51000 142.250.1.1:443\n" +
+			"\t cubic wscale:7,7 rto:204 bytes_sent:1200 bytes_acked:1201 bytes_received:98000 segs_out:20\n" +
+			"ESTAB 0 0 [::1]:51001 [::1]:8080\n" +
+			"\t cubic bytes_sent:50 bytes_received:75\n"
+
+		counters := parseSSTCPInfo(output)
+		sample, ok := counters[connectionKey("192.168.0.5", 51000, "142.250.1.1", 443)]
+		if !ok || sample.sent != 1200 || sample.received != 98000 {
+			t.Errorf("Unexpected IPv4 counters: %+v (found %v)", sample, ok)
+		}
+		if _, ok := counters[connectionKey("::1", 51001, "::1", 8080)]; !ok {
+			t.Errorf("Expected IPv6 connection key, got %v", counters)
+		}
+	})
+
+	t.Run("Rate_Calculation", func(t *testing.T) {
+		now := time.Now()
+		prev := connectionByteSample{sent: 1000, received: 4000, timestamp: now.Add(-2 * time.Second)}
+		sendRate, recvRate := connectionRates(prev, connectionByteSample{sent: 3000, received: 5000}, now)
+		if sendRate != 1000 || recvRate != 500 {
+			t.Errorf("Expected 1000/500 bytes/s, got %f/%f", sendRate, recvRate)
+		}
+	})
+}
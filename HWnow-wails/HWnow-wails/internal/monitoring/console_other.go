@@ -0,0 +1,13 @@
+//go:build !windows
+
+package monitoring
+
+import "os/exec"
+
+// 콘솔 창이 없는 플랫폼에서는 추가 설정이 필요 없음
+
+// hideConsoleWindow is a no-op outside Windows
+func hideConsoleWindow(cmd *exec.Cmd) {}
+
+// detachConsoleWindow is a no-op outside Windows
+func detachConsoleWindow(cmd *exec.Cmd) {}
@@ -0,0 +1,37 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// 콘솔 도구(nvidia-smi, powershell, typeperf ...) 실행 시 명령 프롬프트 창이 깜빡이지 않도록 설정
+
+const (
+	windowsCreateNoWindow        = 0x08000000 // CREATE_NO_WINDOW
+	windowsDetachedProcess       = 0x00000010 // DETACHED_PROCESS
+	windowsCreateNewProcessGroup = 0x00000200 // CREATE_NEW_PROCESS_GROUP
+)
+
+// hideConsoleWindow starts the command without a console window
+func hideConsoleWindow(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow:    true,
+		CreationFlags: windowsCreateNoWindow,
+	}
+}
+
+// detachConsoleWindow starts the command hidden and detached from HWnow's console, with terminal output minimized
+func detachConsoleWindow(cmd *exec.Cmd) {
+	hideConsoleWindow(cmd)
+	cmd.SysProcAttr.CreationFlags |= windowsDetachedProcess | windowsCreateNewProcessGroup
+
+	// 환경 변수로 콘솔 출력 억제
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, "TERM=dumb")
+}
@@ -2,7 +2,6 @@ package monitoring
 
 import (
 	"log"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
@@ -82,22 +81,15 @@ func (c *cpuMonitor) GetCPUCores() (int, error) {
 
 // getCPUCoresFromWMI gets CPU core count using Windows WMI
 func (c *cpuMonitor) getCPUCoresFromWMI() int {
-	cmd := exec.Command("wmic", "cpu", "get", "NumberOfCores", "/format:csv")
-	output, err := cmd.Output()
+	rows, err := queryWMI("", "Win32_Processor", "", "NumberOfCores")
 	if err != nil {
 		return 0
 	}
 
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		if strings.Contains(line, ",") {
-			parts := strings.Split(line, ",")
-			if len(parts) >= 2 && strings.TrimSpace(parts[1]) != "" {
-				cores, err := strconv.Atoi(strings.TrimSpace(parts[1]))
-				if err == nil && cores > 0 {
-					return cores
-				}
-			}
+	for _, row := range rows {
+		cores, err := strconv.Atoi(strings.TrimSpace(row["NumberOfCores"]))
+		if err == nil && cores > 0 {
+			return cores
 		}
 	}
 	return 0
@@ -124,19 +116,14 @@ func (c *cpuMonitor) GetCPUModelName() (string, error) {
 
 // getCPUModelFromWMI gets CPU model name using Windows WMI
 func (c *cpuMonitor) getCPUModelFromWMI() string {
-	cmd := exec.Command("wmic", "cpu", "get", "Name", "/format:csv")
-	output, err := cmd.Output()
+	rows, err := queryWMI("", "Win32_Processor", "", "Name")
 	if err != nil {
 		return ""
 	}
 
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		if strings.Contains(line, ",") {
-			parts := strings.Split(line, ",")
-			if len(parts) >= 2 && strings.TrimSpace(parts[1]) != "" {
-				return strings.TrimSpace(parts[1])
-			}
+	for _, row := range rows {
+		if name := strings.TrimSpace(row["Name"]); name != "" {
+			return name
 		}
 	}
 	return ""
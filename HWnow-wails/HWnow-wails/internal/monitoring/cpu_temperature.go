@@ -0,0 +1,136 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CPU 패키지 온도 수집
+// Linux: hwmon 드라이버(coretemp, k10temp, zenpower)의 패키지 온도, 없으면 thermal zone
+// Windows: ACPI thermal zone (MSAcpi_ThermalZoneTemperature, 관리자 권한 필요, 메인보드가 CPU 온도를 보고하는 경우만)
+// 조회 비용이 있으므로 CPU_TEMPERATURE_CACHE_DURATION 간격으로만 갱신
+
+const CPU_TEMPERATURE_CACHE_DURATION = 10 * time.Second
+
+// CPU 패키지 온도를 보고하는 hwmon 드라이버 이름
+var cpuHwmonDrivers = map[string]bool{
+	"coretemp":    true, // Intel
+	"k10temp":     true, // AMD
+	"zenpower":    true, // AMD (대체 드라이버)
+	"cpu_thermal": true, // ARM SoC
+}
+
+// CPU 온도로 볼 수 있는 thermal zone 종류
+var cpuThermalZoneTypes = []string{"x86_pkg_temp", "cpu", "soc"}
+
+// CPUTemperatureCache caches the CPU temperature reading
+type CPUTemperatureCache struct {
+	mutex       sync.Mutex
+	temperature float64
+	err         error
+	timestamp   time.Time
+}
+
+var cpuTemperatureCache = &CPUTemperatureCache{}
+
+// GetCPUTemperature returns the CPU package temperature in °C, refreshed at most every CPU_TEMPERATURE_CACHE_DURATION
+func GetCPUTemperature(ctx context.Context) (float64, error) {
+	return queryWithContext(ctx, "GetCPUTemperature", getCPUTemperature)
+}
+
+func getCPUTemperature() (float64, error) {
+	cpuTemperatureCache.mutex.Lock()
+	defer cpuTemperatureCache.mutex.Unlock()
+
+	if time.Since(cpuTemperatureCache.timestamp) < CPU_TEMPERATURE_CACHE_DURATION {
+		return cpuTemperatureCache.temperature, cpuTemperatureCache.err
+	}
+
+	var temperature float64
+	var err error
+	switch runtime.GOOS {
+	case "linux":
+		temperature, err = getCPUTemperatureLinux()
+	case "windows":
+		temperature, err = getCPUTemperatureWindows()
+	default:
+		err = fmt.Errorf("CPU temperature not supported on platform: %s", runtime.GOOS)
+	}
+
+	// 실패한 경우에도 타임스탬프를 갱신하여 매 조회마다 다시 시도하지 않도록 함
+	cpuTemperatureCache.temperature = temperature
+	cpuTemperatureCache.err = err
+	cpuTemperatureCache.timestamp = time.Now()
+	return temperature, err
+}
+
+// getCPUTemperatureLinux reads the package temperature from hwmon, falling back to thermal zones
+func getCPUTemperatureLinux() (float64, error) {
+	hwmons, _ := filepath.Glob("/sys/class/hwmon/hwmon*")
+	for _, hwmon := range hwmons {
+		if !cpuHwmonDrivers[strings.TrimSpace(readSysfsString(hwmon, "name"))] {
+			continue
+		}
+		// temp1은 coretemp의 "Package id 0", k10temp의 "Tctl"
+		if milliCelsius, ok := readSysfsFloat(hwmon, "temp1_input"); ok && milliCelsius > 0 {
+			return milliCelsius / 1000, nil
+		}
+	}
+
+	zones, _ := filepath.Glob("/sys/class/thermal/thermal_zone*")
+	for _, zoneType := range cpuThermalZoneTypes {
+		for _, zone := range zones {
+			if !strings.EqualFold(strings.TrimSpace(readSysfsString(zone, "type")), zoneType) {
+				continue
+			}
+			if milliCelsius, ok := readSysfsFloat(zone, "temp"); ok && milliCelsius > 0 {
+				return milliCelsius / 1000, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no CPU temperature sensor found")
+}
+
+// getCPUTemperatureWindows reads the hottest ACPI thermal zone
+func getCPUTemperatureWindows() (float64, error) {
+	rows, err := queryWMI(`root\WMI`, "MSAcpi_ThermalZoneTemperature", "", "CurrentTemperature")
+	if err != nil {
+		return 0, err
+	}
+	values := make([]string, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, row["CurrentTemperature"])
+	}
+	return parseThermalZoneTemperatures(values)
+}
+
+// parseThermalZoneTemperatures converts ACPI readings (tenths of Kelvin) to °C and returns the highest
+// 일부 펌웨어가 보고하는 고정값/범위 밖 값은 무시
+func parseThermalZoneTemperatures(values []string) (float64, error) {
+	highest := 0.0
+	found := false
+	for _, value := range values {
+		deciKelvin, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			continue
+		}
+		celsius := deciKelvin/10 - 273.15
+		if celsius <= 0 || celsius >= 125 {
+			continue
+		}
+		if !found || celsius > highest {
+			highest = celsius
+			found = true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("no valid ACPI thermal zone reading")
+	}
+	return highest, nil
+}
@@ -0,0 +1,26 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCPUTemperature(t *testing.T) {
+	t.Run("Parse_Thermal_Zones", func(t *testing.T) {
+		// 3282 = 55.05°C, 3132 = 40.05°C (0.1 K 단위)
+		celsius, err := parseThermalZoneTemperatures([]string{"3132", "3282", "garbage"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(celsius-55.05) > 0.001 {
+			t.Errorf("Expected hottest zone 55.05°C, got %.2f", celsius)
+		}
+	})
+
+	t.Run("Ignore_Invalid_Readings", func(t *testing.T) {
+		// 0 K 와 125°C 이상(펌웨어 고정값)은 무시
+		if _, err := parseThermalZoneTemperatures([]string{"0", "4000", ""}); err == nil {
+			t.Error("Expected error when no reading is valid")
+		}
+	})
+}
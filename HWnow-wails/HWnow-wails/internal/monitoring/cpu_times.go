@@ -0,0 +1,146 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+)
+
+// CPU 시간 분류 (연산 부하인지 I/O 대기인지 구분하기 위한 선택 기능)
+// cpu.Times 누적값의 이전 샘플 대비 변화량을 백분율로 환산
+// Windows: 합계(cpu-total)에는 인터럽트 시간이 없으므로 코어별 값을 합산하고, 커널 시간에 포함된 인터럽트 시간은 system에서 제외
+// iowait/softirq/steal/nice는 Linux에서만 제공됨
+
+// CPUTimeBreakdown represents the share of CPU time per state since the previous sample (%)
+type CPUTimeBreakdown struct {
+	User    float64 `json:"user"`
+	System  float64 `json:"system"`
+	Idle    float64 `json:"idle"`
+	Nice    float64 `json:"nice"`
+	IOWait  float64 `json:"iowait"`
+	IRQ     float64 `json:"irq"`
+	SoftIRQ float64 `json:"softirq"`
+	Steal   float64 `json:"steal"` // 하이퍼바이저가 다른 VM에 할당한 시간
+}
+
+// cpuTimesState keeps the previous cumulative CPU times for delta calculation
+type cpuTimesState struct {
+	mutex    sync.Mutex
+	previous *cpu.TimesStat
+}
+
+var cpuTimes = &cpuTimesState{}
+
+// GetCPUTimeBreakdown returns user/system/iowait/irq/steal percentages (the first call only records a baseline)
+func GetCPUTimeBreakdown(ctx context.Context) (*CPUTimeBreakdown, error) {
+	return queryWithContext(ctx, "GetCPUTimeBreakdown", getCPUTimeBreakdown)
+}
+
+func getCPUTimeBreakdown() (*CPUTimeBreakdown, error) {
+	current, err := readCPUTimes()
+	if err != nil {
+		return nil, err
+	}
+
+	cpuTimes.mutex.Lock()
+	defer cpuTimes.mutex.Unlock()
+
+	previous := cpuTimes.previous
+	cpuTimes.previous = current
+	if previous == nil {
+		return nil, fmt.Errorf("collecting CPU time baseline")
+	}
+	return cpuTimeDelta(previous, current)
+}
+
+// CPUTimeMetrics converts a CPU time breakdown into resource log metrics
+func CPUTimeMetrics(breakdown *CPUTimeBreakdown) []Metric {
+	if breakdown == nil {
+		return nil
+	}
+	metrics := []Metric{
+		{Type: "cpu_user", Value: breakdown.User},
+		{Type: "cpu_system", Value: breakdown.System},
+		{Type: "cpu_irq", Value: breakdown.IRQ},
+	}
+	if runtime.GOOS != "windows" {
+		metrics = append(metrics,
+			Metric{Type: "cpu_iowait", Value: breakdown.IOWait},
+			Metric{Type: "cpu_softirq", Value: breakdown.SoftIRQ},
+			Metric{Type: "cpu_steal", Value: breakdown.Steal},
+		)
+	}
+	return metrics
+}
+
+// readCPUTimes returns cumulative CPU times summed over all cores
+func readCPUTimes() (*cpu.TimesStat, error) {
+	if runtime.GOOS != "windows" {
+		times, err := cpu.Times(false)
+		if err != nil {
+			return nil, err
+		}
+		if len(times) == 0 {
+			return nil, fmt.Errorf("no CPU times returned")
+		}
+		return &times[0], nil
+	}
+
+	perCore, err := cpu.Times(true)
+	if err != nil {
+		return nil, err
+	}
+	if len(perCore) == 0 {
+		return nil, fmt.Errorf("no CPU times returned")
+	}
+	total := &cpu.TimesStat{CPU: "cpu-total"}
+	for _, core := range perCore {
+		total.User += core.User
+		total.System += core.System - core.Irq
+		total.Idle += core.Idle
+		total.Irq += core.Irq
+	}
+	return total, nil
+}
+
+// cpuTimeDelta converts two cumulative samples into percentages of the elapsed CPU time
+func cpuTimeDelta(previous, current *cpu.TimesStat) (*CPUTimeBreakdown, error) {
+	delta := func(before, after float64) float64 {
+		if after < before {
+			return 0
+		}
+		return after - before
+	}
+
+	user := delta(previous.User, current.User)
+	system := delta(previous.System, current.System)
+	idle := delta(previous.Idle, current.Idle)
+	nice := delta(previous.Nice, current.Nice)
+	iowait := delta(previous.Iowait, current.Iowait)
+	irq := delta(previous.Irq, current.Irq)
+	softirq := delta(previous.Softirq, current.Softirq)
+	steal := delta(previous.Steal, current.Steal)
+
+	// guest 시간은 Linux에서 user에 이미 포함되므로 합계에서 제외
+	total := user + system + idle + nice + iowait + irq + softirq + steal
+	if total <= 0 {
+		return nil, fmt.Errorf("no CPU time elapsed since previous sample")
+	}
+
+	percent := func(value float64) float64 {
+		return value / total * 100
+	}
+	return &CPUTimeBreakdown{
+		User:    percent(user),
+		System:  percent(system),
+		Idle:    percent(idle),
+		Nice:    percent(nice),
+		IOWait:  percent(iowait),
+		IRQ:     percent(irq),
+		SoftIRQ: percent(softirq),
+		Steal:   percent(steal),
+	}, nil
+}
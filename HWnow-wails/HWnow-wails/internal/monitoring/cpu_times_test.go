@@ -0,0 +1,50 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+)
+
+func TestCPUTimeBreakdown(t *testing.T) {
+	t.Run("Delta_Percentages", func(t *testing.T) {
+		previous := &cpu.TimesStat{User: 100, System: 50, Idle: 800, Iowait: 10, Irq: 5, Steal: 0, Guest: 20}
+		current := &cpu.TimesStat{User: 140, System: 60, Idle: 830, Iowait: 25, Irq: 10, Steal: 10, Guest: 40}
+		breakdown, err := cpuTimeDelta(previous, current)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 경과 시간 합계 = 40 + 10 + 30 + 15 + 5 + 10 = 110 (guest 제외)
+		expected := map[string][2]float64{
+			"user":   {breakdown.User, 40.0 / 110 * 100},
+			"system": {breakdown.System, 10.0 / 110 * 100},
+			"iowait": {breakdown.IOWait, 15.0 / 110 * 100},
+			"steal":  {breakdown.Steal, 10.0 / 110 * 100},
+		}
+		for name, values := range expected {
+			if math.Abs(values[0]-values[1]) > 0.001 {
+				t.Errorf("%s = %.3f, want %.3f", name, values[0], values[1])
+			}
+		}
+	})
+
+	t.Run("No_Elapsed_Time", func(t *testing.T) {
+		sample := &cpu.TimesStat{User: 100, Idle: 800}
+		if _, err := cpuTimeDelta(sample, sample); err == nil {
+			t.Error("expected error when no time elapsed")
+		}
+	})
+
+	t.Run("Counter_Reset", func(t *testing.T) {
+		previous := &cpu.TimesStat{User: 100, Idle: 800}
+		current := &cpu.TimesStat{User: 10, Idle: 900}
+		breakdown, err := cpuTimeDelta(previous, current)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if breakdown.User != 0 || breakdown.Idle != 100 {
+			t.Errorf("unexpected breakdown after reset: %+v", breakdown)
+		}
+	})
+}
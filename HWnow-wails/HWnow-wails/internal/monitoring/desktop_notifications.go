@@ -0,0 +1,287 @@
+package monitoring
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 알림 이벤트를 OS 데스크톱 알림으로 표시 (Windows 알림 센터 토스트, macOS 알림 센터, Linux libnotify)
+// 심각도 기준 미만, 방해 금지 시간, 같은 알림의 재표시 대기 시간(cooldown)에 해당하면 표시하지 않고 기록만 남김
+// 실제 표시는 플랫폼별 외부 도구(powershell, osascript, notify-send)를 거치므로 수집 경로를 막지 않도록 비동기로 실행
+
+// Notification severities
+const (
+	NotificationSeverityInfo     = "info"
+	NotificationSeverityWarning  = "warning"
+	NotificationSeverityCritical = "critical"
+)
+
+// Reasons a notification was not shown
+const (
+	NotificationSuppressedDisabled    = "disabled"
+	NotificationSuppressedSeverity    = "below_threshold"
+	NotificationSuppressedQuietHours  = "quiet_hours"
+	NotificationSuppressedCooldown    = "cooldown"
+	NotificationSuppressedUnavailable = "unavailable"
+)
+
+// DEFAULT_NOTIFICATION_COOLDOWN is the default minimum time between notifications for the same event
+const DEFAULT_NOTIFICATION_COOLDOWN = 5 * time.Minute
+
+// 최근 알림 기록 보관 개수
+const maxNotificationRecords = 50
+
+var notificationSeverityRank = map[string]int{
+	NotificationSeverityInfo:     0,
+	NotificationSeverityWarning:  1,
+	NotificationSeverityCritical: 2,
+}
+
+// DesktopNotification is an event to show as an OS notification
+type DesktopNotification struct {
+	Key      string `json:"key"`      // 같은 Key의 알림은 cooldown 동안 한 번만 표시 (빈 값 = Title)
+	Category string `json:"category"` // 이벤트 분류 (events 테이블의 category)
+	Severity string `json:"severity"` // info, warning, critical
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+}
+
+// NotificationRecord is a notification that was shown or suppressed
+type NotificationRecord struct {
+	DesktopNotification
+	Timestamp  time.Time `json:"timestamp"`
+	Delivered  bool      `json:"delivered"`
+	Suppressed string    `json:"suppressed,omitempty"` // 표시하지 않은 이유
+	Error      string    `json:"error,omitempty"`
+}
+
+// NotificationPolicy decides which notifications are shown
+type NotificationPolicy struct {
+	Enabled          bool
+	MinSeverity      string        // 이 심각도 이상만 표시
+	QuietHoursStart  string        // 현지 시각 HH:MM (빈 값 = 방해 금지 시간 없음, 자정을 넘는 범위 가능)
+	QuietHoursEnd    string        // 현지 시각 HH:MM
+	QuietMinSeverity string        // 방해 금지 시간에도 표시할 최소 심각도 (빈 값 = 모두 숨김)
+	Cooldown         time.Duration // 같은 알림을 다시 표시하기까지 최소 간격
+}
+
+// NotificationStatus describes the notification policy, the platform backend and recent notifications
+type NotificationStatus struct {
+	Enabled          bool                 `json:"enabled"`
+	MinSeverity      string               `json:"min_severity"`
+	QuietHoursStart  string               `json:"quiet_hours_start"`
+	QuietHoursEnd    string               `json:"quiet_hours_end"`
+	QuietMinSeverity string               `json:"quiet_min_severity"`
+	CooldownSeconds  int                  `json:"cooldown_seconds"`
+	QuietNow         bool                 `json:"quiet_now"`
+	Backend          string               `json:"backend"`   // toast, notification_center, libnotify
+	Available        bool                 `json:"available"` // 표시 도구를 찾을 수 있음
+	Recent           []NotificationRecord `json:"recent"`    // 최근 알림 (최신순)
+}
+
+// ValidNotificationSeverity reports whether s is a known notification severity
+func ValidNotificationSeverity(s string) bool {
+	_, ok := notificationSeverityRank[s]
+	return ok
+}
+
+// Validate checks the severities and quiet hours of the policy
+func (p NotificationPolicy) Validate() error {
+	if !ValidNotificationSeverity(p.MinSeverity) {
+		return fmt.Errorf("unknown notification severity %q (info, warning, critical)", p.MinSeverity)
+	}
+	if p.QuietMinSeverity != "" && !ValidNotificationSeverity(p.QuietMinSeverity) {
+		return fmt.Errorf("unknown quiet hours severity %q (info, warning, critical)", p.QuietMinSeverity)
+	}
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		return fmt.Errorf("quiet hours need both a start and an end time")
+	}
+	if p.QuietHoursStart != "" {
+		if _, err := parseClockMinutes(p.QuietHoursStart); err != nil {
+			return fmt.Errorf("quiet hours start: %v", err)
+		}
+		if _, err := parseClockMinutes(p.QuietHoursEnd); err != nil {
+			return fmt.Errorf("quiet hours end: %v", err)
+		}
+	}
+	if p.Cooldown < 0 {
+		return fmt.Errorf("notification cooldown cannot be negative")
+	}
+	return nil
+}
+
+// InQuietHours reports whether the local time t falls within the quiet hours
+func (p NotificationPolicy) InQuietHours(t time.Time) bool {
+	start, startErr := parseClockMinutes(p.QuietHoursStart)
+	end, endErr := parseClockMinutes(p.QuietHoursEnd)
+	if startErr != nil || endErr != nil || start == end {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return minute >= start && minute < end
+	}
+	// 자정을 넘는 범위 (예: 22:00-07:00)
+	return minute >= start || minute < end
+}
+
+// parseClockMinutes converts HH:MM to minutes since midnight
+func parseClockMinutes(clock string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (expected HH:MM)", clock)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+// DesktopNotifier shows notifications through the platform notification backend according to a policy
+type DesktopNotifier struct {
+	mutex    sync.Mutex
+	policy   NotificationPolicy
+	lastSent map[string]time.Time
+	recent   []NotificationRecord
+	send     func(DesktopNotification) error // 플랫폼 표시 함수 (테스트에서 교체)
+}
+
+// NewDesktopNotifier creates a notifier using the platform notification backend
+func NewDesktopNotifier(policy NotificationPolicy) (*DesktopNotifier, error) {
+	n := &DesktopNotifier{
+		lastSent: make(map[string]time.Time),
+		send:     sendDesktopNotification,
+	}
+	if err := n.SetPolicy(policy); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// SetPolicy replaces the notification policy (an empty minimum severity means warning)
+func (n *DesktopNotifier) SetPolicy(policy NotificationPolicy) error {
+	if policy.MinSeverity == "" {
+		policy.MinSeverity = NotificationSeverityWarning
+	}
+	if policy.Cooldown == 0 {
+		policy.Cooldown = DEFAULT_NOTIFICATION_COOLDOWN
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	n.mutex.Lock()
+	n.policy = policy
+	n.mutex.Unlock()
+	return nil
+}
+
+// Notify shows the notification in the background unless the policy suppresses it
+func (n *DesktopNotifier) Notify(notification DesktopNotification) NotificationRecord {
+	record := n.decide(notification, time.Now())
+	if record.Suppressed != "" {
+		n.addRecord(record)
+		return record
+	}
+
+	go func() {
+		n.addRecord(n.deliver(record))
+	}()
+	return record
+}
+
+// Test shows a notification immediately regardless of the policy and returns the delivery result
+func (n *DesktopNotifier) Test(title, message string) NotificationRecord {
+	record := NotificationRecord{
+		DesktopNotification: DesktopNotification{
+			Key:      "test",
+			Category: "test",
+			Severity: NotificationSeverityInfo,
+			Title:    title,
+			Message:  message,
+		},
+		Timestamp: time.Now(),
+	}
+	record = n.deliver(record)
+	n.addRecord(record)
+	return record
+}
+
+// decide applies the policy to a notification at the given local time, reserving its cooldown when it is shown
+func (n *DesktopNotifier) decide(notification DesktopNotification, now time.Time) NotificationRecord {
+	if !ValidNotificationSeverity(notification.Severity) {
+		notification.Severity = NotificationSeverityInfo
+	}
+	if notification.Key == "" {
+		notification.Key = notification.Title
+	}
+	record := NotificationRecord{DesktopNotification: notification, Timestamp: now}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	rank := notificationSeverityRank[notification.Severity]
+	switch {
+	case !n.policy.Enabled:
+		record.Suppressed = NotificationSuppressedDisabled
+	case rank < notificationSeverityRank[n.policy.MinSeverity]:
+		record.Suppressed = NotificationSuppressedSeverity
+	case n.policy.InQuietHours(now) &&
+		(n.policy.QuietMinSeverity == "" || rank < notificationSeverityRank[n.policy.QuietMinSeverity]):
+		record.Suppressed = NotificationSuppressedQuietHours
+	case now.Sub(n.lastSent[notification.Key]) < n.policy.Cooldown:
+		record.Suppressed = NotificationSuppressedCooldown
+	default:
+		n.lastSent[notification.Key] = now
+	}
+	return record
+}
+
+// deliver shows the notification through the platform backend and records the outcome
+func (n *DesktopNotifier) deliver(record NotificationRecord) NotificationRecord {
+	if !desktopNotificationsAvailable() {
+		record.Suppressed = NotificationSuppressedUnavailable
+		return record
+	}
+	if err := n.send(record.DesktopNotification); err != nil {
+		LogWarn("Failed to show desktop notification", "backend", desktopNotificationBackend, "title", record.Title, "error", err)
+		record.Error = err.Error()
+		return record
+	}
+	record.Delivered = true
+	return record
+}
+
+func (n *DesktopNotifier) addRecord(record NotificationRecord) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.recent = append(n.recent, record)
+	if len(n.recent) > maxNotificationRecords {
+		n.recent = n.recent[len(n.recent)-maxNotificationRecords:]
+	}
+}
+
+// Status returns the policy, the platform backend and recent notifications
+func (n *DesktopNotifier) Status() NotificationStatus {
+	available := desktopNotificationsAvailable()
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	status := NotificationStatus{
+		Enabled:          n.policy.Enabled,
+		MinSeverity:      n.policy.MinSeverity,
+		QuietHoursStart:  n.policy.QuietHoursStart,
+		QuietHoursEnd:    n.policy.QuietHoursEnd,
+		QuietMinSeverity: n.policy.QuietMinSeverity,
+		CooldownSeconds:  int(n.policy.Cooldown / time.Second),
+		QuietNow:         n.policy.InQuietHours(time.Now()),
+		Backend:          desktopNotificationBackend,
+		Available:        available,
+		Recent:           make([]NotificationRecord, 0, len(n.recent)),
+	}
+	for i := len(n.recent) - 1; i >= 0; i-- {
+		status.Recent = append(status.Recent, n.recent[i])
+	}
+	return status
+}
@@ -0,0 +1,41 @@
+//go:build darwin
+
+package monitoring
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// macOS: osascript의 display notification으로 알림 센터에 표시
+
+const desktopNotificationBackend = "notification_center"
+
+// desktopNotificationsAvailable reports whether osascript can be found
+func desktopNotificationsAvailable() bool {
+	_, err := exec.LookPath("osascript")
+	return err == nil
+}
+
+// sendDesktopNotification shows a notification in the Notification Center
+func sendDesktopNotification(notification DesktopNotification) error {
+	script := fmt.Sprintf(`display notification %s with title "HWnow" subtitle %s`,
+		appleScriptString(notification.Message), appleScriptString(notification.Title))
+	if notification.Severity == NotificationSeverityCritical {
+		script += ` sound name "Basso"`
+	}
+
+	cmd := newExternalCommand(externalCommandTimeout("osascript"), "osascript", "-e", script)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// appleScriptString quotes text as an AppleScript string literal
+func appleScriptString(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\\`)
+	text = strings.ReplaceAll(text, `"`, `\"`)
+	return `"` + text + `"`
+}
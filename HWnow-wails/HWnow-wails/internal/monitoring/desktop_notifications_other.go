@@ -0,0 +1,41 @@
+//go:build !windows && !darwin
+
+package monitoring
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Linux/BSD: libnotify의 notify-send로 데스크톱 알림 데몬에 전달
+
+const desktopNotificationBackend = "libnotify"
+
+// notify-send 긴급도 (low, normal, critical)
+var notifySendUrgency = map[string]string{
+	NotificationSeverityInfo:     "low",
+	NotificationSeverityWarning:  "normal",
+	NotificationSeverityCritical: "critical",
+}
+
+// desktopNotificationsAvailable reports whether notify-send can be found
+func desktopNotificationsAvailable() bool {
+	_, err := exec.LookPath("notify-send")
+	return err == nil
+}
+
+// sendDesktopNotification shows a notification through the desktop notification daemon
+func sendDesktopNotification(notification DesktopNotification) error {
+	urgency := notifySendUrgency[notification.Severity]
+	if urgency == "" {
+		urgency = "normal"
+	}
+
+	cmd := newExternalCommand(externalCommandTimeout("notify-send"), "notify-send",
+		"--app-name=HWnow", "--urgency="+urgency, notification.Title, notification.Message)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
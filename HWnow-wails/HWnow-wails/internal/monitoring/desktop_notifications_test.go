@@ -0,0 +1,111 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDesktopNotifier(t *testing.T) {
+	at := func(clock string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", "2026-03-10 "+clock, time.Local)
+		if err != nil {
+			t.Fatalf("Invalid test time %s: %v", clock, err)
+		}
+		return parsed
+	}
+
+	t.Run("Severity_Threshold", func(t *testing.T) {
+		notifier, err := NewDesktopNotifier(NotificationPolicy{Enabled: true})
+		if err != nil {
+			t.Fatalf("NewDesktopNotifier failed: %v", err)
+		}
+		if record := notifier.decide(DesktopNotification{Title: "Resumed", Severity: NotificationSeverityInfo}, at("12:00")); record.Suppressed != NotificationSuppressedSeverity {
+			t.Errorf("Expected info to be below the default warning threshold, got %+v", record)
+		}
+		if record := notifier.decide(DesktopNotification{Title: "Disk low", Severity: NotificationSeverityWarning}, at("12:00")); record.Suppressed != "" {
+			t.Errorf("Expected a warning to be shown, got %+v", record)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		notifier, _ := NewDesktopNotifier(NotificationPolicy{Enabled: false})
+		if record := notifier.decide(DesktopNotification{Title: "GPU ECC", Severity: NotificationSeverityCritical}, at("12:00")); record.Suppressed != NotificationSuppressedDisabled {
+			t.Errorf("Expected notifications to be suppressed while disabled, got %+v", record)
+		}
+	})
+
+	t.Run("Quiet_Hours_Across_Midnight", func(t *testing.T) {
+		notifier, err := NewDesktopNotifier(NotificationPolicy{
+			Enabled:          true,
+			MinSeverity:      NotificationSeverityInfo,
+			QuietHoursStart:  "22:00",
+			QuietHoursEnd:    "07:00",
+			QuietMinSeverity: NotificationSeverityCritical,
+		})
+		if err != nil {
+			t.Fatalf("NewDesktopNotifier failed: %v", err)
+		}
+
+		for _, clock := range []string{"23:30", "02:00", "06:59"} {
+			if record := notifier.decide(DesktopNotification{Key: clock, Severity: NotificationSeverityWarning}, at(clock)); record.Suppressed != NotificationSuppressedQuietHours {
+				t.Errorf("Expected a warning at %s to be held for quiet hours, got %+v", clock, record)
+			}
+		}
+		if record := notifier.decide(DesktopNotification{Key: "morning", Severity: NotificationSeverityWarning}, at("07:00")); record.Suppressed != "" {
+			t.Errorf("Expected quiet hours to end at 07:00, got %+v", record)
+		}
+		// 방해 금지 시간에도 심각한 알림은 표시
+		if record := notifier.decide(DesktopNotification{Key: "night", Severity: NotificationSeverityCritical}, at("03:00")); record.Suppressed != "" {
+			t.Errorf("Expected a critical notification during quiet hours, got %+v", record)
+		}
+	})
+
+	t.Run("Cooldown_Per_Key", func(t *testing.T) {
+		notifier, _ := NewDesktopNotifier(NotificationPolicy{Enabled: true, Cooldown: 10 * time.Minute})
+		alert := DesktopNotification{Key: "disk:C:", Severity: NotificationSeverityWarning, Title: "Disk low"}
+
+		if record := notifier.decide(alert, at("12:00")); record.Suppressed != "" {
+			t.Fatalf("Expected the first notification to be shown, got %+v", record)
+		}
+		if record := notifier.decide(alert, at("12:05")); record.Suppressed != NotificationSuppressedCooldown {
+			t.Errorf("Expected a repeat within the cooldown to be suppressed, got %+v", record)
+		}
+		other := alert
+		other.Key = "disk:D:"
+		if record := notifier.decide(other, at("12:05")); record.Suppressed != "" {
+			t.Errorf("Expected a different key to be shown, got %+v", record)
+		}
+		if record := notifier.decide(alert, at("12:10")); record.Suppressed != "" {
+			t.Errorf("Expected the notification again after the cooldown, got %+v", record)
+		}
+	})
+
+	t.Run("Invalid_Policy", func(t *testing.T) {
+		invalid := []NotificationPolicy{
+			{MinSeverity: "urgent"},
+			{QuietHoursStart: "22:00"},
+			{QuietHoursStart: "25:00", QuietHoursEnd: "07:00"},
+			{QuietMinSeverity: "loud"},
+			{Cooldown: -time.Second},
+		}
+		for _, policy := range invalid {
+			if _, err := NewDesktopNotifier(policy); err == nil {
+				t.Errorf("Expected policy %+v to be rejected", policy)
+			}
+		}
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		notifier, _ := NewDesktopNotifier(NotificationPolicy{Enabled: true, QuietHoursStart: "00:00", QuietHoursEnd: "00:00"})
+		notifier.Notify(DesktopNotification{Title: "First", Severity: NotificationSeverityInfo})
+		notifier.Notify(DesktopNotification{Title: "Second", Severity: NotificationSeverityInfo})
+
+		status := notifier.Status()
+		if status.MinSeverity != NotificationSeverityWarning || status.CooldownSeconds != int(DEFAULT_NOTIFICATION_COOLDOWN/time.Second) || status.QuietNow {
+			t.Errorf("Unexpected status: %+v", status)
+		}
+		if len(status.Recent) != 2 || status.Recent[0].Title != "Second" || status.Recent[0].Suppressed != NotificationSuppressedSeverity {
+			t.Errorf("Expected suppressed notifications newest first, got %+v", status.Recent)
+		}
+	})
+}
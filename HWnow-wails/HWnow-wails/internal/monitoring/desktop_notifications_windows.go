@@ -0,0 +1,58 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Windows: PowerShell로 WinRT ToastNotificationManager를 호출해 알림 센터 토스트 표시
+// HWnow는 시작 메뉴 바로 가기(AppUserModelID)를 등록하지 않으므로 PowerShell의 AppUserModelID로 표시하고
+// 앱 이름은 attribution 줄에 표시
+
+const desktopNotificationBackend = "toast"
+
+const powershellAppUserModelID = `{1AC14E77-02E7-4E5D-B744-2EB1AE5198B7}\WindowsPowerShell\v1.0\powershell.exe`
+
+// desktopNotificationsAvailable reports whether toasts can be shown (PowerShell ships with Windows)
+func desktopNotificationsAvailable() bool {
+	return true
+}
+
+// sendDesktopNotification shows a toast in the Action Center
+func sendDesktopNotification(notification DesktopNotification) error {
+	// 심각한 알림은 더 오래(약 25초) 표시
+	duration := "short"
+	if notification.Severity == NotificationSeverityCritical {
+		duration = "long"
+	}
+	toast := fmt.Sprintf(`<toast duration="%s"><visual><binding template="ToastGeneric"><text>%s</text><text>%s</text><text placement="attribution">HWnow</text></binding></visual></toast>`,
+		duration, escapeToastText(notification.Title), escapeToastText(notification.Message))
+
+	script := strings.Join([]string{
+		"$ErrorActionPreference = 'Stop'",
+		"[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null",
+		"[Windows.Data.Xml.Dom.XmlDocument, Windows.Data.Xml.Dom.XmlDocument, ContentType = WindowsRuntime] | Out-Null",
+		"$xml = New-Object Windows.Data.Xml.Dom.XmlDocument",
+		fmt.Sprintf("$xml.LoadXml('%s')", strings.ReplaceAll(toast, "'", "''")),
+		"$toast = New-Object Windows.UI.Notifications.ToastNotification $xml",
+		fmt.Sprintf("[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('%s').Show($toast)", powershellAppUserModelID),
+	}, "; ")
+
+	cmd := newExternalCommand(externalCommandTimeout("powershell"), "powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	hideConsoleWindow(cmd.Cmd)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// escapeToastText escapes text for the toast XML payload
+func escapeToastText(text string) string {
+	var buffer bytes.Buffer
+	xml.EscapeText(&buffer, []byte(text))
+	return buffer.String()
+}
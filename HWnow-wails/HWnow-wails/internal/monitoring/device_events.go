@@ -0,0 +1,273 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GPU/디스크/USB 장치/프린터 핫플러그 감지 (eGPU, USB 드라이브 연결/분리)
+// 장치 변경 시 GPU 벤더/nvidia-smi 경로/VideoController 등 장시간 캐시를 무효화하여 재시작 없이 반영
+// Windows: 메시지 전용 창 + RegisterDeviceNotification (WM_DEVICECHANGE, 모든 장치 인터페이스 클래스)
+// Linux: udevadm monitor (block/disk, drm, usb/usb_device, usbmisc 하위 시스템)
+
+// Device event types
+const (
+	DeviceEventAdded   = "added"
+	DeviceEventRemoved = "removed"
+)
+
+// Device classes reported by the watcher
+const (
+	DeviceClassGPU     = "gpu"
+	DeviceClassDisk    = "disk"
+	DeviceClassUSB     = "usb"
+	DeviceClassPrinter = "printer"
+)
+
+// 장치 인터페이스 클래스 GUID → 장치 분류 (볼륨 인터페이스는 디스크와 중복되므로 제외)
+var deviceInterfaceClasses = map[string]string{
+	"{53f56307-b6bf-11d0-94f2-00a0c91efb8b}": DeviceClassDisk,    // GUID_DEVINTERFACE_DISK
+	"{5b45201d-f2f2-4f3b-85bb-30ff1f953599}": DeviceClassGPU,     // GUID_DEVINTERFACE_DISPLAY_ADAPTER
+	"{a5dcbf10-6530-11d2-901f-00c04fb951ed}": DeviceClassUSB,     // GUID_DEVINTERFACE_USB_DEVICE
+	"{28d78fad-5a12-11d1-ae5b-0000f803a8c2}": DeviceClassPrinter, // GUID_DEVINTERFACE_USBPRINT
+}
+
+// 예: UDEV  [12345.678901] add      /devices/pci0000:00/0000:00:14.0/usb2/.../block/sdb (block)
+var udevEventPattern = regexp.MustCompile(`^UDEV\s+\[[\d.]+\]\s+(add|remove)\s+(\S+)\s+\((block|drm|usb|usbmisc)\)`)
+
+// drm 장치 중 카드 자체만 (renderD128, card1-HDMI-A-1 같은 커넥터 제외)
+var drmCardPattern = regexp.MustCompile(`^card\d+$`)
+
+// DeviceEvent represents a GPU, disk, USB device or printer being connected or disconnected
+type DeviceEvent struct {
+	Type      string    `json:"type"`   // added, removed
+	Class     string    `json:"class"`  // gpu, disk, usb, printer
+	Device    string    `json:"device"` // 장치 인터페이스 경로 (Windows) 또는 sysfs 경로 (Linux)
+	Timestamp time.Time `json:"timestamp"`
+	Source    string    `json:"source"` // device_notification, udev
+}
+
+// DeviceEventWatcher reports GPU/disk hot-plug events and invalidates hardware caches
+type DeviceEventWatcher struct {
+	mutex   sync.Mutex
+	handler func(DeviceEvent)
+	cancel  context.CancelFunc
+	hwnd    uintptr // Windows 메시지 전용 창
+	done    chan struct{}
+}
+
+// NewDeviceEventWatcher creates a watcher that calls handler for each GPU or disk hot-plug event
+func NewDeviceEventWatcher(handler func(DeviceEvent)) *DeviceEventWatcher {
+	return &DeviceEventWatcher{handler: handler}
+}
+
+// Start begins watching in the background
+func (w *DeviceEventWatcher) Start(ctx context.Context) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		return nil // already running
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		ready := make(chan error, 1)
+		w.done = make(chan struct{})
+		go w.runNotificationWindow(ready, w.done)
+		if err := <-ready; err != nil {
+			return err
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		w.cancel = cancel
+		go func() {
+			<-watchCtx.Done()
+			w.closeNotificationWindow()
+		}()
+		LogInfo("Device event watcher started", "source", "device_notification")
+	case "linux":
+		udevadmPath, err := exec.LookPath("udevadm")
+		if err != nil {
+			return fmt.Errorf("udevadm not found: %v", err)
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		w.cancel = cancel
+		go w.watchUdev(watchCtx, udevadmPath)
+		LogInfo("Device event watcher started", "source", "udev")
+	default:
+		return fmt.Errorf("device events not supported on platform: %s", runtime.GOOS)
+	}
+	return nil
+}
+
+// Stop stops watching
+func (w *DeviceEventWatcher) Stop() {
+	w.mutex.Lock()
+	cancel := w.cancel
+	done := w.done
+	w.cancel = nil
+	w.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	// 메시지 루프가 종료되어 알림 등록이 해제될 때까지 대기
+	if done != nil {
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// dispatch invalidates the caches of the affected device class and delivers the event
+func (w *DeviceEventWatcher) dispatch(event DeviceEvent) {
+	switch event.Class {
+	case DeviceClassGPU:
+		InvalidateGPUCaches()
+	case DeviceClassDisk:
+		InvalidateDiskCaches()
+	}
+	InvalidateDeviceInventory()
+
+	w.mutex.Lock()
+	handler := w.handler
+	w.mutex.Unlock()
+
+	LogInfo("Device event detected", "type", event.Type, "class", event.Class, "device", event.Device)
+	if handler != nil {
+		handler(event)
+	}
+}
+
+// InvalidateGPUCaches drops cached GPU vendor, nvidia-smi path, VideoController, adapter list and static GPU information
+func InvalidateGPUCaches() {
+	gpuVendorDetectionMutex.Lock()
+	gpuVendorDetected = false
+	detectedGPUVendor = GPUVendorUnknown
+	gpuVendorDetectionMutex.Unlock()
+
+	nvidiaSMIPathCache.mutex.Lock()
+	nvidiaSMIPathCache.path = ""
+	nvidiaSMIPathCache.lastChecked = time.Time{}
+	nvidiaSMIPathCache.mutex.Unlock()
+
+	videoControllerCache.mutex.Lock()
+	videoControllerCache.controllers = nil
+	videoControllerCache.lastUpdated = time.Time{}
+	videoControllerCache.mutex.Unlock()
+
+	wmiVideoControllerCache.mutex.Lock()
+	wmiVideoControllerCache.data = ""
+	wmiVideoControllerCache.timestamp = time.Time{}
+	wmiVideoControllerCache.mutex.Unlock()
+
+	gpuInfoCache.mutex.Lock()
+	gpuInfoCache.info = nil
+	gpuInfoCache.lastUpdated = time.Time{}
+	gpuInfoCache.mutex.Unlock()
+
+	gpuProcessCache.mutex.Lock()
+	gpuProcessCache.processes = nil
+	gpuProcessCache.summary = nil
+	gpuProcessCache.lastUpdated = time.Time{}
+	gpuProcessCache.mutex.Unlock()
+
+	gpuEngineCache.mutex.Lock()
+	gpuEngineCache.sample = nil
+	gpuEngineCache.timestamp = time.Time{}
+	gpuEngineCache.mutex.Unlock()
+
+	resetGPUStaticInfo()
+	resetGPUAdapterCache()
+}
+
+// InvalidateDiskCaches drops cached disk path usage and temperatures and resets I/O rate baselines
+func InvalidateDiskCaches() {
+	diskPathCache.mutex.Lock()
+	diskPathCache.timestamp = time.Time{}
+	diskPathCache.mutex.Unlock()
+
+	diskTemperatureCache.mutex.Lock()
+	diskTemperatureCache.temperatures = nil
+	diskTemperatureCache.timestamp = time.Time{}
+	diskTemperatureCache.mutex.Unlock()
+
+	// 디스크가 빠지면 누적 I/O 합계가 줄어들어 음수 속도가 나오지 않도록 기준값 재설정
+	ResetIOSpeedCounters()
+}
+
+// classifyDeviceInterface maps a device interface path (ending with the class GUID) to a device class
+func classifyDeviceInterface(path string) string {
+	start := strings.LastIndex(path, "#{")
+	if start < 0 {
+		return ""
+	}
+	return deviceInterfaceClasses[strings.ToLower(path[start+1:])]
+}
+
+// parseUdevLine converts a udevadm monitor line into a device event
+func parseUdevLine(line string) (DeviceEvent, bool) {
+	match := udevEventPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return DeviceEvent{}, false
+	}
+
+	event := DeviceEvent{Device: match[2], Source: "udev"}
+	switch match[1] {
+	case "add":
+		event.Type = DeviceEventAdded
+	case "remove":
+		event.Type = DeviceEventRemoved
+	}
+	switch match[3] {
+	case "block":
+		event.Class = DeviceClassDisk
+	case "drm":
+		name := match[2][strings.LastIndex(match[2], "/")+1:]
+		if !drmCardPattern.MatchString(name) {
+			return DeviceEvent{}, false
+		}
+		event.Class = DeviceClassGPU
+	case "usb":
+		// 루트 허브(usbN)는 컨트롤러 자체이므로 제외
+		if strings.HasPrefix(match[2][strings.LastIndex(match[2], "/")+1:], "usb") {
+			return DeviceEvent{}, false
+		}
+		event.Class = DeviceClassUSB
+	case "usbmisc":
+		// usblp 프린터 (…/usbmisc/lp0)
+		if !strings.HasPrefix(match[2][strings.LastIndex(match[2], "/")+1:], "lp") {
+			return DeviceEvent{}, false
+		}
+		event.Class = DeviceClassPrinter
+	}
+	return event, true
+}
+
+// watchUdev streams kernel device events for whole disks, DRM cards, USB devices and USB printers through udevadm monitor
+func (w *DeviceEventWatcher) watchUdev(ctx context.Context, udevadmPath string) {
+	cmd := exec.CommandContext(ctx, udevadmPath, "monitor", "--udev", "--subsystem-match=block/disk", "--subsystem-match=drm",
+		"--subsystem-match=usb/usb_device", "--subsystem-match=usbmisc")
+	stdout, wait, err := commandRunner.Stream(cmd)
+	if err != nil {
+		LogDebug("udev monitor failed to start", "error", err)
+		return
+	}
+	defer wait()
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		if event, ok := parseUdevLine(scanner.Text()); ok {
+			event.Timestamp = time.Now()
+			w.dispatch(event)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package monitoring
+
+import "fmt"
+
+// runNotificationWindow is only available on Windows (Linux uses udevadm monitor)
+func (w *DeviceEventWatcher) runNotificationWindow(ready chan<- error, done chan struct{}) {
+	close(done)
+	ready <- fmt.Errorf("device notification window not supported on this platform")
+}
+
+// closeNotificationWindow is a no-op outside Windows
+func (w *DeviceEventWatcher) closeNotificationWindow() {}
@@ -0,0 +1,58 @@
+package monitoring
+
+import "testing"
+
+func TestDeviceEvents(t *testing.T) {
+	t.Run("Classify_Device_Interface", func(t *testing.T) {
+		cases := map[string]string{
+			`\\?\USBSTOR#Disk&Ven_SanDisk&Prod_Ultra&Rev_1.00#4C530001#{53f56307-b6bf-11d0-94f2-00a0c91efb8b}`:          DeviceClassDisk,
+			`\\?\PCI#VEN_10DE&DEV_2684&SUBSYS_16F110DE&REV_A1#4&1a2b3c4d&0&0008#{5B45201D-F2F2-4F3B-85BB-30FF1F953599}`: DeviceClassGPU,
+			`\\?\STORAGE#Volume#{a1b2c3d4-0000-0000-0000-100000000000}#{53f5630d-b6bf-11d0-94f2-00a0c91efb8b}`:          "",
+			`\\?\HID#VID_046D&PID_C52B#7&2f3a1b&0&0000#{4d1e55b2-f16f-11cf-88cb-001111000030}`:                          "",
+			`\\?\USB#VID_046D&PID_C52B#5&2a3c1f0&0&2#{a5dcbf10-6530-11d2-901f-00c04fb951ed}`:                            DeviceClassUSB,
+			`\\?\USB#VID_03F0&PID_2B17&MI_00#7&1f3a&0&0000#{28d78fad-5a12-11d1-ae5b-0000f803a8c2}`:                      DeviceClassPrinter,
+			"no guid": "",
+		}
+		for path, want := range cases {
+			if got := classifyDeviceInterface(path); got != want {
+				t.Errorf("classifyDeviceInterface(%q) = %q, want %q", path, got, want)
+			}
+		}
+	})
+
+	t.Run("Parse_Udev_Lines", func(t *testing.T) {
+		event, ok := parseUdevLine("UDEV  [12345.678901] add      /devices/pci0000:00/0000:00:14.0/usb2/2-1/2-1:1.0/host6/target6:0:0/6:0:0:0/block/sdb (block)")
+		if !ok || event.Type != DeviceEventAdded || event.Class != DeviceClassDisk {
+			t.Errorf("unexpected disk event: %+v, %v", event, ok)
+		}
+
+		event, ok = parseUdevLine("UDEV  [12346.000001] remove   /devices/pci0000:00/0000:00:01.0/0000:01:00.0/drm/card1 (drm)")
+		if !ok || event.Type != DeviceEventRemoved || event.Class != DeviceClassGPU {
+			t.Errorf("unexpected gpu event: %+v, %v", event, ok)
+		}
+
+		event, ok = parseUdevLine("UDEV  [12347.000001] add      /devices/pci0000:00/0000:00:14.0/usb1/1-2 (usb)")
+		if !ok || event.Type != DeviceEventAdded || event.Class != DeviceClassUSB {
+			t.Errorf("unexpected usb event: %+v, %v", event, ok)
+		}
+
+		event, ok = parseUdevLine("UDEV  [12347.000002] remove   /devices/pci0000:00/0000:00:14.0/usb1/1-3/1-3:1.0/usbmisc/lp0 (usbmisc)")
+		if !ok || event.Type != DeviceEventRemoved || event.Class != DeviceClassPrinter {
+			t.Errorf("unexpected printer event: %+v, %v", event, ok)
+		}
+
+		ignored := []string{
+			"UDEV  [12347.000003] add      /devices/pci0000:00/0000:00:14.0/usb1 (usb)",
+			"UDEV  [12347.000004] add      /devices/pci0000:00/0000:00:14.0/usb1/1-3/1-3:1.1/usbmisc/hiddev0 (usbmisc)",
+			"UDEV  [12346.000002] add      /devices/pci0000:00/0000:00:01.0/0000:01:00.0/drm/renderD129 (drm)",
+			"UDEV  [12346.000003] change   /devices/pci0000:00/0000:00:01.0/0000:01:00.0/drm/card1 (drm)",
+			"monitor will print the received events for:",
+			"KERNEL[12346.000004] add      /devices/virtual/block/loop0 (block)",
+		}
+		for _, line := range ignored {
+			if event, ok := parseUdevLine(line); ok {
+				t.Errorf("line should be ignored: %q -> %+v", line, event)
+			}
+		}
+	})
+}
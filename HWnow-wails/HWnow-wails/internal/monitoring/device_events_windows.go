@@ -0,0 +1,216 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// Windows 장치 알림 상수
+const (
+	wmDeviceChange                  = 0x0219 // WM_DEVICECHANGE
+	wmClose                         = 0x0010
+	wmDestroy                       = 0x0002
+	dbtDeviceArrival                = 0x8000      // DBT_DEVICEARRIVAL
+	dbtDeviceRemoveComplete         = 0x8004      // DBT_DEVICEREMOVECOMPLETE
+	dbtDevtypDeviceInterface        = 0x5         // DBT_DEVTYP_DEVICEINTERFACE
+	deviceNotifyAllInterfaceClasses = 0x4         // DEVICE_NOTIFY_ALL_INTERFACE_CLASSES
+	hwndMessage                     = ^uintptr(2) // HWND_MESSAGE (-3)
+)
+
+// devBroadcastDeviceInterface mirrors DEV_BROADCAST_DEVICEINTERFACE_W (이름은 가변 길이)
+type devBroadcastDeviceInterface struct {
+	size       uint32
+	deviceType uint32
+	reserved   uint32
+	classGUID  [16]byte
+	name       [1]uint16
+}
+
+// wndClassEx mirrors WNDCLASSEXW
+type wndClassEx struct {
+	size       uint32
+	style      uint32
+	wndProc    uintptr
+	clsExtra   int32
+	wndExtra   int32
+	instance   uintptr
+	icon       uintptr
+	cursor     uintptr
+	background uintptr
+	menuName   *uint16
+	className  *uint16
+	iconSm     uintptr
+}
+
+// windowMessage mirrors MSG
+type windowMessage struct {
+	hwnd    uintptr
+	message uint32
+	wParam  uintptr
+	lParam  uintptr
+	time    uint32
+	ptX     int32
+	ptY     int32
+	private uint32
+}
+
+// 창 프로시저 콜백과 창 클래스는 해제할 수 없으므로 한 번만 만들고 현재 감시자에게 전달
+var (
+	deviceWindowClassOnce    sync.Once
+	deviceWindowClassName    *uint16
+	deviceWindowClassErr     error
+	activeDeviceWatcherMutex sync.Mutex
+	activeDeviceWatcher      *DeviceEventWatcher
+)
+
+// registerDeviceWindowClass registers the window class and procedure shared by all watchers (once)
+func registerDeviceWindowClass() error {
+	deviceWindowClassOnce.Do(func() {
+		user32 := syscall.NewLazyDLL("user32.dll")
+		defWindowProc := user32.NewProc("DefWindowProcW")
+		postQuitMessage := user32.NewProc("PostQuitMessage")
+
+		wndProc := syscall.NewCallback(func(hwnd, message, wParam, lParam uintptr) uintptr {
+			switch message {
+			case wmDeviceChange:
+				if (wParam == dbtDeviceArrival || wParam == dbtDeviceRemoveComplete) && lParam != 0 {
+					// lParam은 DEV_BROADCAST_HDR로 시작하는 구조체 포인터
+					header := *(**devBroadcastDeviceInterface)(unsafe.Pointer(&lParam))
+					if header.deviceType == dbtDevtypDeviceInterface {
+						handleDeviceInterfaceChange(wParam, header)
+					}
+				}
+				return 1 // TRUE
+			case wmDestroy:
+				postQuitMessage.Call(0)
+				return 0
+			}
+			ret, _, _ := defWindowProc.Call(hwnd, message, wParam, lParam)
+			return ret
+		})
+
+		className, err := syscall.UTF16PtrFromString("HWnowDeviceNotification")
+		if err != nil {
+			deviceWindowClassErr = err
+			return
+		}
+		class := wndClassEx{wndProc: wndProc, className: className}
+		class.size = uint32(unsafe.Sizeof(class))
+		instance, _, _ := syscall.NewLazyDLL("kernel32.dll").NewProc("GetModuleHandleW").Call(0)
+		class.instance = instance
+		if atom, _, callErr := user32.NewProc("RegisterClassExW").Call(uintptr(unsafe.Pointer(&class))); atom == 0 {
+			deviceWindowClassErr = fmt.Errorf("RegisterClassExW failed: %v", callErr)
+			return
+		}
+		deviceWindowClassName = className
+	})
+	return deviceWindowClassErr
+}
+
+// handleDeviceInterfaceChange classifies a DBT_DEVICEARRIVAL/DBT_DEVICEREMOVECOMPLETE notification
+func handleDeviceInterfaceChange(wParam uintptr, header *devBroadcastDeviceInterface) {
+	nameOffset := unsafe.Offsetof(header.name)
+	if uintptr(header.size) <= nameOffset {
+		return
+	}
+	nameLength := (uintptr(header.size) - nameOffset) / 2
+	path := syscall.UTF16ToString(unsafe.Slice(&header.name[0], nameLength))
+
+	class := classifyDeviceInterface(path)
+	if class == "" {
+		return
+	}
+
+	activeDeviceWatcherMutex.Lock()
+	watcher := activeDeviceWatcher
+	activeDeviceWatcherMutex.Unlock()
+	if watcher == nil {
+		return
+	}
+
+	event := DeviceEvent{Type: DeviceEventAdded, Class: class, Device: path, Timestamp: time.Now(), Source: "device_notification"}
+	if wParam == dbtDeviceRemoveComplete {
+		event.Type = DeviceEventRemoved
+	}
+	// 메시지 루프를 막지 않도록 비동기로 전달 (캐시 무효화, 이벤트 기록)
+	go watcher.dispatch(event)
+}
+
+// runNotificationWindow creates a message-only window registered for all device interface notifications
+// and pumps its messages on a locked OS thread until the window is closed
+func (w *DeviceEventWatcher) runNotificationWindow(ready chan<- error, done chan struct{}) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	defer close(done)
+
+	if err := registerDeviceWindowClass(); err != nil {
+		ready <- err
+		return
+	}
+
+	user32 := syscall.NewLazyDLL("user32.dll")
+	hwnd, _, err := user32.NewProc("CreateWindowExW").Call(0, uintptr(unsafe.Pointer(deviceWindowClassName)), 0, 0,
+		0, 0, 0, 0, hwndMessage, 0, 0, 0)
+	if hwnd == 0 {
+		ready <- fmt.Errorf("CreateWindowExW failed: %v", err)
+		return
+	}
+
+	filter := devBroadcastDeviceInterface{deviceType: dbtDevtypDeviceInterface}
+	filter.size = uint32(unsafe.Sizeof(filter))
+	notification, _, err := user32.NewProc("RegisterDeviceNotificationW").Call(hwnd, uintptr(unsafe.Pointer(&filter)), deviceNotifyAllInterfaceClasses)
+	if notification == 0 {
+		user32.NewProc("DestroyWindow").Call(hwnd)
+		ready <- fmt.Errorf("RegisterDeviceNotificationW failed: %v", err)
+		return
+	}
+	defer user32.NewProc("UnregisterDeviceNotification").Call(notification)
+
+	w.mutex.Lock()
+	w.hwnd = hwnd
+	w.mutex.Unlock()
+	activeDeviceWatcherMutex.Lock()
+	activeDeviceWatcher = w
+	activeDeviceWatcherMutex.Unlock()
+	defer func() {
+		activeDeviceWatcherMutex.Lock()
+		if activeDeviceWatcher == w {
+			activeDeviceWatcher = nil
+		}
+		activeDeviceWatcherMutex.Unlock()
+	}()
+	ready <- nil
+
+	getMessage := user32.NewProc("GetMessageW")
+	translateMessage := user32.NewProc("TranslateMessage")
+	dispatchMessage := user32.NewProc("DispatchMessageW")
+	var msg windowMessage
+	for {
+		// GetMessageW: WM_QUIT이면 0, 오류면 -1
+		ret, _, _ := getMessage.Call(uintptr(unsafe.Pointer(&msg)), 0, 0, 0)
+		if int32(ret) <= 0 {
+			return
+		}
+		translateMessage.Call(uintptr(unsafe.Pointer(&msg)))
+		dispatchMessage.Call(uintptr(unsafe.Pointer(&msg)))
+	}
+}
+
+// closeNotificationWindow asks the message loop to destroy the window and exit
+func (w *DeviceEventWatcher) closeNotificationWindow() {
+	w.mutex.Lock()
+	hwnd := w.hwnd
+	w.hwnd = 0
+	w.mutex.Unlock()
+
+	if hwnd != 0 {
+		// WM_CLOSE → DefWindowProc가 DestroyWindow 호출 → WM_DESTROY에서 PostQuitMessage
+		syscall.NewLazyDLL("user32.dll").NewProc("PostMessageW").Call(hwnd, wmClose, 0, 0)
+	}
+}
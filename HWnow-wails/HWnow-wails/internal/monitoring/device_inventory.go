@@ -0,0 +1,312 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 연결된 USB 장치 및 프린터 목록 ("무엇이 꽂혀 있는가")
+// Windows: Win32_PnPEntity (USB\VID_xxxx&PID_xxxx), Win32_Printer
+// Linux: /sys/bus/usb/devices (루트 허브/인터페이스 제외), CUPS lpstat
+// 연결/분리 이벤트는 DeviceEventWatcher가 보고하며, 이벤트 발생 시 목록 캐시를 무효화
+
+const DEVICE_INVENTORY_CACHE_DURATION = 30 * time.Second
+
+const linuxUSBDevicesPath = "/sys/bus/usb/devices"
+
+// 예: USB\VID_046D&PID_C52B\5&2A3C1F0&0&2
+var usbDeviceIDPattern = regexp.MustCompile(`(?i)^USB\\VID_([0-9A-F]{4})&PID_([0-9A-F]{4})(&MI_[0-9A-F]{2})?\\`)
+
+// 네트워크 프린터로 볼 수 있는 CUPS 장치 URI 스킴
+var networkPrinterSchemes = map[string]bool{
+	"ipp": true, "ipps": true, "http": true, "https": true, "socket": true, "lpd": true, "dnssd": true, "smb": true,
+}
+
+// USBDevice represents a connected USB device (composite device interfaces are not listed separately)
+type USBDevice struct {
+	ID           string `json:"id"` // PnP 장치 인스턴스 ID (Windows) 또는 sysfs 이름 (Linux, 예: 1-2.1)
+	Name         string `json:"name"`
+	Manufacturer string `json:"manufacturer"`
+	VendorID     string `json:"vendor_id"`  // 4자리 16진수 (소문자)
+	ProductID    string `json:"product_id"` // 4자리 16진수 (소문자)
+	Status       string `json:"status,omitempty"`
+}
+
+// Printer represents an installed printer queue
+type Printer struct {
+	Name    string `json:"name"`
+	Driver  string `json:"driver,omitempty"`
+	Port    string `json:"port,omitempty"` // 포트 이름 (Windows) 또는 장치 URI (CUPS)
+	Default bool   `json:"default"`
+	Network bool   `json:"network"`
+	Status  string `json:"status,omitempty"` // idle, printing, offline 등
+}
+
+// DeviceInventory lists the USB devices and printers currently known to the system
+type DeviceInventory struct {
+	USB       []USBDevice `json:"usb"`
+	Printers  []Printer   `json:"printers"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+// DeviceInventoryCache caches the device inventory
+type DeviceInventoryCache struct {
+	mutex     sync.Mutex
+	inventory *DeviceInventory
+	err       error
+	timestamp time.Time
+}
+
+var deviceInventoryCache = &DeviceInventoryCache{}
+
+// GetDeviceInventory returns the connected USB devices and installed printers
+// 한쪽 목록만 실패한 경우 나머지 목록은 그대로 반환
+func GetDeviceInventory(ctx context.Context) (*DeviceInventory, error) {
+	return queryWithContext(ctx, "GetDeviceInventory", getDeviceInventory)
+}
+
+func getDeviceInventory() (*DeviceInventory, error) {
+	deviceInventoryCache.mutex.Lock()
+	defer deviceInventoryCache.mutex.Unlock()
+
+	if time.Since(deviceInventoryCache.timestamp) < DEVICE_INVENTORY_CACHE_DURATION {
+		return deviceInventoryCache.inventory, deviceInventoryCache.err
+	}
+
+	inventory := &DeviceInventory{USB: []USBDevice{}, Printers: []Printer{}, Timestamp: time.Now()}
+	var usbErr, printerErr error
+	switch runtime.GOOS {
+	case "windows":
+		inventory.USB, usbErr = getUSBDevicesWindows()
+		inventory.Printers, printerErr = getPrintersWindows()
+	case "linux":
+		inventory.USB, usbErr = readLinuxUSBDevices(linuxUSBDevicesPath)
+		inventory.Printers, printerErr = getPrintersCUPS()
+	default:
+		usbErr = fmt.Errorf("device inventory not supported on platform: %s", runtime.GOOS)
+	}
+
+	var err error
+	if usbErr != nil && printerErr != nil {
+		err = fmt.Errorf("USB: %v; printers: %v", usbErr, printerErr)
+		inventory = nil
+	} else {
+		if usbErr != nil {
+			LogDebug("USB device enumeration failed", "error", usbErr)
+			inventory.USB = []USBDevice{}
+		}
+		if printerErr != nil {
+			LogDebug("Printer enumeration failed", "error", printerErr)
+			inventory.Printers = []Printer{}
+		}
+	}
+
+	deviceInventoryCache.inventory = inventory
+	deviceInventoryCache.err = err
+	deviceInventoryCache.timestamp = time.Now()
+	return inventory, err
+}
+
+// InvalidateDeviceInventory drops the cached inventory so the next call re-enumerates devices
+func InvalidateDeviceInventory() {
+	deviceInventoryCache.mutex.Lock()
+	deviceInventoryCache.timestamp = time.Time{}
+	deviceInventoryCache.mutex.Unlock()
+}
+
+// getUSBDevicesWindows lists USB devices from Win32_PnPEntity
+func getUSBDevicesWindows() ([]USBDevice, error) {
+	rows, err := queryWMI("", "Win32_PnPEntity", `PNPDeviceID LIKE 'USB\\VID[_]%'`, "Name", "Manufacturer", "PNPDeviceID", "Status")
+	if err != nil {
+		return nil, err
+	}
+
+	devices := []USBDevice{}
+	for _, row := range rows {
+		vendorID, productID, ok := parseUSBDeviceID(row["PNPDeviceID"])
+		if !ok {
+			continue
+		}
+		devices = append(devices, USBDevice{
+			ID:           row["PNPDeviceID"],
+			Name:         row["Name"],
+			Manufacturer: row["Manufacturer"],
+			VendorID:     vendorID,
+			ProductID:    productID,
+			Status:       row["Status"],
+		})
+	}
+	sortUSBDevices(devices)
+	return devices, nil
+}
+
+// parseUSBDeviceID extracts the vendor and product IDs from a USB device instance ID
+// 복합 장치의 인터페이스(&MI_xx)는 부모 장치와 중복되므로 제외
+func parseUSBDeviceID(pnpDeviceID string) (vendorID, productID string, ok bool) {
+	match := usbDeviceIDPattern.FindStringSubmatch(pnpDeviceID)
+	if match == nil || match[3] != "" {
+		return "", "", false
+	}
+	return strings.ToLower(match[1]), strings.ToLower(match[2]), true
+}
+
+// readLinuxUSBDevices lists USB devices from sysfs (root hubs "usbN" and interfaces "1-2:1.0" are skipped)
+func readLinuxUSBDevices(root string) ([]USBDevice, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := []USBDevice{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, "usb") || strings.Contains(name, ":") {
+			continue
+		}
+		dir := filepath.Join(root, name)
+		vendorID := strings.TrimSpace(readSysfsString(dir, "idVendor"))
+		if vendorID == "" {
+			continue
+		}
+		devices = append(devices, USBDevice{
+			ID:           name,
+			Name:         strings.TrimSpace(readSysfsString(dir, "product")),
+			Manufacturer: strings.TrimSpace(readSysfsString(dir, "manufacturer")),
+			VendorID:     strings.ToLower(vendorID),
+			ProductID:    strings.ToLower(strings.TrimSpace(readSysfsString(dir, "idProduct"))),
+		})
+	}
+	sortUSBDevices(devices)
+	return devices, nil
+}
+
+func sortUSBDevices(devices []USBDevice) {
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].VendorID != devices[j].VendorID {
+			return devices[i].VendorID < devices[j].VendorID
+		}
+		if devices[i].ProductID != devices[j].ProductID {
+			return devices[i].ProductID < devices[j].ProductID
+		}
+		return devices[i].ID < devices[j].ID
+	})
+}
+
+// getPrintersWindows lists printer queues from Win32_Printer
+func getPrintersWindows() ([]Printer, error) {
+	rows, err := queryWMI("", "Win32_Printer", "", "Name", "DriverName", "PortName", "Default", "Network", "PrinterStatus", "WorkOffline")
+	if err != nil {
+		return nil, err
+	}
+
+	printers := []Printer{}
+	for _, row := range rows {
+		printer := Printer{
+			Name:    row["Name"],
+			Driver:  row["DriverName"],
+			Port:    row["PortName"],
+			Default: strings.EqualFold(row["Default"], "TRUE"),
+			Network: strings.EqualFold(row["Network"], "TRUE"),
+			Status:  windowsPrinterStatus(row["PrinterStatus"]),
+		}
+		if strings.EqualFold(row["WorkOffline"], "TRUE") {
+			printer.Status = "offline"
+		}
+		printers = append(printers, printer)
+	}
+	sort.Slice(printers, func(i, j int) bool { return printers[i].Name < printers[j].Name })
+	return printers, nil
+}
+
+// windowsPrinterStatus maps Win32_Printer.PrinterStatus to a short state name
+func windowsPrinterStatus(status string) string {
+	switch status {
+	case "3":
+		return "idle"
+	case "4":
+		return "printing"
+	case "5":
+		return "warming_up"
+	case "6":
+		return "stopped"
+	case "7":
+		return "offline"
+	default:
+		return "unknown"
+	}
+}
+
+// getPrintersCUPS lists printer queues through lpstat (CUPS)
+func getPrintersCUPS() ([]Printer, error) {
+	output, err := createHiddenCommandWithTimeout("lpstat", 5, "-p", "-d", "-v").Output()
+	// 프린터가 없으면 lpstat이 오류 코드로 종료하지만 출력은 정상
+	if err != nil && len(output) == 0 {
+		return nil, fmt.Errorf("lpstat failed: %v", err)
+	}
+	return parseLpstatOutput(string(output)), nil
+}
+
+// parseLpstatOutput parses "lpstat -p -d -v" output (English messages)
+func parseLpstatOutput(output string) []Printer {
+	printers := map[string]*Printer{}
+	get := func(name string) *Printer {
+		if printer, ok := printers[name]; ok {
+			return printer
+		}
+		printer := &Printer{Name: name}
+		printers[name] = printer
+		return printer
+	}
+
+	defaultName := ""
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "printer "):
+			// printer HP_LaserJet is idle.  enabled since ...
+			fields := strings.Fields(line)
+			if len(fields) < 4 {
+				continue
+			}
+			printer := get(fields[1])
+			switch {
+			case strings.Contains(line, "disabled"):
+				printer.Status = "offline"
+			case strings.Contains(line, "now printing"):
+				printer.Status = "printing"
+			case strings.Contains(line, "is idle"):
+				printer.Status = "idle"
+			}
+		case strings.HasPrefix(line, "device for "):
+			// device for HP_LaserJet: usb://HP/LaserJet?serial=...
+			name, uri, ok := strings.Cut(strings.TrimPrefix(line, "device for "), ": ")
+			if !ok {
+				continue
+			}
+			printer := get(name)
+			printer.Port = uri
+			scheme, _, _ := strings.Cut(uri, ":")
+			printer.Network = networkPrinterSchemes[strings.ToLower(scheme)]
+		case strings.HasPrefix(line, "system default destination: "):
+			defaultName = strings.TrimPrefix(line, "system default destination: ")
+		}
+	}
+
+	result := make([]Printer, 0, len(printers))
+	for _, printer := range printers {
+		printer.Default = printer.Name == defaultName
+		result = append(result, *printer)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -0,0 +1,69 @@
+package monitoring
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeviceInventory(t *testing.T) {
+	t.Run("Parse_USB_Device_ID", func(t *testing.T) {
+		vendorID, productID, ok := parseUSBDeviceID(`USB\VID_046D&PID_C52B\5&2A3C1F0&0&2`)
+		if !ok || vendorID != "046d" || productID != "c52b" {
+			t.Errorf("Unexpected IDs: %s %s %v", vendorID, productID, ok)
+		}
+
+		// 복합 장치 인터페이스와 루트 허브는 제외
+		for _, id := range []string{`USB\VID_046D&PID_C52B&MI_00\6&1234&0&0000`, `USB\ROOT_HUB30\4&1A2B3C&0&0`, `HID\VID_046D&PID_C52B\7&2F3A`} {
+			if _, _, ok := parseUSBDeviceID(id); ok {
+				t.Errorf("Expected %s to be skipped", id)
+			}
+		}
+	})
+
+	t.Run("Read_Linux_USB_Devices", func(t *testing.T) {
+		root := t.TempDir()
+		write := func(device, name, value string) {
+			dir := filepath.Join(root, device)
+			os.MkdirAll(dir, 0755)
+			os.WriteFile(filepath.Join(dir, name), []byte(value+"\n"), 0644)
+		}
+		write("usb1", "idVendor", "1d6b")
+		write("1-2", "idVendor", "046D")
+		write("1-2", "idProduct", "C52B")
+		write("1-2", "product", "USB Receiver")
+		write("1-2", "manufacturer", "Logitech")
+		write("1-2:1.0", "bInterfaceClass", "03")
+
+		devices, err := readLinuxUSBDevices(root)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(devices) != 1 {
+			t.Fatalf("Expected only 1-2, got %+v", devices)
+		}
+		if device := devices[0]; device.ID != "1-2" || device.VendorID != "046d" || device.ProductID != "c52b" || device.Name != "USB Receiver" || device.Manufacturer != "Logitech" {
+			t.Errorf("Unexpected device: %+v", device)
+		}
+	})
+
+	t.Run("Parse_Lpstat", func(t *testing.T) {
+		output := `printer HP_LaserJet is idle.  enabled since Mon 12 Oct 2026 09:00:00 AM KST
+printer Office_Color disabled since Mon 12 Oct 2026 09:00:00 AM KST -
+	Paused
+system default destination: HP_LaserJet
+device for HP_LaserJet: usb://HP/LaserJet%20P1102?serial=000000000Q8
+device for Office_Color: ipp://192.168.0.20/ipp/print
+`
+		printers := parseLpstatOutput(output)
+		if len(printers) != 2 {
+			t.Fatalf("Expected 2 printers, got %+v", printers)
+		}
+		if hp := printers[0]; hp.Name != "HP_LaserJet" || !hp.Default || hp.Network || hp.Status != "idle" {
+			t.Errorf("Unexpected USB printer: %+v", hp)
+		}
+		if office := printers[1]; office.Name != "Office_Color" || office.Default || !office.Network || office.Status != "offline" {
+			t.Errorf("Unexpected network printer: %+v", office)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+// 경로/볼륨별 디스크 공간 감시
+// 설정된 경로가 없으면 모든 물리 파티션의 마운트 지점을 보고하고,
+// 경로별 최소 여유 공간 기준 아래로 내려가면 한 번만 경고 (기준 위로 회복되면 다시 경고 가능)
+
+// DiskPathUsage represents space usage of a watched path or mount point
+type DiskPathUsage struct {
+	Path        string  `json:"path"`
+	Fstype      string  `json:"fstype,omitempty"`
+	Total       float64 `json:"total"` // bytes
+	Used        float64 `json:"used"`
+	Free        float64 `json:"free"`
+	UsedPercent float64 `json:"used_percent"`
+}
+
+// DiskSpaceThreshold is the minimum free space for a path (0 = not checked)
+type DiskSpaceThreshold struct {
+	Path           string
+	MinFreePercent float64
+	MinFreeGB      float64
+}
+
+// DiskSpaceAlert describes a path whose free space crossed its threshold
+type DiskSpaceAlert struct {
+	Path           string  `json:"path"`
+	Low            bool    `json:"low"` // true = 기준 아래로 떨어짐, false = 회복
+	FreeGB         float64 `json:"free_gb"`
+	FreePercent    float64 `json:"free_percent"`
+	MinFreePercent float64 `json:"min_free_percent"`
+	MinFreeGB      float64 `json:"min_free_gb"`
+}
+
+// DiskPathCache caches path usage readings for the last requested path list
+type DiskPathCache struct {
+	mutex     sync.Mutex
+	key       string
+	usages    []DiskPathUsage
+	err       error
+	timestamp time.Time
+}
+
+const DISK_PATH_CACHE_DURATION = 10 * time.Second
+
+var (
+	diskPathCache             = &DiskPathCache{}
+	diskPathMetricNamePattern = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// GetDiskPathUsage returns usage for each path, or for every physical mount point when paths is empty
+func GetDiskPathUsage(ctx context.Context, paths []string) ([]DiskPathUsage, error) {
+	return queryWithContext(ctx, "GetDiskPathUsage", func() ([]DiskPathUsage, error) { return getDiskPathUsage(paths) })
+}
+
+func getDiskPathUsage(paths []string) ([]DiskPathUsage, error) {
+	diskPathCache.mutex.Lock()
+	defer diskPathCache.mutex.Unlock()
+
+	key := strings.Join(paths, "\x00")
+	if key == diskPathCache.key && time.Since(diskPathCache.timestamp) < DISK_PATH_CACHE_DURATION {
+		return diskPathCache.usages, diskPathCache.err
+	}
+
+	fstypes := map[string]string{}
+	if len(paths) == 0 {
+		partitions, err := disk.Partitions(false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list partitions: %v", err)
+		}
+		seen := map[string]bool{}
+		for _, partition := range partitions {
+			if seen[partition.Mountpoint] {
+				continue
+			}
+			seen[partition.Mountpoint] = true
+			paths = append(paths, partition.Mountpoint)
+			fstypes[partition.Mountpoint] = partition.Fstype
+		}
+	}
+
+	var usages []DiskPathUsage
+	var failed []string
+	for _, path := range paths {
+		usage, err := disk.Usage(path)
+		if err != nil || usage.Total == 0 {
+			failed = append(failed, path)
+			continue
+		}
+		fstype := usage.Fstype
+		if fstype == "" {
+			fstype = fstypes[path]
+		}
+		usages = append(usages, DiskPathUsage{
+			Path:        path,
+			Fstype:      fstype,
+			Total:       float64(usage.Total),
+			Used:        float64(usage.Used),
+			Free:        float64(usage.Free),
+			UsedPercent: usage.UsedPercent,
+		})
+	}
+
+	var err error
+	if len(failed) > 0 {
+		err = fmt.Errorf("failed to read disk usage for: %s", strings.Join(failed, ", "))
+	}
+
+	diskPathCache.key = key
+	diskPathCache.usages = usages
+	diskPathCache.err = err
+	diskPathCache.timestamp = time.Now()
+	return usages, err
+}
+
+// DiskPathMetricName returns the metric type for a path's used percentage (disk_usage_percent_<path>)
+func DiskPathMetricName(path string) string {
+	name := strings.Trim(diskPathMetricNamePattern.ReplaceAllString(strings.ToLower(path), "_"), "_")
+	if name == "" {
+		name = "root"
+	}
+	return "disk_usage_percent_" + name
+}
+
+// DiskSpaceMonitor tracks which paths are below their free space threshold
+type DiskSpaceMonitor struct {
+	mutex sync.Mutex
+	low   map[string]bool
+}
+
+// NewDiskSpaceMonitor creates a monitor with no paths in the low state
+func NewDiskSpaceMonitor() *DiskSpaceMonitor {
+	return &DiskSpaceMonitor{low: make(map[string]bool)}
+}
+
+// Check compares usages against thresholds and returns alerts for paths whose state changed
+func (m *DiskSpaceMonitor) Check(usages []DiskPathUsage, thresholds []DiskSpaceThreshold) []DiskSpaceAlert {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	byPath := make(map[string]DiskPathUsage, len(usages))
+	for _, usage := range usages {
+		byPath[usage.Path] = usage
+	}
+
+	var alerts []DiskSpaceAlert
+	for _, threshold := range thresholds {
+		usage, ok := byPath[threshold.Path]
+		if !ok || usage.Total <= 0 || (threshold.MinFreePercent <= 0 && threshold.MinFreeGB <= 0) {
+			continue
+		}
+
+		freeGB := usage.Free / 1024 / 1024 / 1024
+		freePercent := usage.Free / usage.Total * 100
+		low := (threshold.MinFreePercent > 0 && freePercent < threshold.MinFreePercent) ||
+			(threshold.MinFreeGB > 0 && freeGB < threshold.MinFreeGB)
+		if low == m.low[threshold.Path] {
+			continue
+		}
+
+		m.low[threshold.Path] = low
+		alerts = append(alerts, DiskSpaceAlert{
+			Path:           threshold.Path,
+			Low:            low,
+			FreeGB:         freeGB,
+			FreePercent:    freePercent,
+			MinFreePercent: threshold.MinFreePercent,
+			MinFreeGB:      threshold.MinFreeGB,
+		})
+	}
+	return alerts
+}
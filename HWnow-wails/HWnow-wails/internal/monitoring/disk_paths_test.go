@@ -0,0 +1,44 @@
+package monitoring
+
+import "testing"
+
+func TestDiskPaths(t *testing.T) {
+
+	t.Run("Metric_Name", func(t *testing.T) {
+		cases := map[string]string{
+			`C:\`:       "disk_usage_percent_c",
+			"/":         "disk_usage_percent_root",
+			"/mnt/Data": "disk_usage_percent_mnt_data",
+		}
+		for path, expected := range cases {
+			if name := DiskPathMetricName(path); name != expected {
+				t.Errorf("DiskPathMetricName(%q) = %q; expected %q", path, name, expected)
+			}
+		}
+	})
+
+	t.Run("Threshold_Transitions", func(t *testing.T) {
+		const gb = 1024 * 1024 * 1024
+		monitor := NewDiskSpaceMonitor()
+		thresholds := []DiskSpaceThreshold{{Path: "/data", MinFreePercent: 10}, {Path: "/", MinFreeGB: 5}}
+
+		usages := []DiskPathUsage{
+			{Path: "/data", Total: 100 * gb, Free: 8 * gb},
+			{Path: "/", Total: 100 * gb, Free: 50 * gb},
+		}
+		alerts := monitor.Check(usages, thresholds)
+		if len(alerts) != 1 || alerts[0].Path != "/data" || !alerts[0].Low {
+			t.Fatalf("Expected a single low alert for /data, got %+v", alerts)
+		}
+
+		if alerts := monitor.Check(usages, thresholds); len(alerts) != 0 {
+			t.Errorf("Expected no repeated alerts while still low, got %+v", alerts)
+		}
+
+		usages[0].Free = 20 * gb
+		alerts = monitor.Check(usages, thresholds)
+		if len(alerts) != 1 || alerts[0].Low {
+			t.Errorf("Expected a recovery alert for /data, got %+v", alerts)
+		}
+	})
+}
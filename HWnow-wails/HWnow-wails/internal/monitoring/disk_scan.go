@@ -0,0 +1,243 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// 요청 시 실행하는 디렉터리 크기 분석 ("무엇이 디스크를 차지했는가")
+// 지정한 경로 아래를 순회하며 가장 큰 하위 디렉터리/파일을 집계
+// 안전장치: 최대 깊이 이하로만 내려가고, 시간 제한에 도달하면 부분 결과를 반환 (심볼릭 링크는 따라가지 않음)
+
+const (
+	DISK_SCAN_DEFAULT_MAX_DEPTH  = 12
+	DISK_SCAN_DEFAULT_TIME_LIMIT = 60 * time.Second
+	DISK_SCAN_MAX_TIME_LIMIT     = 10 * time.Minute
+	DISK_SCAN_DEFAULT_TOP_COUNT  = 20
+	DISK_SCAN_PROGRESS_EVERY     = 250 * time.Millisecond
+)
+
+// DiskScanOptions configures a directory size scan
+type DiskScanOptions struct {
+	Path             string `json:"path"`
+	MaxDepth         int    `json:"max_depth"`          // 0 = 기본값
+	TimeLimitSeconds int    `json:"time_limit_seconds"` // 0 = 기본값
+	TopCount         int    `json:"top_count"`          // 0 = 기본값
+}
+
+// DiskScanEntry is a file or directory with its total size
+type DiskScanEntry struct {
+	Path  string `json:"path"`
+	Size  int64  `json:"size"` // bytes (디렉터리는 하위 전체 합계)
+	IsDir bool   `json:"is_dir"`
+}
+
+// DiskScanProgress reports scan progress
+type DiskScanProgress struct {
+	Path           string  `json:"path"`
+	CurrentPath    string  `json:"current_path"`
+	FilesScanned   int64   `json:"files_scanned"`
+	BytesScanned   int64   `json:"bytes_scanned"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+}
+
+// DiskScanResult holds the largest entries found under the scanned path
+type DiskScanResult struct {
+	Path         string          `json:"path"`
+	TotalBytes   int64           `json:"total_bytes"`
+	FileCount    int64           `json:"file_count"`
+	DirCount     int64           `json:"dir_count"`
+	Children     []DiskScanEntry `json:"children"`      // 바로 아래 항목 (크기순)
+	LargestDirs  []DiskScanEntry `json:"largest_dirs"`  // 전체 하위 디렉터리 중 가장 큰 항목
+	LargestFiles []DiskScanEntry `json:"largest_files"` // 전체 파일 중 가장 큰 항목
+	Truncated    bool            `json:"truncated"`     // 깊이/시간 제한으로 일부만 집계됨
+	SkippedDirs  int64           `json:"skipped_dirs"`  // 권한 등으로 읽지 못한 디렉터리 수
+	Duration     float64         `json:"duration_seconds"`
+}
+
+// diskScanner holds state for a single scan
+type diskScanner struct {
+	ctx        context.Context
+	options    DiskScanOptions
+	progress   func(DiskScanProgress)
+	start      time.Time
+	lastReport time.Time
+	bytes      int64
+	result     *DiskScanResult
+	dirs       []DiskScanEntry
+	files      []DiskScanEntry
+}
+
+// NormalizeDiskScanOptions validates the path and applies defaults and limits
+func NormalizeDiskScanOptions(options DiskScanOptions) (DiskScanOptions, error) {
+	if options.Path == "" {
+		return options, fmt.Errorf("path is required")
+	}
+	absPath, err := filepath.Abs(options.Path)
+	if err != nil {
+		return options, err
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return options, err
+	}
+	if !info.IsDir() {
+		return options, fmt.Errorf("%s is not a directory", absPath)
+	}
+	options.Path = absPath
+
+	if options.MaxDepth <= 0 {
+		options.MaxDepth = DISK_SCAN_DEFAULT_MAX_DEPTH
+	}
+	timeLimit := time.Duration(options.TimeLimitSeconds) * time.Second
+	if timeLimit <= 0 {
+		timeLimit = DISK_SCAN_DEFAULT_TIME_LIMIT
+	}
+	if timeLimit > DISK_SCAN_MAX_TIME_LIMIT {
+		timeLimit = DISK_SCAN_MAX_TIME_LIMIT
+	}
+	options.TimeLimitSeconds = int(timeLimit / time.Second)
+	if options.TopCount <= 0 {
+		options.TopCount = DISK_SCAN_DEFAULT_TOP_COUNT
+	}
+	return options, nil
+}
+
+// ScanDirectory computes directory sizes under options.Path (options must be normalized)
+func ScanDirectory(ctx context.Context, options DiskScanOptions, progress func(DiskScanProgress)) (*DiskScanResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(options.TimeLimitSeconds)*time.Second)
+	defer cancel()
+
+	scanner := &diskScanner{
+		ctx:      ctx,
+		options:  options,
+		progress: progress,
+		start:    time.Now(),
+		result:   &DiskScanResult{Path: options.Path},
+	}
+	scanner.lastReport = scanner.start
+
+	entries, err := os.ReadDir(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		child := scanner.scanEntry(filepath.Join(options.Path, entry.Name()), entry, 1)
+		if child != nil {
+			scanner.result.TotalBytes += child.Size
+			scanner.result.Children = append(scanner.result.Children, *child)
+		}
+	}
+
+	// 시간 제한으로 중단된 경우는 부분 결과로 반환 (호출자가 취소한 경우만 오류)
+	if ctx.Err() == context.DeadlineExceeded {
+		scanner.result.Truncated = true
+	} else if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	sortDiskScanEntries(scanner.result.Children)
+	scanner.result.LargestDirs = topDiskScanEntries(scanner.dirs, options.TopCount)
+	scanner.result.LargestFiles = topDiskScanEntries(scanner.files, options.TopCount)
+	scanner.result.Duration = time.Since(scanner.start).Seconds()
+	scanner.report(options.Path)
+	return scanner.result, nil
+}
+
+// scanEntry returns the size of a file or directory tree (nil for skipped entries)
+func (s *diskScanner) scanEntry(path string, entry os.DirEntry, depth int) *DiskScanEntry {
+	if s.ctx.Err() != nil {
+		return nil
+	}
+	if entry.Type()&os.ModeSymlink != 0 {
+		return nil
+	}
+
+	if !entry.IsDir() {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		file := DiskScanEntry{Path: path, Size: info.Size()}
+		s.result.FileCount++
+		s.bytes += file.Size
+		s.files = appendTopDiskScanEntry(s.files, file, s.options.TopCount)
+		s.maybeReport(path)
+		return &file
+	}
+
+	dir := DiskScanEntry{Path: path, IsDir: true}
+	s.result.DirCount++
+	if depth >= s.options.MaxDepth {
+		s.result.Truncated = true
+		return &dir
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		s.result.SkippedDirs++
+		return &dir
+	}
+	for _, child := range entries {
+		if childEntry := s.scanEntry(filepath.Join(path, child.Name()), child, depth+1); childEntry != nil {
+			dir.Size += childEntry.Size
+		}
+	}
+	s.dirs = appendTopDiskScanEntry(s.dirs, dir, s.options.TopCount)
+	return &dir
+}
+
+// maybeReport emits progress at most every DISK_SCAN_PROGRESS_EVERY
+func (s *diskScanner) maybeReport(currentPath string) {
+	if now := time.Now(); now.Sub(s.lastReport) >= DISK_SCAN_PROGRESS_EVERY {
+		s.lastReport = now
+		s.report(currentPath)
+	}
+}
+
+// report emits the current progress
+func (s *diskScanner) report(currentPath string) {
+	if s.progress == nil {
+		return
+	}
+	s.progress(DiskScanProgress{
+		Path:           s.options.Path,
+		CurrentPath:    currentPath,
+		FilesScanned:   s.result.FileCount,
+		BytesScanned:   s.bytes,
+		ElapsedSeconds: time.Since(s.start).Seconds(),
+	})
+}
+
+// appendTopDiskScanEntry appends and prunes to the largest entries once the slice grows past 4x the limit
+func appendTopDiskScanEntry(entries []DiskScanEntry, entry DiskScanEntry, limit int) []DiskScanEntry {
+	entries = append(entries, entry)
+	if len(entries) > limit*4 {
+		entries = topDiskScanEntries(entries, limit)
+	}
+	return entries
+}
+
+// topDiskScanEntries returns the largest entries, largest first
+func topDiskScanEntries(entries []DiskScanEntry, limit int) []DiskScanEntry {
+	sortDiskScanEntries(entries)
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return append([]DiskScanEntry(nil), entries...)
+}
+
+// sortDiskScanEntries sorts by size descending, then by path
+func sortDiskScanEntries(entries []DiskScanEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Size != entries[j].Size {
+			return entries[i].Size > entries[j].Size
+		}
+		return entries[i].Path < entries[j].Path
+	})
+}
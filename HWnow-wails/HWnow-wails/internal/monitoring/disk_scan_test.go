@@ -0,0 +1,66 @@
+package monitoring
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiskScan(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(rel string, size int) {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("small.txt", 10)
+	writeFile("videos/a.mp4", 5000)
+	writeFile("videos/old/b.mp4", 3000)
+	writeFile("docs/report.pdf", 200)
+
+	t.Run("Normalize_Options", func(t *testing.T) {
+		if _, err := NormalizeDiskScanOptions(DiskScanOptions{Path: filepath.Join(root, "small.txt")}); err == nil {
+			t.Error("Expected an error for a file path")
+		}
+		options, err := NormalizeDiskScanOptions(DiskScanOptions{Path: root, TimeLimitSeconds: 3600})
+		if err != nil {
+			t.Fatalf("Normalize failed: %v", err)
+		}
+		if options.MaxDepth != DISK_SCAN_DEFAULT_MAX_DEPTH || options.TimeLimitSeconds != int(DISK_SCAN_MAX_TIME_LIMIT.Seconds()) {
+			t.Errorf("Unexpected normalized options: %+v", options)
+		}
+	})
+
+	t.Run("Largest_Entries", func(t *testing.T) {
+		options, _ := NormalizeDiskScanOptions(DiskScanOptions{Path: root})
+		result, err := ScanDirectory(context.Background(), options, nil)
+		if err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if result.TotalBytes != 8210 || result.FileCount != 4 || result.Truncated {
+			t.Errorf("Unexpected totals: %+v", result)
+		}
+		if len(result.Children) == 0 || filepath.Base(result.Children[0].Path) != "videos" || result.Children[0].Size != 8000 {
+			t.Errorf("Expected videos to be the largest child, got %+v", result.Children)
+		}
+		if len(result.LargestFiles) == 0 || filepath.Base(result.LargestFiles[0].Path) != "a.mp4" {
+			t.Errorf("Expected a.mp4 to be the largest file, got %+v", result.LargestFiles)
+		}
+	})
+
+	t.Run("Depth_Limit", func(t *testing.T) {
+		options, _ := NormalizeDiskScanOptions(DiskScanOptions{Path: root, MaxDepth: 1})
+		result, err := ScanDirectory(context.Background(), options, nil)
+		if err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if !result.Truncated || result.TotalBytes != 10 {
+			t.Errorf("Expected only top-level files to be counted, got %+v", result)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 드라이브별 온도 수집 (S.M.A.R.T / NVMe health log)
+// 온도 변화는 느리고 조회 비용이 크므로 60초 간격으로만 갱신
+
+// DiskTemperature represents the temperature of a physical drive
+type DiskTemperature struct {
+	Device      string  `json:"device"`      // 장치 이름 (sda, nvme0n1, disk0 ...)
+	Model       string  `json:"model"`       // 드라이브 모델명
+	Temperature float64 `json:"temperature"` // 온도 (°C)
+	Source      string  `json:"source"`      // hwmon, smartctl, storage_reliability
+}
+
+// DiskTemperatureCache caches drive temperature readings
+type DiskTemperatureCache struct {
+	mutex        sync.Mutex
+	temperatures []DiskTemperature
+	timestamp    time.Time
+}
+
+const DISK_TEMPERATURE_CACHE_DURATION = 60 * time.Second
+
+var diskTemperatureCache = &DiskTemperatureCache{}
+
+var diskTempMetricNamePattern = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// GetDiskTemperatures returns per-drive temperatures, refreshed at most every DISK_TEMPERATURE_CACHE_DURATION
+func GetDiskTemperatures(ctx context.Context) ([]DiskTemperature, error) {
+	return queryWithContext(ctx, "GetDiskTemperatures", getDiskTemperatures)
+}
+
+func getDiskTemperatures() ([]DiskTemperature, error) {
+	diskTemperatureCache.mutex.Lock()
+	defer diskTemperatureCache.mutex.Unlock()
+
+	if time.Since(diskTemperatureCache.timestamp) < DISK_TEMPERATURE_CACHE_DURATION && diskTemperatureCache.temperatures != nil {
+		result := make([]DiskTemperature, len(diskTemperatureCache.temperatures))
+		copy(result, diskTemperatureCache.temperatures)
+		return result, nil
+	}
+
+	var temperatures []DiskTemperature
+	var err error
+	switch runtime.GOOS {
+	case "linux":
+		temperatures = getDiskTemperaturesLinux()
+	case "windows":
+		temperatures, err = getDiskTemperaturesWindows()
+		if err != nil {
+			LogDebug("Storage reliability counter query failed", "error", err)
+		}
+	}
+
+	// 기본 소스에서 얻지 못하면 smartctl(smartmontools)로 대체
+	if len(temperatures) == 0 {
+		temperatures = getDiskTemperaturesSmartctl()
+	}
+
+	// 실패한 경우에도 타임스탬프를 갱신하여 매 조회마다 외부 명령이 실행되지 않도록 함
+	diskTemperatureCache.temperatures = temperatures
+	if diskTemperatureCache.temperatures == nil {
+		diskTemperatureCache.temperatures = []DiskTemperature{}
+	}
+	diskTemperatureCache.timestamp = time.Now()
+
+	if len(temperatures) == 0 {
+		return []DiskTemperature{}, fmt.Errorf("no drive temperature source available on platform: %s", runtime.GOOS)
+	}
+
+	result := make([]DiskTemperature, len(temperatures))
+	copy(result, temperatures)
+	return result, nil
+}
+
+// DiskTempMetricName returns the metric type for a drive temperature (disk_temp_<device>)
+func DiskTempMetricName(device string) string {
+	name := strings.ToLower(filepath.Base(device))
+	name = strings.Trim(diskTempMetricNamePattern.ReplaceAllString(name, "_"), "_")
+	return "disk_temp_" + name
+}
+
+// getDiskTemperaturesLinux reads drive temperatures from hwmon (nvme driver, drivetemp module)
+func getDiskTemperaturesLinux() []DiskTemperature {
+	var temperatures []DiskTemperature
+
+	blocks, _ := filepath.Glob("/sys/block/*")
+	for _, block := range blocks {
+		device := filepath.Base(block)
+		if strings.HasPrefix(device, "loop") || strings.HasPrefix(device, "ram") || strings.HasPrefix(device, "dm-") {
+			continue
+		}
+
+		// NVMe: /sys/block/nvme0n1/device/hwmonN, SATA(drivetemp): /sys/block/sda/device/hwmon/hwmonN
+		inputs, _ := filepath.Glob(filepath.Join(block, "device", "hwmon*", "temp1_input"))
+		nested, _ := filepath.Glob(filepath.Join(block, "device", "hwmon", "hwmon*", "temp1_input"))
+		inputs = append(inputs, nested...)
+		if len(inputs) == 0 {
+			continue
+		}
+
+		milliCelsius, ok := readSysfsFloat(filepath.Dir(inputs[0]), "temp1_input")
+		if !ok {
+			continue
+		}
+
+		temperatures = append(temperatures, DiskTemperature{
+			Device:      device,
+			Model:       readSysfsString(filepath.Join(block, "device"), "model"),
+			Temperature: milliCelsius / 1000,
+			Source:      "hwmon",
+		})
+	}
+
+	return temperatures
+}
+
+// getDiskTemperaturesWindows reads drive temperatures from MSFT_StorageReliabilityCounter
+func getDiskTemperaturesWindows() ([]DiskTemperature, error) {
+	psScript := `Get-PhysicalDisk | ForEach-Object { $r = $_ | Get-StorageReliabilityCounter; "{0}|{1}|{2}" -f $_.DeviceId, $_.FriendlyName, $r.Temperature }`
+	cmd := createHiddenCommandWithTimeout("powershell", 15, "-NoProfile", "-Command", psScript)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseStorageReliabilityOutput(output), nil
+}
+
+// parseStorageReliabilityOutput parses "DeviceId|FriendlyName|Temperature" lines
+func parseStorageReliabilityOutput(output []byte) []DiskTemperature {
+	var temperatures []DiskTemperature
+	for _, line := range strings.Split(string(output), "\n") {
+		parts := strings.Split(strings.TrimSpace(line), "|")
+		if len(parts) != 3 {
+			continue
+		}
+		temperature, err := strconv.ParseFloat(strings.TrimSpace(parts[2]), 64)
+		if err != nil || temperature <= 0 {
+			continue // 온도를 보고하지 않는 드라이브 (USB, 가상 디스크 등)
+		}
+		temperatures = append(temperatures, DiskTemperature{
+			Device:      "disk" + strings.TrimSpace(parts[0]),
+			Model:       strings.TrimSpace(parts[1]),
+			Temperature: temperature,
+			Source:      "storage_reliability",
+		})
+	}
+	return temperatures
+}
+
+// smartctlScanOutput represents `smartctl --scan -j` output
+type smartctlScanOutput struct {
+	Devices []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"devices"`
+}
+
+// smartctlDeviceOutput represents the relevant parts of `smartctl -A -i -j` output
+type smartctlDeviceOutput struct {
+	ModelName   string `json:"model_name"`
+	Temperature struct {
+		Current float64 `json:"current"`
+	} `json:"temperature"`
+}
+
+// getDiskTemperaturesSmartctl reads drive temperatures via smartctl JSON output (S.M.A.R.T / NVMe health log)
+func getDiskTemperaturesSmartctl() []DiskTemperature {
+	scanCmd := createHiddenCommandWithTimeout("smartctl", 5, "--scan", "-j")
+	scanOutput, err := scanCmd.Output()
+	if err != nil {
+		LogDebug("smartctl scan unavailable", "error", err)
+		return nil
+	}
+
+	var scan smartctlScanOutput
+	if err := json.Unmarshal(scanOutput, &scan); err != nil {
+		return nil
+	}
+
+	var temperatures []DiskTemperature
+	for _, device := range scan.Devices {
+		cmd := createHiddenCommandWithTimeout("smartctl", 5, "-A", "-i", "-j", "-d", device.Type, device.Name)
+		// smartctl은 경고가 있을 때 0이 아닌 종료 코드를 반환하므로 출력만 확인
+		output, _ := cmd.Output()
+		temperature, model, err := parseSmartctlTemperature(output)
+		if err != nil {
+			continue
+		}
+		temperatures = append(temperatures, DiskTemperature{
+			Device:      filepath.Base(device.Name),
+			Model:       model,
+			Temperature: temperature,
+			Source:      "smartctl",
+		})
+	}
+	return temperatures
+}
+
+// parseSmartctlTemperature extracts the current temperature and model from smartctl JSON output
+func parseSmartctlTemperature(output []byte) (float64, string, error) {
+	var device smartctlDeviceOutput
+	if err := json.Unmarshal(output, &device); err != nil {
+		return 0, "", fmt.Errorf("failed to parse smartctl output: %v", err)
+	}
+	if device.Temperature.Current <= 0 {
+		return 0, device.ModelName, fmt.Errorf("drive does not report temperature")
+	}
+	return device.Temperature.Current, device.ModelName, nil
+}
@@ -0,0 +1,46 @@
+package monitoring
+
+import "testing"
+
+func TestDiskTemperatureHelpers(t *testing.T) {
+
+	t.Run("Metric_Name", func(t *testing.T) {
+		cases := map[string]string{
+			"nvme0n1":         "disk_temp_nvme0n1",
+			"/dev/sda":        "disk_temp_sda",
+			"disk0":           "disk_temp_disk0",
+			"PhysicalDrive 1": "disk_temp_physicaldrive_1",
+		}
+		for device, expected := range cases {
+			if name := DiskTempMetricName(device); name != expected {
+				t.Errorf("DiskTempMetricName(%q) = %q, expected %q", device, name, expected)
+			}
+		}
+	})
+
+	t.Run("Parse_Smartctl_JSON", func(t *testing.T) {
+		output := []byte(`{"model_name":"Samsung SSD 980 PRO 1TB","temperature":{"current":41}}`)
+		temperature, model, err := parseSmartctlTemperature(output)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if temperature != 41 || model != "Samsung SSD 980 PRO 1TB" {
+			t.Errorf("Unexpected result: %f, %q", temperature, model)
+		}
+
+		if _, _, err := parseSmartctlTemperature([]byte(`{"model_name":"USB Flash"}`)); err == nil {
+			t.Error("Expected error for drive without temperature")
+		}
+	})
+
+	t.Run("Parse_Storage_Reliability_Output", func(t *testing.T) {
+		output := []byte("0|Samsung SSD 970 EVO|38\r\n1|WDC WD20EZRZ|\r\n2|Virtual Disk|0\r\n")
+		temperatures := parseStorageReliabilityOutput(output)
+		if len(temperatures) != 1 {
+			t.Fatalf("Expected 1 drive with temperature, got %d", len(temperatures))
+		}
+		if temperatures[0].Device != "disk0" || temperatures[0].Temperature != 38 {
+			t.Errorf("Unexpected drive temperature: %+v", temperatures[0])
+		}
+	})
+}
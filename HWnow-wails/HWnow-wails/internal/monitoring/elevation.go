@@ -0,0 +1,137 @@
+package monitoring
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"HWnow-wails/internal/winapi"
+)
+
+// 권한 상승 요청 (프로세스 제어가 권한 부족으로 실패했을 때 프론트엔드가 안내)
+// - Windows: 같은 실행 파일과 인자로 ShellExecute "runas"를 호출하여 UAC 창을 띄움 (승인되면 현재 인스턴스는 종료해야 함)
+// - Linux/macOS: GUI에서 sudo 암호를 받을 수 없으므로 사용자가 실행할 sudo 명령만 반환
+
+// Elevation methods
+const (
+	ElevationMethodRunAs = "runas"
+	ElevationMethodSudo  = "sudo"
+)
+
+// ElevationResult reports the outcome of an elevation request
+type ElevationResult struct {
+	Platform        string  `json:"platform"`
+	Method          string  `json:"method"` // runas, sudo
+	AlreadyElevated bool    `json:"already_elevated"`
+	Relaunched      bool    `json:"relaunched"`        // 관리자 권한 인스턴스가 시작됨 (현재 인스턴스는 종료)
+	Command         string  `json:"command,omitempty"` // 사용자가 직접 실행할 명령 (Linux/macOS)
+	Message         Message `json:"message"`
+}
+
+// 셸에서 따옴표 없이 쓸 수 있는 인자
+var shellSafeArgPattern = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// RequestElevation relaunches the application elevated on Windows, or returns the sudo command to run on Linux/macOS;
+// messages are rendered in the given language
+func RequestElevation(language string) (*ElevationResult, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current executable path: %v", err)
+	}
+	args := os.Args[1:]
+
+	switch runtime.GOOS {
+	case "windows":
+		result := &ElevationResult{Platform: runtime.GOOS, Method: ElevationMethodRunAs}
+		if elevated, err := isProcessElevated(); err == nil && elevated {
+			result.AlreadyElevated = true
+			result.Message = NewMessage(language, "elevation.already_elevated")
+			return result, nil
+		}
+
+		err = winapi.ShellExecuteRunAs(exe, windowsCommandLine(args), filepath.Dir(exe))
+		if errors.Is(err, winapi.ErrElevationCancelled) {
+			result.Message = NewMessage(language, "elevation.cancelled")
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to request elevation: %v", err)
+		}
+		LogInfo("Elevation requested", "executable", exe)
+		result.Relaunched = true
+		result.Message = NewMessage(language, "elevation.relaunched")
+		return result, nil
+
+	case "linux", "darwin":
+		result := &ElevationResult{Platform: runtime.GOOS, Method: ElevationMethodSudo}
+		if os.Geteuid() == 0 {
+			result.AlreadyElevated = true
+			result.Message = NewMessage(language, "elevation.already_elevated")
+			return result, nil
+		}
+		result.Command = sudoCommandLine(exe, args)
+		result.Message = NewMessage(language, "elevation.sudo_command", result.Command)
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("elevation request not supported on %s", runtime.GOOS)
+	}
+}
+
+// sudoCommandLine builds a shell command that runs the executable with its arguments under sudo
+func sudoCommandLine(exe string, args []string) string {
+	parts := []string{"sudo", shellQuote(exe)}
+	for _, arg := range args {
+		parts = append(parts, shellQuote(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuote quotes an argument for POSIX shells
+func shellQuote(arg string) string {
+	if shellSafeArgPattern.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// windowsCommandLine joins arguments following the CommandLineToArgvW quoting rules
+func windowsCommandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = windowsQuoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// windowsQuoteArg quotes an argument when it is empty or contains spaces, tabs or quotes;
+// backslashes are doubled only before a quote (including the closing one)
+func windowsQuoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\"") {
+		return arg
+	}
+
+	var builder strings.Builder
+	builder.WriteByte('"')
+	backslashes := 0
+	for i := 0; i < len(arg); i++ {
+		switch arg[i] {
+		case '\\':
+			backslashes++
+			continue
+		case '"':
+			builder.WriteString(strings.Repeat(`\`, backslashes*2+1))
+		default:
+			builder.WriteString(strings.Repeat(`\`, backslashes))
+		}
+		builder.WriteByte(arg[i])
+		backslashes = 0
+	}
+	builder.WriteString(strings.Repeat(`\`, backslashes*2))
+	builder.WriteByte('"')
+	return builder.String()
+}
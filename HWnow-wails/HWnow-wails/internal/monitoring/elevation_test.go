@@ -0,0 +1,31 @@
+package monitoring
+
+import "testing"
+
+func TestSudoCommandLine(t *testing.T) {
+	got := sudoCommandLine("/opt/HWnow/HWnow-wails", []string{"--data-dir", "/home/user/My Data", "it's"})
+	expected := `sudo /opt/HWnow/HWnow-wails --data-dir '/home/user/My Data' 'it'\''s'`
+	if got != expected {
+		t.Errorf("sudoCommandLine = %s, expected %s", got, expected)
+	}
+}
+
+func TestWindowsCommandLine(t *testing.T) {
+	cases := map[string]string{
+		`--portable`:          `--portable`,
+		`C:\Program Files\x`:  `"C:\Program Files\x"`,
+		`C:\My Data\`:         `"C:\My Data\\"`,
+		`say "hi"`:            `"say \"hi\""`,
+		`a\"b`:                `"a\\\"b"`,
+		``:                    `""`,
+		`C:\path\without\spc`: `C:\path\without\spc`,
+	}
+	for arg, expected := range cases {
+		if got := windowsQuoteArg(arg); got != expected {
+			t.Errorf("windowsQuoteArg(%s) = %s, expected %s", arg, got, expected)
+		}
+	}
+	if got := windowsCommandLine([]string{"--data-dir", `D:\HW now`}); got != `--data-dir "D:\HW now"` {
+		t.Errorf("Unexpected command line: %s", got)
+	}
+}
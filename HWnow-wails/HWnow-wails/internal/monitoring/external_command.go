@@ -0,0 +1,155 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 외부 도구 실행 제어 (nvidia-smi, powershell, typeperf 등)
+// 모든 실행은 전역 동시 실행 수 제한을 거친 뒤 명령별 제한 시간 안에서만 동작
+// 제한 시간이 지나면 프로세스를 종료하고, 손자 프로세스가 출력 파이프를 잡고 있어도 WaitDelay 후 Wait가 반환되도록 해
+// 멈춘 nvidia-smi 하나가 수집 주기 전체를 막거나 좀비 프로세스를 남기지 않게 함
+// 실제 실행은 commandRunner를 거치므로 테스트에서는 기록된 도구 출력(testdata/commands)을 돌려주는 가짜로 바꿀 수 있음
+
+const (
+	externalCommandMaxConcurrent  = 4                // 동시에 실행할 수 있는 외부 명령 수
+	externalCommandWaitDelay      = 2 * time.Second  // 종료 신호 후 파이프를 강제로 닫기까지 대기 시간
+	defaultExternalCommandTimeout = 10 * time.Second // 도구별 제한 시간이 없을 때 사용
+)
+
+// 도구별 제한 시간 (실행 파일 이름 기준, 확장자 제외)
+var externalCommandTimeouts = map[string]time.Duration{
+	"nvidia-smi":   5 * time.Second,
+	"powershell":   15 * time.Second,
+	"ioreg":        3 * time.Second,
+	"powermetrics": 5 * time.Second,
+}
+
+// 전역 동시 실행 제한 (버퍼 크기 = 실행 슬롯 수)
+var externalCommandSlots = make(chan struct{}, externalCommandMaxConcurrent)
+
+// CommandRunner executes the external commands created by this package
+type CommandRunner interface {
+	// Output runs cmd and returns its standard output (combined with standard error when combined is true)
+	Output(cmd *exec.Cmd, combined bool) ([]byte, error)
+	// Run runs cmd and waits for it to complete
+	Run(cmd *exec.Cmd) error
+	// Stream starts a long-running cmd and returns its standard output; wait reaps the process after the stream ends
+	Stream(cmd *exec.Cmd) (stdout io.ReadCloser, wait func() error, err error)
+	// Start launches cmd without reading its output and returns its PID; wait reaps the process
+	Start(cmd *exec.Cmd) (pid int, wait func() error, err error)
+}
+
+// commandRunner is the runner used by every external command (replaced in tests)
+var commandRunner CommandRunner = execCommandRunner{}
+
+// SetCommandRunner replaces the command runner and returns a function restoring the previous one
+func SetCommandRunner(runner CommandRunner) (restore func()) {
+	previous := commandRunner
+	commandRunner = runner
+	return func() { commandRunner = previous }
+}
+
+// execCommandRunner runs commands with os/exec
+type execCommandRunner struct{}
+
+func (execCommandRunner) Output(cmd *exec.Cmd, combined bool) ([]byte, error) {
+	if combined {
+		return cmd.CombinedOutput()
+	}
+	return cmd.Output()
+}
+
+func (execCommandRunner) Run(cmd *exec.Cmd) error {
+	return cmd.Run()
+}
+
+func (execCommandRunner) Stream(cmd *exec.Cmd) (io.ReadCloser, func() error, error) {
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+	return stdout, cmd.Wait, nil
+}
+
+func (execCommandRunner) Start(cmd *exec.Cmd) (int, func() error, error) {
+	if err := cmd.Start(); err != nil {
+		return 0, nil, err
+	}
+	return cmd.Process.Pid, cmd.Wait, nil
+}
+
+// externalCommand is an exec.Cmd whose Output/CombinedOutput/Run are bounded by a timeout and the global concurrency limit
+type externalCommand struct {
+	*exec.Cmd
+	cancel  context.CancelFunc
+	timeout time.Duration
+}
+
+// newExternalCommand creates a command killed after timeout (measured from when it starts running, not while queued)
+func newExternalCommand(timeout time.Duration, name string, args ...string) *externalCommand {
+	ctx, cancel := context.WithCancel(context.Background())
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.WaitDelay = externalCommandWaitDelay
+	return &externalCommand{Cmd: cmd, cancel: cancel, timeout: timeout}
+}
+
+// externalCommandTimeout returns the timeout for a tool, accepting full paths such as C:\...\nvidia-smi.exe
+func externalCommandTimeout(name string) time.Duration {
+	base := strings.ToLower(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	base = strings.TrimSuffix(base, ".exe")
+	if timeout, ok := externalCommandTimeouts[base]; ok {
+		return timeout
+	}
+	return defaultExternalCommandTimeout
+}
+
+// Output runs the command and returns its standard output
+func (c *externalCommand) Output() ([]byte, error) {
+	var output []byte
+	err := c.run(func() error {
+		var err error
+		output, err = commandRunner.Output(c.Cmd, false)
+		return err
+	})
+	return output, err
+}
+
+// CombinedOutput runs the command and returns its combined standard output and standard error
+func (c *externalCommand) CombinedOutput() ([]byte, error) {
+	var output []byte
+	err := c.run(func() error {
+		var err error
+		output, err = commandRunner.Output(c.Cmd, true)
+		return err
+	})
+	return output, err
+}
+
+// Run runs the command and waits for it to complete
+func (c *externalCommand) Run() error {
+	return c.run(func() error { return commandRunner.Run(c.Cmd) })
+}
+
+// run waits for a free slot, then executes fn with the timeout armed
+func (c *externalCommand) run(fn func() error) error {
+	defer c.cancel()
+
+	externalCommandSlots <- struct{}{}
+	defer func() { <-externalCommandSlots }()
+
+	timer := time.AfterFunc(c.timeout, c.cancel)
+	err := fn()
+	if !timer.Stop() && err != nil {
+		return fmt.Errorf("%s timed out after %v: %w", filepath.Base(c.Path), c.timeout, err)
+	}
+	return err
+}
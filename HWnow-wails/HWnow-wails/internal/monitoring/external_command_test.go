@@ -0,0 +1,69 @@
+package monitoring
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExternalCommand(t *testing.T) {
+	t.Run("Timeout_By_Tool_Name", func(t *testing.T) {
+		if got := externalCommandTimeout(`C:\Windows\System32\nvidia-smi.exe`); got != 5*time.Second {
+			t.Errorf("nvidia-smi timeout = %v, want 5s", got)
+		}
+		if got := externalCommandTimeout("/usr/bin/nvidia-smi"); got != 5*time.Second {
+			t.Errorf("nvidia-smi timeout = %v, want 5s", got)
+		}
+		if got := externalCommandTimeout("PowerShell.exe"); got != 15*time.Second {
+			t.Errorf("powershell timeout = %v, want 15s", got)
+		}
+		if got := externalCommandTimeout("lspci"); got != defaultExternalCommandTimeout {
+			t.Errorf("unknown tool timeout = %v, want default", got)
+		}
+	})
+
+	t.Run("Hung_Command_Is_Killed", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses sleep")
+		}
+		start := time.Now()
+		_, err := newExternalCommand(200*time.Millisecond, "sleep", "10").Output()
+		if err == nil || !strings.Contains(err.Error(), "timed out") {
+			t.Fatalf("expected timeout error, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("command returned after %v", elapsed)
+		}
+		if len(externalCommandSlots) != 0 {
+			t.Errorf("slot not released: %d in use", len(externalCommandSlots))
+		}
+	})
+
+	t.Run("Concurrency_Is_Limited", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses sleep")
+		}
+		// 슬롯을 모두 차지한 상태에서는 제한 시간이 시작되지 않고 대기해야 함
+		for i := 0; i < externalCommandMaxConcurrent; i++ {
+			externalCommandSlots <- struct{}{}
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- newExternalCommand(time.Second, "true").Run()
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("command ran while all slots were taken")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		for i := 0; i < externalCommandMaxConcurrent; i++ {
+			<-externalCommandSlots
+		}
+		if err := <-done; err != nil {
+			t.Errorf("queued command failed: %v", err)
+		}
+	})
+}
@@ -0,0 +1,431 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 케이스/CPU 팬 회전수 및 PWM 제어 (Linux hwmon: /sys/class/hwmon/hwmon*/fanN_input, pwmN, pwmN_enable)
+// PWM 변경은 설정에서 명시적으로 허용한 경우만 가능하며, 제어를 멈추면 원래 pwmN_enable 모드(보통 자동)로 되돌림
+// sysfs 쓰기에는 root 권한(또는 udev 규칙으로 쓰기 권한 부여)이 필요
+
+const (
+	linuxHwmonPath = "/sys/class/hwmon"
+
+	FAN_CURVE_INTERVAL = 2 * time.Second
+	fanPWMMax          = 255
+	fanDutyHysteresis  = 1.0 // 이 값(%) 미만의 변화는 다시 쓰지 않음
+)
+
+// pwmN_enable 값 (1 이상 값의 의미는 드라이버마다 다르지만 0/1은 공통)
+const (
+	fanPWMModeFull   = "0" // 제어 안 함 (최대 속도)
+	fanPWMModeManual = "1" // 수동 PWM
+)
+
+// Fan describes one hwmon fan and its PWM control state
+type Fan struct {
+	ID           string  `json:"id"`           // <hwmon 이름>_fan<N> (hwmonX 번호는 부팅마다 바뀔 수 있어 드라이버 이름 사용)
+	Chip         string  `json:"chip"`         // hwmon 드라이버 이름 (nct6775, it87 ...)
+	Label        string  `json:"label"`        // fanN_label (없으면 "Fan N")
+	RPM          float64 `json:"rpm"`          // 회전수 (-1 = 읽기 실패)
+	PWM          int     `json:"pwm"`          // 0-255 (-1 = PWM 없음)
+	PWMPercent   float64 `json:"pwm_percent"`  // PWM 듀티 (%, -1 = PWM 없음)
+	PWMMode      string  `json:"pwm_mode"`     // full, manual, auto (pwmN_enable이 없으면 빈 값)
+	Controllable bool    `json:"controllable"` // pwmN 쓰기 가능 여부
+
+	dir   string
+	index int
+}
+
+// FanCurvePoint maps a CPU temperature to a fan duty
+type FanCurvePoint struct {
+	Temperature float64 `json:"temperature"` // °C
+	Percent     float64 `json:"percent"`     // PWM 듀티 (%)
+}
+
+// FanCurve is a temperature → duty curve for one fan (linear between points)
+type FanCurve struct {
+	FanID  string          `json:"fan_id"`
+	Points []FanCurvePoint `json:"points"`
+}
+
+// Validate checks the curve has at least two points with increasing temperatures and duties within 0-100%
+func (c FanCurve) Validate() error {
+	if strings.TrimSpace(c.FanID) == "" {
+		return fmt.Errorf("fan curve requires a fan id")
+	}
+	if len(c.Points) < 2 {
+		return fmt.Errorf("fan curve for %s requires at least 2 points", c.FanID)
+	}
+	for i, point := range c.Points {
+		if point.Percent < 0 || point.Percent > 100 {
+			return fmt.Errorf("fan curve for %s: duty %.1f%% out of range 0-100", c.FanID, point.Percent)
+		}
+		if i > 0 && point.Temperature <= c.Points[i-1].Temperature {
+			return fmt.Errorf("fan curve for %s: temperatures must be increasing", c.FanID)
+		}
+	}
+	return nil
+}
+
+// Duty returns the fan duty for a temperature, clamped to the first/last point outside the curve
+func (c FanCurve) Duty(temperature float64) float64 {
+	if len(c.Points) == 0 {
+		return 100
+	}
+	if temperature <= c.Points[0].Temperature {
+		return c.Points[0].Percent
+	}
+	for i := 1; i < len(c.Points); i++ {
+		low, high := c.Points[i-1], c.Points[i]
+		if temperature <= high.Temperature {
+			ratio := (temperature - low.Temperature) / (high.Temperature - low.Temperature)
+			return low.Percent + ratio*(high.Percent-low.Percent)
+		}
+	}
+	return c.Points[len(c.Points)-1].Percent
+}
+
+// GetFans returns the RPM and PWM state of every hwmon fan (Linux only)
+func GetFans(ctx context.Context) ([]Fan, error) {
+	return queryWithContext(ctx, "GetFans", getFans)
+}
+
+func getFans() ([]Fan, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("fan monitoring not supported on platform: %s", runtime.GOOS)
+	}
+	return readFans(linuxHwmonPath)
+}
+
+// readFans scans fanN_input attributes under the hwmon root
+func readFans(root string) ([]Fan, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "hwmon*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(dirs)
+
+	var fans []Fan
+	chipDirs := make(map[string]int)
+	for _, dir := range dirs {
+		chip := strings.TrimSpace(readSysfsString(dir, "name"))
+		if chip == "" {
+			chip = filepath.Base(dir)
+		}
+
+		inputs, _ := filepath.Glob(filepath.Join(dir, "fan*_input"))
+		if len(inputs) > 0 {
+			chipDirs[chip]++
+		}
+		for _, input := range inputs {
+			index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(input), "fan"), "_input"))
+			if err != nil {
+				continue
+			}
+			fans = append(fans, readFan(dir, chip, index))
+		}
+	}
+	if len(fans) == 0 {
+		return nil, fmt.Errorf("no hwmon fans found")
+	}
+
+	// 같은 드라이버의 hwmon 장치가 여러 개면 hwmonX를 붙여 구분
+	for i := range fans {
+		fans[i].ID = fmt.Sprintf("%s_fan%d", fans[i].Chip, fans[i].index)
+		if chipDirs[fans[i].Chip] > 1 {
+			fans[i].ID = fmt.Sprintf("%s_%s_fan%d", fans[i].Chip, filepath.Base(fans[i].dir), fans[i].index)
+		}
+	}
+	sort.Slice(fans, func(i, j int) bool { return fans[i].ID < fans[j].ID })
+	return fans, nil
+}
+
+// readFan reads the RPM, label and PWM attributes of fan N in a hwmon directory
+func readFan(dir, chip string, index int) Fan {
+	fan := Fan{Chip: chip, RPM: -1, PWM: -1, PWMPercent: -1, dir: dir, index: index}
+
+	fan.Label = strings.TrimSpace(readSysfsString(dir, fmt.Sprintf("fan%d_label", index)))
+	if fan.Label == "" {
+		fan.Label = fmt.Sprintf("Fan %d", index)
+	}
+	if rpm, ok := readSysfsFloat(dir, fmt.Sprintf("fan%d_input", index)); ok {
+		fan.RPM = rpm
+	}
+
+	pwmName := fmt.Sprintf("pwm%d", index)
+	if pwm, ok := readSysfsFloat(dir, pwmName); ok {
+		fan.PWM = int(pwm)
+		fan.PWMPercent = math.Round(pwm/fanPWMMax*1000) / 10
+		fan.Controllable = isSysfsWritable(dir, pwmName)
+	}
+	switch mode := strings.TrimSpace(readSysfsString(dir, pwmName+"_enable")); mode {
+	case "":
+	case fanPWMModeFull:
+		fan.PWMMode = "full"
+	case fanPWMModeManual:
+		fan.PWMMode = "manual"
+	default:
+		fan.PWMMode = "auto"
+	}
+	return fan
+}
+
+// isSysfsWritable reports whether a sysfs attribute has a write permission bit
+func isSysfsWritable(dir, name string) bool {
+	info, err := os.Stat(filepath.Join(dir, name))
+	if err != nil {
+		return false
+	}
+	return info.Mode().Perm()&0222 != 0
+}
+
+// FanMetricName returns the RPM metric type of a fan (e.g. nct6798_fan2 → fan_rpm_nct6798_fan2)
+func FanMetricName(fanID string) string {
+	name := strings.Trim(diskTempMetricNamePattern.ReplaceAllString(strings.ToLower(fanID), "_"), "_")
+	return "fan_rpm_" + name
+}
+
+// FanMetrics converts fan readings into resource log metrics (fan_rpm_<id>, fan_pwm_<id>_percent)
+func FanMetrics(fans []Fan) []Metric {
+	var metrics []Metric
+	for _, fan := range fans {
+		name := FanMetricName(fan.ID)
+		if fan.RPM >= 0 {
+			metrics = append(metrics, Metric{Type: name, Value: fan.RPM, Info: fan.Label})
+		}
+		if fan.PWMPercent >= 0 {
+			metrics = append(metrics, Metric{
+				Type:  "fan_pwm_" + strings.TrimPrefix(name, "fan_rpm_") + "_percent",
+				Value: fan.PWMPercent,
+				Info:  fan.Label,
+			})
+		}
+	}
+	return metrics
+}
+
+// fanPWMState remembers how a fan was controlled before HWnow took it over
+type fanPWMState struct {
+	dir   string
+	index int
+	mode  string // 원래 pwmN_enable 값 (없으면 빈 값)
+}
+
+// FanController applies fan curves driven by the CPU temperature and manual PWM targets
+type FanController struct {
+	mutex       sync.Mutex
+	root        string
+	curves      []FanCurve
+	temperature func() (float64, error)
+	original    map[string]fanPWMState // 제어를 넘겨받은 팬의 원래 모드
+	applied     map[string]float64     // 마지막으로 쓴 듀티 (%)
+	cancel      context.CancelFunc
+}
+
+// NewFanController creates a controller for the given curves (invalid curves must be filtered by the caller)
+func NewFanController(curves []FanCurve) *FanController {
+	return &FanController{
+		root:        linuxHwmonPath,
+		curves:      curves,
+		temperature: getCPUTemperature,
+		original:    make(map[string]fanPWMState),
+		applied:     make(map[string]float64),
+	}
+}
+
+// Start applies the curves in the background every FAN_CURVE_INTERVAL
+func (c *FanController) Start(ctx context.Context) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.cancel != nil || len(c.curves) == 0 {
+		return
+	}
+
+	controlCtx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	go c.run(controlCtx)
+
+	LogInfo("Fan curve control started", "curves", len(c.curves))
+}
+
+// Stop stops applying curves and hands every fan back to its original mode
+func (c *FanController) Stop() {
+	c.mutex.Lock()
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.mutex.Unlock()
+
+	if err := c.Restore(); err != nil {
+		LogWarn("Failed to restore fan control mode", "error", err)
+	}
+}
+
+// run applies the curves once immediately and then on every interval
+func (c *FanController) run(ctx context.Context) {
+	ticker := time.NewTicker(FAN_CURVE_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		temperature, err := c.temperature()
+		if err != nil {
+			temperature = -1
+		}
+		if err := c.Apply(temperature); err != nil {
+			LogDebug("Fan curve not applied", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Apply sets every curve-controlled fan to the duty for the temperature
+// 온도를 읽지 못하면(temperature < 0) 안전을 위해 최대 속도로 설정
+func (c *FanController) Apply(temperature float64) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.curves) == 0 {
+		return nil
+	}
+	fans, err := readFans(c.root)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, curve := range c.curves {
+		fan, ok := findFan(fans, curve.FanID)
+		if !ok {
+			errs = append(errs, fmt.Errorf("fan %s not found", curve.FanID))
+			continue
+		}
+		duty := 100.0
+		if temperature >= 0 {
+			duty = curve.Duty(temperature)
+		}
+		if last, ok := c.applied[fan.ID]; ok && fan.PWMMode != "auto" && math.Abs(last-duty) < fanDutyHysteresis {
+			continue
+		}
+		if err := c.setPWM(fan, duty); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetPWM sets a manual duty for a fan without a curve (kept until Stop restores the original mode)
+func (c *FanController) SetPWM(fanID string, percent float64) (*Fan, error) {
+	if percent < 0 || percent > 100 {
+		return nil, fmt.Errorf("fan duty %.1f%% out of range 0-100", percent)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, curve := range c.curves {
+		if curve.FanID == fanID {
+			return nil, fmt.Errorf("fan %s is controlled by a fan curve", fanID)
+		}
+	}
+	fans, err := readFans(c.root)
+	if err != nil {
+		return nil, err
+	}
+	fan, ok := findFan(fans, fanID)
+	if !ok {
+		return nil, fmt.Errorf("fan %s not found", fanID)
+	}
+	if err := c.setPWM(fan, percent); err != nil {
+		return nil, err
+	}
+
+	updated := readFan(fan.dir, fan.Chip, fan.index)
+	updated.ID = fan.ID
+	return &updated, nil
+}
+
+// setPWM switches the fan to manual mode (remembering the original mode) and writes the duty (caller holds the mutex)
+func (c *FanController) setPWM(fan Fan, percent float64) error {
+	if !fan.Controllable {
+		return fmt.Errorf("fan %s has no writable PWM control", fan.ID)
+	}
+
+	enableName := fmt.Sprintf("pwm%d_enable", fan.index)
+	if _, saved := c.original[fan.ID]; !saved {
+		c.original[fan.ID] = fanPWMState{
+			dir:   fan.dir,
+			index: fan.index,
+			mode:  strings.TrimSpace(readSysfsString(fan.dir, enableName)),
+		}
+	}
+	if mode := c.original[fan.ID].mode; mode != "" && strings.TrimSpace(readSysfsString(fan.dir, enableName)) != fanPWMModeManual {
+		if err := writeSysfs(fan.dir, enableName, fanPWMModeManual); err != nil {
+			return fmt.Errorf("failed to switch fan %s to manual control: %v", fan.ID, err)
+		}
+	}
+
+	value := int(math.Round(percent / 100 * fanPWMMax))
+	if err := writeSysfs(fan.dir, fmt.Sprintf("pwm%d", fan.index), strconv.Itoa(value)); err != nil {
+		return fmt.Errorf("failed to set fan %s PWM: %v", fan.ID, err)
+	}
+	c.applied[fan.ID] = percent
+	return nil
+}
+
+// Restore hands every fan taken over by the controller back to its original mode
+// pwmN_enable이 없는 드라이버는 원래 모드를 알 수 없으므로 최대 속도로 둠
+func (c *FanController) Restore() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var errs []error
+	for id, state := range c.original {
+		var err error
+		if state.mode != "" {
+			err = writeSysfs(state.dir, fmt.Sprintf("pwm%d_enable", state.index), state.mode)
+		} else {
+			err = writeSysfs(state.dir, fmt.Sprintf("pwm%d", state.index), strconv.Itoa(fanPWMMax))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("fan %s: %v", id, err))
+			continue
+		}
+		delete(c.original, id)
+		delete(c.applied, id)
+	}
+	return errors.Join(errs...)
+}
+
+// findFan looks up a fan by ID
+func findFan(fans []Fan, fanID string) (Fan, bool) {
+	for _, fan := range fans {
+		if fan.ID == fanID {
+			return fan, true
+		}
+	}
+	return Fan{}, false
+}
+
+// writeSysfs writes a sysfs attribute
+func writeSysfs(dir, name, value string) error {
+	return os.WriteFile(filepath.Join(dir, name), []byte(value), 0644)
+}
@@ -0,0 +1,136 @@
+package monitoring
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeHwmonFixture creates a hwmon directory with the given attributes
+func writeHwmonFixture(t *testing.T, root, name string, attributes map[string]string) string {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for attribute, value := range attributes {
+		if err := os.WriteFile(filepath.Join(dir, attribute), []byte(value+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestReadFans(t *testing.T) {
+	root := t.TempDir()
+	writeHwmonFixture(t, root, "hwmon0", map[string]string{"name": "k10temp", "temp1_input": "45000"})
+	writeHwmonFixture(t, root, "hwmon3", map[string]string{
+		"name":        "nct6798",
+		"fan1_input":  "820",
+		"fan1_label":  "CPU_FAN",
+		"pwm1":        "128",
+		"pwm1_enable": "5",
+		"fan2_input":  "0",
+	})
+
+	fans, err := readFans(root)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fans) != 2 {
+		t.Fatalf("Expected 2 fans, got %+v", fans)
+	}
+	cpu := fans[0]
+	if cpu.ID != "nct6798_fan1" || cpu.Label != "CPU_FAN" || cpu.RPM != 820 || cpu.PWM != 128 || cpu.PWMPercent != 50.2 || cpu.PWMMode != "auto" || !cpu.Controllable {
+		t.Errorf("Unexpected fan: %+v", cpu)
+	}
+	if fans[1].Label != "Fan 2" || fans[1].PWM != -1 || fans[1].Controllable {
+		t.Errorf("Expected a fan without PWM control, got %+v", fans[1])
+	}
+
+	if _, err := readFans(t.TempDir()); err == nil {
+		t.Error("Expected an error without hwmon fans")
+	}
+}
+
+func TestFanCurve(t *testing.T) {
+	curve := FanCurve{FanID: "nct6798_fan1", Points: []FanCurvePoint{{40, 30}, {60, 50}, {80, 100}}}
+	if err := curve.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error: %v", err)
+	}
+	for temperature, expected := range map[float64]float64{20: 30, 50: 40, 70: 75, 95: 100} {
+		if duty := curve.Duty(temperature); duty != expected {
+			t.Errorf("Duty(%v) = %v, expected %v", temperature, duty, expected)
+		}
+	}
+
+	invalid := []FanCurve{
+		{FanID: "fan", Points: []FanCurvePoint{{40, 30}}},
+		{FanID: "fan", Points: []FanCurvePoint{{60, 30}, {40, 50}}},
+		{FanID: "fan", Points: []FanCurvePoint{{40, 30}, {60, 120}}},
+	}
+	for _, curve := range invalid {
+		if curve.Validate() == nil {
+			t.Errorf("Expected validation error for %+v", curve)
+		}
+	}
+}
+
+func TestFanControllerApplyAndRestore(t *testing.T) {
+	root := t.TempDir()
+	dir := writeHwmonFixture(t, root, "hwmon2", map[string]string{
+		"name":        "it8686",
+		"fan1_input":  "1100",
+		"pwm1":        "90",
+		"pwm1_enable": "2",
+	})
+
+	controller := NewFanController([]FanCurve{{FanID: "it8686_fan1", Points: []FanCurvePoint{{40, 20}, {80, 100}}}})
+	controller.root = root
+
+	if err := controller.Apply(60); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pwm := strings.TrimSpace(readSysfsString(dir, "pwm1")); pwm != "153" {
+		t.Errorf("Expected pwm1 = 153 (60%%), got %s", pwm)
+	}
+	if mode := strings.TrimSpace(readSysfsString(dir, "pwm1_enable")); mode != fanPWMModeManual {
+		t.Errorf("Expected manual mode, got %s", mode)
+	}
+
+	// 온도를 읽지 못하면 최대 속도
+	if err := controller.Apply(-1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pwm := strings.TrimSpace(readSysfsString(dir, "pwm1")); pwm != "255" {
+		t.Errorf("Expected full speed without a temperature, got %s", pwm)
+	}
+
+	if _, err := controller.SetPWM("it8686_fan1", 40); err == nil {
+		t.Error("Expected manual duty to be rejected for a curve-controlled fan")
+	}
+
+	if err := controller.Restore(); err != nil {
+		t.Fatalf("Unexpected restore error: %v", err)
+	}
+	if mode := strings.TrimSpace(readSysfsString(dir, "pwm1_enable")); mode != "2" {
+		t.Errorf("Expected original mode 2 restored, got %s", mode)
+	}
+}
+
+func TestFanMetrics(t *testing.T) {
+	metrics := FanMetrics([]Fan{
+		{ID: "nct6798_fan1", Label: "CPU_FAN", RPM: 820, PWMPercent: 50},
+		{ID: "asus-ec_fan2", RPM: -1, PWMPercent: -1},
+	})
+	if len(metrics) != 2 || metrics[0].Type != "fan_rpm_nct6798_fan1" || metrics[1].Type != "fan_pwm_nct6798_fan1_percent" {
+		t.Fatalf("Unexpected metrics: %+v", metrics)
+	}
+	if MetricUnit(metrics[0].Type) != UnitRPM || MetricUnit(metrics[1].Type) != UnitPercent {
+		t.Errorf("Unexpected units for %+v", metrics)
+	}
+	if name := FanMetricName("asus-ec_fan2"); name != "fan_rpm_asus_ec_fan2" {
+		t.Errorf("Unexpected metric name %s", name)
+	}
+}
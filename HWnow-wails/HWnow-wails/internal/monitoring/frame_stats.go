@@ -0,0 +1,349 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 애플리케이션별 FPS / 프레임 시간 (Windows, 선택 기능)
+// PresentMon을 실시간 모드로 실행해 CSV 출력(Present 이벤트 단위)을 읽고,
+// 프로세스별 최근 FRAME_STATS_WINDOW 동안의 프레임 시간으로 FPS, 평균/99백분위 프레임 시간을 계산
+// PresentMon 1.x(msBetweenPresents)와 2.x(FrameTime / MsBetweenPresents) 열 이름을 모두 지원
+// ETW 세션을 사용하므로 관리자 권한(또는 Performance Log Users 그룹)이 필요
+
+const (
+	FRAME_STATS_WINDOW        = 2 * time.Second  // 통계 계산에 사용하는 최근 프레임 시간 합
+	FRAME_STATS_STALE_AFTER   = 3 * time.Second  // 이 시간 동안 프레임이 없으면 목록에서 제외
+	FRAME_STATS_RESTART_DELAY = 30 * time.Second // PresentMon 비정상 종료 후 재시작 대기
+)
+
+// 화면 합성기처럼 게임/앱의 프레임 전달과 무관한 Present 호출 프로세스
+var frameStatsIgnoredApps = map[string]bool{
+	"dwm.exe": true,
+}
+
+// 프레임 시간 열 (앞에 있을수록 우선, 소문자)
+var frameTimeColumns = []string{"frametime", "msbetweenpresents"}
+
+// FrameStatsConfig configures the PresentMon frame source
+type FrameStatsConfig struct {
+	Command string   // PresentMon 실행 파일 (PATH 또는 전체 경로)
+	Args    []string // CSV를 표준 출력으로 내보내는 인수
+}
+
+// AppFrameStats holds frame delivery statistics of one presenting process
+type AppFrameStats struct {
+	Application    string  `json:"application"`
+	ProcessID      uint32  `json:"process_id"`
+	Runtime        string  `json:"runtime"` // DXGI, D3D9, Vulkan 등
+	FPS            float64 `json:"fps"`
+	AvgFrameTimeMs float64 `json:"avg_frame_time_ms"`
+	P99FrameTimeMs float64 `json:"p99_frame_time_ms"` // 1% low FPS = 1000 / P99
+	MaxFrameTimeMs float64 `json:"max_frame_time_ms"`
+	DroppedFrames  int     `json:"dropped_frames"` // 통계 구간 중 화면에 표시되지 않은 프레임
+	FramesInWindow int     `json:"frames_in_window"`
+}
+
+// appFrameWindow keeps the recent frame times of one process
+type appFrameWindow struct {
+	application string
+	runtime     string
+	frameTimes  []float64 // ms, 오래된 순
+	dropped     []bool
+	totalMs     float64
+	lastSeen    time.Time
+}
+
+// FrameStatsSource runs PresentMon in the background and aggregates per-application frame times
+type FrameStatsSource struct {
+	mutex  sync.Mutex
+	config FrameStatsConfig
+	apps   map[uint32]*appFrameWindow
+	cancel context.CancelFunc
+}
+
+// NewFrameStatsSource creates a frame source with the given configuration
+func NewFrameStatsSource(config FrameStatsConfig) *FrameStatsSource {
+	return &FrameStatsSource{config: config, apps: make(map[uint32]*appFrameWindow)}
+}
+
+// Start launches PresentMon in the background (restarted after unexpected exits until Stop)
+func (s *FrameStatsSource) Start(ctx context.Context) error {
+	if runtime.GOOS != "windows" {
+		return fmt.Errorf("frame statistics not supported on platform: %s", runtime.GOOS)
+	}
+	if strings.TrimSpace(s.config.Command) == "" {
+		return fmt.Errorf("frame statistics require a PresentMon command")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.cancel != nil {
+		return nil // already running
+	}
+
+	sourceCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	go s.run(sourceCtx)
+
+	LogInfo("Frame statistics source started", "command", s.config.Command)
+	return nil
+}
+
+// Stop terminates PresentMon and clears the collected frames
+func (s *FrameStatsSource) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.apps = make(map[uint32]*appFrameWindow)
+}
+
+// Latest returns statistics of processes that presented recently, highest FPS first
+func (s *FrameStatsSource) Latest() []AppFrameStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.computeStats(time.Now())
+}
+
+// run keeps PresentMon running until ctx is cancelled
+func (s *FrameStatsSource) run(ctx context.Context) {
+	for {
+		err := s.runPresentMon(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		LogWarn("PresentMon exited, restarting later", "error", err, "delay", FRAME_STATS_RESTART_DELAY)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(FRAME_STATS_RESTART_DELAY):
+		}
+	}
+}
+
+// runPresentMon runs one PresentMon session and ingests its output until it exits
+func (s *FrameStatsSource) runPresentMon(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, s.config.Command, s.config.Args...)
+	cmd.WaitDelay = externalCommandWaitDelay
+	hideConsoleWindow(cmd)
+
+	stdout, wait, err := commandRunner.Stream(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to start PresentMon: %v", err)
+	}
+
+	ingestErr := s.ingest(stdout)
+	waitErr := wait()
+	if ingestErr != nil {
+		return ingestErr
+	}
+	if waitErr == nil {
+		waitErr = errors.New("exited")
+	}
+	return waitErr
+}
+
+// ingest reads PresentMon CSV rows until EOF
+func (s *FrameStatsSource) ingest(reader io.Reader) error {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.ReuseRecord = true
+
+	var columns map[string]int
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				continue // 잘린 행은 무시
+			}
+			return err
+		}
+
+		// 헤더 행 (세션 재시작 시 다시 출력될 수 있음)
+		if len(record) > 0 && strings.EqualFold(strings.TrimSpace(record[0]), "Application") {
+			columns = parsePresentMonHeader(record)
+			continue
+		}
+		if columns == nil {
+			continue
+		}
+
+		frame, ok := parsePresentMonRow(record, columns)
+		if !ok {
+			continue
+		}
+		s.mutex.Lock()
+		s.addFrame(frame, time.Now())
+		s.mutex.Unlock()
+	}
+}
+
+// presentMonFrame is a single present event from the CSV output
+type presentMonFrame struct {
+	application string
+	processID   uint32
+	runtime     string
+	frameTimeMs float64
+	dropped     bool
+}
+
+// parsePresentMonHeader maps lowercase column names to indexes
+func parsePresentMonHeader(record []string) map[string]int {
+	columns := make(map[string]int, len(record))
+	for i, name := range record {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return columns
+}
+
+// parsePresentMonRow extracts the fields used for frame statistics
+func parsePresentMonRow(record []string, columns map[string]int) (presentMonFrame, bool) {
+	field := func(name string) string {
+		if index, ok := columns[name]; ok && index < len(record) {
+			return strings.TrimSpace(record[index])
+		}
+		return ""
+	}
+
+	frame := presentMonFrame{application: field("application")}
+	processID, err := strconv.ParseUint(field("processid"), 10, 32)
+	if err != nil || frame.application == "" {
+		return frame, false
+	}
+	frame.processID = uint32(processID)
+
+	frame.runtime = field("runtime")
+	if frame.runtime == "" {
+		frame.runtime = field("presentruntime")
+	}
+
+	frameTimeOK := false
+	for _, column := range frameTimeColumns {
+		// 첫 프레임 등 값이 없으면 "NA"
+		if value, err := strconv.ParseFloat(field(column), 64); err == nil && value > 0 {
+			frame.frameTimeMs = value
+			frameTimeOK = true
+			break
+		}
+	}
+	if !frameTimeOK {
+		return frame, false
+	}
+
+	frame.dropped = field("dropped") == "1"
+	return frame, true
+}
+
+// addFrame appends a frame and trims the process window to FRAME_STATS_WINDOW (caller holds the mutex)
+func (s *FrameStatsSource) addFrame(frame presentMonFrame, now time.Time) {
+	if frameStatsIgnoredApps[strings.ToLower(frame.application)] {
+		return
+	}
+
+	window, ok := s.apps[frame.processID]
+	if !ok || window.application != frame.application {
+		// PID가 재사용된 경우 새 창으로 시작
+		window = &appFrameWindow{application: frame.application}
+		s.apps[frame.processID] = window
+	}
+	window.runtime = frame.runtime
+	window.lastSeen = now
+	window.frameTimes = append(window.frameTimes, frame.frameTimeMs)
+	window.dropped = append(window.dropped, frame.dropped)
+	window.totalMs += frame.frameTimeMs
+
+	windowMs := float64(FRAME_STATS_WINDOW / time.Millisecond)
+	trim := 0
+	for trim < len(window.frameTimes)-1 && window.totalMs-window.frameTimes[trim] >= windowMs {
+		window.totalMs -= window.frameTimes[trim]
+		trim++
+	}
+	if trim > 0 {
+		window.frameTimes = append(window.frameTimes[:0], window.frameTimes[trim:]...)
+		window.dropped = append(window.dropped[:0], window.dropped[trim:]...)
+	}
+}
+
+// computeStats removes stale processes and returns statistics sorted by FPS (caller holds the mutex)
+func (s *FrameStatsSource) computeStats(now time.Time) []AppFrameStats {
+	stats := make([]AppFrameStats, 0, len(s.apps))
+	for processID, window := range s.apps {
+		if now.Sub(window.lastSeen) > FRAME_STATS_STALE_AFTER {
+			delete(s.apps, processID)
+			continue
+		}
+		if len(window.frameTimes) == 0 || window.totalMs <= 0 {
+			continue
+		}
+
+		sorted := append([]float64(nil), window.frameTimes...)
+		sort.Float64s(sorted)
+		dropped := 0
+		for _, isDropped := range window.dropped {
+			if isDropped {
+				dropped++
+			}
+		}
+
+		count := len(sorted)
+		p99Index := int(math.Ceil(0.99*float64(count))) - 1
+		stats = append(stats, AppFrameStats{
+			Application:    window.application,
+			ProcessID:      processID,
+			Runtime:        window.runtime,
+			FPS:            1000 * float64(count) / window.totalMs,
+			AvgFrameTimeMs: window.totalMs / float64(count),
+			P99FrameTimeMs: sorted[p99Index],
+			MaxFrameTimeMs: sorted[count-1],
+			DroppedFrames:  dropped,
+			FramesInWindow: count,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].FPS != stats[j].FPS {
+			return stats[i].FPS > stats[j].FPS
+		}
+		return stats[i].ProcessID < stats[j].ProcessID
+	})
+	return stats
+}
+
+// FrameStatsMetrics converts the application presenting the most frames into fps / frame_time_* metrics
+// 여러 앱이 동시에 Present하는 경우 기록이 섞이지 않도록 가장 많은 프레임을 전달한 앱 하나만 기록
+func FrameStatsMetrics(stats []AppFrameStats) []Metric {
+	if len(stats) == 0 {
+		return nil
+	}
+	top := stats[0]
+	for _, app := range stats[1:] {
+		if app.FramesInWindow > top.FramesInWindow {
+			top = app
+		}
+	}
+	return []Metric{
+		{Type: "fps", Value: top.FPS, Info: top.Application},
+		{Type: "frame_time_ms", Value: top.AvgFrameTimeMs, Info: top.Application},
+		{Type: "frame_time_p99_ms", Value: top.P99FrameTimeMs, Info: top.Application},
+	}
+}
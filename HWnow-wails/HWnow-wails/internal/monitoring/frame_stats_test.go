@@ -0,0 +1,79 @@
+package monitoring
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFrameStats(t *testing.T) {
+	t.Run("Parse_PresentMon_1x", func(t *testing.T) {
+		output := "Application,ProcessID,SwapChainAddress,Runtime,SyncInterval,PresentFlags,Dropped,TimeInSeconds,msInPresentAPI,msBetweenPresents\n" +
+			"game.exe,1234,0x1,DXGI,0,0,0,1.000,0.1,NA\n" +
+			"game.exe,1234,0x1,DXGI,0,0,0,1.010,0.1,10.0\n" +
+			"game.exe,1234,0x1,DXGI,0,0,1,1.030,0.1,20.0\n" +
+			"dwm.exe,88,0x2,DXGI,1,0,0,1.030,0.1,16.6\n"
+
+		source := NewFrameStatsSource(FrameStatsConfig{})
+		if err := source.ingest(strings.NewReader(output)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		stats := source.Latest()
+		if len(stats) != 1 {
+			t.Fatalf("Expected only game.exe (dwm.exe ignored), got %+v", stats)
+		}
+		game := stats[0]
+		if game.Application != "game.exe" || game.ProcessID != 1234 || game.Runtime != "DXGI" {
+			t.Errorf("Unexpected identity: %+v", game)
+		}
+		if game.FramesInWindow != 2 || game.DroppedFrames != 1 || game.MaxFrameTimeMs != 20 {
+			t.Errorf("Unexpected counts: %+v", game)
+		}
+		if math.Abs(game.FPS-1000*2/30.0) > 0.001 || game.AvgFrameTimeMs != 15 {
+			t.Errorf("Unexpected FPS/frame time: %+v", game)
+		}
+	})
+
+	t.Run("Parse_PresentMon_2x", func(t *testing.T) {
+		output := "Application,ProcessID,SwapChainAddress,PresentRuntime,SyncInterval,PresentFlags,AllowsTearing,PresentMode,FrameType,CPUStartTime,FrameTime,CPUBusy\n" +
+			"vk.exe,42,0x1,Vulkan,0,0,1,Hardware: Independent Flip,Application,100.0,8.0,7.5\n"
+
+		source := NewFrameStatsSource(FrameStatsConfig{})
+		source.ingest(strings.NewReader(output))
+		stats := source.Latest()
+		if len(stats) != 1 || stats[0].Runtime != "Vulkan" || stats[0].FPS != 125 {
+			t.Errorf("Unexpected stats: %+v", stats)
+		}
+	})
+
+	t.Run("Rolling_Window", func(t *testing.T) {
+		source := NewFrameStatsSource(FrameStatsConfig{})
+		now := time.Now()
+		for i := 0; i < 500; i++ {
+			source.addFrame(presentMonFrame{application: "game.exe", processID: 1, frameTimeMs: 10}, now)
+		}
+		stats := source.computeStats(now)
+		// 2초 창 = 10ms 프레임 200개
+		if len(stats) != 1 || stats[0].FramesInWindow != 200 || stats[0].FPS != 100 {
+			t.Errorf("Unexpected window: %+v", stats)
+		}
+
+		if stats := source.computeStats(now.Add(FRAME_STATS_STALE_AFTER + time.Second)); len(stats) != 0 {
+			t.Errorf("Expected stale process to be dropped, got %+v", stats)
+		}
+	})
+
+	t.Run("Metrics_Use_Busiest_App", func(t *testing.T) {
+		metrics := FrameStatsMetrics([]AppFrameStats{
+			{Application: "menu.exe", FPS: 300, FramesInWindow: 5},
+			{Application: "game.exe", FPS: 144, AvgFrameTimeMs: 6.9, P99FrameTimeMs: 12, FramesInWindow: 288},
+		})
+		if len(metrics) != 3 || metrics[0].Type != "fps" || metrics[0].Value != 144 || metrics[0].Info != "game.exe" {
+			t.Errorf("Unexpected metrics: %+v", metrics)
+		}
+		if FrameStatsMetrics(nil) != nil {
+			t.Error("Expected no metrics without presenting applications")
+		}
+	})
+}
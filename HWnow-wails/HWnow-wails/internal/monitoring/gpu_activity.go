@@ -0,0 +1,184 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+)
+
+// 프로세스별 실제 GPU 엔진 사용 시간 집계 (ETW DxgKrnl DMA 패킷 이벤트 기반)
+// 엔진(노드)은 패킷을 순서대로 하나씩 실행하므로, 패킷 완료 시 "시작 시각과 같은 노드의 직전 완료 시각 중 늦은 쪽"부터
+// 완료 시각까지를 해당 컨텍스트 소유 프로세스의 실행 시간으로 계산
+// 프로세스 사용률 = 노드별 실행 시간 / 측정 구간 중 가장 큰 값 (작업 관리자와 같은 방식)
+
+const (
+	gpuActivityMinWindow = time.Second     // 이보다 짧은 구간은 직전 결과 재사용 (노이즈 방지)
+	gpuActivityStaleTime = 5 * time.Second // 이 시간 동안 이벤트가 없으면 측정 불가로 판단
+)
+
+// gpuContextKey identifies a GPU context on an adapter
+type gpuContextKey uint64
+
+// gpuPacketKey identifies a DMA packet submitted on a context
+type gpuPacketKey struct {
+	context  gpuContextKey
+	sequence uint32
+}
+
+// gpuContextInfo is the owning process and engine node of a GPU context
+type gpuContextInfo struct {
+	pid  int32
+	node uint32
+}
+
+// gpuProcessNode is the busy time accumulator key
+type gpuProcessNode struct {
+	pid  int32
+	node uint32
+}
+
+// GPUActivityTracker accumulates per-process GPU engine busy time from DMA packet events
+type GPUActivityTracker struct {
+	mutex          sync.Mutex
+	contexts       map[gpuContextKey]gpuContextInfo
+	packets        map[gpuPacketKey]time.Time // 하드웨어 큐에 제출된(실행 대기/실행 중) 패킷의 제출 시각
+	nodeCompletion map[uint32]time.Time       // 노드별 직전 패킷 완료 시각
+	busy           map[gpuProcessNode]time.Duration
+	windowStart    time.Time
+	lastEvent      time.Time
+	lastUsage      map[int32]float64
+}
+
+// NewGPUActivityTracker creates an empty tracker
+func NewGPUActivityTracker() *GPUActivityTracker {
+	return &GPUActivityTracker{
+		contexts:       make(map[gpuContextKey]gpuContextInfo),
+		packets:        make(map[gpuPacketKey]time.Time),
+		nodeCompletion: make(map[uint32]time.Time),
+		busy:           make(map[gpuProcessNode]time.Duration),
+	}
+}
+
+// ContextCreated records the owner and engine node of a GPU context
+func (t *GPUActivityTracker) ContextCreated(context uint64, pid int32, node uint32) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.contexts[gpuContextKey(context)] = gpuContextInfo{pid: pid, node: node}
+}
+
+// ContextDestroyed forgets a GPU context and its pending packets
+func (t *GPUActivityTracker) ContextDestroyed(context uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := gpuContextKey(context)
+	delete(t.contexts, key)
+	for packet := range t.packets {
+		if packet.context == key {
+			delete(t.packets, packet)
+		}
+	}
+}
+
+// PacketStarted records a DMA packet submitted to the hardware queue
+func (t *GPUActivityTracker) PacketStarted(context uint64, sequence uint32, timestamp time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.packets[gpuPacketKey{context: gpuContextKey(context), sequence: sequence}] = timestamp
+	t.lastEvent = timestamp
+}
+
+// PacketCompleted attributes the execution time of a finished DMA packet to its process
+func (t *GPUActivityTracker) PacketCompleted(context uint64, sequence uint32, timestamp time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lastEvent = timestamp
+	key := gpuPacketKey{context: gpuContextKey(context), sequence: sequence}
+	submitted, ok := t.packets[key]
+	if !ok {
+		return
+	}
+	delete(t.packets, key)
+
+	info, ok := t.contexts[key.context]
+	if !ok {
+		return
+	}
+
+	// 같은 노드의 앞선 패킷이 끝난 뒤에야 실행이 시작됨
+	begin := submitted
+	if previous := t.nodeCompletion[info.node]; previous.After(begin) {
+		begin = previous
+	}
+	if !t.windowStart.IsZero() && t.windowStart.After(begin) {
+		begin = t.windowStart
+	}
+	t.nodeCompletion[info.node] = timestamp
+	if timestamp.After(begin) {
+		t.busy[gpuProcessNode{pid: info.pid, node: info.node}] += timestamp.Sub(begin)
+	}
+}
+
+// Usage returns per-process GPU utilization (%) since the previous call and whether events are arriving
+func (t *GPUActivityTracker) Usage(now time.Time) (map[int32]float64, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.lastEvent.IsZero() || now.Sub(t.lastEvent) > gpuActivityStaleTime {
+		// 유휴 GPU도 주기적으로 패킷을 처리하므로 이벤트가 끊기면 세션이 동작하지 않는 것으로 간주
+		t.resetWindow(now)
+		return nil, false
+	}
+	if t.windowStart.IsZero() {
+		t.resetWindow(now)
+		return map[int32]float64{}, true
+	}
+
+	elapsed := now.Sub(t.windowStart)
+	if elapsed < gpuActivityMinWindow && t.lastUsage != nil {
+		return t.lastUsage, true
+	}
+
+	usage := make(map[int32]float64)
+	for key, duration := range t.busy {
+		percent := float64(duration) / float64(elapsed) * 100
+		if percent > 100 {
+			percent = 100
+		}
+		if percent > usage[key.pid] {
+			usage[key.pid] = percent
+		}
+	}
+
+	t.resetWindow(now)
+	t.lastUsage = usage
+	return usage, true
+}
+
+// resetWindow starts a new measurement window (caller holds the mutex)
+func (t *GPUActivityTracker) resetWindow(now time.Time) {
+	t.busy = make(map[gpuProcessNode]time.Duration)
+	t.windowStart = now
+
+	// 완료 이벤트를 놓친 패킷이 무한히 쌓이지 않도록 정리
+	for key, submitted := range t.packets {
+		if now.Sub(submitted) > gpuActivityStaleTime {
+			delete(t.packets, key)
+		}
+	}
+}
+
+// applyMeasuredGPUUsage replaces per-process GPU usage with ETW measurements; returns false when tracing is not available
+func applyMeasuredGPUUsage(processes []GPUProcess) bool {
+	usage, ok := MeasuredGPUProcessUsage()
+	if !ok {
+		return false
+	}
+	for i := range processes {
+		processes[i].GPUUsage = usage[processes[i].PID]
+		processes[i].EstimatedUsage = false
+		processes[i].UsageSource = GPUUsageSourceETW
+	}
+	return true
+}
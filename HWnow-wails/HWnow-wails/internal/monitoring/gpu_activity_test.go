@@ -0,0 +1,83 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGPUActivityTracker(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	at := func(ms int) time.Time { return base.Add(time.Duration(ms) * time.Millisecond) }
+
+	t.Run("Serial_Packets_On_Node", func(t *testing.T) {
+		tracker := NewGPUActivityTracker()
+		tracker.ContextCreated(0xA, 100, 0) // game.exe 3D
+		tracker.ContextCreated(0xB, 200, 0) // browser 3D
+		tracker.ContextCreated(0xC, 200, 5) // browser video decode
+
+		tracker.Usage(at(0)) // 측정 구간 시작
+		tracker.PacketStarted(0xA, 1, at(0))
+		tracker.PacketStarted(0xB, 1, at(100)) // A가 끝날 때까지 대기
+		tracker.PacketCompleted(0xA, 1, at(400))
+		tracker.PacketCompleted(0xB, 1, at(600))
+		tracker.PacketStarted(0xC, 7, at(0))
+		tracker.PacketCompleted(0xC, 7, at(300))
+
+		usage, ok := tracker.Usage(at(1000))
+		if !ok {
+			t.Fatal("Expected measurements to be available")
+		}
+		if usage[100] != 40 {
+			t.Errorf("Expected game 40%%, got %.1f", usage[100])
+		}
+		// 3D 20%, 디코드 30% → 가장 바쁜 엔진 기준
+		if usage[200] != 30 {
+			t.Errorf("Expected browser 30%%, got %.1f", usage[200])
+		}
+	})
+
+	t.Run("Packet_Spanning_Window", func(t *testing.T) {
+		tracker := NewGPUActivityTracker()
+		tracker.ContextCreated(0xA, 100, 0)
+		tracker.PacketStarted(0xA, 1, at(0))
+		tracker.Usage(at(500))
+		tracker.PacketCompleted(0xA, 1, at(1000))
+
+		usage, _ := tracker.Usage(at(1500))
+		if usage[100] != 50 {
+			t.Errorf("Expected only the in-window part (50%%), got %.1f", usage[100])
+		}
+	})
+
+	t.Run("Unknown_Context_And_Stale", func(t *testing.T) {
+		tracker := NewGPUActivityTracker()
+		if _, ok := tracker.Usage(at(0)); ok {
+			t.Error("Expected no measurements before any event")
+		}
+		tracker.PacketStarted(0xF, 1, at(0))
+		tracker.PacketCompleted(0xF, 1, at(100))
+		tracker.Usage(at(100))
+		usage, ok := tracker.Usage(at(1200))
+		if !ok || len(usage) != 0 {
+			t.Errorf("Expected empty usage for unknown context, got %v (ok=%v)", usage, ok)
+		}
+		if _, ok := tracker.Usage(at(100 + 6000)); ok {
+			t.Error("Expected measurements to be stale without events")
+		}
+	})
+
+	t.Run("Classify_Events", func(t *testing.T) {
+		cases := map[[2]string]int{
+			{"Context", "DCStart"}:  gpuEventContextStart,
+			{"Context", "Stop"}:     gpuEventContextStop,
+			{"DmaPacket", "Start"}:  gpuEventPacketStart,
+			{"DmaPacket", "Info"}:   gpuEventPacketComplete,
+			{"QueuePacket", "Info"}: gpuEventIgnored,
+		}
+		for names, want := range cases {
+			if got := classifyDxgKrnlEvent(names[0], names[1]); got != want {
+				t.Errorf("%s/%s: expected %d, got %d", names[0], names[1], want, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,387 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"HWnow-wails/internal/winapi"
+)
+
+// 하이브리드 그래픽 (Optimus / AMD Switchable) 및 eGPU 인식
+// 감지된 벤더 하나만 보는 GetGPUInfo와 달리 시스템의 모든 하드웨어 어댑터를 동시에 모니터링
+// Windows: DXGI로 어댑터 열거 + "GPU Engine" / "GPU Adapter Memory" 카운터를 LUID로 어댑터에 매핑
+// Linux: /sys/class/drm/card* (gpu_busy_percent, mem_info_vram_*는 amdgpu만 제공)
+
+const linuxDRMPath = "/sys/class/drm"
+
+// 이 이하의 전용 메모리를 가진 어댑터는 내장 GPU로 간주 (BIOS가 예약한 UMA 프레임 버퍼)
+const integratedGPUMaxDedicatedMB = 512
+
+// GPUAdapter represents one hardware graphics adapter with its current utilization
+type GPUAdapter struct {
+	Index             int     `json:"index"`
+	ID                string  `json:"id"` // Windows: 어댑터 LUID (성능 카운터 인스턴스 형식), Linux: DRM 카드 이름
+	Name              string  `json:"name"`
+	Vendor            string  `json:"vendor"`              // NVIDIA, AMD, Intel
+	Integrated        bool    `json:"integrated"`          // CPU 내장 GPU (iGPU)
+	External          bool    `json:"external"`            // Thunderbolt 등으로 연결된 외장 GPU (eGPU, Linux만 감지)
+	DedicatedMemoryMB float64 `json:"dedicated_memory_mb"` // 전용 VRAM 용량 (MB)
+	Usage             float64 `json:"usage"`               // 가장 바쁜 엔진의 사용률 (%, -1 = 알 수 없음)
+	DedicatedUsedMB   float64 `json:"dedicated_used_mb"`   // 사용 중인 전용 메모리 (MB, -1 = 알 수 없음)
+	SharedUsedMB      float64 `json:"shared_used_mb"`      // 사용 중인 공유 시스템 메모리 (MB, -1 = 알 수 없음)
+}
+
+// GPUAdapterCache caches the enumerated adapter list (hot-plug events invalidate it)
+type GPUAdapterCache struct {
+	mutex     sync.Mutex
+	adapters  []GPUAdapter
+	timestamp time.Time
+}
+
+const GPU_ADAPTER_CACHE_DURATION = 5 * time.Minute
+
+var gpuAdapterCache = &GPUAdapterCache{}
+
+// Arc A/B 시리즈만 외장 GPU (Meteor Lake 이후 내장 GPU 이름도 "Arc Graphics")
+var intelDiscreteArcPattern = regexp.MustCompile(`arc(\(tm\))? [ab]\d`)
+
+// APU 내장 GPU는 "Radeon(TM) Graphics" / "Radeon 780M Graphics" 형태
+var amdIntegratedRadeonPattern = regexp.MustCompile(`radeon(\(tm\))?( \d{3}m)? graphics$`)
+
+// GetGPUAdapters returns every hardware GPU with live utilization and memory usage
+func GetGPUAdapters(ctx context.Context) ([]GPUAdapter, error) {
+	return queryWithContext(ctx, "GetGPUAdapters", getGPUAdapters)
+}
+
+func getGPUAdapters() ([]GPUAdapter, error) {
+	adapters, err := getGPUAdapterList()
+	if err != nil {
+		return nil, err
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		if sample, err := getGPUEngineSample(); err == nil {
+			applyGPUEngineSampleToAdapters(adapters, sample)
+		} else {
+			LogDebug("GPU adapter usage not available", "error", err)
+		}
+	case "linux":
+		for i := range adapters {
+			readLinuxGPUAdapterUsage(&adapters[i])
+		}
+	}
+	return adapters, nil
+}
+
+// IsHybridGraphics reports whether the system has both an integrated and a discrete GPU
+func IsHybridGraphics(adapters []GPUAdapter) bool {
+	hasIntegrated, hasDiscrete := false, false
+	for _, adapter := range adapters {
+		if adapter.Integrated {
+			hasIntegrated = true
+		} else {
+			hasDiscrete = true
+		}
+	}
+	return hasIntegrated && hasDiscrete
+}
+
+// GPUAdapterMetrics converts per-adapter readings into gpu_adapter_N_* metrics
+// 어댑터가 하나뿐이면 gpu_usage / gpu_memory_used와 중복되므로 기록하지 않음
+func GPUAdapterMetrics(adapters []GPUAdapter) []Metric {
+	if len(adapters) < 2 {
+		return nil
+	}
+	var metrics []Metric
+	for _, adapter := range adapters {
+		prefix := fmt.Sprintf("gpu_adapter_%d_", adapter.Index)
+		if adapter.Usage >= 0 {
+			metrics = append(metrics, Metric{Type: prefix + "usage", Value: adapter.Usage, Info: adapter.Name})
+		}
+		if used := adapterMemoryUsed(adapter); used >= 0 {
+			metrics = append(metrics, Metric{Type: prefix + "memory_used", Value: used, Info: adapter.Name})
+		}
+	}
+	return metrics
+}
+
+// adapterMemoryUsed returns the memory an adapter uses (내장 GPU는 공유 메모리가 주 메모리)
+func adapterMemoryUsed(adapter GPUAdapter) float64 {
+	if adapter.DedicatedUsedMB < 0 {
+		return -1
+	}
+	if adapter.Integrated && adapter.SharedUsedMB >= 0 {
+		return adapter.DedicatedUsedMB + adapter.SharedUsedMB
+	}
+	return adapter.DedicatedUsedMB
+}
+
+// getGPUAdapterList returns a copy of the cached adapter list with live values reset to unknown
+func getGPUAdapterList() ([]GPUAdapter, error) {
+	gpuAdapterCache.mutex.Lock()
+	defer gpuAdapterCache.mutex.Unlock()
+
+	if gpuAdapterCache.adapters == nil || time.Since(gpuAdapterCache.timestamp) >= GPU_ADAPTER_CACHE_DURATION {
+		var adapters []GPUAdapter
+		var err error
+		switch runtime.GOOS {
+		case "windows":
+			adapters, err = enumerateDXGIAdapters()
+		case "linux":
+			adapters, err = enumerateLinuxDRMAdapters()
+		default:
+			err = fmt.Errorf("GPU adapter enumeration not supported on platform: %s", runtime.GOOS)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(adapters) == 0 {
+			return nil, fmt.Errorf("no hardware GPU adapters found")
+		}
+		gpuAdapterCache.adapters = adapters
+		gpuAdapterCache.timestamp = time.Now()
+		LogInfo("GPU adapters enumerated", "count", len(adapters), "hybrid", IsHybridGraphics(adapters))
+	}
+
+	adapters := make([]GPUAdapter, len(gpuAdapterCache.adapters))
+	copy(adapters, gpuAdapterCache.adapters)
+	for i := range adapters {
+		adapters[i].Usage = -1
+		adapters[i].DedicatedUsedMB = -1
+		adapters[i].SharedUsedMB = -1
+	}
+	return adapters, nil
+}
+
+// resetGPUAdapterCache forces the adapter list to be enumerated again
+func resetGPUAdapterCache() {
+	gpuAdapterCache.mutex.Lock()
+	gpuAdapterCache.adapters = nil
+	gpuAdapterCache.timestamp = time.Time{}
+	gpuAdapterCache.mutex.Unlock()
+}
+
+// applyGPUEngineSampleToAdapters fills adapter usage and memory from a GPU Engine counter sample
+func applyGPUEngineSampleToAdapters(adapters []GPUAdapter, sample *gpuEngineSample) {
+	for i := range adapters {
+		id := adapters[i].ID
+		if usage, ok := sample.adapterUsage[id]; ok {
+			adapters[i].Usage = usage
+		} else if len(sample.adapterUsage) > 0 {
+			// 카운터 인스턴스가 없는 어댑터는 실행 중인 작업이 없는 상태
+			adapters[i].Usage = 0
+		}
+		if used, ok := sample.dedicatedUsed[id]; ok {
+			adapters[i].DedicatedUsedMB = used
+		}
+		if used, ok := sample.sharedUsed[id]; ok {
+			adapters[i].SharedUsedMB = used
+		}
+	}
+}
+
+// assignGPUProcessAdapters tags each process with the adapter it is busiest on and, on hybrid
+// systems, appends processes that only run on another adapter (e.g. the iGPU on Optimus laptops)
+func assignGPUProcessAdapters(processes []GPUProcess, adapters []GPUAdapter, processUsage map[int32]map[string]float64, names map[int32]string) []GPUProcess {
+	byID := make(map[string]GPUAdapter, len(adapters))
+	for _, adapter := range adapters {
+		byID[adapter.ID] = adapter
+	}
+
+	listed := make(map[int32]bool, len(processes))
+	for i := range processes {
+		listed[processes[i].PID] = true
+		if id, _ := busiestGPUAdapter(processUsage[processes[i].PID]); id != "" {
+			if adapter, ok := byID[id]; ok {
+				processes[i].AdapterID = adapter.ID
+				processes[i].Adapter = adapter.Name
+			}
+		}
+	}
+
+	if !IsHybridGraphics(adapters) {
+		return processes
+	}
+
+	pids := make([]int32, 0, len(processUsage))
+	for pid := range processUsage {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+
+	for _, pid := range pids {
+		if listed[pid] || pid == 0 {
+			continue
+		}
+		id, usage := busiestGPUAdapter(processUsage[pid])
+		adapter, ok := byID[id]
+		// GPU 컨텍스트만 가진 유휴 프로세스는 제외 (대부분의 GUI 프로세스가 컨텍스트를 가짐)
+		if !ok || usage <= 0 {
+			continue
+		}
+		name := names[pid]
+		if name == "" {
+			name = fmt.Sprintf("PID %d", pid)
+		}
+		processes = append(processes, GPUProcess{
+			PID:         pid,
+			Name:        name,
+			GPUUsage:    usage,
+			GPUMemory:   0,
+			Type:        "G",
+			Status:      "running",
+			UsageSource: GPUUsageSourcePerfCounter,
+			AdapterID:   adapter.ID,
+			Adapter:     adapter.Name,
+		})
+	}
+	return processes
+}
+
+// busiestGPUAdapter returns the adapter ID with the highest usage (ties → 작은 ID)
+func busiestGPUAdapter(usage map[string]float64) (string, float64) {
+	bestID, bestUsage := "", -1.0
+	for id, value := range usage {
+		if value > bestUsage || (value == bestUsage && id < bestID) {
+			bestID, bestUsage = id, value
+		}
+	}
+	return bestID, bestUsage
+}
+
+// attachGPUProcessAdapters applies adapter assignment to the Windows GPU process list
+func attachGPUProcessAdapters(processes []GPUProcess) []GPUProcess {
+	adapters, err := getGPUAdapterList()
+	if err != nil {
+		LogDebug("GPU adapter list not available for process mapping", "error", err)
+		return processes
+	}
+	sample, err := getGPUEngineSample()
+	if err != nil {
+		LogDebug("GPU Engine counters not available for process mapping", "error", err)
+		return processes
+	}
+
+	var names map[int32]string
+	if IsHybridGraphics(adapters) {
+		if names, err = winapi.ProcessNames(); err != nil {
+			LogDebug("Process names not available for hybrid GPU processes", "error", err)
+		}
+	}
+	return assignGPUProcessAdapters(processes, adapters, sample.processUsage, names)
+}
+
+// gpuVendorFromPCIID maps a PCI vendor ID to a GPU vendor
+func gpuVendorFromPCIID(id uint32) GPUVendor {
+	switch id {
+	case 0x10DE:
+		return GPUVendorNVIDIA
+	case 0x1002, 0x1022:
+		return GPUVendorAMD
+	case 0x8086:
+		return GPUVendorIntel
+	default:
+		return GPUVendorUnknown
+	}
+}
+
+// isIntegratedGPU decides whether an adapter is an integrated GPU from its vendor, name and VRAM size
+func isIntegratedGPU(vendor GPUVendor, name string, dedicatedMB float64) bool {
+	if dedicatedMB > 0 && dedicatedMB <= integratedGPUMaxDedicatedMB {
+		return true
+	}
+	lower := strings.ToLower(name)
+	switch vendor {
+	case GPUVendorIntel:
+		return !intelDiscreteArcPattern.MatchString(lower)
+	case GPUVendorAMD:
+		return amdIntegratedRadeonPattern.MatchString(lower)
+	default:
+		return false
+	}
+}
+
+// formatAdapterLUID formats a LUID the way GPU performance counter instances do (소문자로 정규화)
+func formatAdapterLUID(high int32, low uint32) string {
+	return strings.ToLower(fmt.Sprintf("0x%08X_0x%08X", uint32(high), low))
+}
+
+// enumerateLinuxDRMAdapters lists GPUs exposed as /sys/class/drm/cardN
+func enumerateLinuxDRMAdapters() ([]GPUAdapter, error) {
+	entries, err := os.ReadDir(linuxDRMPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var adapters []GPUAdapter
+	for _, entry := range entries {
+		if !drmCardPattern.MatchString(entry.Name()) {
+			continue
+		}
+		device := filepath.Join(linuxDRMPath, entry.Name(), "device")
+		vendorID, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(readSysfsString(device, "vendor")), "0x"), 16, 32)
+		if err != nil {
+			continue
+		}
+		vendor := gpuVendorFromPCIID(uint32(vendorID))
+		if vendor == GPUVendorUnknown {
+			continue
+		}
+
+		driver := ""
+		if link, err := os.Readlink(filepath.Join(device, "driver")); err == nil {
+			driver = filepath.Base(link)
+		}
+		slot := ""
+		if link, err := os.Readlink(device); err == nil {
+			slot = filepath.Base(link)
+		}
+
+		adapter := GPUAdapter{
+			ID:       entry.Name(),
+			Name:     strings.TrimSpace(fmt.Sprintf("%s GPU %s", vendor.String(), slot)),
+			Vendor:   vendor.String(),
+			External: strings.TrimSpace(readSysfsString(device, "removable")) == "removable",
+		}
+		if vram, ok := readSysfsFloat(device, "mem_info_vram_total"); ok {
+			adapter.DedicatedMemoryMB = vram / 1024 / 1024
+		}
+		// i915/xe는 VRAM 정보를 노출하지 않으므로 버스 0번의 Intel GPU를 내장 GPU로 간주
+		adapter.Integrated = isIntegratedGPU(vendor, "", adapter.DedicatedMemoryMB) &&
+			(vendor != GPUVendorIntel || strings.HasPrefix(slot, "0000:00:"))
+		if driver != "" {
+			adapter.Name += " (" + driver + ")"
+		}
+		adapters = append(adapters, adapter)
+	}
+
+	sort.Slice(adapters, func(i, j int) bool { return adapters[i].ID < adapters[j].ID })
+	for i := range adapters {
+		adapters[i].Index = i
+	}
+	return adapters, nil
+}
+
+// readLinuxGPUAdapterUsage reads amdgpu busy percentage and VRAM usage for a DRM card
+func readLinuxGPUAdapterUsage(adapter *GPUAdapter) {
+	device := filepath.Join(linuxDRMPath, adapter.ID, "device")
+	if busy, ok := readSysfsFloat(device, "gpu_busy_percent"); ok {
+		adapter.Usage = busy
+	}
+	if used, ok := readSysfsFloat(device, "mem_info_vram_used"); ok {
+		adapter.DedicatedUsedMB = used / 1024 / 1024
+	}
+	if used, ok := readSysfsFloat(device, "mem_info_gtt_used"); ok {
+		adapter.SharedUsedMB = used / 1024 / 1024
+	}
+}
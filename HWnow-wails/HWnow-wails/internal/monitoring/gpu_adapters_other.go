@@ -0,0 +1,13 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// enumerateDXGIAdapters is only available on Windows (DXGI)
+func enumerateDXGIAdapters() ([]GPUAdapter, error) {
+	return nil, fmt.Errorf("DXGI adapter enumeration not supported on platform: %s", runtime.GOOS)
+}
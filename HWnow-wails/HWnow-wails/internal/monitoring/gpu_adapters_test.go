@@ -0,0 +1,113 @@
+package monitoring
+
+import "testing"
+
+func TestGPUAdapters(t *testing.T) {
+	t.Run("Detect_Integrated_GPU", func(t *testing.T) {
+		cases := []struct {
+			vendor      GPUVendor
+			name        string
+			dedicatedMB float64
+			want        bool
+		}{
+			{GPUVendorIntel, "Intel(R) UHD Graphics 770", 128, true},
+			{GPUVendorIntel, "Intel(R) Arc(TM) Graphics", 0, true},
+			{GPUVendorIntel, "Intel(R) Arc(TM) A770 Graphics", 16288, false},
+			{GPUVendorAMD, "AMD Radeon(TM) Graphics", 2048, true},
+			{GPUVendorAMD, "AMD Radeon 780M Graphics", 4096, true},
+			{GPUVendorAMD, "AMD Radeon RX 7900 XTX", 24560, false},
+			{GPUVendorNVIDIA, "NVIDIA GeForce RTX 4070 Laptop GPU", 8188, false},
+		}
+		for _, c := range cases {
+			if got := isIntegratedGPU(c.vendor, c.name, c.dedicatedMB); got != c.want {
+				t.Errorf("isIntegratedGPU(%s, %q, %.0f) = %v, want %v", c.vendor, c.name, c.dedicatedMB, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Format_LUID", func(t *testing.T) {
+		if got := formatAdapterLUID(0, 0xC8E2); got != "0x00000000_0x0000c8e2" {
+			t.Errorf("formatAdapterLUID = %q", got)
+		}
+	})
+
+	t.Run("Parse_Adapter_Sample", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\GPU Engine(pid_100_luid_0x00000000_0x0000C8E2_phys_0_eng_0_engtype_3D)\Utilization Percentage","\\PC\GPU Engine(pid_200_luid_0x00000000_0x0000D1B7_phys_0_eng_0_engtype_3D)\Utilization Percentage","\\PC\GPU Engine(pid_200_luid_0x00000000_0x0000C8E2_phys_0_eng_0_engtype_3D)\Utilization Percentage","\\PC\GPU Adapter Memory(luid_0x00000000_0x0000C8E2_phys_0)\Dedicated Usage","\\PC\GPU Adapter Memory(luid_0x00000000_0x0000D1B7_phys_0)\Shared Usage"
+"10/16/2026 12:00:00.000","30.0","45.5","5.0","1073741824","536870912"
+`)
+		sample, err := parseGPUEngineSample(output)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if usage := sample.adapterUsage["0x00000000_0x0000c8e2"]; usage != 35 {
+			t.Errorf("dGPU usage = %v, want 35", usage)
+		}
+		if usage := sample.adapterUsage["0x00000000_0x0000d1b7"]; usage != 45.5 {
+			t.Errorf("iGPU usage = %v, want 45.5", usage)
+		}
+		if used := sample.dedicatedUsed["0x00000000_0x0000c8e2"]; used != 1024 {
+			t.Errorf("dedicated used = %v, want 1024", used)
+		}
+		if used := sample.sharedUsed["0x00000000_0x0000d1b7"]; used != 512 {
+			t.Errorf("shared used = %v, want 512", used)
+		}
+		if id, usage := busiestGPUAdapter(sample.processUsage[200]); id != "0x00000000_0x0000d1b7" || usage != 45.5 {
+			t.Errorf("busiest adapter of pid 200 = %s (%v)", id, usage)
+		}
+	})
+
+	t.Run("Assign_Process_Adapters", func(t *testing.T) {
+		adapters := []GPUAdapter{
+			{Index: 0, ID: "dgpu", Name: "NVIDIA GeForce RTX 4070 Laptop GPU", Vendor: "NVIDIA"},
+			{Index: 1, ID: "igpu", Name: "Intel(R) UHD Graphics", Vendor: "Intel", Integrated: true},
+		}
+		processUsage := map[int32]map[string]float64{
+			100: {"dgpu": 60},
+			200: {"igpu": 12},
+			300: {"igpu": 0},
+		}
+		processes := []GPUProcess{{PID: 100, Name: "game.exe"}}
+		names := map[int32]string{200: "chrome.exe", 300: "explorer.exe"}
+
+		result := assignGPUProcessAdapters(processes, adapters, processUsage, names)
+		if len(result) != 2 {
+			t.Fatalf("Expected idle iGPU process to be skipped, got %+v", result)
+		}
+		if result[0].Adapter != adapters[0].Name || result[0].AdapterID != "dgpu" {
+			t.Errorf("Listed process adapter = %q", result[0].Adapter)
+		}
+		if added := result[1]; added.PID != 200 || added.Name != "chrome.exe" || added.AdapterID != "igpu" || added.UsageSource != GPUUsageSourcePerfCounter {
+			t.Errorf("Unexpected iGPU process: %+v", added)
+		}
+
+		single := assignGPUProcessAdapters([]GPUProcess{{PID: 100}}, adapters[:1], processUsage, names)
+		if len(single) != 1 {
+			t.Errorf("Non-hybrid systems should not add processes, got %+v", single)
+		}
+	})
+
+	t.Run("Metrics_Require_Multiple_Adapters", func(t *testing.T) {
+		single := []GPUAdapter{{Index: 0, Usage: 10, DedicatedUsedMB: 100, SharedUsedMB: 10}}
+		if metrics := GPUAdapterMetrics(single); metrics != nil {
+			t.Errorf("Expected no metrics for a single adapter, got %v", metrics)
+		}
+
+		hybrid := []GPUAdapter{
+			{Index: 0, Name: "dGPU", Usage: 50, DedicatedUsedMB: 2048, SharedUsedMB: 64},
+			{Index: 1, Name: "iGPU", Integrated: true, Usage: -1, DedicatedUsedMB: 0, SharedUsedMB: 700},
+		}
+		values := make(map[string]float64)
+		for _, metric := range GPUAdapterMetrics(hybrid) {
+			values[metric.Type] = metric.Value
+		}
+		if values["gpu_adapter_0_usage"] != 50 || values["gpu_adapter_0_memory_used"] != 2048 {
+			t.Errorf("Unexpected dGPU metrics: %v", values)
+		}
+		if _, ok := values["gpu_adapter_1_usage"]; ok {
+			t.Error("Unknown usage should be skipped")
+		}
+		if values["gpu_adapter_1_memory_used"] != 700 {
+			t.Errorf("iGPU memory should include shared usage: %v", values)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// DXGI COM 인터페이스 (vtable 인덱스는 dxgi.h 선언 순서)
+const (
+	dxgiReleaseIndex        = 2
+	dxgiEnumAdapters1Index  = 12
+	dxgiAdapterGetDesc1     = 10
+	dxgiErrorNotFound       = 0x887A0002
+	dxgiAdapterFlagSoftware = 0x2
+	dxgiMicrosoftVendorID   = 0x1414 // Microsoft Basic Render Driver / WARP
+)
+
+// IID_IDXGIFactory1 {770aae78-f26f-4dba-a829-253c83d1b387}
+var iidIDXGIFactory1 = struct {
+	data1 uint32
+	data2 uint16
+	data3 uint16
+	data4 [8]byte
+}{0x770aae78, 0xf26f, 0x4dba, [8]byte{0xa8, 0x29, 0x25, 0x3c, 0x83, 0xd1, 0xb3, 0x87}}
+
+// dxgiObject is the in-memory layout of a COM interface pointer
+type dxgiObject struct {
+	vtbl *[16]uintptr
+}
+
+// dxgiAdapterDesc1 mirrors DXGI_ADAPTER_DESC1
+type dxgiAdapterDesc1 struct {
+	description           [128]uint16
+	vendorID              uint32
+	deviceID              uint32
+	subSysID              uint32
+	revision              uint32
+	dedicatedVideoMemory  uintptr
+	dedicatedSystemMemory uintptr
+	sharedSystemMemory    uintptr
+	luidLow               uint32
+	luidHigh              int32
+	flags                 uint32
+}
+
+func (o *dxgiObject) release() {
+	syscall.SyscallN(o.vtbl[dxgiReleaseIndex], uintptr(unsafe.Pointer(o)))
+}
+
+// enumerateDXGIAdapters lists hardware adapters through IDXGIFactory1::EnumAdapters1
+func enumerateDXGIAdapters() ([]GPUAdapter, error) {
+	createFactory := syscall.NewLazyDLL("dxgi.dll").NewProc("CreateDXGIFactory1")
+	if err := createFactory.Find(); err != nil {
+		return nil, fmt.Errorf("DXGI not available: %v", err)
+	}
+
+	var factory *dxgiObject
+	hr, _, _ := createFactory.Call(uintptr(unsafe.Pointer(&iidIDXGIFactory1)), uintptr(unsafe.Pointer(&factory)))
+	if int32(hr) < 0 || factory == nil {
+		return nil, fmt.Errorf("CreateDXGIFactory1 failed: 0x%08X", uint32(hr))
+	}
+	defer factory.release()
+
+	var adapters []GPUAdapter
+	for i := uintptr(0); ; i++ {
+		var adapter *dxgiObject
+		hr, _, _ := syscall.SyscallN(factory.vtbl[dxgiEnumAdapters1Index], uintptr(unsafe.Pointer(factory)), i, uintptr(unsafe.Pointer(&adapter)))
+		if uint32(hr) == dxgiErrorNotFound {
+			break
+		}
+		if int32(hr) < 0 || adapter == nil {
+			return adapters, fmt.Errorf("EnumAdapters1 failed: 0x%08X", uint32(hr))
+		}
+
+		var desc dxgiAdapterDesc1
+		hr, _, _ = syscall.SyscallN(adapter.vtbl[dxgiAdapterGetDesc1], uintptr(unsafe.Pointer(adapter)), uintptr(unsafe.Pointer(&desc)))
+		adapter.release()
+		if int32(hr) < 0 || desc.flags&dxgiAdapterFlagSoftware != 0 || desc.vendorID == dxgiMicrosoftVendorID {
+			continue
+		}
+
+		vendor := gpuVendorFromPCIID(desc.vendorID)
+		name := strings.TrimSpace(syscall.UTF16ToString(desc.description[:]))
+		dedicatedMB := float64(desc.dedicatedVideoMemory) / 1024 / 1024
+		adapters = append(adapters, GPUAdapter{
+			Index:             len(adapters),
+			ID:                formatAdapterLUID(desc.luidHigh, desc.luidLow),
+			Name:              name,
+			Vendor:            vendor.String(),
+			Integrated:        isIntegratedGPU(vendor, name, dedicatedMB),
+			DedicatedMemoryMB: dedicatedMB,
+		})
+	}
+	return adapters, nil
+}
@@ -0,0 +1,160 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NVIDIA GPU 메모리 컨트롤러 / NVENC / NVDEC 사용률 및 PCIe 송수신 처리량 (nvidia-smi dmon, 내부적으로 NVML 사용)
+// 스트리밍/트랜스코딩처럼 코어는 한가하지만 인코더나 메모리 대역폭이 포화되는 부하를 확인하기 위함
+// dmon 샘플 한 번에 약 1초가 걸리므로 결과를 캐시
+
+const (
+	GPU_BANDWIDTH_CACHE_DURATION       = 5 * time.Second
+	GPU_BANDWIDTH_ERROR_CACHE_DURATION = 60 * time.Second // nvidia-smi가 없거나 dmon을 지원하지 않는 경우 재시도 간격
+)
+
+// GPUBandwidth describes memory controller, video engine and PCIe activity of an NVIDIA GPU
+type GPUBandwidth struct {
+	Index                   int     `json:"index"`
+	MemoryControllerPercent float64 `json:"memory_controller_percent"` // 메모리 컨트롤러 사용률 (%, -1 = 지원 안 함)
+	EncoderPercent          float64 `json:"encoder_percent"`           // NVENC 사용률 (%, -1 = 지원 안 함)
+	DecoderPercent          float64 `json:"decoder_percent"`           // NVDEC 사용률 (%, -1 = 지원 안 함)
+	PCIeRxMBps              float64 `json:"pcie_rx_mbps"`              // 호스트 → GPU PCIe 처리량 (MB/s, -1 = 지원 안 함)
+	PCIeTxMBps              float64 `json:"pcie_tx_mbps"`              // GPU → 호스트 PCIe 처리량 (MB/s, -1 = 지원 안 함)
+}
+
+// GPUBandwidthCache caches the last dmon sample (or the last failure)
+type GPUBandwidthCache struct {
+	mutex     sync.Mutex
+	samples   []GPUBandwidth
+	err       error
+	timestamp time.Time
+}
+
+var gpuBandwidthCache = &GPUBandwidthCache{}
+
+// GetGPUBandwidth returns memory controller, NVENC/NVDEC and PCIe activity of every NVIDIA GPU
+func GetGPUBandwidth(ctx context.Context) ([]GPUBandwidth, error) {
+	return queryWithContext(ctx, "GetGPUBandwidth", getGPUBandwidth)
+}
+
+func getGPUBandwidth() ([]GPUBandwidth, error) {
+	gpuBandwidthCache.mutex.Lock()
+	defer gpuBandwidthCache.mutex.Unlock()
+
+	age := time.Since(gpuBandwidthCache.timestamp)
+	if gpuBandwidthCache.err != nil && age < GPU_BANDWIDTH_ERROR_CACHE_DURATION {
+		return nil, gpuBandwidthCache.err
+	}
+	if gpuBandwidthCache.err == nil && gpuBandwidthCache.samples != nil && age < GPU_BANDWIDTH_CACHE_DURATION {
+		return gpuBandwidthCache.samples, nil
+	}
+
+	samples, err := queryGPUBandwidth()
+	gpuBandwidthCache.samples = samples
+	gpuBandwidthCache.err = err
+	gpuBandwidthCache.timestamp = time.Now()
+	return samples, err
+}
+
+// queryGPUBandwidth runs one nvidia-smi dmon sample of the utilization (u) and PCIe throughput (t) groups
+func queryGPUBandwidth() ([]GPUBandwidth, error) {
+	nvidiaSMIPath := getCachedNVIDIASMIPath()
+	if nvidiaSMIPath == "" {
+		return nil, fmt.Errorf("nvidia-smi not found")
+	}
+
+	output, err := createHiddenCommandWithTimeout(nvidiaSMIPath, 5, "dmon", "-c", "1", "-s", "ut").Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi dmon failed: %v", err)
+	}
+
+	samples := parseGPUBandwidth(string(output))
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no NVIDIA GPUs reported by dmon")
+	}
+	return samples, nil
+}
+
+// parseGPUBandwidth parses nvidia-smi dmon output, locating columns by the header
+// (드라이버 버전에 따라 jpg/ofa 열 유무가 다름)
+//
+//	# gpu    sm   mem   enc   dec   jpg   ofa  rxpci  txpci
+//	# Idx     %     %     %     %     %     %   MB/s   MB/s
+//	    0     3     1     0     0     0     0     12      5
+func parseGPUBandwidth(output string) []GPUBandwidth {
+	var columns map[string]int
+	var samples []GPUBandwidth
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "#" {
+			// 첫 번째 헤더 줄(열 이름)만 사용, 단위 줄은 무시
+			if columns == nil && len(fields) > 1 && fields[1] == "gpu" {
+				columns = make(map[string]int)
+				for i, name := range fields[1:] {
+					columns[name] = i
+				}
+			}
+			continue
+		}
+		if columns == nil {
+			continue
+		}
+
+		index, err := strconv.Atoi(fields[columns["gpu"]])
+		if err != nil {
+			continue
+		}
+		value := func(name string) float64 {
+			column, ok := columns[name]
+			if !ok || column >= len(fields) {
+				return -1
+			}
+			n, err := strconv.ParseFloat(fields[column], 64)
+			if err != nil {
+				return -1 // "-" = 지원 안 함
+			}
+			return n
+		}
+		samples = append(samples, GPUBandwidth{
+			Index:                   index,
+			MemoryControllerPercent: value("mem"),
+			EncoderPercent:          value("enc"),
+			DecoderPercent:          value("dec"),
+			PCIeRxMBps:              value("rxpci"),
+			PCIeTxMBps:              value("txpci"),
+		})
+	}
+	return samples
+}
+
+// GPUBandwidthMetrics converts the first GPU's activity into resource log metrics (unsupported values are skipped)
+// PCIe 처리량은 다른 처리량 메트릭과 같이 bytes/s로 기록
+func GPUBandwidthMetrics(samples []GPUBandwidth) []Metric {
+	if len(samples) == 0 {
+		return nil
+	}
+	gpu := samples[0]
+
+	var metrics []Metric
+	add := func(metricType string, value, scale float64) {
+		if value >= 0 {
+			metrics = append(metrics, Metric{Type: metricType, Value: value * scale})
+		}
+	}
+	add("gpu_memory_controller_percent", gpu.MemoryControllerPercent, 1)
+	add("gpu_encoder_percent", gpu.EncoderPercent, 1)
+	add("gpu_decoder_percent", gpu.DecoderPercent, 1)
+	add("gpu_pcie_rx_bytes_per_sec", gpu.PCIeRxMBps, 1024*1024)
+	add("gpu_pcie_tx_bytes_per_sec", gpu.PCIeTxMBps, 1024*1024)
+	return metrics
+}
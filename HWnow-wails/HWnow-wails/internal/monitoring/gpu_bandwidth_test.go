@@ -0,0 +1,52 @@
+package monitoring
+
+import "testing"
+
+func TestParseGPUBandwidth(t *testing.T) {
+	t.Run("Current_Driver", func(t *testing.T) {
+		output := `# gpu    sm   mem   enc   dec   jpg   ofa  rxpci  txpci
+# Idx     %     %     %     %     %     %   MB/s   MB/s
+    0     3    41    87    12     0     0    250     40
+    1     -     -     -     -     -     -      -      -
+`
+		samples := parseGPUBandwidth(output)
+		if len(samples) != 2 {
+			t.Fatalf("Expected 2 GPUs, got %d", len(samples))
+		}
+		gpu := samples[0]
+		if gpu.MemoryControllerPercent != 41 || gpu.EncoderPercent != 87 || gpu.DecoderPercent != 12 || gpu.PCIeRxMBps != 250 || gpu.PCIeTxMBps != 40 {
+			t.Errorf("Unexpected sample: %+v", gpu)
+		}
+		if samples[1].Index != 1 || samples[1].EncoderPercent != -1 || samples[1].PCIeRxMBps != -1 {
+			t.Errorf("Expected unsupported values as -1, got %+v", samples[1])
+		}
+	})
+
+	t.Run("Older_Driver_Without_JPEG_Columns", func(t *testing.T) {
+		output := `# gpu   sm   mem   enc   dec  rxpci  txpci
+# Idx    %     %     %     %   MB/s   MB/s
+    0   10     5     0    60     8      2
+`
+		samples := parseGPUBandwidth(output)
+		if len(samples) != 1 || samples[0].DecoderPercent != 60 || samples[0].PCIeRxMBps != 8 {
+			t.Errorf("Unexpected samples: %+v", samples)
+		}
+	})
+
+	t.Run("No_Header", func(t *testing.T) {
+		if samples := parseGPUBandwidth("No devices were found\n"); len(samples) != 0 {
+			t.Errorf("Expected no samples, got %+v", samples)
+		}
+	})
+}
+
+func TestGPUBandwidthMetrics(t *testing.T) {
+	metrics := GPUBandwidthMetrics([]GPUBandwidth{{MemoryControllerPercent: 41, EncoderPercent: 87, DecoderPercent: -1, PCIeRxMBps: 2, PCIeTxMBps: -1}})
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		values[metric.Type] = metric.Value
+	}
+	if len(metrics) != 3 || values["gpu_encoder_percent"] != 87 || values["gpu_pcie_rx_bytes_per_sec"] != 2*1024*1024 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+}
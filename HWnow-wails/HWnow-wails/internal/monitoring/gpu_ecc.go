@@ -0,0 +1,264 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NVIDIA 데이터센터/워크스테이션 GPU의 ECC 오류 수와 폐기(retired)/재매핑(remapped) 메모리 페이지 (nvidia-smi, 내부적으로 NVML 사용)
+// 카운터가 증가하면 VRAM 고장의 조기 경고로 알림
+// - 폐기 페이지: Kepler ~ Turing, 행 재매핑: Ampere 이후 (지원하지 않는 GPU는 -1)
+// ECC 카운터는 자주 변하지 않으므로 결과를 길게 캐시
+
+const (
+	GPU_ECC_CACHE_DURATION       = 60 * time.Second
+	GPU_ECC_ERROR_CACHE_DURATION = 5 * time.Minute // nvidia-smi가 없거나 ECC 조회를 지원하지 않는 경우 재시도 간격
+
+	GPUECCSeverityWarning  = "warning"  // 정정 가능 오류 증가
+	GPUECCSeverityCritical = "critical" // 정정 불가 오류, 페이지 폐기, 재매핑 실패
+)
+
+const gpuECCQueryFields = "index,pci.bus_id,ecc.mode.current," +
+	"ecc.errors.corrected.volatile.total,ecc.errors.uncorrected.volatile.total," +
+	"ecc.errors.corrected.aggregate.total,ecc.errors.uncorrected.aggregate.total," +
+	"retired_pages.single_bit_ecc.count,retired_pages.double_bit.count,retired_pages.pending"
+
+// GPUECCStatus describes ECC error counters and retired/remapped memory of an NVIDIA GPU (-1 = not supported)
+type GPUECCStatus struct {
+	Index                 int    `json:"index"`
+	BusID                 string `json:"bus_id"`
+	ECCEnabled            bool   `json:"ecc_enabled"`
+	CorrectedVolatile     int64  `json:"corrected_volatile"`     // 드라이버 로드 이후 정정된 오류
+	UncorrectedVolatile   int64  `json:"uncorrected_volatile"`   // 드라이버 로드 이후 정정 불가 오류
+	CorrectedAggregate    int64  `json:"corrected_aggregate"`    // 누적 정정 오류 (InfoROM)
+	UncorrectedAggregate  int64  `json:"uncorrected_aggregate"`  // 누적 정정 불가 오류 (InfoROM)
+	RetiredPagesSBE       int64  `json:"retired_pages_sbe"`      // 단일 비트 오류로 폐기된 페이지
+	RetiredPagesDBE       int64  `json:"retired_pages_dbe"`      // 이중 비트 오류로 폐기된 페이지
+	RetiredPending        bool   `json:"retired_pending"`        // 재부팅 후 폐기될 페이지 있음
+	RemappedCorrectable   int64  `json:"remapped_correctable"`   // 정정 오류로 재매핑된 행
+	RemappedUncorrectable int64  `json:"remapped_uncorrectable"` // 정정 불가 오류로 재매핑된 행
+	RemapPending          bool   `json:"remap_pending"`          // GPU 리셋 후 재매핑될 행 있음
+	RemapFailure          bool   `json:"remap_failure"`          // 재매핑 실패 (예비 행 소진)
+}
+
+// GPUECCAlert reports an increased ECC/retired page counter of a GPU
+type GPUECCAlert struct {
+	Index     int       `json:"index"`
+	Counter   string    `json:"counter"` // uncorrected_aggregate, retired_pages_dbe, remap_failure 등 (GPUECCStatus JSON 필드명)
+	Previous  int64     `json:"previous"`
+	Current   int64     `json:"current"`
+	Severity  string    `json:"severity"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// GPUECCCache caches the last ECC query (or the last failure)
+type GPUECCCache struct {
+	mutex     sync.Mutex
+	statuses  []GPUECCStatus
+	err       error
+	timestamp time.Time
+}
+
+var gpuECCCache = &GPUECCCache{}
+
+// GetGPUECCStatus returns ECC error counters and retired/remapped pages of every NVIDIA GPU
+func GetGPUECCStatus(ctx context.Context) ([]GPUECCStatus, error) {
+	return queryWithContext(ctx, "GetGPUECCStatus", getGPUECCStatus)
+}
+
+func getGPUECCStatus() ([]GPUECCStatus, error) {
+	gpuECCCache.mutex.Lock()
+	defer gpuECCCache.mutex.Unlock()
+
+	age := time.Since(gpuECCCache.timestamp)
+	if gpuECCCache.err != nil && age < GPU_ECC_ERROR_CACHE_DURATION {
+		return nil, gpuECCCache.err
+	}
+	if gpuECCCache.err == nil && gpuECCCache.statuses != nil && age < GPU_ECC_CACHE_DURATION {
+		return gpuECCCache.statuses, nil
+	}
+
+	statuses, err := queryGPUECC()
+	gpuECCCache.statuses = statuses
+	gpuECCCache.err = err
+	gpuECCCache.timestamp = time.Now()
+	return statuses, err
+}
+
+// queryGPUECC reads ECC counters and retired pages, then merges row remapping state (Ampere and later)
+func queryGPUECC() ([]GPUECCStatus, error) {
+	nvidiaSMIPath := getCachedNVIDIASMIPath()
+	if nvidiaSMIPath == "" {
+		return nil, fmt.Errorf("nvidia-smi not found")
+	}
+
+	output, err := createHiddenCommandWithTimeout(nvidiaSMIPath, 5, "--query-gpu="+gpuECCQueryFields, "--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi ECC query failed: %v", err)
+	}
+	statuses := parseGPUECC(string(output))
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("no NVIDIA GPUs reported ECC state")
+	}
+
+	// 행 재매핑은 지원하지 않는 드라이버/GPU에서 명령 자체가 실패하므로 실패해도 무시
+	remapOutput, err := createHiddenCommandWithTimeout(nvidiaSMIPath, 5,
+		"--query-remapped-rows=gpu_bus_id,remapped_rows.correctable,remapped_rows.uncorrectable,remapped_rows.pending,remapped_rows.failure",
+		"--format=csv,noheader,nounits").Output()
+	if err != nil {
+		LogDebug("nvidia-smi remapped rows query failed", "error", err)
+		return statuses, nil
+	}
+	mergeGPURemappedRows(statuses, string(remapOutput))
+	return statuses, nil
+}
+
+// parseGPUECC parses the --query-gpu ECC output (one line per GPU)
+func parseGPUECC(output string) []GPUECCStatus {
+	var statuses []GPUECCStatus
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 10 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		index, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		statuses = append(statuses, GPUECCStatus{
+			Index:                 index,
+			BusID:                 fields[1],
+			ECCEnabled:            fields[2] == "Enabled",
+			CorrectedVolatile:     nvidiaECCCount(fields[3]),
+			UncorrectedVolatile:   nvidiaECCCount(fields[4]),
+			CorrectedAggregate:    nvidiaECCCount(fields[5]),
+			UncorrectedAggregate:  nvidiaECCCount(fields[6]),
+			RetiredPagesSBE:       nvidiaECCCount(fields[7]),
+			RetiredPagesDBE:       nvidiaECCCount(fields[8]),
+			RetiredPending:        fields[9] == "Yes",
+			RemappedCorrectable:   -1,
+			RemappedUncorrectable: -1,
+		})
+	}
+	return statuses
+}
+
+// mergeGPURemappedRows fills row remapping counters from --query-remapped-rows output, matched by PCI bus ID
+func mergeGPURemappedRows(statuses []GPUECCStatus, output string) {
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 5 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		for i := range statuses {
+			if !strings.EqualFold(statuses[i].BusID, fields[0]) {
+				continue
+			}
+			statuses[i].RemappedCorrectable = nvidiaECCCount(fields[1])
+			statuses[i].RemappedUncorrectable = nvidiaECCCount(fields[2])
+			statuses[i].RemapPending = fields[3] == "1" || fields[3] == "Yes"
+			statuses[i].RemapFailure = fields[4] == "1" || fields[4] == "Yes"
+		}
+	}
+}
+
+// nvidia-smi는 지원되지 않는 카운터를 "[N/A]" 또는 "[Not Supported]"로 출력
+func nvidiaECCCount(value string) int64 {
+	n, err := strconv.ParseInt(nvidiaStaticField(value), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// GPUECCMonitor detects increasing ECC error and retired/remapped page counters
+type GPUECCMonitor struct {
+	mutex    sync.Mutex
+	previous map[int]GPUECCStatus
+}
+
+// NewGPUECCMonitor creates a monitor; the first observation of each GPU only records the baseline
+func NewGPUECCMonitor() *GPUECCMonitor {
+	return &GPUECCMonitor{previous: make(map[int]GPUECCStatus)}
+}
+
+// Check compares the statuses with the previous observation and returns one alert per increased counter
+func (m *GPUECCMonitor) Check(statuses []GPUECCStatus) []GPUECCAlert {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	var alerts []GPUECCAlert
+	for _, status := range statuses {
+		previous, ok := m.previous[status.Index]
+		m.previous[status.Index] = status
+		if !ok {
+			continue
+		}
+
+		check := func(counter string, before, after int64, severity string) {
+			// -1(지원 안 함)에서 값이 생긴 경우는 증가로 보지 않음
+			if before >= 0 && after > before {
+				alerts = append(alerts, GPUECCAlert{
+					Index: status.Index, Counter: counter, Previous: before, Current: after, Severity: severity, Timestamp: now,
+				})
+			}
+		}
+		// 드라이버 재로드 시 초기화되는 volatile 카운터 대신 누적(aggregate) 카운터 사용
+		check("corrected_aggregate", previous.CorrectedAggregate, status.CorrectedAggregate, GPUECCSeverityWarning)
+		check("uncorrected_aggregate", previous.UncorrectedAggregate, status.UncorrectedAggregate, GPUECCSeverityCritical)
+		check("retired_pages_sbe", previous.RetiredPagesSBE, status.RetiredPagesSBE, GPUECCSeverityCritical)
+		check("retired_pages_dbe", previous.RetiredPagesDBE, status.RetiredPagesDBE, GPUECCSeverityCritical)
+		check("remapped_correctable", previous.RemappedCorrectable, status.RemappedCorrectable, GPUECCSeverityWarning)
+		check("remapped_uncorrectable", previous.RemappedUncorrectable, status.RemappedUncorrectable, GPUECCSeverityCritical)
+		if status.RemapFailure && !previous.RemapFailure {
+			check("remap_failure", 0, 1, GPUECCSeverityCritical)
+		}
+	}
+	return alerts
+}
+
+// GPUECCMetrics converts ECC counters summed over all GPUs into resource log metrics (unsupported counters are skipped)
+func GPUECCMetrics(statuses []GPUECCStatus) []Metric {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	sums := map[string]int64{}
+	add := func(metricType string, value int64) {
+		if value < 0 {
+			return
+		}
+		sums[metricType] += value
+	}
+	for _, status := range statuses {
+		add("gpu_ecc_corrected_errors", status.CorrectedAggregate)
+		add("gpu_ecc_uncorrected_errors", status.UncorrectedAggregate)
+		if status.RetiredPagesSBE >= 0 || status.RetiredPagesDBE >= 0 {
+			add("gpu_retired_pages", max(status.RetiredPagesSBE, 0)+max(status.RetiredPagesDBE, 0))
+		}
+		if status.RemappedCorrectable >= 0 || status.RemappedUncorrectable >= 0 {
+			add("gpu_remapped_rows", max(status.RemappedCorrectable, 0)+max(status.RemappedUncorrectable, 0))
+		}
+	}
+
+	var metrics []Metric
+	for _, metricType := range []string{"gpu_ecc_corrected_errors", "gpu_ecc_uncorrected_errors", "gpu_retired_pages", "gpu_remapped_rows"} {
+		if value, ok := sums[metricType]; ok {
+			metrics = append(metrics, Metric{Type: metricType, Value: float64(value)})
+		}
+	}
+	return metrics
+}
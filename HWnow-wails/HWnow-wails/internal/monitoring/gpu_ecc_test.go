@@ -0,0 +1,73 @@
+package monitoring
+
+import "testing"
+
+func TestParseGPUECC(t *testing.T) {
+	output := `0, 00000000:17:00.0, Enabled, 2, 0, 14, 0, 1, 0, No
+1, 00000000:65:00.0, Disabled, [N/A], [N/A], [N/A], [N/A], [Not Supported], [Not Supported], [Not Supported]
+`
+	statuses := parseGPUECC(output)
+	if len(statuses) != 2 {
+		t.Fatalf("Expected 2 GPUs, got %+v", statuses)
+	}
+	gpu := statuses[0]
+	if !gpu.ECCEnabled || gpu.CorrectedVolatile != 2 || gpu.CorrectedAggregate != 14 || gpu.RetiredPagesSBE != 1 || gpu.RetiredPending {
+		t.Errorf("Unexpected status: %+v", gpu)
+	}
+	if statuses[1].ECCEnabled || statuses[1].UncorrectedAggregate != -1 || statuses[1].RetiredPagesDBE != -1 || statuses[1].RemappedCorrectable != -1 {
+		t.Errorf("Expected unsupported counters to be -1, got %+v", statuses[1])
+	}
+
+	mergeGPURemappedRows(statuses, "00000000:17:00.0, 3, 1, 0, 0\n")
+	if statuses[0].RemappedCorrectable != 3 || statuses[0].RemappedUncorrectable != 1 || statuses[0].RemapPending || statuses[1].RemappedCorrectable != -1 {
+		t.Errorf("Unexpected remapped rows: %+v", statuses)
+	}
+}
+
+func TestGPUECCMonitorCheck(t *testing.T) {
+	monitor := NewGPUECCMonitor()
+	status := GPUECCStatus{Index: 0, CorrectedAggregate: 14, UncorrectedAggregate: 0, RetiredPagesSBE: 1, RetiredPagesDBE: 0, RemappedCorrectable: -1, RemappedUncorrectable: -1}
+
+	// 첫 관측은 기준값만 기록
+	if alerts := monitor.Check([]GPUECCStatus{status}); len(alerts) != 0 {
+		t.Fatalf("Expected no alerts on baseline, got %+v", alerts)
+	}
+	if alerts := monitor.Check([]GPUECCStatus{status}); len(alerts) != 0 {
+		t.Fatalf("Expected no alerts without changes, got %+v", alerts)
+	}
+
+	status.CorrectedAggregate = 20
+	status.RetiredPagesDBE = 2
+	alerts := monitor.Check([]GPUECCStatus{status})
+	if len(alerts) != 2 {
+		t.Fatalf("Expected 2 alerts, got %+v", alerts)
+	}
+	if alerts[0].Counter != "corrected_aggregate" || alerts[0].Previous != 14 || alerts[0].Current != 20 || alerts[0].Severity != GPUECCSeverityWarning {
+		t.Errorf("Unexpected corrected alert: %+v", alerts[0])
+	}
+	if alerts[1].Counter != "retired_pages_dbe" || alerts[1].Severity != GPUECCSeverityCritical {
+		t.Errorf("Unexpected retired page alert: %+v", alerts[1])
+	}
+
+	status.RemapFailure = true
+	if alerts := monitor.Check([]GPUECCStatus{status}); len(alerts) != 1 || alerts[0].Counter != "remap_failure" {
+		t.Errorf("Expected remap failure alert, got %+v", alerts)
+	}
+}
+
+func TestGPUECCMetrics(t *testing.T) {
+	metrics := GPUECCMetrics([]GPUECCStatus{
+		{CorrectedAggregate: 5, UncorrectedAggregate: 1, RetiredPagesSBE: 2, RetiredPagesDBE: 1, RemappedCorrectable: -1, RemappedUncorrectable: -1},
+		{CorrectedAggregate: 3, UncorrectedAggregate: -1, RetiredPagesSBE: -1, RetiredPagesDBE: -1, RemappedCorrectable: 4, RemappedUncorrectable: 0},
+	})
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		values[metric.Type] = metric.Value
+	}
+	if len(values) != 4 || values["gpu_ecc_corrected_errors"] != 8 || values["gpu_ecc_uncorrected_errors"] != 1 || values["gpu_retired_pages"] != 3 || values["gpu_remapped_rows"] != 4 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+	if MetricUnit("gpu_retired_pages") != UnitCount {
+		t.Error("Unexpected GPU ECC metric unit")
+	}
+}
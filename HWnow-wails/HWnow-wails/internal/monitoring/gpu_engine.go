@@ -0,0 +1,239 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GPU 엔진 유형별 사용률 (Windows "GPU Engine" 성능 카운터)
+// 3D 엔진이 유휴 상태여도 동영상 인코딩/디코딩 부하를 확인할 수 있도록 엔진 유형별로 집계
+
+// GPUEngineUsage represents the utilization of one GPU engine type
+type GPUEngineUsage struct {
+	Engine string  `json:"engine"` // 3d, copy, decode, encode, compute, video_processing ...
+	Usage  float64 `json:"usage"`  // 해당 유형 엔진 중 가장 바쁜 엔진의 사용률 (%)
+}
+
+// gpuEngineSample holds one typeperf sample aggregated per engine type, adapter and process
+type gpuEngineSample struct {
+	engines       []GPUEngineUsage
+	adapterUsage  map[string]float64           // luid → 가장 바쁜 엔진의 사용률 (%)
+	processUsage  map[int32]map[string]float64 // pid → luid → 해당 어댑터에서 가장 바쁜 엔진의 사용률 (%)
+	dedicatedUsed map[string]float64           // luid → 전용 메모리 사용량 (MB)
+	sharedUsed    map[string]float64           // luid → 공유 메모리 사용량 (MB)
+}
+
+// GPUEngineCache caches per-engine GPU utilization
+type GPUEngineCache struct {
+	mutex     sync.Mutex
+	sample    *gpuEngineSample
+	timestamp time.Time
+}
+
+const GPU_ENGINE_CACHE_DURATION = 2 * time.Second
+
+var gpuEngineCache = &GPUEngineCache{}
+
+// 인스턴스 예: pid_1234_luid_0x00000000_0x0000C8E2_phys_0_eng_3_engtype_VideoDecode
+var gpuEngineInstancePattern = regexp.MustCompile(`luid_(\w+?)_phys_(\d+)_eng_(\d+)_engtype_([A-Za-z0-9]+)`)
+var gpuEngineProcessPattern = regexp.MustCompile(`pid_(\d+)_luid_`)
+
+// 인스턴스 예: luid_0x00000000_0x0000C8E2_phys_0
+var gpuAdapterMemoryPattern = regexp.MustCompile(`(?i)gpu adapter memory\(luid_(\w+?)_phys_\d+\)\\(dedicated|shared) usage`)
+
+// GetGPUEngineUtilization returns utilization per GPU engine type (Windows only)
+func GetGPUEngineUtilization(ctx context.Context) ([]GPUEngineUsage, error) {
+	return queryWithContext(ctx, "GetGPUEngineUtilization", getGPUEngineUtilization)
+}
+
+func getGPUEngineUtilization() ([]GPUEngineUsage, error) {
+	sample, err := getGPUEngineSample()
+	if err != nil {
+		return nil, err
+	}
+	return sample.engines, nil
+}
+
+// getGPUEngineSample returns the cached GPU Engine / GPU Adapter Memory sample (Windows only)
+func getGPUEngineSample() (*gpuEngineSample, error) {
+	if runtime.GOOS != "windows" {
+		return nil, fmt.Errorf("GPU engine counters not supported on platform: %s", runtime.GOOS)
+	}
+
+	gpuEngineCache.mutex.Lock()
+	defer gpuEngineCache.mutex.Unlock()
+
+	if time.Since(gpuEngineCache.timestamp) < GPU_ENGINE_CACHE_DURATION && gpuEngineCache.sample != nil {
+		return gpuEngineCache.sample, nil
+	}
+
+	// 어댑터 메모리 카운터도 같은 샘플로 읽어 하이브리드 그래픽 어댑터별 사용량에 활용
+	cmd := createHiddenCommandWithTimeout("typeperf", 3, `\GPU Engine(*)\Utilization Percentage`,
+		`\GPU Adapter Memory(*)\Dedicated Usage`, `\GPU Adapter Memory(*)\Shared Usage`, "-sc", "1")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("GPU Engine counter query failed: %v", err)
+	}
+
+	sample, err := parseGPUEngineSample(output)
+	if err != nil {
+		return nil, err
+	}
+
+	gpuEngineCache.sample = sample
+	gpuEngineCache.timestamp = time.Now()
+	return sample, nil
+}
+
+// GPUEngineMetricName returns the metric type name for an engine type (e.g. gpu_engine_decode)
+func GPUEngineMetricName(engine string) string {
+	return "gpu_engine_" + engine
+}
+
+// normalizeGPUEngineType maps a counter engtype to the engine name used in metrics
+func normalizeGPUEngineType(engtype string) string {
+	switch lower := strings.ToLower(engtype); {
+	case lower == "3d":
+		return "3d"
+	case lower == "copy":
+		return "copy"
+	case lower == "videodecode":
+		return "decode"
+	case lower == "videoencode":
+		return "encode"
+	case lower == "videoprocessing":
+		return "video_processing"
+	case strings.HasPrefix(lower, "compute"), lower == "cuda":
+		return "compute"
+	default:
+		return lower
+	}
+}
+
+// parseTypeperfSample returns the counter header and first sample row of typeperf CSV output
+func parseTypeperfSample(output []byte) ([]string, []string, error) {
+	// typeperf는 마지막에 상태 메시지를 출력하므로 레코드별 필드 수가 다를 수 있음
+	reader := csv.NewReader(strings.NewReader(strings.TrimSpace(string(output))))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var header, values []string
+	for _, record := range records {
+		if len(record) < 2 {
+			continue
+		}
+		if header == nil {
+			header = record
+			continue
+		}
+		values = record
+		break
+	}
+	if header == nil || values == nil {
+		return nil, nil, fmt.Errorf("no counter samples found")
+	}
+	return header, values, nil
+}
+
+// parseGPUEngineOutput aggregates typeperf GPU Engine output into per-type utilization
+func parseGPUEngineOutput(output []byte) ([]GPUEngineUsage, error) {
+	sample, err := parseGPUEngineSample(output)
+	if err != nil {
+		return nil, err
+	}
+	return sample.engines, nil
+}
+
+// parseGPUEngineSample aggregates typeperf GPU Engine output per engine type, adapter (luid) and process
+func parseGPUEngineSample(output []byte) (*gpuEngineSample, error) {
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &gpuEngineSample{
+		adapterUsage:  make(map[string]float64),
+		processUsage:  make(map[int32]map[string]float64),
+		dedicatedUsed: make(map[string]float64),
+		sharedUsed:    make(map[string]float64),
+	}
+
+	// 같은 물리 엔진을 사용하는 프로세스별 인스턴스를 합산한 뒤 (작업 관리자와 동일)
+	// 엔진 유형별로 가장 바쁜 엔진의 사용률을 사용
+	perEngine := make(map[string]float64)
+	engineTypes := make(map[string]string)
+	engineAdapters := make(map[string]string)
+	for i := 1; i < len(header) && i < len(values); i++ {
+		usage, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		if match := gpuAdapterMemoryPattern.FindStringSubmatch(header[i]); match != nil {
+			luid := strings.ToLower(match[1])
+			if strings.EqualFold(match[2], "dedicated") {
+				sample.dedicatedUsed[luid] += usage / 1024 / 1024
+			} else {
+				sample.sharedUsed[luid] += usage / 1024 / 1024
+			}
+			continue
+		}
+		match := gpuEngineInstancePattern.FindStringSubmatch(header[i])
+		if match == nil {
+			continue
+		}
+		luid := strings.ToLower(match[1])
+		key := luid + "/" + match[2] + "/" + match[3]
+		perEngine[key] += usage
+		engineTypes[key] = normalizeGPUEngineType(match[4])
+		engineAdapters[key] = luid
+
+		// 프로세스가 어느 어댑터에서 실행 중인지 판별하기 위해 pid별로도 기록
+		if pidMatch := gpuEngineProcessPattern.FindStringSubmatch(header[i]); pidMatch != nil {
+			if pid, err := strconv.ParseInt(pidMatch[1], 10, 32); err == nil {
+				adapters := sample.processUsage[int32(pid)]
+				if adapters == nil {
+					adapters = make(map[string]float64)
+					sample.processUsage[int32(pid)] = adapters
+				}
+				if current, exists := adapters[luid]; !exists || usage > current {
+					adapters[luid] = usage
+				}
+			}
+		}
+	}
+	if len(perEngine) == 0 {
+		return nil, fmt.Errorf("no GPU Engine instances found")
+	}
+
+	perType := make(map[string]float64)
+	for key, usage := range perEngine {
+		engine := engineTypes[key]
+		if usage > 100 {
+			usage = 100
+		}
+		if current, exists := perType[engine]; !exists || usage > current {
+			perType[engine] = usage
+		}
+		if current, exists := sample.adapterUsage[engineAdapters[key]]; !exists || usage > current {
+			sample.adapterUsage[engineAdapters[key]] = usage
+		}
+	}
+
+	sample.engines = make([]GPUEngineUsage, 0, len(perType))
+	for engine, usage := range perType {
+		sample.engines = append(sample.engines, GPUEngineUsage{Engine: engine, Usage: usage})
+	}
+	sort.Slice(sample.engines, func(i, j int) bool { return sample.engines[i].Engine < sample.engines[j].Engine })
+	return sample, nil
+}
@@ -0,0 +1,47 @@
+package monitoring
+
+import "testing"
+
+func TestGPUEngineHelpers(t *testing.T) {
+
+	t.Run("Parse_Typeperf_Output", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\GPU Engine(pid_100_luid_0x00000000_0x0000C8E2_phys_0_eng_0_engtype_3D)\Utilization Percentage","\\PC\GPU Engine(pid_200_luid_0x00000000_0x0000C8E2_phys_0_eng_0_engtype_3D)\Utilization Percentage","\\PC\GPU Engine(pid_300_luid_0x00000000_0x0000C8E2_phys_0_eng_3_engtype_VideoDecode)\Utilization Percentage","\\PC\GPU Engine(pid_300_luid_0x00000000_0x0000C8E2_phys_0_eng_4_engtype_VideoEncode)\Utilization Percentage","\\PC\GPU Engine(pid_400_luid_0x00000000_0x0000C8E2_phys_0_eng_5_engtype_Compute_0)\Utilization Percentage"
+"10/16/2026 12:00:00.000","12.5","7.5","40.0","65.25","0.000000"
+Exiting, please wait...
+The command completed successfully.`)
+
+		engines, err := parseGPUEngineOutput(output)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		usage := make(map[string]float64)
+		for _, engine := range engines {
+			usage[engine.Engine] = engine.Usage
+		}
+		expected := map[string]float64{"3d": 20, "decode": 40, "encode": 65.25, "compute": 0}
+		if len(usage) != len(expected) {
+			t.Fatalf("Expected %d engine types, got %v", len(expected), usage)
+		}
+		for engine, value := range expected {
+			if usage[engine] != value {
+				t.Errorf("Engine %s: expected %.2f, got %.2f", engine, value, usage[engine])
+			}
+		}
+	})
+
+	t.Run("No_Instances", func(t *testing.T) {
+		if _, err := parseGPUEngineOutput([]byte("Error: No valid counters.")); err == nil {
+			t.Error("Expected error for output without samples")
+		}
+	})
+
+	t.Run("Metric_Name", func(t *testing.T) {
+		if name := GPUEngineMetricName(normalizeGPUEngineType("VideoDecode")); name != "gpu_engine_decode" {
+			t.Errorf("Unexpected metric name: %s", name)
+		}
+		if name := GPUEngineMetricName(normalizeGPUEngineType("Cuda")); name != "gpu_engine_compute" {
+			t.Errorf("Unexpected metric name: %s", name)
+		}
+	})
+}
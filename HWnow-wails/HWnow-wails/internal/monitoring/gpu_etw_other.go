@@ -0,0 +1,17 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// Start is only available on Windows (DxgKrnl ETW session)
+func (t *GPUActivityTracer) Start(ctx context.Context) error {
+	return fmt.Errorf("GPU activity tracing not supported on platform: %s", runtime.GOOS)
+}
+
+// Stop is a no-op outside Windows
+func (t *GPUActivityTracer) Stop() {}
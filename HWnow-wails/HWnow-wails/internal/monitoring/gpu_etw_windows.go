@@ -0,0 +1,411 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// DxgKrnl ETW 세션 구현 (공용 타입과 이벤트 분류는 gpu_etw.go)
+
+const (
+	gpuTraceSessionName = "HWnow-GPU-Activity"
+
+	// DxgKrnl 키워드: Base(0x1) | Profiler(0x2) - 컨텍스트 수명 주기와 DMA 패킷 이벤트
+	dxgKrnlKeywords      = 0x1 | 0x2
+	traceLevelInfo       = 4
+	eventTraceRealTime   = 0x00000100 // EVENT_TRACE_REAL_TIME_MODE
+	wnodeFlagTracedGUID  = 0x00020000
+	processTraceRealTime = 0x00000100 // PROCESS_TRACE_MODE_REAL_TIME
+	processTraceRecord   = 0x10000000 // PROCESS_TRACE_MODE_EVENT_RECORD
+	eventControlEnable   = 1          // EVENT_CONTROL_CODE_ENABLE_PROVIDER
+	eventControlCapture  = 2          // EVENT_CONTROL_CODE_CAPTURE_STATE
+	traceControlStop     = 1          // EVENT_TRACE_CONTROL_STOP
+	errorAlreadyExists   = 183
+	errorInsufficientBuf = 122
+	invalidTraceHandle   = ^uint64(0)
+	fileTimeUnixOffset   = 116444736000000000 // 1601-01-01 → 1970-01-01 (100ns 단위)
+)
+
+// Microsoft-Windows-DxgKrnl {802EC45A-1E99-4B83-9920-87C98277BA9D}
+var dxgKrnlProviderGUID = syscall.GUID{
+	Data1: 0x802ec45a, Data2: 0x1e99, Data3: 0x4b83,
+	Data4: [8]byte{0x99, 0x20, 0x87, 0xc9, 0x82, 0x77, 0xba, 0x9d},
+}
+
+// wnodeHeader mirrors WNODE_HEADER
+type wnodeHeader struct {
+	BufferSize        uint32
+	ProviderID        uint32
+	HistoricalContext uint64
+	TimeStamp         int64
+	GUID              syscall.GUID
+	ClientContext     uint32
+	Flags             uint32
+}
+
+// eventTraceProperties mirrors EVENT_TRACE_PROPERTIES (세션 이름은 구조체 바로 뒤에 위치)
+type eventTraceProperties struct {
+	Wnode               wnodeHeader
+	BufferSize          uint32
+	MinimumBuffers      uint32
+	MaximumBuffers      uint32
+	MaximumFileSize     uint32
+	LogFileMode         uint32
+	FlushTimer          uint32
+	EnableFlags         uint32
+	AgeLimit            int32
+	NumberOfBuffers     uint32
+	FreeBuffers         uint32
+	EventsLost          uint32
+	BuffersWritten      uint32
+	LogBuffersLost      uint32
+	RealTimeBuffersLost uint32
+	LoggerThreadID      uintptr
+	LogFileNameOffset   uint32
+	LoggerNameOffset    uint32
+}
+
+// eventTraceLogfile mirrors EVENT_TRACE_LOGFILEW (사용하지 않는 중첩 구조체는 바이트 배열로 대체)
+type eventTraceLogfile struct {
+	LogFileName         *uint16
+	LoggerName          *uint16
+	CurrentTime         int64
+	BuffersRead         uint32
+	ProcessTraceMode    uint32
+	CurrentEvent        [88]byte  // EVENT_TRACE
+	LogfileHeader       [280]byte // TRACE_LOGFILE_HEADER
+	BufferCallback      uintptr
+	BufferSize          uint32
+	Filled              uint32
+	EventsLost          uint32
+	_                   uint32
+	EventRecordCallback uintptr
+	IsKernelTrace       uint32
+	_                   uint32
+	Context             uintptr
+}
+
+// eventDescriptor mirrors EVENT_DESCRIPTOR
+type eventDescriptor struct {
+	ID      uint16
+	Version uint8
+	Channel uint8
+	Level   uint8
+	Opcode  uint8
+	Task    uint16
+	Keyword uint64
+}
+
+// eventRecord mirrors EVENT_RECORD
+type eventRecord struct {
+	Size              uint16
+	HeaderType        uint16
+	Flags             uint16
+	EventProperty     uint16
+	ThreadID          uint32
+	ProcessID         uint32
+	TimeStamp         int64
+	ProviderID        syscall.GUID
+	Descriptor        eventDescriptor
+	ProcessorTime     uint64
+	ActivityID        syscall.GUID
+	BufferContext     uint32
+	ExtendedDataCount uint16
+	UserDataLength    uint16
+	ExtendedData      uintptr
+	UserData          uintptr
+	UserContext       uintptr
+}
+
+// propertyDataDescriptor mirrors PROPERTY_DATA_DESCRIPTOR
+type propertyDataDescriptor struct {
+	PropertyName uint64
+	ArrayIndex   uint32
+	Reserved     uint32
+}
+
+var (
+	etwAdvapi32        = syscall.NewLazyDLL("advapi32.dll")
+	etwTdh             = syscall.NewLazyDLL("tdh.dll")
+	procStartTrace     = etwAdvapi32.NewProc("StartTraceW")
+	procControlTrace   = etwAdvapi32.NewProc("ControlTraceW")
+	procEnableTraceEx2 = etwAdvapi32.NewProc("EnableTraceEx2")
+	procOpenTrace      = etwAdvapi32.NewProc("OpenTraceW")
+	procProcessTrace   = etwAdvapi32.NewProc("ProcessTrace")
+	procCloseTrace     = etwAdvapi32.NewProc("CloseTrace")
+	procTdhEventInfo   = etwTdh.NewProc("TdhGetEventInformation")
+	procTdhPropSize    = etwTdh.NewProc("TdhGetPropertySize")
+	procTdhProperty    = etwTdh.NewProc("TdhGetProperty")
+)
+
+// ETW 콜백은 해제할 수 없으므로 한 번만 만들고 현재 추적기에 전달
+var (
+	gpuTraceCallbackOnce sync.Once
+	gpuTraceCallback     uintptr
+)
+
+// Start opens the ETW session and processes events in the background
+func (t *GPUActivityTracer) Start(ctx context.Context) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.cancel != nil {
+		return nil // already running
+	}
+
+	// 이전 실행이 비정상 종료되어 남아 있는 같은 이름의 세션 정리
+	stopTraceSession(0)
+
+	session, err := startTraceSession()
+	if err != nil {
+		return err
+	}
+
+	ret, _, _ := procEnableTraceEx2.Call(uintptr(session), uintptr(unsafe.Pointer(&dxgKrnlProviderGUID)),
+		eventControlEnable, traceLevelInfo, dxgKrnlKeywords, 0, 0, 0)
+	if ret != 0 {
+		stopTraceSession(session)
+		return fmt.Errorf("EnableTraceEx2 failed: error %d", ret)
+	}
+
+	gpuTraceCallbackOnce.Do(func() {
+		gpuTraceCallback = syscall.NewCallback(func(record *eventRecord) uintptr {
+			activeGPUTracerMutex.Lock()
+			tracer := activeGPUTracer
+			activeGPUTracerMutex.Unlock()
+			if tracer != nil {
+				tracer.handleEvent(record)
+			}
+			return 0
+		})
+	})
+
+	namePtr, _ := syscall.UTF16PtrFromString(gpuTraceSessionName)
+	logfile := eventTraceLogfile{
+		LoggerName:          namePtr,
+		ProcessTraceMode:    processTraceRealTime | processTraceRecord,
+		EventRecordCallback: gpuTraceCallback,
+	}
+	trace, _, callErr := procOpenTrace.Call(uintptr(unsafe.Pointer(&logfile)))
+	if uint64(trace) == invalidTraceHandle {
+		stopTraceSession(session)
+		return fmt.Errorf("OpenTrace failed: %v", callErr)
+	}
+
+	activeGPUTracerMutex.Lock()
+	activeGPUTracer = t
+	activeGPUTracerMutex.Unlock()
+
+	t.session = session
+	t.trace = uint64(trace)
+	watchCtx, cancel := context.WithCancel(ctx)
+	t.cancel = cancel
+
+	// 상위 컨텍스트가 끝나면 세션도 정리 (커널 세션은 프로세스 종료 후에도 남음)
+	go func() {
+		<-watchCtx.Done()
+		t.Stop()
+	}()
+
+	go func(traceHandle uint64) {
+		// 세션이 중지되거나 CloseTrace가 호출될 때까지 블록
+		ret, _, _ := procProcessTrace.Call(uintptr(unsafe.Pointer(&traceHandle)), 1, 0, 0)
+		LogDebug("GPU activity trace processing ended", "status", ret)
+	}(t.trace)
+
+	// 세션 시작 전에 만들어진 컨텍스트를 DCStart 이벤트로 받음
+	procEnableTraceEx2.Call(uintptr(session), uintptr(unsafe.Pointer(&dxgKrnlProviderGUID)),
+		eventControlCapture, traceLevelInfo, dxgKrnlKeywords, 0, 0, 0)
+
+	LogInfo("GPU activity tracing started", "session", gpuTraceSessionName)
+	return nil
+}
+
+// Stop closes the ETW session
+func (t *GPUActivityTracer) Stop() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.cancel == nil {
+		return
+	}
+	t.cancel()
+	t.cancel = nil
+
+	activeGPUTracerMutex.Lock()
+	if activeGPUTracer == t {
+		activeGPUTracer = nil
+	}
+	activeGPUTracerMutex.Unlock()
+
+	procCloseTrace.Call(uintptr(t.trace))
+	stopTraceSession(t.session)
+	t.session, t.trace = 0, 0
+}
+
+// startTraceSession creates the real-time ETW session
+func startTraceSession() (uint64, error) {
+	props, buffer := newTraceProperties()
+	namePtr, _ := syscall.UTF16PtrFromString(gpuTraceSessionName)
+	props.LogFileMode = eventTraceRealTime
+	props.FlushTimer = 1 // 초 단위로 버퍼를 전달해 측정 지연 최소화
+
+	var session uint64
+	ret, _, _ := procStartTrace.Call(uintptr(unsafe.Pointer(&session)), uintptr(unsafe.Pointer(namePtr)),
+		uintptr(unsafe.Pointer(&buffer[0])))
+	switch ret {
+	case 0:
+		return session, nil
+	case uintptr(syscall.ERROR_ACCESS_DENIED):
+		return 0, fmt.Errorf("StartTrace requires administrator privileges")
+	case errorAlreadyExists:
+		return 0, fmt.Errorf("ETW session %s already exists", gpuTraceSessionName)
+	default:
+		return 0, fmt.Errorf("StartTrace failed: error %d", ret)
+	}
+}
+
+// stopTraceSession stops the session by handle (0 = by name)
+func stopTraceSession(session uint64) {
+	_, buffer := newTraceProperties()
+	var namePtr *uint16
+	if session == 0 {
+		namePtr, _ = syscall.UTF16PtrFromString(gpuTraceSessionName)
+	}
+	procControlTrace.Call(uintptr(session), uintptr(unsafe.Pointer(namePtr)),
+		uintptr(unsafe.Pointer(&buffer[0])), traceControlStop)
+}
+
+// newTraceProperties allocates EVENT_TRACE_PROPERTIES followed by room for the session name
+func newTraceProperties() (*eventTraceProperties, []byte) {
+	size := unsafe.Sizeof(eventTraceProperties{})
+	buffer := make([]byte, size+uintptr(len(gpuTraceSessionName)+1)*2)
+	props := (*eventTraceProperties)(unsafe.Pointer(&buffer[0]))
+	props.Wnode.BufferSize = uint32(len(buffer))
+	props.Wnode.Flags = wnodeFlagTracedGUID
+	props.Wnode.ClientContext = 1 // QPC 타임스탬프
+	props.LoggerNameOffset = uint32(size)
+	return props, buffer
+}
+
+// handleEvent feeds one DxgKrnl event into the tracker (runs on the ProcessTrace thread)
+func (t *GPUActivityTracer) handleEvent(record *eventRecord) {
+	if record.ProviderID != dxgKrnlProviderGUID {
+		return
+	}
+
+	kind := t.eventKind(record)
+	if kind == gpuEventIgnored {
+		return
+	}
+
+	hContext, ok := t.uintProperty(record, "hContext")
+	if !ok {
+		return
+	}
+	timestamp := time.Unix(0, (record.TimeStamp-fileTimeUnixOffset)*100)
+
+	switch kind {
+	case gpuEventContextStart:
+		node, _ := t.uintProperty(record, "NodeOrdinal")
+		t.tracker.ContextCreated(hContext, int32(record.ProcessID), uint32(node))
+	case gpuEventContextStop:
+		t.tracker.ContextDestroyed(hContext)
+	case gpuEventPacketStart, gpuEventPacketComplete:
+		sequence, ok := t.uintProperty(record, "ulQueueSubmitSequence")
+		if !ok {
+			return
+		}
+		if kind == gpuEventPacketStart {
+			t.tracker.PacketStarted(hContext, uint32(sequence), timestamp)
+		} else {
+			t.tracker.PacketCompleted(hContext, uint32(sequence), timestamp)
+		}
+	}
+}
+
+// eventKind classifies an event by its TDH task and opcode names (cached per event definition)
+func (t *GPUActivityTracer) eventKind(record *eventRecord) int {
+	key := gpuEventKey{id: record.Descriptor.ID, version: record.Descriptor.Version, opcode: record.Descriptor.Opcode}
+	if kind, ok := t.eventKinds[key]; ok {
+		return kind
+	}
+
+	kind := gpuEventIgnored
+	if task, opcode, err := eventNames(record); err == nil {
+		kind = classifyDxgKrnlEvent(task, opcode)
+	}
+	t.eventKinds[key] = kind
+	return kind
+}
+
+// eventNames reads the task and opcode names of an event from TRACE_EVENT_INFO
+func eventNames(record *eventRecord) (string, string, error) {
+	var size uint32
+	ret, _, _ := procTdhEventInfo.Call(uintptr(unsafe.Pointer(record)), 0, 0, 0, uintptr(unsafe.Pointer(&size)))
+	if ret != errorInsufficientBuf {
+		return "", "", fmt.Errorf("TdhGetEventInformation failed: error %d", ret)
+	}
+	buffer := make([]byte, size)
+	ret, _, _ = procTdhEventInfo.Call(uintptr(unsafe.Pointer(record)), 0, 0,
+		uintptr(unsafe.Pointer(&buffer[0])), uintptr(unsafe.Pointer(&size)))
+	if ret != 0 {
+		return "", "", fmt.Errorf("TdhGetEventInformation failed: error %d", ret)
+	}
+
+	// TRACE_EVENT_INFO: TaskNameOffset @68, OpcodeNameOffset @72
+	task := utf16At(buffer, binary.LittleEndian.Uint32(buffer[68:]))
+	opcode := utf16At(buffer, binary.LittleEndian.Uint32(buffer[72:]))
+	return task, opcode, nil
+}
+
+// utf16At decodes the null-terminated UTF-16 string at offset (0 = no string)
+func utf16At(buffer []byte, offset uint32) string {
+	if offset == 0 || int(offset) >= len(buffer) {
+		return ""
+	}
+	var chars []uint16
+	for i := int(offset); i+1 < len(buffer); i += 2 {
+		char := binary.LittleEndian.Uint16(buffer[i:])
+		if char == 0 {
+			break
+		}
+		chars = append(chars, char)
+	}
+	return syscall.UTF16ToString(chars)
+}
+
+// uintProperty reads an integer or pointer property (up to 8 bytes) by name
+func (t *GPUActivityTracer) uintProperty(record *eventRecord, name string) (uint64, bool) {
+	namePtr, ok := t.propertyNames[name]
+	if !ok {
+		namePtr, _ = syscall.UTF16PtrFromString(name)
+		t.propertyNames[name] = namePtr
+	}
+	descriptor := propertyDataDescriptor{
+		PropertyName: uint64(uintptr(unsafe.Pointer(namePtr))),
+		ArrayIndex:   ^uint32(0),
+	}
+
+	var size uint32
+	ret, _, _ := procTdhPropSize.Call(uintptr(unsafe.Pointer(record)), 0, 0, 1,
+		uintptr(unsafe.Pointer(&descriptor)), uintptr(unsafe.Pointer(&size)))
+	if ret != 0 || size == 0 || size > 8 {
+		return 0, false
+	}
+
+	var buffer [8]byte
+	ret, _, _ = procTdhProperty.Call(uintptr(unsafe.Pointer(record)), 0, 0, 1,
+		uintptr(unsafe.Pointer(&descriptor)), uintptr(size), uintptr(unsafe.Pointer(&buffer[0])))
+	if ret != 0 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint64(buffer[:]), true
+}
@@ -33,7 +33,11 @@ func (g *gpuMonitor) GetName() string {
 
 // GetGPUInfo returns GPU hardware information
 func (g *gpuMonitor) GetGPUInfo() (*GPUInfo, error) {
-	return getGPUInfoUncached()
+	info, err := getGPUInfoUncached()
+	if err == nil && info != nil {
+		info.LastUpdated = time.Now() // 캐시를 거치지 않으므로 나이는 0
+	}
+	return info, err
 }
 
 // GetGPUProcessesFiltered returns GPU processes with filtering
@@ -55,12 +59,13 @@ func (g *gpuMonitor) GetGPUProcessesDelta(lastUpdateID string) (*GPUProcessDelta
 func GetGPUProcessesFilteredInternal(query GPUProcessQuery) (*GPUProcessResponse, error) {
 	startTime := time.Now()
 
-	processes, err := getCachedGPUProcesses()
+	snapshot, err := getCachedGPUProcessSnapshot()
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply filters
+	// Apply include/exclude name patterns, then filters
+	processes := applyGPUProcessNamePatterns(snapshot.processes)
 	if query.Filter.Enabled {
 		processes = filterGPUProcesses(processes, query.Filter)
 	}
@@ -94,6 +99,9 @@ func GetGPUProcessesFilteredInternal(query GPUProcessQuery) (*GPUProcessResponse
 		FilteredCount: filteredCount,
 		HasMore:       end < totalCount,
 		QueryTime:     time.Since(startTime).Milliseconds(),
+		Summary:       snapshot.summary,
+		LastUpdated:   snapshot.lastUpdated,
+		CacheAgeMs:    snapshot.cacheAgeMs,
 	}
 
 	return response, nil
@@ -0,0 +1,168 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NVIDIA GPU 전력 한도 / 클럭 조회 및 전력 한도 설정 (nvidia-smi, 내부적으로 NVML 사용)
+// 클럭 오프셋은 현재 application clock과 기본 application clock의 차이로 계산
+// 전력 한도 변경은 관리자 권한이 필요하며 재부팅 시 기본값으로 돌아감
+
+// GPUPowerLimits describes the power limit range and clock settings of an NVIDIA GPU
+type GPUPowerLimits struct {
+	Index               int     `json:"index"`
+	Name                string  `json:"name"`
+	PowerDraw           float64 `json:"power_draw"`            // 현재 소비 전력 (W)
+	PowerLimit          float64 `json:"power_limit"`           // 설정된 전력 한도 (W)
+	EnforcedPowerLimit  float64 `json:"enforced_power_limit"`  // 실제 적용 중인 한도 (W)
+	DefaultPowerLimit   float64 `json:"default_power_limit"`   // 기본 한도 (W)
+	MinPowerLimit       float64 `json:"min_power_limit"`       // 설정 가능한 최소값 (W)
+	MaxPowerLimit       float64 `json:"max_power_limit"`       // 설정 가능한 최대값 (W)
+	GraphicsClock       int     `json:"graphics_clock"`        // 현재 그래픽 클럭 (MHz)
+	MemoryClock         int     `json:"memory_clock"`          // 현재 메모리 클럭 (MHz)
+	MaxGraphicsClock    int     `json:"max_graphics_clock"`    // 최대 그래픽 클럭 (MHz)
+	MaxMemoryClock      int     `json:"max_memory_clock"`      // 최대 메모리 클럭 (MHz)
+	GraphicsClockOffset int     `json:"graphics_clock_offset"` // application clock - 기본 application clock (MHz)
+	MemoryClockOffset   int     `json:"memory_clock_offset"`   // application clock - 기본 application clock (MHz)
+}
+
+// GetGPUPowerLimits returns power limits and clock settings of every NVIDIA GPU
+func GetGPUPowerLimits(ctx context.Context) ([]GPUPowerLimits, error) {
+	return queryWithContext(ctx, "GetGPUPowerLimits", getGPUPowerLimits)
+}
+
+func getGPUPowerLimits() ([]GPUPowerLimits, error) {
+	nvidiaSMIPath := findNVIDIASMIPath()
+	if nvidiaSMIPath == "" {
+		return nil, fmt.Errorf("nvidia-smi not found")
+	}
+
+	cmd := createHiddenCommandWithTimeout(nvidiaSMIPath, 10,
+		"--query-gpu=index,name,power.draw,power.limit,enforced.power.limit,power.default_limit,power.min_limit,power.max_limit,"+
+			"clocks.gr,clocks.mem,clocks.max.gr,clocks.max.mem,clocks.applications.gr,clocks.applications.mem,"+
+			"clocks.default_applications.gr,clocks.default_applications.mem",
+		"--format=csv,noheader,nounits")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi power limit query failed: %v", err)
+	}
+
+	limits := parseGPUPowerLimits(string(output))
+	if len(limits) == 0 {
+		return nil, fmt.Errorf("no NVIDIA GPUs reported")
+	}
+	return limits, nil
+}
+
+// parseGPUPowerLimits parses nvidia-smi CSV output for the power limit query
+func parseGPUPowerLimits(output string) []GPUPowerLimits {
+	var limits []GPUPowerLimits
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 16 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		index, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		gpu := GPUPowerLimits{
+			Index:              index,
+			Name:               fields[1],
+			PowerDraw:          nvidiaStaticFloat(fields[2]),
+			PowerLimit:         nvidiaStaticFloat(fields[3]),
+			EnforcedPowerLimit: nvidiaStaticFloat(fields[4]),
+			DefaultPowerLimit:  nvidiaStaticFloat(fields[5]),
+			MinPowerLimit:      nvidiaStaticFloat(fields[6]),
+			MaxPowerLimit:      nvidiaStaticFloat(fields[7]),
+			GraphicsClock:      nvidiaStaticInt(fields[8]),
+			MemoryClock:        nvidiaStaticInt(fields[9]),
+			MaxGraphicsClock:   nvidiaStaticInt(fields[10]),
+			MaxMemoryClock:     nvidiaStaticInt(fields[11]),
+		}
+
+		// 어느 한쪽이라도 지원되지 않으면 오프셋은 0
+		appGraphics, appMemory := nvidiaStaticInt(fields[12]), nvidiaStaticInt(fields[13])
+		defaultGraphics, defaultMemory := nvidiaStaticInt(fields[14]), nvidiaStaticInt(fields[15])
+		if appGraphics > 0 && defaultGraphics > 0 {
+			gpu.GraphicsClockOffset = appGraphics - defaultGraphics
+		}
+		if appMemory > 0 && defaultMemory > 0 {
+			gpu.MemoryClockOffset = appMemory - defaultMemory
+		}
+		limits = append(limits, gpu)
+	}
+	return limits
+}
+
+func nvidiaStaticFloat(value string) float64 {
+	n, err := strconv.ParseFloat(nvidiaStaticField(value), 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// SetGPUPowerLimit sets the power limit (W) of an NVIDIA GPU within its supported range
+func SetGPUPowerLimit(index int, watts float64) (*GPUPowerLimits, error) {
+	limits, err := getGPUPowerLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *GPUPowerLimits
+	for i := range limits {
+		if limits[i].Index == index {
+			target = &limits[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("NVIDIA GPU %d not found", index)
+	}
+	if err := validateGPUPowerLimit(target, watts); err != nil {
+		return nil, err
+	}
+
+	if admin, _ := HasAdminPrivileges(); !admin {
+		return nil, fmt.Errorf("administrator privileges are required to change the GPU power limit")
+	}
+
+	cmd := createHiddenCommandWithTimeout(findNVIDIASMIPath(), 10,
+		"-i", strconv.Itoa(index), "-pl", strconv.FormatFloat(watts, 'f', 2, 64))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("nvidia-smi failed to set power limit: %v (%s)", err, strings.TrimSpace(string(output)))
+	}
+	LogInfo("GPU power limit changed", "gpu", index, "name", target.Name, "from", target.PowerLimit, "to", watts)
+
+	// 적용된 값을 다시 조회하여 반환
+	updated, err := getGPUPowerLimits()
+	if err != nil {
+		return nil, err
+	}
+	for i := range updated {
+		if updated[i].Index == index {
+			return &updated[i], nil
+		}
+	}
+	return nil, fmt.Errorf("NVIDIA GPU %d not found after update", index)
+}
+
+// validateGPUPowerLimit checks that the requested limit is inside the range reported by the driver
+func validateGPUPowerLimit(gpu *GPUPowerLimits, watts float64) error {
+	if gpu.MinPowerLimit <= 0 || gpu.MaxPowerLimit <= 0 {
+		return fmt.Errorf("GPU %d does not support power limit changes", gpu.Index)
+	}
+	if watts < gpu.MinPowerLimit || watts > gpu.MaxPowerLimit {
+		return fmt.Errorf("power limit %.1f W is outside the supported range %.1f-%.1f W",
+			watts, gpu.MinPowerLimit, gpu.MaxPowerLimit)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package monitoring
+
+import "testing"
+
+func TestGPUPowerLimits(t *testing.T) {
+	output := "0, NVIDIA GeForce RTX 4090, 85.32, 450.00, 450.00, 450.00, 150.00, 600.00, 2520, 10501, 3120, 10501, 2625, 10501, 2520, 10501\n" +
+		"1, NVIDIA GeForce GTX 1050, 12.00, [N/A], [N/A], [N/A], [N/A], [N/A], 1354, 3504, 1911, 3504, [N/A], [N/A], [N/A], [N/A]\n"
+
+	limits := parseGPUPowerLimits(output)
+	if len(limits) != 2 {
+		t.Fatalf("Expected 2 GPUs, got %d", len(limits))
+	}
+
+	t.Run("Parse_Supported", func(t *testing.T) {
+		gpu := limits[0]
+		if gpu.PowerLimit != 450 || gpu.MinPowerLimit != 150 || gpu.MaxPowerLimit != 600 || gpu.PowerDraw != 85.32 {
+			t.Errorf("Unexpected power values: %+v", gpu)
+		}
+		if gpu.GraphicsClockOffset != 105 || gpu.MemoryClockOffset != 0 || gpu.MaxGraphicsClock != 3120 {
+			t.Errorf("Unexpected clock values: %+v", gpu)
+		}
+	})
+
+	t.Run("Parse_Not_Supported", func(t *testing.T) {
+		gpu := limits[1]
+		if gpu.PowerLimit != 0 || gpu.MaxPowerLimit != 0 || gpu.GraphicsClockOffset != 0 || gpu.GraphicsClock != 1354 {
+			t.Errorf("Unexpected values for unsupported fields: %+v", gpu)
+		}
+	})
+
+	t.Run("Validate_Range", func(t *testing.T) {
+		if err := validateGPUPowerLimit(&limits[0], 300); err != nil {
+			t.Errorf("Expected 300 W to be accepted, got %v", err)
+		}
+		if err := validateGPUPowerLimit(&limits[0], 700); err == nil {
+			t.Error("Expected 700 W to be rejected")
+		}
+		if err := validateGPUPowerLimit(&limits[1], 50); err == nil {
+			t.Error("Expected GPU without power limit support to be rejected")
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package monitoring
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// GPU 프로세스 이름 포함/제외 패턴 (정규식, 대소문자 구분 없음)
+// 예: 제외 "^dwm\.exe$|chrome\.exe" (데스크톱 창 관리자, 브라우저 하위 프로세스 숨김), 포함 "python|blender"
+// GetGPUProcessesFiltered에서 다른 필터/정렬/페이지 처리 전에 적용
+
+var (
+	gpuProcessIncludePattern *regexp.Regexp
+	gpuProcessExcludePattern *regexp.Regexp
+	gpuProcessPatternMutex   sync.RWMutex
+)
+
+// CompileGPUProcessPattern compiles a case-insensitive process name pattern (empty = nil)
+func CompileGPUProcessPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	compiled, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid process name pattern %q: %v", pattern, err)
+	}
+	return compiled, nil
+}
+
+// SetGPUProcessNamePatterns sets the include/exclude patterns applied to GPU process queries
+func SetGPUProcessNamePatterns(include, exclude string) error {
+	includePattern, err := CompileGPUProcessPattern(include)
+	if err != nil {
+		return err
+	}
+	excludePattern, err := CompileGPUProcessPattern(exclude)
+	if err != nil {
+		return err
+	}
+
+	gpuProcessPatternMutex.Lock()
+	gpuProcessIncludePattern = includePattern
+	gpuProcessExcludePattern = excludePattern
+	gpuProcessPatternMutex.Unlock()
+
+	LogInfo("GPU process name patterns updated", "include", include, "exclude", exclude)
+	return nil
+}
+
+// applyGPUProcessNamePatterns keeps processes matching the include pattern and not matching the exclude pattern
+func applyGPUProcessNamePatterns(processes []GPUProcess) []GPUProcess {
+	gpuProcessPatternMutex.RLock()
+	include, exclude := gpuProcessIncludePattern, gpuProcessExcludePattern
+	gpuProcessPatternMutex.RUnlock()
+
+	return filterGPUProcessesByName(processes, include, exclude)
+}
+
+// filterGPUProcessesByName filters processes by name (nil patterns are ignored)
+func filterGPUProcessesByName(processes []GPUProcess, include, exclude *regexp.Regexp) []GPUProcess {
+	if include == nil && exclude == nil {
+		return processes
+	}
+
+	filtered := make([]GPUProcess, 0, len(processes))
+	for _, process := range processes {
+		if include != nil && !include.MatchString(process.Name) {
+			continue
+		}
+		if exclude != nil && exclude.MatchString(process.Name) {
+			continue
+		}
+		filtered = append(filtered, process)
+	}
+	return filtered
+}
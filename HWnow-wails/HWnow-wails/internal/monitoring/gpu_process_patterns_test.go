@@ -0,0 +1,37 @@
+package monitoring
+
+import "testing"
+
+func TestGPUProcessNamePatterns(t *testing.T) {
+	processes := []GPUProcess{
+		{PID: 1, Name: "dwm.exe"},
+		{PID: 2, Name: "chrome.exe"},
+		{PID: 3, Name: "python.exe"},
+		{PID: 4, Name: "Blender.exe"},
+	}
+
+	t.Run("Exclude", func(t *testing.T) {
+		exclude, _ := CompileGPUProcessPattern(`^dwm\.exe$|chrome`)
+		filtered := filterGPUProcessesByName(processes, nil, exclude)
+		if len(filtered) != 2 || filtered[0].PID != 3 {
+			t.Errorf("Unexpected result: %+v", filtered)
+		}
+	})
+
+	t.Run("Include_Case_Insensitive", func(t *testing.T) {
+		include, _ := CompileGPUProcessPattern("python|blender")
+		filtered := filterGPUProcessesByName(processes, include, nil)
+		if len(filtered) != 2 || filtered[1].Name != "Blender.exe" {
+			t.Errorf("Unexpected result: %+v", filtered)
+		}
+	})
+
+	t.Run("Invalid_Pattern", func(t *testing.T) {
+		if _, err := CompileGPUProcessPattern("python("); err == nil {
+			t.Error("Expected an error for an invalid pattern")
+		}
+		if pattern, err := CompileGPUProcessPattern(""); pattern != nil || err != nil {
+			t.Errorf("Expected empty pattern to be ignored, got %v, %v", pattern, err)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+// useGPUProcessCache seeds the GPU process cache for the duration of the test
+func useGPUProcessCache(t *testing.T, processes []GPUProcess, summary *GPUSummary) {
+	t.Helper()
+	gpuProcessCache.mutex.Lock()
+	previousProcesses, previousSummary, previousUpdated := gpuProcessCache.processes, gpuProcessCache.summary, gpuProcessCache.lastUpdated
+	gpuProcessCache.processes, gpuProcessCache.summary, gpuProcessCache.lastUpdated = processes, summary, time.Now()
+	gpuProcessCache.mutex.Unlock()
+
+	t.Cleanup(func() {
+		gpuProcessCache.mutex.Lock()
+		gpuProcessCache.processes, gpuProcessCache.summary, gpuProcessCache.lastUpdated = previousProcesses, previousSummary, previousUpdated
+		gpuProcessCache.mutex.Unlock()
+	})
+}
+
+func TestGPUProcessSummary(t *testing.T) {
+
+	t.Run("Built_From_GPU_Info", func(t *testing.T) {
+		collectedAt := time.Now()
+		processes := []GPUProcess{{PID: 1, GPUMemory: 900}, {PID: 2, GPUMemory: 300}}
+		summary := newGPUSummary(&GPUInfo{Name: "RTX 4070", MemoryTotal: 12282, MemoryUsed: 3282}, processes, collectedAt)
+		if summary.MemoryFree != 9000 || summary.ProcessMemory != 1200 || !summary.CollectedAt.Equal(collectedAt) {
+			t.Errorf("Unexpected summary: %+v", summary)
+		}
+	})
+
+	t.Run("Free_Never_Negative", func(t *testing.T) {
+		if summary := newGPUSummary(&GPUInfo{MemoryTotal: 0, MemoryUsed: 512}, nil, time.Now()); summary.MemoryFree != 0 {
+			t.Errorf("Expected 0 free memory, got %v", summary.MemoryFree)
+		}
+	})
+
+	t.Run("No_GPU_Info", func(t *testing.T) {
+		if summary := newGPUSummary(nil, nil, time.Now()); summary != nil {
+			t.Errorf("Expected no summary, got %+v", summary)
+		}
+	})
+
+	t.Run("Filtered_Response_Uses_Same_Generation", func(t *testing.T) {
+		cached := &GPUSummary{Name: "RTX 4070", MemoryTotal: 12282, MemoryUsed: 3282, MemoryFree: 9000}
+		useGPUProcessCache(t, []GPUProcess{{PID: 1, Name: "game.exe", GPUMemory: 900}}, cached)
+
+		response, err := GetGPUProcessesFilteredInternal(GPUProcessQuery{MaxItems: 10})
+		if err != nil {
+			t.Fatalf("GetGPUProcessesFilteredInternal failed: %v", err)
+		}
+		if response.Summary == nil || response.Summary.MemoryFree != 9000 {
+			t.Fatalf("Expected the cached summary, got %+v", response.Summary)
+		}
+
+		response.Summary.MemoryFree = 0
+		if cached.MemoryFree != 9000 {
+			t.Error("Response summary shares memory with the cache")
+		}
+	})
+}
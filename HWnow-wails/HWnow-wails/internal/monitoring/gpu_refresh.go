@@ -0,0 +1,54 @@
+package monitoring
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// GPU 정보/프로세스 강제 새로 고침 (refresh=true)
+// 게임이나 학습 작업을 막 시작한 직후처럼 캐시 주기를 기다릴 수 없을 때 캐시를 만료시켜 다음 조회가 새로 수집하도록 함
+// nvidia-smi/WMI 호출이 몰리지 않도록 강제 새로 고침은 최소 간격을 둠 (간격 안의 요청은 거부)
+
+// GPU_FORCE_REFRESH_MIN_INTERVAL is the minimum time between forced GPU cache refreshes
+const GPU_FORCE_REFRESH_MIN_INTERVAL = 5 * time.Second
+
+// RefreshRateLimitError is returned when a forced refresh is requested too soon after the previous one
+type RefreshRateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RefreshRateLimitError) Error() string {
+	return fmt.Sprintf("refresh rate limited, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+var gpuForceRefresh = struct {
+	mutex sync.Mutex
+	last  time.Time
+}{}
+
+// RequestGPURefresh expires the cached GPU info and process list so the next query collects them again
+func RequestGPURefresh() error {
+	gpuForceRefresh.mutex.Lock()
+	if elapsed := time.Since(gpuForceRefresh.last); !gpuForceRefresh.last.IsZero() && elapsed < GPU_FORCE_REFRESH_MIN_INTERVAL {
+		gpuForceRefresh.mutex.Unlock()
+		return &RefreshRateLimitError{RetryAfter: GPU_FORCE_REFRESH_MIN_INTERVAL - elapsed}
+	}
+	gpuForceRefresh.last = time.Now()
+	gpuForceRefresh.mutex.Unlock()
+
+	// 데이터는 남겨 두고 수집 시각만 지워 만료 처리 (새 수집이 실패해도 다음 조회에서 다시 시도)
+	gpuInfoCache.mutex.Lock()
+	gpuInfoCache.lastUpdated = time.Time{}
+	gpuInfoCache.mutex.Unlock()
+
+	// GPU 프로세스 모니터링이 꺼져 있으면 새로 수집하지 않으므로 마지막 목록의 나이를 유지
+	if IsGPUProcessMonitoringEnabled() {
+		gpuProcessCache.mutex.Lock()
+		gpuProcessCache.lastUpdated = time.Time{}
+		gpuProcessCache.mutex.Unlock()
+	}
+
+	LogInfo("GPU caches expired by forced refresh")
+	return nil
+}
@@ -0,0 +1,74 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// resetGPUForceRefresh clears the forced refresh rate limit for the duration of the test
+func resetGPUForceRefresh(t *testing.T) {
+	t.Helper()
+	gpuForceRefresh.mutex.Lock()
+	previous := gpuForceRefresh.last
+	gpuForceRefresh.last = time.Time{}
+	gpuForceRefresh.mutex.Unlock()
+
+	t.Cleanup(func() {
+		gpuForceRefresh.mutex.Lock()
+		gpuForceRefresh.last = previous
+		gpuForceRefresh.mutex.Unlock()
+	})
+}
+
+func TestRequestGPURefresh(t *testing.T) {
+
+	t.Run("Expires_Cached_Processes", func(t *testing.T) {
+		resetGPUForceRefresh(t)
+		useGPUProcessCache(t, []GPUProcess{{PID: 1, Name: "game.exe"}}, nil)
+
+		if err := RequestGPURefresh(); err != nil {
+			t.Fatalf("RequestGPURefresh failed: %v", err)
+		}
+		gpuProcessCache.mutex.RLock()
+		lastUpdated, count := gpuProcessCache.lastUpdated, len(gpuProcessCache.processes)
+		gpuProcessCache.mutex.RUnlock()
+		if !lastUpdated.IsZero() || count != 1 {
+			t.Errorf("Expected an expired cache that keeps its data, got %v with %d processes", lastUpdated, count)
+		}
+	})
+
+	t.Run("Rate_Limited", func(t *testing.T) {
+		resetGPUForceRefresh(t)
+		useGPUProcessCache(t, nil, nil)
+
+		if err := RequestGPURefresh(); err != nil {
+			t.Fatalf("First refresh failed: %v", err)
+		}
+		err := RequestGPURefresh()
+		var rateLimited *RefreshRateLimitError
+		if !errors.As(err, &rateLimited) {
+			t.Fatalf("Expected RefreshRateLimitError, got %v", err)
+		}
+		if rateLimited.RetryAfter <= 0 || rateLimited.RetryAfter > GPU_FORCE_REFRESH_MIN_INTERVAL {
+			t.Errorf("Unexpected retry delay %v", rateLimited.RetryAfter)
+		}
+	})
+
+	t.Run("Disabled_Process_Monitoring_Keeps_Age", func(t *testing.T) {
+		resetGPUForceRefresh(t)
+		useGPUProcessCache(t, []GPUProcess{{PID: 1}}, nil)
+		previous := IsGPUProcessMonitoringEnabled()
+		SetGPUProcessMonitoringEnabled(false)
+		t.Cleanup(func() { SetGPUProcessMonitoringEnabled(previous) })
+
+		if err := RequestGPURefresh(); err != nil {
+			t.Fatalf("RequestGPURefresh failed: %v", err)
+		}
+		gpuProcessCache.mutex.RLock()
+		defer gpuProcessCache.mutex.RUnlock()
+		if gpuProcessCache.lastUpdated.IsZero() {
+			t.Error("Process list age was reset although it will not be collected again")
+		}
+	})
+}
@@ -0,0 +1,228 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GPU 정적 환경 정보 (드라이버, VBIOS, CUDA 버전, PCIe 링크, UUID)
+// 실행 중 변하지 않으므로 시작 시 한 번만 수집하고 캐시
+
+// GPUStaticInfo describes driver and hardware details of a GPU that do not change at runtime
+type GPUStaticInfo struct {
+	Index             int    `json:"index"`
+	Name              string `json:"name"`
+	Vendor            string `json:"vendor"`
+	UUID              string `json:"uuid,omitempty"`
+	DriverVersion     string `json:"driver_version,omitempty"`
+	VBIOSVersion      string `json:"vbios_version,omitempty"`
+	CUDAVersion       string `json:"cuda_version,omitempty"`
+	PCIeGeneration    int    `json:"pcie_generation,omitempty"` // 현재 링크 세대
+	PCIeWidth         int    `json:"pcie_width,omitempty"`      // 현재 링크 폭 (x16 = 16)
+	PCIeGenerationMax int    `json:"pcie_generation_max,omitempty"`
+	PCIeWidthMax      int    `json:"pcie_width_max,omitempty"`
+	Source            string `json:"source"` // nvidia-smi, wmi
+}
+
+// gpuStaticInfoCache holds the one-time collected static GPU information
+type gpuStaticInfoCache struct {
+	once sync.Once
+	info []GPUStaticInfo
+	err  error
+}
+
+var (
+	gpuStaticCache      = &gpuStaticInfoCache{}
+	gpuStaticCacheMutex sync.Mutex
+)
+
+var cudaVersionPattern = regexp.MustCompile(`CUDA Version:\s*([0-9.]+)`)
+
+// GetGPUStaticInfo returns driver, VBIOS, CUDA and PCIe details for all GPUs (collected once)
+func GetGPUStaticInfo(ctx context.Context) ([]GPUStaticInfo, error) {
+	return queryWithContext(ctx, "GetGPUStaticInfo", getGPUStaticInfo)
+}
+
+func getGPUStaticInfo() ([]GPUStaticInfo, error) {
+	gpuStaticCacheMutex.Lock()
+	cache := gpuStaticCache
+	gpuStaticCacheMutex.Unlock()
+
+	cache.once.Do(func() {
+		info, err := collectGPUStaticInfo()
+		cache.info = info
+		cache.err = err
+		if err != nil {
+			LogDebug("GPU static info not available", "error", err)
+		} else {
+			LogInfo("GPU static info collected", "gpus", len(info))
+		}
+	})
+
+	if cache.err != nil {
+		return nil, cache.err
+	}
+	return append([]GPUStaticInfo(nil), cache.info...), nil
+}
+
+// resetGPUStaticInfo discards the collected static info so the next call queries the GPUs again (e.g. after hot-plug)
+func resetGPUStaticInfo() {
+	gpuStaticCacheMutex.Lock()
+	defer gpuStaticCacheMutex.Unlock()
+	gpuStaticCache = &gpuStaticInfoCache{}
+}
+
+func collectGPUStaticInfo() ([]GPUStaticInfo, error) {
+	if info, err := getNVIDIAStaticInfo(); err == nil && len(info) > 0 {
+		return info, nil
+	}
+	if runtime.GOOS == "windows" {
+		return getWMIGPUStaticInfo()
+	}
+	return nil, fmt.Errorf("no supported source for GPU static info on %s", runtime.GOOS)
+}
+
+// getNVIDIAStaticInfo queries nvidia-smi for static properties of every NVIDIA GPU
+func getNVIDIAStaticInfo() ([]GPUStaticInfo, error) {
+	nvidiaSMIPath := findNVIDIASMIPath()
+	if nvidiaSMIPath == "" {
+		return nil, fmt.Errorf("nvidia-smi not found")
+	}
+
+	cmd := createHiddenCommandWithTimeout(nvidiaSMIPath, 10,
+		"--query-gpu=index,name,uuid,driver_version,vbios_version,pcie.link.gen.current,pcie.link.width.current,pcie.link.gen.max,pcie.link.width.max",
+		"--format=csv,noheader,nounits")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi static query failed: %v", err)
+	}
+
+	info := parseNVIDIAStaticInfo(string(output))
+	if len(info) == 0 {
+		return nil, fmt.Errorf("no NVIDIA GPUs reported")
+	}
+
+	// CUDA 버전은 --query-gpu 필드로 제공되지 않아 기본 출력 헤더에서 추출
+	header, err := createHiddenCommandWithTimeout(nvidiaSMIPath, 10).Output()
+	if err == nil {
+		if cudaVersion := parseCUDAVersion(string(header)); cudaVersion != "" {
+			for i := range info {
+				info[i].CUDAVersion = cudaVersion
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// parseNVIDIAStaticInfo parses nvidia-smi CSV output for the static info query
+func parseNVIDIAStaticInfo(output string) []GPUStaticInfo {
+	var info []GPUStaticInfo
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 9 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		index, _ := strconv.Atoi(fields[0])
+		info = append(info, GPUStaticInfo{
+			Index:             index,
+			Name:              fields[1],
+			Vendor:            "NVIDIA",
+			UUID:              nvidiaStaticField(fields[2]),
+			DriverVersion:     nvidiaStaticField(fields[3]),
+			VBIOSVersion:      nvidiaStaticField(fields[4]),
+			PCIeGeneration:    nvidiaStaticInt(fields[5]),
+			PCIeWidth:         nvidiaStaticInt(fields[6]),
+			PCIeGenerationMax: nvidiaStaticInt(fields[7]),
+			PCIeWidthMax:      nvidiaStaticInt(fields[8]),
+			Source:            "nvidia-smi",
+		})
+	}
+	return info
+}
+
+// parseCUDAVersion extracts the CUDA version from the default nvidia-smi output header
+func parseCUDAVersion(output string) string {
+	if match := cudaVersionPattern.FindStringSubmatch(output); len(match) == 2 {
+		return match[1]
+	}
+	return ""
+}
+
+// nvidia-smi는 지원되지 않는 필드를 "[N/A]" 또는 "[Not Supported]"로 출력
+func nvidiaStaticField(value string) string {
+	if strings.HasPrefix(value, "[") || value == "N/A" {
+		return ""
+	}
+	return value
+}
+
+func nvidiaStaticInt(value string) int {
+	n, err := strconv.Atoi(nvidiaStaticField(value))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// getWMIGPUStaticInfo reads driver versions of all video controllers via WMI (AMD, Intel, etc.)
+func getWMIGPUStaticInfo() ([]GPUStaticInfo, error) {
+	cmd := createHiddenCommandWithTimeout("powershell", 15, "-NoProfile", "-Command",
+		"Get-CimInstance Win32_VideoController | Select-Object Name, DriverVersion, AdapterCompatibility, PNPDeviceID | ConvertTo-Json -Compress")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("WMI video controller query failed: %v", err)
+	}
+	return parseWMIGPUStaticInfo(output)
+}
+
+// parseWMIGPUStaticInfo parses Win32_VideoController JSON (single object or array)
+func parseWMIGPUStaticInfo(output []byte) ([]GPUStaticInfo, error) {
+	type videoController struct {
+		Name                 string
+		DriverVersion        string
+		AdapterCompatibility string
+		PNPDeviceID          string
+	}
+
+	trimmed := strings.TrimSpace(string(output))
+	if trimmed == "" {
+		return nil, fmt.Errorf("no video controllers reported")
+	}
+
+	var controllers []videoController
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &controllers); err != nil {
+			return nil, fmt.Errorf("failed to parse video controllers: %v", err)
+		}
+	} else {
+		var controller videoController
+		if err := json.Unmarshal([]byte(trimmed), &controller); err != nil {
+			return nil, fmt.Errorf("failed to parse video controller: %v", err)
+		}
+		controllers = append(controllers, controller)
+	}
+
+	info := make([]GPUStaticInfo, 0, len(controllers))
+	for i, controller := range controllers {
+		info = append(info, GPUStaticInfo{
+			Index:         i,
+			Name:          controller.Name,
+			Vendor:        controller.AdapterCompatibility,
+			UUID:          controller.PNPDeviceID,
+			DriverVersion: controller.DriverVersion,
+			Source:        "wmi",
+		})
+	}
+	return info, nil
+}
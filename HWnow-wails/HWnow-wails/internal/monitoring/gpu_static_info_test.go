@@ -0,0 +1,40 @@
+package monitoring
+
+import "testing"
+
+func TestGPUStaticInfo(t *testing.T) {
+
+	t.Run("Parse_NVIDIA_Static_Info", func(t *testing.T) {
+		output := "0, NVIDIA GeForce RTX 4090, GPU-1a2b3c4d, 552.22, 95.02.18.80.5F, 4, 16, 4, 16\n" +
+			"1, Tesla T4, GPU-5e6f, 535.104.05, [N/A], [N/A], 8, 3, 16\n"
+
+		info := parseNVIDIAStaticInfo(output)
+		if len(info) != 2 {
+			t.Fatalf("Expected 2 GPUs, got %d", len(info))
+		}
+		if info[0].DriverVersion != "552.22" || info[0].PCIeGeneration != 4 || info[0].PCIeWidth != 16 {
+			t.Errorf("Unexpected first GPU: %+v", info[0])
+		}
+		if info[1].VBIOSVersion != "" || info[1].PCIeGeneration != 0 || info[1].PCIeGenerationMax != 3 {
+			t.Errorf("Expected unsupported fields to be empty: %+v", info[1])
+		}
+	})
+
+	t.Run("Parse_CUDA_Version", func(t *testing.T) {
+		header := "| NVIDIA-SMI 552.22   Driver Version: 552.22   CUDA Version: 12.4     |"
+		if version := parseCUDAVersion(header); version != "12.4" {
+			t.Errorf("Expected CUDA 12.4, got %q", version)
+		}
+	})
+
+	t.Run("Parse_WMI_Single_Controller", func(t *testing.T) {
+		output := []byte(`{"Name":"AMD Radeon RX 7900 XTX","DriverVersion":"31.0.24027.1012","AdapterCompatibility":"Advanced Micro Devices, Inc.","PNPDeviceID":"PCI\\VEN_1002"}`)
+		info, err := parseWMIGPUStaticInfo(output)
+		if err != nil || len(info) != 1 {
+			t.Fatalf("Expected 1 controller, got %d (err=%v)", len(info), err)
+		}
+		if info[0].DriverVersion != "31.0.24027.1012" || info[0].Source != "wmi" {
+			t.Errorf("Unexpected controller: %+v", info[0])
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package monitoring
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GPU 벤더 수동 지정 및 재감지
+// 시작 시점에 잘못된 벤더가 고정되는 경우 대응 (NVIDIA 드라이버가 일시적으로만 응답, 여러 벤더 GPU가 섞인 시스템 등)
+// - 설정의 gpu.vendor로 벤더를 지정하면 자동 감지를 건너뜀
+// - 재감지는 고정된 감지 결과와 GPU 관련 캐시를 비우고 다시 감지 (수동 지정이 있으면 그 값 유지)
+
+// gpuVendorOverride is the vendor set in the configuration (GPUVendorUnknown = auto-detect), guarded by gpuVendorDetectionMutex
+var gpuVendorOverride = GPUVendorUnknown
+
+// GPUVendorStatus reports the GPU vendor in use and whether it was set manually
+type GPUVendorStatus struct {
+	Vendor   string `json:"vendor"`   // NVIDIA, AMD, Intel, Generic
+	Override bool   `json:"override"` // 설정으로 지정된 벤더
+}
+
+// ParseGPUVendor parses a configured vendor name ("" or "auto" = auto-detect)
+func ParseGPUVendor(name string) (GPUVendor, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "auto":
+		return GPUVendorUnknown, nil
+	case "nvidia":
+		return GPUVendorNVIDIA, nil
+	case "amd":
+		return GPUVendorAMD, nil
+	case "intel":
+		return GPUVendorIntel, nil
+	case "generic":
+		return GPUVendorGeneric, nil
+	default:
+		return GPUVendorUnknown, fmt.Errorf("unknown GPU vendor: %s", name)
+	}
+}
+
+// SetGPUVendorOverride pins the GPU vendor ("" or "auto" = auto-detect); GPU caches are cleared when the vendor changes
+func SetGPUVendorOverride(name string) error {
+	vendor, err := ParseGPUVendor(name)
+	if err != nil {
+		return err
+	}
+
+	gpuVendorDetectionMutex.Lock()
+	changed := gpuVendorOverride != vendor
+	gpuVendorOverride = vendor
+	if vendor != GPUVendorUnknown {
+		detectedGPUVendor = vendor
+		gpuVendorDetected = true
+	} else if changed {
+		// 수동 지정 해제: 다음 조회 시 자동 감지
+		gpuVendorDetected = false
+	}
+	gpuVendorDetectionMutex.Unlock()
+
+	if changed {
+		clearAllCaches()
+		LogInfo("GPU vendor override applied", "vendor", vendor.String())
+	}
+	return nil
+}
+
+// RedetectGPUVendor clears the latched vendor and GPU caches and detects the vendor again
+// (a configured override is kept)
+func RedetectGPUVendor() GPUVendorStatus {
+	gpuVendorDetectionMutex.Lock()
+	override := gpuVendorOverride != GPUVendorUnknown
+	if !override {
+		gpuVendorDetected = false
+	}
+	gpuVendorDetectionMutex.Unlock()
+
+	clearAllCaches()
+	return GPUVendorStatus{Vendor: getDetectedGPUVendor().String(), Override: override}
+}
+
+// GetGPUVendorStatus returns the GPU vendor in use, detecting it if necessary
+func GetGPUVendorStatus() GPUVendorStatus {
+	vendor := getDetectedGPUVendor()
+	gpuVendorDetectionMutex.RLock()
+	override := gpuVendorOverride != GPUVendorUnknown
+	gpuVendorDetectionMutex.RUnlock()
+	return GPUVendorStatus{Vendor: vendor.String(), Override: override}
+}
@@ -0,0 +1,43 @@
+package monitoring
+
+import "testing"
+
+func TestParseGPUVendor(t *testing.T) {
+	cases := map[string]GPUVendor{"": GPUVendorUnknown, "auto": GPUVendorUnknown, " AMD ": GPUVendorAMD, "nvidia": GPUVendorNVIDIA, "Intel": GPUVendorIntel}
+	for name, expected := range cases {
+		if vendor, err := ParseGPUVendor(name); err != nil || vendor != expected {
+			t.Errorf("ParseGPUVendor(%q) = %v, %v; expected %v", name, vendor, err, expected)
+		}
+	}
+	if _, err := ParseGPUVendor("matrox"); err == nil {
+		t.Error("Expected an error for an unknown vendor")
+	}
+}
+
+func TestGPUVendorOverride(t *testing.T) {
+	defer SetGPUVendorOverride("")
+
+	if err := SetGPUVendorOverride("amd"); err != nil {
+		t.Fatal(err)
+	}
+	if status := GetGPUVendorStatus(); status.Vendor != "AMD" || !status.Override {
+		t.Errorf("Expected AMD override, got %+v", status)
+	}
+	// 재감지해도 수동 지정은 유지
+	if status := RedetectGPUVendor(); status.Vendor != "AMD" || !status.Override {
+		t.Errorf("Expected override to survive re-detection, got %+v", status)
+	}
+
+	if err := SetGPUVendorOverride("auto"); err != nil {
+		t.Fatal(err)
+	}
+	gpuVendorDetectionMutex.RLock()
+	detected := gpuVendorDetected
+	gpuVendorDetectionMutex.RUnlock()
+	if detected {
+		t.Error("Expected clearing the override to reset the latched vendor")
+	}
+	if err := SetGPUVendorOverride("matrox"); err == nil {
+		t.Error("Expected an error for an unknown vendor")
+	}
+}
@@ -0,0 +1,287 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Windows 이벤트 로그 하드웨어 오류 수집
+// WHEA 오류, 디스크 오류, 열(thermal) 이벤트, 드라이버 크래시(nvlddmkm 등)를 System 로그에서 조회
+
+// Hardware event categories
+const (
+	HardwareEventWHEA    = "whea"
+	HardwareEventDisk    = "disk"
+	HardwareEventThermal = "thermal"
+	HardwareEventDriver  = "driver"
+)
+
+const (
+	HARDWARE_EVENT_POLL_INTERVAL = 60 * time.Second
+	hardwareEventMaxResults      = 50
+)
+
+// HardwareEvent represents a hardware-related Windows Event Log entry
+type HardwareEvent struct {
+	RecordID  uint64    `json:"record_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Channel   string    `json:"channel"`
+	Provider  string    `json:"provider"`
+	EventID   int       `json:"event_id"`
+	Level     string    `json:"level"` // critical, error, warning, information
+	Category  string    `json:"category"`
+	Message   string    `json:"message"`
+}
+
+// hardwareEventSource describes which provider/event IDs map to a hardware category
+type hardwareEventSource struct {
+	provider string
+	eventIDs []int // 비어 있으면 모든 이벤트 ID
+	category string
+}
+
+var hardwareEventSources = []hardwareEventSource{
+	{provider: "Microsoft-Windows-WHEA-Logger", category: HardwareEventWHEA},
+	{provider: "disk", eventIDs: []int{7, 11, 15, 51, 52, 153}, category: HardwareEventDisk},
+	{provider: "Ntfs", eventIDs: []int{55, 98, 140}, category: HardwareEventDisk},
+	{provider: "stornvme", category: HardwareEventDisk},
+	{provider: "storahci", eventIDs: []int{129}, category: HardwareEventDisk},
+	{provider: "Microsoft-Windows-Kernel-Power", eventIDs: []int{86, 88, 125}, category: HardwareEventThermal},
+	{provider: "Microsoft-Windows-Kernel-Processor-Power", eventIDs: []int{37}, category: HardwareEventThermal},
+	{provider: "Display", eventIDs: []int{4101}, category: HardwareEventDriver},
+	{provider: "nvlddmkm", category: HardwareEventDriver},
+	{provider: "amdkmdag", category: HardwareEventDriver},
+}
+
+// wevtutil RenderedXml 출력 구조
+type eventLogXML struct {
+	System struct {
+		Provider struct {
+			Name string `xml:"Name,attr"`
+		} `xml:"Provider"`
+		EventID     int `xml:"EventID"`
+		Level       int `xml:"Level"`
+		TimeCreated struct {
+			SystemTime string `xml:"SystemTime,attr"`
+		} `xml:"TimeCreated"`
+		EventRecordID uint64 `xml:"EventRecordID"`
+		Channel       string `xml:"Channel"`
+	} `xml:"System"`
+	RenderingInfo struct {
+		Message string `xml:"Message"`
+	} `xml:"RenderingInfo"`
+}
+
+type eventLogXMLList struct {
+	Events []eventLogXML `xml:"Event"`
+}
+
+// GetHardwareEvents returns hardware-related Windows Event Log entries newer than since
+func GetHardwareEvents(ctx context.Context, since time.Time) ([]HardwareEvent, error) {
+	return queryWithContext(ctx, "GetHardwareEvents", func() ([]HardwareEvent, error) { return getHardwareEvents(since) })
+}
+
+func getHardwareEvents(since time.Time) ([]HardwareEvent, error) {
+	if runtime.GOOS != "windows" {
+		return nil, fmt.Errorf("hardware event log collection only supported on Windows")
+	}
+
+	cmd := createHiddenCommandWithTimeout("wevtutil", 10, "qe", "System",
+		"/q:"+buildHardwareEventQuery(since),
+		"/f:RenderedXml", "/rd:true", fmt.Sprintf("/c:%d", hardwareEventMaxResults))
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("wevtutil query failed: %v", err)
+	}
+
+	return parseHardwareEventsXML(output)
+}
+
+// buildHardwareEventQuery builds an XPath query for the configured hardware event sources
+func buildHardwareEventQuery(since time.Time) string {
+	var providers []string
+	for _, source := range hardwareEventSources {
+		condition := fmt.Sprintf("Provider[@Name='%s']", source.provider)
+		if len(source.eventIDs) > 0 {
+			ids := make([]string, len(source.eventIDs))
+			for i, id := range source.eventIDs {
+				ids[i] = fmt.Sprintf("EventID=%d", id)
+			}
+			condition = fmt.Sprintf("(%s and (%s))", condition, strings.Join(ids, " or "))
+		}
+		providers = append(providers, condition)
+	}
+
+	query := "*[System[(" + strings.Join(providers, " or ") + ")"
+	if !since.IsZero() {
+		query += fmt.Sprintf(" and TimeCreated[@SystemTime>'%s']", since.UTC().Format("2006-01-02T15:04:05.000Z"))
+	}
+	return query + "]]"
+}
+
+// parseHardwareEventsXML parses concatenated <Event> elements produced by wevtutil
+func parseHardwareEventsXML(output []byte) ([]HardwareEvent, error) {
+	trimmed := strings.TrimSpace(string(output))
+	if trimmed == "" {
+		return []HardwareEvent{}, nil
+	}
+
+	var list eventLogXMLList
+	if err := xml.Unmarshal([]byte("<Events>"+trimmed+"</Events>"), &list); err != nil {
+		return nil, fmt.Errorf("failed to parse event log XML: %v", err)
+	}
+
+	events := make([]HardwareEvent, 0, len(list.Events))
+	for _, e := range list.Events {
+		timestamp, _ := time.Parse(time.RFC3339Nano, e.System.TimeCreated.SystemTime)
+		events = append(events, HardwareEvent{
+			RecordID:  e.System.EventRecordID,
+			Timestamp: timestamp,
+			Channel:   e.System.Channel,
+			Provider:  e.System.Provider.Name,
+			EventID:   e.System.EventID,
+			Level:     eventLevelName(e.System.Level),
+			Category:  classifyHardwareEvent(e.System.Provider.Name, e.System.EventID),
+			Message:   strings.TrimSpace(e.RenderingInfo.Message),
+		})
+	}
+	return events, nil
+}
+
+// classifyHardwareEvent maps a provider/event ID to a hardware event category
+func classifyHardwareEvent(provider string, eventID int) string {
+	for _, source := range hardwareEventSources {
+		if !strings.EqualFold(source.provider, provider) {
+			continue
+		}
+		if len(source.eventIDs) == 0 {
+			return source.category
+		}
+		for _, id := range source.eventIDs {
+			if id == eventID {
+				return source.category
+			}
+		}
+	}
+	return "other"
+}
+
+// eventLevelName converts Windows event levels to names
+func eventLevelName(level int) string {
+	switch level {
+	case 1:
+		return "critical"
+	case 2:
+		return "error"
+	case 3:
+		return "warning"
+	default:
+		return "information"
+	}
+}
+
+// HardwareEventWatcher polls the Windows Event Log and reports new hardware events
+type HardwareEventWatcher struct {
+	mutex        sync.Mutex
+	lastRecordID uint64
+	lastPoll     time.Time
+	interval     time.Duration
+	handler      func(HardwareEvent)
+	cancel       context.CancelFunc
+}
+
+// NewHardwareEventWatcher creates a watcher that calls handler for each newly logged hardware event
+func NewHardwareEventWatcher(interval time.Duration, handler func(HardwareEvent)) *HardwareEventWatcher {
+	if interval <= 0 {
+		interval = HARDWARE_EVENT_POLL_INTERVAL
+	}
+	return &HardwareEventWatcher{
+		interval: interval,
+		handler:  handler,
+	}
+}
+
+// Start begins polling in the background; events logged before Start are not reported
+func (w *HardwareEventWatcher) Start(ctx context.Context) error {
+	if runtime.GOOS != "windows" {
+		return fmt.Errorf("hardware event watcher only supported on Windows")
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		return nil // already running
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.lastPoll = time.Now()
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-watchCtx.Done():
+				return
+			case <-ticker.C:
+				w.poll()
+			}
+		}
+	}()
+
+	LogInfo("Hardware event watcher started", "interval", w.interval.String())
+	return nil
+}
+
+// Stop stops the background polling
+func (w *HardwareEventWatcher) Stop() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+}
+
+// poll queries for events since the last poll and dispatches unseen ones (oldest first)
+func (w *HardwareEventWatcher) poll() {
+	w.mutex.Lock()
+	since := w.lastPoll
+	lastRecordID := w.lastRecordID
+	w.mutex.Unlock()
+
+	pollTime := time.Now()
+	events, err := getHardwareEvents(since)
+	if err != nil {
+		LogDebug("Hardware event poll failed", "error", err)
+		return
+	}
+
+	maxRecordID := lastRecordID
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.RecordID <= lastRecordID {
+			continue
+		}
+		if event.RecordID > maxRecordID {
+			maxRecordID = event.RecordID
+		}
+		if w.handler != nil {
+			w.handler(event)
+		}
+	}
+
+	w.mutex.Lock()
+	w.lastPoll = pollTime
+	w.lastRecordID = maxRecordID
+	w.mutex.Unlock()
+}
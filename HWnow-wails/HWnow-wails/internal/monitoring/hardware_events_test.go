@@ -0,0 +1,74 @@
+package monitoring
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHardwareEventHelpers(t *testing.T) {
+
+	t.Run("Parse_Rendered_XML", func(t *testing.T) {
+		output := []byte(`<Event xmlns='http://schemas.microsoft.com/win/2004/08/events/event'><System><Provider Name='nvlddmkm'/><EventID Qualifiers='49322'>13</EventID><Level>2</Level><TimeCreated SystemTime='2024-05-01T10:20:30.1234567Z'/><EventRecordID>4521</EventRecordID><Channel>System</Channel></System><RenderingInfo Culture='en-US'><Message>Graphics Exception: ESR 0x405840</Message></RenderingInfo></Event>
+<Event xmlns='http://schemas.microsoft.com/win/2004/08/events/event'><System><Provider Name='Microsoft-Windows-WHEA-Logger'/><EventID>17</EventID><Level>3</Level><TimeCreated SystemTime='2024-05-01T10:19:00.0000000Z'/><EventRecordID>4520</EventRecordID><Channel>System</Channel></System></Event>`)
+
+		events, err := parseHardwareEventsXML(output)
+		if err != nil {
+			t.Fatalf("Failed to parse event XML: %v", err)
+		}
+		if len(events) != 2 {
+			t.Fatalf("Expected 2 events, got %d", len(events))
+		}
+
+		first := events[0]
+		if first.RecordID != 4521 || first.EventID != 13 || first.Provider != "nvlddmkm" {
+			t.Errorf("Unexpected first event: %+v", first)
+		}
+		if first.Category != HardwareEventDriver || first.Level != "error" {
+			t.Errorf("Expected driver/error, got %s/%s", first.Category, first.Level)
+		}
+		if first.Message != "Graphics Exception: ESR 0x405840" {
+			t.Errorf("Unexpected message %q", first.Message)
+		}
+		if first.Timestamp.IsZero() {
+			t.Error("Expected timestamp to be parsed")
+		}
+		if events[1].Category != HardwareEventWHEA || events[1].Level != "warning" {
+			t.Errorf("Expected whea/warning, got %s/%s", events[1].Category, events[1].Level)
+		}
+	})
+
+	t.Run("Parse_Empty_Output", func(t *testing.T) {
+		events, err := parseHardwareEventsXML([]byte("  \r\n"))
+		if err != nil || len(events) != 0 {
+			t.Errorf("Expected no events and no error, got %d events, err=%v", len(events), err)
+		}
+	})
+
+	t.Run("Classify_By_Event_ID", func(t *testing.T) {
+		if category := classifyHardwareEvent("disk", 7); category != HardwareEventDisk {
+			t.Errorf("Expected disk category, got %s", category)
+		}
+		if category := classifyHardwareEvent("Display", 4101); category != HardwareEventDriver {
+			t.Errorf("Expected driver category for TDR, got %s", category)
+		}
+		if category := classifyHardwareEvent("Microsoft-Windows-Kernel-Power", 41); category != "other" {
+			t.Errorf("Expected other for unlisted event ID, got %s", category)
+		}
+	})
+
+	t.Run("Build_Query", func(t *testing.T) {
+		since := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		query := buildHardwareEventQuery(since)
+
+		if !strings.Contains(query, "Provider[@Name='Microsoft-Windows-WHEA-Logger']") {
+			t.Errorf("Query missing WHEA provider: %s", query)
+		}
+		if !strings.Contains(query, "(Provider[@Name='Display'] and (EventID=4101))") {
+			t.Errorf("Query missing Display TDR condition: %s", query)
+		}
+		if !strings.Contains(query, "TimeCreated[@SystemTime>'2024-05-01T10:00:00.000Z']") {
+			t.Errorf("Query missing time condition: %s", query)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package monitoring
+
+import "time"
+
+// 수집기 상태 점검 (/healthz, /readyz)
+// 수집기별 마지막 성공 시각이 허용 구간을 넘으면 stale로 판단하고, GPU 백엔드 가용 여부를 함께 보고
+
+// SubsystemHealth describes whether a collector has produced data recently
+type SubsystemHealth struct {
+	Name        string    `json:"name"`
+	Healthy     bool      `json:"healthy"`
+	LastSuccess time.Time `json:"last_success"` // 한 번도 성공하지 않았으면 0
+	LastRun     time.Time `json:"last_run"`
+	LastError   string    `json:"last_error,omitempty"`
+	Errors      int64     `json:"errors"`
+}
+
+// GetCollectorHealth returns the health of every collector that has run at least once
+func GetCollectorHealth(staleAfter time.Duration) []SubsystemHealth {
+	return collectorHealth(GetCollectorStats(), time.Now(), staleAfter)
+}
+
+// collectorHealth marks collectors without a successful run within staleAfter as unhealthy
+func collectorHealth(stats []CollectorStats, now time.Time, staleAfter time.Duration) []SubsystemHealth {
+	health := make([]SubsystemHealth, 0, len(stats))
+	for _, collector := range stats {
+		entry := SubsystemHealth{
+			Name:        collector.Name,
+			LastSuccess: collector.LastSuccess,
+			LastRun:     collector.LastRun,
+			Errors:      collector.Errors,
+			Healthy:     !collector.LastSuccess.IsZero() && now.Sub(collector.LastSuccess) <= staleAfter,
+		}
+		// 지금은 정상이면 과거 오류 메시지는 노출하지 않음
+		if !entry.Healthy || collector.LastSuccess.Before(collector.LastRun) {
+			entry.LastError = collector.LastError
+		}
+		health = append(health, entry)
+	}
+	return health
+}
+
+// GetGPUBackend returns the detected GPU vendor backend and whether vendor-specific tooling is available
+func GetGPUBackend() (string, bool) {
+	vendor := getDetectedGPUVendor()
+	return vendor.String(), vendor != GPUVendorGeneric && vendor != GPUVendorUnknown
+}
@@ -0,0 +1,45 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCollectorHealth(t *testing.T) {
+	now := time.Now()
+	stats := []CollectorStats{
+		{Name: "cpu", Calls: 10, LastRun: now.Add(-time.Second), LastSuccess: now.Add(-time.Second)},
+		{Name: "gpu_info", Calls: 10, Errors: 3, LastRun: now.Add(-time.Second), LastSuccess: now.Add(-2 * time.Minute), LastError: "nvidia-smi timeout"},
+		{Name: "wifi", Calls: 2, Errors: 2, LastRun: now.Add(-time.Second), LastError: "no wireless interface"},
+		{Name: "memory", Calls: 5, Errors: 1, LastRun: now.Add(-time.Second), LastSuccess: now.Add(-time.Second), LastError: "transient"},
+	}
+
+	health := collectorHealth(stats, now, 30*time.Second)
+	if len(health) != len(stats) {
+		t.Fatalf("Expected %d subsystems, got %d", len(stats), len(health))
+	}
+
+	t.Run("Fresh_Success", func(t *testing.T) {
+		if !health[0].Healthy || health[0].LastError != "" {
+			t.Errorf("Expected cpu to be healthy, got %+v", health[0])
+		}
+	})
+
+	t.Run("Stale_Success", func(t *testing.T) {
+		if health[1].Healthy || health[1].LastError != "nvidia-smi timeout" {
+			t.Errorf("Expected gpu_info to be stale with its last error, got %+v", health[1])
+		}
+	})
+
+	t.Run("Never_Succeeded", func(t *testing.T) {
+		if health[2].Healthy || !health[2].LastSuccess.IsZero() {
+			t.Errorf("Expected wifi to be unhealthy, got %+v", health[2])
+		}
+	})
+
+	t.Run("Recovered_Error_Hidden", func(t *testing.T) {
+		if !health[3].Healthy || health[3].LastError != "" {
+			t.Errorf("Expected recovered memory collector without error, got %+v", health[3])
+		}
+	})
+}
@@ -0,0 +1,227 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"HWnow-wails/internal/winapi"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+// 호스트 정적 정보 (OS 버전/빌드, 가상화 여부, BIOS, 제조사/모델)
+// 실행 중 변하지 않으므로 시작 시 한 번만 수집하고 캐시
+// Windows: 레지스트리(CurrentVersion) + WMI(Win32_BIOS, Win32_ComputerSystem)
+// Linux: /sys/class/dmi/id + gopsutil 가상화 감지
+
+const windowsCurrentVersionKey = `HKLM\SOFTWARE\Microsoft\Windows NT\CurrentVersion`
+
+// HostInfo describes the operating system, firmware and hardware platform of the host
+type HostInfo struct {
+	Hostname     string `json:"hostname"`
+	OS           string `json:"os"`                     // windows, linux, darwin
+	OSName       string `json:"os_name"`                // 예: Microsoft Windows 11 Pro, ubuntu
+	OSVersion    string `json:"os_version"`             // 예: 23H2, 22.04
+	OSBuild      string `json:"os_build,omitempty"`     // 예: 22631.3447 (Windows), 커널 버전 (Linux)
+	Architecture string `json:"architecture"`           // amd64, arm64
+	Virtualized  bool   `json:"virtualized"`            // VM(게스트)에서 실행 중
+	Hypervisor   string `json:"hypervisor,omitempty"`   // VMware, Hyper-V, KVM, VirtualBox ...
+	Manufacturer string `json:"manufacturer,omitempty"` // 시스템 제조사
+	Model        string `json:"model,omitempty"`        // 시스템 모델
+	BIOSVendor   string `json:"bios_vendor,omitempty"`
+	BIOSVersion  string `json:"bios_version,omitempty"`
+	BIOSDate     string `json:"bios_date,omitempty"`
+}
+
+// hostInfoCache holds the one-time collected host information
+type hostInfoCache struct {
+	once sync.Once
+	info *HostInfo
+	err  error
+}
+
+var hostCache = &hostInfoCache{}
+
+// 제조사/모델/BIOS 문자열로 하이퍼바이저를 판별하기 위한 표식 (소문자, 순서대로 검사)
+var hypervisorSignatures = []struct {
+	marker string
+	name   string
+}{
+	{"vmware", "VMware"},
+	{"virtualbox", "VirtualBox"},
+	{"innotek", "VirtualBox"},
+	{"qemu", "QEMU"},
+	{"kvm", "KVM"},
+	{"xen", "Xen"},
+	{"parallels", "Parallels"},
+	{"amazon ec2", "Amazon EC2"},
+	{"google compute engine", "Google Compute Engine"},
+	{"virtual machine", "Hyper-V"}, // Microsoft Corporation / Virtual Machine
+	{"bochs", "Bochs"},
+}
+
+// GetHostInfo returns OS, virtualization, BIOS and system model details (collected once)
+func GetHostInfo(ctx context.Context) (*HostInfo, error) {
+	return queryWithContext(ctx, "GetHostInfo", getHostInfo)
+}
+
+func getHostInfo() (*HostInfo, error) {
+	hostCache.once.Do(func() {
+		info, err := collectHostInfo()
+		hostCache.info = info
+		hostCache.err = err
+		if err != nil {
+			LogDebug("Host info not available", "error", err)
+		} else {
+			LogInfo("Host info collected", "os", info.OSName, "build", info.OSBuild, "virtualized", info.Virtualized, "hypervisor", info.Hypervisor)
+		}
+	})
+
+	if hostCache.err != nil {
+		return nil, hostCache.err
+	}
+	info := *hostCache.info
+	return &info, nil
+}
+
+// HostInfoMetrics converts host information into info metrics (Value 1 = 정보 레코드, Info = 설명 문자열)
+func HostInfoMetrics(info *HostInfo) []Metric {
+	if info == nil {
+		return nil
+	}
+
+	osInfo := strings.TrimSpace(info.OSName + " " + info.OSVersion)
+	if info.OSBuild != "" {
+		osInfo += " (build " + info.OSBuild + ")"
+	}
+	metrics := []Metric{{Type: "host_os_info", Value: 1, Info: osInfo}}
+
+	if system := strings.TrimSpace(info.Manufacturer + " " + info.Model); system != "" {
+		metrics = append(metrics, Metric{Type: "host_system_info", Value: 1, Info: system})
+	}
+	if info.BIOSVersion != "" {
+		metrics = append(metrics, Metric{Type: "host_bios_info", Value: 1, Info: strings.TrimSpace(info.BIOSVendor + " " + info.BIOSVersion + " " + info.BIOSDate)})
+	}
+
+	virtualized := Metric{Type: "host_virtualized", Value: 0, Info: "bare metal"}
+	if info.Virtualized {
+		virtualized.Value = 1
+		virtualized.Info = info.Hypervisor
+	}
+	return append(metrics, virtualized)
+}
+
+func collectHostInfo() (*HostInfo, error) {
+	stat, err := host.Info()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read host info: %v", err)
+	}
+
+	info := &HostInfo{
+		Hostname:     stat.Hostname,
+		OS:           stat.OS,
+		OSName:       stat.Platform,
+		OSVersion:    stat.PlatformVersion,
+		OSBuild:      stat.KernelVersion,
+		Architecture: runtime.GOARCH,
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		fillWindowsHostInfo(info)
+	case "linux":
+		fillLinuxHostInfo(info)
+	}
+
+	// gopsutil이 게스트로 판별한 경우 (주로 Linux: /proc, /sys 기반)
+	if stat.VirtualizationRole == "guest" && stat.VirtualizationSystem != "" {
+		info.Virtualized = true
+		if info.Hypervisor == "" {
+			info.Hypervisor = stat.VirtualizationSystem
+		}
+	}
+	if hypervisor := detectHypervisor(info.Manufacturer, info.Model, info.BIOSVendor, info.BIOSVersion); hypervisor != "" {
+		info.Virtualized = true
+		info.Hypervisor = hypervisor
+	}
+	return info, nil
+}
+
+// fillWindowsHostInfo reads the edition/build from the registry and firmware/model from WMI
+func fillWindowsHostInfo(info *HostInfo) {
+	if productName, err := winapi.RegistryString(windowsCurrentVersionKey, "ProductName"); err == nil {
+		info.OSName = productName
+	}
+	if displayVersion, err := winapi.RegistryString(windowsCurrentVersionKey, "DisplayVersion"); err == nil {
+		info.OSVersion = displayVersion
+	}
+	if build, err := winapi.RegistryString(windowsCurrentVersionKey, "CurrentBuild"); err == nil {
+		info.OSBuild = build
+		if ubr, err := winapi.RegistryDWORD(windowsCurrentVersionKey, "UBR"); err == nil {
+			info.OSBuild += "." + strconv.FormatUint(ubr, 10)
+		}
+		// Windows 11도 ProductName은 "Windows 10 ..."으로 남아 있으므로 빌드 번호로 보정
+		if number, err := strconv.Atoi(build); err == nil && number >= 22000 {
+			info.OSName = strings.Replace(info.OSName, "Windows 10", "Windows 11", 1)
+		}
+	}
+
+	if rows, err := queryWMI("", "Win32_ComputerSystem", "", "Manufacturer", "Model"); err == nil && len(rows) > 0 {
+		info.Manufacturer = strings.TrimSpace(rows[0]["Manufacturer"])
+		info.Model = strings.TrimSpace(rows[0]["Model"])
+	} else if err != nil {
+		LogDebug("Win32_ComputerSystem query failed", "error", err)
+	}
+	if rows, err := queryWMI("", "Win32_BIOS", "", "Manufacturer", "SMBIOSBIOSVersion", "ReleaseDate"); err == nil && len(rows) > 0 {
+		info.BIOSVendor = strings.TrimSpace(rows[0]["Manufacturer"])
+		info.BIOSVersion = strings.TrimSpace(rows[0]["SMBIOSBIOSVersion"])
+		info.BIOSDate = formatWMIDate(rows[0]["ReleaseDate"])
+	} else if err != nil {
+		LogDebug("Win32_BIOS query failed", "error", err)
+	}
+}
+
+// fillLinuxHostInfo reads firmware and model strings exposed by the kernel DMI driver
+func fillLinuxHostInfo(info *HostInfo) {
+	readDMI := func(name string) string {
+		content, err := os.ReadFile(filepath.Join("/sys/class/dmi/id", name))
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(content))
+	}
+	info.Manufacturer = readDMI("sys_vendor")
+	info.Model = readDMI("product_name")
+	info.BIOSVendor = readDMI("bios_vendor")
+	info.BIOSVersion = readDMI("bios_version")
+	info.BIOSDate = readDMI("bios_date")
+}
+
+// detectHypervisor returns the hypervisor name when the system/firmware strings identify a virtual machine
+func detectHypervisor(values ...string) string {
+	combined := strings.ToLower(strings.Join(values, " "))
+	for _, signature := range hypervisorSignatures {
+		if strings.Contains(combined, signature.marker) {
+			return signature.name
+		}
+	}
+	return ""
+}
+
+// formatWMIDate converts a CIM_DATETIME value (20230415000000.000000+000) to YYYY-MM-DD
+func formatWMIDate(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return value
+	}
+	if _, err := strconv.Atoi(value[:8]); err != nil {
+		return value
+	}
+	return value[:4] + "-" + value[4:6] + "-" + value[6:8]
+}
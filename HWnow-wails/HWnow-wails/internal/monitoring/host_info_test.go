@@ -0,0 +1,56 @@
+package monitoring
+
+import "testing"
+
+func TestHostInfo(t *testing.T) {
+	t.Run("Detect_Hypervisor", func(t *testing.T) {
+		cases := []struct {
+			values []string
+			want   string
+		}{
+			{[]string{"VMware, Inc.", "VMware7,1", "VMware, Inc."}, "VMware"},
+			{[]string{"innotek GmbH", "VirtualBox"}, "VirtualBox"},
+			{[]string{"Microsoft Corporation", "Virtual Machine", "Hyper-V UEFI Release v4.1"}, "Hyper-V"},
+			{[]string{"QEMU", "Standard PC (Q35 + ICH9, 2009)", "SeaBIOS"}, "QEMU"},
+			{[]string{"Amazon EC2", "m5.large"}, "Amazon EC2"},
+			{[]string{"Dell Inc.", "XPS 15 9530", "Dell Inc.", "1.8.0"}, ""},
+			{[]string{"Microsoft Corporation", "Surface Laptop 5"}, ""},
+		}
+		for _, c := range cases {
+			if got := detectHypervisor(c.values...); got != c.want {
+				t.Errorf("detectHypervisor(%q) = %q, want %q", c.values, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Format_WMI_Date", func(t *testing.T) {
+		if got := formatWMIDate("20230415000000.000000+000"); got != "2023-04-15" {
+			t.Errorf("formatWMIDate = %q, want 2023-04-15", got)
+		}
+		if got := formatWMIDate("04/15/2023"); got != "04/15/2023" {
+			t.Errorf("non-CIM date should be kept, got %q", got)
+		}
+	})
+
+	t.Run("Info_Metrics", func(t *testing.T) {
+		info := &HostInfo{OSName: "Microsoft Windows 11 Pro", OSVersion: "23H2", OSBuild: "22631.3447", Manufacturer: "VMware, Inc.", Model: "VMware7,1", BIOSVendor: "VMware, Inc.", BIOSVersion: "VMW71.00V", Virtualized: true, Hypervisor: "VMware"}
+		metrics := make(map[string]Metric)
+		for _, metric := range HostInfoMetrics(info) {
+			metrics[metric.Type] = metric
+		}
+		if got := metrics["host_os_info"].Info; got != "Microsoft Windows 11 Pro 23H2 (build 22631.3447)" {
+			t.Errorf("host_os_info = %q", got)
+		}
+		if virtualized := metrics["host_virtualized"]; virtualized.Value != 1 || virtualized.Info != "VMware" {
+			t.Errorf("host_virtualized = %+v", virtualized)
+		}
+		if _, ok := metrics["host_bios_info"]; !ok {
+			t.Error("missing host_bios_info metric")
+		}
+
+		bare := HostInfoMetrics(&HostInfo{OSName: "ubuntu", OSVersion: "22.04"})
+		if last := bare[len(bare)-1]; last.Type != "host_virtualized" || last.Value != 0 {
+			t.Errorf("bare metal metric = %+v", last)
+		}
+	})
+}
@@ -0,0 +1,309 @@
+package monitoring
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// 사용자 표시용 문구 다국어 처리 (API 오류, 보안 권장사항, 권한 상승, 수집기 상태)
+// 문구는 키로 식별하고 언어별 번역을 카탈로그에 둠 - 프론트엔드는 키로 자체 번역하거나 반환된 text를 그대로 표시
+// 언어 선택: 설정의 ui.language가 우선, 비어 있으면 HTTP 요청의 Accept-Language, 둘 다 없으면 영어
+// 번역이 없는 언어는 영어로 대체
+
+// Supported languages
+const (
+	LanguageEnglish = "en"
+	LanguageKorean  = "ko"
+
+	DefaultLanguage = LanguageEnglish
+)
+
+// Message is a user-facing message identified by a key, with its parameters and translated text
+type Message struct {
+	Key    string   `json:"key"`
+	Params []string `json:"params,omitempty"`
+	Text   string   `json:"text"`
+}
+
+// messageCatalog maps message keys to fmt format strings per language (%s = parameters in order)
+var messageCatalog = map[string]map[string]string{
+	// API 오류
+	"api.method_not_allowed": {
+		LanguageEnglish: "method not allowed",
+		LanguageKorean:  "허용되지 않는 메서드입니다",
+	},
+	"api.invalid_request_body": {
+		LanguageEnglish: "invalid request body",
+		LanguageKorean:  "요청 본문이 올바르지 않습니다",
+	},
+	"api.invalid_parameter": {
+		LanguageEnglish: "invalid %s",
+		LanguageKorean:  "%s 값이 올바르지 않습니다",
+	},
+	"api.missing_parameter": {
+		LanguageEnglish: "%s is required",
+		LanguageKorean:  "%s 값이 필요합니다",
+	},
+	"api.invalid_timestamp": {
+		LanguageEnglish: "%s must be an RFC3339 timestamp",
+		LanguageKorean:  "%s 값은 RFC3339 시각이어야 합니다",
+	},
+	"api.unsupported_format": {
+		LanguageEnglish: "unsupported format",
+		LanguageKorean:  "지원하지 않는 형식입니다",
+	},
+	"api.read_only": {
+		LanguageEnglish: "read-only mode: changes are disabled",
+		LanguageKorean:  "읽기 전용 모드에서는 변경할 수 없습니다",
+	},
+	"api.invalid_widget_state": {
+		LanguageEnglish: "%s",
+		LanguageKorean:  "위젯 상태가 올바르지 않습니다: %s",
+	},
+	"api.invalid_state_bundle": {
+		LanguageEnglish: "%s",
+		LanguageKorean:  "상태 아카이브가 올바르지 않습니다: %s",
+	},
+	"api.refresh_rate_limited": {
+		LanguageEnglish: "refresh requested too often, retry in %s seconds",
+		LanguageKorean:  "새로 고침 요청이 너무 잦습니다. %s초 후 다시 시도하세요",
+	},
+
+	// 보안 권장사항 (Windows)
+	"security.privileges_unavailable_windows": {
+		LanguageEnglish: "Process privileges could not be checked. Restart the application as administrator.",
+		LanguageKorean:  "프로세스 권한을 확인할 수 없습니다. 관리자 권한으로 재실행하세요.",
+	},
+	"security.enable_uac": {
+		LanguageEnglish: "Enabling UAC (User Account Control) is recommended for security.",
+		LanguageKorean:  "보안을 위해 UAC(사용자 계정 컨트롤)를 활성화하는 것을 권장합니다.",
+	},
+	"security.run_as_admin": {
+		LanguageEnglish: "Run the application as administrator to control GPU processes.",
+		LanguageKorean:  "GPU 프로세스 제어를 위해 관리자 권한으로 애플리케이션을 실행하세요.",
+	},
+	"security.run_as_admin_howto": {
+		LanguageEnglish: "Right-click and choose 'Run as administrator', or use 'Start-Process -Verb RunAs' in PowerShell.",
+		LanguageKorean:  "마우스 우클릭 후 '관리자 권한으로 실행'을 선택하거나, PowerShell에서 'Start-Process -Verb RunAs' 명령을 사용하세요.",
+	},
+	"security.uac_level": {
+		LanguageEnglish: "Setting the UAC level to 'Default' or 'Always notify' is recommended for security.",
+		LanguageKorean:  "보안상 UAC 레벨을 'Default' 또는 'Always notify'로 설정하는 것을 권장합니다.",
+	},
+	"security.missing_privileges": {
+		LanguageEnglish: "The following privileges are missing: %s. Restart as administrator or check the group policy.",
+		LanguageKorean:  "다음 권한이 부족합니다: %s. 관리자 권한으로 재실행하거나 그룹 정책을 확인하세요.",
+	},
+	"security.secure_mode_windows": {
+		LanguageEnglish: "Secure mode is enabled. GPU process control is performed safely.",
+		LanguageKorean:  "보안 모드가 활성화되었습니다. GPU 프로세스 제어가 안전하게 수행됩니다.",
+	},
+
+	// 보안 권장사항 (Linux/macOS)
+	"security.privileges_unavailable_unix": {
+		LanguageEnglish: "Process privileges could not be checked. Restart the application with sudo.",
+		LanguageKorean:  "프로세스 권한을 확인할 수 없습니다. sudo 권한으로 재실행하세요.",
+	},
+	"security.sudo_required": {
+		LanguageEnglish: "sudo privileges are required to control GPU processes.",
+		LanguageKorean:  "GPU 프로세스 제어를 위해 sudo 권한이 필요합니다.",
+	},
+	"security.sudo_howto": {
+		LanguageEnglish: "Run the application with 'sudo ./your-app', or add the user to the sudoers file.",
+		LanguageKorean:  "'sudo ./your-app' 명령으로 실행하거나, sudoers 파일에 사용자를 추가하세요.",
+	},
+	"security.secure_mode_unix": {
+		LanguageEnglish: "sudo privileges confirmed. GPU process control is available.",
+		LanguageKorean:  "sudo 권한이 확인되었습니다. GPU 프로세스 제어가 가능합니다.",
+	},
+
+	// 권한 상승 요청
+	"elevation.relaunched": {
+		LanguageEnglish: "The application is restarting with administrator privileges. This window will close.",
+		LanguageKorean:  "관리자 권한으로 애플리케이션을 다시 시작합니다. 현재 창은 닫힙니다.",
+	},
+	"elevation.cancelled": {
+		LanguageEnglish: "The administrator privilege request was cancelled.",
+		LanguageKorean:  "관리자 권한 요청이 취소되었습니다.",
+	},
+	"elevation.already_elevated": {
+		LanguageEnglish: "The application is already running with elevated privileges.",
+		LanguageKorean:  "애플리케이션이 이미 상승된 권한으로 실행 중입니다.",
+	},
+	"elevation.sudo_command": {
+		LanguageEnglish: "Close the application and run it again with: %s",
+		LanguageKorean:  "애플리케이션을 종료한 후 다음 명령으로 다시 실행하세요: %s",
+	},
+
+	// 수집기 상태
+	"collector.unavailable": {
+		LanguageEnglish: "%s unavailable",
+		LanguageKorean:  "%s 사용 불가",
+	},
+	"collector.unavailable_reason": {
+		LanguageEnglish: "%s unavailable: %s",
+		LanguageKorean:  "%s 사용 불가: %s",
+	},
+	"collector.cpu":              {LanguageEnglish: "CPU data", LanguageKorean: "CPU 데이터"},
+	"collector.cpu_temperature":  {LanguageEnglish: "CPU temperature", LanguageKorean: "CPU 온도"},
+	"collector.memory":           {LanguageEnglish: "Memory data", LanguageKorean: "메모리 데이터"},
+	"collector.disk":             {LanguageEnglish: "Disk data", LanguageKorean: "디스크 데이터"},
+	"collector.disk_paths":       {LanguageEnglish: "Disk path usage", LanguageKorean: "디스크 경로 사용량"},
+	"collector.disk_temperature": {LanguageEnglish: "Disk temperature", LanguageKorean: "디스크 온도"},
+	"collector.network":          {LanguageEnglish: "Network data", LanguageKorean: "네트워크 데이터"},
+	"collector.network_errors":   {LanguageEnglish: "Network error counters", LanguageKorean: "네트워크 오류 카운터"},
+	"collector.wifi":             {LanguageEnglish: "Wi-Fi data", LanguageKorean: "Wi-Fi 데이터"},
+	"collector.audio":            {LanguageEnglish: "Audio data", LanguageKorean: "오디오 데이터"},
+	"collector.system":           {LanguageEnglish: "System uptime", LanguageKorean: "시스템 가동 시간"},
+	"collector.gpu_info":         {LanguageEnglish: "GPU data", LanguageKorean: "GPU 데이터"},
+	"collector.gpu_engines":      {LanguageEnglish: "GPU engine data", LanguageKorean: "GPU 엔진 데이터"},
+	"collector.gpu_adapters":     {LanguageEnglish: "GPU adapter data", LanguageKorean: "GPU 어댑터 데이터"},
+	"collector.gpu_bandwidth":    {LanguageEnglish: "GPU bandwidth data", LanguageKorean: "GPU 대역폭 데이터"},
+	"collector.gpu_ecc":          {LanguageEnglish: "GPU ECC data", LanguageKorean: "GPU ECC 데이터"},
+	"collector.gpu_processes":    {LanguageEnglish: "GPU process list", LanguageKorean: "GPU 프로세스 목록"},
+	"collector.top_processes":    {LanguageEnglish: "Process list", LanguageKorean: "프로세스 목록"},
+	"collector.rapl":             {LanguageEnglish: "CPU power data", LanguageKorean: "CPU 전력 데이터"},
+	"collector.throttle":         {LanguageEnglish: "Throttling data", LanguageKorean: "스로틀링 데이터"},
+	"collector.fans":             {LanguageEnglish: "Fan data", LanguageKorean: "팬 데이터"},
+	"collector.npu":              {LanguageEnglish: "NPU data", LanguageKorean: "NPU 데이터"},
+	"collector.battery":          {LanguageEnglish: "Battery data", LanguageKorean: "배터리 데이터"},
+	"collector.power":            {LanguageEnglish: "Power estimate", LanguageKorean: "전력 추정치"},
+}
+
+// configuredLanguage is the language set in the configuration ("" = follow Accept-Language)
+var configuredLanguage = struct {
+	mutex    sync.RWMutex
+	language string
+}{}
+
+// NormalizeLanguage maps a language tag (e.g. "ko-KR", "en_US") to a supported language, or "" when unsupported
+func NormalizeLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	switch tag {
+	case LanguageEnglish, LanguageKorean:
+		return tag
+	default:
+		return ""
+	}
+}
+
+// SetLanguage sets the configured message language ("" or "auto" = follow Accept-Language, then English)
+func SetLanguage(language string) error {
+	normalized := ""
+	if trimmed := strings.TrimSpace(language); trimmed != "" && !strings.EqualFold(trimmed, "auto") {
+		normalized = NormalizeLanguage(trimmed)
+		if normalized == "" {
+			return fmt.Errorf("unsupported language: %s", language)
+		}
+	}
+
+	configuredLanguage.mutex.Lock()
+	configuredLanguage.language = normalized
+	configuredLanguage.mutex.Unlock()
+	return nil
+}
+
+// ResolveLanguage picks the message language: the configured language, then the Accept-Language header, then English
+func ResolveLanguage(acceptLanguage string) string {
+	configuredLanguage.mutex.RLock()
+	language := configuredLanguage.language
+	configuredLanguage.mutex.RUnlock()
+	if language != "" {
+		return language
+	}
+	if language = ParseAcceptLanguage(acceptLanguage); language != "" {
+		return language
+	}
+	return DefaultLanguage
+}
+
+// ParseAcceptLanguage returns the supported language with the highest q-value in an Accept-Language header,
+// or "" when none is supported
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		language string
+		quality  float64
+		order    int
+	}
+
+	var candidates []candidate
+	for i, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		language := NormalizeLanguage(fields[0])
+		if language == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				quality = q
+			}
+		}
+		if quality <= 0 {
+			continue // q=0은 "사용하지 않음"
+		}
+		candidates = append(candidates, candidate{language: language, quality: quality, order: i})
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	// q값이 같으면 헤더에 먼저 나온 언어 우선
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].language
+}
+
+// Translate renders a message key in the given language, falling back to English and then to the key itself
+func Translate(language, key string, params ...string) string {
+	translations, ok := messageCatalog[key]
+	if !ok {
+		return key
+	}
+	format, ok := translations[NormalizeLanguage(language)]
+	if !ok {
+		format = translations[DefaultLanguage]
+	}
+	if len(params) == 0 {
+		return format
+	}
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// NewMessage creates a message with its text rendered in the given language
+func NewMessage(language, key string, params ...string) Message {
+	return Message{Key: key, Params: params, Text: Translate(language, key, params...)}
+}
+
+// LocalizeMessages re-renders the text of messages in the given language
+func LocalizeMessages(messages []Message, language string) []Message {
+	localized := make([]Message, len(messages))
+	for i, message := range messages {
+		localized[i] = NewMessage(language, message.Key, message.Params...)
+	}
+	return localized
+}
+
+// messageTexts returns the rendered text of each message
+func messageTexts(messages []Message) []string {
+	texts := make([]string, len(messages))
+	for i, message := range messages {
+		texts[i] = message.Text
+	}
+	return texts
+}
@@ -0,0 +1,100 @@
+package monitoring
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                           "",
+		"ko-KR,ko;q=0.9,en-US;q=0.8": LanguageKorean,
+		"fr-FR,en;q=0.5,ko;q=0.7":    LanguageKorean,
+		"en_US, ko":                  LanguageEnglish, // q값이 같으면 먼저 나온 언어
+		"ko;q=0,en;q=0.1":            LanguageEnglish,
+		"de-DE,fr;q=0.8":             "",
+		"ko;q=invalid":               LanguageKorean,
+	}
+	for header, expected := range cases {
+		if got := ParseAcceptLanguage(header); got != expected {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, expected %q", header, got, expected)
+		}
+	}
+}
+
+func TestResolveLanguage(t *testing.T) {
+	defer SetLanguage("")
+
+	if got := ResolveLanguage("ko-KR"); got != LanguageKorean {
+		t.Errorf("Expected Accept-Language to be used without a configured language, got %q", got)
+	}
+	if got := ResolveLanguage("de"); got != DefaultLanguage {
+		t.Errorf("Expected fallback to %q, got %q", DefaultLanguage, got)
+	}
+	if err := SetLanguage("en-GB"); err != nil {
+		t.Fatalf("SetLanguage failed: %v", err)
+	}
+	if got := ResolveLanguage("ko-KR"); got != LanguageEnglish {
+		t.Errorf("Expected configured language to take precedence, got %q", got)
+	}
+	if err := SetLanguage("klingon"); err == nil {
+		t.Error("Expected an error for an unsupported language")
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if got := Translate(LanguageKorean, "api.invalid_parameter", "limit"); got != "limit 값이 올바르지 않습니다" {
+		t.Errorf("Unexpected Korean translation: %q", got)
+	}
+	// 번역이 없는 언어는 영어, 없는 키는 키 그대로
+	if got := Translate("de", "api.method_not_allowed"); got != "method not allowed" {
+		t.Errorf("Expected English fallback, got %q", got)
+	}
+	if got := Translate(LanguageKorean, "no.such.key"); got != "no.such.key" {
+		t.Errorf("Expected key fallback, got %q", got)
+	}
+}
+
+func TestMessageCatalogComplete(t *testing.T) {
+	for key, translations := range messageCatalog {
+		english, ok := translations[LanguageEnglish]
+		if !ok {
+			t.Errorf("Message %s has no English text", key)
+			continue
+		}
+		korean, ok := translations[LanguageKorean]
+		if !ok {
+			t.Errorf("Message %s has no Korean translation", key)
+			continue
+		}
+		if strings.Count(english, "%s") != strings.Count(korean, "%s") {
+			t.Errorf("Message %s has mismatched parameters: %q / %q", key, english, korean)
+		}
+	}
+}
+
+func TestLocalizeSecurityContext(t *testing.T) {
+	ctx := &SecurityContext{UACStatus: UACStatus{IsElevated: false}}
+	ctx.RecommendationMessages = generateUnixRecommendations(ctx)
+
+	LocalizeSecurityContext(ctx, LanguageKorean)
+	if len(ctx.Recommendations) != 2 || ctx.Recommendations[0] != "GPU 프로세스 제어를 위해 sudo 권한이 필요합니다." {
+		t.Fatalf("Unexpected Korean recommendations: %+v", ctx.Recommendations)
+	}
+	LocalizeSecurityContext(ctx, LanguageEnglish)
+	if ctx.RecommendationMessages[0].Key != "security.sudo_required" || ctx.Recommendations[0] != ctx.RecommendationMessages[0].Text {
+		t.Errorf("Unexpected English recommendations: %+v", ctx.RecommendationMessages)
+	}
+}
+
+func TestCollectorStatusesKorean(t *testing.T) {
+	now := time.Now()
+	stats := []CollectorStats{
+		{Name: "gpu_info", Calls: 1, Errors: 1, ConsecutiveErrors: 1, LastRun: now, LastErrorAt: now, LastError: "nvidia-smi not found"},
+	}
+	statuses := collectorStatuses(stats, LanguageKorean)
+	if statuses[0].Message != "GPU 데이터 사용 불가: nvidia-smi not found" || statuses[0].MessageKey != "collector.unavailable_reason" {
+		t.Errorf("Unexpected Korean collector status: %+v", statuses[0])
+	}
+}
@@ -0,0 +1,212 @@
+package monitoring
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 사용자 유휴 상태 감지 (N분 동안 입력 없음 또는 디스플레이 꺼짐)
+// 유휴 상태에서는 수집 주기를 늘리고 비용이 큰 수집기를 건너뛰며, 입력이 생기면 즉시 원래 주기로 복귀
+// Windows: GetLastInputInfo + 콘솔 디스플레이 상태 전원 알림 (GUID_CONSOLE_DISPLAY_STATE)
+// Linux: xprintidle (X 세션) + DRM 커넥터 DPMS 상태 (/sys/class/drm/*/dpms)
+
+const (
+	DEFAULT_IDLE_THRESHOLD = 5 * time.Minute
+	IDLE_CHECK_INTERVAL    = 2 * time.Second // 입력 시간 조회 최소 간격 (수집 주기마다 호출되므로 캐시)
+
+	pbtPowerSettingChange = 0x8013 // PBT_POWERSETTINGCHANGE
+	displayStateOff       = 0      // GUID_CONSOLE_DISPLAY_STATE 데이터: 0 = 꺼짐, 1 = 켜짐, 2 = 흐리게
+)
+
+// IdleState describes whether the user is away from the machine
+type IdleState struct {
+	Idle        bool      `json:"idle"`
+	IdleSeconds float64   `json:"idle_seconds"` // 마지막 입력 이후 경과 시간 (초, -1 = 알 수 없음)
+	DisplayOff  bool      `json:"display_off"`
+	Since       time.Time `json:"since,omitempty"` // 유휴 상태로 전환된 시각 (유휴가 아니면 zero)
+	Source      string    `json:"source"`          // 입력 시간 출처: last_input_info, xprintidle, unavailable
+}
+
+// IdleDetector tracks user input and display state to decide when monitoring can back off
+type IdleDetector struct {
+	mutex     sync.Mutex
+	threshold time.Duration
+	state     IdleState
+	checkedAt time.Time
+	running   bool
+
+	// Windows 디스플레이 상태 알림 (콜백에서 갱신)
+	displayOff   bool
+	notifyHandle uintptr
+	notifyParams *deviceNotifySubscribeParameters
+}
+
+// NewIdleDetector creates a detector that reports idle after threshold without input (0 = default)
+func NewIdleDetector(threshold time.Duration) *IdleDetector {
+	if threshold <= 0 {
+		threshold = DEFAULT_IDLE_THRESHOLD
+	}
+	return &IdleDetector{threshold: threshold, state: IdleState{IdleSeconds: -1, Source: "unavailable"}}
+}
+
+// Start registers native display state notifications (Windows); input time is polled lazily by State
+func (d *IdleDetector) Start() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.running {
+		return nil // already running
+	}
+	d.running = true
+
+	if runtime.GOOS == "windows" {
+		if err := d.registerDisplayNotification(); err != nil {
+			LogDebug("Display state notification not registered", "error", err)
+		}
+	}
+	LogInfo("Idle detector started", "threshold", d.threshold.String())
+	return nil
+}
+
+// Stop unregisters native notifications
+func (d *IdleDetector) Stop() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.running = false
+	d.unregisterDisplayNotification()
+}
+
+// State returns the current idle state, re-reading the input time at most every IDLE_CHECK_INTERVAL
+func (d *IdleDetector) State() IdleState {
+	now := time.Now()
+
+	d.mutex.Lock()
+	if !d.checkedAt.IsZero() && now.Sub(d.checkedAt) < IDLE_CHECK_INTERVAL {
+		state := d.state
+		d.mutex.Unlock()
+		return state
+	}
+	d.checkedAt = now
+	displayOff := d.displayOff
+	d.mutex.Unlock()
+
+	idleFor, source, err := lastInputIdle()
+	if err != nil {
+		idleFor, source = -1, "unavailable"
+	}
+	if runtime.GOOS == "linux" {
+		displayOff = drmDisplaysOff()
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.update(idleFor, source, displayOff, now)
+	return d.state
+}
+
+// update applies an input/display reading and logs idle transitions (caller holds the mutex)
+func (d *IdleDetector) update(idleFor time.Duration, source string, displayOff bool, now time.Time) {
+	idle := displayOff || (idleFor >= 0 && idleFor >= d.threshold)
+
+	state := IdleState{
+		Idle:        idle,
+		IdleSeconds: -1,
+		DisplayOff:  displayOff,
+		Source:      source,
+	}
+	if idleFor >= 0 {
+		state.IdleSeconds = idleFor.Seconds()
+	}
+
+	switch {
+	case idle && d.state.Idle:
+		state.Since = d.state.Since
+	case idle:
+		// 마지막 입력 시각부터 유휴 상태로 간주
+		state.Since = now
+		if idleFor >= 0 && !displayOff {
+			state.Since = now.Add(-idleFor)
+		}
+		LogInfo("User idle, reducing sampling", "idle_seconds", state.IdleSeconds, "display_off", displayOff)
+	case d.state.Idle:
+		LogInfo("User active, resuming full-rate sampling")
+	}
+	d.state = state
+}
+
+// IdleMetrics converts the idle state into resource log metrics
+func IdleMetrics(state *IdleState) []Metric {
+	if state == nil {
+		return nil
+	}
+	metrics := []Metric{
+		{Type: "user_idle", Value: boolToFloat(state.Idle)},
+		{Type: "display_off", Value: boolToFloat(state.DisplayOff)},
+	}
+	if state.IdleSeconds >= 0 {
+		metrics = append(metrics, Metric{Type: "user_idle_seconds", Value: state.IdleSeconds})
+	}
+	return metrics
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// lastInputIdle returns the time since the last keyboard or mouse input
+func lastInputIdle() (time.Duration, string, error) {
+	switch runtime.GOOS {
+	case "windows":
+		return lastInputIdleWindows()
+	case "linux":
+		output, err := createHiddenCommandWithTimeout("xprintidle", 2).Output()
+		if err != nil {
+			return 0, "", err
+		}
+		milliseconds, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+		if err != nil {
+			return 0, "", err
+		}
+		return time.Duration(milliseconds) * time.Millisecond, "xprintidle", nil
+	}
+	return 0, "", fmt.Errorf("input idle time not supported on %s", runtime.GOOS)
+}
+
+// drmDisplaysOff reports whether every connected DRM connector is in DPMS off
+func drmDisplaysOff() bool {
+	connectors, err := filepath.Glob("/sys/class/drm/card*-*")
+	if err != nil {
+		return false
+	}
+	connected := 0
+	for _, connector := range connectors {
+		status, err := os.ReadFile(filepath.Join(connector, "status"))
+		if err != nil || strings.TrimSpace(string(status)) != "connected" {
+			continue
+		}
+		connected++
+		dpms, err := os.ReadFile(filepath.Join(connector, "dpms"))
+		if err != nil || strings.TrimSpace(string(dpms)) != "Off" {
+			return false
+		}
+	}
+	return connected > 0
+}
+
+// setDisplayOff records a display state change and forces the next State call to re-evaluate
+func (d *IdleDetector) setDisplayOff(off bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.displayOff = off
+	d.checkedAt = time.Time{}
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"fmt"
+	"time"
+)
+
+// lastInputIdleWindows is only available on Windows (GetLastInputInfo)
+func lastInputIdleWindows() (time.Duration, string, error) {
+	return 0, "", fmt.Errorf("GetLastInputInfo not supported on this platform")
+}
+
+// registerDisplayNotification is only available on Windows (Linux reads the DRM connector DPMS state)
+func (d *IdleDetector) registerDisplayNotification() error {
+	return fmt.Errorf("display state notification not supported on this platform")
+}
+
+// unregisterDisplayNotification is a no-op outside Windows
+func (d *IdleDetector) unregisterDisplayNotification() {}
@@ -0,0 +1,60 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdleDetectorUpdate(t *testing.T) {
+	detector := NewIdleDetector(5 * time.Minute)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.Local)
+
+	t.Run("Active", func(t *testing.T) {
+		detector.update(30*time.Second, "last_input_info", false, now)
+		if detector.state.Idle || detector.state.IdleSeconds != 30 || !detector.state.Since.IsZero() {
+			t.Errorf("Expected active state, got %+v", detector.state)
+		}
+	})
+
+	t.Run("Idle_After_Threshold", func(t *testing.T) {
+		detector.update(6*time.Minute, "last_input_info", false, now)
+		if !detector.state.Idle {
+			t.Fatalf("Expected idle state, got %+v", detector.state)
+		}
+		if want := now.Add(-6 * time.Minute); !detector.state.Since.Equal(want) {
+			t.Errorf("Expected idle since last input %v, got %v", want, detector.state.Since)
+		}
+
+		// 유휴 상태가 이어지면 시작 시각 유지
+		detector.update(7*time.Minute, "last_input_info", false, now.Add(time.Minute))
+		if want := now.Add(-6 * time.Minute); !detector.state.Since.Equal(want) {
+			t.Errorf("Expected idle start to be kept, got %v", detector.state.Since)
+		}
+	})
+
+	t.Run("Resume_On_Input", func(t *testing.T) {
+		detector.update(time.Second, "last_input_info", false, now.Add(2*time.Minute))
+		if detector.state.Idle || !detector.state.Since.IsZero() {
+			t.Errorf("Expected active state after input, got %+v", detector.state)
+		}
+	})
+
+	t.Run("Display_Off_Without_Input_Time", func(t *testing.T) {
+		detector.update(-1, "unavailable", true, now)
+		if !detector.state.Idle || !detector.state.DisplayOff || detector.state.IdleSeconds != -1 {
+			t.Errorf("Expected idle state from display off, got %+v", detector.state)
+		}
+	})
+}
+
+func TestIdleMetrics(t *testing.T) {
+	metrics := IdleMetrics(&IdleState{Idle: true, IdleSeconds: 400})
+	if len(metrics) != 3 || metrics[0].Type != "user_idle" || metrics[0].Value != 1 || metrics[2].Value != 400 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+
+	metrics = IdleMetrics(&IdleState{IdleSeconds: -1})
+	if len(metrics) != 2 {
+		t.Errorf("Expected idle seconds to be skipped when unknown, got %+v", metrics)
+	}
+}
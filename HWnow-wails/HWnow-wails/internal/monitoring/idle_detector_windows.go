@@ -0,0 +1,117 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// GUID_CONSOLE_DISPLAY_STATE {6FE69556-704A-47A0-8F24-C28D936FDA47}
+var consoleDisplayStateGUID = syscall.GUID{
+	Data1: 0x6FE69556,
+	Data2: 0x704A,
+	Data3: 0x47A0,
+	Data4: [8]byte{0x8F, 0x24, 0xC2, 0x8D, 0x93, 0x6F, 0xDA, 0x47},
+}
+
+// powerBroadcastSetting mirrors the header of POWERBROADCAST_SETTING
+type powerBroadcastSetting struct {
+	powerSetting syscall.GUID
+	dataLength   uint32
+	data         uint32
+}
+
+// Windows 콜백은 해제할 수 없으므로 한 번만 만들고 현재 감지기에게 전달
+var (
+	displayNotifyCallbackOnce sync.Once
+	displayNotifyCallback     uintptr
+	activeIdleDetectorMutex   sync.Mutex
+	activeIdleDetector        *IdleDetector
+)
+
+// lastInputIdleWindows compares GetLastInputInfo with GetTickCount (both in milliseconds since boot)
+func lastInputIdleWindows() (time.Duration, string, error) {
+	user32 := syscall.NewLazyDLL("user32.dll")
+	getLastInputInfo := user32.NewProc("GetLastInputInfo")
+	getTickCount := syscall.NewLazyDLL("kernel32.dll").NewProc("GetTickCount")
+
+	// LASTINPUTINFO { UINT cbSize; DWORD dwTime; }
+	info := struct {
+		size uint32
+		time uint32
+	}{size: 8}
+	ret, _, err := getLastInputInfo.Call(uintptr(unsafe.Pointer(&info)))
+	if ret == 0 {
+		return 0, "", fmt.Errorf("GetLastInputInfo failed: %v", err)
+	}
+	tick, _, _ := getTickCount.Call()
+	// 틱 카운트는 49.7일마다 순환하므로 uint32 뺄셈으로 계산
+	elapsed := uint32(tick) - info.time
+	return time.Duration(elapsed) * time.Millisecond, "last_input_info", nil
+}
+
+// registerDisplayNotification subscribes to GUID_CONSOLE_DISPLAY_STATE changes (caller holds the mutex)
+func (d *IdleDetector) registerDisplayNotification() error {
+	powrprof := syscall.NewLazyDLL("powrprof.dll")
+	register := powrprof.NewProc("PowerSettingRegisterNotification")
+	if err := register.Find(); err != nil {
+		return err
+	}
+
+	displayNotifyCallbackOnce.Do(func() {
+		displayNotifyCallback = syscall.NewCallback(func(_, eventType uintptr, broadcast *powerBroadcastSetting) uintptr {
+			if eventType != pbtPowerSettingChange || broadcast == nil {
+				return 0
+			}
+			if broadcast.powerSetting != consoleDisplayStateGUID || broadcast.dataLength < 4 {
+				return 0
+			}
+
+			activeIdleDetectorMutex.Lock()
+			detector := activeIdleDetector
+			activeIdleDetectorMutex.Unlock()
+			if detector != nil {
+				// 콜백 스레드를 막지 않도록 비동기로 반영 (등록 해제가 콜백 종료를 기다림)
+				go detector.setDisplayOff(broadcast.data == displayStateOff)
+			}
+			return 0
+		})
+	})
+
+	params := &deviceNotifySubscribeParameters{callback: displayNotifyCallback}
+	var handle uintptr
+	ret, _, _ := register.Call(uintptr(unsafe.Pointer(&consoleDisplayStateGUID)), deviceNotifyCallback,
+		uintptr(unsafe.Pointer(params)), uintptr(unsafe.Pointer(&handle)))
+	if ret != 0 {
+		return fmt.Errorf("PowerSettingRegisterNotification failed: error %d", ret)
+	}
+
+	d.notifyHandle = handle
+	d.notifyParams = params
+	activeIdleDetectorMutex.Lock()
+	activeIdleDetector = d
+	activeIdleDetectorMutex.Unlock()
+	return nil
+}
+
+// unregisterDisplayNotification releases the native registration (caller holds the mutex)
+func (d *IdleDetector) unregisterDisplayNotification() {
+	if d.notifyHandle == 0 {
+		return
+	}
+
+	powrprof := syscall.NewLazyDLL("powrprof.dll")
+	powrprof.NewProc("PowerSettingUnregisterNotification").Call(d.notifyHandle)
+	d.notifyHandle = 0
+	d.notifyParams = nil
+
+	activeIdleDetectorMutex.Lock()
+	if activeIdleDetector == d {
+		activeIdleDetector = nil
+	}
+	activeIdleDetectorMutex.Unlock()
+}
@@ -0,0 +1,89 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/load"
+)
+
+// 스케줄러 부하 (사용률과 별개로 CPU를 기다리는 작업량)
+// Linux/macOS: 1/5/15분 load average
+// Windows: load average가 없으므로 "\System\Processor Queue Length" (실행 대기 중인 스레드 수)
+
+// LoadInfo represents scheduler pressure (-1 = 이 플랫폼에서 제공되지 않음)
+type LoadInfo struct {
+	Load1       float64 `json:"load1"`
+	Load5       float64 `json:"load5"`
+	Load15      float64 `json:"load15"`
+	QueueLength float64 `json:"queue_length"` // 실행 대기 중인 스레드 수 (Windows)
+}
+
+// GetLoadInfo returns load averages (Linux/macOS) or the processor queue length (Windows)
+func GetLoadInfo(ctx context.Context) (*LoadInfo, error) {
+	return queryWithContext(ctx, "GetLoadInfo", getLoadInfo)
+}
+
+func getLoadInfo() (*LoadInfo, error) {
+	info := &LoadInfo{Load1: -1, Load5: -1, Load15: -1, QueueLength: -1}
+
+	if runtime.GOOS == "windows" {
+		cmd := createHiddenCommandWithTimeout("typeperf", 3, `\System\Processor Queue Length`, "-sc", "1")
+		output, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("processor queue length query failed: %v", err)
+		}
+		queueLength, err := parseProcessorQueueOutput(output)
+		if err != nil {
+			return nil, err
+		}
+		info.QueueLength = queueLength
+		return info, nil
+	}
+
+	average, err := load.Avg()
+	if err != nil {
+		return nil, err
+	}
+	info.Load1 = average.Load1
+	info.Load5 = average.Load5
+	info.Load15 = average.Load15
+	return info, nil
+}
+
+// LoadMetrics converts scheduler pressure into resource log metrics (unsupported values are skipped)
+func LoadMetrics(info *LoadInfo) []Metric {
+	if info == nil {
+		return nil
+	}
+	var metrics []Metric
+	if info.Load1 >= 0 {
+		metrics = append(metrics,
+			Metric{Type: "load_1", Value: info.Load1},
+			Metric{Type: "load_5", Value: info.Load5},
+			Metric{Type: "load_15", Value: info.Load15},
+		)
+	}
+	if info.QueueLength >= 0 {
+		metrics = append(metrics, Metric{Type: "cpu_queue_length", Value: info.QueueLength})
+	}
+	return metrics
+}
+
+// parseProcessorQueueOutput reads the processor queue length from typeperf output
+func parseProcessorQueueOutput(output []byte) (float64, error) {
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return 0, err
+	}
+	for i := 1; i < len(header) && i < len(values); i++ {
+		if !strings.HasSuffix(strings.ToLower(header[i]), `\processor queue length`) {
+			continue
+		}
+		return strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+	}
+	return 0, fmt.Errorf("processor queue length counter not found")
+}
@@ -0,0 +1,36 @@
+package monitoring
+
+import "testing"
+
+func TestLoadInfo(t *testing.T) {
+	t.Run("Parse_Processor_Queue_Length", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\System\Processor Queue Length"
+"10/16/2026 10:00:00.000","7.000000"
+`)
+		queueLength, err := parseProcessorQueueOutput(output)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if queueLength != 7 {
+			t.Errorf("queue length = %v, want 7", queueLength)
+		}
+
+		if _, err := parseProcessorQueueOutput([]byte(`"(PDH-CSV 4.0)","\\PC\Memory\Pages/sec"
+"10/16/2026 10:00:00.000","1.0"
+`)); err == nil {
+			t.Error("expected error without the queue length counter")
+		}
+	})
+
+	t.Run("Metrics_Skip_Unsupported", func(t *testing.T) {
+		windows := LoadMetrics(&LoadInfo{Load1: -1, Load5: -1, Load15: -1, QueueLength: 3})
+		if len(windows) != 1 || windows[0].Type != "cpu_queue_length" {
+			t.Errorf("unexpected Windows metrics: %v", windows)
+		}
+
+		unix := LoadMetrics(&LoadInfo{Load1: 1.5, Load5: 1.2, Load15: 0.8, QueueLength: -1})
+		if len(unix) != 3 || unix[0].Type != "load_1" || unix[2].Value != 0.8 {
+			t.Errorf("unexpected load average metrics: %v", unix)
+		}
+	})
+}
@@ -0,0 +1,393 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// 구조화 로깅 (log/slog)
+// 런타임에 로그 레벨 변경, JSON 출력, 크기 기반 로그 파일 회전 지원
+
+// 로깅 레벨 정의
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+	LogLevelFatal
+)
+
+// String returns the level name used by the runtime log-level API
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	case LogLevelFatal:
+		return "fatal"
+	default:
+		return "info"
+	}
+}
+
+// ParseLogLevel converts a level name to a LogLevel
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return LogLevelDebug, nil
+	case "info", "":
+		return LogLevelInfo, nil
+	case "warn", "warning":
+		return LogLevelWarn, nil
+	case "error":
+		return LogLevelError, nil
+	case "fatal":
+		return LogLevelFatal, nil
+	default:
+		return LogLevelInfo, fmt.Errorf("invalid log level: %s", level)
+	}
+}
+
+// slogLevel maps LogLevel to slog levels (fatal은 error보다 높은 레벨로 취급)
+func (l LogLevel) slogLevel() slog.Level {
+	switch l {
+	case LogLevelDebug:
+		return slog.LevelDebug
+	case LogLevelWarn:
+		return slog.LevelWarn
+	case LogLevelError:
+		return slog.LevelError
+	case LogLevelFatal:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// LogOptions configures the logging system
+type LogOptions struct {
+	Level      LogLevel
+	Format     string    // "text" 또는 "json"
+	FilePath   string    // 비어 있으면 파일 기록 안 함
+	MaxSizeMB  int       // 로그 파일 회전 크기
+	MaxBackups int       // 보관할 회전 파일 수
+	Console    io.Writer // 콘솔 출력 대상 (nil이면 stdout, stdout을 데이터 출력에 쓰는 모드에서는 stderr)
+}
+
+// LogSettings describes the current runtime logging configuration
+type LogSettings struct {
+	Level             string `json:"level"`
+	Format            string `json:"format"`
+	FilePath          string `json:"file_path"`
+	GPUMonitoringLogs bool   `json:"gpu_monitoring_logs"`
+}
+
+var (
+	logLevel    = LogLevelInfo
+	logLevelVar = new(slog.LevelVar)
+	loggerMutex sync.RWMutex
+	logger      = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: logLevelVar}))
+	logOptions  = LogOptions{Level: LogLevelInfo, Format: "text"}
+	logFile     *rotatingLogFile
+	levelMutex  sync.RWMutex
+
+	// GPU 모니터링 경로의 상세 로그 (기본 비활성화, 런타임에 켤 수 있음)
+	gpuMonitoringLogsEnabled atomic.Bool
+)
+
+// InitializeLogging - 로깅 시스템 초기화
+func InitializeLogging(options LogOptions) error {
+	var console io.Writer = os.Stdout
+	if options.Console != nil {
+		console = options.Console
+	}
+	var writer io.Writer = console
+	var file *rotatingLogFile
+	if options.FilePath != "" {
+		var err error
+		file, err = openRotatingLogFile(options.FilePath, options.MaxSizeMB, options.MaxBackups)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %v", err)
+		}
+		writer = io.MultiWriter(console, file)
+	}
+
+	handlerOptions := &slog.HandlerOptions{Level: logLevelVar}
+	var handler slog.Handler
+	if strings.EqualFold(options.Format, "json") {
+		options.Format = "json"
+		handler = slog.NewJSONHandler(writer, handlerOptions)
+	} else {
+		options.Format = "text"
+		handler = slog.NewTextHandler(writer, handlerOptions)
+	}
+
+	log.SetOutput(widgetLogWriter{target: console})
+
+	loggerMutex.Lock()
+	previousFile := logFile
+	logger = slog.New(handler)
+	logFile = file
+	logOptions = options
+	loggerMutex.Unlock()
+
+	if previousFile != nil {
+		previousFile.Close()
+	}
+
+	SetLogLevel(options.Level)
+	LogInfo("Logging system initialized", "level", options.Level.String(), "format", options.Format, "file", options.FilePath)
+	return nil
+}
+
+// CloseLogging - 로깅 시스템 종료
+func CloseLogging() {
+	loggerMutex.Lock()
+	defer loggerMutex.Unlock()
+
+	if logFile != nil {
+		logFile.Close()
+		logFile = nil
+	}
+}
+
+// SetLogLevel changes the log level at runtime
+func SetLogLevel(level LogLevel) {
+	levelMutex.Lock()
+	logLevel = level
+	levelMutex.Unlock()
+	logLevelVar.Set(level.slogLevel())
+}
+
+// GetLogLevel returns the current log level
+func GetLogLevel() LogLevel {
+	levelMutex.RLock()
+	defer levelMutex.RUnlock()
+	return logLevel
+}
+
+// SetGPUMonitoringLogsEnabled toggles the verbose GPU monitoring logs (Log*Optimized)
+func SetGPUMonitoringLogsEnabled(enabled bool) {
+	gpuMonitoringLogsEnabled.Store(enabled)
+}
+
+// GetLogSettings returns the current logging configuration
+func GetLogSettings() LogSettings {
+	loggerMutex.RLock()
+	defer loggerMutex.RUnlock()
+
+	return LogSettings{
+		Level:             GetLogLevel().String(),
+		Format:            logOptions.Format,
+		FilePath:          logOptions.FilePath,
+		GPUMonitoringLogs: gpuMonitoringLogsEnabled.Load(),
+	}
+}
+
+// Logger returns a structured logger tagged with a component field
+func Logger(component string) *slog.Logger {
+	return currentLogger().With("component", component)
+}
+
+func currentLogger() *slog.Logger {
+	loggerMutex.RLock()
+	defer loggerMutex.RUnlock()
+	return logger
+}
+
+// 로깅 함수들 (호출한 패키지 이름을 component 필드로 기록: monitoring, services, database, main 등)
+func LogDebug(message string, keyvals ...interface{}) {
+	logWithComponent(slog.LevelDebug, message, keyvals)
+}
+
+func LogInfo(message string, keyvals ...interface{}) {
+	logWithComponent(slog.LevelInfo, message, keyvals)
+}
+
+func LogWarn(message string, keyvals ...interface{}) {
+	logWithComponent(slog.LevelWarn, message, keyvals)
+}
+
+func LogError(message string, keyvals ...interface{}) {
+	logWithComponent(slog.LevelError, message, keyvals)
+}
+
+func LogFatal(message string, keyvals ...interface{}) {
+	logWithComponent(LogLevelFatal.slogLevel(), message, keyvals)
+	CloseLogging()
+	os.Exit(1)
+}
+
+// componentCache maps caller program counters to component names
+var componentCache sync.Map
+
+// logWithComponent writes a record tagged with the package of the code calling the Log* helper
+func logWithComponent(level slog.Level, message string, keyvals []interface{}) {
+	l := currentLogger()
+	ctx := context.Background()
+	// 비활성 레벨은 호출자 조회 비용 없이 바로 반환
+	if !l.Enabled(ctx, level) {
+		return
+	}
+	l.With("component", callerComponent()).Log(ctx, level, message, keyvals...)
+}
+
+// callerComponent returns the package name of the function that called a Log* helper
+func callerComponent() string {
+	var pcs [1]uintptr
+	// runtime.Callers, callerComponent, logWithComponent, Log* 를 건너뜀
+	if runtime.Callers(4, pcs[:]) == 0 {
+		return "unknown"
+	}
+	if component, ok := componentCache.Load(pcs[0]); ok {
+		return component.(string)
+	}
+
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	// 예: HWnow-wails/internal/services.(*AppService).UpdateConfig -> services
+	name := frame.Function
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		name = name[slash+1:]
+	}
+	if dot := strings.Index(name, "."); dot >= 0 {
+		name = name[:dot]
+	}
+	if name == "" {
+		name = "unknown"
+	}
+	componentCache.Store(pcs[0], name)
+	return name
+}
+
+// CPU 최적화된 조건부 로깅 함수들 (GPU 모니터링 경로, 런타임 토글)
+func LogInfoOptimized(msg string, args ...interface{}) {
+	if gpuMonitoringLogsEnabled.Load() {
+		Logger("gpu_monitoring").Info(msg, args...)
+	}
+}
+
+func LogDebugOptimized(msg string, args ...interface{}) {
+	if gpuMonitoringLogsEnabled.Load() {
+		Logger("gpu_monitoring").Debug(msg, args...)
+	}
+}
+
+func LogWarnOptimized(msg string, args ...interface{}) {
+	if gpuMonitoringLogsEnabled.Load() {
+		Logger("gpu_monitoring").Warn(msg, args...)
+	}
+}
+
+func LogErrorOptimized(msg string, args ...interface{}) {
+	if gpuMonitoringLogsEnabled.Load() {
+		Logger("gpu_monitoring").Error(msg, args...)
+	}
+}
+
+// rotatingLogFile is an io.Writer that rotates the log file once it exceeds maxSize
+type rotatingLogFile struct {
+	mutex      sync.Mutex
+	path       string
+	file       *os.File
+	size       int64
+	maxSize    int64
+	maxBackups int
+}
+
+func openRotatingLogFile(path string, maxSizeMB, maxBackups int) (*rotatingLogFile, error) {
+	if maxSizeMB <= 0 {
+		maxSizeMB = 10
+	}
+	if maxBackups < 0 {
+		maxBackups = 0
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	r := &rotatingLogFile{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingLogFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+func (r *rotatingLogFile) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.file == nil {
+		return 0, os.ErrClosed
+	}
+	if r.size+int64(len(p)) > r.maxSize && r.size > 0 {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate renames app.log -> app.log.1 -> app.log.2 ... and opens a new file (caller holds the mutex)
+func (r *rotatingLogFile) rotate() error {
+	r.file.Close()
+
+	if r.maxBackups == 0 {
+		os.Remove(r.path)
+	} else {
+		os.Remove(fmt.Sprintf("%s.%d", r.path, r.maxBackups))
+		for i := r.maxBackups - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+		}
+		os.Rename(r.path, r.path+".1")
+	}
+
+	return r.open()
+}
+
+func (r *rotatingLogFile) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
@@ -0,0 +1,108 @@
+package monitoring
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLogging(t *testing.T) {
+
+	t.Run("Parse_Log_Level", func(t *testing.T) {
+		if level, err := ParseLogLevel("WARNING"); err != nil || level != LogLevelWarn {
+			t.Errorf("Expected warn level, got %v (err=%v)", level, err)
+		}
+		if _, err := ParseLogLevel("verbose"); err == nil {
+			t.Error("Expected error for unknown level")
+		}
+	})
+
+	t.Run("Runtime_Level_Change", func(t *testing.T) {
+		previous := GetLogLevel()
+		defer SetLogLevel(previous)
+
+		SetLogLevel(LogLevelDebug)
+		if GetLogSettings().Level != "debug" {
+			t.Errorf("Expected debug level, got %s", GetLogSettings().Level)
+		}
+	})
+
+	t.Run("Rotate_By_Size", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "hwnow.log")
+		file, err := openRotatingLogFile(path, 1, 2)
+		if err != nil {
+			t.Fatalf("Failed to open log file: %v", err)
+		}
+		defer file.Close()
+
+		line := []byte(strings.Repeat("x", 1023) + "\n")
+		for i := 0; i < 1024*3; i++ {
+			if _, err := file.Write(line); err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+		}
+
+		for _, name := range []string{path, path + ".1", path + ".2"} {
+			if _, err := os.Stat(name); err != nil {
+				t.Errorf("Expected %s to exist: %v", filepath.Base(name), err)
+			}
+		}
+		if _, err := os.Stat(path + ".3"); err == nil {
+			t.Error("Expected at most 2 backups")
+		}
+	})
+	t.Run("Console_Writer", func(t *testing.T) {
+		previous := GetLogSettings()
+		defer InitializeLogging(LogOptions{Level: GetLogLevel(), Format: previous.Format})
+
+		var console bytes.Buffer
+		if err := InitializeLogging(LogOptions{Level: LogLevelInfo, Format: "json", Console: &console}); err != nil {
+			t.Fatalf("InitializeLogging failed: %v", err)
+		}
+		LogInfo("redirected")
+		if !strings.Contains(console.String(), `"msg":"redirected"`) {
+			t.Errorf("Expected log line on console writer, got %q", console.String())
+		}
+	})
+
+	t.Run("Component_Field", func(t *testing.T) {
+		previous := GetLogSettings()
+		defer InitializeLogging(LogOptions{Level: GetLogLevel(), Format: previous.Format})
+		defer SetGPUMonitoringLogsEnabled(previous.GPUMonitoringLogs)
+
+		var console bytes.Buffer
+		if err := InitializeLogging(LogOptions{Level: LogLevelInfo, Format: "json", Console: &console}); err != nil {
+			t.Fatalf("InitializeLogging failed: %v", err)
+		}
+		SetGPUMonitoringLogsEnabled(true)
+
+		cases := []struct {
+			name      string
+			log       func()
+			component string
+		}{
+			{"Caller_Package", func() { LogWarn("caller package", "count", 3) }, `"component":"monitoring"`},
+			{"GPU_Monitoring", func() { LogInfoOptimized("gpu path", "count", 3) }, `"component":"gpu_monitoring"`},
+		}
+		for _, c := range cases {
+			console.Reset()
+			c.log()
+			line := console.String()
+			if !strings.Contains(line, c.component) || !strings.Contains(line, `"count":3`) {
+				t.Errorf("%s: expected %s with key/value fields, got %q", c.name, c.component, line)
+			}
+			if strings.Contains(line, "!BADKEY") {
+				t.Errorf("%s: unexpected !BADKEY in %q", c.name, line)
+			}
+		}
+
+		// 비활성 레벨은 기록되지 않아야 함
+		console.Reset()
+		LogDebug("hidden")
+		if console.Len() != 0 {
+			t.Errorf("Expected debug records to be dropped at info level, got %q", console.String())
+		}
+	})
+}
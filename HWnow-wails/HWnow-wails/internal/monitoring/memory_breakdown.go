@@ -0,0 +1,182 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// 메모리 구성 상세 (사용률 하나만으로는 캐시가 많은 최신 OS에서 오해의 소지가 있음)
+// Windows: GetPerformanceInfo로 커밋 사용량/한도, "\Memory" 성능 카운터로 대기(standby)/수정됨 목록
+//          캐시 = 대기 + 수정됨 (작업 관리자의 "캐시됨"과 같은 기준)
+// Linux: /proc/meminfo의 Cached, Buffers, Committed_AS, CommitLimit (gopsutil)
+
+const MEMORY_STANDBY_CACHE_DURATION = 10 * time.Second // typeperf 프로세스 생성 빈도 제한
+
+// MemoryBreakdown describes how physical memory and commit charge are used (MB, -1 = 알 수 없음)
+type MemoryBreakdown struct {
+	TotalMB       float64 `json:"total_mb"`
+	UsedMB        float64 `json:"used_mb"`
+	AvailableMB   float64 `json:"available_mb"`    // 새 할당에 바로 쓸 수 있는 메모리 (캐시 포함)
+	CachedMB      float64 `json:"cached_mb"`       // 파일 캐시 (Windows: 대기 + 수정됨 목록)
+	BuffersMB     float64 `json:"buffers_mb"`      // 커널 버퍼 (Linux)
+	StandbyMB     float64 `json:"standby_mb"`      // 대기 목록 (Windows)
+	CommitMB      float64 `json:"commit_mb"`       // 커밋 사용량
+	CommitLimitMB float64 `json:"commit_limit_mb"` // 커밋 한도 (물리 메모리 + 페이지 파일)
+	CommitPercent float64 `json:"commit_percent"`  // 커밋 사용량 / 한도 (%)
+}
+
+// memoryListSample caches the Windows standby/modified list sizes read through typeperf
+type memoryListSample struct {
+	mutex      sync.Mutex
+	standbyMB  float64
+	modifiedMB float64
+	err        error
+	timestamp  time.Time
+}
+
+var memoryListCache = &memoryListSample{}
+
+// GetMemoryBreakdown returns available, cached, standby and commit charge details
+func GetMemoryBreakdown(ctx context.Context) (*MemoryBreakdown, error) {
+	return queryWithContext(ctx, "GetMemoryBreakdown", getMemoryBreakdown)
+}
+
+func getMemoryBreakdown() (*MemoryBreakdown, error) {
+	virtual, err := mem.VirtualMemory()
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := &MemoryBreakdown{
+		TotalMB:       bytesToMB(virtual.Total),
+		UsedMB:        bytesToMB(virtual.Used),
+		AvailableMB:   bytesToMB(virtual.Available),
+		CachedMB:      -1,
+		BuffersMB:     -1,
+		StandbyMB:     -1,
+		CommitMB:      -1,
+		CommitLimitMB: -1,
+		CommitPercent: -1,
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		if commit, limit, err := getCommitChargeWindows(); err == nil {
+			breakdown.CommitMB = commit
+			breakdown.CommitLimitMB = limit
+		} else {
+			LogDebug("GetPerformanceInfo failed", "error", err)
+		}
+		if standby, modified, err := getMemoryListsWindows(); err == nil {
+			breakdown.StandbyMB = standby
+			breakdown.CachedMB = standby + modified
+		}
+	case "linux":
+		breakdown.CachedMB = bytesToMB(virtual.Cached)
+		breakdown.BuffersMB = bytesToMB(virtual.Buffers)
+		if virtual.CommitLimit > 0 {
+			breakdown.CommitMB = bytesToMB(virtual.CommittedAS)
+			breakdown.CommitLimitMB = bytesToMB(virtual.CommitLimit)
+		}
+	default:
+		if virtual.Cached > 0 {
+			breakdown.CachedMB = bytesToMB(virtual.Cached)
+		}
+	}
+
+	if breakdown.CommitLimitMB > 0 {
+		breakdown.CommitPercent = breakdown.CommitMB / breakdown.CommitLimitMB * 100
+	}
+	return breakdown, nil
+}
+
+// MemoryBreakdownMetrics converts a memory breakdown into resource log metrics (unknown values are skipped)
+func MemoryBreakdownMetrics(breakdown *MemoryBreakdown) []Metric {
+	if breakdown == nil {
+		return nil
+	}
+	metrics := []Metric{{Type: "memory_available", Value: breakdown.AvailableMB}}
+	if breakdown.CachedMB >= 0 {
+		metrics = append(metrics, Metric{Type: "memory_cached", Value: breakdown.CachedMB})
+	}
+	if breakdown.BuffersMB >= 0 {
+		metrics = append(metrics, Metric{Type: "memory_buffers", Value: breakdown.BuffersMB})
+	}
+	if breakdown.StandbyMB >= 0 {
+		metrics = append(metrics, Metric{Type: "memory_standby", Value: breakdown.StandbyMB})
+	}
+	if breakdown.CommitPercent >= 0 {
+		metrics = append(metrics,
+			Metric{Type: "memory_commit", Value: breakdown.CommitMB},
+			Metric{Type: "memory_commit_percent", Value: breakdown.CommitPercent},
+		)
+	}
+	return metrics
+}
+
+func bytesToMB(bytes uint64) float64 {
+	return float64(bytes) / 1024 / 1024
+}
+
+// getMemoryListsWindows returns the standby and modified page list sizes in MB (cached for MEMORY_STANDBY_CACHE_DURATION)
+func getMemoryListsWindows() (float64, float64, error) {
+	memoryListCache.mutex.Lock()
+	defer memoryListCache.mutex.Unlock()
+
+	if time.Since(memoryListCache.timestamp) < MEMORY_STANDBY_CACHE_DURATION {
+		return memoryListCache.standbyMB, memoryListCache.modifiedMB, memoryListCache.err
+	}
+
+	cmd := createHiddenCommandWithTimeout("typeperf", 3,
+		`\Memory\Standby Cache Core Bytes`,
+		`\Memory\Standby Cache Normal Priority Bytes`,
+		`\Memory\Standby Cache Reserve Bytes`,
+		`\Memory\Modified Page List Bytes`,
+		"-sc", "1")
+	output, err := cmd.Output()
+	if err == nil {
+		memoryListCache.standbyMB, memoryListCache.modifiedMB, err = parseMemoryListOutput(output)
+	} else {
+		err = fmt.Errorf("memory list counter query failed: %v", err)
+	}
+	memoryListCache.err = err
+	memoryListCache.timestamp = time.Now()
+	return memoryListCache.standbyMB, memoryListCache.modifiedMB, err
+}
+
+// parseMemoryListOutput sums the standby counters and reads the modified list from typeperf output (bytes -> MB)
+func parseMemoryListOutput(output []byte) (float64, float64, error) {
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	standby, modified := 0.0, 0.0
+	found := false
+	for i := 1; i < len(header) && i < len(values); i++ {
+		value, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		counter := strings.ToLower(header[i])
+		switch {
+		case strings.Contains(counter, `\standby cache `):
+			standby += value
+			found = true
+		case strings.Contains(counter, `\modified page list bytes`):
+			modified += value
+			found = true
+		}
+	}
+	if !found {
+		return 0, 0, fmt.Errorf("no memory list counters in output")
+	}
+	return standby / 1024 / 1024, modified / 1024 / 1024, nil
+}
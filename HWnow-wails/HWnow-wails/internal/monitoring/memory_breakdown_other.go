@@ -0,0 +1,10 @@
+//go:build !windows
+
+package monitoring
+
+import "fmt"
+
+// getCommitChargeWindows is only available on Windows (GetPerformanceInfo)
+func getCommitChargeWindows() (float64, float64, error) {
+	return 0, 0, fmt.Errorf("commit charge not supported on this platform")
+}
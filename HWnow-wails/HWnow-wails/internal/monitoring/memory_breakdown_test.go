@@ -0,0 +1,53 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMemoryBreakdown(t *testing.T) {
+	t.Run("Parse_Memory_List_Counters", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\Memory\Standby Cache Core Bytes","\\PC\Memory\Standby Cache Normal Priority Bytes","\\PC\Memory\Standby Cache Reserve Bytes","\\PC\Memory\Modified Page List Bytes"
+"10/16/2026 10:00:00.000","104857600","1073741824","524288000","52428800"
+
+Exiting, please wait...
+The command completed successfully.
+`)
+		standby, modified, err := parseMemoryListOutput(output)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if math.Abs(standby-1624) > 0.01 {
+			t.Errorf("standby = %.2f MB, want 1624", standby)
+		}
+		if math.Abs(modified-50) > 0.01 {
+			t.Errorf("modified = %.2f MB, want 50", modified)
+		}
+	})
+
+	t.Run("Missing_Counters", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\Memory\Available Bytes"
+"10/16/2026 10:00:00.000","1048576"
+`)
+		if _, _, err := parseMemoryListOutput(output); err == nil {
+			t.Error("expected error when no memory list counters are present")
+		}
+	})
+
+	t.Run("Metrics_Skip_Unknown_Values", func(t *testing.T) {
+		breakdown := &MemoryBreakdown{AvailableMB: 4096, CachedMB: 2048, BuffersMB: -1, StandbyMB: 1800, CommitMB: 8192, CommitLimitMB: 16384, CommitPercent: 50}
+		types := make(map[string]float64)
+		for _, metric := range MemoryBreakdownMetrics(breakdown) {
+			types[metric.Type] = metric.Value
+		}
+		if _, ok := types["memory_buffers"]; ok {
+			t.Error("unknown buffers value should be skipped")
+		}
+		if types["memory_commit_percent"] != 50 || types["memory_cached"] != 2048 || types["memory_standby"] != 1800 {
+			t.Errorf("unexpected metrics: %v", types)
+		}
+		if MemoryBreakdownMetrics(nil) != nil {
+			t.Error("nil breakdown should produce no metrics")
+		}
+	})
+}
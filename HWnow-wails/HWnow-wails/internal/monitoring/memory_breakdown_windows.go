@@ -0,0 +1,43 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// Windows 커밋 사용량 (psapi GetPerformanceInfo)
+
+// performanceInformation mirrors PERFORMANCE_INFORMATION (psapi.h)
+type performanceInformation struct {
+	cb                uint32
+	commitTotal       uintptr
+	commitLimit       uintptr
+	commitPeak        uintptr
+	physicalTotal     uintptr
+	physicalAvailable uintptr
+	systemCache       uintptr
+	kernelTotal       uintptr
+	kernelPaged       uintptr
+	kernelNonpaged    uintptr
+	pageSize          uintptr
+	handleCount       uint32
+	processCount      uint32
+	threadCount       uint32
+}
+
+// getCommitChargeWindows returns the commit charge and commit limit in MB
+func getCommitChargeWindows() (float64, float64, error) {
+	var info performanceInformation
+	info.cb = uint32(unsafe.Sizeof(info))
+
+	getPerformanceInfo := syscall.NewLazyDLL("psapi.dll").NewProc("GetPerformanceInfo")
+	if ret, _, err := getPerformanceInfo.Call(uintptr(unsafe.Pointer(&info)), uintptr(info.cb)); ret == 0 {
+		return 0, 0, err
+	}
+
+	// 커밋 값은 페이지 단위
+	pageSize := float64(info.pageSize)
+	return float64(info.commitTotal) * pageSize / 1024 / 1024, float64(info.commitLimit) * pageSize / 1024 / 1024, nil
+}
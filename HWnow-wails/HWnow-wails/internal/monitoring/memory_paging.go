@@ -0,0 +1,197 @@
+package monitoring
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 페이징 활동 (메모리 사용률이 높은 것과 실제 메모리 부족을 구분하기 위한 지표)
+// Windows: "\Memory" 성능 카운터 (Pages/sec, Page Reads/sec = 하드 폴트, Pages Input/Output/sec)
+// Linux: /proc/vmstat 누적값(pswpin, pswpout, pgmajfault)의 이전 샘플 대비 변화량
+
+// MemoryPaging represents hard fault and swap traffic rates
+type MemoryPaging struct {
+	PagesPerSec        float64 `json:"pages_per_sec"`         // 디스크와 주고받은 페이지 수 (초당)
+	HardFaultsPerSec   float64 `json:"hard_faults_per_sec"`   // 디스크 읽기가 필요했던 페이지 폴트 (초당)
+	SwapInBytesPerSec  float64 `json:"swap_in_bytes_per_sec"` // 디스크에서 읽어 들인 페이지 (Windows는 매핑된 파일 포함)
+	SwapOutBytesPerSec float64 `json:"swap_out_bytes_per_sec"`
+}
+
+// vmstatSample is one reading of the cumulative Linux paging counters
+type vmstatSample struct {
+	swapIn     uint64 // pswpin (페이지)
+	swapOut    uint64 // pswpout (페이지)
+	majorFault uint64 // pgmajfault
+	timestamp  time.Time
+}
+
+// memoryPagingState keeps the previous vmstat sample for rate calculation
+type memoryPagingState struct {
+	mutex    sync.Mutex
+	previous *vmstatSample
+}
+
+var memoryPaging = &memoryPagingState{}
+
+// GetMemoryPaging returns the current paging activity (Linux needs two calls before reporting rates)
+func GetMemoryPaging(ctx context.Context) (*MemoryPaging, error) {
+	return queryWithContext(ctx, "GetMemoryPaging", getMemoryPaging)
+}
+
+func getMemoryPaging() (*MemoryPaging, error) {
+	switch runtime.GOOS {
+	case "windows":
+		return getMemoryPagingWindows()
+	case "linux":
+		return getMemoryPagingLinux()
+	default:
+		return nil, fmt.Errorf("paging counters not supported on platform: %s", runtime.GOOS)
+	}
+}
+
+// MemoryPagingMetrics converts paging activity into resource log metrics
+func MemoryPagingMetrics(paging *MemoryPaging) []Metric {
+	if paging == nil {
+		return nil
+	}
+	return []Metric{
+		{Type: "memory_pages_per_sec", Value: paging.PagesPerSec},
+		{Type: "memory_hard_faults_per_sec", Value: paging.HardFaultsPerSec},
+		{Type: "swap_in_bytes_per_sec", Value: paging.SwapInBytesPerSec},
+		{Type: "swap_out_bytes_per_sec", Value: paging.SwapOutBytesPerSec},
+	}
+}
+
+func getMemoryPagingWindows() (*MemoryPaging, error) {
+	cmd := createHiddenCommandWithTimeout("typeperf", 3,
+		`\Memory\Pages/sec`,
+		`\Memory\Page Reads/sec`,
+		`\Memory\Pages Input/sec`,
+		`\Memory\Pages Output/sec`,
+		"-sc", "1")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("paging counter query failed: %v", err)
+	}
+	return parseMemoryPagingOutput(output, float64(os.Getpagesize()))
+}
+
+// parseMemoryPagingOutput reads the "\Memory" paging counters from typeperf output
+func parseMemoryPagingOutput(output []byte, pageSize float64) (*MemoryPaging, error) {
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return nil, err
+	}
+
+	paging := &MemoryPaging{}
+	found := false
+	for i := 1; i < len(header) && i < len(values); i++ {
+		value, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		counter := strings.ToLower(header[i])
+		switch {
+		case strings.HasSuffix(counter, `\pages/sec`):
+			paging.PagesPerSec = value
+		case strings.HasSuffix(counter, `\page reads/sec`):
+			paging.HardFaultsPerSec = value
+		case strings.HasSuffix(counter, `\pages input/sec`):
+			paging.SwapInBytesPerSec = value * pageSize
+		case strings.HasSuffix(counter, `\pages output/sec`):
+			paging.SwapOutBytesPerSec = value * pageSize
+		default:
+			continue
+		}
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("no paging counters in output")
+	}
+	return paging, nil
+}
+
+func getMemoryPagingLinux() (*MemoryPaging, error) {
+	content, err := os.ReadFile("/proc/vmstat")
+	if err != nil {
+		return nil, err
+	}
+	current, err := parseVMStat(content)
+	if err != nil {
+		return nil, err
+	}
+	current.timestamp = time.Now()
+
+	memoryPaging.mutex.Lock()
+	defer memoryPaging.mutex.Unlock()
+
+	previous := memoryPaging.previous
+	memoryPaging.previous = current
+	if previous == nil {
+		return nil, fmt.Errorf("collecting paging baseline")
+	}
+	return vmstatRates(previous, current, float64(os.Getpagesize())), nil
+}
+
+// parseVMStat reads the cumulative swap and major fault counters from /proc/vmstat
+func parseVMStat(content []byte) (*vmstatSample, error) {
+	sample := &vmstatSample{}
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "pswpin":
+			sample.swapIn = value
+		case "pswpout":
+			sample.swapOut = value
+		case "pgmajfault":
+			sample.majorFault = value
+		default:
+			continue
+		}
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("no paging counters in /proc/vmstat")
+	}
+	return sample, nil
+}
+
+// vmstatRates converts two cumulative samples into per-second rates (counter resets yield 0)
+func vmstatRates(previous, current *vmstatSample, pageSize float64) *MemoryPaging {
+	seconds := current.timestamp.Sub(previous.timestamp).Seconds()
+	if seconds <= 0 {
+		return &MemoryPaging{}
+	}
+	rate := func(before, after uint64) float64 {
+		if after < before {
+			return 0
+		}
+		return float64(after-before) / seconds
+	}
+
+	swapIn := rate(previous.swapIn, current.swapIn)
+	swapOut := rate(previous.swapOut, current.swapOut)
+	return &MemoryPaging{
+		PagesPerSec:        swapIn + swapOut,
+		HardFaultsPerSec:   rate(previous.majorFault, current.majorFault),
+		SwapInBytesPerSec:  swapIn * pageSize,
+		SwapOutBytesPerSec: swapOut * pageSize,
+	}
+}
@@ -0,0 +1,57 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryPaging(t *testing.T) {
+	t.Run("Parse_Windows_Counters", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\Memory\Pages/sec","\\PC\Memory\Page Reads/sec","\\PC\Memory\Pages Input/sec","\\PC\Memory\Pages Output/sec"
+"10/16/2026 10:00:00.000","150.000000","12.000000","100.000000","50.000000"
+`)
+		paging, err := parseMemoryPagingOutput(output, 4096)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if paging.PagesPerSec != 150 || paging.HardFaultsPerSec != 12 {
+			t.Errorf("unexpected rates: %+v", paging)
+		}
+		if paging.SwapInBytesPerSec != 100*4096 || paging.SwapOutBytesPerSec != 50*4096 {
+			t.Errorf("unexpected byte rates: %+v", paging)
+		}
+	})
+
+	t.Run("Parse_VMStat", func(t *testing.T) {
+		content := []byte("nr_free_pages 123\npgmajfault 900\npswpin 40\npswpout 20\npgfault 50000\n")
+		sample, err := parseVMStat(content)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sample.swapIn != 40 || sample.swapOut != 20 || sample.majorFault != 900 {
+			t.Errorf("unexpected sample: %+v", sample)
+		}
+		if _, err := parseVMStat([]byte("nr_free_pages 123\n")); err == nil {
+			t.Error("expected error without paging counters")
+		}
+	})
+
+	t.Run("VMStat_Rates", func(t *testing.T) {
+		now := time.Now()
+		previous := &vmstatSample{swapIn: 100, swapOut: 50, majorFault: 1000, timestamp: now}
+		current := &vmstatSample{swapIn: 120, swapOut: 60, majorFault: 1040, timestamp: now.Add(2 * time.Second)}
+		paging := vmstatRates(previous, current, 4096)
+		if paging.PagesPerSec != 15 || paging.HardFaultsPerSec != 20 {
+			t.Errorf("unexpected rates: %+v", paging)
+		}
+		if paging.SwapInBytesPerSec != 10*4096 || paging.SwapOutBytesPerSec != 5*4096 {
+			t.Errorf("unexpected byte rates: %+v", paging)
+		}
+
+		// 카운터가 초기화된 경우 음수 대신 0
+		reset := &vmstatSample{timestamp: now.Add(4 * time.Second)}
+		if paging := vmstatRates(current, reset, 4096); paging.HardFaultsPerSec != 0 || paging.PagesPerSec != 0 {
+			t.Errorf("counter reset should yield 0, got %+v", paging)
+		}
+	})
+}
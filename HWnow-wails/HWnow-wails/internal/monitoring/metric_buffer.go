@@ -0,0 +1,179 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+)
+
+// 최근 수집 값 메모리 링 버퍼
+// 메트릭 유형별로 최근 N분의 샘플을 보관 (대시보드를 새로 열었을 때나 위젯 스파크라인처럼
+// SQLite 조회 없이 최근 추이가 필요한 곳에서 사용)
+
+const (
+	DEFAULT_RECENT_BUFFER_MINUTES = 10
+	MAX_RECENT_BUFFER_MINUTES     = 60
+	MIN_METRIC_BUFFER_SIZE        = 60
+)
+
+// MetricSample is one buffered value of a metric
+type MetricSample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// metricRing is a ring of samples for one metric type (grows up to the buffer size, then overwrites the oldest)
+type metricRing struct {
+	samples []MetricSample
+	next    int
+	info    string // 마지막 샘플의 부가 정보
+	unit    string
+}
+
+// MetricBuffer keeps the most recent samples of every metric type in memory
+type MetricBuffer struct {
+	mutex     sync.RWMutex
+	size      int
+	retention time.Duration
+	rings     map[string]*metricRing
+}
+
+// NewMetricBuffer creates a buffer keeping the samples of the last retention (0 = default)
+// 수집 주기는 최소 1초이므로 초당 1개 샘플 기준으로 크기를 정함
+func NewMetricBuffer(retention time.Duration) *MetricBuffer {
+	if retention <= 0 {
+		retention = DEFAULT_RECENT_BUFFER_MINUTES * time.Minute
+	}
+	size := int(retention / time.Second)
+	if size < MIN_METRIC_BUFFER_SIZE {
+		size = MIN_METRIC_BUFFER_SIZE
+	}
+	return &MetricBuffer{size: size, retention: retention, rings: make(map[string]*metricRing)}
+}
+
+// Retention returns how far back the buffer keeps samples
+func (b *MetricBuffer) Retention() time.Duration {
+	return b.retention
+}
+
+// Add appends every metric of the snapshot to its ring
+func (b *MetricBuffer) Add(snapshot *ResourceSnapshot) {
+	if snapshot == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, metric := range snapshot.Metrics {
+		ring, ok := b.rings[metric.Type]
+		if !ok {
+			ring = &metricRing{}
+			b.rings[metric.Type] = ring
+		}
+		sample := MetricSample{Timestamp: snapshot.Timestamp, Value: metric.Value}
+		if len(ring.samples) < b.size {
+			ring.samples = append(ring.samples, sample)
+		} else {
+			ring.samples[ring.next] = sample
+		}
+		ring.next = (ring.next + 1) % b.size
+		ring.info = metric.Info
+		ring.unit = metric.Unit
+	}
+}
+
+// Latest returns the most recent value of a metric type
+func (b *MetricBuffer) Latest(metricType string) (Metric, time.Time, bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	ring, ok := b.rings[metricType]
+	if !ok || len(ring.samples) == 0 {
+		return Metric{}, time.Time{}, false
+	}
+	last := ring.samples[(ring.next-1+len(ring.samples))%len(ring.samples)]
+	return Metric{Type: metricType, Value: last.Value, Info: ring.info, Unit: ring.unit}, last.Timestamp, true
+}
+
+// Samples returns up to count most recent samples of a metric type, oldest first (0 = all buffered)
+func (b *MetricBuffer) Samples(metricType string, count int) []MetricSample {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	ring, ok := b.rings[metricType]
+	if !ok {
+		return nil
+	}
+	return ring.last(count)
+}
+
+// SamplesSince returns the samples of a metric type collected after since, oldest first
+func (b *MetricBuffer) SamplesSince(metricType string, since time.Time) []MetricSample {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	ring, ok := b.rings[metricType]
+	if !ok {
+		return nil
+	}
+	samples := ring.last(0)
+	first := 0
+	for first < len(samples) && !samples[first].Timestamp.After(since) {
+		first++
+	}
+	return samples[first:]
+}
+
+// last returns up to count most recent samples, oldest first (0 = all)
+func (r *metricRing) last(count int) []MetricSample {
+	available := len(r.samples)
+	if count <= 0 || count > available {
+		count = available
+	}
+
+	samples := make([]MetricSample, count)
+	start := (r.next - count + available) % available
+	for i := 0; i < count; i++ {
+		samples[i] = r.samples[(start+i)%available]
+	}
+	return samples
+}
+
+// MetricTypes returns the metric types currently held in the buffer
+func (b *MetricBuffer) MetricTypes() []string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	types := make([]string, 0, len(b.rings))
+	for metricType := range b.rings {
+		types = append(types, metricType)
+	}
+	return types
+}
+
+// RecentMetrics is the buffered history of one metric for a time window
+type RecentMetrics struct {
+	Metric  string         `json:"metric"`
+	Unit    string         `json:"unit,omitempty"`
+	Seconds int            `json:"seconds"`
+	Samples []MetricSample `json:"samples"`
+}
+
+// Recent returns the samples of the last window (capped at the retention) in the preferred units
+func (b *MetricBuffer) Recent(metricType string, window time.Duration, now time.Time, units UnitPreferences) *RecentMetrics {
+	if window <= 0 || window > b.retention {
+		window = b.retention
+	}
+
+	recent := &RecentMetrics{
+		Metric:  metricType,
+		Unit:    units.TargetUnit(MetricUnit(metricType)),
+		Seconds: int(window / time.Second),
+		Samples: []MetricSample{},
+	}
+	for _, sample := range b.SamplesSince(metricType, now.Add(-window)) {
+		sample.Value, recent.Unit = units.ConvertValue(metricType, sample.Value)
+		recent.Samples = append(recent.Samples, sample)
+	}
+	return recent
+}
@@ -0,0 +1,257 @@
+package monitoring
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 메트릭 단위 태깅 및 변환
+// 모든 Metric은 수집 시점의 기본 단위(%, bytes/s, MB, °C, W ...)로 기록되며, 이름 규칙 대신 Unit 필드로 단위를 명시
+// 출력 시점(히스토리 API, JSON 스트림)에 사용자가 선호하는 단위로 변환 (저장 값은 항상 기본 단위)
+
+// Metric units
+const (
+	UnitNone           = ""
+	UnitPercent        = "%"
+	UnitBytes          = "bytes"
+	UnitGigabytes      = "GB"
+	UnitMegabytes      = "MB"
+	UnitBytesPerSecond = "bytes/s"
+	UnitKBPerSecond    = "KB/s"
+	UnitMBPerSecond    = "MB/s"
+	UnitMbitPerSecond  = "Mbit/s"
+	UnitCelsius        = "°C"
+	UnitFahrenheit     = "°F"
+	UnitWatts          = "W"
+	UnitMilliwattHours = "mWh"
+	UnitSeconds        = "s"
+	UnitMinutes        = "min"
+	UnitMilliseconds   = "ms"
+	UnitPerSecond      = "/s"
+	UnitFPS            = "fps"
+	UnitDBm            = "dBm"
+	UnitMbps           = "Mbps"
+	UnitRPM            = "RPM"
+	UnitCount          = "count"
+	UnitBoolean        = "bool"
+)
+
+// 이름이 정확히 일치하는 메트릭의 단위
+var metricUnits = map[string]string{
+	"cpu":                   UnitPercent,
+	"ram":                   UnitPercent,
+	"cpu_user":              UnitPercent,
+	"cpu_system":            UnitPercent,
+	"cpu_iowait":            UnitPercent,
+	"cpu_irq":               UnitPercent,
+	"cpu_softirq":           UnitPercent,
+	"cpu_steal":             UnitPercent,
+	"memory_physical":       UnitPercent,
+	"memory_virtual":        UnitPercent,
+	"memory_swap":           UnitPercent,
+	"memory_commit_percent": UnitPercent,
+	"gpu_usage":             UnitPercent,
+	"npu_usage":             UnitPercent,
+	"audio_volume":          UnitPercent,
+	"net_packet_loss":       UnitPercent,
+	"wifi_signal_percent":   UnitPercent,
+
+	"disk_read":  UnitBytesPerSecond,
+	"disk_write": UnitBytesPerSecond,
+	"net_sent":   UnitBytesPerSecond,
+	"net_recv":   UnitBytesPerSecond,
+
+	"disk_total": UnitBytes,
+	"disk_used":  UnitBytes,
+	"disk_free":  UnitBytes,
+
+	"gpu_memory_used":   UnitMegabytes,
+	"gpu_memory_total":  UnitMegabytes,
+	"memory_available":  UnitMegabytes,
+	"memory_cached":     UnitMegabytes,
+	"memory_buffers":    UnitMegabytes,
+	"memory_standby":    UnitMegabytes,
+	"memory_commit":     UnitMegabytes,
+	"npu_memory":        UnitMegabytes,
+	"hwnow_self_rss_mb": UnitMegabytes,
+
+	"battery_design_capacity":        UnitMilliwattHours,
+	"battery_full_charge_capacity":   UnitMilliwattHours,
+	"battery_time_remaining_minutes": UnitMinutes,
+	"system_uptime":                  UnitSeconds,
+	"user_idle_seconds":              UnitSeconds,
+	"throttled_seconds":              UnitSeconds,
+	"fps":                            UnitFPS,
+	"wifi_signal_dbm":                UnitDBm,
+	"wifi_link_rate":                 UnitMbps,
+	"memory_pages_per_sec":           UnitPerSecond,
+	"memory_hard_faults_per_sec":     UnitPerSecond,
+
+	"load_1":                     UnitCount,
+	"load_5":                     UnitCount,
+	"load_15":                    UnitCount,
+	"cpu_queue_length":           UnitCount,
+	"audio_active_sessions":      UnitCount,
+	"hwnow_self_goroutines":      UnitCount,
+	"hwnow_self_open_handles":    UnitCount,
+	"gpu_ecc_corrected_errors":   UnitCount,
+	"gpu_ecc_uncorrected_errors": UnitCount,
+	"gpu_retired_pages":          UnitCount,
+	"gpu_remapped_rows":          UnitCount,
+
+	"battery_plugged":  UnitBoolean,
+	"user_idle":        UnitBoolean,
+	"display_off":      UnitBoolean,
+	"host_virtualized": UnitBoolean,
+	"cpu_throttled":    UnitBoolean,
+	"gpu_throttled":    UnitBoolean,
+}
+
+// 이름 패턴으로 단위가 정해지는 메트릭 (코어/디스크/어댑터별 메트릭 등), 위에서부터 먼저 일치하는 규칙 적용
+var metricUnitPatterns = []struct {
+	pattern *regexp.Regexp
+	unit    string
+}{
+	{regexp.MustCompile(`^cpu_core_\d+$`), UnitPercent},
+	{regexp.MustCompile(`^disk_usage_percent(_.+)?$`), UnitPercent},
+	{regexp.MustCompile(`^gpu_engine_.+$`), UnitPercent},
+	{regexp.MustCompile(`^gpu_adapter_\d+_usage$`), UnitPercent},
+	{regexp.MustCompile(`^gpu_adapter_\d+_memory_used$`), UnitMegabytes},
+	{regexp.MustCompile(`^gpu_process_\d+$`), UnitPercent},
+	{regexp.MustCompile(`^network_.+_status$`), UnitBoolean},
+	{regexp.MustCompile(`^network_.+_(errors|drops|collisions)_per_sec$`), UnitPerSecond},
+	{regexp.MustCompile(`^fan_rpm_.+$`), UnitRPM},
+	{regexp.MustCompile(`^(disk|cpu|gpu)_temp(erature)?(_.+)?$`), UnitCelsius},
+	{regexp.MustCompile(`^hwnow_self_collector_.+_errors$`), UnitCount},
+	{regexp.MustCompile(`_percent$`), UnitPercent},
+	{regexp.MustCompile(`_ms$`), UnitMilliseconds},
+	{regexp.MustCompile(`_mb$`), UnitMegabytes},
+	{regexp.MustCompile(`(_power|_watts)$`), UnitWatts},
+	{regexp.MustCompile(`_bytes_per_sec$`), UnitBytesPerSecond},
+	{regexp.MustCompile(`_info$`), UnitNone},
+}
+
+// MetricUnit returns the base unit a metric type is collected in ("" for informational metrics)
+func MetricUnit(metricType string) string {
+	if unit, ok := metricUnits[metricType]; ok {
+		return unit
+	}
+	for _, rule := range metricUnitPatterns {
+		if rule.pattern.MatchString(metricType) {
+			return rule.unit
+		}
+	}
+	return UnitNone
+}
+
+// TagMetricUnits fills in the base unit of metrics that do not carry one yet
+func TagMetricUnits(metrics []Metric) {
+	for i := range metrics {
+		if metrics[i].Unit == UnitNone {
+			metrics[i].Unit = MetricUnit(metrics[i].Type)
+		}
+	}
+}
+
+// UnitPreferences selects the units metrics are reported in (empty fields keep the base unit)
+type UnitPreferences struct {
+	Temperature string `json:"temperature"` // °C, °F
+	DataRate    string `json:"data_rate"`   // bytes/s, KB/s, MB/s, Mbit/s
+	Memory      string `json:"memory"`      // MB, GB
+	Storage     string `json:"storage"`     // bytes, GB
+}
+
+// 단위 종류별로 선택할 수 있는 단위
+var unitChoices = map[string][]string{
+	"temperature": {UnitCelsius, UnitFahrenheit},
+	"data_rate":   {UnitBytesPerSecond, UnitKBPerSecond, UnitMBPerSecond, UnitMbitPerSecond},
+	"memory":      {UnitMegabytes, UnitGigabytes},
+	"storage":     {UnitBytes, UnitGigabytes},
+}
+
+// CanonicalUnitChoice returns the canonical spelling of a unit selectable for the given kind
+// (temperature, data_rate, memory, storage); false if the unit is not a valid choice
+func CanonicalUnitChoice(kind, unit string) (string, bool) {
+	for _, choice := range unitChoices[kind] {
+		if strings.EqualFold(choice, strings.TrimSpace(unit)) {
+			return choice, true
+		}
+	}
+	return "", false
+}
+
+// TargetUnit returns the preferred unit for a base unit
+func (p UnitPreferences) TargetUnit(baseUnit string) string {
+	preferred := ""
+	switch baseUnit {
+	case UnitCelsius:
+		preferred = p.Temperature
+	case UnitBytesPerSecond:
+		preferred = p.DataRate
+	case UnitMegabytes:
+		preferred = p.Memory
+	case UnitBytes:
+		preferred = p.Storage
+	}
+	if preferred == "" {
+		return baseUnit
+	}
+	return preferred
+}
+
+// ConvertUnit converts a value between two units of the same kind (false if no conversion exists)
+func ConvertUnit(value float64, from, to string) (float64, bool) {
+	if from == to {
+		return value, true
+	}
+	switch from + "->" + to {
+	case UnitCelsius + "->" + UnitFahrenheit:
+		return value*9/5 + 32, true
+	case UnitBytesPerSecond + "->" + UnitKBPerSecond:
+		return value / 1024, true
+	case UnitBytesPerSecond + "->" + UnitMBPerSecond:
+		return value / 1024 / 1024, true
+	case UnitBytesPerSecond + "->" + UnitMbitPerSecond:
+		return value * 8 / 1000 / 1000, true
+	case UnitMegabytes + "->" + UnitGigabytes:
+		return value / 1024, true
+	case UnitBytes + "->" + UnitGigabytes:
+		return value / 1024 / 1024 / 1024, true
+	}
+	return value, false
+}
+
+// ConvertValue converts a value of the given metric type from its base unit to the preferred unit
+func (p UnitPreferences) ConvertValue(metricType string, value float64) (float64, string) {
+	baseUnit := MetricUnit(metricType)
+	target := p.TargetUnit(baseUnit)
+	if converted, ok := ConvertUnit(value, baseUnit, target); ok {
+		return converted, target
+	}
+	return value, baseUnit
+}
+
+// NormalizeMetric tags the metric with its base unit and converts it to the preferred unit
+func (p UnitPreferences) NormalizeMetric(metric Metric) Metric {
+	if metric.Unit == UnitNone {
+		metric.Unit = MetricUnit(metric.Type)
+	}
+	target := p.TargetUnit(metric.Unit)
+	if value, ok := ConvertUnit(metric.Value, metric.Unit, target); ok {
+		metric.Value = value
+		metric.Unit = target
+	}
+	return metric
+}
+
+// NormalizeSnapshot returns a copy of the snapshot with every metric in the preferred units
+func (p UnitPreferences) NormalizeSnapshot(snapshot *ResourceSnapshot) *ResourceSnapshot {
+	normalized := &ResourceSnapshot{
+		Timestamp: snapshot.Timestamp,
+		Metrics:   make([]Metric, len(snapshot.Metrics)),
+	}
+	for i, metric := range snapshot.Metrics {
+		normalized.Metrics[i] = p.NormalizeMetric(metric)
+	}
+	return normalized
+}
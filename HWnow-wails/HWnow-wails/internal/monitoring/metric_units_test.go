@@ -0,0 +1,69 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMetricUnit(t *testing.T) {
+	cases := map[string]string{
+		"cpu":                         UnitPercent,
+		"cpu_core_3":                  UnitPercent,
+		"disk_usage_percent_c":        UnitPercent,
+		"gpu_engine_decode":           UnitPercent,
+		"gpu_adapter_1_memory_used":   UnitMegabytes,
+		"net_recv":                    UnitBytesPerSecond,
+		"swap_in_bytes_per_sec":       UnitBytesPerSecond,
+		"disk_total":                  UnitBytes,
+		"cpu_temperature":             UnitCelsius,
+		"disk_temp_nvme0n1":           UnitCelsius,
+		"gpu_power":                   UnitWatts,
+		"battery_charge_rate_watts":   UnitWatts,
+		"net_latency_ms":              UnitMilliseconds,
+		"hwnow_self_collector_cpu_ms": UnitMilliseconds,
+		"hwnow_self_heap_alloc_mb":    UnitMegabytes,
+		"user_idle":                   UnitBoolean,
+		"host_os_info":                UnitNone,
+		"unknown_metric":              UnitNone,
+	}
+	for metricType, want := range cases {
+		if got := MetricUnit(metricType); got != want {
+			t.Errorf("MetricUnit(%q) = %q, want %q", metricType, got, want)
+		}
+	}
+}
+
+func TestUnitPreferencesNormalizeMetric(t *testing.T) {
+	preferences := UnitPreferences{
+		Temperature: UnitFahrenheit,
+		DataRate:    UnitMbitPerSecond,
+		Memory:      UnitGigabytes,
+	}
+
+	cases := []struct {
+		metric    Metric
+		wantValue float64
+		wantUnit  string
+	}{
+		{Metric{Type: "cpu_temperature", Value: 50}, 122, UnitFahrenheit},
+		{Metric{Type: "net_recv", Value: 1250000}, 10, UnitMbitPerSecond},
+		{Metric{Type: "gpu_memory_used", Value: 2048}, 2, UnitGigabytes},
+		{Metric{Type: "disk_total", Value: 1024}, 1024, UnitBytes}, // 선호 단위 없음 → 기본 단위 유지
+		{Metric{Type: "cpu", Value: 42}, 42, UnitPercent},
+	}
+	for _, tc := range cases {
+		got := preferences.NormalizeMetric(tc.metric)
+		if math.Abs(got.Value-tc.wantValue) > 1e-9 || got.Unit != tc.wantUnit {
+			t.Errorf("NormalizeMetric(%s) = %v %s, want %v %s", tc.metric.Type, got.Value, got.Unit, tc.wantValue, tc.wantUnit)
+		}
+	}
+}
+
+func TestCanonicalUnitChoice(t *testing.T) {
+	if unit, ok := CanonicalUnitChoice("data_rate", "mb/s"); !ok || unit != UnitMBPerSecond {
+		t.Errorf("Expected MB/s, got %q (%v)", unit, ok)
+	}
+	if _, ok := CanonicalUnitChoice("memory", "°F"); ok {
+		t.Error("Expected °F to be rejected for memory")
+	}
+}
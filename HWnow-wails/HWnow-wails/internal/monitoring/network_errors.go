@@ -0,0 +1,171 @@
+package monitoring
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// 인터페이스별 네트워크 오류/드롭/충돌 카운터 (net.IOCounters(pernic=true))
+// 누적 카운터를 초당 증가율로 변환하여, 오류율이 0이 아니게 되면 알림 (불량 케이블, 드라이버 문제 조기 발견)
+// - 충돌(collision) 수는 gopsutil에 없으므로 Linux에서만 /sys/class/net/{iface}/statistics/collisions에서 읽음
+// - 드롭은 알 수 없는 프로토콜/VLAN 패킷 등 정상 상황에서도 증가하므로 메트릭으로만 기록하고 알림하지 않음
+
+// 연속 호출(수집 주기 + API 조회) 시 너무 짧은 간격으로 비율을 계산하지 않도록 직전 결과를 재사용하는 최소 간격
+const NETWORK_ERROR_MIN_SAMPLE_INTERVAL = time.Second
+
+// NetworkInterfaceErrors holds the error/drop/collision counters of a network interface and their rates per second
+type NetworkInterfaceErrors struct {
+	Name          string  `json:"name"`
+	ErrorsIn      uint64  `json:"errors_in"`
+	ErrorsOut     uint64  `json:"errors_out"`
+	DropsIn       uint64  `json:"drops_in"`
+	DropsOut      uint64  `json:"drops_out"`
+	Collisions    int64   `json:"collisions"` // -1 = 지원 안 함 (Linux만)
+	ErrorsInRate  float64 `json:"errors_in_rate"`
+	ErrorsOutRate float64 `json:"errors_out_rate"`
+	DropsInRate   float64 `json:"drops_in_rate"`
+	DropsOutRate  float64 `json:"drops_out_rate"`
+	CollisionRate float64 `json:"collision_rate"`
+}
+
+// NetworkErrorAlert reports an interface whose error or collision rate became non-zero
+type NetworkErrorAlert struct {
+	Interface     string    `json:"interface"`
+	ErrorsInRate  float64   `json:"errors_in_rate"`
+	ErrorsOutRate float64   `json:"errors_out_rate"`
+	CollisionRate float64   `json:"collision_rate"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// NetworkErrorMonitor turns cumulative interface counters into rates and detects interfaces that start failing
+type NetworkErrorMonitor struct {
+	mutex      sync.Mutex
+	previous   map[string]NetworkInterfaceErrors
+	failing    map[string]bool // 직전 샘플에서 오류율이 0이 아니었던 인터페이스
+	lastSample time.Time
+	lastResult []NetworkInterfaceErrors
+}
+
+// NewNetworkErrorMonitor creates a monitor; the first sample of each interface only records the baseline
+func NewNetworkErrorMonitor() *NetworkErrorMonitor {
+	return &NetworkErrorMonitor{
+		previous: make(map[string]NetworkInterfaceErrors),
+		failing:  make(map[string]bool),
+	}
+}
+
+// Sample reads the per-interface counters and returns them with their rates since the previous sample,
+// plus one alert per interface whose error or collision rate became non-zero
+func (m *NetworkErrorMonitor) Sample() ([]NetworkInterfaceErrors, []NetworkErrorAlert, error) {
+	m.mutex.Lock()
+	if !m.lastSample.IsZero() && time.Since(m.lastSample) < NETWORK_ERROR_MIN_SAMPLE_INTERVAL {
+		result := m.lastResult
+		m.mutex.Unlock()
+		return result, nil, nil
+	}
+	m.mutex.Unlock()
+
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get network I/O counters: %v", err)
+	}
+
+	current := make([]NetworkInterfaceErrors, 0, len(counters))
+	for _, counter := range counters {
+		if isLoopbackInterface(counter.Name) {
+			continue
+		}
+		current = append(current, NetworkInterfaceErrors{
+			Name:       counter.Name,
+			ErrorsIn:   counter.Errin,
+			ErrorsOut:  counter.Errout,
+			DropsIn:    counter.Dropin,
+			DropsOut:   counter.Dropout,
+			Collisions: interfaceCollisions(counter.Name),
+		})
+	}
+
+	result, alerts := m.update(current, time.Now())
+	return result, alerts, nil
+}
+
+// update computes rates against the previous sample and records the new baseline
+func (m *NetworkErrorMonitor) update(current []NetworkInterfaceErrors, now time.Time) ([]NetworkInterfaceErrors, []NetworkErrorAlert) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	elapsed := now.Sub(m.lastSample).Seconds()
+	var alerts []NetworkErrorAlert
+	previous := make(map[string]NetworkInterfaceErrors, len(current))
+	failing := make(map[string]bool, len(current))
+	for i := range current {
+		stats := &current[i]
+		before, ok := m.previous[stats.Name]
+		previous[stats.Name] = *stats
+		if !ok || m.lastSample.IsZero() || elapsed <= 0 {
+			continue
+		}
+
+		stats.ErrorsInRate = counterRate(before.ErrorsIn, stats.ErrorsIn, elapsed)
+		stats.ErrorsOutRate = counterRate(before.ErrorsOut, stats.ErrorsOut, elapsed)
+		stats.DropsInRate = counterRate(before.DropsIn, stats.DropsIn, elapsed)
+		stats.DropsOutRate = counterRate(before.DropsOut, stats.DropsOut, elapsed)
+		if stats.Collisions >= 0 && before.Collisions >= 0 {
+			stats.CollisionRate = counterRate(uint64(before.Collisions), uint64(stats.Collisions), elapsed)
+		}
+
+		// 오류가 계속되는 동안 매 주기 알림하지 않도록 0 → 0이 아닌 값으로 바뀔 때만 알림
+		if stats.ErrorsInRate > 0 || stats.ErrorsOutRate > 0 || stats.CollisionRate > 0 {
+			failing[stats.Name] = true
+			if !m.failing[stats.Name] {
+				alerts = append(alerts, NetworkErrorAlert{
+					Interface:     stats.Name,
+					ErrorsInRate:  stats.ErrorsInRate,
+					ErrorsOutRate: stats.ErrorsOutRate,
+					CollisionRate: stats.CollisionRate,
+					Timestamp:     now,
+				})
+			}
+		}
+	}
+
+	// 사라진 인터페이스(USB 어댑터 분리 등)는 기준값에서 제거
+	m.previous = previous
+	m.failing = failing
+	m.lastSample = now
+	m.lastResult = current
+	return current, alerts
+}
+
+// counterRate returns the increase per second of a cumulative counter (0 when the counter was reset)
+func counterRate(before, after uint64, elapsed float64) float64 {
+	if after < before {
+		return 0
+	}
+	return float64(after-before) / elapsed
+}
+
+// isLoopbackInterface reports whether an interface name is a loopback interface
+func isLoopbackInterface(name string) bool {
+	lower := strings.ToLower(name)
+	return lower == "lo" || strings.HasPrefix(lower, "lo0") || strings.Contains(lower, "loopback")
+}
+
+// NetworkErrorMetrics converts per-interface error/drop/collision rates into resource log metrics
+func NetworkErrorMetrics(interfaces []NetworkInterfaceErrors) []Metric {
+	var metrics []Metric
+	for _, stats := range interfaces {
+		metrics = append(metrics,
+			Metric{Type: fmt.Sprintf("network_%s_errors_per_sec", stats.Name), Value: stats.ErrorsInRate + stats.ErrorsOutRate},
+			Metric{Type: fmt.Sprintf("network_%s_drops_per_sec", stats.Name), Value: stats.DropsInRate + stats.DropsOutRate},
+		)
+		if stats.Collisions >= 0 {
+			metrics = append(metrics, Metric{Type: fmt.Sprintf("network_%s_collisions_per_sec", stats.Name), Value: stats.CollisionRate})
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,21 @@
+package monitoring
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// interfaceCollisions reads the collision counter of an interface from sysfs (-1 = not available)
+func interfaceCollisions(name string) int64 {
+	content, err := os.ReadFile(filepath.Join("/sys/class/net", name, "statistics", "collisions"))
+	if err != nil {
+		return -1
+	}
+	collisions, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return collisions
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+
+package monitoring
+
+// interfaceCollisions is only reported on Linux (-1 = not supported)
+func interfaceCollisions(name string) int64 {
+	return -1
+}
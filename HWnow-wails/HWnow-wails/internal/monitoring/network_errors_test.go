@@ -0,0 +1,60 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNetworkErrorMonitorRates(t *testing.T) {
+	monitor := NewNetworkErrorMonitor()
+	start := time.Now()
+
+	// 첫 샘플은 기준값만 기록
+	result, alerts := monitor.update([]NetworkInterfaceErrors{
+		{Name: "eth0", ErrorsIn: 5, DropsIn: 10, Collisions: 2},
+		{Name: "wlan0", Collisions: -1},
+	}, start)
+	if len(alerts) != 0 || result[0].ErrorsInRate != 0 {
+		t.Fatalf("Expected baseline without rates or alerts, got %+v %+v", result, alerts)
+	}
+
+	result, alerts = monitor.update([]NetworkInterfaceErrors{
+		{Name: "eth0", ErrorsIn: 15, DropsIn: 30, Collisions: 6},
+		{Name: "wlan0", Collisions: -1},
+	}, start.Add(2*time.Second))
+	if result[0].ErrorsInRate != 5 || result[0].DropsInRate != 10 || result[0].CollisionRate != 2 {
+		t.Errorf("Unexpected rates: %+v", result[0])
+	}
+	if len(alerts) != 1 || alerts[0].Interface != "eth0" {
+		t.Fatalf("Expected one alert for eth0, got %+v", alerts)
+	}
+
+	// 오류가 계속되는 동안은 다시 알림하지 않음
+	_, alerts = monitor.update([]NetworkInterfaceErrors{{Name: "eth0", ErrorsIn: 20, Collisions: 6}}, start.Add(3*time.Second))
+	if len(alerts) != 0 {
+		t.Errorf("Expected no repeated alert, got %+v", alerts)
+	}
+
+	// 오류가 멈춘 뒤 다시 발생하면 알림, 카운터 초기화는 0으로 처리
+	monitor.update([]NetworkInterfaceErrors{{Name: "eth0", ErrorsIn: 20, DropsIn: 50, Collisions: 6}}, start.Add(4*time.Second))
+	result, alerts = monitor.update([]NetworkInterfaceErrors{{Name: "eth0", ErrorsIn: 21, DropsIn: 0, Collisions: 6}}, start.Add(5*time.Second))
+	if len(alerts) != 1 || result[0].DropsInRate != 0 {
+		t.Errorf("Expected a new alert and zero drop rate after reset, got %+v %+v", result, alerts)
+	}
+}
+
+func TestNetworkErrorMetrics(t *testing.T) {
+	metrics := NetworkErrorMetrics([]NetworkInterfaceErrors{
+		{Name: "eth0", ErrorsInRate: 1, ErrorsOutRate: 2, Collisions: 0},
+		{Name: "Wi-Fi", DropsInRate: 3, Collisions: -1},
+	})
+	if len(metrics) != 5 {
+		t.Fatalf("Expected 5 metrics, got %+v", metrics)
+	}
+	if metrics[0].Type != "network_eth0_errors_per_sec" || metrics[0].Value != 3 {
+		t.Errorf("Unexpected error metric: %+v", metrics[0])
+	}
+	if unit := MetricUnit("network_Wi-Fi_drops_per_sec"); unit != UnitPerSecond {
+		t.Errorf("Expected %s unit, got %q", UnitPerSecond, unit)
+	}
+}
@@ -0,0 +1,418 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 네트워크 품질 측정 (선택 기능)
+// 기본 게이트웨이와 외부 대상 호스트에 주기적으로 ICMP(ping) 또는 TCP 연결을 시도해
+// 지연 시간/지터/패킷 손실을 계산하고, 공인 IP를 조회
+
+// Network quality probe methods
+const (
+	NetworkProbeICMP = "icmp"
+	NetworkProbeTCP  = "tcp"
+)
+
+const (
+	PUBLIC_IP_REFRESH_INTERVAL = 10 * time.Minute
+	networkProbeTimeout        = time.Second
+	publicIPLookupTimeout      = 5 * time.Second
+)
+
+// ping 출력의 응답 시간 (예: "time=12.3 ms", "time<1ms", "시간=12ms")
+var pingTimePattern = regexp.MustCompile(`[=<]\s*([0-9]+(?:\.[0-9]+)?)\s*ms`)
+
+// NetworkQualityConfig configures the network quality probe
+type NetworkQualityConfig struct {
+	Target      string        // 외부 측정 대상 호스트 (예: 1.1.1.1)
+	Method      string        // icmp, tcp
+	Port        int           // TCP 측정 포트
+	ProbeCount  int           // 측정 주기당 시도 횟수
+	Interval    time.Duration // 측정 주기
+	PublicIPURL string        // 공인 IP 조회 URL (빈 값 = 조회 안 함)
+}
+
+// NetworkQuality holds the latest latency, jitter, packet loss and public IP measurements
+type NetworkQuality struct {
+	Target           string    `json:"target"`
+	Method           string    `json:"method"`
+	LatencyMs        float64   `json:"latency_ms"`         // 평균 지연 시간 (-1 = 응답 없음)
+	JitterMs         float64   `json:"jitter_ms"`          // 연속 응답 간 지연 시간 차이의 평균
+	PacketLoss       float64   `json:"packet_loss"`        // 손실률 (%)
+	Reachable        bool      `json:"reachable"`          // 외부 대상에 한 번이라도 응답을 받았는지
+	Gateway          string    `json:"gateway"`            // 기본 게이트웨이 (감지 실패 시 빈 값)
+	GatewayLatencyMs float64   `json:"gateway_latency_ms"` // -1 = 응답 없음
+	PublicIP         string    `json:"public_ip"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
+// NetworkQualityProbe periodically measures network quality in the background
+type NetworkQualityProbe struct {
+	mutex  sync.RWMutex
+	config NetworkQualityConfig
+	latest *NetworkQuality
+	cancel context.CancelFunc
+
+	publicIP        string
+	publicIPChecked time.Time
+}
+
+// NewNetworkQualityProbe creates a probe with the given configuration
+func NewNetworkQualityProbe(config NetworkQualityConfig) *NetworkQualityProbe {
+	return &NetworkQualityProbe{config: config}
+}
+
+// Start begins measuring in the background
+func (p *NetworkQualityProbe) Start(ctx context.Context) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.cancel != nil {
+		return // already running
+	}
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	p.cancel = cancel
+	go p.run(probeCtx, p.config.Interval)
+
+	LogInfo("Network quality probe started", "target", p.config.Target, "method", p.config.Method, "interval", p.config.Interval)
+}
+
+// Stop stops background measurement
+func (p *NetworkQualityProbe) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+}
+
+// Latest returns the most recent measurement, or nil before the first one completes
+func (p *NetworkQualityProbe) Latest() *NetworkQuality {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.latest
+}
+
+// run measures once immediately and then on every interval
+func (p *NetworkQualityProbe) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		quality := p.measure(ctx)
+		p.mutex.Lock()
+		p.latest = quality
+		p.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// measure probes the gateway and target and refreshes the public IP when stale
+func (p *NetworkQualityProbe) measure(ctx context.Context) *NetworkQuality {
+	p.mutex.RLock()
+	config := p.config
+	p.mutex.RUnlock()
+
+	quality := &NetworkQuality{
+		Target:           config.Target,
+		Method:           config.Method,
+		LatencyMs:        -1,
+		GatewayLatencyMs: -1,
+		Timestamp:        time.Now(),
+	}
+
+	rtts := probeHost(ctx, config.Method, config.Target, config.Port, config.ProbeCount)
+	quality.LatencyMs, quality.JitterMs, quality.PacketLoss = latencyStats(rtts, config.ProbeCount)
+	quality.Reachable = len(rtts) > 0
+
+	// 게이트웨이는 TCP 포트가 열려 있지 않은 경우가 많아 항상 ICMP로 측정
+	if gateway, err := getDefaultGateway(); err == nil {
+		quality.Gateway = gateway
+		gatewayRTTs := probeHost(ctx, NetworkProbeICMP, gateway, 0, 1)
+		quality.GatewayLatencyMs, _, _ = latencyStats(gatewayRTTs, 1)
+	} else {
+		LogDebug("Default gateway not detected", "error", err)
+	}
+
+	quality.PublicIP = p.getPublicIP(ctx, config.PublicIPURL)
+	return quality
+}
+
+// getPublicIP returns the cached public IP, looking it up again after PUBLIC_IP_REFRESH_INTERVAL
+func (p *NetworkQualityProbe) getPublicIP(ctx context.Context, lookupURL string) string {
+	if lookupURL == "" {
+		return ""
+	}
+
+	p.mutex.RLock()
+	publicIP, checked := p.publicIP, p.publicIPChecked
+	p.mutex.RUnlock()
+	if time.Since(checked) < PUBLIC_IP_REFRESH_INTERVAL {
+		return publicIP
+	}
+
+	lookedUp, err := lookupPublicIP(ctx, lookupURL)
+	if err != nil {
+		LogDebug("Public IP lookup failed", "url", lookupURL, "error", err)
+	} else {
+		publicIP = lookedUp
+	}
+
+	p.mutex.Lock()
+	p.publicIP, p.publicIPChecked = publicIP, time.Now()
+	p.mutex.Unlock()
+	return publicIP
+}
+
+// NetworkQualityMetrics converts a measurement into resource log metrics
+func NetworkQualityMetrics(quality *NetworkQuality) []Metric {
+	if quality == nil {
+		return nil
+	}
+	metrics := []Metric{{Type: "net_packet_loss", Value: quality.PacketLoss}}
+	if quality.LatencyMs >= 0 {
+		metrics = append(metrics,
+			Metric{Type: "net_latency_ms", Value: quality.LatencyMs},
+			Metric{Type: "net_jitter_ms", Value: quality.JitterMs},
+		)
+	}
+	if quality.GatewayLatencyMs >= 0 {
+		metrics = append(metrics, Metric{Type: "net_gateway_latency_ms", Value: quality.GatewayLatencyMs})
+	}
+	return metrics
+}
+
+// probeHost returns the round-trip times (ms) of successful probes
+func probeHost(ctx context.Context, method, host string, port, count int) []float64 {
+	if host == "" || count <= 0 {
+		return nil
+	}
+	if method == NetworkProbeTCP {
+		return probeTCP(ctx, host, port, count)
+	}
+	return probeICMP(host, count)
+}
+
+// probeTCP measures TCP connect time to host:port
+func probeTCP(ctx context.Context, host string, port, count int) []float64 {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	dialer := net.Dialer{Timeout: networkProbeTimeout}
+
+	var rtts []float64
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		rtts = append(rtts, float64(time.Since(start).Microseconds())/1000)
+		conn.Close()
+	}
+	return rtts
+}
+
+// probeICMP runs the system ping command (raw ICMP sockets need elevated privileges)
+func probeICMP(host string, count int) []float64 {
+	countArg := strconv.Itoa(count)
+	timeoutSeconds := count*int(networkProbeTimeout/time.Second) + 2
+
+	var args []string
+	switch runtime.GOOS {
+	case "windows":
+		args = []string{"-n", countArg, "-w", strconv.Itoa(int(networkProbeTimeout / time.Millisecond)), host}
+	case "darwin":
+		args = []string{"-c", countArg, "-W", strconv.Itoa(int(networkProbeTimeout / time.Millisecond)), host}
+	default:
+		args = []string{"-c", countArg, "-W", strconv.Itoa(int(networkProbeTimeout / time.Second)), host}
+	}
+
+	// 일부 응답이 없으면 ping이 0이 아닌 종료 코드를 반환하므로 출력만 사용
+	output, _ := createHiddenCommandWithTimeout("ping", timeoutSeconds, args...).Output()
+	return parsePingOutput(string(output))
+}
+
+// parsePingOutput extracts per-reply round-trip times from ping output
+func parsePingOutput(output string) []float64 {
+	var rtts []float64
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		// 응답 줄에만 TTL이 포함됨 (요약 줄의 최소/최대/평균 제외, 로캘 무관)
+		if !strings.Contains(strings.ToLower(line), "ttl") {
+			continue
+		}
+		match := pingTimePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if rtt, err := strconv.ParseFloat(match[1], 64); err == nil {
+			rtts = append(rtts, rtt)
+		}
+	}
+	return rtts
+}
+
+// latencyStats returns average latency, jitter and packet loss (%) for the given replies.
+// 응답이 없으면 지연 시간은 -1
+func latencyStats(rtts []float64, sent int) (latency, jitter, loss float64) {
+	if sent <= 0 {
+		return -1, 0, 0
+	}
+	received := len(rtts)
+	if received > sent {
+		received = sent
+	}
+	loss = float64(sent-received) / float64(sent) * 100
+	if len(rtts) == 0 {
+		return -1, 0, loss
+	}
+
+	sum := 0.0
+	for _, rtt := range rtts {
+		sum += rtt
+	}
+	latency = sum / float64(len(rtts))
+
+	if len(rtts) > 1 {
+		diffSum := 0.0
+		for i := 1; i < len(rtts); i++ {
+			diffSum += math.Abs(rtts[i] - rtts[i-1])
+		}
+		jitter = diffSum / float64(len(rtts)-1)
+	}
+	return latency, jitter, loss
+}
+
+// getDefaultGateway returns the IPv4 default gateway address
+func getDefaultGateway() (string, error) {
+	switch runtime.GOOS {
+	case "linux":
+		data, err := os.ReadFile("/proc/net/route")
+		if err != nil {
+			return "", err
+		}
+		return parseProcNetRoute(string(data))
+	case "windows":
+		output, err := createHiddenCommandWithTimeout("route", 3, "print", "-4", "0.0.0.0").Output()
+		if err != nil {
+			return "", fmt.Errorf("route print failed: %v", err)
+		}
+		return parseWindowsRoutePrint(string(output))
+	case "darwin":
+		output, err := createHiddenCommandWithTimeout("route", 3, "-n", "get", "default").Output()
+		if err != nil {
+			return "", fmt.Errorf("route get failed: %v", err)
+		}
+		for _, line := range strings.Split(string(output), "\n") {
+			if gateway, ok := strings.CutPrefix(strings.TrimSpace(line), "gateway:"); ok {
+				return strings.TrimSpace(gateway), nil
+			}
+		}
+		return "", fmt.Errorf("no default route")
+	default:
+		return "", fmt.Errorf("default gateway detection not supported on %s", runtime.GOOS)
+	}
+}
+
+// parseProcNetRoute finds the default route in /proc/net/route (gateway is little-endian hex)
+func parseProcNetRoute(content string) (string, error) {
+	for _, line := range strings.Split(content, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[1] != "00000000" {
+			continue
+		}
+		raw, err := hex.DecodeString(fields[2])
+		if err != nil || len(raw) != 4 {
+			continue
+		}
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, binary.LittleEndian.Uint32(raw))
+		if ip.IsUnspecified() {
+			continue
+		}
+		return ip.String(), nil
+	}
+	return "", fmt.Errorf("no default route")
+}
+
+// parseWindowsRoutePrint finds the lowest-metric default route in "route print" output
+func parseWindowsRoutePrint(output string) (string, error) {
+	gateway := ""
+	bestMetric := math.MaxInt
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 5 || fields[0] != "0.0.0.0" || fields[1] != "0.0.0.0" {
+			continue
+		}
+		if net.ParseIP(fields[2]) == nil {
+			continue // "On-link" 등
+		}
+		metric, err := strconv.Atoi(fields[4])
+		if err != nil {
+			continue
+		}
+		if metric < bestMetric {
+			gateway, bestMetric = fields[2], metric
+		}
+	}
+	if gateway == "" {
+		return "", fmt.Errorf("no default route")
+	}
+	return gateway, nil
+}
+
+// lookupPublicIP fetches the public IP from a plain-text lookup service
+func lookupPublicIP(ctx context.Context, lookupURL string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, publicIPLookupTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, lookupURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return "", err
+	}
+	ip := strings.TrimSpace(string(body))
+	if net.ParseIP(ip) == nil {
+		return "", fmt.Errorf("invalid public IP response: %q", ip)
+	}
+	return ip, nil
+}
@@ -0,0 +1,71 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNetworkQuality(t *testing.T) {
+
+	t.Run("Parse_Ping_Output", func(t *testing.T) {
+		linux := "PING 1.1.1.1 (1.1.1.1) 56(84) bytes of data.\n" +
+			"64 bytes from 1.1.1.1: icmp_seq=1 ttl=57 time=12.4 ms\n" +
+			"64 bytes from 1.1.1.1: icmp_seq=3 ttl=57 time=14.0 ms\n" +
+			"\n--- 1.1.1.1 ping statistics ---\n" +
+			"3 packets transmitted, 2 received, 33% packet loss, time 2003ms\n" +
+			"rtt min/avg/max/mdev = 12.400/13.200/14.000/0.800 ms\n"
+		if rtts := parsePingOutput(linux); len(rtts) != 2 || rtts[0] != 12.4 || rtts[1] != 14.0 {
+			t.Errorf("Unexpected Linux ping RTTs: %v", rtts)
+		}
+
+		windows := "Reply from 192.168.0.1: bytes=32 time<1ms TTL=64\r\n" +
+			"Request timed out.\r\n" +
+			"Reply from 192.168.0.1: bytes=32 time=3ms TTL=64\r\n" +
+			"    Minimum = 0ms, Maximum = 3ms, Average = 1ms\r\n"
+		if rtts := parsePingOutput(windows); len(rtts) != 2 || rtts[0] != 1 || rtts[1] != 3 {
+			t.Errorf("Unexpected Windows ping RTTs: %v", rtts)
+		}
+	})
+
+	t.Run("Latency_Stats", func(t *testing.T) {
+		latency, jitter, loss := latencyStats([]float64{10, 14, 12}, 4)
+		if latency != 12 || jitter != 3 || loss != 25 {
+			t.Errorf("Expected 12ms/3ms/25%%, got %f/%f/%f", latency, jitter, loss)
+		}
+
+		latency, _, loss = latencyStats(nil, 4)
+		if latency != -1 || loss != 100 {
+			t.Errorf("Expected unreachable result, got latency %f loss %f", latency, loss)
+		}
+	})
+
+	t.Run("Default_Gateway", func(t *testing.T) {
+		route := "Iface\tDestination\tGateway \tFlags\tRefCnt\tUse\tMetric\tMask\n" +
+			"eth0\t0000A8C0\t00000000\t0001\t0\t0\t100\t00FFFFFF\n" +
+			"eth0\t00000000\t0100A8C0\t0003\t0\t0\t100\t00000000\n"
+		if gateway, err := parseProcNetRoute(route); err != nil || gateway != "192.168.0.1" {
+			t.Errorf("Expected 192.168.0.1, got %q (%v)", gateway, err)
+		}
+
+		routePrint := "Network Destination        Netmask          Gateway       Interface  Metric\n" +
+			"          0.0.0.0          0.0.0.0      10.0.0.1       10.0.0.20     50\n" +
+			"          0.0.0.0          0.0.0.0  192.168.0.1    192.168.0.5     25\n"
+		if gateway, err := parseWindowsRoutePrint(routePrint); err != nil || gateway != "192.168.0.1" {
+			t.Errorf("Expected lowest-metric gateway 192.168.0.1, got %q (%v)", gateway, err)
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		metrics := NetworkQualityMetrics(&NetworkQuality{LatencyMs: -1, GatewayLatencyMs: 2, PacketLoss: 100})
+		found := map[string]float64{}
+		for _, metric := range metrics {
+			found[metric.Type] = metric.Value
+		}
+		if _, ok := found["net_latency_ms"]; ok {
+			t.Error("Expected latency to be omitted when the target is unreachable")
+		}
+		if math.Abs(found["net_packet_loss"]-100) > 0.001 || found["net_gateway_latency_ms"] != 2 {
+			t.Errorf("Unexpected metrics: %v", found)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NPU (AI 가속기) 감지 및 사용률
+// Windows: "NPU Engine" / "NPU Adapter Memory" 성능 카운터 (Intel NPU, AMD XDNA 등)
+// Linux: /sys/class/accel 장치 (intel_vpu는 npu_busy_time_us로 사용률 계산, amdxdna는 감지만)
+// macOS: Apple Neural Engine은 공개 API가 없어 감지만 제공 (placeholder)
+
+const linuxAccelPath = "/sys/class/accel"
+
+// NPUInfo represents a neural processing unit and its current utilization
+type NPUInfo struct {
+	Name     string  `json:"name"`
+	Vendor   string  `json:"vendor"`    // Intel, AMD, Apple
+	Driver   string  `json:"driver"`    // intel_vpu, amdxdna, ...
+	Usage    float64 `json:"usage"`     // 사용률 (%, -1 = 알 수 없음)
+	MemoryMB float64 `json:"memory_mb"` // 사용 중인 NPU 메모리 (MB, -1 = 알 수 없음)
+	Source   string  `json:"source"`    // perf_counter, sysfs, placeholder
+}
+
+// npuBusySample stores the previous busy time reading of an accel device
+type npuBusySample struct {
+	busyUS    float64
+	timestamp time.Time
+}
+
+// NPUCache caches NPU detection and utilization readings
+type NPUCache struct {
+	mutex      sync.Mutex
+	info       *NPUInfo
+	err        error
+	timestamp  time.Time
+	busySample map[string]npuBusySample
+}
+
+const NPU_CACHE_DURATION = 2 * time.Second
+
+var npuCache = &NPUCache{busySample: make(map[string]npuBusySample)}
+
+// GetNPUInfo detects the NPU and returns its current utilization
+func GetNPUInfo(ctx context.Context) (*NPUInfo, error) {
+	return queryWithContext(ctx, "GetNPUInfo", getNPUInfo)
+}
+
+func getNPUInfo() (*NPUInfo, error) {
+	npuCache.mutex.Lock()
+	defer npuCache.mutex.Unlock()
+
+	if time.Since(npuCache.timestamp) < NPU_CACHE_DURATION {
+		return npuCache.info, npuCache.err
+	}
+
+	var info *NPUInfo
+	var err error
+	switch runtime.GOOS {
+	case "windows":
+		info, err = getNPUInfoWindows()
+	case "linux":
+		info, err = npuCache.readLinuxNPU()
+	case "darwin":
+		info, err = getAppleNeuralEngineInfo()
+	default:
+		err = fmt.Errorf("NPU monitoring not supported on platform: %s", runtime.GOOS)
+	}
+
+	npuCache.info = info
+	npuCache.err = err
+	npuCache.timestamp = time.Now()
+	return info, err
+}
+
+// NPUMetrics converts NPU info to npu_usage / npu_memory metrics (unknown values are omitted)
+func NPUMetrics(info *NPUInfo) []Metric {
+	if info == nil {
+		return nil
+	}
+	var metrics []Metric
+	if info.Usage >= 0 {
+		metrics = append(metrics, Metric{Type: "npu_usage", Value: info.Usage})
+	}
+	if info.MemoryMB >= 0 {
+		metrics = append(metrics, Metric{Type: "npu_memory", Value: info.MemoryMB})
+	}
+	return metrics
+}
+
+// getNPUInfoWindows reads the NPU Engine and NPU Adapter Memory performance counters
+func getNPUInfoWindows() (*NPUInfo, error) {
+	cmd := createHiddenCommandWithTimeout("typeperf", 3,
+		`\NPU Engine(*)\Utilization Percentage`, `\NPU Adapter Memory(*)\Dedicated Usage`, "-sc", "1")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("NPU counter query failed: %v", err)
+	}
+	return parseNPUCounterOutput(output)
+}
+
+// parseNPUCounterOutput aggregates typeperf NPU counter output into a single NPU reading
+func parseNPUCounterOutput(output []byte) (*NPUInfo, error) {
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return nil, err
+	}
+
+	// GPU Engine과 동일하게 프로세스별 인스턴스를 엔진 단위로 합산한 뒤 가장 바쁜 엔진을 사용
+	perEngine := make(map[string]float64)
+	memoryBytes := 0.0
+	hasEngine, hasMemory := false, false
+	for i := 1; i < len(header) && i < len(values); i++ {
+		value, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		counter := strings.ToLower(header[i])
+		switch {
+		case strings.Contains(counter, `npu engine(`):
+			key := header[i]
+			if match := gpuEngineInstancePattern.FindStringSubmatch(header[i]); match != nil {
+				key = match[1] + "/" + match[2] + "/" + match[3]
+			}
+			perEngine[key] += value
+			hasEngine = true
+		case strings.Contains(counter, `npu adapter memory(`):
+			memoryBytes += value
+			hasMemory = true
+		}
+	}
+	if !hasEngine && !hasMemory {
+		return nil, fmt.Errorf("no NPU detected")
+	}
+
+	info := &NPUInfo{Name: "NPU", Usage: -1, MemoryMB: -1, Source: "perf_counter"}
+	if hasEngine {
+		info.Usage = 0
+		for _, usage := range perEngine {
+			if usage > info.Usage {
+				info.Usage = usage
+			}
+		}
+		if info.Usage > 100 {
+			info.Usage = 100
+		}
+	}
+	if hasMemory {
+		info.MemoryMB = memoryBytes / 1024 / 1024
+	}
+	return info, nil
+}
+
+// readLinuxNPU detects accel devices and computes utilization from busy time (caller holds the mutex)
+func (c *NPUCache) readLinuxNPU() (*NPUInfo, error) {
+	devices, err := filepath.Glob(filepath.Join(linuxAccelPath, "accel*"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		driverLink, err := os.Readlink(filepath.Join(device, "device", "driver"))
+		if err != nil {
+			continue
+		}
+		driver := filepath.Base(driverLink)
+		vendor, name := npuVendorForDriver(driver)
+		if vendor == "" {
+			continue
+		}
+
+		info := &NPUInfo{Name: name, Vendor: vendor, Driver: driver, Usage: -1, MemoryMB: -1, Source: "sysfs"}
+
+		// intel_vpu는 누적 busy 시간(µs)을 제공하므로 이전 샘플과의 차이로 사용률 계산
+		if busyUS, ok := readSysfsFloat(filepath.Join(device, "device"), "npu_busy_time_us"); ok {
+			now := time.Now()
+			prev, hasPrev := c.busySample[device]
+			c.busySample[device] = npuBusySample{busyUS: busyUS, timestamp: now}
+			if hasPrev {
+				info.Usage = npuUsageFromBusyTime(prev.busyUS, busyUS, now.Sub(prev.timestamp))
+			}
+		}
+		return info, nil
+	}
+
+	return nil, fmt.Errorf("no NPU accel device found under %s", linuxAccelPath)
+}
+
+// npuVendorForDriver maps a Linux accel driver to its vendor and display name
+func npuVendorForDriver(driver string) (string, string) {
+	switch driver {
+	case "intel_vpu":
+		return "Intel", "Intel NPU"
+	case "amdxdna":
+		return "AMD", "AMD XDNA NPU"
+	default:
+		return "", ""
+	}
+}
+
+// npuUsageFromBusyTime converts a busy time delta (µs) over an interval to a utilization percentage
+func npuUsageFromBusyTime(prevUS, currentUS float64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || currentUS < prevUS {
+		return -1
+	}
+	usage := (currentUS - prevUS) / float64(elapsed.Microseconds()) * 100
+	if usage > 100 {
+		usage = 100
+	}
+	return usage
+}
+
+// getAppleNeuralEngineInfo reports the Apple Neural Engine on Apple silicon (utilization not available)
+func getAppleNeuralEngineInfo() (*NPUInfo, error) {
+	if runtime.GOARCH != "arm64" {
+		return nil, fmt.Errorf("Apple Neural Engine requires Apple silicon")
+	}
+	return &NPUInfo{
+		Name:     "Apple Neural Engine",
+		Vendor:   "Apple",
+		Usage:    -1,
+		MemoryMB: -1,
+		Source:   "placeholder",
+	}, nil
+}
@@ -0,0 +1,50 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNPUHelpers(t *testing.T) {
+
+	t.Run("Parse_Counter_Output", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\NPU Engine(pid_100_luid_0x00000000_0x0001A2B3_phys_0_eng_0_engtype_Compute)\Utilization Percentage","\\PC\NPU Engine(pid_200_luid_0x00000000_0x0001A2B3_phys_0_eng_0_engtype_Compute)\Utilization Percentage","\\PC\NPU Adapter Memory(luid_0x00000000_0x0001A2B3_phys_0)\Dedicated Usage"
+"10/16/2026 12:00:00.000","30.5","12.0","104857600"
+Exiting, please wait...`)
+
+		info, err := parseNPUCounterOutput(output)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if info.Usage != 42.5 {
+			t.Errorf("Expected usage 42.5, got %f", info.Usage)
+		}
+		if info.MemoryMB != 100 {
+			t.Errorf("Expected 100 MB, got %f", info.MemoryMB)
+		}
+	})
+
+	t.Run("No_NPU", func(t *testing.T) {
+		output := []byte(`"(PDH-CSV 4.0)","\\PC\Processor(_Total)\% Processor Time"
+"10/16/2026 12:00:00.000","5.0"`)
+		if _, err := parseNPUCounterOutput(output); err == nil {
+			t.Error("Expected error when no NPU counters are present")
+		}
+	})
+
+	t.Run("Busy_Time_Usage", func(t *testing.T) {
+		if usage := npuUsageFromBusyTime(1000, 501000, time.Second); usage != 50 {
+			t.Errorf("Expected 50%% usage, got %f", usage)
+		}
+		if usage := npuUsageFromBusyTime(5000, 1000, time.Second); usage != -1 {
+			t.Errorf("Expected -1 for counter reset, got %f", usage)
+		}
+	})
+
+	t.Run("Metrics_Omit_Unknown", func(t *testing.T) {
+		metrics := NPUMetrics(&NPUInfo{Usage: 12, MemoryMB: -1})
+		if len(metrics) != 1 || metrics[0].Type != "npu_usage" {
+			t.Errorf("Unexpected metrics: %+v", metrics)
+		}
+	})
+}
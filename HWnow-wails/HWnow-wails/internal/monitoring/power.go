@@ -0,0 +1,194 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 시스템 전체 전력 추정
+// GPU 전력(nvidia-smi) + CPU 패키지 전력(RAPL / Windows Energy Estimation) + 배터리 방전 전력을 결합
+
+const linuxPowercapPath = "/sys/class/powercap"
+
+// SystemPowerInfo represents the estimated total system power draw
+type SystemPowerInfo struct {
+	TotalWatts   float64  `json:"total_watts"`   // 추정 시스템 전체 전력 (W, -1 = 알 수 없음)
+	CPUWatts     float64  `json:"cpu_watts"`     // CPU 패키지 전력 (W, -1 = 알 수 없음)
+	GPUWatts     float64  `json:"gpu_watts"`     // GPU 전력 (W, -1 = 알 수 없음)
+	BatteryWatts float64  `json:"battery_watts"` // 배터리 방전 전력 (W, 0 = 충전 중 또는 AC 전원)
+	Sources      []string `json:"sources"`       // 추정에 사용된 데이터 소스
+}
+
+// CPUPowerCache caches CPU package power readings
+type CPUPowerCache struct {
+	mutex     sync.Mutex
+	watts     float64
+	timestamp time.Time
+}
+
+const CPU_POWER_CACHE_DURATION = 2 * time.Second
+
+var cpuPowerCache = &CPUPowerCache{
+	watts: -1,
+}
+
+// GetSystemPowerInfo estimates total system power draw from the available sources
+func GetSystemPowerInfo(ctx context.Context) (*SystemPowerInfo, error) {
+	return queryWithContext(ctx, "GetSystemPowerInfo", getSystemPowerInfo)
+}
+
+func getSystemPowerInfo() (*SystemPowerInfo, error) {
+	info := &SystemPowerInfo{
+		TotalWatts: -1,
+		CPUWatts:   -1,
+		GPUWatts:   -1,
+		Sources:    []string{},
+	}
+
+	if cpuWatts, err := getCPUPackagePower(); err == nil {
+		info.CPUWatts = cpuWatts
+		info.Sources = append(info.Sources, "cpu_package")
+	} else {
+		LogDebug("CPU package power unavailable", "error", err)
+	}
+
+	if gpuInfo, err := getCachedGPUInfo(); err == nil && gpuInfo != nil && gpuInfo.Power > 0 {
+		info.GPUWatts = gpuInfo.Power
+		info.Sources = append(info.Sources, "gpu")
+	}
+
+	if battery, err := getBatteryInfo(); err == nil && battery != nil && battery.ChargeRateWatts < 0 {
+		info.BatteryWatts = -battery.ChargeRateWatts
+		info.Sources = append(info.Sources, "battery")
+	}
+
+	info.TotalWatts = totalPowerWatts(info.CPUWatts, info.GPUWatts, info.BatteryWatts)
+	if info.TotalWatts < 0 {
+		return info, fmt.Errorf("no power data source available on platform: %s", runtime.GOOS)
+	}
+
+	return info, nil
+}
+
+// totalPowerWatts picks the system total from the component readings (-1 = unknown component)
+// and the battery discharge rate (0 = charging or on AC); returns -1 when nothing is known
+func totalPowerWatts(cpuWatts, gpuWatts, batteryWatts float64) float64 {
+	componentWatts := 0.0
+	hasComponents := false
+	if cpuWatts >= 0 {
+		componentWatts += cpuWatts
+		hasComponents = true
+	}
+	if gpuWatts >= 0 {
+		componentWatts += gpuWatts
+		hasComponents = true
+	}
+
+	// 배터리 방전 중이면 방전 전력이 시스템 전체 소비량을 가장 잘 반영
+	switch {
+	case batteryWatts > 0 && batteryWatts >= componentWatts:
+		return batteryWatts
+	case hasComponents:
+		return componentWatts
+	}
+	return -1
+}
+
+// GetCPUPackagePower returns CPU package power draw in watts
+func GetCPUPackagePower(ctx context.Context) (float64, error) {
+	return queryWithContext(ctx, "GetCPUPackagePower", getCPUPackagePower)
+}
+
+func getCPUPackagePower() (float64, error) {
+	cpuPowerCache.mutex.Lock()
+	defer cpuPowerCache.mutex.Unlock()
+
+	if time.Since(cpuPowerCache.timestamp) < CPU_POWER_CACHE_DURATION && cpuPowerCache.watts >= 0 {
+		return cpuPowerCache.watts, nil
+	}
+
+	var watts float64
+	var err error
+	switch runtime.GOOS {
+	case "linux":
+		var rapl *RAPLPower
+		if rapl, err = getRAPLPower(); err == nil {
+			watts = rapl.PackageWatts
+			if watts < 0 {
+				err = fmt.Errorf("no RAPL package domains reported")
+			}
+		}
+	case "windows":
+		watts, err = getCPUPackagePowerWindows()
+	default:
+		err = fmt.Errorf("CPU package power not supported on platform: %s", runtime.GOOS)
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	cpuPowerCache.watts = watts
+	cpuPowerCache.timestamp = time.Now()
+	return watts, nil
+}
+
+// getCPUPackagePowerWindows reads package power from the Windows Energy Estimation Engine counters
+func getCPUPackagePowerWindows() (float64, error) {
+	cmd := createHiddenCommandWithTimeout("typeperf", 3, `\Energy Meter(*)\Power`, "-sc", "1")
+	output, err := cmd.Output()
+	if err != nil {
+		return -1, fmt.Errorf("Energy Meter counter query failed: %v", err)
+	}
+	return parseEnergyMeterOutput(output)
+}
+
+// parseEnergyMeterOutput sums the package-level instances of typeperf Energy Meter output (mW -> W)
+func parseEnergyMeterOutput(output []byte) (float64, error) {
+	// typeperf는 마지막에 상태 메시지를 출력하므로 레코드별 필드 수가 다를 수 있음
+	reader := csv.NewReader(strings.NewReader(strings.TrimSpace(string(output))))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return -1, err
+	}
+
+	var header, values []string
+	for _, record := range records {
+		if len(record) < 2 {
+			continue
+		}
+		if header == nil {
+			header = record
+			continue
+		}
+		values = record
+		break
+	}
+	if header == nil || values == nil {
+		return -1, fmt.Errorf("no Energy Meter samples found")
+	}
+
+	total := 0.0
+	found := false
+	for i := 1; i < len(header) && i < len(values); i++ {
+		if !strings.Contains(strings.ToLower(header[i]), "pkg") {
+			continue
+		}
+		milliwatts, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		total += milliwatts / 1000
+		found = true
+	}
+	if !found {
+		return -1, fmt.Errorf("no CPU package Energy Meter instance found")
+	}
+	return total, nil
+}
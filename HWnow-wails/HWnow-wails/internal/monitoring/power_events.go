@@ -0,0 +1,202 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 시스템 절전/복귀 및 사용자 세션 잠금/해제 감지
+// Windows: PowerRegisterSuspendResumeNotification 콜백 + 입력 데스크톱 폴링(잠금 화면 감지)
+// Linux: logind D-Bus 신호 (PrepareForSleep, Session.Lock/Unlock)를 gdbus monitor로 수신
+// 그 외: 벽시계와 단조 시계의 차이로 절전 후 복귀만 감지
+
+// Power event types
+const (
+	PowerEventSuspend = "suspend"
+	PowerEventResume  = "resume"
+	PowerEventLock    = "lock"
+	PowerEventUnlock  = "unlock"
+)
+
+const (
+	SESSION_LOCK_POLL_INTERVAL = 2 * time.Second
+	clockGapCheckInterval      = 5 * time.Second
+	clockGapThreshold          = 15 * time.Second
+)
+
+// PowerEvent represents a system suspend/resume or session lock/unlock
+type PowerEvent struct {
+	Type         string    `json:"type"` // suspend, resume, lock, unlock
+	Timestamp    time.Time `json:"timestamp"`
+	Source       string    `json:"source"`                  // power_notification, session_poll, logind, clock_gap
+	SleepSeconds float64   `json:"sleep_seconds,omitempty"` // 절전 지속 시간 (resume 이벤트만)
+}
+
+// deviceNotifySubscribeParameters mirrors DEVICE_NOTIFY_SUBSCRIBE_PARAMETERS
+type deviceNotifySubscribeParameters struct {
+	callback uintptr
+	context  uintptr
+}
+
+// PowerEventWatcher reports suspend/resume and session lock/unlock events
+type PowerEventWatcher struct {
+	mutex       sync.Mutex
+	handler     func(PowerEvent)
+	cancel      context.CancelFunc
+	suspendedAt time.Time
+
+	// Windows 전원 알림 등록 핸들 (콜백 파라미터는 등록 해제 전까지 유지)
+	notifyHandle uintptr
+	notifyParams *deviceNotifySubscribeParameters
+}
+
+// NewPowerEventWatcher creates a watcher that calls handler for each power or session event
+func NewPowerEventWatcher(handler func(PowerEvent)) *PowerEventWatcher {
+	return &PowerEventWatcher{handler: handler}
+}
+
+// Start begins watching in the background
+func (w *PowerEventWatcher) Start(ctx context.Context) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		return nil // already running
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+
+	sources := []string{}
+	switch runtime.GOOS {
+	case "windows":
+		if err := w.registerSuspendResumeNotification(); err != nil {
+			LogDebug("Suspend/resume notification not registered, using clock gap detection", "error", err)
+			go w.watchClockGap(watchCtx)
+			sources = append(sources, "clock_gap")
+		} else {
+			sources = append(sources, "power_notification")
+		}
+		go w.pollSessionLock(watchCtx)
+		sources = append(sources, "session_poll")
+	case "linux":
+		if gdbusPath, err := exec.LookPath("gdbus"); err == nil {
+			go w.watchLogind(watchCtx, gdbusPath)
+			sources = append(sources, "logind")
+		} else {
+			go w.watchClockGap(watchCtx)
+			sources = append(sources, "clock_gap")
+		}
+	default:
+		go w.watchClockGap(watchCtx)
+		sources = append(sources, "clock_gap")
+	}
+
+	LogInfo("Power event watcher started", "sources", strings.Join(sources, ","))
+	return nil
+}
+
+// Stop stops watching and unregisters native notifications
+func (w *PowerEventWatcher) Stop() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+	w.unregisterSuspendResumeNotification()
+}
+
+// dispatch delivers an event to the handler, pairing resume events with the preceding suspend
+func (w *PowerEventWatcher) dispatch(eventType, source string, timestamp time.Time) {
+	event := PowerEvent{Type: eventType, Timestamp: timestamp, Source: source}
+
+	w.mutex.Lock()
+	switch eventType {
+	case PowerEventSuspend:
+		w.suspendedAt = timestamp
+	case PowerEventResume:
+		if !w.suspendedAt.IsZero() {
+			event.SleepSeconds = timestamp.Sub(w.suspendedAt).Seconds()
+			w.suspendedAt = time.Time{}
+		}
+	}
+	handler := w.handler
+	w.mutex.Unlock()
+
+	LogInfo("Power event detected", "type", eventType, "source", source)
+	if handler != nil {
+		handler(event)
+	}
+}
+
+// watchLogind streams logind D-Bus signals through gdbus monitor
+func (w *PowerEventWatcher) watchLogind(ctx context.Context, gdbusPath string) {
+	cmd := exec.CommandContext(ctx, gdbusPath, "monitor", "--system", "--dest", "org.freedesktop.login1")
+	stdout, wait, err := commandRunner.Stream(cmd)
+	if err != nil {
+		LogDebug("logind monitor failed to start", "error", err)
+		return
+	}
+	defer wait()
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		if eventType, ok := parseLogindSignal(scanner.Text()); ok {
+			w.dispatch(eventType, "logind", time.Now())
+		}
+	}
+}
+
+// parseLogindSignal maps a gdbus monitor line to a power event type
+func parseLogindSignal(line string) (string, bool) {
+	switch {
+	case strings.Contains(line, "org.freedesktop.login1.Manager.PrepareForSleep"):
+		if strings.Contains(line, "(true,)") {
+			return PowerEventSuspend, true
+		}
+		if strings.Contains(line, "(false,)") {
+			return PowerEventResume, true
+		}
+	case strings.Contains(line, "org.freedesktop.login1.Session.Lock "):
+		return PowerEventLock, true
+	case strings.Contains(line, "org.freedesktop.login1.Session.Unlock "):
+		return PowerEventUnlock, true
+	}
+	return "", false
+}
+
+// watchClockGap detects resume from sleep when wall-clock time jumps ahead of monotonic time
+// (단조 시계는 절전 중에 멈추므로 두 시계의 경과 시간 차이가 절전 시간)
+func (w *PowerEventWatcher) watchClockGap(ctx context.Context) {
+	ticker := time.NewTicker(clockGapCheckInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			if gap := clockGap(last, now); gap > clockGapThreshold {
+				w.dispatch(PowerEventSuspend, "clock_gap", last.Round(0))
+				w.dispatch(PowerEventResume, "clock_gap", now.Round(0))
+			}
+			last = now
+		}
+	}
+}
+
+// clockGap returns how much more wall-clock time than monotonic time elapsed between two readings
+func clockGap(previous, current time.Time) time.Duration {
+	wall := current.Round(0).Sub(previous.Round(0))
+	monotonic := current.Sub(previous)
+	return wall - monotonic
+}
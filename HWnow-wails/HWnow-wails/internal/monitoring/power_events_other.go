@@ -0,0 +1,19 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+)
+
+// registerSuspendResumeNotification is only available on Windows (caller falls back to logind or clock gap detection)
+func (w *PowerEventWatcher) registerSuspendResumeNotification() error {
+	return fmt.Errorf("suspend/resume notification not supported on this platform")
+}
+
+// unregisterSuspendResumeNotification is a no-op outside Windows
+func (w *PowerEventWatcher) unregisterSuspendResumeNotification() {}
+
+// pollSessionLock is only available on Windows (Linux uses logind Session.Lock/Unlock signals)
+func (w *PowerEventWatcher) pollSessionLock(ctx context.Context) {}
@@ -0,0 +1,57 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPowerEvents(t *testing.T) {
+
+	t.Run("Parse_Logind_Signal", func(t *testing.T) {
+		cases := map[string]string{
+			"/org/freedesktop/login1: org.freedesktop.login1.Manager.PrepareForSleep (true,)":  PowerEventSuspend,
+			"/org/freedesktop/login1: org.freedesktop.login1.Manager.PrepareForSleep (false,)": PowerEventResume,
+			"/org/freedesktop/login1/session/_32: org.freedesktop.login1.Session.Lock ()":      PowerEventLock,
+			"/org/freedesktop/login1/session/_32: org.freedesktop.login1.Session.Unlock ()":    PowerEventUnlock,
+		}
+		for line, expected := range cases {
+			eventType, ok := parseLogindSignal(line)
+			if !ok || eventType != expected {
+				t.Errorf("parseLogindSignal(%q) = %q, %v; expected %q", line, eventType, ok, expected)
+			}
+		}
+
+		if _, ok := parseLogindSignal("/org/freedesktop/login1: org.freedesktop.DBus.Properties.PropertiesChanged ()"); ok {
+			t.Error("Expected unrelated signal to be ignored")
+		}
+	})
+
+	t.Run("Clock_Gap", func(t *testing.T) {
+		now := time.Now()
+		if gap := clockGap(now, now.Add(5*time.Second)); gap != 0 {
+			t.Errorf("Expected no gap for monotonic readings, got %v", gap)
+		}
+	})
+
+	t.Run("Resume_Reports_Sleep_Duration", func(t *testing.T) {
+		var events []PowerEvent
+		watcher := NewPowerEventWatcher(func(event PowerEvent) {
+			events = append(events, event)
+		})
+
+		suspendedAt := time.Date(2026, 10, 16, 1, 0, 0, 0, time.UTC)
+		watcher.dispatch(PowerEventSuspend, "test", suspendedAt)
+		watcher.dispatch(PowerEventResume, "test", suspendedAt.Add(90*time.Second))
+		watcher.dispatch(PowerEventResume, "test", suspendedAt.Add(100*time.Second))
+
+		if len(events) != 3 {
+			t.Fatalf("Expected 3 events, got %d", len(events))
+		}
+		if events[1].SleepSeconds != 90 {
+			t.Errorf("Expected 90s sleep, got %f", events[1].SleepSeconds)
+		}
+		if events[2].SleepSeconds != 0 {
+			t.Errorf("Expected unpaired resume to have no sleep duration, got %f", events[2].SleepSeconds)
+		}
+	})
+}
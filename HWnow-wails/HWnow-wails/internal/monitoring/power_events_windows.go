@@ -0,0 +1,132 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// Windows 전원 알림 상수
+const (
+	deviceNotifyCallback  = 2    // DEVICE_NOTIFY_CALLBACK
+	pbtAPMSuspend         = 0x4  // PBT_APMSUSPEND
+	pbtAPMResumeAutomatic = 0x12 // PBT_APMRESUMEAUTOMATIC
+	desktopSwitchDesktop  = 0x0100
+)
+
+// Windows 콜백은 해제할 수 없으므로 한 번만 만들고 현재 감시자에게 전달
+var (
+	powerNotifyCallbackOnce sync.Once
+	powerNotifyCallback     uintptr
+	activePowerWatcherMutex sync.Mutex
+	activePowerWatcher      *PowerEventWatcher
+)
+
+// registerSuspendResumeNotification subscribes to PBT_APMSUSPEND / PBT_APMRESUMEAUTOMATIC (caller holds the mutex)
+func (w *PowerEventWatcher) registerSuspendResumeNotification() error {
+	powrprof := syscall.NewLazyDLL("powrprof.dll")
+	register := powrprof.NewProc("PowerRegisterSuspendResumeNotification")
+	if err := register.Find(); err != nil {
+		return err
+	}
+
+	powerNotifyCallbackOnce.Do(func() {
+		powerNotifyCallback = syscall.NewCallback(func(_, eventType, _ uintptr) uintptr {
+			activePowerWatcherMutex.Lock()
+			watcher := activePowerWatcher
+			activePowerWatcherMutex.Unlock()
+			if watcher == nil {
+				return 0
+			}
+
+			// 콜백 스레드를 막지 않도록 비동기로 전달
+			switch eventType {
+			case pbtAPMSuspend:
+				go watcher.dispatch(PowerEventSuspend, "power_notification", time.Now())
+			case pbtAPMResumeAutomatic:
+				go watcher.dispatch(PowerEventResume, "power_notification", time.Now())
+			}
+			return 0
+		})
+	})
+
+	params := &deviceNotifySubscribeParameters{callback: powerNotifyCallback}
+	var handle uintptr
+	ret, _, _ := register.Call(deviceNotifyCallback, uintptr(unsafe.Pointer(params)), uintptr(unsafe.Pointer(&handle)))
+	if ret != 0 {
+		return fmt.Errorf("PowerRegisterSuspendResumeNotification failed: error %d", ret)
+	}
+
+	w.notifyHandle = handle
+	w.notifyParams = params
+	activePowerWatcherMutex.Lock()
+	activePowerWatcher = w
+	activePowerWatcherMutex.Unlock()
+	return nil
+}
+
+// unregisterSuspendResumeNotification releases the native registration (caller holds the mutex)
+func (w *PowerEventWatcher) unregisterSuspendResumeNotification() {
+	if w.notifyHandle == 0 {
+		return
+	}
+
+	powrprof := syscall.NewLazyDLL("powrprof.dll")
+	powrprof.NewProc("PowerUnregisterSuspendResumeNotification").Call(w.notifyHandle)
+	w.notifyHandle = 0
+	w.notifyParams = nil
+
+	activePowerWatcherMutex.Lock()
+	if activePowerWatcher == w {
+		activePowerWatcher = nil
+	}
+	activePowerWatcherMutex.Unlock()
+}
+
+// pollSessionLock detects lock/unlock by checking whether the input desktop can be opened
+// (잠금 화면에서는 입력 데스크톱이 Winlogon으로 전환되어 일반 프로세스가 열 수 없음)
+func (w *PowerEventWatcher) pollSessionLock(ctx context.Context) {
+	user32 := syscall.NewLazyDLL("user32.dll")
+	openInputDesktop := user32.NewProc("OpenInputDesktop")
+	closeDesktop := user32.NewProc("CloseDesktop")
+	if err := openInputDesktop.Find(); err != nil {
+		LogDebug("Session lock detection not available", "error", err)
+		return
+	}
+
+	isLocked := func() bool {
+		desktop, _, _ := openInputDesktop.Call(0, 0, desktopSwitchDesktop)
+		if desktop == 0 {
+			return true
+		}
+		closeDesktop.Call(desktop)
+		return false
+	}
+
+	locked := isLocked()
+	ticker := time.NewTicker(SESSION_LOCK_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current := isLocked()
+			if current == locked {
+				continue
+			}
+			locked = current
+			if locked {
+				w.dispatch(PowerEventLock, "session_poll", time.Now())
+			} else {
+				w.dispatch(PowerEventUnlock, "session_poll", time.Now())
+			}
+		}
+	}
+}
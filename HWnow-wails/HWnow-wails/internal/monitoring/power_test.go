@@ -0,0 +1,64 @@
+package monitoring
+
+import "testing"
+
+func TestParseEnergyMeterOutput(t *testing.T) {
+	const header = `"(PDH-CSV 4.0)","\\PC\Energy Meter(rapl_package0_pkg)\Power","\\PC\Energy Meter(rapl_package0_pp0)\Power","\\PC\Energy Meter(rapl_package1_pkg)\Power"`
+	cases := []struct {
+		name     string
+		output   string
+		expected float64
+		invalid  bool
+	}{
+		{"Sums_Package_Instances", header + "\n" + `"05/01/2024 09:00:00.000","15250.000000","9000.000000","4750.000000"`, 20, false},
+		// typeperf는 마지막에 필드 수가 다른 상태 메시지를 출력함
+		{"Trailing_Status_Line", "\r\n" + header + "\r\n" + `"05/01/2024 09:00:00.000","12000.000000","8000.000000","0.000000"` +
+			"\r\nExiting, please wait...\r\nThe command completed successfully.\r\n", 12, false},
+		{"Unparsable_Value_Skipped", header + "\n" + `"05/01/2024 09:00:00.000"," ","9000.000000","3500.000000"`, 3.5, false},
+		{"No_Package_Instance", `"(PDH-CSV 4.0)","\\PC\Energy Meter(rapl_package0_pp0)\Power"` + "\n" + `"05/01/2024 09:00:00.000","9000.000000"`, 0, true},
+		{"Header_Without_Samples", header + "\nThe command completed successfully.", 0, true},
+		{"All_Package_Values_Unparsable", header + "\n" + `"05/01/2024 09:00:00.000","","9000.000000","n/a"`, 0, true},
+		{"Empty", "", 0, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			watts, err := parseEnergyMeterOutput([]byte(c.output))
+			if c.invalid {
+				if err == nil {
+					t.Errorf("Expected an error, got %v W", watts)
+				}
+				return
+			}
+			if err != nil || watts != c.expected {
+				t.Errorf("Expected %v W, got %v (err=%v)", c.expected, watts, err)
+			}
+		})
+	}
+}
+
+func TestTotalPowerWatts(t *testing.T) {
+	cases := []struct {
+		name     string
+		cpu      float64
+		gpu      float64
+		battery  float64
+		expected float64
+	}{
+		{"Components_On_AC", 35, 120, 0, 155},
+		{"CPU_Only", 20, -1, 0, 20},
+		{"GPU_Only", -1, 80, 0, 80},
+		// 배터리 방전량이 부품 합계 이상이면 시스템 전체 소비량으로 사용
+		{"Battery_Covers_Components", 12, 8, 28, 28},
+		{"Battery_Equal_To_Components", 12, 8, 20, 20},
+		{"Battery_Below_Components", 30, 25, 18, 55},
+		{"Battery_Only", -1, -1, 15, 15},
+		{"Nothing_Known", -1, -1, 0, -1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := totalPowerWatts(c.cpu, c.gpu, c.battery); got != c.expected {
+				t.Errorf("Expected %v W, got %v", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package monitoring
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 프로세스별 누적 자원 시간 집계 ("오늘 가장 많이 쓴 프로세스")
+// 순간 사용률이 아닌 HWnow 시작(또는 자정) 이후 누적된 CPU 초/GPU 초로 순위를 매김
+// CPU 초는 OS 누적 CPU 시간의 증가분, GPU 초는 측정된 GPU 사용률을 샘플 간격으로 적분한 값
+
+// 샘플 간격이 이보다 길면 (절전, 일시정지 등) 그 구간의 GPU 사용률을 적분하지 않음
+const GPU_TIME_MAX_SAMPLE_GAP = 30 * time.Second
+
+// ProcessResourceTime is the resource time accumulated by all processes sharing a name
+type ProcessResourceTime struct {
+	Name         string    `json:"name"`
+	ProcessCount int       `json:"process_count"` // 집계 기간 동안 관찰된 PID 수
+	CPUSeconds   float64   `json:"cpu_seconds"`   // 누적 CPU 시간 (user+system, 초)
+	GPUSeconds   float64   `json:"gpu_seconds"`   // 누적 GPU 시간 (사용률 100% 기준 초, 측정 가능한 경우만)
+	LastSeen     time.Time `json:"last_seen"`
+}
+
+// TopConsumersResponse ranks processes by accumulated resource time
+type TopConsumersResponse struct {
+	Since     time.Time             `json:"since"`   // 집계 시작 시각 (HWnow 시작 또는 오늘 자정 중 늦은 쪽)
+	SortBy    string                `json:"sort_by"` // "cpu" 또는 "gpu"
+	Consumers []ProcessResourceTime `json:"consumers"`
+}
+
+// processCPUTimeSample is the cumulative CPU time of a process read during a process list refresh
+type processCPUTimeSample struct {
+	pid        int32
+	name       string
+	cpuSeconds float64
+	createTime time.Time // 알 수 없으면 zero
+}
+
+type accountedPID struct {
+	name        string
+	cpuSeconds  float64   // 마지막으로 관찰한 누적 CPU 시간
+	cpuSeen     bool      // CPU 시간 기준값이 기록되었는지 여부
+	gpuUsage    float64   // 마지막으로 관찰한 GPU 사용률 (%)
+	gpuSampleAt time.Time // 마지막 GPU 샘플 시각 (zero면 GPU 샘플 없음)
+	countedDay  time.Time // ProcessCount에 반영된 날짜
+}
+
+// ProcessAccounting accumulates CPU and GPU time per process name for the current local day
+type ProcessAccounting struct {
+	mutex     sync.Mutex
+	startedAt time.Time
+	day       time.Time // 집계 중인 날짜 (현지 자정)
+	pids      map[int32]*accountedPID
+	totals    map[string]*ProcessResourceTime
+}
+
+// NewProcessAccounting creates an accounting tracker that counts resource time from startedAt on
+func NewProcessAccounting(startedAt time.Time) *ProcessAccounting {
+	return &ProcessAccounting{
+		startedAt: startedAt,
+		day:       startOfDay(startedAt),
+		pids:      make(map[int32]*accountedPID),
+		totals:    make(map[string]*ProcessResourceTime),
+	}
+}
+
+var processAccounting = NewProcessAccounting(time.Now())
+
+// startOfDay returns local midnight of the given time
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// rollover starts a new day of totals when the local date changes (caller holds the mutex)
+func (a *ProcessAccounting) rollover(now time.Time) {
+	if day := startOfDay(now); day.After(a.day) {
+		a.day = day
+		a.totals = make(map[string]*ProcessResourceTime)
+	}
+}
+
+// since returns the beginning of the current accounting window (caller holds the mutex)
+func (a *ProcessAccounting) since() time.Time {
+	if a.startedAt.After(a.day) {
+		return a.startedAt
+	}
+	return a.day
+}
+
+// entry returns the total for a process name and counts the PID once per day (caller holds the mutex)
+func (a *ProcessAccounting) entry(tracked *accountedPID, now time.Time) *ProcessResourceTime {
+	total, ok := a.totals[tracked.name]
+	if !ok {
+		total = &ProcessResourceTime{Name: tracked.name}
+		a.totals[tracked.name] = total
+	}
+	if !tracked.countedDay.Equal(a.day) {
+		tracked.countedDay = a.day
+		total.ProcessCount++
+	}
+	total.LastSeen = now
+	return total
+}
+
+// ObserveCPU adds the CPU time consumed since the previous refresh and forgets exited processes
+func (a *ProcessAccounting) ObserveCPU(samples []processCPUTimeSample, now time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.rollover(now)
+	since := a.since()
+
+	alive := make(map[int32]bool, len(samples))
+	for _, sample := range samples {
+		alive[sample.pid] = true
+
+		tracked, ok := a.pids[sample.pid]
+		if ok && tracked.name != sample.name {
+			// PID가 재사용됨
+			ok = false
+		}
+
+		if !ok {
+			tracked = &accountedPID{name: sample.name}
+			a.pids[sample.pid] = tracked
+		}
+
+		var delta float64
+		if tracked.cpuSeen {
+			delta = sample.cpuSeconds - tracked.cpuSeconds
+		} else {
+			// 집계 시작 이후 생성된 프로세스는 전체 CPU 시간이 집계 대상, 그 이전 프로세스는 기준값만 기록
+			if !sample.createTime.IsZero() && !sample.createTime.Before(since) {
+				delta = sample.cpuSeconds
+			}
+		}
+		tracked.cpuSeconds = sample.cpuSeconds
+		tracked.cpuSeen = true
+
+		total := a.entry(tracked, now)
+		if delta > 0 {
+			total.CPUSeconds += delta
+		}
+	}
+
+	for pid := range a.pids {
+		if !alive[pid] {
+			delete(a.pids, pid)
+		}
+	}
+}
+
+// ObserveGPU integrates measured per-process GPU usage over the time since the previous sample
+func (a *ProcessAccounting) ObserveGPU(processes []GPUProcess, now time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.rollover(now)
+	since := a.since()
+
+	for _, gpuProcess := range processes {
+		// 추정값은 실제 GPU 시간이 아니므로 누적하지 않음
+		if gpuProcess.EstimatedUsage || gpuProcess.GPUUsage < 0 {
+			continue
+		}
+
+		tracked, ok := a.pids[gpuProcess.PID]
+		if !ok {
+			tracked = &accountedPID{name: gpuProcess.Name}
+			a.pids[gpuProcess.PID] = tracked
+		}
+
+		if !tracked.gpuSampleAt.IsZero() {
+			start := tracked.gpuSampleAt
+			if start.Before(since) {
+				start = since
+			}
+			elapsed := now.Sub(start)
+			if elapsed > 0 && now.Sub(tracked.gpuSampleAt) <= GPU_TIME_MAX_SAMPLE_GAP {
+				total := a.entry(tracked, now)
+				total.GPUSeconds += tracked.gpuUsage / 100 * elapsed.Seconds()
+			}
+		}
+		tracked.gpuUsage = gpuProcess.GPUUsage
+		tracked.gpuSampleAt = now
+	}
+}
+
+// Top returns the accumulated totals of the current day sorted by "cpu" (default) or "gpu" time
+func (a *ProcessAccounting) Top(sortBy string, limit int, now time.Time) *TopConsumersResponse {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.rollover(now)
+
+	sortBy = strings.ToLower(sortBy)
+	if sortBy != "gpu" {
+		sortBy = "cpu"
+	}
+
+	consumers := make([]ProcessResourceTime, 0, len(a.totals))
+	for _, total := range a.totals {
+		if total.CPUSeconds > 0 || total.GPUSeconds > 0 {
+			consumers = append(consumers, *total)
+		}
+	}
+	sort.Slice(consumers, func(i, j int) bool {
+		left, right := consumers[i].CPUSeconds, consumers[j].CPUSeconds
+		if sortBy == "gpu" {
+			left, right = consumers[i].GPUSeconds, consumers[j].GPUSeconds
+		}
+		if left != right {
+			return left > right
+		}
+		return consumers[i].Name < consumers[j].Name
+	})
+	if limit > 0 && len(consumers) > limit {
+		consumers = consumers[:limit]
+	}
+
+	return &TopConsumersResponse{
+		Since:     a.since(),
+		SortBy:    sortBy,
+		Consumers: consumers,
+	}
+}
+
+// GetTopConsumers ranks processes by CPU or GPU time accumulated today since HWnow started
+func GetTopConsumers(ctx context.Context, sortBy string, limit int) (*TopConsumersResponse, error) {
+	return queryWithContext(ctx, "GetTopConsumers", func() (*TopConsumersResponse, error) { return getTopConsumers(sortBy, limit) })
+}
+
+func getTopConsumers(sortBy string, limit int) (*TopConsumersResponse, error) {
+	// 최신 CPU 시간을 반영하기 위해 프로세스 목록 캐시를 갱신
+	if _, err := getCachedProcessDetails(); err != nil {
+		return nil, err
+	}
+	return processAccounting.Top(sortBy, limit, time.Now()), nil
+}
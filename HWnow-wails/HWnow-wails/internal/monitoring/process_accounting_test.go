@@ -0,0 +1,80 @@
+package monitoring
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestProcessAccounting(t *testing.T) {
+	started := time.Date(2026, 10, 16, 9, 0, 0, 0, time.Local)
+	accounting := NewProcessAccounting(started)
+
+	// 기존 프로세스는 기준값만 기록, HWnow 시작 이후 생성된 프로세스는 전체 CPU 시간 집계
+	accounting.ObserveCPU([]processCPUTimeSample{
+		{pid: 1, name: "chrome.exe", cpuSeconds: 500, createTime: started.Add(-time.Hour)},
+		{pid: 2, name: "chrome.exe", cpuSeconds: 20, createTime: started.Add(time.Minute)},
+		{pid: 3, name: "blender.exe", cpuSeconds: 100, createTime: started.Add(-time.Hour)},
+	}, started.Add(2*time.Minute))
+	accounting.ObserveCPU([]processCPUTimeSample{
+		{pid: 1, name: "chrome.exe", cpuSeconds: 510},
+		{pid: 2, name: "chrome.exe", cpuSeconds: 25},
+		{pid: 3, name: "blender.exe", cpuSeconds: 160},
+	}, started.Add(3*time.Minute))
+
+	accounting.ObserveGPU([]GPUProcess{
+		{PID: 3, Name: "blender.exe", GPUUsage: 50},
+		{PID: 1, Name: "chrome.exe", GPUUsage: 40, EstimatedUsage: true},
+	}, started.Add(3*time.Minute))
+	accounting.ObserveGPU([]GPUProcess{
+		{PID: 3, Name: "blender.exe", GPUUsage: 80},
+	}, started.Add(3*time.Minute+10*time.Second))
+
+	t.Run("Sorted_By_CPU", func(t *testing.T) {
+		top := accounting.Top("cpu", 0, started.Add(4*time.Minute))
+		if len(top.Consumers) != 2 || top.Consumers[0].Name != "blender.exe" || top.Consumers[1].Name != "chrome.exe" {
+			t.Fatalf("Unexpected order: %+v", top.Consumers)
+		}
+		chrome := top.Consumers[1]
+		if chrome.CPUSeconds != 35 || chrome.ProcessCount != 2 {
+			t.Errorf("Unexpected totals for chrome: %+v", chrome)
+		}
+		if !top.Since.Equal(started) {
+			t.Errorf("Expected window to start at HWnow start, got %v", top.Since)
+		}
+	})
+
+	t.Run("GPU_Seconds_Measured_Only", func(t *testing.T) {
+		top := accounting.Top("gpu", 1, started.Add(4*time.Minute))
+		if len(top.Consumers) != 1 || top.Consumers[0].Name != "blender.exe" {
+			t.Fatalf("Unexpected result: %+v", top.Consumers)
+		}
+		if math.Abs(top.Consumers[0].GPUSeconds-5) > 1e-9 {
+			t.Errorf("Expected 5 GPU seconds, got %v", top.Consumers[0].GPUSeconds)
+		}
+	})
+
+	t.Run("Long_Gap_Not_Integrated", func(t *testing.T) {
+		accounting.ObserveGPU([]GPUProcess{
+			{PID: 3, Name: "blender.exe", GPUUsage: 80},
+		}, started.Add(10*time.Minute))
+		top := accounting.Top("gpu", 1, started.Add(10*time.Minute))
+		if math.Abs(top.Consumers[0].GPUSeconds-5) > 1e-9 {
+			t.Errorf("Expected gap to be skipped, got %v", top.Consumers[0].GPUSeconds)
+		}
+	})
+
+	t.Run("Day_Rollover", func(t *testing.T) {
+		tomorrow := startOfDay(started).Add(24*time.Hour + time.Hour)
+		accounting.ObserveCPU([]processCPUTimeSample{
+			{pid: 3, name: "blender.exe", cpuSeconds: 170},
+		}, tomorrow)
+		top := accounting.Top("cpu", 0, tomorrow)
+		if len(top.Consumers) != 1 || top.Consumers[0].CPUSeconds != 10 {
+			t.Errorf("Expected only today's CPU time, got %+v", top.Consumers)
+		}
+		if !top.Since.Equal(startOfDay(tomorrow)) {
+			t.Errorf("Expected window to start at midnight, got %v", top.Since)
+		}
+	})
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package monitoring
+
+import "fmt"
+
+// setPriorityClassWindows is only available on Windows (other platforms use renice)
+func setPriorityClassWindows(pid int32, priorityClass uint32) error {
+	return fmt.Errorf("SetPriorityClass not supported on this platform")
+}
+
+// terminateProcessWindows is only available on Windows (other platforms use kill)
+func terminateProcessWindows(pid int32) error {
+	return fmt.Errorf("TerminateProcess not supported on this platform")
+}
+
+// openProcessWindows is only available on Windows (other platforms compare process owners)
+func openProcessWindows(pid int32, access uint32) error {
+	return fmt.Errorf("OpenProcess not supported on this platform")
+}
@@ -0,0 +1,195 @@
+package monitoring
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 프로세스 제어 미리보기 (dry-run)
+// 실제 제어(종료/일시정지/재개/우선순위)와 같은 검증(존재, 보호 규칙, GPU 사용 여부, 권한, 우선순위 값)을 실행하되
+// 동작은 수행하지 않고 결과만 보고하여 프론트엔드가 버튼 활성화 여부를 정확히 결정할 수 있게 함
+// 보호 규칙 판단은 실제 요청이 아니므로 감사 로그에 기록하지 않음
+
+// Control preview checks
+const (
+	ControlCheckExists     = "exists"
+	ControlCheckProtection = "protection"
+	ControlCheckPriority   = "priority"
+	ControlCheckGPU        = "gpu"
+	ControlCheckPrivileges = "privileges"
+	ControlCheckReadOnly   = "read_only"
+)
+
+// 제어 작업별 Windows OpenProcess 접근 권한
+var controlOperationAccess = map[string]uint32{
+	"kill":     windowsProcessTerminate,
+	"suspend":  windowsProcessSuspendResume,
+	"resume":   windowsProcessSuspendResume,
+	"priority": windowsProcessSetInformation,
+}
+
+// ControlCheck is one validation step of a control preview
+type ControlCheck struct {
+	Name     string `json:"name"` // exists, protection, priority, gpu, privileges, read_only
+	Passed   bool   `json:"passed"`
+	Blocking bool   `json:"blocking"` // 실패하면 제어가 거부됨 (gpu는 경고만)
+	Message  string `json:"message,omitempty"`
+}
+
+// ControlPreview reports what a process control request would do without performing it
+type ControlPreview struct {
+	PID         int32          `json:"pid"`
+	ProcessName string         `json:"process_name,omitempty"`
+	Operation   string         `json:"operation"` // kill, suspend, resume, priority
+	Priority    string         `json:"priority,omitempty"`
+	Allowed     bool           `json:"allowed"`          // 차단 검사를 모두 통과 (실제 실행 성공을 보장하지는 않음)
+	Action      string         `json:"action,omitempty"` // 실행될 동작 (예: "kill -STOP 1234")
+	Checks      []ControlCheck `json:"checks"`
+	Warnings    []string       `json:"warnings,omitempty"`
+}
+
+// AddCheck appends a check result and updates Allowed
+func (preview *ControlPreview) AddCheck(name string, passed, blocking bool, message string) {
+	preview.Checks = append(preview.Checks, ControlCheck{Name: name, Passed: passed, Blocking: blocking, Message: message})
+	preview.Allowed = true
+	for _, check := range preview.Checks {
+		if check.Blocking && !check.Passed {
+			preview.Allowed = false
+		}
+	}
+}
+
+// PreviewProcessControl runs the validations of a kill/suspend/resume/priority request without performing it
+func PreviewProcessControl(operation string, pid int32, priority string) (*ControlPreview, error) {
+	operation = strings.ToLower(strings.TrimSpace(operation))
+	access, ok := controlOperationAccess[operation]
+	if !ok {
+		return nil, fmt.Errorf("unknown control operation: %s (valid: kill, suspend, resume, priority)", operation)
+	}
+
+	preview := &ControlPreview{PID: pid, Operation: operation, Checks: []ControlCheck{}}
+	if operation == "priority" {
+		preview.Priority = strings.ToLower(priority)
+	}
+
+	// 1. 프로세스 존재 확인
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		preview.AddCheck(ControlCheckExists, false, true, fmt.Sprintf("process with PID %d not found", pid))
+		return preview, nil
+	}
+	name, err := proc.Name()
+	if err != nil {
+		preview.AddCheck(ControlCheckExists, false, true, fmt.Sprintf("failed to get process name: %v", err))
+		return preview, nil
+	}
+	preview.ProcessName = name
+	preview.AddCheck(ControlCheckExists, true, true, "")
+
+	// 2. 보호 규칙 (감사 로그에 기록하지 않음)
+	if info, critical := GetProcessProtectionService().IsCriticalProcess(name, pid); critical {
+		if err := controlDecision(info, name, pid); err != nil {
+			preview.AddCheck(ControlCheckProtection, false, true, err.Error())
+		} else {
+			preview.AddCheck(ControlCheckProtection, true, true,
+				fmt.Sprintf("%s-protected process: %s", info.ProtectionLevel, info.Description))
+		}
+	} else {
+		preview.AddCheck(ControlCheckProtection, true, true, "")
+	}
+
+	// 3. 우선순위 값
+	var niceValue int
+	var windowsPriority uint32
+	if operation == "priority" {
+		if niceValue, windowsPriority, err = priorityLevelValues(priority); err != nil {
+			preview.AddCheck(ControlCheckPriority, false, true, err.Error())
+		} else {
+			preview.AddCheck(ControlCheckPriority, true, true, "")
+		}
+	}
+
+	// 4. GPU 사용 여부 (실제 제어와 마찬가지로 경고만)
+	if isGPUProcess, err := verifyGPUProcess(pid); err != nil {
+		preview.AddCheck(ControlCheckGPU, false, false, fmt.Sprintf("could not verify GPU usage: %v", err))
+	} else if !isGPUProcess {
+		preview.AddCheck(ControlCheckGPU, false, false, "process is not an active GPU process")
+	} else {
+		preview.AddCheck(ControlCheckGPU, true, false, "")
+	}
+
+	// 5. 권한
+	if message, err := checkControlPrivileges(proc, operation, access, niceValue); err != nil {
+		preview.AddCheck(ControlCheckPrivileges, false, true, err.Error())
+	} else {
+		preview.AddCheck(ControlCheckPrivileges, true, true, message)
+	}
+	if operation == "priority" && runtime.GOOS == "windows" && windowsPriority == windowsRealtimePriorityClass && !hasSimpleAdminRights() {
+		preview.Warnings = append(preview.Warnings, "realtime priority requires administrator rights; Windows applies high instead")
+	}
+
+	preview.Action = controlAction(runtime.GOOS, operation, pid, niceValue, windowsPriority)
+	return preview, nil
+}
+
+// checkControlPrivileges checks whether the current process may control the target process
+// (Windows: OpenProcess with the operation's access rights, Unix: process owner and nice value)
+func checkControlPrivileges(proc *process.Process, operation string, access uint32, niceValue int) (string, error) {
+	if runtime.GOOS == "windows" {
+		if err := openProcessWindows(proc.Pid, access); err != nil {
+			return "", fmt.Errorf("insufficient privileges to %s process: %v", operation, err)
+		}
+		return "", nil
+	}
+
+	if os.Geteuid() == 0 {
+		return "running as root", nil
+	}
+	uids, err := proc.Uids()
+	if err != nil || len(uids) == 0 {
+		return "could not verify process owner", nil
+	}
+	if owner := uids[0]; owner != int32(os.Getuid()) && owner != int32(os.Geteuid()) {
+		return "", fmt.Errorf("process is owned by another user (uid %d); root is required", owner)
+	}
+	if operation == "priority" {
+		// 비특권 사용자는 nice 값을 낮출 수 없음 (우선순위 상승)
+		if _, current := processPriorityOf(proc); niceValue < int(current) {
+			return "", fmt.Errorf("raising priority (nice %d to %d) requires root", current, niceValue)
+		}
+	}
+	return "", nil
+}
+
+// controlAction describes the command or API call a control request would perform
+func controlAction(goos, operation string, pid int32, niceValue int, windowsPriority uint32) string {
+	if goos == "windows" {
+		switch operation {
+		case "kill":
+			return fmt.Sprintf("taskkill /F /PID %d", pid)
+		case "suspend":
+			return fmt.Sprintf("NtSuspendProcess(%d)", pid)
+		case "resume":
+			return fmt.Sprintf("NtResumeProcess(%d)", pid)
+		case "priority":
+			return fmt.Sprintf("SetPriorityClass(%d, 0x%X)", pid, windowsPriority)
+		}
+		return ""
+	}
+
+	switch operation {
+	case "kill":
+		return fmt.Sprintf("kill -9 %d", pid)
+	case "suspend":
+		return fmt.Sprintf("kill -STOP %d", pid)
+	case "resume":
+		return fmt.Sprintf("kill -CONT %d", pid)
+	case "priority":
+		return fmt.Sprintf("renice %d %d", niceValue, pid)
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package monitoring
+
+import (
+	"os"
+	"testing"
+)
+
+func TestPreviewProcessControlUnknownOperation(t *testing.T) {
+	if _, err := PreviewProcessControl("restart", int32(os.Getpid()), ""); err == nil {
+		t.Error("Expected an error for an unknown operation")
+	}
+}
+
+func TestPreviewProcessControlMissingProcess(t *testing.T) {
+	preview, err := PreviewProcessControl("kill", 999999999, "")
+	if err != nil {
+		t.Fatalf("PreviewProcessControl failed: %v", err)
+	}
+	if preview.Allowed || len(preview.Checks) != 1 || preview.Checks[0].Name != ControlCheckExists || preview.Checks[0].Passed {
+		t.Errorf("Expected a failed existence check, got %+v", preview)
+	}
+}
+
+func TestPreviewProcessControlInvalidPriority(t *testing.T) {
+	preview, err := PreviewProcessControl("priority", int32(os.Getpid()), "turbo")
+	if err != nil {
+		t.Fatalf("PreviewProcessControl failed: %v", err)
+	}
+	if preview.Allowed {
+		t.Error("Expected an invalid priority to be refused")
+	}
+	for _, check := range preview.Checks {
+		if check.Name == ControlCheckPriority && check.Passed {
+			t.Errorf("Expected the priority check to fail, got %+v", check)
+		}
+	}
+}
+
+func TestControlPreviewAddCheck(t *testing.T) {
+	preview := &ControlPreview{}
+	preview.AddCheck(ControlCheckExists, true, true, "")
+	preview.AddCheck(ControlCheckGPU, false, false, "not a GPU process")
+	if !preview.Allowed {
+		t.Error("Expected a failed non-blocking check to keep the request allowed")
+	}
+	preview.AddCheck(ControlCheckReadOnly, false, true, "read-only")
+	if preview.Allowed {
+		t.Error("Expected a failed blocking check to refuse the request")
+	}
+}
+
+func TestControlAction(t *testing.T) {
+	cases := []struct {
+		goos, operation string
+		nice            int
+		class           uint32
+		want            string
+	}{
+		{"linux", "suspend", 0, 0, "kill -STOP 42"},
+		{"linux", "priority", -10, windowsHighPriorityClass, "renice -10 42"},
+		{"windows", "kill", 0, 0, "taskkill /F /PID 42"},
+		{"windows", "priority", 0, windowsHighPriorityClass, "SetPriorityClass(42, 0x80)"},
+	}
+	for _, c := range cases {
+		if got := controlAction(c.goos, c.operation, 42, c.nice, c.class); got != c.want {
+			t.Errorf("controlAction(%s, %s) = %q, want %q", c.goos, c.operation, got, c.want)
+		}
+	}
+}
+
+func TestPriorityLevelValues(t *testing.T) {
+	if nice, class, err := priorityLevelValues("Below_Normal"); err != nil || nice != 5 || class != windowsBelowNormalPriorityClass {
+		t.Errorf("Unexpected values for below_normal: %d, 0x%X, %v", nice, class, err)
+	}
+	if _, _, err := priorityLevelValues("turbo"); err == nil {
+		t.Error("Expected an error for an unknown priority")
+	}
+}
@@ -0,0 +1,48 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Windows 프로세스 우선순위 변경 / 강제 종료 (kernel32)
+
+// setPriorityClassWindows changes the priority class of a process via SetPriorityClass
+func setPriorityClassWindows(pid int32, priorityClass uint32) error {
+	handle, err := syscall.OpenProcess(windowsProcessSetInformation, false, uint32(pid))
+	if err != nil {
+		return fmt.Errorf("OpenProcess failed: %v", err)
+	}
+	defer syscall.CloseHandle(handle)
+
+	setPriorityClass := syscall.NewLazyDLL("kernel32.dll").NewProc("SetPriorityClass")
+	if ret, _, callErr := setPriorityClass.Call(uintptr(handle), uintptr(priorityClass)); ret == 0 {
+		return fmt.Errorf("SetPriorityClass failed: %v", callErr)
+	}
+	return nil
+}
+
+// terminateProcessWindows force-terminates a process via TerminateProcess
+func terminateProcessWindows(pid int32) error {
+	handle, err := syscall.OpenProcess(syscall.PROCESS_TERMINATE, false, uint32(pid))
+	if err != nil {
+		return fmt.Errorf("OpenProcess failed: %v", err)
+	}
+	defer syscall.CloseHandle(handle)
+
+	if err := syscall.TerminateProcess(handle, 1); err != nil {
+		return fmt.Errorf("TerminateProcess failed: %v", err)
+	}
+	return nil
+}
+
+// openProcessWindows checks that the process can be opened with the given access rights (제어 미리보기용)
+func openProcessWindows(pid int32, access uint32) error {
+	handle, err := syscall.OpenProcess(access, false, uint32(pid))
+	if err != nil {
+		return fmt.Errorf("OpenProcess failed: %v", err)
+	}
+	return syscall.CloseHandle(handle)
+}
@@ -0,0 +1,148 @@
+package monitoring
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// 애플리케이션별 프로세스 묶음 집계 (브라우저처럼 수십 개의 하위 프로세스를 띄우는 앱을 한 줄로 표시)
+// 1. 실행 파일 이름이 같은 프로세스는 한 애플리케이션으로 묶음 (chrome.exe 34개 → Chrome)
+// 2. 부모 프로세스 이름으로 시작하는 하위 프로세스는 부모 애플리케이션에 포함 ("Google Chrome Helper (Renderer)" → "Google Chrome")
+// 관련 없는 부모(explorer.exe, launchd 등)는 이름이 달라 묶이지 않음
+
+// 부모 체인을 따라 올라가는 최대 깊이 (PID 재사용으로 생긴 순환 방지)
+const maxProcessGroupDepth = 32
+
+// ProcessGroup aggregates the resource usage of all processes belonging to one application
+type ProcessGroup struct {
+	Name          string  `json:"name"` // 대표 프로세스 이름 (최상위 프로세스 기준)
+	ProcessCount  int     `json:"process_count"`
+	PIDs          []int32 `json:"pids"`
+	CPUPercent    float64 `json:"cpu_percent"`    // 프로세스 CPU 사용률 합계 (%)
+	MemoryPercent float64 `json:"memory_percent"` // 물리 메모리 사용률 합계 (%)
+	MemoryRSS     float64 `json:"memory_rss"`     // 상주 메모리 합계 (MB)
+	GPUUsage      float64 `json:"gpu_usage"`      // GPU 사용률 합계 (%)
+	GPUMemory     float64 `json:"gpu_memory"`     // GPU 메모리 합계 (MB)
+}
+
+// GetProcessGroups returns resource usage per application (process tree / executable name), sorted by CPU usage
+func GetProcessGroups(ctx context.Context) ([]ProcessGroup, error) {
+	return queryWithContext(ctx, "GetProcessGroups", getProcessGroups)
+}
+
+func getProcessGroups() ([]ProcessGroup, error) {
+	details, err := getCachedProcessDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	// GPU 정보가 없는 환경에서도 CPU/메모리 집계는 제공
+	gpuProcesses, err := getGPUProcessesFromMonitor()
+	if err != nil {
+		LogDebug("GPU processes not available for process groups", "error", err)
+	}
+
+	return aggregateProcessGroups(details, gpuProcesses), nil
+}
+
+// aggregateProcessGroups sums process metrics by application
+func aggregateProcessGroups(details []ProcessDetail, gpuProcesses []GPUProcess) []ProcessGroup {
+	byPID := make(map[int32]ProcessDetail, len(details))
+	for _, detail := range details {
+		byPID[detail.PID] = detail
+	}
+
+	groups := make(map[string]*ProcessGroup)
+	groupOf := make(map[int32]string, len(details))
+	for _, detail := range details {
+		root := processGroupRoot(detail, byPID)
+		key := processGroupKey(root.Name)
+		groupOf[detail.PID] = key
+
+		group, ok := groups[key]
+		if !ok {
+			group = &ProcessGroup{Name: displayProcessGroupName(root.Name)}
+			groups[key] = group
+		}
+		group.ProcessCount++
+		group.PIDs = append(group.PIDs, detail.PID)
+		group.CPUPercent += detail.CPUPercent
+		group.MemoryPercent += detail.MemoryPercent
+		group.MemoryRSS += detail.MemoryRSS
+	}
+
+	for _, gpuProcess := range gpuProcesses {
+		key, ok := groupOf[gpuProcess.PID]
+		if !ok {
+			// 프로세스 목록 갱신 이전에 시작된 프로세스는 GPU 프로세스 이름으로 묶음
+			key = processGroupKey(gpuProcess.Name)
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &ProcessGroup{Name: displayProcessGroupName(gpuProcess.Name)}
+			groups[key] = group
+		}
+		group.GPUUsage += gpuProcess.GPUUsage
+		group.GPUMemory += gpuProcess.GPUMemory
+	}
+
+	result := make([]ProcessGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group.PIDs, func(i, j int) bool { return group.PIDs[i] < group.PIDs[j] })
+		if group.PIDs == nil {
+			group.PIDs = []int32{}
+		}
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CPUPercent != result[j].CPUPercent {
+			return result[i].CPUPercent > result[j].CPUPercent
+		}
+		if result[i].MemoryRSS != result[j].MemoryRSS {
+			return result[i].MemoryRSS > result[j].MemoryRSS
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// processGroupRoot walks up the parent chain while the parent belongs to the same application
+func processGroupRoot(detail ProcessDetail, byPID map[int32]ProcessDetail) ProcessDetail {
+	root := detail
+	for depth := 0; depth < maxProcessGroupDepth; depth++ {
+		if root.PPID == 0 || root.PPID == root.PID {
+			break
+		}
+		parent, ok := byPID[root.PPID]
+		if !ok || !isSameApplication(parent.Name, root.Name) {
+			break
+		}
+		root = parent
+	}
+	return root
+}
+
+// isSameApplication reports whether child is the parent executable itself or one of its helpers
+func isSameApplication(parentName, childName string) bool {
+	parent := processGroupKey(parentName)
+	child := processGroupKey(childName)
+	if parent == "" || child == "" {
+		return false
+	}
+	return child == parent || strings.HasPrefix(child, parent+" ")
+}
+
+// processGroupKey normalizes an executable name (case-insensitive, without .exe)
+func processGroupKey(name string) string {
+	return strings.ToLower(displayProcessGroupName(name))
+}
+
+// displayProcessGroupName strips the Windows executable extension for display
+func displayProcessGroupName(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.HasSuffix(strings.ToLower(name), ".exe") {
+		name = name[:len(name)-len(".exe")]
+	}
+	return name
+}
@@ -0,0 +1,73 @@
+package monitoring
+
+import "testing"
+
+func TestAggregateProcessGroups(t *testing.T) {
+	details := []ProcessDetail{
+		{PID: 10, PPID: 1, Name: "explorer.exe", CPUPercent: 1, MemoryRSS: 100},
+		{PID: 20, PPID: 10, Name: "chrome.exe", CPUPercent: 5, MemoryRSS: 300},
+		{PID: 21, PPID: 20, Name: "chrome.exe", CPUPercent: 10, MemoryRSS: 500},
+		{PID: 22, PPID: 20, Name: "Chrome.exe", CPUPercent: 3, MemoryRSS: 200},
+		{PID: 30, PPID: 1, Name: "Google Chrome", CPUPercent: 2, MemoryRSS: 50},
+		{PID: 31, PPID: 30, Name: "Google Chrome Helper (Renderer)", CPUPercent: 4, MemoryRSS: 150},
+		{PID: 40, PPID: 10, Name: "python.exe", CPUPercent: 30, MemoryRSS: 1000},
+		{PID: 41, PPID: 40, Name: "python3", CPUPercent: 0.5, MemoryRSS: 20},
+	}
+	gpuProcesses := []GPUProcess{
+		{PID: 21, Name: "chrome.exe", GPUUsage: 12, GPUMemory: 900},
+		{PID: 99, Name: "game.exe", GPUUsage: 60, GPUMemory: 4000}, // 프로세스 목록 갱신 이전에 시작된 프로세스
+	}
+
+	groups := aggregateProcessGroups(details, gpuProcesses)
+	byName := make(map[string]ProcessGroup, len(groups))
+	for _, group := range groups {
+		byName[group.Name] = group
+	}
+
+	t.Run("Same_Executable_Name", func(t *testing.T) {
+		chrome := byName["chrome"]
+		if chrome.ProcessCount != 3 || chrome.CPUPercent != 18 || chrome.MemoryRSS != 1000 || chrome.GPUMemory != 900 {
+			t.Errorf("Unexpected chrome group: %+v", chrome)
+		}
+		if len(chrome.PIDs) != 3 || chrome.PIDs[0] != 20 || chrome.PIDs[2] != 22 {
+			t.Errorf("Unexpected chrome PIDs: %v", chrome.PIDs)
+		}
+	})
+
+	t.Run("Helper_Joins_Parent", func(t *testing.T) {
+		if group := byName["Google Chrome"]; group.ProcessCount != 2 || group.CPUPercent != 6 {
+			t.Errorf("Unexpected helper grouping: %+v", group)
+		}
+	})
+
+	t.Run("Unrelated_Parent_Not_Merged", func(t *testing.T) {
+		if group := byName["explorer"]; group.ProcessCount != 1 {
+			t.Errorf("Explorer absorbed its children: %+v", group)
+		}
+		if group := byName["python3"]; group.ProcessCount != 1 {
+			t.Errorf("python3 should not join python: %+v", byName)
+		}
+	})
+
+	t.Run("GPU_Only_Process", func(t *testing.T) {
+		if group := byName["game"]; group.ProcessCount != 0 || group.GPUMemory != 4000 || group.PIDs == nil {
+			t.Errorf("Unexpected GPU-only group: %+v", group)
+		}
+	})
+
+	t.Run("Sorted_By_CPU", func(t *testing.T) {
+		if groups[0].Name != "python" || groups[1].Name != "chrome" {
+			t.Errorf("Unexpected order: %+v", groups)
+		}
+	})
+
+	t.Run("Parent_Cycle_Terminates", func(t *testing.T) {
+		cyclic := []ProcessDetail{
+			{PID: 1, PPID: 2, Name: "a.exe"},
+			{PID: 2, PPID: 1, Name: "a.exe"},
+		}
+		if groups := aggregateProcessGroups(cyclic, nil); len(groups) != 1 || groups[0].ProcessCount != 2 {
+			t.Errorf("Unexpected cyclic grouping: %+v", groups)
+		}
+	})
+}
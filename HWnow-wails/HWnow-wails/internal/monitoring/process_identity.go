@@ -0,0 +1,102 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 프로세스 식별 정보 (명령줄, 실행 파일 경로, 시작 시간, 사용자)
+// 같은 이름의 프로세스(python.exe 5개 등)를 구분하기 위함
+// 조회 비용이 크므로 detail=full 요청에서만 채우고, PID + 시작 시간 기준으로 캐시 (PID 재사용 시 다시 조회)
+
+const (
+	ProcessDetailBasic = ""     // 기본 필드만
+	ProcessDetailFull  = "full" // 명령줄, 실행 파일 경로, 시작 시간, 사용자 포함
+
+	maxProcessIdentityCacheSize = 4096
+)
+
+// ProcessIdentity identifies a process instance beyond its name
+type ProcessIdentity struct {
+	Cmdline   string
+	ExePath   string
+	StartTime *time.Time
+	Username  string
+}
+
+type processIdentityEntry struct {
+	createTime int64
+	identity   ProcessIdentity
+}
+
+var processIdentityCache = struct {
+	mutex   sync.Mutex
+	entries map[int32]processIdentityEntry
+}{entries: make(map[int32]processIdentityEntry)}
+
+// getProcessIdentity returns the command line, executable path, start time and owner of a process
+// (fields the OS refuses to report, e.g. for protected processes, stay empty)
+func getProcessIdentity(pid int32) (ProcessIdentity, bool) {
+	p, err := process.NewProcess(pid)
+	if err != nil {
+		return ProcessIdentity{}, false
+	}
+	createTime, _ := p.CreateTime()
+
+	processIdentityCache.mutex.Lock()
+	entry, cached := processIdentityCache.entries[pid]
+	processIdentityCache.mutex.Unlock()
+	if cached && entry.createTime == createTime {
+		return entry.identity, true
+	}
+
+	identity := ProcessIdentity{}
+	identity.Cmdline, _ = p.Cmdline()
+	identity.ExePath, _ = p.Exe()
+	identity.Username, _ = p.Username()
+	if createTime > 0 {
+		startTime := time.UnixMilli(createTime)
+		identity.StartTime = &startTime
+	}
+
+	processIdentityCache.mutex.Lock()
+	// 종료된 프로세스 항목이 쌓이지 않도록 크기 제한을 넘으면 비움
+	if len(processIdentityCache.entries) >= maxProcessIdentityCacheSize {
+		processIdentityCache.entries = make(map[int32]processIdentityEntry)
+	}
+	processIdentityCache.entries[pid] = processIdentityEntry{createTime: createTime, identity: identity}
+	processIdentityCache.mutex.Unlock()
+	return identity, true
+}
+
+// AddProcessIdentities fills Cmdline, ExePath, StartTime and Username of top processes
+func AddProcessIdentities(processes []ProcessInfo) {
+	for i := range processes {
+		identity, ok := getProcessIdentity(processes[i].PID)
+		if !ok {
+			continue
+		}
+		processes[i].Cmdline = identity.Cmdline
+		processes[i].ExePath = identity.ExePath
+		processes[i].StartTime = identity.StartTime
+		if identity.Username != "" {
+			processes[i].Username = identity.Username
+		}
+	}
+}
+
+// AddGPUProcessIdentities fills Cmdline, ExePath, StartTime and Username of GPU processes
+func AddGPUProcessIdentities(processes []GPUProcess) {
+	for i := range processes {
+		identity, ok := getProcessIdentity(processes[i].PID)
+		if !ok {
+			continue
+		}
+		processes[i].Cmdline = identity.Cmdline
+		processes[i].ExePath = identity.ExePath
+		processes[i].StartTime = identity.StartTime
+		processes[i].Username = identity.Username
+	}
+}
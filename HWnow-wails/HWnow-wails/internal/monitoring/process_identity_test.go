@@ -0,0 +1,26 @@
+package monitoring
+
+import (
+	"os"
+	"testing"
+)
+
+func TestAddProcessIdentities(t *testing.T) {
+	pid := int32(os.Getpid())
+
+	processes := []ProcessInfo{{PID: pid, Name: "monitoring.test"}, {PID: -1, Name: "gone"}}
+	AddProcessIdentities(processes)
+	if processes[0].Cmdline == "" || processes[0].ExePath == "" || processes[0].StartTime == nil {
+		t.Errorf("Expected identity of the test process, got %+v", processes[0])
+	}
+	if processes[1].Cmdline != "" || processes[1].StartTime != nil {
+		t.Errorf("Expected missing process to keep empty identity, got %+v", processes[1])
+	}
+
+	// 캐시된 결과도 같은 값을 반환
+	gpuProcesses := []GPUProcess{{PID: pid}}
+	AddGPUProcessIdentities(gpuProcesses)
+	if gpuProcesses[0].Cmdline != processes[0].Cmdline || !gpuProcesses[0].StartTime.Equal(*processes[0].StartTime) {
+		t.Errorf("Expected cached identity to match, got %+v", gpuProcesses[0])
+	}
+}
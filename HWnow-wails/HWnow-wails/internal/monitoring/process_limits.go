@@ -0,0 +1,153 @@
+package monitoring
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 프로세스 자원 한도 (우선순위 변경보다 강한 격리)
+// Linux: cgroup v2의 전용 slice(hwnow.slice/pid-<PID>)에 넣고 cpu.max/memory.max 설정
+// Windows: 프로세스별 Job Object에 할당하고 CPU rate hard cap / job 메모리 한도 설정 (자식 프로세스 포함)
+// CPU 한도는 전체 CPU 용량 대비 비율 (100 = 모든 코어), 0 = 제한 없음
+
+const (
+	cgroupRoot      = "/sys/fs/cgroup"
+	cgroupSlice     = "hwnow.slice"
+	cgroupCPUPeriod = 100000 // cpu.max 기본 주기 (µs)
+)
+
+// ProcessLimits describes the CPU/memory caps applied to a process
+type ProcessLimits struct {
+	PID        int32   `json:"pid"`
+	Name       string  `json:"name"`
+	CPUPercent float64 `json:"cpu_percent"` // 전체 CPU 용량 대비 (%), 0 = 제한 없음
+	MemoryMB   float64 `json:"memory_mb"`   // 0 = 제한 없음
+	Method     string  `json:"method"`      // cgroup, job_object
+	Group      string  `json:"group"`       // cgroup 경로 또는 Job Object 이름
+}
+
+// 한도 적용 직렬화 (Windows Job Object 핸들 맵 보호)
+var processLimitMutex sync.Mutex
+
+// SetProcessLimits caps the CPU and memory of a process (0 removes the respective limit)
+func SetProcessLimits(pid int32, cpuPercent, memoryMB float64) (*ProcessLimits, error) {
+	if cpuPercent < 0 || cpuPercent > 100 {
+		return nil, fmt.Errorf("CPU limit must be between 0 and 100 percent, got %.1f", cpuPercent)
+	}
+	if memoryMB < 0 {
+		return nil, fmt.Errorf("memory limit cannot be negative")
+	}
+
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return nil, fmt.Errorf("process with PID %d not found: %v", pid, err)
+	}
+	name, err := proc.Name()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get process name: %v", err)
+	}
+
+	// 시스템 프로세스 격리 방지
+	if _, protectionErr := isCriticalProcessEnhanced("limit", name, pid); protectionErr != nil {
+		return nil, protectionErr
+	}
+
+	processLimitMutex.Lock()
+	defer processLimitMutex.Unlock()
+
+	limits := &ProcessLimits{PID: pid, Name: name, CPUPercent: cpuPercent, MemoryMB: memoryMB}
+	switch runtime.GOOS {
+	case "windows":
+		limits.Method = "job_object"
+		limits.Group, err = applyJobObjectLimits(pid, cpuPercent, memoryMB)
+	case "linux":
+		limits.Method = "cgroup"
+		limits.Group, err = applyCgroupLimits(pid, cpuPercent, memoryMB)
+	default:
+		err = fmt.Errorf("process resource limits are not supported on %s", runtime.GOOS)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	LogInfo("Process resource limits applied", "pid", pid, "name", name,
+		"cpuPercent", cpuPercent, "memoryMB", memoryMB, "method", limits.Method, "group", limits.Group)
+	return limits, nil
+}
+
+// applyCgroupLimits moves the process into its own cgroup v2 group under hwnow.slice
+func applyCgroupLimits(pid int32, cpuPercent, memoryMB float64) (string, error) {
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return "", fmt.Errorf("cgroup v2 is not available: %v", err)
+	}
+
+	slice := filepath.Join(cgroupRoot, cgroupSlice)
+	group := filepath.Join(slice, fmt.Sprintf("pid-%d", pid))
+	if err := os.MkdirAll(group, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cgroup %s (root required): %v", group, err)
+	}
+
+	// 하위 그룹에서 cpu/memory 컨트롤러를 쓰려면 상위에서 위임 필요
+	for _, parent := range []string{cgroupRoot, slice} {
+		if err := writeCgroupFile(parent, "cgroup.subtree_control", "+cpu +memory"); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writeCgroupFile(group, "cpu.max", cgroupCPUMax(cpuPercent, runtime.NumCPU())); err != nil {
+		return "", err
+	}
+	if err := writeCgroupFile(group, "memory.max", cgroupMemoryMax(memoryMB)); err != nil {
+		return "", err
+	}
+	if err := writeCgroupFile(group, "cgroup.procs", strconv.Itoa(int(pid))); err != nil {
+		return "", err
+	}
+	return group, nil
+}
+
+// writeCgroupFile writes a single cgroup interface file
+func writeCgroupFile(dir, name, value string) error {
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0644); err != nil {
+		return fmt.Errorf("failed to write %s to %s: %v", value, filepath.Join(dir, name), err)
+	}
+	return nil
+}
+
+// cgroupCPUMax converts a share of total CPU capacity to a cpu.max value ("quota period")
+func cgroupCPUMax(cpuPercent float64, numCPU int) string {
+	if cpuPercent <= 0 {
+		return fmt.Sprintf("max %d", cgroupCPUPeriod)
+	}
+	quota := int64(cpuPercent / 100 * float64(numCPU) * cgroupCPUPeriod)
+	if quota < 1000 {
+		quota = 1000 // 커널 최소값 (1ms)
+	}
+	return fmt.Sprintf("%d %d", quota, cgroupCPUPeriod)
+}
+
+// cgroupMemoryMax converts a memory limit in MB to a memory.max value
+func cgroupMemoryMax(memoryMB float64) string {
+	if memoryMB <= 0 {
+		return "max"
+	}
+	return strconv.FormatInt(int64(memoryMB*1024*1024), 10)
+}
+
+// jobCPURate converts a share of total CPU capacity to a Job Object CpuRate (1/100 %, 1-10000)
+func jobCPURate(cpuPercent float64) uint32 {
+	rate := uint32(cpuPercent * 100)
+	if rate < 1 {
+		rate = 1
+	}
+	if rate > 10000 {
+		rate = 10000
+	}
+	return rate
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// applyJobObjectLimits is only available on Windows (Job Objects)
+func applyJobObjectLimits(pid int32, cpuPercent, memoryMB float64) (string, error) {
+	return "", fmt.Errorf("job object limits not supported on platform: %s", runtime.GOOS)
+}
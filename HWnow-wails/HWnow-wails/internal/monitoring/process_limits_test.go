@@ -0,0 +1,36 @@
+package monitoring
+
+import "testing"
+
+func TestProcessLimitValues(t *testing.T) {
+	t.Run("Cgroup_CPU_Max", func(t *testing.T) {
+		// 8코어의 25% = 2코어 = 200000µs / 100000µs
+		if got := cgroupCPUMax(25, 8); got != "200000 100000" {
+			t.Errorf("Expected 200000 100000, got %q", got)
+		}
+		if got := cgroupCPUMax(0, 8); got != "max 100000" {
+			t.Errorf("Expected unlimited cpu.max, got %q", got)
+		}
+		if got := cgroupCPUMax(0.001, 1); got != "1000 100000" {
+			t.Errorf("Expected minimum quota, got %q", got)
+		}
+	})
+
+	t.Run("Cgroup_Memory_Max", func(t *testing.T) {
+		if got := cgroupMemoryMax(512); got != "536870912" {
+			t.Errorf("Expected 536870912, got %q", got)
+		}
+		if got := cgroupMemoryMax(0); got != "max" {
+			t.Errorf("Expected max, got %q", got)
+		}
+	})
+
+	t.Run("Job_CPU_Rate", func(t *testing.T) {
+		cases := map[float64]uint32{25: 2500, 100: 10000, 0.001: 1}
+		for percent, expected := range cases {
+			if got := jobCPURate(percent); got != expected {
+				t.Errorf("jobCPURate(%v) = %d, expected %d", percent, got, expected)
+			}
+		}
+	})
+}
@@ -0,0 +1,131 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// Windows Job Object 상수
+const (
+	jobObjectExtendedLimitInformation  = 9
+	jobObjectCPURateControlInformation = 15
+	jobObjectLimitJobMemory            = 0x200
+	jobObjectCPURateControlEnable      = 0x1
+	jobObjectCPURateControlHardCap     = 0x4
+	processSetQuota                    = 0x0100
+	processTerminate                   = 0x0001
+)
+
+// jobObjectBasicLimitInformation mirrors JOBOBJECT_BASIC_LIMIT_INFORMATION
+type jobObjectBasicLimitInformation struct {
+	PerProcessUserTimeLimit int64
+	PerJobUserTimeLimit     int64
+	LimitFlags              uint32
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+}
+
+// jobObjectExtendedLimitInfo mirrors JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+type jobObjectExtendedLimitInfo struct {
+	BasicLimitInformation jobObjectBasicLimitInformation
+	IoInfo                [6]uint64 // IO_COUNTERS
+	ProcessMemoryLimit    uintptr
+	JobMemoryLimit        uintptr
+	PeakProcessMemoryUsed uintptr
+	PeakJobMemoryUsed     uintptr
+}
+
+// jobObjectCPURateControlInfo mirrors JOBOBJECT_CPU_RATE_CONTROL_INFORMATION (CpuRate variant)
+type jobObjectCPURateControlInfo struct {
+	ControlFlags uint32
+	CPURate      uint32
+}
+
+// 프로세스별 Job Object 핸들 (한도 변경 시 재사용, 핸들을 닫으면 한도 갱신 불가)
+var processLimitJobs = make(map[int32]syscall.Handle)
+
+// applyJobObjectLimits assigns the process to its own Job Object and sets the limits
+func applyJobObjectLimits(pid int32, cpuPercent, memoryMB float64) (string, error) {
+	kernel32 := syscall.NewLazyDLL("kernel32.dll")
+	createJobObject := kernel32.NewProc("CreateJobObjectW")
+	setInformationJobObject := kernel32.NewProc("SetInformationJobObject")
+	assignProcessToJobObject := kernel32.NewProc("AssignProcessToJobObject")
+
+	// 종료된 프로세스의 Job Object 핸들 정리
+	for limitedPID, handle := range processLimitJobs {
+		if alive, _ := process.PidExists(limitedPID); !alive {
+			syscall.CloseHandle(handle)
+			delete(processLimitJobs, limitedPID)
+		}
+	}
+
+	jobName := fmt.Sprintf("HWnow-limit-%d", pid)
+	job, exists := processLimitJobs[pid]
+	if !exists {
+		namePtr, err := syscall.UTF16PtrFromString(jobName)
+		if err != nil {
+			return "", err
+		}
+		handle, _, callErr := createJobObject.Call(0, uintptr(unsafe.Pointer(namePtr)))
+		if handle == 0 {
+			return "", fmt.Errorf("CreateJobObject failed: %v", callErr)
+		}
+		job = syscall.Handle(handle)
+	}
+
+	var extended jobObjectExtendedLimitInfo
+	if memoryMB > 0 {
+		extended.BasicLimitInformation.LimitFlags = jobObjectLimitJobMemory
+		extended.JobMemoryLimit = uintptr(memoryMB * 1024 * 1024)
+	}
+	ret, _, callErr := setInformationJobObject.Call(uintptr(job), jobObjectExtendedLimitInformation,
+		uintptr(unsafe.Pointer(&extended)), unsafe.Sizeof(extended))
+	if ret == 0 {
+		closeNewJob(job, exists)
+		return "", fmt.Errorf("failed to set job memory limit: %v", callErr)
+	}
+
+	var cpuRate jobObjectCPURateControlInfo
+	if cpuPercent > 0 {
+		cpuRate.ControlFlags = jobObjectCPURateControlEnable | jobObjectCPURateControlHardCap
+		cpuRate.CPURate = jobCPURate(cpuPercent)
+	}
+	ret, _, callErr = setInformationJobObject.Call(uintptr(job), jobObjectCPURateControlInformation,
+		uintptr(unsafe.Pointer(&cpuRate)), unsafe.Sizeof(cpuRate))
+	if ret == 0 {
+		closeNewJob(job, exists)
+		return "", fmt.Errorf("failed to set job CPU rate: %v", callErr)
+	}
+
+	if !exists {
+		procHandle, err := syscall.OpenProcess(processSetQuota|processTerminate, false, uint32(pid))
+		if err != nil {
+			closeNewJob(job, exists)
+			return "", fmt.Errorf("failed to open process %d: %v", pid, err)
+		}
+		ret, _, callErr = assignProcessToJobObject.Call(uintptr(job), uintptr(procHandle))
+		syscall.CloseHandle(procHandle)
+		if ret == 0 {
+			closeNewJob(job, exists)
+			return "", fmt.Errorf("failed to assign process %d to job object: %v", pid, callErr)
+		}
+		processLimitJobs[pid] = job
+	}
+	return jobName, nil
+}
+
+// closeNewJob releases a Job Object handle created during a failed attempt
+func closeNewJob(job syscall.Handle, existing bool) {
+	if !existing {
+		syscall.CloseHandle(job)
+	}
+}
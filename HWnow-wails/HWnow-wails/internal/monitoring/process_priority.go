@@ -0,0 +1,113 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 프로세스 우선순위 조회 (SetGPUProcessPriority 적용 결과 확인용)
+// 이름은 SetGPUProcessPriority가 받는 값과 동일: realtime, high, above_normal, normal, below_normal, low
+
+// Windows priority class 값 (GetPriorityClass)
+const (
+	windowsPriorityIdle        = 0x40
+	windowsPriorityBelowNormal = 0x4000
+	windowsPriorityNormal      = 0x20
+	windowsPriorityAboveNormal = 0x8000
+	windowsPriorityHigh        = 0x80
+	windowsPriorityRealtime    = 0x100
+)
+
+// ProcessPriority describes the priority currently applied to a process
+type ProcessPriority struct {
+	PID      int32  `json:"pid"`
+	Name     string `json:"name"`
+	Priority string `json:"priority"` // realtime, high, above_normal, normal, below_normal, low, unknown
+	Nice     int32  `json:"nice"`     // Unix nice 값 (Windows는 priority class에 대응하는 nice 값)
+	Raw      int32  `json:"raw"`      // OS가 보고한 원본 값 (Unix: nice, Windows: priority class)
+}
+
+// GetProcessPriority returns the priority class / nice value currently applied to a process
+func GetProcessPriority(ctx context.Context, pid int32) (*ProcessPriority, error) {
+	return queryWithContext(ctx, "GetProcessPriority", func() (*ProcessPriority, error) { return getProcessPriority(pid) })
+}
+
+func getProcessPriority(pid int32) (*ProcessPriority, error) {
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return nil, fmt.Errorf("process with PID %d not found: %v", pid, err)
+	}
+
+	raw, err := proc.Nice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get priority of process %d: %v", pid, err)
+	}
+
+	result := &ProcessPriority{PID: pid, Raw: raw}
+	if name, err := proc.Name(); err == nil {
+		result.Name = name
+	}
+	result.Priority, result.Nice = resolveProcessPriority(runtime.GOOS, raw)
+	return result, nil
+}
+
+// processPriorityOf returns the priority name and nice value of an open process handle ("" if unavailable)
+func processPriorityOf(proc *process.Process) (string, int32) {
+	raw, err := proc.Nice()
+	if err != nil {
+		return "", 0
+	}
+	return resolveProcessPriority(runtime.GOOS, raw)
+}
+
+// resolveProcessPriority converts the OS-reported value to a priority name and nice value
+func resolveProcessPriority(goos string, raw int32) (string, int32) {
+	if goos == "windows" {
+		switch raw {
+		case windowsPriorityRealtime:
+			return "realtime", -20
+		case windowsPriorityHigh:
+			return "high", -10
+		case windowsPriorityAboveNormal:
+			return "above_normal", -5
+		case windowsPriorityNormal:
+			return "normal", 0
+		case windowsPriorityBelowNormal:
+			return "below_normal", 5
+		case windowsPriorityIdle:
+			return "low", 10
+		default:
+			return "unknown", 0
+		}
+	}
+
+	// SetGPUProcessPriority의 nice 매핑(-20, -10, -5, 0, 5, 10)을 구간으로 역변환
+	switch {
+	case raw <= -20:
+		return "realtime", raw
+	case raw <= -10:
+		return "high", raw
+	case raw < 0:
+		return "above_normal", raw
+	case raw == 0:
+		return "normal", raw
+	case raw < 10:
+		return "below_normal", raw
+	default:
+		return "low", raw
+	}
+}
+
+// annotateGPUProcessPriorities fills the current priority of each GPU process
+func annotateGPUProcessPriorities(processes []GPUProcess) {
+	for i := range processes {
+		proc, err := process.NewProcess(processes[i].PID)
+		if err != nil {
+			continue
+		}
+		processes[i].Priority, processes[i].Nice = processPriorityOf(proc)
+	}
+}
@@ -0,0 +1,30 @@
+package monitoring
+
+import "testing"
+
+func TestResolveProcessPriority(t *testing.T) {
+	tests := []struct {
+		goos     string
+		raw      int32
+		priority string
+		nice     int32
+	}{
+		{"linux", -20, "realtime", -20},
+		{"linux", -10, "high", -10},
+		{"linux", -5, "above_normal", -5},
+		{"linux", 0, "normal", 0},
+		{"linux", 5, "below_normal", 5},
+		{"linux", 19, "low", 19},
+		{"windows", windowsPriorityHigh, "high", -10},
+		{"windows", windowsPriorityIdle, "low", 10},
+		{"windows", 0x1234, "unknown", 0},
+	}
+
+	for _, tt := range tests {
+		priority, nice := resolveProcessPriority(tt.goos, tt.raw)
+		if priority != tt.priority || nice != tt.nice {
+			t.Errorf("resolveProcessPriority(%s, %d) = (%s, %d), want (%s, %d)",
+				tt.goos, tt.raw, priority, nice, tt.priority, tt.nice)
+		}
+	}
+}
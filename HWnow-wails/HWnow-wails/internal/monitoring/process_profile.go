@@ -0,0 +1,250 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 프로세스 상세 패널용 프로필 (GET /api/processes/{pid})
+// gopsutil 프로세스 정보, 프로세스 목록 캐시(CPU/I/O 속도), GPU 프로세스 파이프라인(GPU 메모리)을 한 번에 모아 반환
+// 코어별 사용률은 Linux에서만 제공: /proc/{pid}/task/*/stat의 스레드 CPU 시간 변화를 스레드가 마지막으로 실행된 코어에 배분
+
+const (
+	PROCESS_CORE_SAMPLE_WINDOW = 250 * time.Millisecond
+	linuxClockTicksPerSecond   = 100 // USER_HZ (리눅스 사용자 공간에 노출되는 값은 항상 100)
+)
+
+// ErrProcessNotFound is returned when the requested PID is not running
+var ErrProcessNotFound = errors.New("process not found")
+
+// ProcessChild is a direct child process
+type ProcessChild struct {
+	PID  int32  `json:"pid"`
+	Name string `json:"name"`
+}
+
+// ProcessProfile is the full profile of one process for the process detail pane
+type ProcessProfile struct {
+	PID        int32      `json:"pid"`
+	Name       string     `json:"name"`
+	ParentPID  int32      `json:"parent_pid"`
+	Status     string     `json:"status"`
+	Username   string     `json:"username,omitempty"`
+	Cmdline    string     `json:"cmdline,omitempty"`
+	ExePath    string     `json:"exe_path,omitempty"`
+	StartTime  *time.Time `json:"start_time,omitempty"`
+	Priority   string     `json:"priority,omitempty"`
+	Nice       int32      `json:"nice"`
+	NumThreads int32      `json:"num_threads"`
+	OpenFiles  int32      `json:"open_files"` // 열린 파일 디스크립터/핸들 수 (-1 = 권한 부족)
+
+	CPUPercent float64   `json:"cpu_percent"` // 마지막 조회 이후 CPU 사용률 (%, 코어 1개 = 100)
+	CoreUsage  []float64 `json:"core_usage"`  // 코어별 사용률 (%, Linux만)
+
+	MemoryRSSMB    float64 `json:"memory_rss_mb"`
+	MemoryVMSMB    float64 `json:"memory_vms_mb"`
+	MemorySharedMB float64 `json:"memory_shared_mb"` // 공유 메모리 (MB, -1 = 지원 안 함)
+	MemoryPercent  float64 `json:"memory_percent"`
+
+	ReadBytes  uint64  `json:"read_bytes"`
+	WriteBytes uint64  `json:"write_bytes"`
+	ReadCount  uint64  `json:"read_count"`
+	WriteCount uint64  `json:"write_count"`
+	ReadRate   float64 `json:"read_rate"`  // bytes/s
+	WriteRate  float64 `json:"write_rate"` // bytes/s
+
+	Connections int     `json:"connections"`
+	GPUUsage    float64 `json:"gpu_usage"`     // GPU 사용률 (%, GPU를 사용하지 않으면 0)
+	GPUMemoryMB float64 `json:"gpu_memory_mb"` // GPU 메모리 사용량 (MB)
+
+	Children []ProcessChild `json:"children"`
+}
+
+// GetProcessProfile aggregates everything known about a process into one profile
+func GetProcessProfile(ctx context.Context, pid int32) (*ProcessProfile, error) {
+	return queryWithContext(ctx, "GetProcessProfile", func() (*ProcessProfile, error) { return getProcessProfile(pid) })
+}
+
+func getProcessProfile(pid int32) (*ProcessProfile, error) {
+	if exists, err := process.PidExists(pid); err != nil || !exists {
+		return nil, fmt.Errorf("%w: %d", ErrProcessNotFound, pid)
+	}
+	p, err := process.NewProcess(pid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %d", ErrProcessNotFound, pid)
+	}
+
+	profile := &ProcessProfile{PID: pid, OpenFiles: -1, MemorySharedMB: -1, Children: []ProcessChild{}}
+	profile.Name, _ = p.Name()
+	profile.ParentPID, _ = p.Ppid()
+	if status, err := p.Status(); err == nil {
+		profile.Status = strings.Join(status, ",")
+	}
+	if identity, ok := getProcessIdentity(pid); ok {
+		profile.Username = identity.Username
+		profile.Cmdline = identity.Cmdline
+		profile.ExePath = identity.ExePath
+		profile.StartTime = identity.StartTime
+	}
+	profile.Priority, profile.Nice = processPriorityOf(p)
+	profile.NumThreads, _ = p.NumThreads()
+	if fds, err := p.NumFDs(); err == nil {
+		profile.OpenFiles = fds
+	}
+
+	if memInfo, err := p.MemoryInfo(); err == nil && memInfo != nil {
+		profile.MemoryRSSMB = float64(memInfo.RSS) / 1024 / 1024
+		profile.MemoryVMSMB = float64(memInfo.VMS) / 1024 / 1024
+	}
+	profile.MemorySharedMB = processSharedMemoryMB(p)
+	if memPercent, err := p.MemoryPercent(); err == nil {
+		profile.MemoryPercent = float64(memPercent)
+	}
+	if ioCounters, err := p.IOCounters(); err == nil && ioCounters != nil {
+		profile.ReadBytes = ioCounters.ReadBytes
+		profile.WriteBytes = ioCounters.WriteBytes
+		profile.ReadCount = ioCounters.ReadCount
+		profile.WriteCount = ioCounters.WriteCount
+	}
+
+	// CPU 사용률과 I/O 속도는 이전 샘플이 필요하므로 프로세스 목록 캐시의 값 사용
+	cached := false
+	if details, err := getCachedProcessDetails(); err == nil {
+		for _, detail := range details {
+			if detail.PID == pid {
+				profile.CPUPercent = detail.CPUPercent
+				profile.ReadRate = detail.ReadRate
+				profile.WriteRate = detail.WriteRate
+				profile.Connections = detail.Connections
+				cached = true
+				break
+			}
+		}
+	}
+	if !cached {
+		// 캐시 갱신 이후 시작된 프로세스는 시작 이후 평균 사용률
+		profile.CPUPercent, _ = p.CPUPercent()
+	}
+
+	if gpuProcesses, err := getGPUProcessesFromMonitor(); err == nil {
+		for _, gpuProcess := range gpuProcesses {
+			if gpuProcess.PID == pid {
+				profile.GPUUsage = gpuProcess.GPUUsage
+				profile.GPUMemoryMB = gpuProcess.GPUMemory
+				break
+			}
+		}
+	}
+
+	if children, err := p.Children(); err == nil {
+		for _, child := range children {
+			name, _ := child.Name()
+			profile.Children = append(profile.Children, ProcessChild{PID: child.Pid, Name: name})
+		}
+	}
+
+	if runtime.GOOS == "linux" {
+		if usage, err := sampleProcessCoreUsage("/proc", pid, runtime.NumCPU(), PROCESS_CORE_SAMPLE_WINDOW); err == nil {
+			profile.CoreUsage = usage
+		} else {
+			LogDebug("Per-core process usage unavailable", "pid", pid, "error", err)
+		}
+	}
+
+	return profile, nil
+}
+
+// threadCPUSample is the cumulative CPU time of a thread and the core it last ran on
+type threadCPUSample struct {
+	ticks uint64
+	core  int
+}
+
+// sampleProcessCoreUsage measures per-core usage of a process over the window, attributing each
+// thread's CPU time to the core it last ran on
+func sampleProcessCoreUsage(procRoot string, pid int32, cores int, window time.Duration) ([]float64, error) {
+	before, err := readThreadCPUSamples(procRoot, pid)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	time.Sleep(window)
+	after, err := readThreadCPUSamples(procRoot, pid)
+	if err != nil {
+		return nil, err
+	}
+	return threadCoreUsage(before, after, cores, time.Since(start)), nil
+}
+
+// threadCoreUsage converts two thread samples into per-core usage percentages
+func threadCoreUsage(before, after map[string]threadCPUSample, cores int, elapsed time.Duration) []float64 {
+	usage := make([]float64, cores)
+	if elapsed <= 0 {
+		return usage
+	}
+	for tid, sample := range after {
+		previous, ok := before[tid]
+		if !ok || sample.ticks < previous.ticks || sample.core < 0 || sample.core >= cores {
+			continue
+		}
+		seconds := float64(sample.ticks-previous.ticks) / linuxClockTicksPerSecond
+		usage[sample.core] += seconds / elapsed.Seconds() * 100
+	}
+	for i := range usage {
+		usage[i] = min(usage[i], 100)
+	}
+	return usage
+}
+
+// readThreadCPUSamples reads utime+stime and the last CPU of every thread from /proc/{pid}/task/*/stat
+func readThreadCPUSamples(procRoot string, pid int32) (map[string]threadCPUSample, error) {
+	taskDir := filepath.Join(procRoot, strconv.Itoa(int(pid)), "task")
+	entries, err := os.ReadDir(taskDir)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make(map[string]threadCPUSample, len(entries))
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(taskDir, entry.Name(), "stat"))
+		if err != nil {
+			continue // 조회 중 종료된 스레드
+		}
+		if sample, ok := parseThreadStat(string(content)); ok {
+			samples[entry.Name()] = sample
+		}
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no thread statistics for process %d", pid)
+	}
+	return samples, nil
+}
+
+// parseThreadStat parses a /proc stat line; the command name may contain spaces and parentheses,
+// so fields are counted from the last ')' (state = field 3, utime = 14, stime = 15, processor = 39)
+func parseThreadStat(stat string) (threadCPUSample, bool) {
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return threadCPUSample{}, false
+	}
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 37 {
+		return threadCPUSample{}, false
+	}
+	utime, err1 := strconv.ParseUint(fields[11], 10, 64)
+	stime, err2 := strconv.ParseUint(fields[12], 10, 64)
+	core, err3 := strconv.Atoi(fields[36])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return threadCPUSample{}, false
+	}
+	return threadCPUSample{ticks: utime + stime, core: core}, true
+}
@@ -0,0 +1,12 @@
+package monitoring
+
+import "github.com/shirou/gopsutil/v3/process"
+
+// processSharedMemoryMB returns the shared (file-backed) resident memory of a process in MB
+func processSharedMemoryMB(p *process.Process) float64 {
+	memInfoEx, err := p.MemoryInfoEx()
+	if err != nil || memInfoEx == nil {
+		return -1
+	}
+	return float64(memInfoEx.Shared) / 1024 / 1024
+}
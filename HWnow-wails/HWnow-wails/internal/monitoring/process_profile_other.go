@@ -0,0 +1,10 @@
+//go:build !linux
+
+package monitoring
+
+import "github.com/shirou/gopsutil/v3/process"
+
+// processSharedMemoryMB is only reported on Linux (-1 = not supported)
+func processSharedMemoryMB(p *process.Process) float64 {
+	return -1
+}
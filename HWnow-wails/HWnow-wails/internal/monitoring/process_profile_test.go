@@ -0,0 +1,75 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseThreadStat(t *testing.T) {
+	// 명령 이름에 공백과 괄호가 포함된 경우
+	stat := "4242 (Web Content (x)) S 1 4242 4242 0 -1 4194560 100 0 0 0 350 150 0 0 20 0 12 0 1000 0 0 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 3 0 0 0 0 0"
+	sample, ok := parseThreadStat(stat)
+	if !ok || sample.ticks != 500 || sample.core != 3 {
+		t.Errorf("Unexpected sample: %+v (ok %v)", sample, ok)
+	}
+	if _, ok := parseThreadStat("4242 (short) S 1"); ok {
+		t.Error("Expected truncated stat to be rejected")
+	}
+}
+
+func TestThreadCoreUsage(t *testing.T) {
+	before := map[string]threadCPUSample{"1": {ticks: 100, core: 0}, "2": {ticks: 50, core: 1}, "3": {ticks: 10, core: 1}}
+	after := map[string]threadCPUSample{"1": {ticks: 150, core: 0}, "2": {ticks: 75, core: 2}, "4": {ticks: 999, core: 3}}
+
+	// 1초 동안 스레드 1은 코어 0에서 0.5초, 스레드 2는 코어 2에서 0.25초 실행 (새 스레드 4는 제외)
+	usage := threadCoreUsage(before, after, 4, time.Second)
+	if len(usage) != 4 || usage[0] != 50 || usage[1] != 0 || usage[2] != 25 || usage[3] != 0 {
+		t.Errorf("Unexpected core usage: %v", usage)
+	}
+}
+
+func TestReadThreadCPUSamples(t *testing.T) {
+	root := t.TempDir()
+	for tid, stat := range map[string]string{
+		"100": "100 (worker) R 1 100 100 0 -1 0 0 0 0 0 20 5 0 0 20 0 2 0 1 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 17 1 0 0 0 0 0",
+		"101": "101 (worker) S 1 100 100 0 -1 0 0 0 0 0 7 3 0 0 20 0 2 0 1 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0",
+	} {
+		dir := filepath.Join(root, "100", "task", tid)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	samples, err := readThreadCPUSamples(root, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(samples) != 2 || samples["100"].ticks != 25 || samples["100"].core != 1 || samples["101"].ticks != 10 {
+		t.Errorf("Unexpected samples: %+v", samples)
+	}
+	if _, err := readThreadCPUSamples(root, 200); err == nil {
+		t.Error("Expected an error for a missing process")
+	}
+}
+
+func TestGetProcessProfile(t *testing.T) {
+	profile, err := GetProcessProfile(context.Background(), int32(os.Getpid()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if profile.Name == "" || profile.NumThreads == 0 || profile.MemoryRSSMB <= 0 || !strings.Contains(profile.Cmdline, "test") {
+		t.Errorf("Unexpected profile: %+v", profile)
+	}
+
+	if _, err := GetProcessProfile(context.Background(), -1); !errors.Is(err, ErrProcessNotFound) {
+		t.Errorf("Expected ErrProcessNotFound, got %v", err)
+	}
+}
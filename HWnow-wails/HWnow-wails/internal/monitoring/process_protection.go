@@ -32,13 +32,19 @@ type CriticalProcessInfo struct {
 	ParentProcess   string                 `json:"parent_process"`  // 부모 프로세스 이름 (선택적)
 	IsService       bool                   `json:"is_service"`      // Windows 서비스인지
 	MatchPattern    string                 `json:"match_pattern"`   // 정규식 패턴 매칭
+	UserDefined     bool                   `json:"user_defined"`    // 설정(security.protected_processes)으로 추가된 규칙
 }
 
 // ProcessProtectionService manages critical process protection
 type ProcessProtectionService struct {
 	criticalProcesses map[string]*CriticalProcessInfo
+	userProcesses     []*CriticalProcessInfo // 사용자 정의 규칙 (내장 규칙보다 먼저 확인)
 	mutex             sync.RWMutex
 	currentPlatform   string
+
+	// 최근 제어 판단 기록 (감사 API)
+	decisions     []ProtectionDecision
+	decisionMutex sync.Mutex
 }
 
 var (
@@ -165,6 +171,13 @@ func (pps *ProcessProtectionService) IsCriticalProcess(processName string, pid i
 	
 	processName = strings.ToLower(processName)
 	
+	// 0. 사용자 정의 규칙 확인 (내장 규칙의 보호 수준을 덮어쓸 수 있도록 먼저)
+	for _, proc := range pps.userProcesses {
+		if pps.matchesPattern(proc, processName) && pps.matchesPIDRange(proc, pid) {
+			return proc, true
+		}
+	}
+	
 	// 1. 정확한 이름 매칭 확인
 	key := fmt.Sprintf("%s_%s", pps.currentPlatform, processName)
 	if proc, exists := pps.criticalProcesses[key]; exists {
@@ -290,33 +303,38 @@ func (pps *ProcessProtectionService) hasKriticalParent(pid int32) bool {
 // CanControlProcess determines if a process can be controlled
 func (pps *ProcessProtectionService) CanControlProcess(processName string, pid int32) error {
 	if proc, isCritical := pps.IsCriticalProcess(processName, pid); isCritical {
-		switch proc.ProtectionLevel {
-		case ProtectionCritical:
-			return fmt.Errorf("critical system process cannot be controlled: %s (PID: %d) - %s", 
-				processName, pid, proc.Description)
-		case ProtectionHigh:
-			return fmt.Errorf("highly protected process should not be controlled: %s (PID: %d) - %s", 
-				processName, pid, proc.Description)
-		case ProtectionMedium:
-			LogWarn("Controlling medium-protected process", 
-				"process", processName, "pid", pid, "description", proc.Description)
-			// 경고만 하고 허용
-			return nil
-		case ProtectionLow:
-			// 낮은 보호 수준은 경고 없이 허용
-			return nil
-		}
+		return controlDecision(proc, processName, pid)
 	}
 	
 	return nil // 중요하지 않은 프로세스는 제어 허용
 }
 
+// controlDecision decides whether a process matching a protection rule may be controlled
+func controlDecision(proc *CriticalProcessInfo, processName string, pid int32) error {
+	switch proc.ProtectionLevel {
+	case ProtectionCritical:
+		return fmt.Errorf("critical system process cannot be controlled: %s (PID: %d) - %s", 
+			processName, pid, proc.Description)
+	case ProtectionHigh:
+		return fmt.Errorf("highly protected process should not be controlled: %s (PID: %d) - %s", 
+			processName, pid, proc.Description)
+	case ProtectionMedium:
+		LogWarn("Controlling medium-protected process", 
+			"process", processName, "pid", pid, "description", proc.Description)
+		// 경고만 하고 허용
+		return nil
+	default:
+		// 낮은 보호 수준은 경고 없이 허용
+		return nil
+	}
+}
+
 // GetCriticalProcesses returns list of critical processes for current platform
 func (pps *ProcessProtectionService) GetCriticalProcesses() []*CriticalProcessInfo {
 	pps.mutex.RLock()
 	defer pps.mutex.RUnlock()
 	
-	var result []*CriticalProcessInfo
+	result := append([]*CriticalProcessInfo{}, pps.userProcesses...)
 	for _, proc := range pps.criticalProcesses {
 		if proc.Platform == pps.currentPlatform || proc.Platform == "all" {
 			result = append(result, proc)
@@ -0,0 +1,210 @@
+package monitoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 프로세스 보호 감사 (GET /api/processes/protection)
+// 현재 적용 중인 보호 규칙과 최근 제어 요청(종료/일시정지/재개/우선순위/제한)에 대한 판단과 거부 사유를 보여줌
+// 사용자 정의 보호 규칙은 설정의 security.protected_processes로 추가하며 내장 규칙보다 먼저 확인됨
+
+const (
+	PROTECTION_AUDIT_LOG_SIZE      = 200 // 보관하는 최근 판단 수
+	DEFAULT_PROTECTION_AUDIT_LIMIT = 50
+)
+
+// String returns the protection level name used in the configuration and audit API
+func (level ProcessProtectionLevel) String() string {
+	switch level {
+	case ProtectionLow:
+		return "low"
+	case ProtectionMedium:
+		return "medium"
+	case ProtectionHigh:
+		return "high"
+	case ProtectionCritical:
+		return "critical"
+	default:
+		return "none"
+	}
+}
+
+// ParseProtectionLevel parses a protection level name (low, medium, high, critical)
+func ParseProtectionLevel(name string) (ProcessProtectionLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "low":
+		return ProtectionLow, nil
+	case "medium":
+		return ProtectionMedium, nil
+	case "high":
+		return ProtectionHigh, nil
+	case "critical":
+		return ProtectionCritical, nil
+	default:
+		return ProtectionNone, fmt.Errorf("unknown protection level: %s", name)
+	}
+}
+
+// ProtectedProcessRule is a user-defined protected process from the configuration
+type ProtectedProcessRule struct {
+	Name        string `json:"name"`                  // 프로세스 이름 (부분 일치, 대소문자 무시)
+	Pattern     string `json:"pattern,omitempty"`     // "^prefix", "suffix$" 또는 부분 문자열 (비어 있으면 name 사용)
+	Level       string `json:"level"`                 // low, medium, high, critical (high 이상은 제어 거부)
+	Description string `json:"description,omitempty"` // 거부 사유에 표시
+}
+
+// Validate checks that the rule has a name and a known protection level
+func (rule ProtectedProcessRule) Validate() error {
+	if strings.TrimSpace(rule.Name) == "" {
+		return fmt.Errorf("protected process name is required")
+	}
+	if _, err := ParseProtectionLevel(rule.Level); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProtectionDecision records one protection check of a control request against a protected process
+type ProtectionDecision struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Operation   string    `json:"operation"` // kill, suspend, resume, priority, limit
+	PID         int32     `json:"pid"`
+	ProcessName string    `json:"process_name"`
+	Rule        string    `json:"rule"` // 일치한 보호 규칙 이름
+	Level       string    `json:"level"`
+	UserDefined bool      `json:"user_defined"`
+	Allowed     bool      `json:"allowed"`
+	Reason      string    `json:"reason,omitempty"` // 거부 사유 (허용된 경우 비어 있음)
+}
+
+// ProtectionRuleStatus is a protection rule as listed by the audit API
+type ProtectionRuleStatus struct {
+	CriticalProcessInfo
+	Level string `json:"level"`
+}
+
+// ProtectionAudit lists the active protection rules and recent control decisions
+type ProtectionAudit struct {
+	Platform  string                 `json:"platform"`
+	Rules     []ProtectionRuleStatus `json:"rules"`
+	Decisions []ProtectionDecision   `json:"decisions"` // 최신 순
+	Refused   int                    `json:"refused"`   // decisions 중 거부된 수
+}
+
+// CheckControl decides whether an operation may control a process and records the decision
+// when the process matches a protection rule
+func (pps *ProcessProtectionService) CheckControl(operation, processName string, pid int32) (*CriticalProcessInfo, error) {
+	proc, isCritical := pps.IsCriticalProcess(processName, pid)
+	if !isCritical {
+		return nil, nil
+	}
+	err := controlDecision(proc, processName, pid)
+
+	decision := ProtectionDecision{
+		Timestamp:   time.Now(),
+		Operation:   operation,
+		PID:         pid,
+		ProcessName: processName,
+		Rule:        proc.Name,
+		Level:       proc.ProtectionLevel.String(),
+		UserDefined: proc.UserDefined,
+		Allowed:     err == nil,
+	}
+	if err != nil {
+		decision.Reason = err.Error()
+	}
+	pps.recordDecision(decision)
+	return proc, err
+}
+
+// recordDecision appends a decision, dropping the oldest beyond PROTECTION_AUDIT_LOG_SIZE
+func (pps *ProcessProtectionService) recordDecision(decision ProtectionDecision) {
+	pps.decisionMutex.Lock()
+	defer pps.decisionMutex.Unlock()
+
+	pps.decisions = append(pps.decisions, decision)
+	if len(pps.decisions) > PROTECTION_AUDIT_LOG_SIZE {
+		pps.decisions = append([]ProtectionDecision(nil), pps.decisions[len(pps.decisions)-PROTECTION_AUDIT_LOG_SIZE:]...)
+	}
+}
+
+// SetUserProtectedProcesses replaces the user-defined protection rules; invalid rules are rejected
+func (pps *ProcessProtectionService) SetUserProtectedProcesses(rules []ProtectedProcessRule) error {
+	userProcesses := make([]*CriticalProcessInfo, 0, len(rules))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		level, _ := ParseProtectionLevel(rule.Level)
+		description := rule.Description
+		if description == "" {
+			description = "user-defined protected process"
+		}
+		userProcesses = append(userProcesses, &CriticalProcessInfo{
+			Name:            strings.TrimSpace(rule.Name),
+			Description:     description,
+			ProtectionLevel: level,
+			Platform:        pps.currentPlatform,
+			MatchPattern:    strings.TrimSpace(rule.Pattern),
+			UserDefined:     true,
+		})
+	}
+
+	pps.mutex.Lock()
+	pps.userProcesses = userProcesses
+	pps.mutex.Unlock()
+
+	if len(userProcesses) > 0 {
+		LogInfo("Applied user-defined protected processes", "count", len(userProcesses))
+	}
+	return nil
+}
+
+// Audit returns the active rules and up to limit recent decisions (newest first)
+func (pps *ProcessProtectionService) Audit(limit int) *ProtectionAudit {
+	if limit <= 0 {
+		limit = DEFAULT_PROTECTION_AUDIT_LIMIT
+	}
+
+	audit := &ProtectionAudit{Platform: pps.currentPlatform, Rules: []ProtectionRuleStatus{}, Decisions: []ProtectionDecision{}}
+	for _, proc := range pps.GetCriticalProcesses() {
+		audit.Rules = append(audit.Rules, ProtectionRuleStatus{CriticalProcessInfo: *proc, Level: proc.ProtectionLevel.String()})
+	}
+	// 사용자 정의 규칙, 보호 수준 높은 순, 이름 순
+	sort.SliceStable(audit.Rules, func(i, j int) bool {
+		a, b := audit.Rules[i], audit.Rules[j]
+		if a.UserDefined != b.UserDefined {
+			return a.UserDefined
+		}
+		if a.ProtectionLevel != b.ProtectionLevel {
+			return a.ProtectionLevel > b.ProtectionLevel
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	pps.decisionMutex.Lock()
+	for i := len(pps.decisions) - 1; i >= 0 && len(audit.Decisions) < limit; i-- {
+		audit.Decisions = append(audit.Decisions, pps.decisions[i])
+	}
+	pps.decisionMutex.Unlock()
+
+	for _, decision := range audit.Decisions {
+		if !decision.Allowed {
+			audit.Refused++
+		}
+	}
+	return audit
+}
+
+// SetUserProtectedProcesses replaces the user-defined protection rules of the global protection service
+func SetUserProtectedProcesses(rules []ProtectedProcessRule) error {
+	return GetProcessProtectionService().SetUserProtectedProcesses(rules)
+}
+
+// GetProcessProtectionAudit returns the active protection rules and up to limit recent control decisions
+func GetProcessProtectionAudit(limit int) *ProtectionAudit {
+	return GetProcessProtectionService().Audit(limit)
+}
@@ -0,0 +1,92 @@
+package monitoring
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestProtectionService() *ProcessProtectionService {
+	pps := &ProcessProtectionService{
+		criticalProcesses: make(map[string]*CriticalProcessInfo),
+		currentPlatform:   "linux",
+	}
+	pps.criticalProcesses["linux_sshd"] = &CriticalProcessInfo{Name: "sshd", Description: "SSH 데몬", ProtectionLevel: ProtectionMedium, Platform: "linux"}
+	return pps
+}
+
+func TestProtectedProcessRuleValidate(t *testing.T) {
+	if err := (ProtectedProcessRule{Name: "postgres", Level: "critical"}).Validate(); err != nil {
+		t.Errorf("Expected valid rule, got %v", err)
+	}
+	if err := (ProtectedProcessRule{Name: " ", Level: "high"}).Validate(); err == nil {
+		t.Error("Expected an error for an empty name")
+	}
+	if err := (ProtectedProcessRule{Name: "postgres", Level: "extreme"}).Validate(); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+}
+
+func TestUserProtectedProcessesOverrideBuiltin(t *testing.T) {
+	pps := newTestProtectionService()
+
+	// 내장 규칙(medium)은 경고만 하고 허용
+	if _, err := pps.CheckControl("kill", "sshd", 999991); err != nil {
+		t.Fatalf("Expected medium-protected process to be allowed, got %v", err)
+	}
+
+	err := pps.SetUserProtectedProcesses([]ProtectedProcessRule{
+		{Name: "sshd", Level: "critical", Description: "remote access"},
+		{Name: "postgres", Pattern: "^postgres", Level: "high"},
+	})
+	if err != nil {
+		t.Fatalf("SetUserProtectedProcesses failed: %v", err)
+	}
+
+	proc, err := pps.CheckControl("kill", "sshd", 999991)
+	if err == nil || !proc.UserDefined || !strings.Contains(err.Error(), "remote access") {
+		t.Errorf("Expected user-defined critical rule to refuse, got %+v, %v", proc, err)
+	}
+	if _, err := pps.CheckControl("priority", "postgres: checkpointer", 999992); err == nil {
+		t.Error("Expected prefix pattern rule to refuse")
+	}
+	if proc, err := pps.CheckControl("kill", "notepad", 999993); proc != nil || err != nil {
+		t.Errorf("Expected unprotected process to be allowed without a decision, got %+v, %v", proc, err)
+	}
+
+	if err := pps.SetUserProtectedProcesses([]ProtectedProcessRule{{Name: "x", Level: "bogus"}}); err == nil {
+		t.Error("Expected an invalid rule to be rejected")
+	}
+}
+
+func TestProtectionAudit(t *testing.T) {
+	pps := newTestProtectionService()
+	pps.SetUserProtectedProcesses([]ProtectedProcessRule{{Name: "backupd", Level: "high"}})
+
+	pps.CheckControl("kill", "sshd", 999991)
+	pps.CheckControl("suspend", "backupd", 999992)
+
+	audit := pps.Audit(0)
+	if len(audit.Rules) != 2 || !audit.Rules[0].UserDefined || audit.Rules[0].Level != "high" {
+		t.Errorf("Expected the user-defined rule first, got %+v", audit.Rules)
+	}
+	if len(audit.Decisions) != 2 || audit.Refused != 1 {
+		t.Fatalf("Unexpected decisions: %+v", audit.Decisions)
+	}
+	latest := audit.Decisions[0]
+	if latest.Operation != "suspend" || latest.Allowed || latest.Rule != "backupd" || latest.Reason == "" {
+		t.Errorf("Unexpected latest decision: %+v", latest)
+	}
+	if !audit.Decisions[1].Allowed || audit.Decisions[1].Level != "medium" {
+		t.Errorf("Unexpected earlier decision: %+v", audit.Decisions[1])
+	}
+
+	if limited := pps.Audit(1); len(limited.Decisions) != 1 {
+		t.Errorf("Expected limit to apply, got %d decisions", len(limited.Decisions))
+	}
+	for i := 0; i < PROTECTION_AUDIT_LOG_SIZE+10; i++ {
+		pps.CheckControl("kill", "sshd", 999991)
+	}
+	if len(pps.decisions) != PROTECTION_AUDIT_LOG_SIZE {
+		t.Errorf("Expected decision log capped at %d, got %d", PROTECTION_AUDIT_LOG_SIZE, len(pps.decisions))
+	}
+}
@@ -0,0 +1,413 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// 일반 프로세스 조회 (GPUProcessQuery와 동일한 필터/정렬/페이지네이션 구조)
+
+// ProcessDetail represents a process with CPU, memory, I/O and thread information
+type ProcessDetail struct {
+	PID           int32   `json:"pid"`
+	Name          string  `json:"name"`
+	PPID          int32   `json:"ppid"`               // 부모 프로세스 ID (알 수 없으면 0)
+	Username      string  `json:"username,omitempty"` // 소유 사용자 계정 (권한 부족 시 빈 값)
+	CPUPercent    float64 `json:"cpu_percent"`        // 마지막 조회 이후 CPU 사용률 (%)
+	MemoryPercent float64 `json:"memory_percent"`     // 물리 메모리 사용률 (%)
+	MemoryRSS     float64 `json:"memory_rss"`         // 상주 메모리 (MB)
+	ReadBytes     uint64  `json:"read_bytes"`         // 누적 디스크 읽기 (bytes)
+	WriteBytes    uint64  `json:"write_bytes"`        // 누적 디스크 쓰기 (bytes)
+	ReadRate      float64 `json:"read_rate"`          // 디스크 읽기 속도 (bytes/s)
+	WriteRate     float64 `json:"write_rate"`         // 디스크 쓰기 속도 (bytes/s)
+	Connections   int     `json:"connections"`        // 열린 네트워크 연결 수
+	NumThreads    int32   `json:"num_threads"`
+	Priority      string  `json:"priority,omitempty"` // 현재 우선순위 (realtime, high, above_normal, normal, below_normal, low)
+	Nice          int32   `json:"nice"`               // 현재 nice 값 (Windows는 priority class에 대응하는 값)
+}
+
+type ProcessFilter struct {
+	CPUThreshold    float64 `json:"cpu_threshold"`
+	MemoryThreshold float64 `json:"memory_threshold"`
+	NameContains    string  `json:"name_contains"`
+	FilterType      string  `json:"filter_type"` // "all", "cpu", "memory", "both"
+	Enabled         bool    `json:"enabled"`
+}
+
+type ProcessSort struct {
+	Field string `json:"field"` // "pid", "name", "cpu_percent", "memory_percent", "memory_rss", "io", "io_rate", "connections", "threads"
+	Order string `json:"order"` // "asc", "desc"
+}
+
+type ProcessQuery struct {
+	Filter   ProcessFilter `json:"filter"`
+	Sort     ProcessSort   `json:"sort"`
+	MaxItems int           `json:"max_items"`
+	Offset   int           `json:"offset"`
+}
+
+type ProcessResponse struct {
+	Processes     []ProcessDetail `json:"processes"`
+	TotalCount    int             `json:"total_count"`
+	FilteredCount int             `json:"filtered_count"`
+	HasMore       bool            `json:"has_more"`
+	QueryTime     int64           `json:"query_time_ms"`
+}
+
+// processIOSample stores the previous disk I/O counters of a process for rate calculation
+type processIOSample struct {
+	readBytes  uint64
+	writeBytes uint64
+	timestamp  time.Time
+}
+
+// ProcessListCache caches the full process list and keeps process handles for CPU and I/O delta calculation
+type ProcessListCache struct {
+	mutex     sync.Mutex
+	handles   map[int32]*process.Process
+	ioSamples map[int32]processIOSample
+	usernames map[int32]string // 소유자 조회는 비용이 커서 PID별로 한 번만 조회
+	parents   map[int32]int32  // 부모 PID는 바뀌지 않고 Windows에서는 조회마다 스냅샷을 만들므로 PID별로 한 번만 조회
+	cmdlines  map[int32]string // 명령줄은 프로세스 검색 시에만 PID별로 한 번 조회
+	details   []ProcessDetail
+	timestamp time.Time
+}
+
+const PROCESS_LIST_CACHE_DURATION = 2 * time.Second
+
+var processListCache = &ProcessListCache{
+	handles:   make(map[int32]*process.Process),
+	ioSamples: make(map[int32]processIOSample),
+	usernames: make(map[int32]string),
+	parents:   make(map[int32]int32),
+	cmdlines:  make(map[int32]string),
+}
+
+// getCachedProcessDetails returns a copy of the cached process list, refreshing it when stale
+func getCachedProcessDetails() ([]ProcessDetail, error) {
+	processListCache.mutex.Lock()
+	defer processListCache.mutex.Unlock()
+
+	if time.Since(processListCache.timestamp) >= PROCESS_LIST_CACHE_DURATION || processListCache.details == nil {
+		details, err := processListCache.refresh()
+		if err != nil {
+			return nil, err
+		}
+		processListCache.details = details
+		processListCache.timestamp = time.Now()
+	}
+
+	result := make([]ProcessDetail, len(processListCache.details))
+	copy(result, processListCache.details)
+	return result, nil
+}
+
+// refresh collects details for all running processes (caller holds the mutex)
+func (c *ProcessListCache) refresh() ([]ProcessDetail, error) {
+	pids, err := process.Pids()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes: %v", err)
+	}
+
+	alive := make(map[int32]bool, len(pids))
+	details := make([]ProcessDetail, 0, len(pids))
+	cpuTimes := make([]processCPUTimeSample, 0, len(pids))
+	connectionCounts := getConnectionCountsByPID()
+	now := time.Now()
+
+	for _, pid := range pids {
+		alive[pid] = true
+
+		// 기존 핸들을 재사용해야 Percent(0)가 이전 조회 대비 CPU 사용률을 계산함
+		p, exists := c.handles[pid]
+		if !exists {
+			p, err = process.NewProcess(pid)
+			if err != nil {
+				continue
+			}
+			c.handles[pid] = p
+		}
+
+		name, err := p.Name()
+		if err != nil || name == "" {
+			continue
+		}
+
+		username, known := c.usernames[pid]
+		if !known {
+			username, _ = p.Username()
+			c.usernames[pid] = username
+		}
+
+		ppid, known := c.parents[pid]
+		if !known {
+			ppid, _ = p.Ppid()
+			c.parents[pid] = ppid
+		}
+
+		detail := ProcessDetail{
+			PID:      pid,
+			Name:     name,
+			PPID:     ppid,
+			Username: username,
+		}
+		if cpuPercent, err := p.Percent(0); err == nil {
+			detail.CPUPercent = cpuPercent
+		}
+		if times, err := p.Times(); err == nil && times != nil {
+			sample := processCPUTimeSample{pid: pid, name: name, cpuSeconds: times.User + times.System}
+			if createTime, err := p.CreateTime(); err == nil && createTime > 0 {
+				sample.createTime = time.UnixMilli(createTime)
+			}
+			cpuTimes = append(cpuTimes, sample)
+		}
+		if memPercent, err := p.MemoryPercent(); err == nil {
+			detail.MemoryPercent = float64(memPercent)
+		}
+		if memInfo, err := p.MemoryInfo(); err == nil && memInfo != nil {
+			detail.MemoryRSS = float64(memInfo.RSS) / 1024 / 1024
+		}
+		if ioCounters, err := p.IOCounters(); err == nil && ioCounters != nil {
+			detail.ReadBytes = ioCounters.ReadBytes
+			detail.WriteBytes = ioCounters.WriteBytes
+
+			if prev, ok := c.ioSamples[pid]; ok {
+				detail.ReadRate, detail.WriteRate = calculateIORates(prev, ioCounters.ReadBytes, ioCounters.WriteBytes, now)
+			}
+			c.ioSamples[pid] = processIOSample{
+				readBytes:  ioCounters.ReadBytes,
+				writeBytes: ioCounters.WriteBytes,
+				timestamp:  now,
+			}
+		}
+		detail.Connections = connectionCounts[pid]
+		if threads, err := p.NumThreads(); err == nil {
+			detail.NumThreads = threads
+		}
+		detail.Priority, detail.Nice = processPriorityOf(p)
+
+		details = append(details, detail)
+	}
+
+	processAccounting.ObserveCPU(cpuTimes, now)
+
+	// 종료된 프로세스 핸들 정리
+	for pid := range c.handles {
+		if !alive[pid] {
+			delete(c.handles, pid)
+		}
+	}
+	for pid := range c.ioSamples {
+		if !alive[pid] {
+			delete(c.ioSamples, pid)
+		}
+	}
+	for pid := range c.usernames {
+		if !alive[pid] {
+			delete(c.usernames, pid)
+		}
+	}
+	for pid := range c.parents {
+		if !alive[pid] {
+			delete(c.parents, pid)
+		}
+	}
+	for pid := range c.cmdlines {
+		if !alive[pid] {
+			delete(c.cmdlines, pid)
+		}
+	}
+
+	return details, nil
+}
+
+// calculateIORates returns read/write bytes per second since the previous sample
+func calculateIORates(prev processIOSample, readBytes, writeBytes uint64, now time.Time) (float64, float64) {
+	elapsed := now.Sub(prev.timestamp).Seconds()
+	if elapsed <= 0 || readBytes < prev.readBytes || writeBytes < prev.writeBytes {
+		return 0, 0
+	}
+	return float64(readBytes-prev.readBytes) / elapsed, float64(writeBytes-prev.writeBytes) / elapsed
+}
+
+// getConnectionCountsByPID counts open TCP/UDP connections per process
+// 프로세스별 네트워크 바이트 수는 ETW/pcap 없이는 얻을 수 없으므로 연결 수로 네트워크 활동을 표시
+func getConnectionCountsByPID() map[int32]int {
+	counts := make(map[int32]int)
+	connections, err := net.Connections("inet")
+	if err != nil {
+		LogDebug("Failed to get network connections", "error", err)
+		return counts
+	}
+	for _, conn := range connections {
+		if conn.Pid > 0 {
+			counts[conn.Pid]++
+		}
+	}
+	return counts
+}
+
+// GetProcessesFiltered returns processes with backend filtering, sorting and pagination
+func GetProcessesFiltered(ctx context.Context, query ProcessQuery) (*ProcessResponse, error) {
+	return queryWithContext(ctx, "GetProcessesFiltered", func() (*ProcessResponse, error) { return getProcessesFiltered(query) })
+}
+
+func getProcessesFiltered(query ProcessQuery) (*ProcessResponse, error) {
+	startTime := time.Now()
+
+	allProcesses, err := getCachedProcessDetails()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get processes: %v", err)
+	}
+
+	totalCount := len(allProcesses)
+
+	// Apply filtering
+	filteredProcesses := filterProcesses(allProcesses, query.Filter)
+	filteredCount := len(filteredProcesses)
+
+	// Apply sorting
+	sortProcesses(filteredProcesses, query.Sort)
+
+	// Apply pagination
+	paginatedProcesses := []ProcessDetail{}
+	hasMore := false
+
+	start := query.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start < filteredCount {
+		end := filteredCount
+		if query.MaxItems > 0 && start+query.MaxItems < filteredCount {
+			end = start + query.MaxItems
+			hasMore = true
+		}
+		paginatedProcesses = filteredProcesses[start:end]
+	}
+
+	return &ProcessResponse{
+		Processes:     paginatedProcesses,
+		TotalCount:    totalCount,
+		FilteredCount: filteredCount,
+		HasMore:       hasMore,
+		QueryTime:     time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func filterProcesses(processes []ProcessDetail, filter ProcessFilter) []ProcessDetail {
+	if !filter.Enabled {
+		return processes
+	}
+
+	nameFilter := strings.ToLower(strings.TrimSpace(filter.NameContains))
+	var filtered []ProcessDetail
+
+	for _, proc := range processes {
+		include := true
+
+		switch filter.FilterType {
+		case "cpu":
+			include = proc.CPUPercent >= filter.CPUThreshold
+		case "memory":
+			include = proc.MemoryPercent >= filter.MemoryThreshold
+		case "both":
+			include = proc.CPUPercent >= filter.CPUThreshold && proc.MemoryPercent >= filter.MemoryThreshold
+		default:
+			// "all" 또는 알 수 없는 값: 임계값 필터 없음
+		}
+
+		if include && nameFilter != "" {
+			include = strings.Contains(strings.ToLower(proc.Name), nameFilter)
+		}
+
+		if include {
+			filtered = append(filtered, proc)
+		}
+	}
+
+	return filtered
+}
+
+func sortProcesses(processes []ProcessDetail, sortConfig ProcessSort) {
+	if sortConfig.Field == "" {
+		return // No sorting
+	}
+
+	sort.SliceStable(processes, func(i, j int) bool {
+		a, b := processes[i], processes[j]
+		if sortConfig.Order == "desc" {
+			a, b = b, a
+		}
+
+		switch sortConfig.Field {
+		case "name":
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		case "cpu_percent":
+			return a.CPUPercent < b.CPUPercent
+		case "memory_percent":
+			return a.MemoryPercent < b.MemoryPercent
+		case "memory_rss":
+			return a.MemoryRSS < b.MemoryRSS
+		case "io":
+			return a.ReadBytes+a.WriteBytes < b.ReadBytes+b.WriteBytes
+		case "io_rate":
+			return a.ReadRate+a.WriteRate < b.ReadRate+b.WriteRate
+		case "connections":
+			return a.Connections < b.Connections
+		case "threads":
+			return a.NumThreads < b.NumThreads
+		default:
+			return a.PID < b.PID
+		}
+	})
+}
+
+// ProcessCPUTime represents the cumulative CPU time consumed by a process
+type ProcessCPUTime struct {
+	PID        int32   `json:"pid"`
+	Name       string  `json:"name"`
+	CPUSeconds float64 `json:"cpu_seconds"` // user + system
+}
+
+// GetTopProcessesByCPUTime returns the running processes with the most cumulative CPU time
+func GetTopProcessesByCPUTime(ctx context.Context, count int) ([]ProcessCPUTime, error) {
+	return queryWithContext(ctx, "GetTopProcessesByCPUTime", func() ([]ProcessCPUTime, error) { return getTopProcessesByCPUTime(count) })
+}
+
+func getTopProcessesByCPUTime(count int) ([]ProcessCPUTime, error) {
+	processes, err := process.Processes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes: %v", err)
+	}
+
+	result := make([]ProcessCPUTime, 0, len(processes))
+	for _, p := range processes {
+		times, err := p.Times()
+		if err != nil || times == nil {
+			continue
+		}
+		name, err := p.Name()
+		if err != nil || name == "" {
+			continue
+		}
+		result = append(result, ProcessCPUTime{
+			PID:        p.Pid,
+			Name:       name,
+			CPUSeconds: times.User + times.System,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CPUSeconds > result[j].CPUSeconds })
+	if count > 0 && len(result) > count {
+		result = result[:count]
+	}
+	return result, nil
+}
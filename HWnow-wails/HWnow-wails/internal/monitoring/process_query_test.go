@@ -0,0 +1,50 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProcessQueryHelpers(t *testing.T) {
+	processes := []ProcessDetail{
+		{PID: 10, Name: "chrome.exe", CPUPercent: 12.5, MemoryPercent: 8.0, ReadRate: 100, WriteRate: 50},
+		{PID: 20, Name: "explorer.exe", CPUPercent: 1.0, MemoryPercent: 2.0, ReadRate: 5000},
+		{PID: 30, Name: "Chrome.exe", CPUPercent: 30.0, MemoryPercent: 1.0},
+	}
+
+	t.Run("Filter_By_Name_And_CPU", func(t *testing.T) {
+		filtered := filterProcesses(processes, ProcessFilter{
+			Enabled:      true,
+			FilterType:   "cpu",
+			CPUThreshold: 10,
+			NameContains: "chrome",
+		})
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 chrome processes above 10%% CPU, got %d", len(filtered))
+		}
+	})
+
+	t.Run("Sort_By_IO_Rate", func(t *testing.T) {
+		sorted := append([]ProcessDetail(nil), processes...)
+		sortProcesses(sorted, ProcessSort{Field: "io_rate", Order: "desc"})
+		if sorted[0].PID != 20 {
+			t.Errorf("Expected PID 20 to have the highest I/O rate, got %d", sorted[0].PID)
+		}
+	})
+
+	t.Run("IO_Rate_Calculation", func(t *testing.T) {
+		now := time.Now()
+		prev := processIOSample{readBytes: 1000, writeBytes: 2000, timestamp: now.Add(-2 * time.Second)}
+
+		readRate, writeRate := calculateIORates(prev, 3000, 2400, now)
+		if readRate != 1000 || writeRate != 200 {
+			t.Errorf("Expected 1000/200 bytes/s, got %f/%f", readRate, writeRate)
+		}
+
+		// 카운터가 감소하면 (PID 재사용 등) 0으로 처리
+		readRate, writeRate = calculateIORates(prev, 500, 2400, now)
+		if readRate != 0 || writeRate != 0 {
+			t.Errorf("Expected zero rates on counter reset, got %f/%f", readRate, writeRate)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package monitoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 프로세스 검색 (대시보드의 "프로세스 찾기" 입력란)
+// 전체 목록을 내려받지 않고 이름/명령줄에 대해 퍼지 매칭한 결과만 점수순으로 반환
+// - 이름 일치 > 접두사 > 부분 문자열 > 순서대로 포함된 글자(서브시퀀스) 순으로 점수 부여
+// - 명령줄 일치는 이름 일치보다 낮은 점수
+
+const (
+	DEFAULT_PROCESS_SEARCH_LIMIT = 20
+	MAX_PROCESS_SEARCH_LIMIT     = 200
+)
+
+// ProcessSearchMatch is a process matching a search query with its resource usage
+type ProcessSearchMatch struct {
+	Process      ProcessDetail `json:"process"`
+	CommandLine  string        `json:"command_line,omitempty"`
+	Score        int           `json:"score"`
+	MatchedField string        `json:"matched_field"` // name, command_line
+}
+
+// ProcessSearchResponse lists the best matches for a query
+type ProcessSearchResponse struct {
+	Query      string               `json:"query"`
+	Matches    []ProcessSearchMatch `json:"matches"`
+	TotalCount int                  `json:"total_count"` // 검색 대상 프로세스 수
+	QueryTime  int64                `json:"query_time_ms"`
+}
+
+// SearchProcesses fuzzy-matches running processes by name and command line, best matches first
+func SearchProcesses(query string, limit int) (*ProcessSearchResponse, error) {
+	startTime := time.Now()
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty search query")
+	}
+	if limit <= 0 {
+		limit = DEFAULT_PROCESS_SEARCH_LIMIT
+	}
+	if limit > MAX_PROCESS_SEARCH_LIMIT {
+		limit = MAX_PROCESS_SEARCH_LIMIT
+	}
+
+	processes, err := getCachedProcessDetails()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get processes: %v", err)
+	}
+
+	matches := matchProcesses(processes, query, getProcessCommandLines(processes))
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return &ProcessSearchResponse{
+		Query:      query,
+		Matches:    matches,
+		TotalCount: len(processes),
+		QueryTime:  time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// getProcessCommandLines returns command lines of the given processes, looked up once per PID
+func getProcessCommandLines(processes []ProcessDetail) map[int32]string {
+	processListCache.mutex.Lock()
+	defer processListCache.mutex.Unlock()
+
+	cmdlines := make(map[int32]string, len(processes))
+	for _, proc := range processes {
+		cmdline, known := processListCache.cmdlines[proc.PID]
+		if !known {
+			// 권한 부족 시 빈 값으로 기록해 다시 조회하지 않음
+			if p, exists := processListCache.handles[proc.PID]; exists {
+				cmdline, _ = p.Cmdline()
+			}
+			processListCache.cmdlines[proc.PID] = cmdline
+		}
+		cmdlines[proc.PID] = cmdline
+	}
+	return cmdlines
+}
+
+// matchProcesses scores every process against the query and returns matches sorted by score, then CPU usage
+func matchProcesses(processes []ProcessDetail, query string, cmdlines map[int32]string) []ProcessSearchMatch {
+	matches := []ProcessSearchMatch{}
+	for _, proc := range processes {
+		match := ProcessSearchMatch{Process: proc, CommandLine: cmdlines[proc.PID]}
+		if score, ok := fuzzyMatchScore(query, proc.Name); ok {
+			match.Score = score
+			match.MatchedField = "name"
+		}
+		// 명령줄 일치는 인자/경로에 흔한 단어가 많으므로 절반 점수
+		if score, ok := fuzzyMatchScore(query, match.CommandLine); ok && score/2 > match.Score {
+			match.Score = score / 2
+			match.MatchedField = "command_line"
+		}
+		if match.MatchedField != "" {
+			matches = append(matches, match)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Process.CPUPercent > matches[j].Process.CPUPercent
+	})
+	return matches
+}
+
+// fuzzyMatchScore scores how well text matches the query (case-insensitive); false when the query
+// characters do not all appear in order
+func fuzzyMatchScore(query, text string) (int, bool) {
+	query = strings.ToLower(query)
+	text = strings.ToLower(text)
+	if query == "" || text == "" {
+		return 0, false
+	}
+
+	// 짧은 텍스트일수록 더 정확한 일치로 간주
+	lengthPenalty := min(utf8.RuneCountInString(text)-utf8.RuneCountInString(query), 100)
+	switch {
+	case text == query || strings.TrimSuffix(text, ".exe") == query:
+		return 1000, true
+	case strings.HasPrefix(text, query):
+		return 800 - lengthPenalty, true
+	case strings.Contains(text, query):
+		return 600 - min(strings.Index(text, query), 100) - lengthPenalty/2, true
+	}
+
+	// 서브시퀀스: 글자가 연속으로 이어질수록 높은 점수
+	queryRunes := []rune(query)
+	matched, consecutive, gaps := 0, 0, 0
+	previous := -2
+	for i, r := range []rune(text) {
+		if matched == len(queryRunes) {
+			break
+		}
+		if r != queryRunes[matched] {
+			continue
+		}
+		if i == previous+1 {
+			consecutive++
+		} else if matched > 0 {
+			gaps++
+		}
+		previous = i
+		matched++
+	}
+	if matched < len(queryRunes) {
+		return 0, false
+	}
+	return max(300+consecutive*20-gaps*30-lengthPenalty/2, 1), true
+}
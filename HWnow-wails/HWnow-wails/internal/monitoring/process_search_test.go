@@ -0,0 +1,53 @@
+package monitoring
+
+import "testing"
+
+func TestFuzzyMatchScore(t *testing.T) {
+	exact, _ := fuzzyMatchScore("chrome", "chrome.exe")
+	prefix, _ := fuzzyMatchScore("chro", "chrome.exe")
+	contains, _ := fuzzyMatchScore("rome", "chrome.exe")
+	subsequence, ok := fuzzyMatchScore("chrm", "Chrome.exe")
+	if !ok {
+		t.Fatal("Expected in-order characters to match")
+	}
+	if !(exact > prefix && prefix > contains && contains > subsequence && subsequence > 0) {
+		t.Errorf("Unexpected score order: exact %d, prefix %d, contains %d, subsequence %d", exact, prefix, contains, subsequence)
+	}
+
+	if _, ok := fuzzyMatchScore("mhc", "chrome.exe"); ok {
+		t.Error("Expected out-of-order characters not to match")
+	}
+	if _, ok := fuzzyMatchScore("chro", ""); ok {
+		t.Error("Expected empty text not to match")
+	}
+}
+
+func TestMatchProcesses(t *testing.T) {
+	processes := []ProcessDetail{
+		{PID: 10, Name: "chrome.exe", CPUPercent: 3},
+		{PID: 20, Name: "chrome.exe", CPUPercent: 12},
+		{PID: 30, Name: "python3", CPUPercent: 50},
+		{PID: 40, Name: "explorer.exe"},
+		{PID: 50, Name: "node"},
+	}
+	cmdlines := map[int32]string{
+		30: "python3 /opt/chromium/build.py",
+		50: "node server.js",
+	}
+
+	matches := matchProcesses(processes, "chro", cmdlines)
+	if len(matches) != 3 {
+		t.Fatalf("Expected 3 matches, got %+v", matches)
+	}
+	// 같은 점수는 CPU 사용률 순, 명령줄 일치는 이름 일치보다 뒤
+	if matches[0].Process.PID != 20 || matches[1].Process.PID != 10 || matches[0].MatchedField != "name" {
+		t.Errorf("Unexpected name matches: %+v", matches[:2])
+	}
+	if matches[2].Process.PID != 30 || matches[2].MatchedField != "command_line" || matches[2].CommandLine == "" {
+		t.Errorf("Unexpected command line match: %+v", matches[2])
+	}
+
+	if matches := matchProcesses(processes, "zzz", cmdlines); len(matches) != 0 {
+		t.Errorf("Expected no matches, got %+v", matches)
+	}
+}
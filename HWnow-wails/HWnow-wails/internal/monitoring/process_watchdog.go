@@ -0,0 +1,249 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 프로세스 감시 (watchdog)
+// 등록된 이름의 프로세스가 종료되면 알림 후 선택적으로 재시작 명령을 실행하고,
+// 같은 이름의 프로세스들의 CPU/메모리 합계가 한도를 넘으면 알림 (상태가 바뀔 때만 한 번)
+
+const (
+	PROCESS_WATCHDOG_INTERVAL = 5 * time.Second
+	PROCESS_RESTART_COOLDOWN  = 30 * time.Second
+)
+
+// Process watch event types
+const (
+	ProcessWatchStarted       = "started"
+	ProcessWatchExited        = "exited"
+	ProcessWatchCPULimit      = "cpu_limit"
+	ProcessWatchMemoryLimit   = "memory_limit"
+	ProcessWatchRestarted     = "restarted"
+	ProcessWatchRestartFailed = "restart_failed"
+)
+
+// ProcessWatchRule describes a watched process name and its limits
+type ProcessWatchRule struct {
+	ID             int64
+	Name           string
+	RestartCommand string
+	MaxCPUPercent  float64 // 0 = 제한 없음
+	MaxMemoryMB    float64 // 0 = 제한 없음
+}
+
+// ProcessWatchEvent is emitted when a watched process changes state
+type ProcessWatchEvent struct {
+	RuleID    int64     `json:"rule_id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"` // started, exited, cpu_limit, memory_limit, restarted, restart_failed
+	PIDs      []int32   `json:"pids,omitempty"`
+	Value     float64   `json:"value,omitempty"` // 한도 이벤트의 현재 값 (CPU %, 메모리 MB)
+	Limit     float64   `json:"limit,omitempty"`
+	Command   string    `json:"command,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// processWatchState tracks the last observed state of a rule
+type processWatchState struct {
+	observed    bool
+	running     bool
+	overCPU     bool
+	overMemory  bool
+	down        bool // 실행 중이던 프로세스가 종료되어 재시작 대기 중
+	lastRestart time.Time
+}
+
+// ProcessWatchdog periodically checks watched processes
+type ProcessWatchdog struct {
+	mutex   sync.Mutex
+	rules   []ProcessWatchRule
+	states  map[int64]*processWatchState
+	handler func(ProcessWatchEvent)
+	cancel  context.CancelFunc
+}
+
+// NewProcessWatchdog creates a watchdog that calls handler for each state change
+func NewProcessWatchdog(handler func(ProcessWatchEvent)) *ProcessWatchdog {
+	return &ProcessWatchdog{
+		states:  make(map[int64]*processWatchState),
+		handler: handler,
+	}
+}
+
+// SetRules replaces the watch list (state is kept for rules that remain)
+func (w *ProcessWatchdog) SetRules(rules []ProcessWatchRule) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.rules = rules
+	states := make(map[int64]*processWatchState, len(rules))
+	for _, rule := range rules {
+		if state, ok := w.states[rule.ID]; ok {
+			states[rule.ID] = state
+		}
+	}
+	w.states = states
+}
+
+// Start begins checking in the background
+func (w *ProcessWatchdog) Start(ctx context.Context) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		return // already running
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	go w.run(watchCtx)
+}
+
+// Stop stops background checking
+func (w *ProcessWatchdog) Stop() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+}
+
+// run checks the process list on every interval
+func (w *ProcessWatchdog) run(ctx context.Context) {
+	ticker := time.NewTicker(PROCESS_WATCHDOG_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.mutex.Lock()
+			hasRules := len(w.rules) > 0
+			w.mutex.Unlock()
+			if !hasRules {
+				continue
+			}
+
+			processes, err := getCachedProcessDetails()
+			if err != nil {
+				LogDebug("Process watchdog could not list processes", "error", err)
+				continue
+			}
+			events, restarts := w.check(processes, time.Now())
+			for _, rule := range restarts {
+				events = append(events, runRestartCommand(rule))
+			}
+			for _, event := range events {
+				w.handler(event)
+			}
+		}
+	}
+}
+
+// check compares the process list against the rules and returns state changes and rules to restart
+func (w *ProcessWatchdog) check(processes []ProcessDetail, now time.Time) ([]ProcessWatchEvent, []ProcessWatchRule) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	var events []ProcessWatchEvent
+	var restarts []ProcessWatchRule
+	for _, rule := range w.rules {
+		state, ok := w.states[rule.ID]
+		if !ok {
+			state = &processWatchState{}
+			w.states[rule.ID] = state
+		}
+
+		var pids []int32
+		var cpuPercent, memoryMB float64
+		for _, process := range processes {
+			if strings.EqualFold(process.Name, rule.Name) {
+				pids = append(pids, process.PID)
+				cpuPercent += process.CPUPercent
+				memoryMB += process.MemoryRSS
+			}
+		}
+		running := len(pids) > 0
+		event := ProcessWatchEvent{RuleID: rule.ID, Name: rule.Name, PIDs: pids, Timestamp: now}
+
+		// 첫 관찰은 기준 상태로만 기록
+		if state.observed && running != state.running {
+			event.Type = ProcessWatchExited
+			if running {
+				event.Type = ProcessWatchStarted
+			}
+			events = append(events, event)
+		}
+		if state.observed && state.running && !running {
+			state.down = true
+		}
+		if running {
+			state.down = false
+		}
+		state.observed = true
+		state.running = running
+
+		if state.down && rule.RestartCommand != "" && now.Sub(state.lastRestart) >= PROCESS_RESTART_COOLDOWN {
+			state.lastRestart = now
+			restarts = append(restarts, rule)
+		}
+
+		overCPU := running && rule.MaxCPUPercent > 0 && cpuPercent > rule.MaxCPUPercent
+		if overCPU && !state.overCPU {
+			limitEvent := event
+			limitEvent.Type, limitEvent.Value, limitEvent.Limit = ProcessWatchCPULimit, cpuPercent, rule.MaxCPUPercent
+			events = append(events, limitEvent)
+		}
+		state.overCPU = overCPU
+
+		overMemory := running && rule.MaxMemoryMB > 0 && memoryMB > rule.MaxMemoryMB
+		if overMemory && !state.overMemory {
+			limitEvent := event
+			limitEvent.Type, limitEvent.Value, limitEvent.Limit = ProcessWatchMemoryLimit, memoryMB, rule.MaxMemoryMB
+			events = append(events, limitEvent)
+		}
+		state.overMemory = overMemory
+	}
+	return events, restarts
+}
+
+// runRestartCommand starts the rule's restart command through the system shell without waiting for it
+func runRestartCommand(rule ProcessWatchRule) ProcessWatchEvent {
+	event := ProcessWatchEvent{
+		RuleID:    rule.ID,
+		Name:      rule.Name,
+		Type:      ProcessWatchRestarted,
+		Command:   rule.RestartCommand,
+		Timestamp: time.Now(),
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", rule.RestartCommand)
+	} else {
+		cmd = exec.Command("sh", "-c", rule.RestartCommand)
+	}
+	pid, wait, err := commandRunner.Start(cmd)
+	if err != nil {
+		event.Type = ProcessWatchRestartFailed
+		event.Error = fmt.Sprintf("failed to start restart command: %v", err)
+		LogWarn("Process watchdog restart failed", "name", rule.Name, "command", rule.RestartCommand, "error", err)
+		return event
+	}
+	event.PIDs = []int32{int32(pid)}
+	go wait()
+
+	LogInfo("Process watchdog ran restart command", "name", rule.Name, "command", rule.RestartCommand, "pid", pid)
+	return event
+}
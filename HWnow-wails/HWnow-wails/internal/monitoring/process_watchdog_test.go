@@ -0,0 +1,58 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProcessWatchdog(t *testing.T) {
+	watchdog := NewProcessWatchdog(func(ProcessWatchEvent) {})
+	watchdog.SetRules([]ProcessWatchRule{
+		{ID: 1, Name: "blender.exe", RestartCommand: "blender", MaxMemoryMB: 1000},
+		{ID: 2, Name: "miner"},
+	})
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	running := []ProcessDetail{
+		{PID: 10, Name: "Blender.exe", MemoryRSS: 600},
+		{PID: 11, Name: "blender.exe", MemoryRSS: 600},
+	}
+
+	t.Run("First_Observation_Is_Baseline", func(t *testing.T) {
+		events, restarts := watchdog.check(running, now)
+		if len(restarts) != 0 {
+			t.Errorf("Expected no restarts, got %+v", restarts)
+		}
+		// 메모리 합계(1200MB)가 한도를 넘는 것만 보고
+		if len(events) != 1 || events[0].Type != ProcessWatchMemoryLimit || events[0].Value != 1200 || len(events[0].PIDs) != 2 {
+			t.Errorf("Expected a single memory limit event, got %+v", events)
+		}
+	})
+
+	t.Run("Exit_Triggers_Restart_With_Cooldown", func(t *testing.T) {
+		events, restarts := watchdog.check(nil, now.Add(5*time.Second))
+		if len(events) != 1 || events[0].Type != ProcessWatchExited || events[0].Name != "blender.exe" {
+			t.Errorf("Expected an exited event, got %+v", events)
+		}
+		if len(restarts) != 1 || restarts[0].ID != 1 {
+			t.Fatalf("Expected blender restart, got %+v", restarts)
+		}
+
+		if _, restarts := watchdog.check(nil, now.Add(10*time.Second)); len(restarts) != 0 {
+			t.Errorf("Expected cooldown to suppress restart, got %+v", restarts)
+		}
+		if _, restarts := watchdog.check(nil, now.Add(5*time.Second+PROCESS_RESTART_COOLDOWN)); len(restarts) != 1 {
+			t.Errorf("Expected restart retry after cooldown, got %+v", restarts)
+		}
+	})
+
+	t.Run("Start_Clears_Down_State", func(t *testing.T) {
+		events, _ := watchdog.check(running[:1], now.Add(time.Minute))
+		if len(events) != 1 || events[0].Type != ProcessWatchStarted {
+			t.Errorf("Expected a started event, got %+v", events)
+		}
+		if _, restarts := watchdog.check(running[:1], now.Add(2*time.Minute)); len(restarts) != 0 {
+			t.Errorf("Expected no restart while running, got %+v", restarts)
+		}
+	})
+}
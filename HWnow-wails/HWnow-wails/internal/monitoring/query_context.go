@@ -0,0 +1,46 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+)
+
+// 공개 수집 함수(GetGPUInfo, GetGPUProcesses 등)의 취소/제한 시간 처리
+// 조회는 별도 고루틴에서 실행하고, ctx가 먼저 끝나면 결과를 기다리지 않고 바로 반환
+// 버려진 조회는 외부 명령 제한 시간(external_command.go) 안에서 끝나고 결과는 버려짐
+// 캐시를 쓰는 수집기는 버려진 조회의 결과로 캐시가 갱신되므로 다음 호출에서 재사용됨
+
+// queryWithContext runs query unless ctx is already done, returning early with ctx's error if it ends first
+func queryWithContext[T any](ctx context.Context, name string, query func() (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, fmt.Errorf("%s: %w", name, err)
+	}
+	// 취소될 수 없는 context(Background 등)는 고루틴 없이 바로 실행
+	if ctx.Done() == nil {
+		return query()
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1) // 버려진 조회의 고루틴이 막히지 않도록 버퍼 1
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{err: fmt.Errorf("%s panicked: %v", name, r)}
+			}
+		}()
+		value, err := query()
+		done <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		LogDebug("Query abandoned", "query", name, "reason", ctx.Err())
+		return zero, fmt.Errorf("%s: %w", name, ctx.Err())
+	}
+}
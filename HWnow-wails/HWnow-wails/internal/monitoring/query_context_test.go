@@ -0,0 +1,69 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestQueryWithContext(t *testing.T) {
+
+	t.Run("Returns_Query_Result", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		value, err := queryWithContext(ctx, "test", func() (int, error) { return 42, nil })
+		if err != nil || value != 42 {
+			t.Errorf("Unexpected result: %d, %v", value, err)
+		}
+	})
+
+	t.Run("Canceled_Context_Skips_Query", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := false
+		_, err := queryWithContext(ctx, "test", func() (int, error) { called = true; return 0, nil })
+		if !errors.Is(err, context.Canceled) || called {
+			t.Errorf("Expected the query to be skipped with context.Canceled, got %v (called=%v)", err, called)
+		}
+	})
+
+	t.Run("Deadline_Returns_Before_Slow_Query", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		release := make(chan struct{})
+		defer close(release)
+		start := time.Now()
+		_, err := queryWithContext(ctx, "GetGPUInfo", func() (*GPUInfo, error) {
+			<-release
+			return &GPUInfo{}, nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Query blocked the caller for %v", elapsed)
+		}
+	})
+
+	t.Run("Panic_Becomes_Error", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		if _, err := queryWithContext(ctx, "test", func() (int, error) { panic("boom") }); err == nil {
+			t.Error("Expected an error from a panicking query")
+		}
+	})
+
+	t.Run("Public_Collector_Honors_Canceled_Context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := GetGPUProcesses(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+}
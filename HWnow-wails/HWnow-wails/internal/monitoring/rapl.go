@@ -0,0 +1,189 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RAPL(Running Average Power Limit) 도메인별 CPU 전력 (Linux powercap: /sys/class/powercap/intel-rapl:*)
+// Intel과 AMD(Zen 이후, 커널이 같은 intel-rapl 이름으로 노출) 모두 energy_uj 누적 카운터를 제공하며
+// 직전 샘플과의 차이를 경과 시간으로 나누어 W로 환산 (GPU의 power.draw와 대응되는 CPU 전력 지표)
+
+const RAPL_CACHE_DURATION = 2 * time.Second
+
+// RAPLDomain is the power draw of one RAPL domain
+type RAPLDomain struct {
+	Zone    string  `json:"zone"`    // intel-rapl:0, intel-rapl:0:1 ...
+	Name    string  `json:"name"`    // package-0, core, uncore, dram, psys
+	Package int     `json:"package"` // 소켓 번호 (-1 = psys 등 패키지에 속하지 않는 도메인)
+	Watts   float64 `json:"watts"`
+}
+
+// RAPLPower summarizes RAPL power draw by domain type across all packages
+type RAPLPower struct {
+	PackageWatts float64      `json:"package_watts"` // 모든 패키지 합 (W, -1 = 지원 안 함)
+	CoreWatts    float64      `json:"core_watts"`    // 코어(PP0) 합 (W, -1 = 지원 안 함)
+	UncoreWatts  float64      `json:"uncore_watts"`  // 내장 GPU 등 언코어(PP1) 합 (W, -1 = 지원 안 함)
+	DRAMWatts    float64      `json:"dram_watts"`    // 메모리 합 (W, -1 = 지원 안 함)
+	Domains      []RAPLDomain `json:"domains"`
+}
+
+// raplSample stores the previous RAPL energy counter reading of a domain
+type raplSample struct {
+	energyUJ  float64
+	maxUJ     float64
+	timestamp time.Time
+}
+
+// RAPLCache keeps the previous counter of every domain and caches the last computed power
+type RAPLCache struct {
+	mutex     sync.Mutex
+	samples   map[string]raplSample
+	power     *RAPLPower
+	timestamp time.Time
+}
+
+var raplCache = &RAPLCache{samples: make(map[string]raplSample)}
+
+// GetRAPLPower returns package, core, uncore and DRAM power draw from RAPL energy counters (Linux only)
+// 첫 호출은 기준 카운터만 기록하므로 다음 호출부터 값이 반환됨
+func GetRAPLPower(ctx context.Context) (*RAPLPower, error) {
+	return queryWithContext(ctx, "GetRAPLPower", getRAPLPower)
+}
+
+func getRAPLPower() (*RAPLPower, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("RAPL power not supported on platform: %s", runtime.GOOS)
+	}
+
+	raplCache.mutex.Lock()
+	defer raplCache.mutex.Unlock()
+
+	if raplCache.power != nil && time.Since(raplCache.timestamp) < RAPL_CACHE_DURATION {
+		return raplCache.power, nil
+	}
+
+	power, err := raplCache.read(linuxPowercapPath, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	raplCache.power = power
+	raplCache.timestamp = time.Now()
+	return power, nil
+}
+
+// read computes the power of every RAPL domain under root since the previous read (caller holds the mutex)
+func (c *RAPLCache) read(root string, now time.Time) (*RAPLPower, error) {
+	zones, err := filepath.Glob(filepath.Join(root, "intel-rapl:*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(zones)
+
+	power := &RAPLPower{PackageWatts: -1, CoreWatts: -1, UncoreWatts: -1, DRAMWatts: -1, Domains: []RAPLDomain{}}
+	readable := 0
+	for _, zone := range zones {
+		energy, ok := readSysfsFloat(zone, "energy_uj")
+		if !ok {
+			continue // energy_uj는 root 권한이 필요할 수 있음
+		}
+		readable++
+		maxRange, _ := readSysfsFloat(zone, "max_energy_range_uj")
+
+		prev, hasPrev := c.samples[zone]
+		c.samples[zone] = raplSample{energyUJ: energy, maxUJ: maxRange, timestamp: now}
+		if !hasPrev {
+			continue
+		}
+
+		elapsed := now.Sub(prev.timestamp).Seconds()
+		if elapsed <= 0 {
+			continue
+		}
+		delta := energy - prev.energyUJ
+		if delta < 0 && prev.maxUJ > 0 {
+			delta += prev.maxUJ // 카운터 오버플로우
+		}
+		if delta < 0 {
+			continue
+		}
+
+		domain := newRAPLDomain(zone, delta/1e6/elapsed)
+		power.Domains = append(power.Domains, domain)
+		switch {
+		case strings.HasPrefix(domain.Name, "package"):
+			power.PackageWatts = addRAPLWatts(power.PackageWatts, domain.Watts)
+		case domain.Name == "core":
+			power.CoreWatts = addRAPLWatts(power.CoreWatts, domain.Watts)
+		case domain.Name == "uncore":
+			power.UncoreWatts = addRAPLWatts(power.UncoreWatts, domain.Watts)
+		case domain.Name == "dram":
+			power.DRAMWatts = addRAPLWatts(power.DRAMWatts, domain.Watts)
+		}
+	}
+
+	if readable == 0 {
+		return nil, fmt.Errorf("no readable RAPL domains under %s", root)
+	}
+	if len(power.Domains) == 0 {
+		return nil, fmt.Errorf("RAPL baseline sample collected, power available on next read")
+	}
+	return power, nil
+}
+
+// newRAPLDomain describes a powercap zone (intel-rapl:<package>[:<subzone>])
+func newRAPLDomain(zone string, watts float64) RAPLDomain {
+	base := filepath.Base(zone)
+	domain := RAPLDomain{
+		Zone:    base,
+		Name:    strings.TrimSpace(readSysfsString(zone, "name")),
+		Package: -1,
+		Watts:   watts,
+	}
+	if domain.Name == "" {
+		domain.Name = base
+	}
+	if domain.Name != "psys" {
+		parts := strings.Split(base, ":")
+		if len(parts) > 1 {
+			if index, err := strconv.Atoi(parts[1]); err == nil {
+				domain.Package = index
+			}
+		}
+	}
+	return domain
+}
+
+// addRAPLWatts adds a domain to a total that starts at -1 (unsupported)
+func addRAPLWatts(total, watts float64) float64 {
+	if total < 0 {
+		return watts
+	}
+	return total + watts
+}
+
+// RAPLMetrics converts RAPL power into resource log metrics (unsupported domains are skipped)
+func RAPLMetrics(power *RAPLPower) []Metric {
+	if power == nil {
+		return nil
+	}
+
+	var metrics []Metric
+	add := func(metricType string, watts float64) {
+		if watts >= 0 {
+			metrics = append(metrics, Metric{Type: metricType, Value: watts})
+		}
+	}
+	add("rapl_package_watts", power.PackageWatts)
+	add("rapl_core_watts", power.CoreWatts)
+	add("rapl_uncore_watts", power.UncoreWatts)
+	add("rapl_dram_watts", power.DRAMWatts)
+	return metrics
+}
@@ -0,0 +1,63 @@
+package monitoring
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeRAPLZone creates a powercap zone with the given name and energy counter
+func writeRAPLZone(t *testing.T, root, zone, name, energy string) {
+	t.Helper()
+	dir := filepath.Join(root, zone)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for attribute, value := range map[string]string{"name": name, "energy_uj": energy, "max_energy_range_uj": "262143328850"} {
+		if err := os.WriteFile(filepath.Join(dir, attribute), []byte(value+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRAPLCacheRead(t *testing.T) {
+	root := t.TempDir()
+	writeRAPLZone(t, root, "intel-rapl:0", "package-0", "1000000")
+	writeRAPLZone(t, root, "intel-rapl:0:0", "core", "500000")
+	writeRAPLZone(t, root, "intel-rapl:0:1", "dram", "100000")
+
+	cache := &RAPLCache{samples: make(map[string]raplSample)}
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	if _, err := cache.read(root, start); err == nil {
+		t.Fatal("Expected the first read to only record a baseline")
+	}
+
+	// 2초 동안 패키지 50 J, 코어 30 J, DRAM 0.2 J (DRAM 카운터는 7.9 J → 0.1 J로 오버플로우)
+	writeRAPLZone(t, root, "intel-rapl:0", "package-0", "51000000")
+	writeRAPLZone(t, root, "intel-rapl:0:0", "core", "30500000")
+	cache.samples[filepath.Join(root, "intel-rapl:0:1")] = raplSample{energyUJ: 7900000, maxUJ: 8000000, timestamp: start}
+
+	power, err := cache.read(root, start.Add(2*time.Second))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if power.PackageWatts != 25 || power.CoreWatts != 15 || power.DRAMWatts != 0.1 || power.UncoreWatts != -1 {
+		t.Errorf("Unexpected power: %+v", power)
+	}
+	if len(power.Domains) != 3 || power.Domains[1].Name != "core" || power.Domains[1].Package != 0 {
+		t.Errorf("Unexpected domains: %+v", power.Domains)
+	}
+
+	metrics := RAPLMetrics(power)
+	if len(metrics) != 3 || metrics[0].Type != "rapl_package_watts" || MetricUnit(metrics[0].Type) != UnitWatts {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestRAPLCacheReadWithoutDomains(t *testing.T) {
+	cache := &RAPLCache{samples: make(map[string]raplSample)}
+	if _, err := cache.read(t.TempDir(), time.Now()); err == nil {
+		t.Error("Expected an error without RAPL domains")
+	}
+}
@@ -1,16 +1,14 @@
 package monitoring
 
 import (
-	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"runtime"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
-	"unsafe"
+
+	"HWnow-wails/internal/winapi"
 )
 
 // CachedResult represents a cached security check result
@@ -60,55 +58,24 @@ func executeBatchPowerShell(commands []string) (string, error) {
 }
 
 // Helper function to create exec.Command with hidden window for Windows
-func createHiddenCommand(name string, args ...string) *exec.Cmd {
-	cmd := exec.Command(name, args...)
-	
-	// CMD 창 숨기기 설정 (Windows 전용)
-	if runtime.GOOS == "windows" {
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			HideWindow:    true,
-			CreationFlags: 0x08000000, // CREATE_NO_WINDOW
-		}
-		
-		// 추가적인 창 숨기기 설정
-		cmd.SysProcAttr.CreationFlags |= 0x00000010 // DETACHED_PROCESS
-		
-		// StartupInfo 설정으로 창 완전히 숨기기
-		cmd.SysProcAttr.CreationFlags |= 0x00000200 // CREATE_NO_WINDOW 강화
-		
-		// 환경 변수로 콘솔 출력 억제
-		if cmd.Env == nil {
-			cmd.Env = os.Environ()
-		}
-		cmd.Env = append(cmd.Env, "TERM=dumb") // 터미널 출력 최소화
-	}
-	
-	return cmd
+// 도구별 제한 시간과 전역 동시 실행 제한이 적용됨 (external_command.go)
+func createHiddenCommand(name string, args ...string) *externalCommand {
+	return createHiddenCommandWithDuration(name, externalCommandTimeout(name), args...)
 }
 
 // createHiddenCommandWithTimeout creates a command with timeout and hidden window
-func createHiddenCommandWithTimeout(name string, timeoutSeconds int, args ...string) *exec.Cmd {
-	cmd := createHiddenCommand(name, args...)
-	
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
-	
-	// Store cancel function for potential cleanup
-	// Note: In practice, the caller should handle ctx cancellation properly
-	_ = cancel
+func createHiddenCommandWithTimeout(name string, timeoutSeconds int, args ...string) *externalCommand {
+	return createHiddenCommandWithDuration(name, time.Duration(timeoutSeconds)*time.Second, args...)
+}
+
+// createHiddenCommandWithDuration creates a hidden-window command killed after timeout
+func createHiddenCommandWithDuration(name string, timeout time.Duration, args ...string) *externalCommand {
+	cmd := newExternalCommand(timeout, name, args...)
 	
-	// Apply context to command
-	cmdWithCtx := exec.CommandContext(ctx, name, args...)
+	// CMD 창 숨기기 설정 (Windows 전용, 다른 플랫폼에서는 아무 것도 하지 않음)
+	detachConsoleWindow(cmd.Cmd)
 	
-	// Copy the SysProcAttr from the original hidden command
-	if cmd.SysProcAttr != nil {
-		cmdWithCtx.SysProcAttr = cmd.SysProcAttr
-	}
-	if cmd.Env != nil {
-		cmdWithCtx.Env = cmd.Env
-	}
-	
-	return cmdWithCtx
+	return cmd
 }
 
 // Windows UAC 및 권한 관리 시스템
@@ -137,7 +104,8 @@ type SecurityContext struct {
 	UACStatus        UACStatus          `json:"uac_status"`         // UAC 상태
 	ProcessPrivileges []ProcessPrivilege `json:"process_privileges"` // 프로세스 권한 목록
 	IsSecureMode     bool               `json:"is_secure_mode"`     // 보안 모드 여부
-	Recommendations  []string           `json:"recommendations"`    // 보안 권장사항
+	Recommendations  []string           `json:"recommendations"`    // 보안 권장사항 (선택된 언어로 표시)
+	RecommendationMessages []Message    `json:"recommendation_messages"` // 권장사항 메시지 키와 번역
 }
 
 // Windows API 상수 및 구조체 정의
@@ -155,6 +123,7 @@ func GetSecurityContext() (*SecurityContext, error) {
 		Platform:          runtime.GOOS,
 		ProcessPrivileges: []ProcessPrivilege{},
 		Recommendations:   []string{},
+		RecommendationMessages: []Message{},
 	}
 	
 	if runtime.GOOS == "windows" {
@@ -182,8 +151,8 @@ func getWindowsSecurityContext(ctx *SecurityContext) (*SecurityContext, error) {
 	privileges, err := getWindowsProcessPrivileges()
 	if err != nil {
 		LogError("Failed to get process privileges", "error", err)
-		ctx.Recommendations = append(ctx.Recommendations, 
-			"프로세스 권한을 확인할 수 없습니다. 관리자 권한으로 재실행하세요.")
+		ctx.RecommendationMessages = append(ctx.RecommendationMessages,
+			NewMessage(DefaultLanguage, "security.privileges_unavailable_windows"))
 	} else {
 		ctx.ProcessPrivileges = privileges
 	}
@@ -192,7 +161,8 @@ func getWindowsSecurityContext(ctx *SecurityContext) (*SecurityContext, error) {
 	ctx.IsSecureMode = ctx.UACStatus.IsEnabled && ctx.UACStatus.IsElevated
 	
 	// 권장사항 생성
-	ctx.Recommendations = generateWindowsRecommendations(ctx)
+	ctx.RecommendationMessages = append(ctx.RecommendationMessages, generateWindowsRecommendations(ctx)...)
+	LocalizeSecurityContext(ctx, ResolveLanguage(""))
 	
 	return ctx, nil
 }
@@ -213,8 +183,8 @@ func getUnixSecurityContext(ctx *SecurityContext) (*SecurityContext, error) {
 	privileges, err := getUnixProcessPrivileges()
 	if err != nil {
 		LogError("Failed to get Unix process privileges", "error", err)
-		ctx.Recommendations = append(ctx.Recommendations, 
-			"프로세스 권한을 확인할 수 없습니다. sudo 권한으로 재실행하세요.")
+		ctx.RecommendationMessages = append(ctx.RecommendationMessages,
+			NewMessage(DefaultLanguage, "security.privileges_unavailable_unix"))
 	} else {
 		ctx.ProcessPrivileges = privileges
 	}
@@ -223,7 +193,8 @@ func getUnixSecurityContext(ctx *SecurityContext) (*SecurityContext, error) {
 	ctx.IsSecureMode = ctx.UACStatus.IsElevated
 	
 	// 권장사항 생성
-	ctx.Recommendations = generateUnixRecommendations(ctx)
+	ctx.RecommendationMessages = append(ctx.RecommendationMessages, generateUnixRecommendations(ctx)...)
+	LocalizeSecurityContext(ctx, ResolveLanguage(""))
 	
 	return ctx, nil
 }
@@ -268,6 +239,9 @@ func getWindowsUACStatus() (*UACStatus, error) {
 	return status, nil
 }
 
+// UAC 정책 레지스트리 키
+const uacPolicyKey = `HKLM\SOFTWARE\Microsoft\Windows\CurrentVersion\Policies\System`
+
 // isUACEnabled checks if UAC is enabled in Windows with caching
 func isUACEnabled() (bool, error) {
 	cacheKey := "UAC_EnableLUA"
@@ -283,18 +257,13 @@ func isUACEnabled() (bool, error) {
 	securityCache.mu.RUnlock()
 	
 	// 레지스트리에서 UAC 설정 확인
-	cmd := createHiddenCommand("reg", "query", 
-		"HKLM\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System", 
-		"/v", "EnableLUA")
-	
-	output, err := cmd.Output()
+	enableLUA, err := winapi.RegistryDWORD(uacPolicyKey, "EnableLUA")
 	if err != nil {
 		return false, fmt.Errorf("failed to query UAC registry: %v", err)
 	}
 	
-	outputStr := string(output)
-	// EnableLUA 값이 0x1이면 UAC 활성화
-	result := strings.Contains(outputStr, "0x1")
+	// EnableLUA 값이 1이면 UAC 활성화
+	result := enableLUA == 1
 	
 	// Cache the result
 	securityCache.mu.Lock()
@@ -310,24 +279,19 @@ func isUACEnabled() (bool, error) {
 // getUACLevel gets the current UAC level
 func getUACLevel() (string, error) {
 	// ConsentPromptBehaviorAdmin 레지스트리 값으로 UAC 레벨 확인
-	cmd := createHiddenCommand("reg", "query", 
-		"HKLM\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Policies\\System", 
-		"/v", "ConsentPromptBehaviorAdmin")
-	
-	output, err := cmd.Output()
+	behavior, err := winapi.RegistryDWORD(uacPolicyKey, "ConsentPromptBehaviorAdmin")
 	if err != nil {
 		return "Unknown", fmt.Errorf("failed to query UAC level: %v", err)
 	}
 	
-	outputStr := string(output)
-	
-	if strings.Contains(outputStr, "0x0") {
+	switch behavior {
+	case 0:
 		return "Never notify", nil
-	} else if strings.Contains(outputStr, "0x1") {
+	case 1:
 		return "Prompt for credentials on secure desktop", nil
-	} else if strings.Contains(outputStr, "0x2") {
+	case 2:
 		return "Prompt for consent on secure desktop", nil
-	} else if strings.Contains(outputStr, "0x5") {
+	case 5:
 		return "Prompt for consent for non-Windows binaries", nil
 	}
 	
@@ -357,10 +321,11 @@ func hasSimpleAdminRights() bool {
 	}
 	securityCache.mu.RUnlock()
 	
-	// net session 명령으로 간단한 관리자 권한 확인
-	cmd := createHiddenCommand("net", "session")
-	err := cmd.Run()
-	result := err == nil
+	// 토큰의 Administrators 그룹 멤버십으로 간단한 관리자 권한 확인 (net session 대체)
+	result, err := winapi.IsAdmin()
+	if err != nil {
+		result = false
+	}
 	
 	// Cache the result
 	securityCache.mu.Lock()
@@ -373,52 +338,6 @@ func hasSimpleAdminRights() bool {
 	return result
 }
 
-// checkTokenElevation uses Windows API to check token elevation
-func checkTokenElevation() (bool, error) {
-	// Windows API 호출을 위한 DLL 로드
-	kernel32 := syscall.NewLazyDLL("kernel32.dll")
-	getCurrentProcess := kernel32.NewProc("GetCurrentProcess")
-	
-	advapi32 := syscall.NewLazyDLL("advapi32.dll")
-	openProcessToken := advapi32.NewProc("OpenProcessToken")
-	getTokenInformation := advapi32.NewProc("GetTokenInformation")
-	
-	// 현재 프로세스 핸들 가져오기
-	processHandle, _, _ := getCurrentProcess.Call()
-	
-	// 프로세스 토큰 열기
-	var tokenHandle syscall.Handle
-	ret, _, err := openProcessToken.Call(
-		processHandle,
-		TOKEN_QUERY,
-		uintptr(unsafe.Pointer(&tokenHandle)),
-	)
-	
-	if ret == 0 {
-		return false, fmt.Errorf("OpenProcessToken failed: %v", err)
-	}
-	defer syscall.CloseHandle(tokenHandle)
-	
-	// 토큰 권한 정보 가져오기
-	var elevationType uint32
-	var returnedLen uint32
-	
-	ret, _, err = getTokenInformation.Call(
-		uintptr(tokenHandle),
-		TokenElevationType,
-		uintptr(unsafe.Pointer(&elevationType)),
-		unsafe.Sizeof(elevationType),
-		uintptr(unsafe.Pointer(&returnedLen)),
-	)
-	
-	if ret == 0 {
-		return false, fmt.Errorf("GetTokenInformation failed: %v", err)
-	}
-	
-	// 권한 상승 타입 확인
-	return elevationType == TokenElevationTypeFull, nil
-}
-
 // getWindowsProcessPrivileges gets Windows process privileges
 func getWindowsProcessPrivileges() ([]ProcessPrivilege, error) {
 	privileges := []ProcessPrivilege{}
@@ -453,24 +372,12 @@ func getWindowsProcessPrivileges() ([]ProcessPrivilege, error) {
 
 // checkWindowsPrivilege checks if a specific Windows privilege is enabled
 func checkWindowsPrivilege(privilegeName string) (bool, error) {
-	// whoami /priv 명령으로 권한 확인
-	cmd := createHiddenCommand("whoami", "/priv")
-	output, err := cmd.Output()
+	// 현재 프로세스 토큰의 권한 목록에서 확인 (whoami /priv 대체)
+	enabled, err := winapi.PrivilegeEnabled(privilegeName)
 	if err != nil {
-		return false, fmt.Errorf("failed to run whoami /priv: %v", err)
-	}
-	
-	outputStr := string(output)
-	lines := strings.Split(outputStr, "\n")
-	
-	for _, line := range lines {
-		if strings.Contains(line, privilegeName) {
-			// "Enabled" 상태인지 확인
-			return strings.Contains(line, "Enabled"), nil
-		}
+		return false, fmt.Errorf("failed to query token privileges: %v", err)
 	}
-	
-	return false, nil
+	return enabled, nil
 }
 
 // isPrivilegeRequired determines if a privilege is required for GPU process control
@@ -490,24 +397,24 @@ func isPrivilegeRequired(privilegeName string) bool {
 }
 
 // generateWindowsRecommendations generates security recommendations for Windows
-func generateWindowsRecommendations(ctx *SecurityContext) []string {
-	recommendations := []string{}
+func generateWindowsRecommendations(ctx *SecurityContext) []Message {
+	recommendations := []Message{}
 	
 	if !ctx.UACStatus.IsEnabled {
 		recommendations = append(recommendations, 
-			"보안을 위해 UAC(사용자 계정 컨트롤)를 활성화하는 것을 권장합니다.")
+			NewMessage(DefaultLanguage, "security.enable_uac"))
 	}
 	
 	if !ctx.UACStatus.IsElevated {
 		recommendations = append(recommendations, 
-			"GPU 프로세스 제어를 위해 관리자 권한으로 애플리케이션을 실행하세요.")
+			NewMessage(DefaultLanguage, "security.run_as_admin"))
 		recommendations = append(recommendations, 
-			"마우스 우클릭 후 '관리자 권한으로 실행'을 선택하거나, PowerShell에서 'Start-Process -Verb RunAs' 명령을 사용하세요.")
+			NewMessage(DefaultLanguage, "security.run_as_admin_howto"))
 	}
 	
 	if ctx.UACStatus.Level == "Never notify" {
 		recommendations = append(recommendations, 
-			"보안상 UAC 레벨을 'Default' 또는 'Always notify'로 설정하는 것을 권장합니다.")
+			NewMessage(DefaultLanguage, "security.uac_level"))
 	}
 	
 	// 필수 권한 확인
@@ -520,66 +427,40 @@ func generateWindowsRecommendations(ctx *SecurityContext) []string {
 	
 	if len(missingPrivileges) > 0 {
 		recommendations = append(recommendations, 
-			fmt.Sprintf("다음 권한이 부족합니다: %s. 관리자 권한으로 재실행하거나 그룹 정책을 확인하세요.", 
-				strings.Join(missingPrivileges, ", ")))
+			NewMessage(DefaultLanguage, "security.missing_privileges", strings.Join(missingPrivileges, ", ")))
 	}
 	
 	if ctx.IsSecureMode {
 		recommendations = append(recommendations, 
-			"보안 모드가 활성화되었습니다. GPU 프로세스 제어가 안전하게 수행됩니다.")
+			NewMessage(DefaultLanguage, "security.secure_mode_windows"))
 	}
 	
 	return recommendations
 }
 
 // generateUnixRecommendations generates security recommendations for Unix-like systems
-func generateUnixRecommendations(ctx *SecurityContext) []string {
-	recommendations := []string{}
+func generateUnixRecommendations(ctx *SecurityContext) []Message {
+	recommendations := []Message{}
 	
 	if !ctx.UACStatus.IsElevated {
 		recommendations = append(recommendations, 
-			"GPU 프로세스 제어를 위해 sudo 권한이 필요합니다.")
+			NewMessage(DefaultLanguage, "security.sudo_required"))
 		recommendations = append(recommendations, 
-			"'sudo ./your-app' 명령으로 실행하거나, sudoers 파일에 사용자를 추가하세요.")
+			NewMessage(DefaultLanguage, "security.sudo_howto"))
 	}
 	
 	if ctx.IsSecureMode {
 		recommendations = append(recommendations, 
-			"sudo 권한이 확인되었습니다. GPU 프로세스 제어가 가능합니다.")
+			NewMessage(DefaultLanguage, "security.secure_mode_unix"))
 	}
 	
 	return recommendations
 }
 
-// RequestElevation attempts to request elevation for the current process
-func RequestElevation() error {
-	if runtime.GOOS == "windows" {
-		return requestWindowsElevation()
-	} else {
-		return fmt.Errorf("elevation request not supported on %s", runtime.GOOS)
-	}
-}
-
-// requestWindowsElevation requests Windows UAC elevation
-func requestWindowsElevation() error {
-	// 현재 실행 파일 경로 가져오기
-	currentExe, err := exec.LookPath("monitoring-app.exe")
-	if err != nil {
-		return fmt.Errorf("failed to get current executable path: %v", err)
-	}
-	
-	// PowerShell을 사용하여 관리자 권한으로 재실행
-	cmd := createHiddenCommand("powershell", 
-		"-Command", 
-		fmt.Sprintf("Start-Process -FilePath '%s' -Verb RunAs", currentExe))
-	
-	err = cmd.Start()
-	if err != nil {
-		return fmt.Errorf("failed to request elevation: %v", err)
-	}
-	
-	LogInfo("Elevation requested", "executable", currentExe)
-	return nil
+// LocalizeSecurityContext renders the recommendations of a security context in the given language
+func LocalizeSecurityContext(ctx *SecurityContext, language string) {
+	ctx.RecommendationMessages = LocalizeMessages(ctx.RecommendationMessages, language)
+	ctx.Recommendations = messageTexts(ctx.RecommendationMessages)
 }
 
 // Enhanced HasAdminPrivileges function with detailed checking
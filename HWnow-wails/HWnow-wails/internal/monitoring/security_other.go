@@ -0,0 +1,10 @@
+//go:build !windows
+
+package monitoring
+
+import "fmt"
+
+// checkTokenElevation is only available on Windows
+func checkTokenElevation() (bool, error) {
+	return false, fmt.Errorf("token elevation not supported on this platform")
+}
@@ -0,0 +1,55 @@
+//go:build windows
+
+package monitoring
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// checkTokenElevation uses Windows API to check token elevation
+func checkTokenElevation() (bool, error) {
+	// Windows API 호출을 위한 DLL 로드
+	kernel32 := syscall.NewLazyDLL("kernel32.dll")
+	getCurrentProcess := kernel32.NewProc("GetCurrentProcess")
+	
+	advapi32 := syscall.NewLazyDLL("advapi32.dll")
+	openProcessToken := advapi32.NewProc("OpenProcessToken")
+	getTokenInformation := advapi32.NewProc("GetTokenInformation")
+	
+	// 현재 프로세스 핸들 가져오기
+	processHandle, _, _ := getCurrentProcess.Call()
+	
+	// 프로세스 토큰 열기
+	var tokenHandle syscall.Handle
+	ret, _, err := openProcessToken.Call(
+		processHandle,
+		TOKEN_QUERY,
+		uintptr(unsafe.Pointer(&tokenHandle)),
+	)
+	
+	if ret == 0 {
+		return false, fmt.Errorf("OpenProcessToken failed: %v", err)
+	}
+	defer syscall.CloseHandle(tokenHandle)
+	
+	// 토큰 권한 정보 가져오기
+	var elevationType uint32
+	var returnedLen uint32
+	
+	ret, _, err = getTokenInformation.Call(
+		uintptr(tokenHandle),
+		TokenElevationType,
+		uintptr(unsafe.Pointer(&elevationType)),
+		unsafe.Sizeof(elevationType),
+		uintptr(unsafe.Pointer(&returnedLen)),
+	)
+	
+	if ret == 0 {
+		return false, fmt.Errorf("GetTokenInformation failed: %v", err)
+	}
+	
+	// 권한 상승 타입 확인
+	return elevationType == TokenElevationTypeFull, nil
+}
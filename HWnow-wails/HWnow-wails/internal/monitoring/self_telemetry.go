@@ -0,0 +1,207 @@
+package monitoring
+
+import (
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// HWnow 자체 리소스 사용량 및 수집기 성능 측정 (hwnow_self_*)
+// CPU 최적화 효과를 검증하고 회귀를 감지하기 위한 용도
+
+// CollectorStats holds timing and error counters for a single collector
+type CollectorStats struct {
+	Name              string    `json:"name"`
+	Calls             int64     `json:"calls"`
+	Errors            int64     `json:"errors"`
+	ConsecutiveErrors int64     `json:"consecutive_errors"` // 마지막 성공 이후 연속 실패 횟수
+	LastDurationMs    float64   `json:"last_duration_ms"`
+	AvgDurationMs     float64   `json:"avg_duration_ms"` // 지수 이동 평균
+	MaxDurationMs     float64   `json:"max_duration_ms"`
+	LastError         string    `json:"last_error,omitempty"`
+	LastErrorAt       time.Time `json:"last_error_at"` // 마지막 실패 시각 (실패한 적이 없으면 0)
+	LastRun           time.Time `json:"last_run"`
+	LastSuccess       time.Time `json:"last_success"` // 오류 없이 끝난 마지막 실행 (상태 점검용)
+}
+
+// SelfTelemetry represents HWnow's own resource usage
+type SelfTelemetry struct {
+	CPUPercent          float64          `json:"cpu_percent"`
+	RSSMB               float64          `json:"rss_mb"`
+	Goroutines          int              `json:"goroutines"`
+	OpenHandles         int32            `json:"open_handles"` // 파일 디스크립터(Linux) / 핸들(Windows), -1 = 알 수 없음
+	HeapAllocMB         float64          `json:"heap_alloc_mb"`
+	GCCount             uint32           `json:"gc_count"`
+	LastCycleDurationMs float64          `json:"last_cycle_duration_ms"`
+	AvgCycleDurationMs  float64          `json:"avg_cycle_duration_ms"`
+	Cycles              int64            `json:"cycles"`
+	Collectors          []CollectorStats `json:"collectors"`
+	Timestamp           time.Time        `json:"timestamp"`
+}
+
+// selfTelemetryRegistry stores collector statistics and the persistent self process handle
+type selfTelemetryRegistry struct {
+	mutex      sync.Mutex
+	collectors map[string]*CollectorStats
+	cycle      CollectorStats
+	self       *process.Process
+}
+
+// 이동 평균 가중치
+const collectorAvgWeight = 0.2
+
+var selfTelemetry = &selfTelemetryRegistry{
+	collectors: make(map[string]*CollectorStats),
+}
+
+var selfMetricNamePattern = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ObserveCollector records the duration and result of a single collector run
+func ObserveCollector(name string, duration time.Duration, err error) {
+	selfTelemetry.mutex.Lock()
+	defer selfTelemetry.mutex.Unlock()
+
+	stats, exists := selfTelemetry.collectors[name]
+	if !exists {
+		stats = &CollectorStats{Name: name}
+		selfTelemetry.collectors[name] = stats
+	}
+	observeDuration(stats, duration, err)
+}
+
+// ObserveCollectionCycle records the total duration of one metrics collection cycle
+func ObserveCollectionCycle(duration time.Duration) {
+	selfTelemetry.mutex.Lock()
+	defer selfTelemetry.mutex.Unlock()
+
+	selfTelemetry.cycle.Name = "cycle"
+	observeDuration(&selfTelemetry.cycle, duration, nil)
+}
+
+// AverageCollectionCycle returns the moving average duration of a collection cycle
+func AverageCollectionCycle() time.Duration {
+	selfTelemetry.mutex.Lock()
+	defer selfTelemetry.mutex.Unlock()
+	return time.Duration(selfTelemetry.cycle.AvgDurationMs * float64(time.Millisecond))
+}
+
+// TimeCollector runs fn and records its duration and error under name
+func TimeCollector(name string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	ObserveCollector(name, time.Since(start), err)
+	return err
+}
+
+func observeDuration(stats *CollectorStats, duration time.Duration, err error) {
+	ms := float64(duration.Microseconds()) / 1000
+	stats.Calls++
+	stats.LastDurationMs = ms
+	stats.LastRun = time.Now()
+	if stats.Calls == 1 {
+		stats.AvgDurationMs = ms
+	} else {
+		stats.AvgDurationMs = stats.AvgDurationMs*(1-collectorAvgWeight) + ms*collectorAvgWeight
+	}
+	if ms > stats.MaxDurationMs {
+		stats.MaxDurationMs = ms
+	}
+	if err != nil {
+		stats.Errors++
+		stats.ConsecutiveErrors++
+		stats.LastError = err.Error()
+		stats.LastErrorAt = stats.LastRun
+	} else {
+		stats.ConsecutiveErrors = 0
+		stats.LastSuccess = stats.LastRun
+	}
+}
+
+// GetCollectorStats returns a snapshot of all collector statistics sorted by name
+func GetCollectorStats() []CollectorStats {
+	selfTelemetry.mutex.Lock()
+	defer selfTelemetry.mutex.Unlock()
+
+	stats := make([]CollectorStats, 0, len(selfTelemetry.collectors))
+	for _, s := range selfTelemetry.collectors {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+// GetSelfTelemetry returns HWnow's own process metrics and collector statistics
+func GetSelfTelemetry() (*SelfTelemetry, error) {
+	telemetry := &SelfTelemetry{
+		Goroutines:  runtime.NumGoroutine(),
+		OpenHandles: -1,
+		Collectors:  GetCollectorStats(),
+		Timestamp:   time.Now(),
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	telemetry.HeapAllocMB = float64(memStats.HeapAlloc) / 1024 / 1024
+	telemetry.GCCount = memStats.NumGC
+
+	selfTelemetry.mutex.Lock()
+	telemetry.LastCycleDurationMs = selfTelemetry.cycle.LastDurationMs
+	telemetry.AvgCycleDurationMs = selfTelemetry.cycle.AvgDurationMs
+	telemetry.Cycles = selfTelemetry.cycle.Calls
+
+	// 같은 핸들을 재사용해야 Percent(0)가 이전 조회 대비 CPU 사용률을 계산함
+	if selfTelemetry.self == nil {
+		p, err := process.NewProcess(int32(os.Getpid()))
+		if err != nil {
+			selfTelemetry.mutex.Unlock()
+			return telemetry, err
+		}
+		selfTelemetry.self = p
+	}
+	self := selfTelemetry.self
+	selfTelemetry.mutex.Unlock()
+
+	if cpuPercent, err := self.Percent(0); err == nil {
+		telemetry.CPUPercent = cpuPercent
+	}
+	if memInfo, err := self.MemoryInfo(); err == nil && memInfo != nil {
+		telemetry.RSSMB = float64(memInfo.RSS) / 1024 / 1024
+	}
+	if handles, err := self.NumFDs(); err == nil {
+		telemetry.OpenHandles = handles
+	}
+
+	return telemetry, nil
+}
+
+// SelfTelemetryMetrics converts self telemetry to hwnow_self_* metrics
+func SelfTelemetryMetrics(telemetry *SelfTelemetry) []Metric {
+	if telemetry == nil {
+		return nil
+	}
+
+	metrics := []Metric{
+		{Type: "hwnow_self_cpu_percent", Value: telemetry.CPUPercent},
+		{Type: "hwnow_self_rss_mb", Value: telemetry.RSSMB},
+		{Type: "hwnow_self_goroutines", Value: float64(telemetry.Goroutines)},
+		{Type: "hwnow_self_heap_alloc_mb", Value: telemetry.HeapAllocMB},
+		{Type: "hwnow_self_cycle_ms", Value: telemetry.LastCycleDurationMs},
+	}
+	if telemetry.OpenHandles >= 0 {
+		metrics = append(metrics, Metric{Type: "hwnow_self_open_handles", Value: float64(telemetry.OpenHandles)})
+	}
+	for _, collector := range telemetry.Collectors {
+		name := strings.Trim(selfMetricNamePattern.ReplaceAllString(strings.ToLower(collector.Name), "_"), "_")
+		metrics = append(metrics,
+			Metric{Type: "hwnow_self_collector_" + name + "_ms", Value: collector.LastDurationMs},
+			Metric{Type: "hwnow_self_collector_" + name + "_errors", Value: float64(collector.Errors)},
+		)
+	}
+	return metrics
+}
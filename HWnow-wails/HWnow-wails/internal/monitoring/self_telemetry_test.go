@@ -0,0 +1,50 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSelfTelemetry(t *testing.T) {
+
+	t.Run("Observe_Collector", func(t *testing.T) {
+		ObserveCollector("test_collector", 10*time.Millisecond, nil)
+		ObserveCollector("test_collector", 30*time.Millisecond, errors.New("boom"))
+
+		var found *CollectorStats
+		for _, stats := range GetCollectorStats() {
+			if stats.Name == "test_collector" {
+				s := stats
+				found = &s
+			}
+		}
+		if found == nil {
+			t.Fatal("Expected test_collector stats")
+		}
+		if found.Calls != 2 || found.Errors != 1 {
+			t.Errorf("Expected 2 calls and 1 error, got %d and %d", found.Calls, found.Errors)
+		}
+		if found.MaxDurationMs != 30 || found.LastError != "boom" {
+			t.Errorf("Unexpected stats: %+v", *found)
+		}
+	})
+
+	t.Run("Metric_Names", func(t *testing.T) {
+		telemetry := &SelfTelemetry{
+			OpenHandles: -1,
+			Collectors:  []CollectorStats{{Name: "GPU Processes", LastDurationMs: 5}},
+		}
+
+		names := make(map[string]bool)
+		for _, metric := range SelfTelemetryMetrics(telemetry) {
+			names[metric.Type] = true
+		}
+		if !names["hwnow_self_collector_gpu_processes_ms"] {
+			t.Errorf("Expected sanitized collector metric name, got %v", names)
+		}
+		if names["hwnow_self_open_handles"] {
+			t.Error("Expected open handles metric to be omitted when unknown")
+		}
+	})
+}
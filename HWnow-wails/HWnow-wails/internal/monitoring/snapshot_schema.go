@@ -0,0 +1,216 @@
+package monitoring
+
+import (
+	"strings"
+	"time"
+)
+
+// 구조화된 스냅샷 스키마
+// "cpu_core_7", "gpu_adapter_1_usage" 같은 문자열 키를 파싱하지 않도록 자원별 하위 구조체로 직렬화 (Wails/HTTP API)
+// 기존 평면 메트릭 목록(ResourceSnapshot.Metrics)은 Metrics 필드로 함께 제공할 수 있음 (호환용)
+
+// TypedSnapshot is a point-in-time resource snapshot grouped by subsystem
+type TypedSnapshot struct {
+	Timestamp time.Time        `json:"timestamp"`
+	CPU       CPUSnapshot      `json:"cpu"`
+	Memory    MemorySnapshot   `json:"memory"`
+	DiskIO    DiskIOSnapshot   `json:"disk_io"`
+	Disks     []DiskSnapshot   `json:"disks"`
+	NICs      []NICSnapshot    `json:"nics"`
+	Network   NetworkSnapshot  `json:"network"`
+	GPUs      []GPUSnapshot    `json:"gpus"`
+	Processes []ProcessInfo    `json:"processes"`
+	Battery   *BatteryInfo     `json:"battery,omitempty"`
+	Power     *SystemPowerInfo `json:"power,omitempty"`
+
+	// 호환용 평면 메트릭 목록 (요청한 경우만)
+	Metrics []Metric `json:"metrics,omitempty"`
+}
+
+// CPUSnapshot holds CPU usage, per-core usage and scheduler load
+type CPUSnapshot struct {
+	Usage       float64           `json:"usage"`       // %
+	Cores       []float64         `json:"cores"`       // 코어별 사용률 (%, 인덱스 0 = 첫 번째 코어)
+	Temperature float64           `json:"temperature"` // 패키지 온도 (°C, -1 = 알 수 없음)
+	Times       *CPUTimeBreakdown `json:"times,omitempty"`
+	Load        *LoadInfo         `json:"load,omitempty"`
+	Power       *RAPLPower        `json:"power,omitempty"` // RAPL 도메인별 전력 (Linux)
+}
+
+// MemorySnapshot holds RAM usage and its breakdown
+type MemorySnapshot struct {
+	UsedPercent float64          `json:"used_percent"`
+	Details     *MemoryDetails   `json:"details,omitempty"`
+	Breakdown   *MemoryBreakdown `json:"breakdown,omitempty"`
+	Paging      *MemoryPaging    `json:"paging,omitempty"`
+}
+
+// DiskIOSnapshot holds the aggregate disk throughput
+type DiskIOSnapshot struct {
+	ReadBytesPerSec  float64 `json:"read_bytes_per_sec"`
+	WriteBytesPerSec float64 `json:"write_bytes_per_sec"`
+}
+
+// DiskSnapshot is either a watched path/mount point (usage) or a physical drive (temperature)
+type DiskSnapshot struct {
+	Path        string  `json:"path,omitempty"`   // 감시 경로/마운트 지점
+	Device      string  `json:"device,omitempty"` // 물리 드라이브 (sda, nvme0n1 ...)
+	Model       string  `json:"model,omitempty"`
+	Total       float64 `json:"total"` // bytes (물리 드라이브는 0)
+	Used        float64 `json:"used"`
+	Free        float64 `json:"free"`
+	UsedPercent float64 `json:"used_percent"` // %, -1 = 해당 없음
+	Temperature float64 `json:"temperature"`  // °C, -1 = 알 수 없음
+}
+
+// NICSnapshot holds the link state of a network interface
+type NICSnapshot struct {
+	Name      string    `json:"name"`
+	Up        bool      `json:"up"`
+	IPAddress string    `json:"ip_address"`
+	WiFi      *WiFiInfo `json:"wifi,omitempty"` // 무선 어댑터인 경우 신호/링크 속도
+}
+
+// NetworkSnapshot holds the aggregate network throughput and quality
+type NetworkSnapshot struct {
+	SentBytesPerSec float64         `json:"sent_bytes_per_sec"`
+	RecvBytesPerSec float64         `json:"recv_bytes_per_sec"`
+	Status          string          `json:"status"`
+	Quality         *NetworkQuality `json:"quality,omitempty"`
+}
+
+// GPUSnapshot holds the usage of one GPU adapter
+type GPUSnapshot struct {
+	Index         int              `json:"index"`
+	Name          string           `json:"name"`
+	Vendor        string           `json:"vendor,omitempty"`
+	Integrated    bool             `json:"integrated"`
+	Usage         float64          `json:"usage"`           // %, -1 = 알 수 없음
+	MemoryUsedMB  float64          `json:"memory_used_mb"`  // -1 = 알 수 없음
+	MemoryTotalMB float64          `json:"memory_total_mb"` // 0 = 알 수 없음
+	Temperature   float64          `json:"temperature"`     // °C, -1 = 알 수 없음
+	Power         float64          `json:"power"`           // W, -1 = 알 수 없음
+	Engines       []GPUEngineUsage `json:"engines,omitempty"`
+	Processes     []GPUProcess     `json:"processes,omitempty"`
+}
+
+// NewDiskSnapshots merges watched path usage and drive temperatures into one disk list
+func NewDiskSnapshots(paths []DiskPathUsage, temperatures []DiskTemperature) []DiskSnapshot {
+	disks := make([]DiskSnapshot, 0, len(paths)+len(temperatures))
+	for _, path := range paths {
+		disks = append(disks, DiskSnapshot{
+			Path:        path.Path,
+			Total:       path.Total,
+			Used:        path.Used,
+			Free:        path.Free,
+			UsedPercent: path.UsedPercent,
+			Temperature: -1,
+		})
+	}
+	for _, temperature := range temperatures {
+		disks = append(disks, DiskSnapshot{
+			Device:      temperature.Device,
+			Model:       temperature.Model,
+			UsedPercent: -1,
+			Temperature: temperature.Temperature,
+		})
+	}
+	return disks
+}
+
+// NewNICSnapshots attaches wireless link information to the matching interfaces
+func NewNICSnapshots(interfaces []NetworkInterface, wifi []WiFiInfo) []NICSnapshot {
+	nics := make([]NICSnapshot, 0, len(interfaces))
+	for _, nic := range interfaces {
+		snapshot := NICSnapshot{Name: nic.Name, Up: nic.Status > 0, IPAddress: nic.IpAddress}
+		for i := range wifi {
+			if strings.EqualFold(wifi[i].Interface, nic.Name) {
+				info := wifi[i]
+				snapshot.WiFi = &info
+				break
+			}
+		}
+		nics = append(nics, snapshot)
+	}
+	return nics
+}
+
+// NewGPUSnapshots builds one entry per adapter, merging the primary GPU's readings and processes
+// 어댑터가 2개 미만이면 주 GPU 정보만으로 항목을 만듦 (GPUAdapterMetrics와 같은 기준)
+func NewGPUSnapshots(info *GPUInfo, adapters []GPUAdapter, engines []GPUEngineUsage, processes []GPUProcess) []GPUSnapshot {
+	var gpus []GPUSnapshot
+	if len(adapters) >= 2 {
+		for _, adapter := range adapters {
+			gpu := GPUSnapshot{
+				Index:         adapter.Index,
+				Name:          adapter.Name,
+				Vendor:        adapter.Vendor,
+				Integrated:    adapter.Integrated,
+				Usage:         adapter.Usage,
+				MemoryUsedMB:  adapterMemoryUsed(adapter),
+				MemoryTotalMB: adapter.DedicatedMemoryMB,
+				Temperature:   -1,
+				Power:         -1,
+			}
+			for _, process := range processes {
+				if process.AdapterID != "" && process.AdapterID == adapter.ID {
+					gpu.Processes = append(gpu.Processes, process)
+				}
+			}
+			gpus = append(gpus, gpu)
+		}
+	} else if info != nil {
+		gpu := GPUSnapshot{Name: info.Name, Temperature: -1, Power: -1}
+		if len(adapters) == 1 {
+			gpu.Index = adapters[0].Index
+			gpu.Vendor = adapters[0].Vendor
+			gpu.Integrated = adapters[0].Integrated
+		}
+		gpu.Processes = processes
+		gpus = append(gpus, gpu)
+	}
+
+	// 주 GPU(센서/전력 값을 제공하는 GPU)에 온도, 전력, 엔진 사용률 반영
+	if info == nil || len(gpus) == 0 {
+		return gpus
+	}
+	primary := 0
+	for i := range gpus {
+		if gpus[i].Name == info.Name {
+			primary = i
+			break
+		}
+	}
+	gpu := &gpus[primary]
+	if len(adapters) < 2 {
+		gpu.Usage = info.Usage
+		gpu.MemoryUsedMB = info.MemoryUsed
+		gpu.MemoryTotalMB = info.MemoryTotal
+	}
+	if info.Temperature > 0 {
+		gpu.Temperature = info.Temperature
+	}
+	if info.Power > 0 {
+		gpu.Power = info.Power
+	}
+	gpu.Engines = engines
+
+	// 어댑터를 알 수 없는 프로세스는 주 GPU에 포함
+	if len(adapters) >= 2 {
+		for _, process := range processes {
+			if process.AdapterID == "" || !hasGPUAdapter(adapters, process.AdapterID) {
+				gpu.Processes = append(gpu.Processes, process)
+			}
+		}
+	}
+	return gpus
+}
+
+func hasGPUAdapter(adapters []GPUAdapter, id string) bool {
+	for _, adapter := range adapters {
+		if adapter.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package monitoring
+
+import "testing"
+
+func TestNewGPUSnapshots(t *testing.T) {
+	info := &GPUInfo{Name: "NVIDIA GeForce RTX 4070", Usage: 70, MemoryUsed: 3000, MemoryTotal: 12288, Temperature: 65, Power: 120}
+	engines := []GPUEngineUsage{{Engine: "3d", Usage: 70}}
+
+	t.Run("Single_GPU", func(t *testing.T) {
+		processes := []GPUProcess{{PID: 10, Name: "game.exe"}}
+		gpus := NewGPUSnapshots(info, nil, engines, processes)
+		if len(gpus) != 1 {
+			t.Fatalf("Expected 1 GPU, got %d", len(gpus))
+		}
+		gpu := gpus[0]
+		if gpu.Usage != 70 || gpu.MemoryTotalMB != 12288 || gpu.Temperature != 65 || gpu.Power != 120 {
+			t.Errorf("Unexpected GPU readings: %+v", gpu)
+		}
+		if len(gpu.Engines) != 1 || len(gpu.Processes) != 1 {
+			t.Errorf("Expected engines and processes on the GPU, got %+v", gpu)
+		}
+	})
+
+	t.Run("Hybrid_Graphics", func(t *testing.T) {
+		adapters := []GPUAdapter{
+			{Index: 0, ID: "igpu", Name: "Intel UHD Graphics", Integrated: true, Usage: 10, DedicatedUsedMB: 100, SharedUsedMB: 400},
+			{Index: 1, ID: "dgpu", Name: "NVIDIA GeForce RTX 4070", Usage: 75, DedicatedUsedMB: 3000, SharedUsedMB: 0, DedicatedMemoryMB: 12288},
+		}
+		processes := []GPUProcess{
+			{PID: 10, Name: "game.exe", AdapterID: "dgpu"},
+			{PID: 11, Name: "browser.exe", AdapterID: "igpu"},
+			{PID: 12, Name: "unknown.exe"},
+		}
+		gpus := NewGPUSnapshots(info, adapters, engines, processes)
+		if len(gpus) != 2 {
+			t.Fatalf("Expected 2 GPUs, got %d", len(gpus))
+		}
+
+		igpu, dgpu := gpus[0], gpus[1]
+		if igpu.MemoryUsedMB != 500 || igpu.Temperature != -1 || len(igpu.Engines) != 0 {
+			t.Errorf("Unexpected iGPU entry: %+v", igpu)
+		}
+		if len(igpu.Processes) != 1 || igpu.Processes[0].PID != 11 {
+			t.Errorf("Expected browser on iGPU, got %+v", igpu.Processes)
+		}
+
+		// 주 GPU는 어댑터 사용률을 유지하고 센서 값과 어댑터를 알 수 없는 프로세스를 받음
+		if dgpu.Usage != 75 || dgpu.Temperature != 65 || dgpu.Power != 120 || len(dgpu.Engines) != 1 {
+			t.Errorf("Unexpected dGPU entry: %+v", dgpu)
+		}
+		if len(dgpu.Processes) != 2 {
+			t.Errorf("Expected game and unassigned process on dGPU, got %+v", dgpu.Processes)
+		}
+	})
+
+	t.Run("No_GPU", func(t *testing.T) {
+		if gpus := NewGPUSnapshots(nil, nil, nil, nil); len(gpus) != 0 {
+			t.Errorf("Expected no GPUs, got %+v", gpus)
+		}
+	})
+}
+
+func TestNewDiskAndNICSnapshots(t *testing.T) {
+	disks := NewDiskSnapshots(
+		[]DiskPathUsage{{Path: "C:\\", Total: 100, Used: 40, Free: 60, UsedPercent: 40}},
+		[]DiskTemperature{{Device: "nvme0n1", Model: "Samsung 980", Temperature: 45}},
+	)
+	if len(disks) != 2 || disks[0].Temperature != -1 || disks[1].UsedPercent != -1 || disks[1].Temperature != 45 {
+		t.Errorf("Unexpected disks: %+v", disks)
+	}
+
+	nics := NewNICSnapshots(
+		[]NetworkInterface{{Name: "Wi-Fi", Status: 1, IpAddress: "192.168.0.2"}, {Name: "Ethernet", Status: 0}},
+		[]WiFiInfo{{Interface: "wi-fi", SSID: "home"}},
+	)
+	if len(nics) != 2 || !nics[0].Up || nics[0].WiFi == nil || nics[0].WiFi.SSID != "home" {
+		t.Errorf("Unexpected Wi-Fi NIC: %+v", nics)
+	}
+	if nics[1].Up || nics[1].WiFi != nil {
+		t.Errorf("Unexpected Ethernet NIC: %+v", nics[1])
+	}
+}
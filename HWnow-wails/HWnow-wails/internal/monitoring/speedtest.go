@@ -0,0 +1,261 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// 요청 시 실행하는 인터넷 속도 측정 (M-Lab ndt7 프로토콜)
+// locate API로 가까운 서버를 찾고, WebSocket으로 다운로드/업로드를 각각 최대 10초간 측정
+
+const (
+	SPEEDTEST_PHASE_DURATION = 10 * time.Second
+	SPEEDTEST_PROGRESS_EVERY = 250 * time.Millisecond
+
+	ndt7LocateURL      = "https://locate.measurementlab.net/v2/nearest/ndt/ndt7"
+	ndt7Subprotocol    = "net.measurementlab.ndt.v7"
+	ndt7DownloadKey    = "wss:///ndt/v7/download"
+	ndt7UploadKey      = "wss:///ndt/v7/upload"
+	ndt7MinMessageSize = 1 << 13
+	ndt7MaxMessageSize = 1 << 20
+)
+
+// Speedtest phases
+const (
+	SpeedtestPhaseLocate   = "locate"
+	SpeedtestPhaseDownload = "download"
+	SpeedtestPhaseUpload   = "upload"
+)
+
+// SpeedtestProgress reports intermediate throughput during a phase
+type SpeedtestProgress struct {
+	Phase          string  `json:"phase"` // locate, download, upload
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	Bytes          int64   `json:"bytes"`
+	Mbps           float64 `json:"mbps"`
+}
+
+// SpeedtestResult holds the final measurement
+type SpeedtestResult struct {
+	Server       string    `json:"server"`
+	DownloadMbps float64   `json:"download_mbps"`
+	UploadMbps   float64   `json:"upload_mbps"`
+	LatencyMs    float64   `json:"latency_ms"` // 서버가 보고한 최소 RTT (-1 = 알 수 없음)
+	StartedAt    time.Time `json:"started_at"`
+	Duration     float64   `json:"duration_seconds"`
+}
+
+// ndt7LocateResponse mirrors the subset of the locate v2 response that is used
+type ndt7LocateResponse struct {
+	Results []struct {
+		Machine string            `json:"machine"`
+		URLs    map[string]string `json:"urls"`
+	} `json:"results"`
+}
+
+// ndt7Measurement mirrors the subset of server measurement messages that is used
+type ndt7Measurement struct {
+	TCPInfo *struct {
+		MinRTT int64 `json:"MinRTT"` // 마이크로초
+	} `json:"TCPInfo"`
+}
+
+// RunSpeedtest measures download and upload throughput against the nearest ndt7 server
+func RunSpeedtest(ctx context.Context, progress func(SpeedtestProgress)) (*SpeedtestResult, error) {
+	result := &SpeedtestResult{LatencyMs: -1, StartedAt: time.Now()}
+
+	progress(SpeedtestProgress{Phase: SpeedtestPhaseLocate})
+	machine, urls, err := locateNDT7Server(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("speedtest server lookup failed: %v", err)
+	}
+	result.Server = machine
+
+	minRTT, downloadMbps, err := runNDT7Download(ctx, urls[ndt7DownloadKey], progress)
+	if err != nil {
+		return nil, fmt.Errorf("download test failed: %v", err)
+	}
+	result.DownloadMbps = downloadMbps
+	if minRTT > 0 {
+		result.LatencyMs = float64(minRTT) / 1000
+	}
+
+	uploadMbps, err := runNDT7Upload(ctx, urls[ndt7UploadKey], progress)
+	if err != nil {
+		return nil, fmt.Errorf("upload test failed: %v", err)
+	}
+	result.UploadMbps = uploadMbps
+
+	result.Duration = time.Since(result.StartedAt).Seconds()
+	LogInfo("Speedtest completed", "server", result.Server,
+		"downloadMbps", result.DownloadMbps, "uploadMbps", result.UploadMbps, "latencyMs", result.LatencyMs)
+	return result, nil
+}
+
+// locateNDT7Server asks the M-Lab locate service for the nearest server and its access URLs
+func locateNDT7Server(ctx context.Context) (string, map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ndt7LocateURL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	var located ndt7LocateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&located); err != nil {
+		return "", nil, err
+	}
+	for _, candidate := range located.Results {
+		if candidate.URLs[ndt7DownloadKey] != "" && candidate.URLs[ndt7UploadKey] != "" {
+			return candidate.Machine, candidate.URLs, nil
+		}
+	}
+	return "", nil, fmt.Errorf("no ndt7 server available")
+}
+
+// dialNDT7 opens an ndt7 WebSocket connection
+func dialNDT7(ctx context.Context, rawURL string) (*websocket.Conn, error) {
+	if _, err := url.Parse(rawURL); err != nil || rawURL == "" {
+		return nil, fmt.Errorf("invalid server URL %q", rawURL)
+	}
+	dialer := websocket.Dialer{
+		HandshakeTimeout: 10 * time.Second,
+		Subprotocols:     []string{ndt7Subprotocol},
+		ReadBufferSize:   ndt7MaxMessageSize,
+		WriteBufferSize:  ndt7MaxMessageSize,
+	}
+	conn, _, err := dialer.DialContext(ctx, rawURL, http.Header{})
+	return conn, err
+}
+
+// runNDT7Download receives data until the server closes the connection or the phase times out.
+// Returns the smallest server-reported RTT (microseconds) and the average throughput
+func runNDT7Download(ctx context.Context, rawURL string, progress func(SpeedtestProgress)) (int64, float64, error) {
+	conn, err := dialNDT7(ctx, rawURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	start := time.Now()
+	deadline := start.Add(SPEEDTEST_PHASE_DURATION + 5*time.Second)
+	conn.SetReadDeadline(deadline)
+	conn.SetReadLimit(ndt7MaxMessageSize)
+	stopOnCancel := closeOnDone(ctx, conn)
+	defer stopOnCancel()
+
+	var total, minRTT int64
+	lastReport := start
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) || (total > 0 && time.Now().After(deadline)) {
+				break
+			}
+			if ctx.Err() != nil {
+				return 0, 0, ctx.Err()
+			}
+			return 0, 0, err
+		}
+		total += int64(len(data))
+
+		if messageType == websocket.TextMessage {
+			var measurement ndt7Measurement
+			if json.Unmarshal(data, &measurement) == nil && measurement.TCPInfo != nil && measurement.TCPInfo.MinRTT > 0 {
+				if minRTT == 0 || measurement.TCPInfo.MinRTT < minRTT {
+					minRTT = measurement.TCPInfo.MinRTT
+				}
+			}
+		}
+
+		if now := time.Now(); now.Sub(lastReport) >= SPEEDTEST_PROGRESS_EVERY {
+			lastReport = now
+			progress(speedtestProgress(SpeedtestPhaseDownload, start, now, total))
+		}
+	}
+
+	final := speedtestProgress(SpeedtestPhaseDownload, start, time.Now(), total)
+	progress(final)
+	return minRTT, final.Mbps, nil
+}
+
+// runNDT7Upload sends data for SPEEDTEST_PHASE_DURATION, growing the message size as throughput allows
+func runNDT7Upload(ctx context.Context, rawURL string, progress func(SpeedtestProgress)) (float64, error) {
+	conn, err := dialNDT7(ctx, rawURL)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	start := time.Now()
+	conn.SetWriteDeadline(start.Add(SPEEDTEST_PHASE_DURATION + 5*time.Second))
+	stopOnCancel := closeOnDone(ctx, conn)
+	defer stopOnCancel()
+
+	payload := make([]byte, ndt7MinMessageSize)
+	var total int64
+	lastReport := start
+	for time.Since(start) < SPEEDTEST_PHASE_DURATION {
+		if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+			if ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+			return 0, err
+		}
+		total += int64(len(payload))
+
+		// ndt7 권장: 전송량의 1/16보다 작으면 메시지 크기를 두 배로 (최대 1MB)
+		if len(payload) < ndt7MaxMessageSize && int64(len(payload)) <= total/16 {
+			payload = make([]byte, len(payload)*2)
+		}
+
+		if now := time.Now(); now.Sub(lastReport) >= SPEEDTEST_PROGRESS_EVERY {
+			lastReport = now
+			progress(speedtestProgress(SpeedtestPhaseUpload, start, now, total))
+		}
+	}
+	conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+
+	final := speedtestProgress(SpeedtestPhaseUpload, start, time.Now(), total)
+	progress(final)
+	return final.Mbps, nil
+}
+
+// closeOnDone closes the connection when ctx is cancelled so blocked reads/writes return
+func closeOnDone(ctx context.Context, conn *websocket.Conn) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+	return func() { close(done) }
+}
+
+// speedtestProgress computes throughput in megabits per second
+func speedtestProgress(phase string, start, now time.Time, bytes int64) SpeedtestProgress {
+	elapsed := now.Sub(start).Seconds()
+	mbps := 0.0
+	if elapsed > 0 {
+		mbps = float64(bytes) * 8 / elapsed / 1e6
+	}
+	return SpeedtestProgress{Phase: phase, ElapsedSeconds: elapsed, Bytes: bytes, Mbps: mbps}
+}
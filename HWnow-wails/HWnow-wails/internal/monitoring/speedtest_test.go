@@ -0,0 +1,55 @@
+package monitoring
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestSpeedtest(t *testing.T) {
+
+	t.Run("Progress_Mbps", func(t *testing.T) {
+		start := time.Now()
+		progress := speedtestProgress(SpeedtestPhaseDownload, start, start.Add(2*time.Second), 2_500_000)
+		if progress.Mbps != 10 {
+			t.Errorf("Expected 10 Mbps, got %f", progress.Mbps)
+		}
+	})
+
+	t.Run("NDT7_Download", func(t *testing.T) {
+		upgrader := websocket.Upgrader{Subprotocols: []string{ndt7Subprotocol}}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for i := 0; i < 3; i++ {
+				conn.WriteMessage(websocket.BinaryMessage, make([]byte, 1000))
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"TCPInfo":{"MinRTT":5000}}`))
+			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		}))
+		defer server.Close()
+
+		var updates []SpeedtestProgress
+		wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+		minRTT, mbps, err := runNDT7Download(context.Background(), wsURL, func(p SpeedtestProgress) {
+			updates = append(updates, p)
+		})
+		if err != nil {
+			t.Fatalf("Download failed: %v", err)
+		}
+		if minRTT != 5000 {
+			t.Errorf("Expected MinRTT 5000us, got %d", minRTT)
+		}
+		if mbps <= 0 || len(updates) == 0 || updates[len(updates)-1].Bytes < 3000 {
+			t.Errorf("Unexpected download result: %f Mbps, updates %+v", mbps, updates)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 냉각 검증 및 튜닝 전후 비교용 부하 발생기
+// CPU: 지정한 수의 작업자가 각자 OS 스레드에 고정된 채 부동소수점 연산을 반복
+// GPU: 내장 GPU 부하 생성기가 없으므로 설정된 외부 도구(gpu-burn, FurMark CLI 등)를 실행 시간 동안 실행
+// 실행 시간이 끝나거나 중지되면 모든 작업자/도구를 종료
+
+const (
+	STRESS_DEFAULT_DURATION = 60 * time.Second
+	STRESS_MAX_DURATION     = 30 * time.Minute
+	STRESS_PROGRESS_EVERY   = time.Second
+
+	stressBurnBatch = 1 << 16 // 중지 여부를 확인하기 전 연산 반복 수
+)
+
+// Stress test load kinds
+const (
+	StressKindCPU = "cpu"
+	StressKindGPU = "gpu"
+)
+
+// StressTestOptions configures a load generator run
+type StressTestOptions struct {
+	Kind            string   `json:"kind"`             // cpu, gpu
+	Threads         int      `json:"threads"`          // CPU 작업자 수 (0 = 논리 코어 수)
+	DurationSeconds int      `json:"duration_seconds"` // 0 = 60초
+	Label           string   `json:"label,omitempty"`  // 전후 비교용 이름 (예: "before repaste")
+	GPUCommand      string   `json:"-"`                // GPU 부하 도구 (설정에서 채움)
+	GPUArgs         []string `json:"-"`
+}
+
+// StressTestProgress reports the elapsed time of a running load generator
+type StressTestProgress struct {
+	Kind             string  `json:"kind"`
+	ElapsedSeconds   float64 `json:"elapsed_seconds"`
+	RemainingSeconds float64 `json:"remaining_seconds"`
+}
+
+// StressTestResult describes a finished run; its time window tags the metrics collected under load
+type StressTestResult struct {
+	Kind            string    `json:"kind"`
+	Label           string    `json:"label,omitempty"`
+	Threads         int       `json:"threads"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Stopped         bool      `json:"stopped"` // 설정한 시간 전에 중지됨
+}
+
+// 연산 결과를 보관해 컴파일러가 부하 루프를 제거하지 못하게 함
+var stressSink atomic.Uint64
+
+// NormalizeStressTestOptions validates the options and fills in defaults
+func NormalizeStressTestOptions(options StressTestOptions, maxDuration time.Duration) (StressTestOptions, error) {
+	options.Kind = strings.ToLower(strings.TrimSpace(options.Kind))
+	if options.Kind == "" {
+		options.Kind = StressKindCPU
+	}
+	if maxDuration <= 0 || maxDuration > STRESS_MAX_DURATION {
+		maxDuration = STRESS_MAX_DURATION
+	}
+
+	switch options.Kind {
+	case StressKindCPU:
+		if options.Threads <= 0 || options.Threads > runtime.NumCPU() {
+			options.Threads = runtime.NumCPU()
+		}
+	case StressKindGPU:
+		if strings.TrimSpace(options.GPUCommand) == "" {
+			return options, fmt.Errorf("GPU load requires stress_test.gpu_command in the configuration")
+		}
+		options.Threads = 0
+	default:
+		return options, fmt.Errorf("unsupported stress test kind: %s", options.Kind)
+	}
+
+	if options.DurationSeconds < 0 {
+		return options, fmt.Errorf("duration_seconds must not be negative")
+	}
+	if options.DurationSeconds == 0 {
+		options.DurationSeconds = int(STRESS_DEFAULT_DURATION / time.Second)
+	}
+	if time.Duration(options.DurationSeconds)*time.Second > maxDuration {
+		return options, fmt.Errorf("duration_seconds exceeds the maximum of %d", int(maxDuration/time.Second))
+	}
+	options.Label = strings.TrimSpace(options.Label)
+	return options, nil
+}
+
+// RunStressTest generates load until the duration elapses or ctx is cancelled (reported as Stopped)
+func RunStressTest(ctx context.Context, options StressTestOptions, progress func(StressTestProgress)) (*StressTestResult, error) {
+	duration := time.Duration(options.DurationSeconds) * time.Second
+	result := &StressTestResult{
+		Kind:      options.Kind,
+		Label:     options.Label,
+		Threads:   options.Threads,
+		StartedAt: time.Now(),
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var toolErr error
+	switch options.Kind {
+	case StressKindCPU:
+		for i := 0; i < options.Threads; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				burnCPU(runCtx)
+			}()
+		}
+	case StressKindGPU:
+		cmd := exec.CommandContext(runCtx, options.GPUCommand, options.GPUArgs...)
+		cmd.WaitDelay = externalCommandWaitDelay
+		_, wait, err := commandRunner.Start(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start GPU load tool: %v", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := wait()
+			if runCtx.Err() == nil {
+				// 도구가 먼저 종료되면 부하 구간도 종료
+				if err == nil {
+					err = fmt.Errorf("exited before the test duration elapsed")
+				}
+				toolErr = fmt.Errorf("GPU load tool: %v", err)
+				cancel()
+			}
+		}()
+	default:
+		return nil, fmt.Errorf("unsupported stress test kind: %s", options.Kind)
+	}
+
+	ticker := time.NewTicker(STRESS_PROGRESS_EVERY)
+	defer ticker.Stop()
+	for running := true; running; {
+		select {
+		case <-runCtx.Done():
+			running = false
+		case <-ticker.C:
+			if progress != nil {
+				elapsed := time.Since(result.StartedAt)
+				progress(StressTestProgress{
+					Kind:             options.Kind,
+					ElapsedSeconds:   elapsed.Seconds(),
+					RemainingSeconds: math.Max(0, (duration - elapsed).Seconds()),
+				})
+			}
+		}
+	}
+	wg.Wait()
+
+	result.EndedAt = time.Now()
+	result.DurationSeconds = result.EndedAt.Sub(result.StartedAt).Seconds()
+	result.Stopped = ctx.Err() != nil
+	return result, toolErr
+}
+
+// burnCPU keeps one OS thread busy until ctx is done
+func burnCPU(ctx context.Context) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	x := 1.0
+	for {
+		for i := 0; i < stressBurnBatch; i++ {
+			x = math.Sqrt(x*x + 1.000001)
+			if x > 1e6 {
+				x = 1.0
+			}
+		}
+		select {
+		case <-ctx.Done():
+			stressSink.Store(math.Float64bits(x))
+			return
+		default:
+		}
+	}
+}
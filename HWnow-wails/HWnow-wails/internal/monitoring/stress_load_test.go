@@ -0,0 +1,46 @@
+package monitoring
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestStressLoad(t *testing.T) {
+	t.Run("Normalize_Options", func(t *testing.T) {
+		options, err := NormalizeStressTestOptions(StressTestOptions{Threads: 1000}, 0)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if options.Kind != StressKindCPU || options.Threads != runtime.NumCPU() || options.DurationSeconds != 60 {
+			t.Errorf("Unexpected defaults: %+v", options)
+		}
+
+		if _, err := NormalizeStressTestOptions(StressTestOptions{Kind: "gpu"}, 0); err == nil {
+			t.Error("Expected error for GPU load without a configured tool")
+		}
+		if _, err := NormalizeStressTestOptions(StressTestOptions{Kind: "disk"}, 0); err == nil {
+			t.Error("Expected error for unsupported kind")
+		}
+		if _, err := NormalizeStressTestOptions(StressTestOptions{DurationSeconds: 600}, 5*time.Minute); err == nil {
+			t.Error("Expected error for duration above the configured maximum")
+		}
+	})
+
+	t.Run("Stop_CPU_Load", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		result, err := RunStressTest(ctx, StressTestOptions{Kind: StressKindCPU, Threads: 1, DurationSeconds: 30}, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.Stopped {
+			t.Error("Expected run cancelled early to be reported as stopped")
+		}
+		if result.DurationSeconds > 5 {
+			t.Errorf("Load generator did not stop promptly: %.1fs", result.DurationSeconds)
+		}
+	})
+}
@@ -59,24 +59,25 @@ func (s *systemInfoProvider) GetCurrentPlatform() string {
 
 // GetBatteryInfo returns battery information
 func (s *systemInfoProvider) GetBatteryInfo() (*BatteryInfo, error) {
-	// Windows에서 배터리 정보를 WMI로 가져오기
-	if s.GetCurrentPlatform() == "windows" {
+	switch s.GetCurrentPlatform() {
+	case "windows":
+		// Windows에서 배터리 정보를 WMI로 가져오기
 		return s.getBatteryInfoWindows()
+	case "linux":
+		// Linux에서는 /sys/class/power_supply 사용
+		return getBatteryInfoLinux()
 	}
 
 	// 다른 플랫폼에서는 기본 구현
 	return &BatteryInfo{
-		Percent: 0,
-		Plugged: 1.0, // AC power
+		Percent:              0,
+		Plugged:              1.0, // AC power
+		HealthPercent:        -1,
+		TimeRemainingMinutes: -1,
 	}, nil
 }
 
 // getBatteryInfoWindows gets battery information on Windows using WMI
 func (s *systemInfoProvider) getBatteryInfoWindows() (*BatteryInfo, error) {
-	// WMI 쿼리를 사용한 배터리 정보 수집
-	// 실제 구현에서는 WMI 호출을 통해 배터리 정보를 가져옴
-	return &BatteryInfo{
-		Percent: 0,   // WMI 결과에 따라 설정
-		Plugged: 1.0, // AC power
-	}, nil
-}
\ No newline at end of file
+	return getBatteryStatusWindows()
+}
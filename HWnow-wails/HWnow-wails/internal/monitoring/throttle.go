@@ -0,0 +1,464 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CPU/GPU 열·전력 스로틀링 감지 및 코어별 동작 주파수
+// - Linux: cpufreq(scaling_cur_freq / cpuinfo_max_freq) + thermal_throttle 카운터 증가 여부 (Intel)
+// - Windows: Processor Information 성능 카운터(% Processor Performance, % Performance Limit) + Thermal Zone % Passive Limit
+// - NVIDIA: nvidia-smi clocks_throttle_reasons.active 비트마스크 (NVML), 유휴/애플리케이션 클럭 설정은 스로틀링으로 보지 않음
+// 스로틀링 시작/종료 이벤트와 누적 스로틀 시간은 ThrottleMonitor가 수집 주기마다 계산
+
+// Throttle reasons
+const (
+	ThrottleReasonThermal  = "thermal"  // 온도 한계 (Linux thermal_throttle, Windows passive cooling, NVIDIA thermal slowdown)
+	ThrottleReasonPower    = "power"    // 전력 한계 (Windows performance limit, NVIDIA power cap/power brake)
+	ThrottleReasonHardware = "hardware" // 하드웨어 감속 신호 (NVIDIA HW slowdown)
+)
+
+// Throttle event types
+const (
+	ThrottleEventStart = "start"
+	ThrottleEventEnd   = "end"
+)
+
+const THROTTLE_CACHE_DURATION = 2 * time.Second
+
+// NVIDIA clocks_throttle_reasons 비트 (nvml.h nvmlClocksThrottleReason*)
+const (
+	nvidiaThrottleSWPowerCap   = 0x4
+	nvidiaThrottleHWSlowdown   = 0x8
+	nvidiaThrottleSWThermal    = 0x20
+	nvidiaThrottleHWThermal    = 0x40
+	nvidiaThrottleHWPowerBrake = 0x80
+)
+
+const (
+	linuxCPUPath                 = "/sys/devices/system/cpu"
+	windowsPassiveLimitUnlimited = 100 // % Passive Limit < 100 = 열 영역이 수동 냉각(클럭 제한) 중
+)
+
+// CoreFrequency is the current clock of one logical CPU
+type CoreFrequency struct {
+	Core    int     `json:"core"`
+	MHz     float64 `json:"mhz"`
+	MaxMHz  float64 `json:"max_mhz"` // 최대(Linux) 또는 기본(Windows) 클럭 (-1 = 알 수 없음)
+	Percent float64 `json:"percent"` // MaxMHz 대비 현재 클럭 (%, 터보 시 100 초과 가능)
+}
+
+// ThrottleState is the throttle state of one device (cpu, gpu0, gpu1 ...)
+type ThrottleState struct {
+	Device           string   `json:"device"`
+	Throttled        bool     `json:"throttled"`
+	Reasons          []string `json:"reasons"`
+	ThrottledSeconds float64  `json:"throttled_seconds"` // 모니터링 시작 이후 누적 스로틀 시간
+}
+
+// ThrottleInfo summarizes CPU/GPU throttling and per-core clocks
+type ThrottleInfo struct {
+	Throttled        bool            `json:"throttled"`         // 하나 이상의 장치가 스로틀링 중
+	ThrottledSeconds float64         `json:"throttled_seconds"` // 어떤 장치든 스로틀링 중이었던 누적 시간
+	Devices          []ThrottleState `json:"devices"`
+	Cores            []CoreFrequency `json:"cores"`
+}
+
+// ThrottleEvent reports a device entering or leaving a throttled state
+type ThrottleEvent struct {
+	Device          string    `json:"device"`
+	Type            string    `json:"type"` // start, end
+	Reasons         []string  `json:"reasons"`
+	DurationSeconds float64   `json:"duration_seconds"` // end 이벤트에서 스로틀 지속 시간
+	Timestamp       time.Time `json:"timestamp"`
+}
+
+// ThrottleCache keeps the previous Linux throttle counters and the last reading
+type ThrottleCache struct {
+	mutex     sync.Mutex
+	counters  map[string]float64
+	info      *ThrottleInfo
+	timestamp time.Time
+}
+
+var throttleCache = &ThrottleCache{counters: make(map[string]float64)}
+
+// GetThrottleInfo returns the current CPU/GPU throttle state and per-core clocks
+// 누적 시간(ThrottledSeconds)은 ThrottleMonitor.Observe가 채움
+func GetThrottleInfo(ctx context.Context) (*ThrottleInfo, error) {
+	return queryWithContext(ctx, "GetThrottleInfo", getThrottleInfo)
+}
+
+func getThrottleInfo() (*ThrottleInfo, error) {
+	throttleCache.mutex.Lock()
+	defer throttleCache.mutex.Unlock()
+
+	if throttleCache.info == nil || time.Since(throttleCache.timestamp) >= THROTTLE_CACHE_DURATION {
+		info, err := readThrottleInfo()
+		if err != nil {
+			return nil, err
+		}
+		throttleCache.info = info
+		throttleCache.timestamp = time.Now()
+	}
+
+	// 호출자가 누적 시간을 기록하므로 복사본 반환
+	info := *throttleCache.info
+	info.Devices = make([]ThrottleState, len(throttleCache.info.Devices))
+	copy(info.Devices, throttleCache.info.Devices)
+	return &info, nil
+}
+
+// readThrottleInfo queries the platform CPU source and NVIDIA GPUs (caller holds the cache mutex)
+func readThrottleInfo() (*ThrottleInfo, error) {
+	info := &ThrottleInfo{Devices: []ThrottleState{}, Cores: []CoreFrequency{}}
+
+	var cpuErr error
+	switch runtime.GOOS {
+	case "linux":
+		var cpu ThrottleState
+		cpu, info.Cores, cpuErr = throttleCache.readLinuxCPU(linuxCPUPath)
+		if cpuErr == nil {
+			info.Devices = append(info.Devices, cpu)
+		}
+	case "windows":
+		var output []byte
+		output, cpuErr = createHiddenCommandWithTimeout("typeperf", 3,
+			`\Processor Information(*)\% Processor Performance`,
+			`\Processor Information(*)\Processor Frequency`,
+			`\Processor Information(_Total)\% Performance Limit`,
+			`\Thermal Zone Information(*)\% Passive Limit`,
+			"-sc", "1").Output()
+		if cpuErr == nil {
+			var cpu ThrottleState
+			cpu, info.Cores, cpuErr = parseWindowsThrottleCounters(output)
+			if cpuErr == nil {
+				info.Devices = append(info.Devices, cpu)
+			}
+		}
+	default:
+		cpuErr = fmt.Errorf("CPU throttle detection not supported on platform: %s", runtime.GOOS)
+	}
+
+	gpus, gpuErr := queryNVIDIAThrottle()
+	info.Devices = append(info.Devices, gpus...)
+
+	if cpuErr != nil && gpuErr != nil {
+		return nil, fmt.Errorf("no throttle source available: %v; %v", cpuErr, gpuErr)
+	}
+	for _, device := range info.Devices {
+		if device.Throttled {
+			info.Throttled = true
+		}
+	}
+	return info, nil
+}
+
+// readLinuxCPU reads per-core cpufreq clocks and checks whether any thermal_throttle counter increased
+func (c *ThrottleCache) readLinuxCPU(root string) (ThrottleState, []CoreFrequency, error) {
+	state := ThrottleState{Device: "cpu", Reasons: []string{}}
+	cpuDirs, err := filepath.Glob(filepath.Join(root, "cpu[0-9]*"))
+	if err != nil {
+		return state, nil, err
+	}
+
+	cores := []CoreFrequency{}
+	readable := false
+	for _, dir := range cpuDirs {
+		index, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "cpu"))
+		if err != nil {
+			continue
+		}
+
+		if khz, ok := readSysfsFloat(filepath.Join(dir, "cpufreq"), "scaling_cur_freq"); ok {
+			readable = true
+			core := CoreFrequency{Core: index, MHz: khz / 1000, MaxMHz: -1, Percent: -1}
+			if maxKHz, ok := readSysfsFloat(filepath.Join(dir, "cpufreq"), "cpuinfo_max_freq"); ok && maxKHz > 0 {
+				core.MaxMHz = maxKHz / 1000
+				core.Percent = math.Round(khz/maxKHz*1000) / 10
+			}
+			cores = append(cores, core)
+		}
+
+		// 카운터는 부팅 이후 누적 - 직전 샘플 대비 증가했으면 이번 주기에 스로틀링 발생
+		for _, counter := range []string{"core_throttle_count", "package_throttle_count"} {
+			value, ok := readSysfsFloat(filepath.Join(dir, "thermal_throttle"), counter)
+			if !ok {
+				continue
+			}
+			readable = true
+			key := filepath.Join(dir, counter)
+			if prev, seen := c.counters[key]; seen && value > prev {
+				state.Throttled = true
+			}
+			c.counters[key] = value
+		}
+	}
+
+	if !readable {
+		return state, nil, fmt.Errorf("no cpufreq or thermal_throttle data under %s", root)
+	}
+	if state.Throttled {
+		state.Reasons = append(state.Reasons, ThrottleReasonThermal)
+	}
+	sort.Slice(cores, func(i, j int) bool { return cores[i].Core < cores[j].Core })
+	return state, cores, nil
+}
+
+// parseWindowsThrottleCounters reads per-core clocks, the performance limit and thermal zone passive limits from typeperf output
+func parseWindowsThrottleCounters(output []byte) (ThrottleState, []CoreFrequency, error) {
+	state := ThrottleState{Device: "cpu", Reasons: []string{}}
+	header, values, err := parseTypeperfSample(output)
+	if err != nil {
+		return state, nil, err
+	}
+
+	performance := map[string]float64{}
+	frequency := map[string]float64{}
+	found := false
+	for i := 1; i < len(header) && i < len(values); i++ {
+		value, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			continue
+		}
+		counter := strings.ToLower(header[i])
+		instance := typeperfInstance(counter)
+		switch {
+		case strings.HasSuffix(counter, `\% processor performance`):
+			performance[instance] = value
+		case strings.HasSuffix(counter, `\processor frequency`):
+			frequency[instance] = value
+		case strings.HasSuffix(counter, `\% performance limit`):
+			found = true
+			if value > 0 {
+				state.Reasons = appendReason(state.Reasons, ThrottleReasonPower)
+			}
+		case strings.HasSuffix(counter, `\% passive limit`):
+			found = true
+			if value < windowsPassiveLimitUnlimited {
+				state.Reasons = appendReason(state.Reasons, ThrottleReasonThermal)
+			}
+		}
+	}
+	if !found {
+		return state, nil, fmt.Errorf("processor throttle counters not found")
+	}
+	state.Throttled = len(state.Reasons) > 0
+
+	// 인스턴스 이름은 "<그룹>,<번호>" - _Total 및 그룹 합계("0,_Total") 제외
+	cores := []CoreFrequency{}
+	for instance, base := range frequency {
+		parts := strings.Split(instance, ",")
+		if len(parts) != 2 {
+			continue
+		}
+		group, errGroup := strconv.Atoi(parts[0])
+		index, errIndex := strconv.Atoi(parts[1])
+		if errGroup != nil || errIndex != nil {
+			continue
+		}
+		percent, ok := performance[instance]
+		if !ok {
+			continue
+		}
+		cores = append(cores, CoreFrequency{
+			Core:    group*64 + index,
+			MHz:     math.Round(base * percent / 100),
+			MaxMHz:  base,
+			Percent: math.Round(percent*10) / 10,
+		})
+	}
+	sort.Slice(cores, func(i, j int) bool { return cores[i].Core < cores[j].Core })
+	return state, cores, nil
+}
+
+// typeperfInstance extracts the instance name from a counter path such as \\HOST\Processor Information(0,3)\% Processor Performance
+func typeperfInstance(counter string) string {
+	start := strings.Index(counter, "(")
+	end := strings.LastIndex(counter, ")")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return counter[start+1 : end]
+}
+
+// queryNVIDIAThrottle reads the active clock throttle reasons of every NVIDIA GPU
+func queryNVIDIAThrottle() ([]ThrottleState, error) {
+	nvidiaSMIPath := getCachedNVIDIASMIPath()
+	if nvidiaSMIPath == "" {
+		return nil, fmt.Errorf("nvidia-smi not found")
+	}
+
+	output, err := createHiddenCommandWithTimeout(nvidiaSMIPath, 5,
+		"--query-gpu=index,clocks_throttle_reasons.active", "--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi throttle query failed: %v", err)
+	}
+	return parseNVIDIAThrottle(string(output))
+}
+
+// parseNVIDIAThrottle parses "index, 0x0000000000000004" lines into throttle states
+func parseNVIDIAThrottle(output string) ([]ThrottleState, error) {
+	var states []ThrottleState
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 2 {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+		if err != nil {
+			continue
+		}
+		mask, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(fields[1]), "0x"), 16, 64)
+		if err != nil {
+			continue // [Not Supported]
+		}
+
+		state := ThrottleState{Device: fmt.Sprintf("gpu%d", index), Reasons: []string{}}
+		if mask&(nvidiaThrottleSWThermal|nvidiaThrottleHWThermal) != 0 {
+			state.Reasons = append(state.Reasons, ThrottleReasonThermal)
+		}
+		if mask&(nvidiaThrottleSWPowerCap|nvidiaThrottleHWPowerBrake) != 0 {
+			state.Reasons = append(state.Reasons, ThrottleReasonPower)
+		}
+		if mask&nvidiaThrottleHWSlowdown != 0 {
+			state.Reasons = append(state.Reasons, ThrottleReasonHardware)
+		}
+		state.Throttled = len(state.Reasons) > 0
+		states = append(states, state)
+	}
+	if len(states) == 0 {
+		return nil, fmt.Errorf("no NVIDIA throttle reasons reported")
+	}
+	return states, nil
+}
+
+// appendReason adds a reason once
+func appendReason(reasons []string, reason string) []string {
+	for _, existing := range reasons {
+		if existing == reason {
+			return reasons
+		}
+	}
+	return append(reasons, reason)
+}
+
+// ThrottleMonitor tracks throttled time per device and reports throttle start/end transitions
+type ThrottleMonitor struct {
+	mutex     sync.Mutex
+	since     map[string]time.Time // 장치별 현재 스로틀 시작 시각
+	reasons   map[string][]string
+	total     map[string]float64 // 장치별 누적 스로틀 시간 (초)
+	anyTotal  float64
+	lastCheck time.Time
+	anyActive bool
+}
+
+// NewThrottleMonitor creates a monitor with no devices throttled
+func NewThrottleMonitor() *ThrottleMonitor {
+	return &ThrottleMonitor{
+		since:   make(map[string]time.Time),
+		reasons: make(map[string][]string),
+		total:   make(map[string]float64),
+	}
+}
+
+// Observe accumulates throttled time since the previous observation, fills in ThrottledSeconds
+// and returns events for devices whose throttle state changed
+// 직전 관찰에서 스로틀링 중이었던 장치에 경과 시간을 더함 (수집 주기 단위 근사)
+func (m *ThrottleMonitor) Observe(info *ThrottleInfo, now time.Time) []ThrottleEvent {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	elapsed := 0.0
+	if !m.lastCheck.IsZero() {
+		elapsed = now.Sub(m.lastCheck).Seconds()
+	}
+	m.lastCheck = now
+	for device := range m.since {
+		m.total[device] += elapsed
+	}
+	if m.anyActive {
+		m.anyTotal += elapsed
+	}
+
+	var events []ThrottleEvent
+	for i := range info.Devices {
+		device := &info.Devices[i]
+		start, active := m.since[device.Device]
+		switch {
+		case device.Throttled && !active:
+			m.since[device.Device] = now
+			m.reasons[device.Device] = device.Reasons
+			events = append(events, ThrottleEvent{Device: device.Device, Type: ThrottleEventStart, Reasons: device.Reasons, Timestamp: now})
+		case device.Throttled:
+			for _, reason := range device.Reasons {
+				m.reasons[device.Device] = appendReason(m.reasons[device.Device], reason)
+			}
+		case active:
+			events = append(events, ThrottleEvent{
+				Device:          device.Device,
+				Type:            ThrottleEventEnd,
+				Reasons:         m.reasons[device.Device],
+				DurationSeconds: math.Round(now.Sub(start).Seconds()*10) / 10,
+				Timestamp:       now,
+			})
+			delete(m.since, device.Device)
+			delete(m.reasons, device.Device)
+		}
+		device.ThrottledSeconds = math.Round(m.total[device.Device]*10) / 10
+	}
+
+	m.anyActive = info.Throttled
+	info.ThrottledSeconds = math.Round(m.anyTotal*10) / 10
+	return events
+}
+
+// ThrottleMetrics converts throttle state into resource log metrics
+func ThrottleMetrics(info *ThrottleInfo) []Metric {
+	if info == nil {
+		return nil
+	}
+
+	var metrics []Metric
+	gpuThrottled, hasGPU := 0.0, false
+	for _, device := range info.Devices {
+		value := 0.0
+		if device.Throttled {
+			value = 1
+		}
+		if device.Device == "cpu" {
+			metrics = append(metrics, Metric{Type: "cpu_throttled", Value: value, Info: strings.Join(device.Reasons, ",")})
+			continue
+		}
+		hasGPU = true
+		if value > gpuThrottled {
+			gpuThrottled = value
+		}
+	}
+	if hasGPU {
+		metrics = append(metrics, Metric{Type: "gpu_throttled", Value: gpuThrottled})
+	}
+	metrics = append(metrics, Metric{Type: "throttled_seconds", Value: info.ThrottledSeconds})
+
+	// 코어 평균 클럭 비율 (최대 클럭을 알 수 있는 코어만)
+	total, count := 0.0, 0
+	for _, core := range info.Cores {
+		if core.Percent >= 0 {
+			total += core.Percent
+			count++
+		}
+	}
+	if count > 0 {
+		metrics = append(metrics, Metric{Type: "cpu_frequency_percent", Value: math.Round(total/float64(count)*10) / 10})
+	}
+	return metrics
+}
@@ -0,0 +1,126 @@
+package monitoring
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestThrottleCacheReadLinuxCPU(t *testing.T) {
+	root := t.TempDir()
+	writeHwmonFixture(t, filepath.Join(root, "cpu0"), "cpufreq", map[string]string{"scaling_cur_freq": "2400000", "cpuinfo_max_freq": "4800000"})
+	writeHwmonFixture(t, filepath.Join(root, "cpu0"), "thermal_throttle", map[string]string{"core_throttle_count": "12", "package_throttle_count": "3"})
+	writeHwmonFixture(t, filepath.Join(root, "cpu1"), "cpufreq", map[string]string{"scaling_cur_freq": "4800000", "cpuinfo_max_freq": "4800000"})
+
+	cache := &ThrottleCache{counters: make(map[string]float64)}
+	state, cores, err := cache.readLinuxCPU(root)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if state.Throttled {
+		t.Error("Expected the first read to only record the counter baseline")
+	}
+	if len(cores) != 2 || cores[0].MHz != 2400 || cores[0].Percent != 50 || cores[1].Percent != 100 {
+		t.Errorf("Unexpected cores: %+v", cores)
+	}
+
+	// 카운터가 증가하면 열 스로틀링
+	writeHwmonFixture(t, filepath.Join(root, "cpu0"), "thermal_throttle", map[string]string{"core_throttle_count": "15", "package_throttle_count": "3"})
+	state, _, _ = cache.readLinuxCPU(root)
+	if !state.Throttled || len(state.Reasons) != 1 || state.Reasons[0] != ThrottleReasonThermal {
+		t.Errorf("Expected thermal throttling, got %+v", state)
+	}
+
+	state, _, _ = cache.readLinuxCPU(root)
+	if state.Throttled {
+		t.Errorf("Expected throttling to end when counters stop increasing, got %+v", state)
+	}
+
+	if _, _, err := cache.readLinuxCPU(t.TempDir()); err == nil {
+		t.Error("Expected an error without cpufreq data")
+	}
+}
+
+func TestParseWindowsThrottleCounters(t *testing.T) {
+	output := []byte(`"(PDH-CSV 4.0)","\\PC\Processor Information(0,0)\% Processor Performance","\\PC\Processor Information(0,1)\% Processor Performance","\\PC\Processor Information(_Total)\% Processor Performance","\\PC\Processor Information(0,0)\Processor Frequency","\\PC\Processor Information(0,1)\Processor Frequency","\\PC\Processor Information(_Total)\% Performance Limit","\\PC\Thermal Zone Information(\_TZ.THRM)\% Passive Limit"
+"10/16/2026 12:00:00.000","120.5","60.0","90.2","3000","3000","25","100"
+Exiting, please wait...
+`)
+	state, cores, err := parseWindowsThrottleCounters(output)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !state.Throttled || len(state.Reasons) != 1 || state.Reasons[0] != ThrottleReasonPower {
+		t.Errorf("Expected power throttling, got %+v", state)
+	}
+	if len(cores) != 2 || cores[0].MHz != 3615 || cores[0].Percent != 120.5 || cores[1].Core != 1 || cores[1].MHz != 1800 {
+		t.Errorf("Unexpected cores: %+v", cores)
+	}
+
+	if _, _, err := parseWindowsThrottleCounters([]byte(`"(PDH-CSV 4.0)","\\PC\Processor Information(0,0)\Processor Frequency"
+"10/16/2026 12:00:00.000","3000"
+`)); err == nil {
+		t.Error("Expected an error without limit counters")
+	}
+}
+
+func TestParseNVIDIAThrottle(t *testing.T) {
+	states, err := parseNVIDIAThrottle("0, 0x0000000000000001\n1, 0x0000000000000064\n2, [Not Supported]\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(states) != 2 {
+		t.Fatalf("Expected 2 GPUs, got %+v", states)
+	}
+	if states[0].Device != "gpu0" || states[0].Throttled {
+		t.Errorf("Expected GPU idle not to count as throttling, got %+v", states[0])
+	}
+	// 0x64 = SW power cap | SW thermal | HW thermal
+	if !states[1].Throttled || len(states[1].Reasons) != 2 || states[1].Reasons[0] != ThrottleReasonThermal || states[1].Reasons[1] != ThrottleReasonPower {
+		t.Errorf("Unexpected reasons: %+v", states[1])
+	}
+}
+
+func TestThrottleMonitorObserve(t *testing.T) {
+	monitor := NewThrottleMonitor()
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	observe := func(offset time.Duration, cpu, gpu bool) (*ThrottleInfo, []ThrottleEvent) {
+		info := &ThrottleInfo{Throttled: cpu || gpu, Devices: []ThrottleState{
+			{Device: "cpu", Throttled: cpu, Reasons: []string{ThrottleReasonThermal}},
+			{Device: "gpu0", Throttled: gpu, Reasons: []string{ThrottleReasonPower}},
+		}}
+		return info, monitor.Observe(info, start.Add(offset))
+	}
+
+	if _, events := observe(0, false, false); len(events) != 0 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+	_, events := observe(2*time.Second, true, false)
+	if len(events) != 1 || events[0].Device != "cpu" || events[0].Type != ThrottleEventStart {
+		t.Fatalf("Expected CPU throttle start, got %+v", events)
+	}
+	observe(4*time.Second, true, true)
+	info, events := observe(6*time.Second, false, true)
+	if len(events) != 1 || events[0].Type != ThrottleEventEnd || events[0].DurationSeconds != 4 {
+		t.Fatalf("Expected CPU throttle end after 4 s, got %+v", events)
+	}
+	if info.Devices[0].ThrottledSeconds != 4 || info.Devices[1].ThrottledSeconds != 2 || info.ThrottledSeconds != 4 {
+		t.Errorf("Unexpected throttled time: %+v (total %v)", info.Devices, info.ThrottledSeconds)
+	}
+
+	metrics := ThrottleMetrics(&ThrottleInfo{
+		Devices:          info.Devices,
+		ThrottledSeconds: info.ThrottledSeconds,
+		Cores:            []CoreFrequency{{Percent: 50}, {Percent: 100}, {Percent: -1}},
+	})
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		values[metric.Type] = metric.Value
+	}
+	if values["cpu_throttled"] != 0 || values["gpu_throttled"] != 1 || values["throttled_seconds"] != 4 || values["cpu_frequency_percent"] != 75 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+	if MetricUnit("throttled_seconds") != UnitSeconds || MetricUnit("gpu_throttled") != UnitBoolean {
+		t.Error("Unexpected throttle metric units")
+	}
+}
@@ -0,0 +1,99 @@
+package monitoring
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// 사용자 계정별 자원 사용량 집계 (공용 워크스테이션에서 누가 자원을 쓰는지 확인)
+// CPU/메모리는 전체 프로세스 목록, GPU 메모리/사용률은 GPU 프로세스 목록을 PID로 연결하여 합산
+
+const unknownUsername = "unknown"
+
+// UserUsage aggregates the resource usage of all processes owned by a user account
+type UserUsage struct {
+	Username      string  `json:"username"` // 소유자를 조회할 수 없는 프로세스는 "unknown"
+	ProcessCount  int     `json:"process_count"`
+	CPUPercent    float64 `json:"cpu_percent"`    // 프로세스 CPU 사용률 합계 (%)
+	MemoryPercent float64 `json:"memory_percent"` // 물리 메모리 사용률 합계 (%)
+	MemoryRSS     float64 `json:"memory_rss"`     // 상주 메모리 합계 (MB)
+	GPUUsage      float64 `json:"gpu_usage"`      // GPU 사용률 합계 (%)
+	GPUMemory     float64 `json:"gpu_memory"`     // GPU 메모리 합계 (MB)
+}
+
+// GetUserUsage returns resource usage per owning user account, sorted by CPU usage
+func GetUserUsage(ctx context.Context) ([]UserUsage, error) {
+	return queryWithContext(ctx, "GetUserUsage", getUserUsage)
+}
+
+func getUserUsage() ([]UserUsage, error) {
+	details, err := getCachedProcessDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	// GPU 정보가 없는 환경에서도 CPU/메모리 집계는 제공
+	gpuProcesses, err := getGPUProcessesFromMonitor()
+	if err != nil {
+		LogDebug("GPU processes not available for user usage", "error", err)
+	}
+
+	return aggregateUserUsage(details, gpuProcesses), nil
+}
+
+// aggregateUserUsage sums process metrics by username
+func aggregateUserUsage(details []ProcessDetail, gpuProcesses []GPUProcess) []UserUsage {
+	usage := make(map[string]*UserUsage)
+	owners := make(map[int32]string, len(details))
+
+	for _, detail := range details {
+		username := normalizeUsername(detail.Username)
+		owners[detail.PID] = username
+
+		entry, ok := usage[username]
+		if !ok {
+			entry = &UserUsage{Username: username}
+			usage[username] = entry
+		}
+		entry.ProcessCount++
+		entry.CPUPercent += detail.CPUPercent
+		entry.MemoryPercent += detail.MemoryPercent
+		entry.MemoryRSS += detail.MemoryRSS
+	}
+
+	for _, gpuProcess := range gpuProcesses {
+		username, ok := owners[gpuProcess.PID]
+		if !ok {
+			username = unknownUsername
+		}
+		entry, ok := usage[username]
+		if !ok {
+			entry = &UserUsage{Username: username}
+			usage[username] = entry
+		}
+		entry.GPUUsage += gpuProcess.GPUUsage
+		entry.GPUMemory += gpuProcess.GPUMemory
+	}
+
+	result := make([]UserUsage, 0, len(usage))
+	for _, entry := range usage {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CPUPercent != result[j].CPUPercent {
+			return result[i].CPUPercent > result[j].CPUPercent
+		}
+		return result[i].Username < result[j].Username
+	})
+	return result
+}
+
+// normalizeUsername maps empty owners to "unknown" (Windows reports DOMAIN\user, which is kept as is)
+func normalizeUsername(username string) string {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return unknownUsername
+	}
+	return username
+}
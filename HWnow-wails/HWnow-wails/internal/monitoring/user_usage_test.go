@@ -0,0 +1,42 @@
+package monitoring
+
+import "testing"
+
+func TestAggregateUserUsage(t *testing.T) {
+	details := []ProcessDetail{
+		{PID: 1, Name: "python.exe", Username: `LAB\alice`, CPUPercent: 40, MemoryRSS: 2048, MemoryPercent: 6.25},
+		{PID: 2, Name: "chrome.exe", Username: `LAB\alice`, CPUPercent: 5, MemoryRSS: 512, MemoryPercent: 1.5},
+		{PID: 3, Name: "blender.exe", Username: `LAB\bob`, CPUPercent: 60, MemoryRSS: 4096, MemoryPercent: 12.5},
+		{PID: 4, Name: "csrss.exe", CPUPercent: 0.5, MemoryRSS: 8},
+	}
+	gpuProcesses := []GPUProcess{
+		{PID: 1, GPUUsage: 80, GPUMemory: 6000},
+		{PID: 3, GPUUsage: 10, GPUMemory: 1500},
+		{PID: 99, GPUUsage: 1, GPUMemory: 100}, // 프로세스 목록 갱신 이전에 시작된 프로세스
+	}
+
+	usage := aggregateUserUsage(details, gpuProcesses)
+	if len(usage) != 3 {
+		t.Fatalf("Expected 3 users, got %+v", usage)
+	}
+
+	t.Run("Sorted_By_CPU", func(t *testing.T) {
+		if usage[0].Username != `LAB\bob` || usage[1].Username != `LAB\alice` || usage[2].Username != "unknown" {
+			t.Errorf("Unexpected order: %+v", usage)
+		}
+	})
+
+	t.Run("Sums", func(t *testing.T) {
+		alice := usage[1]
+		if alice.ProcessCount != 2 || alice.CPUPercent != 45 || alice.MemoryRSS != 2560 || alice.GPUMemory != 6000 || alice.GPUUsage != 80 {
+			t.Errorf("Unexpected totals for alice: %+v", alice)
+		}
+	})
+
+	t.Run("Unknown_Owner", func(t *testing.T) {
+		unknown := usage[2]
+		if unknown.ProcessCount != 1 || unknown.GPUMemory != 100 {
+			t.Errorf("Unexpected totals for unknown owner: %+v", unknown)
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package monitoring
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 위젯별 데이터 집계
+// 각 위젯이 필요한 형태(현재 값 + 최근 N개 스파크라인 + 보조 값)를 서버에서 링 버퍼로부터 계산
+// 프론트엔드가 전체 메트릭 목록을 받아 직접 필터링/집계하지 않도록 함
+
+const (
+	DEFAULT_WIDGET_POINTS = 60
+	MAX_WIDGET_POINTS     = 300
+)
+
+// ErrUnsupportedWidget is returned for widget types without server-side aggregated data
+var ErrUnsupportedWidget = errors.New("unsupported widget type")
+
+// WidgetValue is a secondary value shown by a widget (e.g. per-core usage, GPU temperature)
+type WidgetValue struct {
+	Metric string  `json:"metric"`
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	Info   string  `json:"info,omitempty"`
+}
+
+// WidgetData is the data a widget renders: the current value, its sparkline and secondary values
+type WidgetData struct {
+	Type      string         `json:"type"`
+	Metric    string         `json:"metric"` // 주 메트릭 유형
+	Timestamp time.Time      `json:"timestamp"`
+	Available bool           `json:"available"` // 주 메트릭이 아직 수집되지 않았으면 false
+	Value     float64        `json:"value"`
+	Unit      string         `json:"unit,omitempty"`
+	Info      string         `json:"info,omitempty"`
+	Min       float64        `json:"min"` // 스파크라인 구간 최솟값
+	Max       float64        `json:"max"`
+	Avg       float64        `json:"avg"`
+	Sparkline []MetricSample `json:"sparkline"`
+	Values    []WidgetValue  `json:"values,omitempty"`
+}
+
+// widgetSpec lists the metrics a widget type is built from
+type widgetSpec struct {
+	primary  string
+	extras   []string
+	prefixes []string // 코어/어댑터/경로별 메트릭
+}
+
+var widgetSpecs = map[string]widgetSpec{
+	"cpu": {
+		primary: "cpu",
+		extras: []string{"cpu_temperature", "cpu_user", "cpu_system", "cpu_iowait", "load_1", "cpu_queue_length",
+			"rapl_package_watts", "rapl_core_watts", "rapl_dram_watts", "cpu_frequency_percent", "cpu_throttled"},
+		prefixes: []string{"cpu_core_"},
+	},
+	"ram": {
+		primary: "ram",
+		extras:  []string{"memory_available", "memory_cached", "memory_commit_percent"},
+	},
+	"memory_detail": {
+		primary: "ram",
+		extras: []string{"memory_available", "memory_cached", "memory_buffers", "memory_standby", "memory_commit",
+			"memory_commit_percent", "memory_hard_faults_per_sec", "swap_in_bytes_per_sec", "swap_out_bytes_per_sec"},
+	},
+	"disk_read":  {primary: "disk_read"},
+	"disk_write": {primary: "disk_write"},
+	"disk_space": {
+		primary:  "disk_usage_percent",
+		prefixes: []string{"disk_usage_percent_", "disk_temp_"},
+	},
+	"net_sent": {primary: "net_sent"},
+	"net_recv": {primary: "net_recv"},
+	"gpu": {
+		primary: "gpu_usage",
+		extras: []string{"gpu_memory_used", "gpu_temperature", "gpu_power", "gpu_memory_controller_percent",
+			"gpu_encoder_percent", "gpu_decoder_percent", "gpu_pcie_rx_bytes_per_sec", "gpu_pcie_tx_bytes_per_sec", "gpu_throttled"},
+		prefixes: []string{"gpu_engine_", "gpu_adapter_"},
+	},
+	"battery": {
+		primary: "battery_percent",
+		extras:  []string{"system_power_watts"},
+	},
+}
+
+// WidgetTypes returns the widget types with server-side aggregated data
+func WidgetTypes() []string {
+	types := make([]string, 0, len(widgetSpecs))
+	for widgetType := range widgetSpecs {
+		types = append(types, widgetType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// BuildWidgetData aggregates the buffered samples a widget needs, converted to the preferred units
+// points는 스파크라인 샘플 수 (0 = DEFAULT_WIDGET_POINTS, 최대 MAX_WIDGET_POINTS)
+func BuildWidgetData(buffer *MetricBuffer, widgetType string, points int, units UnitPreferences) (*WidgetData, error) {
+	spec, ok := widgetSpecs[widgetType]
+	if !ok {
+		return nil, ErrUnsupportedWidget
+	}
+	if points <= 0 {
+		points = DEFAULT_WIDGET_POINTS
+	}
+	if points > MAX_WIDGET_POINTS {
+		points = MAX_WIDGET_POINTS
+	}
+
+	data := &WidgetData{
+		Type:      widgetType,
+		Metric:    spec.primary,
+		Unit:      units.TargetUnit(MetricUnit(spec.primary)),
+		Sparkline: []MetricSample{},
+	}
+
+	if latest, timestamp, ok := buffer.Latest(spec.primary); ok {
+		data.Available = true
+		data.Timestamp = timestamp
+		data.Value, data.Unit = units.ConvertValue(spec.primary, latest.Value)
+		data.Info = latest.Info
+	}
+
+	samples := buffer.Samples(spec.primary, points)
+	for i, sample := range samples {
+		sample.Value, _ = units.ConvertValue(spec.primary, sample.Value)
+		data.Sparkline = append(data.Sparkline, sample)
+		if i == 0 || sample.Value < data.Min {
+			data.Min = sample.Value
+		}
+		if i == 0 || sample.Value > data.Max {
+			data.Max = sample.Value
+		}
+		data.Avg += sample.Value
+	}
+	if len(samples) > 0 {
+		data.Avg /= float64(len(samples))
+	}
+
+	for _, metricType := range widgetExtraMetrics(buffer, spec) {
+		latest, timestamp, ok := buffer.Latest(metricType)
+		// 사라진 장치(분리된 디스크 등)의 오래된 값은 제외
+		if !ok || (data.Available && timestamp.Before(data.Timestamp)) {
+			continue
+		}
+		value, unit := units.ConvertValue(metricType, latest.Value)
+		data.Values = append(data.Values, WidgetValue{Metric: metricType, Value: value, Unit: unit, Info: latest.Info})
+	}
+	return data, nil
+}
+
+// widgetExtraMetrics returns the widget's secondary metric types in display order
+func widgetExtraMetrics(buffer *MetricBuffer, spec widgetSpec) []string {
+	metricTypes := append([]string{}, spec.extras...)
+	if len(spec.prefixes) == 0 {
+		return metricTypes
+	}
+
+	buffered := buffer.MetricTypes()
+	for _, prefix := range spec.prefixes {
+		var matched []string
+		for _, metricType := range buffered {
+			if strings.HasPrefix(metricType, prefix) {
+				matched = append(matched, metricType)
+			}
+		}
+		// cpu_core_2가 cpu_core_10보다 앞에 오도록 길이 우선 정렬
+		sort.Slice(matched, func(i, j int) bool {
+			if len(matched[i]) != len(matched[j]) {
+				return len(matched[i]) < len(matched[j])
+			}
+			return matched[i] < matched[j]
+		})
+		metricTypes = append(metricTypes, matched...)
+	}
+	return metricTypes
+}
@@ -0,0 +1,114 @@
+package monitoring
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMetricBufferWraps(t *testing.T) {
+	buffer := &MetricBuffer{size: 3, rings: make(map[string]*metricRing)}
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		buffer.Add(&ResourceSnapshot{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Metrics:   []Metric{{Type: "cpu", Value: float64(i)}},
+		})
+	}
+
+	samples := buffer.Samples("cpu", 0)
+	if len(samples) != 3 || samples[0].Value != 2 || samples[2].Value != 4 {
+		t.Fatalf("Expected the last 3 samples oldest first, got %+v", samples)
+	}
+	if samples := buffer.Samples("cpu", 2); len(samples) != 2 || samples[0].Value != 3 {
+		t.Errorf("Expected the last 2 samples, got %+v", samples)
+	}
+	if latest, _, ok := buffer.Latest("cpu"); !ok || latest.Value != 4 {
+		t.Errorf("Expected latest value 4, got %+v (%v)", latest, ok)
+	}
+	if _, _, ok := buffer.Latest("ram"); ok {
+		t.Error("Expected no value for an unbuffered metric")
+	}
+}
+
+func TestMetricBufferRecent(t *testing.T) {
+	buffer := NewMetricBuffer(time.Minute)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	for _, age := range []time.Duration{90 * time.Second, 50 * time.Second, 20 * time.Second, 5 * time.Second} {
+		buffer.Add(&ResourceSnapshot{
+			Timestamp: now.Add(-age),
+			Metrics:   []Metric{{Type: "gpu_temperature", Value: 60}},
+		})
+	}
+
+	recent := buffer.Recent("gpu_temperature", 30*time.Second, now, UnitPreferences{Temperature: UnitFahrenheit})
+	if len(recent.Samples) != 2 || recent.Seconds != 30 || recent.Unit != UnitFahrenheit || recent.Samples[0].Value != 140 {
+		t.Errorf("Unexpected recent samples: %+v", recent)
+	}
+
+	// 보관 기간보다 긴 구간은 보관 기간으로 제한
+	recent = buffer.Recent("gpu_temperature", time.Hour, now, UnitPreferences{})
+	if len(recent.Samples) != 3 || recent.Seconds != 60 {
+		t.Errorf("Expected window capped at retention, got %+v", recent)
+	}
+
+	if recent := buffer.Recent("unknown", 0, now, UnitPreferences{}); len(recent.Samples) != 0 {
+		t.Errorf("Expected no samples for an unknown metric, got %+v", recent)
+	}
+}
+
+func TestBuildWidgetData(t *testing.T) {
+	buffer := NewMetricBuffer(time.Minute)
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	for i, usage := range []float64{10, 30, 20} {
+		buffer.Add(&ResourceSnapshot{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Metrics: []Metric{
+				{Type: "cpu", Value: usage},
+				{Type: "cpu_core_10", Value: usage},
+				{Type: "cpu_core_2", Value: usage},
+				{Type: "cpu_temperature", Value: 50},
+				{Type: "net_recv", Value: 1250000},
+			},
+		})
+	}
+
+	t.Run("Gauge_And_Sparkline", func(t *testing.T) {
+		data, err := BuildWidgetData(buffer, "cpu", 2, UnitPreferences{Temperature: UnitFahrenheit})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !data.Available || data.Value != 20 || data.Unit != UnitPercent {
+			t.Errorf("Unexpected current value: %+v", data)
+		}
+		if len(data.Sparkline) != 2 || data.Min != 20 || data.Max != 30 || data.Avg != 25 {
+			t.Errorf("Unexpected sparkline aggregation: %+v", data)
+		}
+		if len(data.Values) != 3 || data.Values[0].Metric != "cpu_temperature" || data.Values[0].Value != 122 {
+			t.Fatalf("Unexpected secondary values: %+v", data.Values)
+		}
+		if data.Values[1].Metric != "cpu_core_2" || data.Values[2].Metric != "cpu_core_10" {
+			t.Errorf("Expected cores in numeric order, got %+v", data.Values)
+		}
+	})
+
+	t.Run("Preferred_Units", func(t *testing.T) {
+		data, _ := BuildWidgetData(buffer, "net_recv", 0, UnitPreferences{DataRate: UnitMbitPerSecond})
+		if data.Value != 10 || data.Unit != UnitMbitPerSecond || data.Sparkline[0].Value != 10 {
+			t.Errorf("Expected values in Mbit/s, got %+v", data)
+		}
+	})
+
+	t.Run("Not_Collected_Yet", func(t *testing.T) {
+		data, err := BuildWidgetData(buffer, "gpu", 0, UnitPreferences{})
+		if err != nil || data.Available || len(data.Sparkline) != 0 {
+			t.Errorf("Expected empty widget data, got %+v (%v)", data, err)
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		if _, err := BuildWidgetData(buffer, "system_log", 0, UnitPreferences{}); !errors.Is(err, ErrUnsupportedWidget) {
+			t.Errorf("Expected ErrUnsupportedWidget, got %v", err)
+		}
+	})
+}
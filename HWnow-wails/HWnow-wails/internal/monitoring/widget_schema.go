@@ -0,0 +1,521 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 위젯 상태 스키마 검증 및 버전별 마이그레이션
+// 위젯 상태(config/layout)는 프론트엔드가 보낸 JSON 문자열 그대로 저장되므로 잘못된 값이 저장되면 대시보드 전체가 로드되지 않을 수 있음
+// 위젯 유형마다 스키마(알려진 설정 키와 값 형식)를 등록해 저장 전에 검증하고, 이전 버전으로 저장된 상태는 조회/저장 시 현재 버전으로 변환
+// 알 수 없는 설정 키는 프론트엔드의 확장 설정일 수 있으므로 거부하지 않고 경고만 남김
+
+const (
+	// WIDGET_STATE_VERSION is the widget state format written by this build
+	WIDGET_STATE_VERSION = 2
+
+	MAX_WIDGET_CONFIG_BYTES = 16 * 1024
+	MAX_WIDGET_LAYOUT_BYTES = 8 * 1024
+	MAX_WIDGET_CONFIG_KEYS  = 128
+	MAX_WIDGETS_PER_PAGE    = 200
+)
+
+// Widget config field kinds
+const (
+	WidgetFieldBoolean = "boolean"
+	WidgetFieldNumber  = "number"
+	WidgetFieldString  = "string"
+)
+
+// ErrInvalidWidgetState is returned for widget states that fail schema validation
+var ErrInvalidWidgetState = errors.New("invalid widget state")
+
+// WidgetStateError describes which part of a widget state was rejected and why
+type WidgetStateError struct {
+	WidgetType string
+	Field      string // widget_type | version | config | config.<key> | layout | layout.<breakpoint>
+	Reason     string
+}
+
+func (e *WidgetStateError) Error() string {
+	return fmt.Sprintf("invalid widget state (%s): %s %s", e.WidgetType, e.Field, e.Reason)
+}
+
+func (e *WidgetStateError) Is(target error) bool {
+	return target == ErrInvalidWidgetState
+}
+
+// WidgetConfigField describes the value allowed for one config key
+type WidgetConfigField struct {
+	Kind   string   `json:"kind"`             // boolean | number | string
+	Values []string `json:"values,omitempty"` // 허용되는 문자열 값 (비어 있으면 제한 없음)
+	Min    *float64 `json:"min,omitempty"`
+	Max    *float64 `json:"max,omitempty"`
+}
+
+// WidgetSchema lists the known config keys of a widget type
+type WidgetSchema struct {
+	Type           string                       `json:"type"`
+	Fields         map[string]WidgetConfigField `json:"fields"`
+	MaxConfigBytes int                          `json:"max_config_bytes"` // 0 = MAX_WIDGET_CONFIG_BYTES
+}
+
+// WidgetStateDocument is a widget's parsed config and layout, the unit widget state migrations operate on
+type WidgetStateDocument struct {
+	Type   string
+	Config map[string]interface{}
+	Layout map[string]interface{}
+}
+
+// WidgetStateMigration converts a widget state to Version; Migrate must leave states already in that format unchanged
+// and reports whether it changed the document
+type WidgetStateMigration struct {
+	Version     int
+	Description string
+	Migrate     func(doc *WidgetStateDocument) bool
+}
+
+// WidgetStateResult is a widget state validated and converted to the current version
+type WidgetStateResult struct {
+	Config   string   `json:"config"`
+	Layout   string   `json:"layout"`
+	Version  int      `json:"version"`
+	Migrated bool     `json:"migrated"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// 반응형 그리드 레이아웃의 브레이크포인트
+var widgetLayoutBreakpoints = []string{"lg", "md", "sm", "xs", "xxs"}
+
+// widgetStateMigrations lists every widget state format change in version order
+// (버전 1 = 버전 관리 이전에 저장된 상태)
+var widgetStateMigrations = []WidgetStateMigration{
+	{Version: 2, Description: "single grid layout to responsive layouts", Migrate: migrateResponsiveLayout},
+}
+
+// migrateResponsiveLayout moves a legacy single layout ({x, y, w, h}) under the lg breakpoint
+func migrateResponsiveLayout(doc *WidgetStateDocument) bool {
+	if len(doc.Layout) == 0 {
+		return false
+	}
+	for _, breakpoint := range widgetLayoutBreakpoints {
+		if _, ok := doc.Layout[breakpoint]; ok {
+			return false
+		}
+	}
+	for _, key := range []string{"x", "y", "w", "h"} {
+		if _, ok := doc.Layout[key]; ok {
+			doc.Layout = map[string]interface{}{"lg": doc.Layout}
+			return true
+		}
+	}
+	return false
+}
+
+func widgetNumberField(min, max float64) WidgetConfigField {
+	return WidgetConfigField{Kind: WidgetFieldNumber, Min: &min, Max: &max}
+}
+
+func widgetMinimumField(min float64) WidgetConfigField {
+	return WidgetConfigField{Kind: WidgetFieldNumber, Min: &min}
+}
+
+func widgetStringField(values ...string) WidgetConfigField {
+	return WidgetConfigField{Kind: WidgetFieldString, Values: values}
+}
+
+var widgetBooleanField = WidgetConfigField{Kind: WidgetFieldBoolean}
+
+// commonWidgetFields are the config keys every widget type accepts
+var commonWidgetFields = map[string]WidgetConfigField{
+	"chartType":         widgetStringField("line", "area", "bar", "gauge"),
+	"color":             widgetStringField(),
+	"dataPoints":        widgetNumberField(1, MAX_WIDGET_POINTS*10),
+	"unit":              widgetStringField(),
+	"title":             widgetStringField(),
+	"showGraph":         widgetBooleanField,
+	"showPercentage":    widgetBooleanField,
+	"chartOnlyMode":     widgetBooleanField,
+	"updateInterval":    widgetNumberField(0, 3600000),
+	"warningThreshold":  widgetMinimumField(0),
+	"criticalThreshold": widgetMinimumField(0),
+}
+
+// builtinWidgetSchemas returns the schemas of the widget types shipped with the dashboard
+func builtinWidgetSchemas() map[string]WidgetSchema {
+	schemas := []WidgetSchema{
+		{Type: "cpu", Fields: map[string]WidgetConfigField{"showCoreUsage": widgetBooleanField}},
+		{Type: "ram", Fields: map[string]WidgetConfigField{
+			"showUsedMemory":  widgetBooleanField,
+			"showTotalMemory": widgetBooleanField,
+		}},
+		{Type: "disk_read", Fields: map[string]WidgetConfigField{"showReadSpeed": widgetBooleanField, "showWriteSpeed": widgetBooleanField}},
+		{Type: "disk_write", Fields: map[string]WidgetConfigField{"showReadSpeed": widgetBooleanField, "showWriteSpeed": widgetBooleanField}},
+		{Type: "net_sent", Fields: map[string]WidgetConfigField{
+			"showSentSpeed": widgetBooleanField, "showRecvSpeed": widgetBooleanField,
+			"showTotalSent": widgetBooleanField, "showTotalRecv": widgetBooleanField,
+		}},
+		{Type: "net_recv", Fields: map[string]WidgetConfigField{
+			"showSentSpeed": widgetBooleanField, "showRecvSpeed": widgetBooleanField,
+			"showTotalSent": widgetBooleanField, "showTotalRecv": widgetBooleanField,
+		}},
+		{Type: "gpu", Fields: map[string]WidgetConfigField{
+			"showGpuMemory":      widgetBooleanField,
+			"showGpuTemperature": widgetBooleanField,
+			"showGpuPower":       widgetBooleanField,
+		}},
+		{Type: "gpu_process", Fields: map[string]WidgetConfigField{
+			"gpuProcessCount":           widgetNumberField(1, 100),
+			"gpuSortBy":                 widgetStringField("gpu_usage_percent", "gpu_memory_mb", "name", "pid", "type", "status"),
+			"gpuSortOrder":              widgetStringField("asc", "desc"),
+			"gpuFilterEnabled":          widgetBooleanField,
+			"gpuUsageThreshold":         widgetNumberField(0, 100),
+			"gpuMemoryThreshold":        widgetMinimumField(0),
+			"gpuFilterType":             widgetStringField("and", "or"),
+			"gpuShowUpdateIndicators":   widgetBooleanField,
+			"gpuEnableUpdateAnimations": widgetBooleanField,
+			"gpuUpdateInterval":         widgetNumberField(0, 3600000),
+			"gpuShowStatusColors":       widgetBooleanField,
+			"gpuShowUsageGradients":     widgetBooleanField,
+			"gpuShowProcessIcons":       widgetBooleanField,
+			"gpuShowStatusAnimations":   widgetBooleanField,
+			"gpuEnableProcessControl":   widgetBooleanField,
+			"gpuShowControlButtons":     widgetBooleanField,
+			"gpuEnableContextMenu":      widgetBooleanField,
+			"gpuRequireConfirmation":    widgetBooleanField,
+			"gpuShowProcessPriority":    widgetBooleanField,
+			"gpuShowProcessCommand":     widgetBooleanField,
+			"gpuShowLastUpdateTime":     widgetBooleanField,
+			"gpuCompactView":            widgetBooleanField,
+			"gpuShowTerminateButton":    widgetBooleanField,
+			"gpuRefreshInterval":        widgetNumberField(0, 3600000),
+		}},
+		{Type: "system_uptime"},
+		{Type: "process_monitor", Fields: map[string]WidgetConfigField{
+			"processCount": widgetNumberField(1, 100),
+			"sortBy":       widgetStringField("cpu", "memory", "name"),
+		}},
+		{Type: "battery", Fields: map[string]WidgetConfigField{"showBatteryTime": widgetBooleanField, "showChargingStatus": widgetBooleanField}},
+		{Type: "disk_space", Fields: map[string]WidgetConfigField{
+			"showTotalSpace": widgetBooleanField,
+			"showFreeSpace":  widgetBooleanField,
+			"showUsedSpace":  widgetBooleanField,
+		}},
+		{Type: "network_status", Fields: map[string]WidgetConfigField{
+			"showIpAddress":        widgetBooleanField,
+			"showConnectionStatus": widgetBooleanField,
+			"showBandwidth":        widgetBooleanField,
+		}},
+		{Type: "memory_detail", Fields: map[string]WidgetConfigField{
+			"showPhysicalMemory": widgetBooleanField,
+			"showVirtualMemory":  widgetBooleanField,
+			"showSwapMemory":     widgetBooleanField,
+		}},
+		{Type: "system_log", Fields: map[string]WidgetConfigField{
+			"logCount": widgetNumberField(1, 1000),
+			"logLevel": widgetStringField("all", "error", "warning", "info"),
+		}},
+	}
+
+	registry := make(map[string]WidgetSchema, len(schemas))
+	for _, schema := range schemas {
+		registry[schema.Type] = schema
+	}
+	return registry
+}
+
+var widgetSchemas = struct {
+	mutex   sync.RWMutex
+	schemas map[string]WidgetSchema
+}{schemas: builtinWidgetSchemas()}
+
+// RegisterWidgetSchema adds or replaces the schema of a widget type (e.g. a widget provided by a plugin)
+func RegisterWidgetSchema(schema WidgetSchema) error {
+	if strings.TrimSpace(schema.Type) == "" {
+		return fmt.Errorf("widget schema type is required")
+	}
+	for key, field := range schema.Fields {
+		switch field.Kind {
+		case WidgetFieldBoolean, WidgetFieldNumber, WidgetFieldString:
+		default:
+			return fmt.Errorf("widget schema %s: unknown kind %q for config key %s", schema.Type, field.Kind, key)
+		}
+	}
+
+	widgetSchemas.mutex.Lock()
+	defer widgetSchemas.mutex.Unlock()
+	widgetSchemas.schemas[schema.Type] = schema
+	return nil
+}
+
+// GetWidgetSchemas returns every registered widget schema, including the common config keys, sorted by type
+func GetWidgetSchemas() []WidgetSchema {
+	widgetSchemas.mutex.RLock()
+	defer widgetSchemas.mutex.RUnlock()
+
+	schemas := make([]WidgetSchema, 0, len(widgetSchemas.schemas))
+	for _, schema := range widgetSchemas.schemas {
+		merged := WidgetSchema{
+			Type:           schema.Type,
+			Fields:         make(map[string]WidgetConfigField, len(commonWidgetFields)+len(schema.Fields)),
+			MaxConfigBytes: schema.MaxConfigBytes,
+		}
+		if merged.MaxConfigBytes <= 0 {
+			merged.MaxConfigBytes = MAX_WIDGET_CONFIG_BYTES
+		}
+		for key, field := range commonWidgetFields {
+			merged.Fields[key] = field
+		}
+		for key, field := range schema.Fields {
+			merged.Fields[key] = field
+		}
+		schemas = append(schemas, merged)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+	return schemas
+}
+
+func widgetSchemaFor(widgetType string) (WidgetSchema, bool) {
+	widgetSchemas.mutex.RLock()
+	defer widgetSchemas.mutex.RUnlock()
+	schema, ok := widgetSchemas.schemas[widgetType]
+	return schema, ok
+}
+
+// NormalizeWidgetState converts a stored widget state to the current version and validates it against its schema;
+// version <= 0 means unknown (every migration is applied)
+func NormalizeWidgetState(widgetType, config, layout string, version int) (*WidgetStateResult, error) {
+	schema, ok := widgetSchemaFor(widgetType)
+	if !ok {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "widget_type", Reason: "is not registered"}
+	}
+	if version > WIDGET_STATE_VERSION {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "version",
+			Reason: fmt.Sprintf("%d is newer than supported version %d", version, WIDGET_STATE_VERSION)}
+	}
+
+	maxConfigBytes := schema.MaxConfigBytes
+	if maxConfigBytes <= 0 {
+		maxConfigBytes = MAX_WIDGET_CONFIG_BYTES
+	}
+	if len(config) > maxConfigBytes {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "config", Reason: fmt.Sprintf("exceeds %d bytes", maxConfigBytes)}
+	}
+	if len(layout) > MAX_WIDGET_LAYOUT_BYTES {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "layout", Reason: fmt.Sprintf("exceeds %d bytes", MAX_WIDGET_LAYOUT_BYTES)}
+	}
+
+	doc := &WidgetStateDocument{Type: widgetType}
+	var err error
+	if doc.Config, err = parseWidgetStateObject(config); err != nil {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "config", Reason: err.Error()}
+	}
+	if doc.Layout, err = parseWidgetStateObject(layout); err != nil {
+		return nil, &WidgetStateError{WidgetType: widgetType, Field: "layout", Reason: err.Error()}
+	}
+
+	result := &WidgetStateResult{Config: config, Layout: layout, Version: WIDGET_STATE_VERSION}
+	for _, migration := range widgetStateMigrations {
+		if version > 0 && migration.Version <= version {
+			continue
+		}
+		if migration.Migrate(doc) {
+			result.Migrated = true
+		}
+	}
+
+	warnings, err := validateWidgetConfig(schema, doc.Config)
+	if err != nil {
+		return nil, err
+	}
+	result.Warnings = append(result.Warnings, warnings...)
+
+	warnings, err = validateWidgetLayout(widgetType, doc.Layout)
+	if err != nil {
+		return nil, err
+	}
+	result.Warnings = append(result.Warnings, warnings...)
+
+	// 마이그레이션으로 바뀐 경우에만 다시 직렬화 (그 외에는 저장된 문자열 그대로 유지)
+	if result.Migrated {
+		if result.Config, err = marshalWidgetStateObject(doc.Config, config); err != nil {
+			return nil, err
+		}
+		if result.Layout, err = marshalWidgetStateObject(doc.Layout, layout); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// RepairWidgetState normalizes a stored widget state, resetting the config or layout that fails validation
+// so a corrupted widget falls back to its defaults instead of breaking the dashboard
+func RepairWidgetState(widgetType, config, layout string, version int) *WidgetStateResult {
+	var warnings []string
+	for attempt := 0; attempt < 3; attempt++ {
+		result, err := NormalizeWidgetState(widgetType, config, layout, version)
+		if err == nil {
+			result.Warnings = append(warnings, result.Warnings...)
+			return result
+		}
+
+		var stateErr *WidgetStateError
+		if !errors.As(err, &stateErr) {
+			break
+		}
+		switch {
+		case strings.HasPrefix(stateErr.Field, "config") && config != "":
+			config = ""
+		case strings.HasPrefix(stateErr.Field, "layout") && layout != "":
+			layout = ""
+		default:
+			// 등록되지 않은 유형이나 더 새로운 버전은 고칠 수 없으므로 저장된 그대로 반환
+			return &WidgetStateResult{Config: config, Layout: layout, Version: version, Warnings: append(warnings, err.Error())}
+		}
+		warnings = append(warnings, fmt.Sprintf("%s (reset to defaults)", err.Error()))
+	}
+	return &WidgetStateResult{Config: config, Layout: layout, Version: version, Warnings: warnings}
+}
+
+// parseWidgetStateObject parses a config/layout JSON string; an empty string is an empty object
+func parseWidgetStateObject(raw string) (map[string]interface{}, error) {
+	object := make(map[string]interface{})
+	if strings.TrimSpace(raw) == "" {
+		return object, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &object); err != nil {
+		return nil, fmt.Errorf("must be a JSON object")
+	}
+	if object == nil {
+		object = make(map[string]interface{})
+	}
+	return object, nil
+}
+
+// marshalWidgetStateObject serializes a migrated config/layout, keeping an originally empty value empty
+func marshalWidgetStateObject(object map[string]interface{}, original string) (string, error) {
+	if len(object) == 0 && strings.TrimSpace(original) == "" {
+		return original, nil
+	}
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// validateWidgetConfig checks known config keys against the schema and returns warnings for unknown keys
+func validateWidgetConfig(schema WidgetSchema, config map[string]interface{}) ([]string, error) {
+	if len(config) > MAX_WIDGET_CONFIG_KEYS {
+		return nil, &WidgetStateError{WidgetType: schema.Type, Field: "config", Reason: fmt.Sprintf("has more than %d keys", MAX_WIDGET_CONFIG_KEYS)}
+	}
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var warnings []string
+	for _, key := range keys {
+		field, known := schema.Fields[key]
+		if !known {
+			field, known = commonWidgetFields[key]
+		}
+		if !known {
+			warnings = append(warnings, fmt.Sprintf("widget %s: unknown config key %s", schema.Type, key))
+			continue
+		}
+		if reason := checkWidgetConfigValue(field, config[key]); reason != "" {
+			return nil, &WidgetStateError{WidgetType: schema.Type, Field: "config." + key, Reason: reason}
+		}
+	}
+	return warnings, nil
+}
+
+// checkWidgetConfigValue returns why a value does not match its field, or "" (null clears the setting)
+func checkWidgetConfigValue(field WidgetConfigField, value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	switch field.Kind {
+	case WidgetFieldBoolean:
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	case WidgetFieldNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return "must be a number"
+		}
+		if field.Min != nil && number < *field.Min {
+			return fmt.Sprintf("must be at least %g", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return fmt.Sprintf("must be at most %g", *field.Max)
+		}
+	case WidgetFieldString:
+		text, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		if len(field.Values) > 0 {
+			for _, allowed := range field.Values {
+				if text == allowed {
+					return ""
+				}
+			}
+			return fmt.Sprintf("must be one of %s", strings.Join(field.Values, ", "))
+		}
+	}
+	return ""
+}
+
+// validateWidgetLayout checks that every breakpoint holds a grid item with non-negative numeric position and size
+func validateWidgetLayout(widgetType string, layout map[string]interface{}) ([]string, error) {
+	var warnings []string
+	for _, breakpoint := range widgetLayoutBreakpoints {
+		value, ok := layout[breakpoint]
+		if !ok || value == nil {
+			continue
+		}
+		item, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, &WidgetStateError{WidgetType: widgetType, Field: "layout." + breakpoint, Reason: "must be an object"}
+		}
+		for _, key := range []string{"x", "y", "w", "h"} {
+			raw, present := item[key]
+			if !present || raw == nil {
+				continue
+			}
+			number, isNumber := raw.(float64)
+			if !isNumber || number < 0 {
+				return nil, &WidgetStateError{WidgetType: widgetType, Field: "layout." + breakpoint,
+					Reason: fmt.Sprintf("%s must be a non-negative number", key)}
+			}
+			if (key == "w" || key == "h") && number == 0 {
+				return nil, &WidgetStateError{WidgetType: widgetType, Field: "layout." + breakpoint,
+					Reason: fmt.Sprintf("%s must be greater than 0", key)}
+			}
+		}
+	}
+
+	for key := range layout {
+		known := false
+		for _, breakpoint := range widgetLayoutBreakpoints {
+			if key == breakpoint {
+				known = true
+				break
+			}
+		}
+		if !known {
+			warnings = append(warnings, fmt.Sprintf("widget %s: unknown layout key %s", widgetType, key))
+		}
+	}
+	sort.Strings(warnings)
+	return warnings, nil
+}
@@ -0,0 +1,128 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNormalizeWidgetState(t *testing.T) {
+
+	t.Run("Valid_State_Unchanged", func(t *testing.T) {
+		config := `{"chartType":"line","showCoreUsage":true,"dataPoints":60}`
+		layout := `{"lg":{"x":0,"y":2,"w":6,"h":4,"i":"cpu-1"}}`
+		result, err := NormalizeWidgetState("cpu", config, layout, WIDGET_STATE_VERSION)
+		if err != nil {
+			t.Fatalf("NormalizeWidgetState failed: %v", err)
+		}
+		if result.Config != config || result.Layout != layout || result.Migrated || len(result.Warnings) != 0 {
+			t.Errorf("Expected the stored state unchanged, got %+v", result)
+		}
+	})
+
+	t.Run("Legacy_Layout_Migrated", func(t *testing.T) {
+		result, err := NormalizeWidgetState("ram", "", `{"x":1,"y":0,"w":4,"h":3}`, 1)
+		if err != nil {
+			t.Fatalf("NormalizeWidgetState failed: %v", err)
+		}
+		if !result.Migrated || result.Version != WIDGET_STATE_VERSION || result.Config != "" {
+			t.Fatalf("Expected a migrated state, got %+v", result)
+		}
+		var layout map[string]map[string]float64
+		if err := json.Unmarshal([]byte(result.Layout), &layout); err != nil || layout["lg"]["w"] != 4 {
+			t.Errorf("Expected the legacy layout under lg, got %s", result.Layout)
+		}
+
+		// 버전을 알 수 없으면 모든 마이그레이션을 적용하되 이미 변환된 상태는 그대로 둠
+		again, err := NormalizeWidgetState("ram", "", result.Layout, 0)
+		if err != nil || again.Migrated || again.Layout != result.Layout {
+			t.Errorf("Expected migrations to be idempotent, got %+v (%v)", again, err)
+		}
+	})
+
+	t.Run("Rejects_Invalid_Values", func(t *testing.T) {
+		cases := map[string][2]string{
+			"config.chartType":    {`{"chartType":"pie"}`, ""},
+			"config.processCount": {`{"processCount":"ten"}`, ""},
+			"config":              {`[1,2]`, ""},
+			"layout.lg":           {"", `{"lg":{"x":0,"w":-1}}`},
+			"layout":              {"", `not json`},
+		}
+		for field, state := range cases {
+			_, err := NormalizeWidgetState("process_monitor", state[0], state[1], WIDGET_STATE_VERSION)
+			var stateErr *WidgetStateError
+			if !errors.Is(err, ErrInvalidWidgetState) || !errors.As(err, &stateErr) || stateErr.Field != field {
+				t.Errorf("Expected %s to be rejected, got %v", field, err)
+			}
+		}
+	})
+
+	t.Run("Size_Limit", func(t *testing.T) {
+		config := `{"title":"` + string(make([]byte, MAX_WIDGET_CONFIG_BYTES)) + `"}`
+		if _, err := NormalizeWidgetState("cpu", config, "", WIDGET_STATE_VERSION); !errors.Is(err, ErrInvalidWidgetState) {
+			t.Errorf("Expected an oversized config to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("Unknown_Keys_Warn", func(t *testing.T) {
+		result, err := NormalizeWidgetState("gpu", `{"experimentalMode":true}`, "", WIDGET_STATE_VERSION)
+		if err != nil {
+			t.Fatalf("NormalizeWidgetState failed: %v", err)
+		}
+		if len(result.Warnings) != 1 {
+			t.Errorf("Expected one warning for the unknown key, got %v", result.Warnings)
+		}
+	})
+
+	t.Run("Unregistered_Type_And_Newer_Version", func(t *testing.T) {
+		if _, err := NormalizeWidgetState("weather", "", "", WIDGET_STATE_VERSION); !errors.Is(err, ErrInvalidWidgetState) {
+			t.Errorf("Expected an unregistered widget type to be rejected, got %v", err)
+		}
+		if _, err := NormalizeWidgetState("cpu", "", "", WIDGET_STATE_VERSION+1); !errors.Is(err, ErrInvalidWidgetState) {
+			t.Errorf("Expected a newer state version to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("Registered_Schema", func(t *testing.T) {
+		if err := RegisterWidgetSchema(WidgetSchema{Type: "test_plugin", Fields: map[string]WidgetConfigField{"city": widgetStringField()}}); err != nil {
+			t.Fatalf("RegisterWidgetSchema failed: %v", err)
+		}
+		t.Cleanup(func() {
+			widgetSchemas.mutex.Lock()
+			delete(widgetSchemas.schemas, "test_plugin")
+			widgetSchemas.mutex.Unlock()
+		})
+
+		if _, err := NormalizeWidgetState("test_plugin", `{"city":"Seoul","color":"#fff"}`, "", 0); err != nil {
+			t.Errorf("Expected the plugin schema and common keys to validate, got %v", err)
+		}
+		if err := RegisterWidgetSchema(WidgetSchema{Type: "test_bad", Fields: map[string]WidgetConfigField{"x": {Kind: "date"}}}); err == nil {
+			t.Error("Expected an unknown field kind to be rejected")
+		}
+	})
+}
+
+func TestRepairWidgetState(t *testing.T) {
+
+	t.Run("Resets_Invalid_Part", func(t *testing.T) {
+		layout := `{"lg":{"x":0,"y":0,"w":6,"h":4}}`
+		result := RepairWidgetState("cpu", `{"chartType":42}`, layout, WIDGET_STATE_VERSION)
+		if result.Config != "" || result.Layout != layout || len(result.Warnings) != 1 {
+			t.Errorf("Expected only the config to be reset, got %+v", result)
+		}
+	})
+
+	t.Run("Resets_Both", func(t *testing.T) {
+		result := RepairWidgetState("cpu", `{`, `{"lg":"full"}`, 1)
+		if result.Config != "" || result.Layout != "" || result.Version != WIDGET_STATE_VERSION || len(result.Warnings) != 2 {
+			t.Errorf("Expected config and layout to be reset, got %+v", result)
+		}
+	})
+
+	t.Run("Unregistered_Type_Kept", func(t *testing.T) {
+		result := RepairWidgetState("weather", `{"city":"Seoul"}`, "", 2)
+		if result.Config != `{"city":"Seoul"}` || len(result.Warnings) != 1 {
+			t.Errorf("Expected the state to be returned as stored, got %+v", result)
+		}
+	})
+}
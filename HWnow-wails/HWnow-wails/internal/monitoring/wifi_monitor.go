@@ -0,0 +1,277 @@
+package monitoring
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 무선 네트워크(Wi-Fi) 연결 정보
+// Windows: netsh wlan show interfaces (WLAN AutoConfig 서비스 기반)
+// Linux: iw dev / iw dev <if> link (nl80211)
+
+// WiFiInfo represents a wireless adapter and its current link
+type WiFiInfo struct {
+	Interface     string  `json:"interface"`
+	Connected     bool    `json:"connected"`
+	SSID          string  `json:"ssid"`
+	BSSID         string  `json:"bssid"`
+	SignalDBm     float64 `json:"signal_dbm"`     // 신호 세기 (dBm, Windows는 품질(%)에서 환산)
+	SignalPercent float64 `json:"signal_percent"` // 신호 품질 (%, Linux는 dBm에서 환산)
+	RxRateMbps    float64 `json:"rx_rate_mbps"`   // 수신 링크 속도
+	TxRateMbps    float64 `json:"tx_rate_mbps"`   // 송신 링크 속도
+	Channel       int     `json:"channel"`
+	FrequencyMHz  float64 `json:"frequency_mhz"`
+	RadioType     string  `json:"radio_type,omitempty"` // 802.11ax 등 (Windows)
+}
+
+// WiFiCache caches wireless adapter readings
+type WiFiCache struct {
+	mutex     sync.Mutex
+	adapters  []WiFiInfo
+	err       error
+	timestamp time.Time
+}
+
+const WIFI_CACHE_DURATION = 5 * time.Second
+
+var wifiCache = &WiFiCache{}
+
+// GetWiFiInfo returns the wireless adapters and their link quality
+func GetWiFiInfo(ctx context.Context) ([]WiFiInfo, error) {
+	return queryWithContext(ctx, "GetWiFiInfo", getWiFiInfo)
+}
+
+func getWiFiInfo() ([]WiFiInfo, error) {
+	wifiCache.mutex.Lock()
+	defer wifiCache.mutex.Unlock()
+
+	if time.Since(wifiCache.timestamp) < WIFI_CACHE_DURATION {
+		return wifiCache.adapters, wifiCache.err
+	}
+
+	var adapters []WiFiInfo
+	var err error
+	switch runtime.GOOS {
+	case "windows":
+		adapters, err = getWiFiInfoWindows()
+	case "linux":
+		adapters, err = getWiFiInfoLinux()
+	default:
+		err = fmt.Errorf("Wi-Fi monitoring not supported on platform: %s", runtime.GOOS)
+	}
+
+	wifiCache.adapters = adapters
+	wifiCache.err = err
+	wifiCache.timestamp = time.Now()
+	return adapters, err
+}
+
+// WiFiMetrics converts the first connected adapter to wifi_* metrics
+func WiFiMetrics(adapters []WiFiInfo) []Metric {
+	for _, adapter := range adapters {
+		if !adapter.Connected {
+			continue
+		}
+		return []Metric{
+			{Type: "wifi_signal_dbm", Value: adapter.SignalDBm, Info: adapter.SSID},
+			{Type: "wifi_signal_percent", Value: adapter.SignalPercent, Info: adapter.SSID},
+			{Type: "wifi_link_rate", Value: adapter.RxRateMbps, Info: adapter.SSID},
+		}
+	}
+	return nil
+}
+
+// getWiFiInfoWindows reads "netsh wlan show interfaces"
+func getWiFiInfoWindows() ([]WiFiInfo, error) {
+	output, err := createHiddenCommandWithTimeout("netsh", 3, "wlan", "show", "interfaces").Output()
+	if err != nil {
+		return nil, fmt.Errorf("netsh wlan query failed: %v", err)
+	}
+	return parseNetshWlanInterfaces(string(output)), nil
+}
+
+// parseNetshWlanInterfaces parses "netsh wlan show interfaces" output (English field names)
+func parseNetshWlanInterfaces(output string) []WiFiInfo {
+	var adapters []WiFiInfo
+	var current *WiFiInfo
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if key == "Name" {
+			adapters = append(adapters, WiFiInfo{Interface: value})
+			current = &adapters[len(adapters)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		switch key {
+		case "State":
+			current.Connected = strings.EqualFold(value, "connected")
+		case "SSID":
+			current.SSID = value
+		case "BSSID", "AP BSSID":
+			current.BSSID = value
+		case "Radio type":
+			current.RadioType = value
+		case "Channel":
+			current.Channel, _ = strconv.Atoi(value)
+		case "Receive rate (Mbps)":
+			current.RxRateMbps, _ = strconv.ParseFloat(value, 64)
+		case "Transmit rate (Mbps)":
+			current.TxRateMbps, _ = strconv.ParseFloat(value, 64)
+		case "Signal":
+			if percent, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64); err == nil {
+				current.SignalPercent = percent
+				current.SignalDBm = wifiPercentToDBm(percent)
+			}
+		case "Rssi":
+			// 최신 Windows 11은 RSSI(dBm)도 직접 제공
+			if dbm, err := strconv.ParseFloat(value, 64); err == nil {
+				current.SignalDBm = dbm
+			}
+		}
+	}
+
+	for i := range adapters {
+		if adapters[i].Channel > 0 {
+			adapters[i].FrequencyMHz = wifiChannelToFrequency(adapters[i].Channel)
+		}
+	}
+	return adapters
+}
+
+// getWiFiInfoLinux lists wireless interfaces with "iw dev" and reads each link
+func getWiFiInfoLinux() ([]WiFiInfo, error) {
+	if _, err := exec.LookPath("iw"); err != nil {
+		return nil, fmt.Errorf("iw not found: %v", err)
+	}
+	output, err := createHiddenCommandWithTimeout("iw", 3, "dev").Output()
+	if err != nil {
+		return nil, fmt.Errorf("iw dev failed: %v", err)
+	}
+
+	var adapters []WiFiInfo
+	for _, iface := range parseIWDevInterfaces(string(output)) {
+		adapter := WiFiInfo{Interface: iface}
+		linkOutput, err := createHiddenCommandWithTimeout("iw", 3, "dev", iface, "link").Output()
+		if err == nil {
+			parseIWLink(string(linkOutput), &adapter)
+		}
+		adapters = append(adapters, adapter)
+	}
+	return adapters, nil
+}
+
+// parseIWDevInterfaces returns interface names from "iw dev" output
+func parseIWDevInterfaces(output string) []string {
+	var interfaces []string
+	for _, line := range strings.Split(output, "\n") {
+		if name, ok := strings.CutPrefix(strings.TrimSpace(line), "Interface "); ok {
+			interfaces = append(interfaces, strings.TrimSpace(name))
+		}
+	}
+	return interfaces
+}
+
+// parseIWLink fills link details from "iw dev <if> link" output
+func parseIWLink(output string, adapter *WiFiInfo) {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if rest, ok := strings.CutPrefix(line, "Connected to "); ok {
+			adapter.Connected = true
+			if fields := strings.Fields(rest); len(fields) > 0 {
+				adapter.BSSID = fields[0]
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch key {
+		case "SSID":
+			adapter.SSID = value
+		case "freq":
+			adapter.FrequencyMHz, _ = strconv.ParseFloat(fields[0], 64)
+			adapter.Channel = wifiFrequencyToChannel(adapter.FrequencyMHz)
+		case "signal":
+			if dbm, err := strconv.ParseFloat(fields[0], 64); err == nil {
+				adapter.SignalDBm = dbm
+				adapter.SignalPercent = wifiDBmToPercent(dbm)
+			}
+		case "rx bitrate":
+			adapter.RxRateMbps, _ = strconv.ParseFloat(fields[0], 64)
+		case "tx bitrate":
+			adapter.TxRateMbps, _ = strconv.ParseFloat(fields[0], 64)
+		}
+	}
+}
+
+// wifiDBmToPercent maps -100..-50 dBm to 0..100% (Windows WLAN API와 같은 선형 근사)
+func wifiDBmToPercent(dbm float64) float64 {
+	percent := 2 * (dbm + 100)
+	if percent < 0 {
+		return 0
+	}
+	if percent > 100 {
+		return 100
+	}
+	return percent
+}
+
+// wifiPercentToDBm is the inverse of wifiDBmToPercent
+func wifiPercentToDBm(percent float64) float64 {
+	return percent/2 - 100
+}
+
+// wifiFrequencyToChannel converts a center frequency (MHz) to a channel number
+func wifiFrequencyToChannel(freq float64) int {
+	mhz := int(freq)
+	switch {
+	case mhz == 2484:
+		return 14
+	case mhz >= 2412 && mhz < 2484:
+		return (mhz - 2407) / 5
+	case mhz > 5950 && mhz <= 7125: // 6 GHz
+		return (mhz - 5950) / 5
+	case mhz >= 5000 && mhz <= 5900:
+		return (mhz - 5000) / 5
+	}
+	return 0
+}
+
+// wifiChannelToFrequency converts a channel number to its center frequency (MHz, 6 GHz 채널은 구분 불가하여 5 GHz로 간주)
+func wifiChannelToFrequency(channel int) float64 {
+	switch {
+	case channel == 14:
+		return 2484
+	case channel >= 1 && channel <= 13:
+		return float64(2407 + channel*5)
+	case channel >= 32:
+		return float64(5000 + channel*5)
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+package monitoring
+
+import "testing"
+
+func TestWiFiMonitor(t *testing.T) {
+
+	t.Run("Parse_Netsh", func(t *testing.T) {
+		output := "\r\nThere is 1 interface on the system:\r\n\r\n" +
+			"    Name                   : Wi-Fi\r\n" +
+			"    Description            : Intel(R) Wi-Fi 6 AX201 160MHz\r\n" +
+			"    State                  : connected\r\n" +
+			"    SSID                   : HomeNet\r\n" +
+			"    AP BSSID               : aa:bb:cc:dd:ee:ff\r\n" +
+			"    Radio type             : 802.11ax\r\n" +
+			"    Channel                : 36\r\n" +
+			"    Receive rate (Mbps)    : 1201\r\n" +
+			"    Transmit rate (Mbps)   : 960\r\n" +
+			"    Signal                 : 80%\r\n"
+
+		adapters := parseNetshWlanInterfaces(output)
+		if len(adapters) != 1 {
+			t.Fatalf("Expected 1 adapter, got %d", len(adapters))
+		}
+		wifi := adapters[0]
+		if !wifi.Connected || wifi.SSID != "HomeNet" || wifi.BSSID != "aa:bb:cc:dd:ee:ff" || wifi.Channel != 36 {
+			t.Errorf("Unexpected adapter: %+v", wifi)
+		}
+		if wifi.SignalPercent != 80 || wifi.SignalDBm != -60 || wifi.RxRateMbps != 1201 || wifi.FrequencyMHz != 5180 {
+			t.Errorf("Unexpected link quality: %+v", wifi)
+		}
+	})
+
+	t.Run("Parse_IW", func(t *testing.T) {
+		interfaces := parseIWDevInterfaces("phy#0\n\tInterface wlp2s0\n\t\tifindex 3\n\t\ttype managed\n")
+		if len(interfaces) != 1 || interfaces[0] != "wlp2s0" {
+			t.Fatalf("Unexpected interfaces: %v", interfaces)
+		}
+
+		link := "Connected to 11:22:33:44:55:66 (on wlp2s0)\n" +
+			"\tSSID: Office WiFi\n" +
+			"\tfreq: 2437\n" +
+			"\tsignal: -67 dBm\n" +
+			"\trx bitrate: 144.4 MBit/s MCS 15 short GI\n" +
+			"\ttx bitrate: 130.0 MBit/s MCS 15\n"
+		wifi := WiFiInfo{Interface: "wlp2s0"}
+		parseIWLink(link, &wifi)
+		if !wifi.Connected || wifi.SSID != "Office WiFi" || wifi.Channel != 6 {
+			t.Errorf("Unexpected adapter: %+v", wifi)
+		}
+		if wifi.SignalDBm != -67 || wifi.SignalPercent != 66 || wifi.RxRateMbps != 144.4 || wifi.TxRateMbps != 130 {
+			t.Errorf("Unexpected link quality: %+v", wifi)
+		}
+	})
+
+	t.Run("Metrics_Skip_Disconnected", func(t *testing.T) {
+		metrics := WiFiMetrics([]WiFiInfo{{Interface: "wlan1"}, {Interface: "wlan0", Connected: true, SignalDBm: -50, SignalPercent: 100}})
+		if len(metrics) != 3 || metrics[0].Value != -50 {
+			t.Errorf("Unexpected metrics: %+v", metrics)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package monitoring
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	ole "github.com/go-ole/go-ole"
+	"github.com/go-ole/go-ole/oleutil"
+)
+
+// WMI 조회 (COM SWbemLocator 직접 사용)
+// wmic은 최신 Windows 11에서 제거되었으므로 외부 프로세스 없이 WQL을 실행
+// 결과는 인스턴스별 "속성 이름 → 문자열 값" 맵이며, 캐시에는 wmic /format:list와 같은 "Key=Value" 텍스트로 저장해
+// 기존 파서(parseWMIListOutput 등)를 그대로 사용
+
+const (
+	wmiDefaultNamespace = `root\cimv2`
+	wmiQueryTimeout     = 10 * time.Second
+	wmiSFalse           = 0x00000001 // CoInitializeEx: 이 스레드는 이미 COM이 초기화됨
+)
+
+// queryWMI runs "SELECT properties FROM class [WHERE where]" and returns one map per instance
+func queryWMI(namespace, class, where string, properties ...string) ([]map[string]string, error) {
+	if runtime.GOOS != "windows" {
+		return nil, fmt.Errorf("WMI is only available on Windows")
+	}
+	if namespace == "" {
+		namespace = wmiDefaultNamespace
+	}
+	query := "SELECT " + strings.Join(properties, ",") + " FROM " + class
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	type wmiResult struct {
+		rows []map[string]string
+		err  error
+	}
+	done := make(chan wmiResult, 1)
+	go func() {
+		// 외부 명령과 같은 동시 실행 제한 적용
+		externalCommandSlots <- struct{}{}
+		defer func() { <-externalCommandSlots }()
+
+		rows, err := execWMIQuery(namespace, query, properties)
+		done <- wmiResult{rows: rows, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		return result.rows, result.err
+	case <-time.After(wmiQueryTimeout):
+		// 멈춘 COM 호출은 취소할 수 없으므로 결과를 기다리지 않고 반환 (고루틴은 호출이 끝나면 종료)
+		return nil, fmt.Errorf("WMI query timed out after %v: %s", wmiQueryTimeout, query)
+	}
+}
+
+// queryWMIList runs a WMI query and formats the result like wmic /format:list output
+func queryWMIList(namespace, class, where string, properties ...string) ([]byte, error) {
+	rows, err := queryWMI(namespace, class, where, properties...)
+	if err != nil {
+		return nil, err
+	}
+	return formatWMIList(rows, properties), nil
+}
+
+// execWMIQuery executes the query on a COM-initialized, locked OS thread
+func execWMIQuery(namespace, query string, properties []string) ([]map[string]string, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := ole.CoInitializeEx(0, ole.COINIT_MULTITHREADED); err != nil {
+		oleErr, ok := err.(*ole.OleError)
+		if !ok || (oleErr.Code() != ole.S_OK && oleErr.Code() != wmiSFalse) {
+			return nil, fmt.Errorf("CoInitializeEx failed: %v", err)
+		}
+	}
+	defer ole.CoUninitialize()
+
+	locator, err := oleutil.CreateObject("WbemScripting.SWbemLocator")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SWbemLocator: %v", err)
+	}
+	defer locator.Release()
+
+	dispatch, err := locator.QueryInterface(ole.IID_IDispatch)
+	if err != nil {
+		return nil, err
+	}
+	defer dispatch.Release()
+
+	serviceRaw, err := oleutil.CallMethod(dispatch, "ConnectServer", nil, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to WMI namespace %s: %v", namespace, err)
+	}
+	defer serviceRaw.Clear()
+	service := serviceRaw.ToIDispatch()
+
+	resultRaw, err := oleutil.CallMethod(service, "ExecQuery", query)
+	if err != nil {
+		return nil, fmt.Errorf("WMI query failed: %v", err)
+	}
+	defer resultRaw.Clear()
+	result := resultRaw.ToIDispatch()
+
+	countRaw, err := oleutil.GetProperty(result, "Count")
+	if err != nil {
+		return nil, err
+	}
+	count := int(countRaw.Val)
+	countRaw.Clear()
+
+	rows := make([]map[string]string, 0, count)
+	for i := 0; i < count; i++ {
+		itemRaw, err := oleutil.CallMethod(result, "ItemIndex", i)
+		if err != nil {
+			return rows, err
+		}
+		item := itemRaw.ToIDispatch()
+
+		row := make(map[string]string, len(properties))
+		for _, property := range properties {
+			value, err := oleutil.GetProperty(item, property)
+			if err != nil {
+				continue
+			}
+			row[property] = formatWMIValue(value.Value())
+			value.Clear()
+		}
+		itemRaw.Clear()
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// formatWMIValue converts a VARIANT value to the text wmic would print (NULL = empty)
+func formatWMIValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatWMIList renders instances as "Key=Value" lines with a blank line between instances
+func formatWMIList(rows []map[string]string, properties []string) []byte {
+	var builder strings.Builder
+	for _, row := range rows {
+		builder.WriteString("\r\n")
+		for _, property := range properties {
+			builder.WriteString(property)
+			builder.WriteString("=")
+			builder.WriteString(row[property])
+			builder.WriteString("\r\n")
+		}
+	}
+	return []byte(builder.String())
+}
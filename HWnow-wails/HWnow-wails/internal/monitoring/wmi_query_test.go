@@ -0,0 +1,39 @@
+package monitoring
+
+import "testing"
+
+func TestFormatWMIList(t *testing.T) {
+	t.Run("Round_Trips_Through_List_Parser", func(t *testing.T) {
+		rows := []map[string]string{
+			{"BatteryStatus": "2", "EstimatedChargeRemaining": "95"},
+			{"BatteryStatus": "1", "EstimatedChargeRemaining": "40"},
+		}
+		values := parseWMIListOutput(formatWMIList(rows, []string{"BatteryStatus", "EstimatedChargeRemaining", "EstimatedRunTime"}))
+
+		// 여러 인스턴스가 있으면 첫 번째 값 사용
+		if values["BatteryStatus"] != "2" || values["EstimatedChargeRemaining"] != "95" {
+			t.Errorf("unexpected values: %v", values)
+		}
+		if value, ok := values["EstimatedRunTime"]; !ok || value != "" {
+			t.Errorf("missing property should be present and empty, got %q (present=%v)", value, ok)
+		}
+	})
+
+	t.Run("Formats_Variant_Values", func(t *testing.T) {
+		cases := []struct {
+			value interface{}
+			want  string
+		}{
+			{nil, ""},
+			{true, "TRUE"},
+			{false, "FALSE"},
+			{int32(8), "8"},
+			{"NVIDIA GeForce RTX 3060", "NVIDIA GeForce RTX 3060"},
+		}
+		for _, c := range cases {
+			if got := formatWMIValue(c.value); got != c.want {
+				t.Errorf("formatWMIValue(%v) = %q, want %q", c.value, got, c.want)
+			}
+		}
+	})
+}
@@ -345,6 +345,11 @@ func (ui *UIService) ShowNotification(options NotificationOptions) error {
 	return nil
 }
 
+// EmitEvent emits a Wails event to the frontend (used by services outside the native UI)
+func (ui *UIService) EmitEvent(eventName string, data ...interface{}) {
+	ui.emitEvent(eventName, data...)
+}
+
 // Helper method to emit events safely
 func (ui *UIService) emitEvent(eventName string, data ...interface{}) {
 	if ui.ctx != nil {
@@ -2,27 +2,45 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
+	db "HWnow-wails/internal/database"
 	"HWnow-wails/internal/monitoring"
 	"HWnow-wails/internal/native"
 )
 
 // AppService coordinates all application services
 type AppService struct {
-	ctx    context.Context
-	config *Config
+	ctx     context.Context
+	config  *Config
+	dataDir string // config.json과 데이터베이스가 저장되는 디렉터리
 
 	// Service components
 	configService    *ConfigService
 	monitoringService *MonitoringService
 	gpuControlService *GPUProcessControlService
 	databaseService  *DatabaseService
+	reportService    *ReportService
+	processWatchdog  *monitoring.ProcessWatchdog
 
 	// Native services
 	nativeUIService *native.UIService
+	desktopNotifier *monitoring.DesktopNotifier // OS notifications for alert events
+
+	// On-demand speed test and directory scan (only one of each at a time)
+	speedtestRunning bool
+	diskScanRunning  bool
+
+	// Running load generator (nil = idle)
+	stressTestCancel context.CancelFunc
 
 	// Synchronization
 	mutex sync.RWMutex
@@ -31,10 +49,12 @@ type AppService struct {
 // NewAppService creates a new application service coordinator
 func NewAppService(configPath string) *AppService {
 	app := &AppService{
+		dataDir:          filepath.Dir(configPath),
 		configService:    NewConfigService(configPath),
 		gpuControlService: NewGPUProcessControlService(),
 		databaseService:  NewDatabaseService(),
 	}
+	app.databaseService.SetDataDir(app.dataDir)
 
 	return app
 }
@@ -53,6 +73,11 @@ func (a *AppService) Initialize(ctx context.Context) error {
 	}
 	a.config = config
 
+	// Configure structured logging before other services start logging
+	if err := initializeLogging(&config.Logging, a.dataDir, nil); err != nil {
+		monitoring.LogWarn("Failed to initialize logging, using stdout", "error", err)
+	}
+
 	// Initialize monitoring service with config
 	a.monitoringService = NewMonitoringService(&config.Monitoring)
 
@@ -67,6 +92,10 @@ func (a *AppService) Initialize(ctx context.Context) error {
 	}
 	monitoring.LogInfo("Database service initialized successfully during startup")
 
+	// Start scheduled report delivery
+	a.reportService = NewReportService(a.databaseService, config.Reports)
+	a.reportService.Start()
+
 	// Initialize native UI service
 	a.nativeUIService = native.NewUIService()
 	if err := a.nativeUIService.Initialize(ctx); err != nil {
@@ -74,10 +103,68 @@ func (a *AppService) Initialize(ctx context.Context) error {
 		return err
 	}
 
+	// Show alert events as OS notifications (Action Center, Notification Center, libnotify)
+	notifier, err := monitoring.NewDesktopNotifier(config.Notifications.Policy())
+	if err != nil {
+		monitoring.LogWarn("Invalid notification settings, desktop notifications disabled", "error", err)
+		notifier, _ = monitoring.NewDesktopNotifier(monitoring.NotificationPolicy{})
+	}
+	a.desktopNotifier = notifier
+
 	monitoring.LogInfo("Starting monitoring service with configuration",
 		"intervalSeconds", config.Monitoring.IntervalSeconds,
 		"securityCheckSeconds", config.Monitoring.SecurityCheckSeconds)
 
+	// Record collected metrics to resource_logs through the batched writer
+	a.monitoringService.SetSnapshotHandler(func(snapshot *monitoring.ResourceSnapshot) {
+		a.databaseService.EnqueueResourceSnapshot(snapshot)
+	})
+
+	// Surface hardware errors from the Windows Event Log as events
+	a.monitoringService.SetHardwareEventHandler(a.handleHardwareEvent)
+
+	// Record suspend/resume and session lock/unlock as events
+	a.monitoringService.SetPowerEventHandler(a.handlePowerEvent)
+
+	// Record GPU/disk hot-plug as events
+	a.monitoringService.SetDeviceEventHandler(a.handleDeviceEvent)
+
+	// Hide or restrict GPU processes by name
+	if err := monitoring.SetGPUProcessNamePatterns(config.Monitoring.GPUProcessInclude, config.Monitoring.GPUProcessExclude); err != nil {
+		monitoring.LogWarn("Failed to apply GPU process name patterns", "error", err)
+	}
+	if err := monitoring.SetGPUVendorOverride(config.GPU.Vendor); err != nil {
+		monitoring.LogWarn("Failed to apply GPU vendor override", "error", err)
+	}
+
+	// Warn when cached GPU data served is older than the configured age
+	monitoring.SetCacheStaleThreshold(time.Duration(config.Monitoring.CacheStaleWarningSecs) * time.Second)
+	monitoring.SetCacheStaleHandler(a.handleCacheStaleAlert)
+	if err := monitoring.SetLanguage(config.UI.Language); err != nil {
+		monitoring.LogWarn("Failed to apply message language", "error", err)
+	}
+	a.applyProtectedProcesses(config)
+
+	// Record watched paths crossing their free space thresholds as events
+	a.monitoringService.SetDiskSpaceHandler(a.handleDiskSpaceAlert)
+	a.monitoringService.SetThrottleEventHandler(a.handleThrottleEvent)
+	a.monitoringService.SetGPUECCHandler(a.handleGPUECCAlert)
+	a.monitoringService.SetNetworkErrorHandler(a.handleNetworkErrorAlert)
+
+	// Optional gateway/internet latency probe
+	a.monitoringService.ConfigureNetworkQuality(config.NetworkQuality)
+
+	// Optional per-application FPS / frame time source (PresentMon)
+	a.monitoringService.ConfigureFrameStats(config.FrameStats)
+
+	// Optional fan curves / manual PWM targets (Linux hwmon)
+	a.monitoringService.ConfigureFanControl(effectiveFanControl(config))
+
+	// Watch registered processes for exits and resource limits
+	a.processWatchdog = monitoring.NewProcessWatchdog(a.handleProcessWatchEvent)
+	a.reloadWatchedProcesses()
+	a.processWatchdog.Start(ctx)
+
 	// Auto-start monitoring service
 	if err := a.monitoringService.Start(); err != nil {
 		monitoring.LogError("Failed to auto-start monitoring service", "error", err)
@@ -96,6 +183,11 @@ func (a *AppService) Shutdown() error {
 
 	var errors []error
 
+	// Stop the load generator (CPU workers and the external GPU tool)
+	if a.stressTestCancel != nil {
+		a.stressTestCancel()
+	}
+
 	// Stop monitoring service
 	if a.monitoringService != nil && a.monitoringService.IsRunning() {
 		if err := a.monitoringService.Stop(); err != nil {
@@ -104,6 +196,15 @@ func (a *AppService) Shutdown() error {
 		}
 	}
 
+	if a.processWatchdog != nil {
+		a.processWatchdog.Stop()
+	}
+
+	// Stop scheduled reports before closing the database
+	if a.reportService != nil {
+		a.reportService.Stop()
+	}
+
 	// Close database service
 	if a.databaseService != nil {
 		if err := a.databaseService.Close(); err != nil {
@@ -112,6 +213,8 @@ func (a *AppService) Shutdown() error {
 		}
 	}
 
+	monitoring.CloseLogging()
+
 	if len(errors) > 0 {
 		return errors[0] // Return first error
 	}
@@ -121,6 +224,14 @@ func (a *AppService) Shutdown() error {
 
 // Configuration methods
 
+// GetDataDir returns the absolute directory holding config.json and the database
+func (a *AppService) GetDataDir() string {
+	if absolute, err := filepath.Abs(a.dataDir); err == nil {
+		return absolute
+	}
+	return a.dataDir
+}
+
 // GetConfig returns the current configuration
 func (a *AppService) GetConfig() *Config {
 	a.mutex.RLock()
@@ -128,6 +239,68 @@ func (a *AppService) GetConfig() *Config {
 	return a.config
 }
 
+// UpdateConfig validates, persists and applies a new configuration
+func (a *AppService) UpdateConfig(config *Config) error {
+	if config == nil {
+		return fmt.Errorf("config cannot be nil")
+	}
+
+	validated := validateAndFillDefaults(*config)
+
+	a.mutex.Lock()
+	// 읽기 전용 모드는 API로 해제할 수 없음 (설정 파일을 직접 수정해야 함)
+	if a.config != nil && a.config.Security.ReadOnly {
+		validated.Security.ReadOnly = true
+	}
+	err := a.configService.SaveConfig(&validated)
+	if err == nil {
+		a.config = &validated
+	}
+	a.mutex.Unlock()
+
+	if err == nil {
+		if logErr := initializeLogging(&validated.Logging, a.dataDir, nil); logErr != nil {
+			monitoring.LogWarn("Failed to apply logging configuration", "error", logErr)
+		}
+		if a.reportService != nil {
+			a.reportService.UpdateConfig(validated.Reports)
+		}
+		if patternErr := monitoring.SetGPUProcessNamePatterns(validated.Monitoring.GPUProcessInclude, validated.Monitoring.GPUProcessExclude); patternErr != nil {
+			monitoring.LogWarn("Failed to apply GPU process name patterns", "error", patternErr)
+		}
+		if vendorErr := monitoring.SetGPUVendorOverride(validated.GPU.Vendor); vendorErr != nil {
+			monitoring.LogWarn("Failed to apply GPU vendor override", "error", vendorErr)
+		}
+		monitoring.SetCacheStaleThreshold(time.Duration(validated.Monitoring.CacheStaleWarningSecs) * time.Second)
+		if languageErr := monitoring.SetLanguage(validated.UI.Language); languageErr != nil {
+			monitoring.LogWarn("Failed to apply message language", "error", languageErr)
+		}
+		a.applyProtectedProcesses(&validated)
+		if a.desktopNotifier != nil {
+			if notifyErr := a.desktopNotifier.SetPolicy(validated.Notifications.Policy()); notifyErr != nil {
+				monitoring.LogWarn("Failed to apply notification settings", "error", notifyErr)
+			}
+		}
+		if a.monitoringService != nil {
+			a.monitoringService.SetDiskPaths(validated.Monitoring.DiskPaths)
+			a.monitoringService.SetCollectorIntervals(validated.Monitoring.CollectorIntervalSecs)
+			a.monitoringService.SetBackgroundInterval(validated.Monitoring.BackgroundIntervalSecs)
+			a.monitoringService.ConfigureNetworkQuality(validated.NetworkQuality)
+			a.monitoringService.ConfigureFrameStats(validated.FrameStats)
+			a.monitoringService.ConfigureFanControl(effectiveFanControl(&validated))
+		}
+	}
+
+	message := "Configuration updated"
+	if err != nil {
+		message = fmt.Sprintf("Failed to save configuration: %v", err)
+	}
+	details, _ := json.Marshal(validated)
+	a.recordEvent(db.EventCategoryConfig, "update", a.configService.configPath, err == nil, message, string(details))
+
+	return err
+}
+
 // System information methods
 
 // GetSystemInfo retrieves system information
@@ -140,6 +313,126 @@ func (a *AppService) GetRealTimeMetrics() (*RealTimeMetrics, error) {
 	return a.monitoringService.GetRealTimeMetrics()
 }
 
+// GetSelfTelemetry retrieves HWnow's own resource usage and collector timings
+func (a *AppService) GetSelfTelemetry() (*monitoring.SelfTelemetry, error) {
+	return a.monitoringService.GetSelfTelemetry()
+}
+
+// GetCollectorSchedule retrieves the adaptive sampling budget and current collector intervals
+func (a *AppService) GetCollectorSchedule() (*monitoring.SchedulerStatus, error) {
+	status := a.monitoringService.GetCollectorSchedule()
+	return &status, nil
+}
+
+// PauseMonitoring pauses collection-triggering for a client session (e.g. hidden dashboard tab)
+func (a *AppService) PauseMonitoring(sessionID string) (*CollectionState, error) {
+	state := a.monitoringService.SetSessionPaused(sessionID, true)
+	return &state, nil
+}
+
+// ResumeMonitoring resumes collection-triggering for a client session
+func (a *AppService) ResumeMonitoring(sessionID string) (*CollectionState, error) {
+	state := a.monitoringService.SetSessionPaused(sessionID, false)
+	return &state, nil
+}
+
+// GetMonitoringState retrieves the per-session pause state of metric collection
+func (a *AppService) GetMonitoringState() (*CollectionState, error) {
+	state := a.monitoringService.GetCollectionState()
+	return &state, nil
+}
+
+// ReportVisibility records a client session's visible widgets and foreground state (e.g. widgets scrolled into view, minimized window)
+func (a *AppService) ReportVisibility(sessionID string, foreground bool, widgets []string) (*monitoring.ClientDemandStatus, error) {
+	status := a.monitoringService.SetSessionVisibility(sessionID, foreground, widgets)
+	return &status, nil
+}
+
+// EndSession forgets a disconnected client session so it no longer affects collection
+func (a *AppService) EndSession(sessionID string) (*monitoring.ClientDemandStatus, error) {
+	status := a.monitoringService.EndSession(sessionID)
+	return &status, nil
+}
+
+// GetClientDemand retrieves the reporting clients and the rate of every visibility-driven collector
+func (a *AppService) GetClientDemand() (*monitoring.ClientDemandStatus, error) {
+	status := a.monitoringService.GetClientDemand()
+	return &status, nil
+}
+
+// HealthReport summarizes whether HWnow is collecting metrics and can store them
+type HealthReport struct {
+	Status           string                       `json:"status"` // ok | degraded | unhealthy
+	Ready            bool                         `json:"ready"`  // DB 연결 + 최소 한 번의 수집 성공
+	CollectionPaused bool                         `json:"collection_paused"`
+	Subsystems       []monitoring.SubsystemHealth `json:"subsystems"`
+	Database         DatabaseHealth               `json:"database"`
+	GPUBackend       string                       `json:"gpu_backend"`
+	GPUAvailable     bool                         `json:"gpu_available"`
+	Timestamp        time.Time                    `json:"timestamp"`
+}
+
+// StatusReport lists the state and last error of every collector (GET /api/status)
+type StatusReport struct {
+	Collectors []monitoring.CollectorStatus `json:"collectors"`
+	Caches     []monitoring.CacheStatus     `json:"caches"` // 캐시된 GPU 정보/프로세스/비디오 컨트롤러의 나이
+	Errors     int                          `json:"errors"`    // 마지막 실행이 실패한 수집기 수
+	ReadOnly   bool                         `json:"read_only"` // security.read_only: 변경 API 비활성화
+	Timestamp  time.Time                    `json:"timestamp"`
+}
+
+// GetStatus reports per-collector state so the frontend can explain missing data instead of showing zeros
+func (a *AppService) GetStatus(language string) *StatusReport {
+	report := &StatusReport{
+		Collectors: a.monitoringService.GetCollectorStatuses(language),
+		Caches:     monitoring.GetCacheStatuses(),
+		ReadOnly:   a.IsReadOnly(),
+		Timestamp:  time.Now(),
+	}
+	for _, collector := range report.Collectors {
+		if collector.State == monitoring.CollectorStateError {
+			report.Errors++
+		}
+	}
+	return report
+}
+
+// GetHealth checks collector freshness, database connectivity and GPU backend availability
+func (a *AppService) GetHealth() *HealthReport {
+	report := &HealthReport{
+		Status:           "ok",
+		CollectionPaused: a.monitoringService.IsCollectionPaused(),
+		Subsystems:       a.monitoringService.GetCollectorHealth(),
+		Database:         a.databaseService.CheckHealth(),
+		Timestamp:        time.Now(),
+	}
+	report.GPUBackend, report.GPUAvailable = monitoring.GetGPUBackend()
+
+	// 한 번도 성공하지 않은 수집기(Wi-Fi 없는 데스크톱의 wifi 등)는 미지원으로 보고 상태 판정에서 제외
+	// 수집은 프론트엔드 조회 시점에 이루어지므로 모든 세션이 일시정지된 동안의 지연은 장애로 보지 않음
+	healthy, stale := 0, 0
+	for _, subsystem := range report.Subsystems {
+		switch {
+		case subsystem.LastSuccess.IsZero():
+		case subsystem.Healthy:
+			healthy++
+		default:
+			stale++
+		}
+	}
+	report.Ready = report.Database.Connected && healthy+stale > 0
+
+	switch {
+	case !report.Database.Connected:
+		report.Status = "unhealthy"
+	case !report.CollectionPaused && stale > 0 && healthy == 0:
+		report.Status = "unhealthy"
+	case (!report.CollectionPaused && stale > 0) || report.Database.SpillBytes > 0:
+		report.Status = "degraded"
+	}
+	return report
+}
+
 // GPU methods
 
 // GetGPUInfo retrieves GPU information
@@ -147,6 +440,11 @@ func (a *AppService) GetGPUInfo() (*monitoring.GPUInfo, error) {
 	return a.monitoringService.GetGPUInfo()
 }
 
+// GetGPUStaticInfo retrieves GPU driver and environment details
+func (a *AppService) GetGPUStaticInfo() ([]monitoring.GPUStaticInfo, error) {
+	return a.monitoringService.GetGPUStaticInfo()
+}
+
 // GetGPUProcesses retrieves GPU processes
 func (a *AppService) GetGPUProcesses() ([]monitoring.GPUProcess, error) {
 	return a.monitoringService.GetGPUProcesses()
@@ -167,6 +465,94 @@ func (a *AppService) GetTopProcesses(count int) ([]monitoring.ProcessInfo, error
 	return a.monitoringService.GetTopProcesses(count)
 }
 
+// GetTopProcessesWithDetail retrieves top processes with optional identity details ("full")
+func (a *AppService) GetTopProcessesWithDetail(count int, detail string) ([]monitoring.ProcessInfo, error) {
+	return a.monitoringService.GetTopProcessesWithDetail(count, detail)
+}
+
+// GetGPUProcessesWithDetail retrieves GPU processes with optional identity details ("full")
+func (a *AppService) GetGPUProcessesWithDetail(detail string) ([]monitoring.GPUProcess, error) {
+	return a.monitoringService.GetGPUProcessesWithDetail(detail)
+}
+
+// GetGPUInfoWithRefresh retrieves GPU information, collecting it again first when refresh is set (rate limited)
+func (a *AppService) GetGPUInfoWithRefresh(refresh bool) (*monitoring.GPUInfo, error) {
+	if refresh {
+		if err := monitoring.RequestGPURefresh(); err != nil {
+			return nil, err
+		}
+	}
+	return a.GetGPUInfo()
+}
+
+// GetGPUProcessesWithRefresh retrieves GPU processes, collecting them again first when refresh is set (rate limited)
+func (a *AppService) GetGPUProcessesWithRefresh(detail string, refresh bool) ([]monitoring.GPUProcess, error) {
+	if refresh {
+		if err := monitoring.RequestGPURefresh(); err != nil {
+			return nil, err
+		}
+	}
+	return a.GetGPUProcessesWithDetail(detail)
+}
+
+// GetProcessesFiltered retrieves processes with filtering, sorting and pagination
+func (a *AppService) GetProcessesFiltered(query monitoring.ProcessQuery) (*monitoring.ProcessResponse, error) {
+	return a.monitoringService.GetProcessesFiltered(query)
+}
+
+// GetProcessProfile retrieves the full profile of one process
+func (a *AppService) GetProcessProfile(pid int32) (*monitoring.ProcessProfile, error) {
+	return a.monitoringService.GetProcessProfile(pid)
+}
+
+// SearchProcesses fuzzy-matches running processes by name and command line
+func (a *AppService) SearchProcesses(query string, limit int) (*monitoring.ProcessSearchResponse, error) {
+	return a.monitoringService.SearchProcesses(query, limit)
+}
+
+// GetConnectionsFiltered retrieves network connections with filtering, sorting and pagination
+func (a *AppService) GetConnectionsFiltered(query monitoring.ConnectionQuery) (*monitoring.ConnectionResponse, error) {
+	return a.monitoringService.GetConnectionsFiltered(query)
+}
+
+// GetUserUsage retrieves CPU, memory and GPU usage aggregated by process owner
+func (a *AppService) GetUserUsage() ([]monitoring.UserUsage, error) {
+	return a.monitoringService.GetUserUsage()
+}
+
+// GetProcessGroups retrieves CPU, memory and GPU usage aggregated by application (process tree)
+func (a *AppService) GetProcessGroups() ([]monitoring.ProcessGroup, error) {
+	return a.monitoringService.GetProcessGroups()
+}
+
+// GetTopConsumers retrieves processes ranked by CPU or GPU time accumulated today
+func (a *AppService) GetTopConsumers(sortBy string, limit int) (*monitoring.TopConsumersResponse, error) {
+	return a.monitoringService.GetTopConsumers(sortBy, limit)
+}
+
+// GetRecentMetrics retrieves the last seconds of a metric from memory in the configured units
+func (a *AppService) GetRecentMetrics(metricType string, seconds int) *monitoring.RecentMetrics {
+	var preferences monitoring.UnitPreferences
+	if a.config != nil {
+		preferences = a.config.Units.Preferences()
+	}
+	return a.monitoringService.GetRecentMetrics(metricType, time.Duration(seconds)*time.Second, preferences)
+}
+
+// GetWidgetData retrieves the current value, sparkline and secondary values of a widget in the configured units
+func (a *AppService) GetWidgetData(widgetType string, points int) (*monitoring.WidgetData, error) {
+	var preferences monitoring.UnitPreferences
+	if a.config != nil {
+		preferences = a.config.Units.Preferences()
+	}
+	return a.monitoringService.GetWidgetData(widgetType, points, preferences)
+}
+
+// GetTypedSnapshot retrieves the latest metrics grouped by subsystem (optionally with the legacy flat metric list)
+func (a *AppService) GetTypedSnapshot(includeLegacy bool) (*monitoring.TypedSnapshot, error) {
+	return a.monitoringService.GetTypedSnapshot(includeLegacy)
+}
+
 
 // Page management methods
 
@@ -192,24 +578,243 @@ func (a *AppService) UpdatePageName(userID, pageID, pageName string) *PageResult
 
 // GPU control methods
 
+// ErrReadOnlyMode is returned by mutating operations when security.read_only is set
+var ErrReadOnlyMode = errors.New("read-only mode: changes are disabled (security.read_only in configuration)")
+
+// IsReadOnly reports whether mutating operations are disabled by security.read_only
+func (a *AppService) IsReadOnly() bool {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.config != nil && a.config.Security.ReadOnly
+}
+
+// readOnlyControlResult is the failed result returned for process control requests in read-only mode
+func readOnlyControlResult(pid int32, operation, priority string) *GPUProcessControlResult {
+	return &GPUProcessControlResult{PID: pid, Success: false, Message: ErrReadOnlyMode.Error(), Operation: operation, Priority: priority}
+}
+
 // KillGPUProcess kills a GPU process
 func (a *AppService) KillGPUProcess(pid int32) *GPUProcessControlResult {
-	return a.gpuControlService.KillProcess(pid)
+	if a.IsReadOnly() {
+		return readOnlyControlResult(pid, "kill", "")
+	}
+	result := a.gpuControlService.KillProcess(pid)
+	a.recordProcessControlEvent(result)
+	return result
 }
 
 // SuspendGPUProcess suspends a GPU process
 func (a *AppService) SuspendGPUProcess(pid int32) *GPUProcessControlResult {
-	return a.gpuControlService.SuspendProcess(pid)
+	if a.IsReadOnly() {
+		return readOnlyControlResult(pid, "suspend", "")
+	}
+	result := a.gpuControlService.SuspendProcess(pid)
+	a.recordProcessControlEvent(result)
+	return result
 }
 
 // ResumeGPUProcess resumes a GPU process
 func (a *AppService) ResumeGPUProcess(pid int32) *GPUProcessControlResult {
-	return a.gpuControlService.ResumeProcess(pid)
+	if a.IsReadOnly() {
+		return readOnlyControlResult(pid, "resume", "")
+	}
+	result := a.gpuControlService.ResumeProcess(pid)
+	a.recordProcessControlEvent(result)
+	return result
+}
+
+// PreviewGPUProcessControl reports whether a process control request would be allowed without performing it
+func (a *AppService) PreviewGPUProcessControl(pid int32, operation, priority string) (*monitoring.ControlPreview, error) {
+	preview, err := a.gpuControlService.PreviewControl(pid, operation, priority)
+	if err != nil {
+		return nil, err
+	}
+	if a.IsReadOnly() {
+		preview.AddCheck(monitoring.ControlCheckReadOnly, false, true, ErrReadOnlyMode.Error())
+	}
+	return preview, nil
+}
+
+// GetProcessPriority retrieves the priority currently applied to a process
+func (a *AppService) GetProcessPriority(pid int32) (*monitoring.ProcessPriority, error) {
+	return a.gpuControlService.GetProcessPriority(pid)
 }
 
 // SetGPUProcessPriority sets the priority of a GPU process
 func (a *AppService) SetGPUProcessPriority(pid int32, priority string) *GPUProcessControlResult {
-	return a.gpuControlService.SetProcessPriority(pid, priority)
+	if a.IsReadOnly() {
+		return readOnlyControlResult(pid, "priority", priority)
+	}
+	result := a.gpuControlService.SetProcessPriority(pid, priority)
+	a.recordProcessControlEvent(result)
+	return result
+}
+
+// SetProcessLimits caps the CPU and memory of a process (cgroup v2 on Linux, Job Object on Windows)
+func (a *AppService) SetProcessLimits(pid int32, cpuPercent, memoryMB float64) (*monitoring.ProcessLimits, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	limits, err := a.gpuControlService.SetProcessLimits(pid, cpuPercent, memoryMB)
+
+	message := fmt.Sprintf("Process limits set to CPU %.1f%%, memory %.0f MB", cpuPercent, memoryMB)
+	if err != nil {
+		message = fmt.Sprintf("Failed to set process limits: %v", err)
+	}
+	details := fmt.Sprintf(`{"cpu_percent":%g,"memory_mb":%g}`, cpuPercent, memoryMB)
+	a.recordEvent(db.EventCategoryProcessControl, "limit", fmt.Sprintf("%d", pid), err == nil, message, details)
+
+	return limits, err
+}
+
+// GetProcessProtectionAudit lists the active process protection rules and up to limit recent control decisions
+func (a *AppService) GetProcessProtectionAudit(limit int) *monitoring.ProtectionAudit {
+	return monitoring.GetProcessProtectionAudit(limit)
+}
+
+// GetGPUPowerLimits retrieves power limits and clock settings of NVIDIA GPUs
+func (a *AppService) GetGPUPowerLimits() ([]monitoring.GPUPowerLimits, error) {
+	ctx, cancel := a.monitoringService.queryContext()
+	defer cancel()
+	return monitoring.GetGPUPowerLimits(ctx)
+}
+
+// SetGPUPowerLimit changes the power limit of an NVIDIA GPU when enabled in configuration
+func (a *AppService) SetGPUPowerLimit(index int, watts float64) (*monitoring.GPUPowerLimits, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	a.mutex.RLock()
+	allowed := a.config != nil && a.config.GPUControl.AllowPowerLimit
+	a.mutex.RUnlock()
+	if !allowed {
+		return nil, fmt.Errorf("GPU power limit changes are disabled (enable gpu_control.allow_power_limit in configuration)")
+	}
+
+	limits, err := monitoring.SetGPUPowerLimit(index, watts)
+
+	message := fmt.Sprintf("GPU %d power limit set to %.1f W", index, watts)
+	if err != nil {
+		message = fmt.Sprintf("Failed to set GPU %d power limit: %v", index, err)
+	}
+	a.recordEvent(db.EventCategoryConfig, "gpu_power_limit", fmt.Sprintf("gpu%d", index), err == nil, message,
+		fmt.Sprintf(`{"watts":%g}`, watts))
+
+	return limits, err
+}
+
+// GetFans retrieves fan speeds and PWM state (Linux hwmon)
+func (a *AppService) GetFans() ([]monitoring.Fan, error) {
+	return a.monitoringService.GetFans()
+}
+
+// GetThrottleInfo retrieves CPU/GPU throttle state, per-core clocks and accumulated throttled time
+func (a *AppService) GetThrottleInfo() (*monitoring.ThrottleInfo, error) {
+	return a.monitoringService.GetThrottleInfo()
+}
+
+// GetGPUECCStatus retrieves ECC error counters and retired/remapped memory pages of NVIDIA GPUs
+func (a *AppService) GetGPUECCStatus() ([]monitoring.GPUECCStatus, error) {
+	return a.monitoringService.GetGPUECCStatus()
+}
+
+// GetSecurityContext reports UAC/sudo state, process privileges and recommendations in the given language
+func (a *AppService) GetSecurityContext(language string) (*monitoring.SecurityContext, error) {
+	ctx, err := monitoring.GetSecurityContext()
+	if ctx != nil {
+		monitoring.LocalizeSecurityContext(ctx, language)
+	}
+	return ctx, err
+}
+
+// ELEVATION_QUIT_DELAY lets the elevation response reach the frontend before this instance quits
+const ELEVATION_QUIT_DELAY = time.Second
+
+// RequestElevation relaunches the application elevated (Windows) or returns the sudo command to run (Linux/macOS);
+// after a successful relaunch this instance quits so the elevated one can open the database and window
+func (a *AppService) RequestElevation(language string) (*monitoring.ElevationResult, error) {
+	result, err := monitoring.RequestElevation(language)
+	if err != nil {
+		return nil, err
+	}
+	if result.Relaunched && a.nativeUIService != nil {
+		time.AfterFunc(ELEVATION_QUIT_DELAY, a.nativeUIService.QuitApplication)
+	}
+	return result, nil
+}
+
+// RedetectGPUVendor clears the latched GPU vendor and detects it again (a configured vendor is kept)
+func (a *AppService) RedetectGPUVendor() monitoring.GPUVendorStatus {
+	status := monitoring.RedetectGPUVendor()
+	monitoring.LogInfo("GPU vendor re-detected", "vendor", status.Vendor, "override", status.Override)
+	return status
+}
+
+// GetNetworkErrors retrieves error/drop/collision counters and rates of every network interface
+func (a *AppService) GetNetworkErrors() ([]monitoring.NetworkInterfaceErrors, error) {
+	return a.monitoringService.GetNetworkErrors()
+}
+
+// SetFanPWM sets a manual fan duty when enabled in configuration
+func (a *AppService) SetFanPWM(fanID string, percent float64) (*monitoring.Fan, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	a.mutex.RLock()
+	allowed := a.config != nil && a.config.FanControl.AllowPWMControl
+	a.mutex.RUnlock()
+	if !allowed {
+		return nil, fmt.Errorf("fan PWM changes are disabled (enable fan_control.allow_pwm_control in configuration)")
+	}
+
+	fan, err := a.monitoringService.SetFanPWM(fanID, percent)
+
+	message := fmt.Sprintf("Fan %s duty set to %.0f%%", fanID, percent)
+	if err != nil {
+		message = fmt.Sprintf("Failed to set fan %s duty: %v", fanID, err)
+	}
+	a.recordEvent(db.EventCategoryConfig, "fan_pwm", fanID, err == nil, message, fmt.Sprintf(`{"percent":%g}`, percent))
+
+	return fan, err
+}
+
+// GetFanCurves returns the configured fan curves
+func (a *AppService) GetFanCurves() []monitoring.FanCurve {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	if a.config == nil {
+		return []monitoring.FanCurve{}
+	}
+	return append([]monitoring.FanCurve{}, a.config.FanControl.Curves...)
+}
+
+// SetFanCurves validates and persists the fan curves (applied only while fan_control.allow_pwm_control is enabled)
+func (a *AppService) SetFanCurves(curves []monitoring.FanCurve) error {
+	if a.IsReadOnly() {
+		return ErrReadOnlyMode
+	}
+	seen := make(map[string]bool)
+	for _, curve := range curves {
+		if err := curve.Validate(); err != nil {
+			return err
+		}
+		if seen[curve.FanID] {
+			return fmt.Errorf("duplicate fan curve for %s", curve.FanID)
+		}
+		seen[curve.FanID] = true
+	}
+
+	a.mutex.RLock()
+	if a.config == nil {
+		a.mutex.RUnlock()
+		return fmt.Errorf("configuration not loaded")
+	}
+	config := *a.config
+	a.mutex.RUnlock()
+
+	config.FanControl.Curves = curves
+	return a.UpdateConfig(&config)
 }
 
 // ValidateGPUProcess validates if a process is a valid GPU process
@@ -217,6 +822,27 @@ func (a *AppService) ValidateGPUProcess(pid int32) *GPUProcessValidationResult {
 	return a.gpuControlService.ValidateProcess(pid)
 }
 
+// SetGPUProcessNamePatterns validates and persists the GPU process include/exclude name patterns
+func (a *AppService) SetGPUProcessNamePatterns(include, exclude string) error {
+	for _, pattern := range []string{include, exclude} {
+		if _, err := monitoring.CompileGPUProcessPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	a.mutex.RLock()
+	if a.config == nil {
+		a.mutex.RUnlock()
+		return fmt.Errorf("configuration not loaded")
+	}
+	config := *a.config
+	a.mutex.RUnlock()
+
+	config.Monitoring.GPUProcessInclude = include
+	config.Monitoring.GPUProcessExclude = exclude
+	return a.UpdateConfig(&config)
+}
+
 // SetGPUProcessMonitoring enables or disables GPU process monitoring
 func (a *AppService) SetGPUProcessMonitoring(enabled bool) {
 	a.gpuControlService.SetGPUProcessMonitoring(enabled)
@@ -265,6 +891,1056 @@ func (a *AppService) DeleteWidget(userID, pageID, widgetID string) *WidgetResult
 	return a.databaseService.DeleteWidget(userID, pageID, widgetID)
 }
 
+// GetWidgetStates retrieves the widgets of several pages at once
+func (a *AppService) GetWidgetStates(userID string, pageIDs []string) (*WidgetStateBundle, error) {
+	return a.databaseService.GetWidgetStates(userID, pageIDs)
+}
+
+// SaveWidgetStates validates and saves the widgets of several pages at once
+func (a *AppService) SaveWidgetStates(bundle WidgetStateBundle) (*WidgetStateBundle, error) {
+	return a.databaseService.SaveWidgetStates(bundle)
+}
+
+// GetWidgetSchemas returns the config schema of every registered widget type
+func (a *AppService) GetWidgetSchemas() []monitoring.WidgetSchema {
+	return monitoring.GetWidgetSchemas()
+}
+
+// Logging methods
+
+// GetLogSettings returns the current runtime logging configuration
+func (a *AppService) GetLogSettings() monitoring.LogSettings {
+	return monitoring.GetLogSettings()
+}
+
+// SetLogLevel changes the log level at runtime (not persisted to the config file)
+func (a *AppService) SetLogLevel(level string) error {
+	logLevel, err := monitoring.ParseLogLevel(level)
+	if err != nil {
+		return err
+	}
+	monitoring.SetLogLevel(logLevel)
+	monitoring.LogInfo("Log level changed", "level", logLevel.String())
+	return nil
+}
+
+// SetGPUMonitoringLogs toggles verbose GPU monitoring logs at runtime
+func (a *AppService) SetGPUMonitoringLogs(enabled bool) {
+	monitoring.SetGPUMonitoringLogsEnabled(enabled)
+}
+
+// initializeLogging applies the logging configuration
+// console가 nil이면 stdout에 출력
+func initializeLogging(config *LoggingConfig, dataDir string, console io.Writer) error {
+	level, err := monitoring.ParseLogLevel(config.Level)
+	if err != nil {
+		return err
+	}
+	monitoring.SetGPUMonitoringLogsEnabled(config.GPUMonitoringLogs)
+
+	// 상대 경로 로그 파일은 작업 디렉터리가 아닌 데이터 디렉터리 기준
+	filePath := config.File
+	if filePath != "" && !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(dataDir, filePath)
+	}
+	return monitoring.InitializeLogging(monitoring.LogOptions{
+		Level:      level,
+		Format:     config.Format,
+		FilePath:   filePath,
+		MaxSizeMB:  config.MaxSizeMB,
+		MaxBackups: config.MaxBackups,
+		Console:    console,
+	})
+}
+
+// Event log methods
+
+// GetEvents retrieves audit trail entries with filtering and pagination
+func (a *AppService) GetEvents(query db.EventQuery) *EventResult {
+	return a.databaseService.GetEvents(query)
+}
+
+// GetResourceHistory retrieves long-term metric history
+func (a *AppService) GetResourceHistory(query db.ResourceHistoryQuery) *HistoryResult {
+	result := a.databaseService.GetResourceHistory(query)
+
+	// DB에는 기본 단위로 저장되어 있으므로 설정된 선호 단위로 변환
+	var preferences monitoring.UnitPreferences
+	if a.config != nil {
+		preferences = a.config.Units.Preferences()
+	}
+	for i := range result.Points {
+		point := &result.Points[i]
+		point.Avg, point.Unit = preferences.ConvertValue(point.MetricType, point.Avg)
+		point.Min, _ = preferences.ConvertValue(point.MetricType, point.Min)
+		point.Max, _ = preferences.ConvertValue(point.MetricType, point.Max)
+		// 표준편차는 값의 차이이므로 오프셋(°C → °F의 +32) 없이 배율만 적용
+		offset, _ := preferences.ConvertValue(point.MetricType, 0)
+		stdDev, _ := preferences.ConvertValue(point.MetricType, point.StdDev)
+		point.StdDev = stdDev - offset
+		for _, percentile := range []*float64{point.P50, point.P95, point.P99} {
+			if percentile != nil {
+				*percentile, _ = preferences.ConvertValue(point.MetricType, *percentile)
+			}
+		}
+	}
+	return result
+}
+
+// 온도 프로파일 최대 조회 기간 (1시간 집계 기준)
+const MAX_THERMAL_PROFILE_DAYS = 90
+
+// 장치 목록과 함께 반환하는 최근 연결/분리 이벤트 수
+const DEVICE_INVENTORY_EVENT_LIMIT = 20
+
+// DeviceInventoryReport combines the connected devices with recent connect/disconnect events
+type DeviceInventoryReport struct {
+	Inventory *monitoring.DeviceInventory `json:"inventory"`
+	Events    []db.Event                  `json:"events"` // 최근 연결/분리 이벤트 (최신 순)
+}
+
+// GetDeviceInventory returns connected USB devices and printers with recent connect/disconnect events
+func (a *AppService) GetDeviceInventory() (*DeviceInventoryReport, error) {
+	ctx, cancel := a.monitoringService.queryContext()
+	defer cancel()
+	inventory, err := monitoring.GetDeviceInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DeviceInventoryReport{Inventory: inventory, Events: []db.Event{}}
+	// 하드웨어 범주에는 오류 이벤트도 있으므로 장치 연결/분리만 추림
+	result := a.databaseService.GetEvents(db.EventQuery{Category: db.EventCategoryHardware, MaxItems: DEVICE_INVENTORY_EVENT_LIMIT * 5})
+	if !result.Success {
+		monitoring.LogWarn("Failed to load device events", "error", result.Message)
+		return report, nil
+	}
+	for _, event := range result.Events {
+		if strings.HasPrefix(event.Action, "device_") && len(report.Events) < DEVICE_INVENTORY_EVENT_LIMIT {
+			report.Events = append(report.Events, event)
+		}
+	}
+	return report, nil
+}
+
+// GetThermalProfiles returns daily temperature profiles of the last days (starting at local midnight)
+func (a *AppService) GetThermalProfiles(days int) ([]db.ThermalProfile, error) {
+	if days <= 0 || days > MAX_THERMAL_PROFILE_DAYS {
+		return nil, fmt.Errorf("days must be between 1 and %d", MAX_THERMAL_PROFILE_DAYS)
+	}
+	now := time.Now()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return a.databaseService.GetThermalProfiles(nil, midnight.AddDate(0, 0, -(days-1)), now)
+}
+
+// 에너지 요약 조회 기간 (1분 집계 보관 기간 안에서만 정확함)
+var ENERGY_WINDOWS = map[string]time.Duration{
+	"1h":  time.Hour,
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// 에너지 추정에 사용하는 전력 메트릭 (CPU/GPU/기타 부품을 합산한 추정 시스템 전력)
+const ENERGY_POWER_METRIC = "system_power_watts"
+
+// EnergySummary is the estimated energy use, electricity cost and CO2 emissions over a window
+type EnergySummary struct {
+	Window            string    `json:"window"`
+	Since             time.Time `json:"since"`
+	Until             time.Time `json:"until"`
+	EnergyKWh         float64   `json:"energy_kwh"`
+	AvgWatts          float64   `json:"avg_watts"`
+	PeakWatts         float64   `json:"peak_watts"`
+	Cost              float64   `json:"cost"`
+	Currency          string    `json:"currency"`
+	CO2Grams          float64   `json:"co2_grams"`
+	PricePerKWh       float64   `json:"price_per_kwh"`
+	CarbonGramsPerKWh float64   `json:"carbon_grams_per_kwh"`
+	Coverage          float64   `json:"coverage"` // 전력 기록이 있는 시간 비율 (0-1, 앱이 꺼져 있던 시간은 0 W로 계산됨)
+}
+
+// GetEnergySummary estimates energy, cost and CO2 of the system power draw over a window (1h, 24h, 7d, 30d)
+func (a *AppService) GetEnergySummary(window string) (*EnergySummary, error) {
+	duration, ok := ENERGY_WINDOWS[window]
+	if !ok {
+		return nil, fmt.Errorf("unsupported window %q", window)
+	}
+	until := time.Now()
+	since := until.Add(-duration)
+	usage, err := a.databaseService.GetEnergyUsage(ENERGY_POWER_METRIC, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	energy := a.GetConfig().Energy
+	kWh := usage.EnergyWh / 1000
+	return &EnergySummary{
+		Window:            window,
+		Since:             since,
+		Until:             until,
+		EnergyKWh:         kWh,
+		AvgWatts:          usage.AvgWatts,
+		PeakWatts:         usage.PeakWatts,
+		Cost:              kWh * energy.PricePerKWh,
+		Currency:          energy.Currency,
+		CO2Grams:          kWh * energy.CarbonGramsPerKWh,
+		PricePerKWh:       energy.PricePerKWh,
+		CarbonGramsPerKWh: energy.CarbonGramsPerKWh,
+		Coverage:          math.Min(float64(usage.CoveredSeconds)/duration.Seconds(), 1),
+	}, nil
+}
+
+// GetAvailability computes host uptime percentage and reboot history over the last days
+func (a *AppService) GetAvailability(days int) *AvailabilityResult {
+	if days <= 0 {
+		return &AvailabilityResult{
+			Success:   false,
+			Message:   "days must be positive",
+			ErrorCode: 400,
+		}
+	}
+	now := time.Now()
+	return a.databaseService.GetAvailability(now.AddDate(0, 0, -days), now)
+}
+
+// GetSnapshotDiff reports processes that appeared/disappeared and metrics that shifted between two points in time
+func (a *AppService) GetSnapshotDiff(query db.SnapshotDiffQuery) *SnapshotDiffResult {
+	return a.databaseService.GetSnapshotDiff(query)
+}
+
+// GetReport generates a summary report for the given period (daily or weekly)
+func (a *AppService) GetReport(period string) (*Report, error) {
+	if a.reportService == nil {
+		return nil, fmt.Errorf("report service not initialized")
+	}
+	return a.reportService.Generate(period)
+}
+
+// Process watchdog methods
+
+// GetWatchedProcesses returns the process watchdog list
+func (a *AppService) GetWatchedProcesses() ([]db.WatchedProcess, error) {
+	return a.databaseService.GetWatchedProcesses()
+}
+
+// SaveWatchedProcess adds (ID 0) or updates a watched process and applies the watch list
+func (a *AppService) SaveWatchedProcess(watched db.WatchedProcess) (*db.WatchedProcess, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	watched.Name = strings.TrimSpace(watched.Name)
+	watched.RestartCommand = strings.TrimSpace(watched.RestartCommand)
+	if watched.Name == "" {
+		return nil, fmt.Errorf("process name cannot be empty")
+	}
+	if watched.MaxCPUPercent < 0 || watched.MaxMemoryMB < 0 {
+		return nil, fmt.Errorf("resource limits cannot be negative")
+	}
+
+	id, err := a.databaseService.SaveWatchedProcess(watched)
+	if err != nil {
+		return nil, err
+	}
+	watched.ID = id
+	a.reloadWatchedProcesses()
+
+	a.recordEvent(db.EventCategoryWatchdog, "watch_saved", watched.Name, true,
+		fmt.Sprintf("Watching process %s", watched.Name), "")
+	return &watched, nil
+}
+
+// RemoveWatchedProcess removes a watched process and applies the watch list
+func (a *AppService) RemoveWatchedProcess(id int64) error {
+	if a.IsReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if err := a.databaseService.DeleteWatchedProcess(id); err != nil {
+		return err
+	}
+	a.reloadWatchedProcesses()
+
+	a.recordEvent(db.EventCategoryWatchdog, "watch_removed", fmt.Sprintf("%d", id), true,
+		fmt.Sprintf("Stopped watching process %d", id), "")
+	return nil
+}
+
+// reloadWatchedProcesses loads enabled watch list entries into the watchdog
+func (a *AppService) reloadWatchedProcesses() {
+	if a.processWatchdog == nil {
+		return
+	}
+
+	watched, err := a.databaseService.GetWatchedProcesses()
+	if err != nil {
+		monitoring.LogWarn("Failed to load watched processes", "error", err)
+		return
+	}
+
+	rules := make([]monitoring.ProcessWatchRule, 0, len(watched))
+	for _, w := range watched {
+		if !w.Enabled {
+			continue
+		}
+		rules = append(rules, monitoring.ProcessWatchRule{
+			ID:             w.ID,
+			Name:           w.Name,
+			RestartCommand: w.RestartCommand,
+			MaxCPUPercent:  w.MaxCPUPercent,
+			MaxMemoryMB:    w.MaxMemoryMB,
+		})
+	}
+	a.processWatchdog.SetRules(rules)
+}
+
+// Protected process methods
+
+// GetProtectedProcesses returns the user-defined protected processes stored in the database
+func (a *AppService) GetProtectedProcesses() ([]db.ProtectedProcess, error) {
+	return a.databaseService.GetProtectedProcesses()
+}
+
+// SaveProtectedProcess adds (ID 0) or updates a stored protected process and applies the protection rules
+func (a *AppService) SaveProtectedProcess(protected db.ProtectedProcess) (*db.ProtectedProcess, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	protected.Name = strings.TrimSpace(protected.Name)
+	protected.Pattern = strings.TrimSpace(protected.Pattern)
+	protected.Level = strings.ToLower(strings.TrimSpace(protected.Level))
+	protected.Description = strings.TrimSpace(protected.Description)
+	rule := monitoring.ProtectedProcessRule{Name: protected.Name, Pattern: protected.Pattern, Level: protected.Level}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := a.databaseService.SaveProtectedProcess(protected)
+	if err != nil {
+		return nil, err
+	}
+	protected.ID = id
+	a.applyProtectedProcesses(a.GetConfig())
+
+	a.recordEvent(db.EventCategoryConfig, "protected_process_saved", protected.Name, true,
+		fmt.Sprintf("Protecting process %s (%s)", protected.Name, protected.Level), "")
+	return &protected, nil
+}
+
+// RemoveProtectedProcess removes a stored protected process and applies the protection rules
+func (a *AppService) RemoveProtectedProcess(id int64) error {
+	if a.IsReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if err := a.databaseService.DeleteProtectedProcess(id); err != nil {
+		return err
+	}
+	a.applyProtectedProcesses(a.GetConfig())
+
+	a.recordEvent(db.EventCategoryConfig, "protected_process_removed", fmt.Sprintf("%d", id), true,
+		fmt.Sprintf("Stopped protecting process %d", id), "")
+	return nil
+}
+
+// applyProtectedProcesses merges the configured (security.protected_processes) and stored protected
+// processes into the process protection service
+func (a *AppService) applyProtectedProcesses(config *Config) {
+	var rules []monitoring.ProtectedProcessRule
+	if config != nil {
+		rules = append(rules, config.Security.ProtectedProcesses...)
+	}
+
+	if a.databaseService != nil {
+		stored, err := a.databaseService.GetProtectedProcesses()
+		if err != nil {
+			monitoring.LogWarn("Failed to load protected processes", "error", err)
+		}
+		for _, p := range stored {
+			rules = append(rules, monitoring.ProtectedProcessRule{
+				Name:        p.Name,
+				Pattern:     p.Pattern,
+				Level:       p.Level,
+				Description: p.Description,
+			})
+		}
+	}
+
+	if err := monitoring.SetUserProtectedProcesses(rules); err != nil {
+		monitoring.LogWarn("Failed to apply protected processes", "error", err)
+	}
+}
+
+// ErrSpeedtestRunning is returned when a speed test is requested while another is in progress
+var ErrSpeedtestRunning = errors.New("speedtest already running")
+
+// StartSpeedtest starts a bandwidth measurement in the background.
+// Progress is emitted as "network:speedtest-progress" and the outcome as "network:speedtest-result"
+func (a *AppService) StartSpeedtest() error {
+	a.mutex.Lock()
+	if a.speedtestRunning {
+		a.mutex.Unlock()
+		return ErrSpeedtestRunning
+	}
+	a.speedtestRunning = true
+	ctx := a.ctx
+	a.mutex.Unlock()
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	go a.runSpeedtest(ctx)
+	return nil
+}
+
+// runSpeedtest performs the measurement, streams progress and records the result
+func (a *AppService) runSpeedtest(ctx context.Context) {
+	defer func() {
+		a.mutex.Lock()
+		a.speedtestRunning = false
+		a.mutex.Unlock()
+	}()
+
+	result, err := monitoring.RunSpeedtest(ctx, func(progress monitoring.SpeedtestProgress) {
+		if a.nativeUIService != nil {
+			a.nativeUIService.EmitEvent("network:speedtest-progress", progress)
+		}
+	})
+
+	payload := map[string]interface{}{"result": result}
+	if err != nil {
+		monitoring.LogWarn("Speedtest failed", "error", err)
+		payload = map[string]interface{}{"error": err.Error()}
+		a.recordEvent(db.EventCategoryNetwork, "speedtest", "", false, err.Error(), "")
+	} else {
+		message := fmt.Sprintf("Download %.1f Mbps, upload %.1f Mbps", result.DownloadMbps, result.UploadMbps)
+		details := ""
+		if data, marshalErr := json.Marshal(result); marshalErr == nil {
+			details = string(data)
+		}
+		a.recordEvent(db.EventCategoryNetwork, "speedtest", result.Server, true, message, details)
+	}
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("network:speedtest-result", payload)
+	}
+}
+
+// ErrDiskScanRunning is returned when a directory scan is requested while another is in progress
+var ErrDiskScanRunning = errors.New("disk scan already running")
+
+// StartDiskScan validates the options and starts a directory size scan in the background.
+// Progress is emitted as "disk:scan-progress" and the outcome as "disk:scan-result"
+func (a *AppService) StartDiskScan(options monitoring.DiskScanOptions) error {
+	options, err := monitoring.NormalizeDiskScanOptions(options)
+	if err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	if a.diskScanRunning {
+		a.mutex.Unlock()
+		return ErrDiskScanRunning
+	}
+	a.diskScanRunning = true
+	ctx := a.ctx
+	a.mutex.Unlock()
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	go a.runDiskScan(ctx, options)
+	return nil
+}
+
+// runDiskScan performs the scan and streams progress and the result to the frontend
+func (a *AppService) runDiskScan(ctx context.Context, options monitoring.DiskScanOptions) {
+	defer func() {
+		a.mutex.Lock()
+		a.diskScanRunning = false
+		a.mutex.Unlock()
+	}()
+
+	result, err := monitoring.ScanDirectory(ctx, options, func(progress monitoring.DiskScanProgress) {
+		if a.nativeUIService != nil {
+			a.nativeUIService.EmitEvent("disk:scan-progress", progress)
+		}
+	})
+
+	payload := map[string]interface{}{"result": result}
+	if err != nil {
+		monitoring.LogWarn("Disk scan failed", "path", options.Path, "error", err)
+		payload = map[string]interface{}{"path": options.Path, "error": err.Error()}
+	} else {
+		monitoring.LogInfo("Disk scan completed", "path", result.Path, "totalBytes", result.TotalBytes,
+			"files", result.FileCount, "truncated", result.Truncated, "duration", result.Duration)
+	}
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("disk:scan-result", payload)
+	}
+}
+
+// ErrStressTestRunning is returned when a load generator is started while another is running
+var ErrStressTestRunning = errors.New("stress test already running")
+
+// ErrStressTestNotRunning is returned when stopping while no load generator is running
+var ErrStressTestNotRunning = errors.New("no stress test running")
+
+// 부하 구간 요약에 포함되는 지표 (냉각/전력 비교용)
+var stressTestMetricTypes = []string{"cpu", "cpu_temperature", "gpu_usage", "gpu_temperature", "gpu_power", "system_power_watts"}
+
+// StressTestReport pairs a recorded run with the metrics collected during its window
+type StressTestReport struct {
+	Run     db.StressTestRun   `json:"run"`
+	Metrics []db.MetricSummary `json:"metrics"`
+}
+
+// StressTestComparison compares the metric averages of two runs (e.g. before and after tuning)
+type StressTestComparison struct {
+	Before  *StressTestReport `json:"before"`
+	After   *StressTestReport `json:"after"`
+	Changes []db.MetricChange `json:"changes"`
+}
+
+// StartStressTest validates the options and starts a CPU or GPU load generator in the background.
+// Progress is emitted as "stress:progress" and the outcome as "stress:result"; the run window is
+// stored so the metrics recorded under load can be compared later
+func (a *AppService) StartStressTest(options monitoring.StressTestOptions) error {
+	if a.IsReadOnly() {
+		return ErrReadOnlyMode
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.stressTestCancel != nil {
+		return ErrStressTestRunning
+	}
+
+	maxDuration := monitoring.STRESS_MAX_DURATION
+	if a.config != nil {
+		options.GPUCommand = a.config.StressTest.GPUCommand
+		options.GPUArgs = a.config.StressTest.GPUArgs
+		maxDuration = time.Duration(a.config.StressTest.MaxDurationSeconds) * time.Second
+	}
+	options, err := monitoring.NormalizeStressTestOptions(options, maxDuration)
+	if err != nil {
+		return err
+	}
+
+	parent := a.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	a.stressTestCancel = cancel
+	go a.runStressTest(ctx, options)
+	return nil
+}
+
+// StopStressTest stops the running load generator early
+func (a *AppService) StopStressTest() error {
+	a.mutex.RLock()
+	cancel := a.stressTestCancel
+	a.mutex.RUnlock()
+
+	if cancel == nil {
+		return ErrStressTestNotRunning
+	}
+	cancel()
+	return nil
+}
+
+// runStressTest generates load, records the run window and notifies the frontend
+func (a *AppService) runStressTest(ctx context.Context, options monitoring.StressTestOptions) {
+	defer func() {
+		a.mutex.Lock()
+		if a.stressTestCancel != nil {
+			a.stressTestCancel()
+			a.stressTestCancel = nil
+		}
+		a.mutex.Unlock()
+	}()
+
+	monitoring.LogInfo("Stress test started", "kind", options.Kind, "threads", options.Threads,
+		"durationSeconds", options.DurationSeconds, "label", options.Label)
+	result, err := monitoring.RunStressTest(ctx, options, func(progress monitoring.StressTestProgress) {
+		if a.nativeUIService != nil {
+			a.nativeUIService.EmitEvent("stress:progress", progress)
+		}
+	})
+
+	payload := map[string]interface{}{"result": result}
+	if result != nil {
+		run := db.StressTestRun{
+			Kind:      result.Kind,
+			Label:     result.Label,
+			Threads:   result.Threads,
+			StartedAt: result.StartedAt,
+			EndedAt:   result.EndedAt,
+			Stopped:   result.Stopped,
+		}
+		if id, saveErr := a.databaseService.SaveStressTestRun(run); saveErr != nil {
+			monitoring.LogWarn("Failed to record stress test run", "error", saveErr)
+		} else {
+			run.ID = id
+			payload["run"] = run
+		}
+	}
+
+	var message string
+	if err != nil {
+		monitoring.LogWarn("Stress test failed", "kind", options.Kind, "error", err)
+		payload["error"] = err.Error()
+		message = err.Error()
+	} else {
+		message = fmt.Sprintf("%s load for %.0f seconds", strings.ToUpper(result.Kind), result.DurationSeconds)
+		if result.Stopped {
+			message += " (stopped early)"
+		}
+		monitoring.LogInfo("Stress test finished", "kind", result.Kind, "duration", result.DurationSeconds, "stopped", result.Stopped)
+	}
+	details := ""
+	if result != nil {
+		if data, marshalErr := json.Marshal(result); marshalErr == nil {
+			details = string(data)
+		}
+	}
+	a.recordEvent(db.EventCategoryStressTest, "run", options.Label, err == nil, message, details)
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("stress:result", payload)
+	}
+}
+
+// IsStressTestRunning reports whether a load generator is running
+func (a *AppService) IsStressTestRunning() bool {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.stressTestCancel != nil
+}
+
+// GetStressTestRuns returns recorded load generator runs, most recent first
+func (a *AppService) GetStressTestRuns(limit int) ([]db.StressTestRun, error) {
+	return a.databaseService.GetStressTestRuns(limit)
+}
+
+// GetStressTestReport returns a recorded run with the metrics collected during its window
+func (a *AppService) GetStressTestReport(id int64) (*StressTestReport, error) {
+	run, err := a.databaseService.GetStressTestRun(id)
+	if err != nil {
+		return nil, err
+	}
+	// 마지막 초의 샘플까지 포함
+	summaries, err := a.databaseService.GetResourceSummary(stressTestMetricTypes, run.StartedAt, run.EndedAt.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	return &StressTestReport{Run: *run, Metrics: summaries}, nil
+}
+
+// CompareStressTests compares the metrics recorded during two runs (before -> after)
+func (a *AppService) CompareStressTests(beforeID, afterID int64) (*StressTestComparison, error) {
+	before, err := a.GetStressTestReport(beforeID)
+	if err != nil {
+		return nil, fmt.Errorf("run %d: %w", beforeID, err)
+	}
+	after, err := a.GetStressTestReport(afterID)
+	if err != nil {
+		return nil, fmt.Errorf("run %d: %w", afterID, err)
+	}
+	return &StressTestComparison{
+		Before:  before,
+		After:   after,
+		Changes: db.DiffMetricSummaries(before.Metrics, after.Metrics, 0),
+	}, nil
+}
+
+// AlertEvent is a threshold or health alert raised by a monitor
+type AlertEvent struct {
+	Action   string // 경고 종류 (space_low, throttle_start, gpu_ecc_warning, network_errors, cache_stale 등)
+	Target   string // 경고 대상 (경로, 장치, 인터페이스, 캐시 이름)
+	Key      string // 데스크톱 알림 중복 억제 키 (빈 값 = action:target)
+	Severity string // 데스크톱 알림 심각도
+	Title    string
+	Message  string
+	Details  string
+}
+
+// RecordAlertEvent records an alert firing in the audit trail under the alert category and shows a desktop notification
+// (경고 해제/복구 이벤트는 각 분류(disk, hardware)에 기록되어 보고서의 경고 수에 포함되지 않음)
+func (a *AppService) RecordAlertEvent(alert AlertEvent) {
+	if alert.Key == "" {
+		alert.Key = alert.Action + ":" + alert.Target
+	}
+	if alert.Severity == "" {
+		alert.Severity = monitoring.NotificationSeverityWarning
+	}
+	a.recordEvent(db.EventCategoryAlert, alert.Action, alert.Target, false, alert.Message, alert.Details)
+	a.notifyDesktop(monitoring.DesktopNotification{
+		Key:      alert.Key,
+		Category: db.EventCategoryAlert,
+		Severity: alert.Severity,
+		Title:    alert.Title,
+		Message:  alert.Message,
+	})
+}
+
+// handleHardwareEvent stores a hardware event log entry with a telemetry snapshot and notifies the frontend
+func (a *AppService) handleHardwareEvent(event monitoring.HardwareEvent) {
+	monitoring.LogWarn("Hardware event detected",
+		"category", event.Category, "provider", event.Provider, "eventID", event.EventID, "level", event.Level)
+
+	// 오류 발생 시점의 하드웨어 상태를 함께 기록하여 원인 분석에 활용
+	payload := map[string]interface{}{
+		"event":     event,
+		"telemetry": a.captureTelemetrySnapshot(),
+	}
+	details := ""
+	if data, err := json.Marshal(payload); err == nil {
+		details = string(data)
+	}
+
+	message := event.Message
+	if message == "" {
+		message = fmt.Sprintf("%s event %d", event.Provider, event.EventID)
+	}
+	a.recordEvent(db.EventCategoryHardware, event.Category, event.Provider, false, message, details)
+
+	severity := monitoring.NotificationSeverityWarning
+	switch strings.ToLower(event.Level) {
+	case "critical", "error":
+		severity = monitoring.NotificationSeverityCritical
+	case "information":
+		severity = monitoring.NotificationSeverityInfo
+	}
+	a.notifyDesktop(monitoring.DesktopNotification{
+		Key:      fmt.Sprintf("hardware:%s:%d", event.Provider, event.EventID),
+		Category: db.EventCategoryHardware,
+		Severity: severity,
+		Title:    "Hardware error",
+		Message:  message,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:hardware-event", payload)
+	}
+}
+
+// handlePowerEvent stores a suspend/resume or session lock/unlock event and notifies the frontend
+func (a *AppService) handlePowerEvent(event monitoring.PowerEvent) {
+	var message string
+	switch event.Type {
+	case monitoring.PowerEventSuspend:
+		message = "System suspended"
+	case monitoring.PowerEventResume:
+		message = "System resumed"
+	case monitoring.PowerEventLock:
+		message = "Session locked"
+	case monitoring.PowerEventUnlock:
+		message = "Session unlocked"
+	}
+	if event.SleepSeconds > 0 {
+		message = fmt.Sprintf("System resumed after %s asleep", time.Duration(event.SleepSeconds*float64(time.Second)).Round(time.Second))
+	}
+
+	details := ""
+	if data, err := json.Marshal(event); err == nil {
+		details = string(data)
+	}
+	a.recordEvent(db.EventCategoryPower, event.Type, event.Source, true, message, details)
+	a.notifyDesktop(monitoring.DesktopNotification{
+		Key:      "power:" + event.Type,
+		Category: db.EventCategoryPower,
+		Severity: monitoring.NotificationSeverityInfo,
+		Title:    "Power",
+		Message:  message,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:power-event", event)
+	}
+}
+
+// handleDeviceEvent stores a GPU, disk, USB device or printer connect/disconnect event and notifies the frontend
+func (a *AppService) handleDeviceEvent(event monitoring.DeviceEvent) {
+	action := "device_" + event.Type
+	device := "Disk"
+	switch event.Class {
+	case monitoring.DeviceClassGPU:
+		device = "GPU"
+	case monitoring.DeviceClassUSB:
+		device = "USB device"
+	case monitoring.DeviceClassPrinter:
+		device = "Printer"
+	}
+	message := fmt.Sprintf("%s %s", device, event.Type)
+
+	details := ""
+	if data, err := json.Marshal(event); err == nil {
+		details = string(data)
+	}
+	a.recordEvent(db.EventCategoryHardware, action, event.Device, true, message, details)
+	a.notifyDesktop(monitoring.DesktopNotification{
+		Key:      action + ":" + event.Device,
+		Category: db.EventCategoryHardware,
+		Severity: monitoring.NotificationSeverityInfo,
+		Title:    message,
+		Message:  event.Device,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:device-event", event)
+	}
+}
+
+// handleDiskSpaceAlert stores a low (or recovered) free space event and notifies the frontend
+func (a *AppService) handleDiskSpaceAlert(alert monitoring.DiskSpaceAlert) {
+	action := "space_low"
+	message := fmt.Sprintf("Free space on %s dropped to %.1f GB (%.1f%%)", alert.Path, alert.FreeGB, alert.FreePercent)
+	if !alert.Low {
+		action = "space_recovered"
+		message = fmt.Sprintf("Free space on %s recovered to %.1f GB (%.1f%%)", alert.Path, alert.FreeGB, alert.FreePercent)
+	}
+
+	details := ""
+	if data, err := json.Marshal(alert); err == nil {
+		details = string(data)
+	}
+	if alert.Low {
+		a.RecordAlertEvent(AlertEvent{Action: action, Target: alert.Path, Title: "Disk space", Message: message, Details: details})
+	} else {
+		a.recordEvent(db.EventCategoryDisk, action, alert.Path, true, message, details)
+		a.notifyDesktop(monitoring.DesktopNotification{
+			Key:      action + ":" + alert.Path,
+			Category: db.EventCategoryDisk,
+			Severity: monitoring.NotificationSeverityInfo,
+			Title:    "Disk space",
+			Message:  message,
+		})
+	}
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:disk-space", alert)
+	}
+}
+
+// handleThrottleEvent stores a CPU/GPU throttle start/end event and notifies the frontend
+func (a *AppService) handleThrottleEvent(event monitoring.ThrottleEvent) {
+	reasons := strings.Join(event.Reasons, ", ")
+	message := fmt.Sprintf("%s throttling started (%s)", strings.ToUpper(event.Device), reasons)
+	if event.Type == monitoring.ThrottleEventEnd {
+		message = fmt.Sprintf("%s throttling ended after %.0f s (%s)", strings.ToUpper(event.Device), event.DurationSeconds, reasons)
+	}
+
+	details := ""
+	if data, err := json.Marshal(event); err == nil {
+		details = string(data)
+	}
+	action := "throttle_" + event.Type
+	if event.Type == monitoring.ThrottleEventEnd {
+		a.recordEvent(db.EventCategoryHardware, action, event.Device, true, message, details)
+		a.notifyDesktop(monitoring.DesktopNotification{
+			Key:      action + ":" + event.Device,
+			Category: db.EventCategoryHardware,
+			Severity: monitoring.NotificationSeverityInfo,
+			Title:    "Throttling",
+			Message:  message,
+		})
+	} else {
+		a.RecordAlertEvent(AlertEvent{Action: action, Target: event.Device, Title: "Throttling", Message: message, Details: details})
+	}
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:throttle-event", event)
+	}
+}
+
+// handleCacheStaleAlert stores a warning about stale cached data being served and notifies the frontend
+func (a *AppService) handleCacheStaleAlert(alert monitoring.CacheStaleAlert) {
+	age := time.Duration(alert.CacheAgeMs) * time.Millisecond
+	message := fmt.Sprintf("Cached %s data is %s old", alert.Cache, age.Round(time.Second))
+
+	details := ""
+	if data, err := json.Marshal(alert); err == nil {
+		details = string(data)
+	}
+	a.RecordAlertEvent(AlertEvent{
+		Action: "cache_stale", Target: alert.Cache, Severity: monitoring.NotificationSeverityInfo,
+		Title: "Stale data", Message: message, Details: details,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:cache-stale", alert)
+	}
+}
+
+// handleGPUECCAlert stores an increased GPU ECC/retired page counter and notifies the frontend
+func (a *AppService) handleGPUECCAlert(alert monitoring.GPUECCAlert) {
+	message := fmt.Sprintf("GPU %d %s increased from %d to %d - possible failing VRAM", alert.Index, alert.Counter, alert.Previous, alert.Current)
+
+	details := ""
+	if data, err := json.Marshal(alert); err == nil {
+		details = string(data)
+	}
+	// ECC 경고 심각도(warning, critical)는 알림 심각도와 같음
+	a.RecordAlertEvent(AlertEvent{
+		Action:   "gpu_ecc_" + alert.Severity,
+		Target:   fmt.Sprintf("gpu%d", alert.Index),
+		Key:      fmt.Sprintf("gpu_ecc:%d:%s", alert.Index, alert.Counter),
+		Severity: alert.Severity,
+		Title:    "GPU memory errors",
+		Message:  message,
+		Details:  details,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:gpu-ecc", alert)
+	}
+}
+
+// handleNetworkErrorAlert stores a network interface that started reporting errors and notifies the frontend
+func (a *AppService) handleNetworkErrorAlert(alert monitoring.NetworkErrorAlert) {
+	message := fmt.Sprintf("Network interface %s reports errors (in %.1f/s, out %.1f/s, collisions %.1f/s) - check cable and driver",
+		alert.Interface, alert.ErrorsInRate, alert.ErrorsOutRate, alert.CollisionRate)
+
+	details := ""
+	if data, err := json.Marshal(alert); err == nil {
+		details = string(data)
+	}
+	a.RecordAlertEvent(AlertEvent{Action: "network_errors", Target: alert.Interface, Title: "Network errors", Message: message, Details: details})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:network-errors", alert)
+	}
+}
+
+// handleProcessWatchEvent stores a watched process state change and notifies the frontend
+func (a *AppService) handleProcessWatchEvent(event monitoring.ProcessWatchEvent) {
+	success := true
+	var message string
+	switch event.Type {
+	case monitoring.ProcessWatchStarted:
+		message = fmt.Sprintf("Watched process %s started", event.Name)
+	case monitoring.ProcessWatchExited:
+		success = false
+		message = fmt.Sprintf("Watched process %s exited", event.Name)
+	case monitoring.ProcessWatchCPULimit:
+		success = false
+		message = fmt.Sprintf("Watched process %s CPU usage %.1f%% exceeds %.1f%%", event.Name, event.Value, event.Limit)
+	case monitoring.ProcessWatchMemoryLimit:
+		success = false
+		message = fmt.Sprintf("Watched process %s memory %.0f MB exceeds %.0f MB", event.Name, event.Value, event.Limit)
+	case monitoring.ProcessWatchRestarted:
+		message = fmt.Sprintf("Restarted watched process %s", event.Name)
+	case monitoring.ProcessWatchRestartFailed:
+		success = false
+		message = fmt.Sprintf("Failed to restart watched process %s: %s", event.Name, event.Error)
+	}
+
+	details := ""
+	if data, err := json.Marshal(event); err == nil {
+		details = string(data)
+	}
+	a.recordEvent(db.EventCategoryWatchdog, event.Type, event.Name, success, message, details)
+
+	severity := monitoring.NotificationSeverityInfo
+	switch event.Type {
+	case monitoring.ProcessWatchExited, monitoring.ProcessWatchCPULimit, monitoring.ProcessWatchMemoryLimit:
+		severity = monitoring.NotificationSeverityWarning
+	case monitoring.ProcessWatchRestartFailed:
+		severity = monitoring.NotificationSeverityCritical
+	}
+	a.notifyDesktop(monitoring.DesktopNotification{
+		Key:      event.Type + ":" + event.Name,
+		Category: db.EventCategoryWatchdog,
+		Severity: severity,
+		Title:    "Process watchdog",
+		Message:  message,
+	})
+
+	if a.nativeUIService != nil {
+		a.nativeUIService.EmitEvent("monitoring:watchdog", event)
+	}
+}
+
+// notifyDesktop shows an alert event as an OS notification according to the notification settings
+func (a *AppService) notifyDesktop(notification monitoring.DesktopNotification) {
+	if a.desktopNotifier == nil {
+		return
+	}
+	a.desktopNotifier.Notify(notification)
+}
+
+// GetNotificationStatus returns the desktop notification settings, backend and recent notifications
+func (a *AppService) GetNotificationStatus() (*monitoring.NotificationStatus, error) {
+	if a.desktopNotifier == nil {
+		return nil, fmt.Errorf("desktop notifications not initialized")
+	}
+	status := a.desktopNotifier.Status()
+	return &status, nil
+}
+
+// SendTestNotification shows a notification immediately, ignoring severity, quiet hours and cooldown
+func (a *AppService) SendTestNotification() (*monitoring.NotificationRecord, error) {
+	if a.desktopNotifier == nil {
+		return nil, fmt.Errorf("desktop notifications not initialized")
+	}
+	record := a.desktopNotifier.Test("Test notification", "HWnow desktop notifications are working")
+	if record.Suppressed == monitoring.NotificationSuppressedUnavailable {
+		return &record, fmt.Errorf("no desktop notification backend available")
+	}
+	if record.Error != "" {
+		return &record, fmt.Errorf("failed to show notification: %s", record.Error)
+	}
+	return &record, nil
+}
+
+// captureTelemetrySnapshot collects the current key hardware readings for event correlation
+func (a *AppService) captureTelemetrySnapshot() map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"timestamp": time.Now(),
+	}
+	if a.monitoringService == nil {
+		return snapshot
+	}
+
+	if metrics, err := a.monitoringService.GetRealTimeMetrics(); err == nil && metrics != nil {
+		snapshot["cpu_usage"] = metrics.CPUUsage
+		snapshot["memory_usage"] = metrics.MemoryUsage
+		snapshot["disk_read_speed"] = metrics.DiskReadSpeed
+		snapshot["disk_write_speed"] = metrics.DiskWriteSpeed
+		snapshot["system_power_watts"] = metrics.SystemPowerWatts
+		if metrics.GPUInfo != nil {
+			snapshot["gpu_usage"] = metrics.GPUInfo.Usage
+			snapshot["gpu_temperature"] = metrics.GPUInfo.Temperature
+			snapshot["gpu_power"] = metrics.GPUInfo.Power
+		}
+	}
+	return snapshot
+}
+
+// recordProcessControlEvent records a process control action in the audit trail
+func (a *AppService) recordProcessControlEvent(result *GPUProcessControlResult) {
+	if result == nil {
+		return
+	}
+	details := ""
+	if result.Priority != "" {
+		details = fmt.Sprintf(`{"priority":%q}`, result.Priority)
+	}
+	a.recordEvent(db.EventCategoryProcessControl, result.Operation, fmt.Sprintf("%d", result.PID),
+		result.Success, result.Message, details)
+}
+
+// recordEvent writes an event without failing the calling operation
+func (a *AppService) recordEvent(category, action, target string, success bool, message, details string) {
+	if a.databaseService == nil {
+		return
+	}
+	if err := a.databaseService.RecordEvent(category, action, target, success, message, details); err != nil {
+		monitoring.LogWarn("Failed to record audit event", "category", category, "action", action, "error", err)
+	}
+}
+
 // Service access methods
 
 // GetMonitoringService returns the monitoring service
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+func TestAlertEvents(t *testing.T) {
+	cases := []struct {
+		name     string
+		raise    func(a *AppService)
+		category string
+		action   string
+		target   string
+	}{
+		{"Disk_Space_Low", func(a *AppService) {
+			a.handleDiskSpaceAlert(monitoring.DiskSpaceAlert{Path: "D:\\", Low: true, FreeGB: 4, FreePercent: 2})
+		}, db.EventCategoryAlert, "space_low", "D:\\"},
+		{"Disk_Space_Recovered", func(a *AppService) {
+			a.handleDiskSpaceAlert(monitoring.DiskSpaceAlert{Path: "D:\\", FreeGB: 40, FreePercent: 20})
+		}, db.EventCategoryDisk, "space_recovered", "D:\\"},
+		{"Throttle_Start", func(a *AppService) {
+			a.handleThrottleEvent(monitoring.ThrottleEvent{Device: "cpu", Type: monitoring.ThrottleEventStart, Reasons: []string{"thermal"}})
+		}, db.EventCategoryAlert, "throttle_start", "cpu"},
+		{"Throttle_End", func(a *AppService) {
+			a.handleThrottleEvent(monitoring.ThrottleEvent{Device: "cpu", Type: monitoring.ThrottleEventEnd, DurationSeconds: 12})
+		}, db.EventCategoryHardware, "throttle_end", "cpu"},
+		{"GPU_ECC", func(a *AppService) {
+			a.handleGPUECCAlert(monitoring.GPUECCAlert{Index: 1, Counter: "retired_pages_dbe", Previous: 0, Current: 2, Severity: monitoring.NotificationSeverityCritical})
+		}, db.EventCategoryAlert, "gpu_ecc_critical", "gpu1"},
+		{"Network_Errors", func(a *AppService) {
+			a.handleNetworkErrorAlert(monitoring.NetworkErrorAlert{Interface: "Ethernet", ErrorsInRate: 3})
+		}, db.EventCategoryAlert, "network_errors", "Ethernet"},
+		{"Cache_Stale", func(a *AppService) {
+			a.handleCacheStaleAlert(monitoring.CacheStaleAlert{Cache: "gpu_info", CacheAgeMs: 90000})
+		}, db.EventCategoryAlert, "cache_stale", "gpu_info"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a := newTestAppService(t)
+			c.raise(a)
+
+			result := a.databaseService.GetEvents(db.EventQuery{Category: c.category})
+			if !result.Success || result.TotalCount != 1 {
+				t.Fatalf("Expected one %s event, got %+v", c.category, result)
+			}
+			event := result.Events[0]
+			if event.Action != c.action || event.Target != c.target {
+				t.Errorf("Expected %s on %s, got %s on %s", c.action, c.target, event.Action, event.Target)
+			}
+			// 경고 해제 이벤트는 경고 분류에 기록되지 않아야 함
+			if c.category != db.EventCategoryAlert {
+				if alerts := a.databaseService.GetEvents(db.EventQuery{Category: db.EventCategoryAlert}); alerts.TotalCount != 0 {
+					t.Errorf("Expected no alert events for a recovery, got %d", alerts.TotalCount)
+				}
+			}
+		})
+	}
+}
@@ -4,43 +4,211 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
 )
 
 // ServerConfig represents server configuration
 type ServerConfig struct {
-	Port int    `json:"port"`
-	Host string `json:"host"`
+	Port       int    `json:"port"`
+	Host       string `json:"host"`
+	HealthPort int    `json:"health_port"` // Loopback port serving /healthz and /readyz to external probes (0 = off)
 }
 
 // DatabaseConfig represents database configuration
 type DatabaseConfig struct {
-	Filename string `json:"filename"`
+	Driver               string `json:"driver"`                  // sqlite (default), postgres, mysql
+	DSN                  string `json:"dsn,omitempty"`           // Connection string for postgres/mysql (sqlite uses filename)
+	Filename             string `json:"filename"`
+	WriteBatchSize       int    `json:"write_batch_size"`        // resource_logs rows per transaction
+	WriteFlushIntervalMs int    `json:"write_flush_interval_ms"` // Interval between batched writes
+	WriteQueueSize       int    `json:"write_queue_size"`        // Pending snapshots before new ones are dropped
+	RawRetentionDays     int    `json:"raw_retention_days"`      // Raw samples older than this are rolled into 1-minute aggregates
+	MinuteRetentionDays  int    `json:"minute_retention_days"`   // 1-minute aggregates older than this are rolled into 1-hour aggregates
+	CompactionMinutes    int    `json:"compaction_minutes"`      // Interval between compaction runs
+	SpillMaxMB           int    `json:"spill_max_mb"`            // On-disk buffer for rows that could not be written while the database is unavailable
+}
+
+// DiskPathConfig represents a watched path or volume and its free space thresholds
+type DiskPathConfig struct {
+	Path           string  `json:"path"`
+	MinFreePercent float64 `json:"min_free_percent"` // Raise an event below this free percentage (0 = off)
+	MinFreeGB      float64 `json:"min_free_gb"`      // Raise an event below this free space in GB (0 = off)
 }
 
 // MonitoringConfig represents monitoring configuration
 type MonitoringConfig struct {
-	IntervalSeconds         int  `json:"interval_seconds"`         // Default interval for performance metrics
-	SecurityCheckSeconds    int  `json:"security_check_seconds"`   // Security checks interval (longer)
-	GPUInfoCacheSeconds     int  `json:"gpu_info_cache_seconds"`   // GPU hardware info caching
-	RegistryCacheSeconds    int  `json:"registry_cache_seconds"`   // Registry query caching
-	EnableCpuMonitoring     bool `json:"enable_cpu_monitoring"`
-	EnableMemoryMonitoring  bool `json:"enable_memory_monitoring"`
-	EnableDiskMonitoring    bool `json:"enable_disk_monitoring"`
-	EnableNetworkMonitoring bool `json:"enable_network_monitoring"`
+	IntervalSeconds         int              `json:"interval_seconds"`              // Default interval for performance metrics
+	SecurityCheckSeconds    int              `json:"security_check_seconds"`        // Security checks interval (longer)
+	GPUInfoCacheSeconds     int              `json:"gpu_info_cache_seconds"`        // GPU hardware info caching
+	RegistryCacheSeconds    int              `json:"registry_cache_seconds"`        // Registry query caching
+	CollectionBudgetMs      int              `json:"collection_budget_ms"`          // Target time per collection cycle
+	QueryTimeoutSeconds     int              `json:"query_timeout_seconds"`         // Limit for a single hardware query; slower queries fail instead of blocking the caller
+	CacheStaleWarningSecs   int              `json:"cache_stale_warning_seconds"`   // Warn when cached GPU/video controller data served is older than this (0 = off)
+	MaxAdaptiveIntervalSecs int              `json:"max_adaptive_interval_seconds"` // Upper bound for throttled expensive collectors
+	CollectorIntervalSecs   map[string]int   `json:"collector_interval_seconds"`    // Per-collector intervals, e.g. {"disk": 5, "gpu_processes": 15, "smart": 60}
+	EnableCpuMonitoring     bool             `json:"enable_cpu_monitoring"`
+	EnableMemoryMonitoring  bool             `json:"enable_memory_monitoring"`
+	EnableDiskMonitoring    bool             `json:"enable_disk_monitoring"`
+	EnableNetworkMonitoring bool             `json:"enable_network_monitoring"`
+	EnableCPUTimeBreakdown  bool             `json:"enable_cpu_time_breakdown"` // Report user/system/iowait/irq/steal shares
+	EnableAudioMonitoring   bool             `json:"enable_audio_monitoring"`   // Report default audio devices, volume and audio sessions (Windows)
+	IdleThresholdMinutes    int              `json:"idle_threshold_minutes"`    // No input for this long (or display off) counts as idle
+	IdleIntervalSeconds     int              `json:"idle_interval_seconds"`     // Minimum time between collections while idle
+	BackgroundIntervalSecs  int              `json:"background_interval_seconds"` // Minimum time between collections for widgets only shown in background clients
+	RecentBufferMinutes     int              `json:"recent_buffer_minutes"`     // Minutes of every metric kept in memory for sparklines
+	DiskPaths               []DiskPathConfig `json:"disk_paths"`          // Watched paths (empty = all mount points, no thresholds)
+	GPUProcessInclude       string           `json:"gpu_process_include"` // Only report GPU processes whose name matches (regex)
+	GPUProcessExclude       string           `json:"gpu_process_exclude"` // Hide GPU processes whose name matches (regex)
+}
+
+// collectorIntervalAliases maps metric family names accepted in collector_interval_seconds to collector names
+var collectorIntervalAliases = map[string]string{
+	"smart":       "disk_temperature",
+	"temperature": "cpu_temperature",
+	"gpu":         "gpu_info",
+	"processes":   "top_processes",
 }
 
 // UIConfig represents UI configuration
 type UIConfig struct {
 	AutoOpenBrowser bool   `json:"auto_open_browser"`
 	Theme          string `json:"theme"`
+	Language       string `json:"language"` // Message language: "" (Accept-Language, then English), en, ko
+}
+
+// LoggingConfig represents logging configuration
+type LoggingConfig struct {
+	Level             string `json:"level"`               // debug, info, warn, error
+	Format            string `json:"format"`              // text, json
+	File              string `json:"file"`                // Log file path (empty = stdout only)
+	MaxSizeMB         int    `json:"max_size_mb"`         // Rotate the log file after this size
+	MaxBackups        int    `json:"max_backups"`         // Rotated files to keep
+	GPUMonitoringLogs bool   `json:"gpu_monitoring_logs"` // Verbose GPU monitoring logs
+}
+
+// ReportsConfig represents scheduled summary report configuration
+type ReportsConfig struct {
+	Schedule   string `json:"schedule"`    // "", daily, weekly (empty = no scheduled delivery)
+	Hour       int    `json:"hour"`        // Local hour of day to deliver (weekly reports go out on Monday)
+	WebhookURL string `json:"webhook_url"` // Receives the report as a JSON POST
+	TopCount   int    `json:"top_count"`   // Number of top processes by CPU time
+}
+
+// NetworkQualityConfig represents the optional gateway/internet latency probe configuration
+type NetworkQualityConfig struct {
+	Enabled         bool   `json:"enabled"`
+	Target          string `json:"target"`           // External host to probe (e.g. 1.1.1.1)
+	Method          string `json:"method"`           // icmp, tcp
+	Port            int    `json:"port"`             // Port for tcp probes
+	ProbeCount      int    `json:"probe_count"`      // Probes per measurement
+	IntervalSeconds int    `json:"interval_seconds"` // Interval between measurements
+	PublicIPURL     string `json:"public_ip_url"`    // Plain-text public IP lookup (empty = disabled)
+}
+
+// GPUConfig represents GPU detection settings
+type GPUConfig struct {
+	Vendor string `json:"vendor"` // "" (auto-detect), nvidia, amd, intel, generic
+}
+
+// GPUControlConfig represents GPU hardware control settings (all changes disabled by default)
+type GPUControlConfig struct {
+	AllowPowerLimit bool `json:"allow_power_limit"` // Allow changing the NVIDIA power limit (also requires administrator rights)
+}
+
+// StressTestConfig represents the load generator settings
+type StressTestConfig struct {
+	MaxDurationSeconds int      `json:"max_duration_seconds"` // Upper bound for a single run
+	GPUCommand         string   `json:"gpu_command"`          // External GPU load tool (e.g. gpu-burn, FurMark CLI); empty = GPU load unavailable
+	GPUArgs            []string `json:"gpu_args"`             // Arguments passed to the GPU load tool
+}
+
+// FrameStatsConfig represents the optional per-application FPS / frame time source (Windows, PresentMon)
+type FrameStatsConfig struct {
+	Enabled bool     `json:"enabled"`
+	Command string   `json:"command"` // PresentMon executable (PATH or full path)
+	Args    []string `json:"args"`    // Arguments making PresentMon write CSV rows to stdout
+}
+
+// FanControlConfig represents Linux hwmon fan control settings (PWM changes disabled by default)
+type FanControlConfig struct {
+	AllowPWMControl bool                  `json:"allow_pwm_control"` // Allow writing fan PWM targets (also requires root or writable hwmon attributes)
+	Curves          []monitoring.FanCurve `json:"curves"`            // CPU temperature → duty curves applied while monitoring runs
+}
+
+// SecurityConfig represents access restrictions
+type SecurityConfig struct {
+	ReadOnly           bool                              `json:"read_only"`           // Disable process control, power limit, fan and stress test changes (viewer deployments)
+	ProtectedProcesses []monitoring.ProtectedProcessRule `json:"protected_processes"` // Extra processes process control refuses (high/critical) or warns about
+}
+
+// UnitsConfig represents the units metric values are reported in (history API, JSON stream)
+type UnitsConfig struct {
+	Temperature string `json:"temperature"` // °C, °F
+	DataRate    string `json:"data_rate"`   // bytes/s, KB/s, MB/s, Mbit/s
+	Memory      string `json:"memory"`      // MB, GB
+	Storage     string `json:"storage"`     // bytes, GB
+}
+
+// Preferences converts the config section to monitoring unit preferences
+func (c UnitsConfig) Preferences() monitoring.UnitPreferences {
+	return monitoring.UnitPreferences{
+		Temperature: c.Temperature,
+		DataRate:    c.DataRate,
+		Memory:      c.Memory,
+		Storage:     c.Storage,
+	}
+}
+
+// EnergyConfig represents the electricity tariff and grid carbon intensity used for energy cost estimates
+type EnergyConfig struct {
+	PricePerKWh       float64 `json:"price_per_kwh"`        // Electricity price per kWh in Currency
+	Currency          string  `json:"currency"`             // Display currency code (e.g. USD, KRW, EUR)
+	CarbonGramsPerKWh float64 `json:"carbon_grams_per_kwh"` // Grid carbon intensity (gCO2e per kWh)
+}
+
+// NotificationsConfig represents native desktop notifications for alert events
+type NotificationsConfig struct {
+	Enabled          bool   `json:"enabled"`
+	MinSeverity      string `json:"min_severity"`       // info, warning, critical
+	QuietHoursStart  string `json:"quiet_hours_start"`  // Local time HH:MM (empty = no quiet hours)
+	QuietHoursEnd    string `json:"quiet_hours_end"`    // Local time HH:MM (may be earlier than the start to span midnight)
+	QuietMinSeverity string `json:"quiet_min_severity"` // Severity still shown during quiet hours (empty = none)
+	CooldownSeconds  int    `json:"cooldown_seconds"`   // Minimum time between notifications for the same event
+}
+
+// Policy converts the config section to a monitoring notification policy
+func (c NotificationsConfig) Policy() monitoring.NotificationPolicy {
+	return monitoring.NotificationPolicy{
+		Enabled:          c.Enabled,
+		MinSeverity:      c.MinSeverity,
+		QuietHoursStart:  c.QuietHoursStart,
+		QuietHoursEnd:    c.QuietHoursEnd,
+		QuietMinSeverity: c.QuietMinSeverity,
+		Cooldown:         time.Duration(c.CooldownSeconds) * time.Second,
+	}
 }
 
 // Config structure for application configuration
 type Config struct {
-	Server     ServerConfig     `json:"server"`
-	Database   DatabaseConfig   `json:"database"`
-	Monitoring MonitoringConfig `json:"monitoring"`
-	UI         UIConfig         `json:"ui"`
+	Server         ServerConfig         `json:"server"`
+	Database       DatabaseConfig       `json:"database"`
+	Monitoring     MonitoringConfig     `json:"monitoring"`
+	UI             UIConfig             `json:"ui"`
+	Logging        LoggingConfig        `json:"logging"`
+	Reports        ReportsConfig        `json:"reports"`
+	NetworkQuality NetworkQualityConfig `json:"network_quality"`
+	GPU            GPUConfig            `json:"gpu"`
+	GPUControl     GPUControlConfig     `json:"gpu_control"`
+	StressTest     StressTestConfig     `json:"stress_test"`
+	FrameStats     FrameStatsConfig     `json:"frame_stats"`
+	Units          UnitsConfig          `json:"units"`
+	FanControl     FanControlConfig     `json:"fan_control"`
+	Security       SecurityConfig       `json:"security"`
+	Energy         EnergyConfig         `json:"energy"`
+	Notifications  NotificationsConfig  `json:"notifications"`
 }
 
 // ConfigService provides configuration management functionality
@@ -123,13 +291,29 @@ func getDefaultConfig() Config {
 			Host: "localhost",
 		},
 		Database: DatabaseConfig{
-			Filename: "hwinfo.db",
+			Driver:               "sqlite",
+			Filename:             "hwinfo.db",
+			WriteBatchSize:       500,
+			WriteFlushIntervalMs: 5000,
+			WriteQueueSize:       64,
+			RawRetentionDays:     7,
+			MinuteRetentionDays:  90,
+			CompactionMinutes:    60,
+			SpillMaxMB:           64,
 		},
 		Monitoring: MonitoringConfig{
 			IntervalSeconds:         1,
 			SecurityCheckSeconds:    30,
 			GPUInfoCacheSeconds:     600,
 			RegistryCacheSeconds:    300,
+			CollectionBudgetMs:      500,
+			QueryTimeoutSeconds:     10,
+			CacheStaleWarningSecs:   int(monitoring.DEFAULT_CACHE_STALE_THRESHOLD / time.Second),
+			MaxAdaptiveIntervalSecs: 10,
+			IdleThresholdMinutes:    5,
+			IdleIntervalSeconds:     10,
+			BackgroundIntervalSecs:  int(monitoring.DEFAULT_BACKGROUND_INTERVAL / time.Second),
+			RecentBufferMinutes:     monitoring.DEFAULT_RECENT_BUFFER_MINUTES,
 			EnableCpuMonitoring:     true,
 			EnableMemoryMonitoring:  true,
 			EnableDiskMonitoring:    true,
@@ -139,6 +323,53 @@ func getDefaultConfig() Config {
 			AutoOpenBrowser: true,
 			Theme:          "dark",
 		},
+		Logging: LoggingConfig{
+			Level:      "info",
+			Format:     "text",
+			MaxSizeMB:  10,
+			MaxBackups: 3,
+		},
+		Reports: ReportsConfig{
+			Hour:     8,
+			TopCount: 10,
+		},
+		NetworkQuality: NetworkQualityConfig{
+			Target:          "1.1.1.1",
+			Method:          monitoring.NetworkProbeICMP,
+			Port:            443,
+			ProbeCount:      4,
+			IntervalSeconds: 30,
+			PublicIPURL:     "https://api.ipify.org",
+		},
+		StressTest: StressTestConfig{
+			MaxDurationSeconds: 600,
+		},
+		FrameStats: FrameStatsConfig{
+			Command: "PresentMon.exe",
+			Args:    []string{"--output_stdout", "--stop_existing_session", "--session_name", "HWnow-PresentMon", "--no_console_stats"},
+		},
+		Units: UnitsConfig{
+			Temperature: monitoring.UnitCelsius,
+			DataRate:    monitoring.UnitBytesPerSecond,
+			Memory:      monitoring.UnitMegabytes,
+			Storage:     monitoring.UnitBytes,
+		},
+		FanControl: FanControlConfig{
+			Curves: []monitoring.FanCurve{},
+		},
+		Energy: EnergyConfig{
+			PricePerKWh:       0.15,
+			Currency:          "USD",
+			CarbonGramsPerKWh: 475, // IEA 세계 평균 전력 배출계수
+		},
+		Security: SecurityConfig{
+			ProtectedProcesses: []monitoring.ProtectedProcessRule{},
+		},
+		Notifications: NotificationsConfig{
+			Enabled:         true,
+			MinSeverity:     monitoring.NotificationSeverityWarning,
+			CooldownSeconds: int(monitoring.DEFAULT_NOTIFICATION_COOLDOWN / time.Second),
+		},
 	}
 }
 
@@ -153,11 +384,41 @@ func validateAndFillDefaults(config Config) Config {
 	if config.Server.Host == "" {
 		config.Server.Host = defaults.Server.Host
 	}
+	if config.Server.HealthPort < 0 || config.Server.HealthPort > 65535 {
+		config.Server.HealthPort = defaults.Server.HealthPort
+	}
 
 	// Database config validation
+	if config.Database.Driver == "" {
+		config.Database.Driver = defaults.Database.Driver
+	}
 	if config.Database.Filename == "" {
 		config.Database.Filename = defaults.Database.Filename
 	}
+	if config.Database.WriteBatchSize <= 0 {
+		config.Database.WriteBatchSize = defaults.Database.WriteBatchSize
+	}
+	if config.Database.WriteFlushIntervalMs <= 0 {
+		config.Database.WriteFlushIntervalMs = defaults.Database.WriteFlushIntervalMs
+	}
+	if config.Database.WriteQueueSize <= 0 {
+		config.Database.WriteQueueSize = defaults.Database.WriteQueueSize
+	}
+	if config.Database.RawRetentionDays <= 0 {
+		config.Database.RawRetentionDays = defaults.Database.RawRetentionDays
+	}
+	if config.Database.MinuteRetentionDays <= 0 {
+		config.Database.MinuteRetentionDays = defaults.Database.MinuteRetentionDays
+	}
+	if config.Database.MinuteRetentionDays < config.Database.RawRetentionDays {
+		config.Database.MinuteRetentionDays = config.Database.RawRetentionDays
+	}
+	if config.Database.CompactionMinutes <= 0 {
+		config.Database.CompactionMinutes = defaults.Database.CompactionMinutes
+	}
+	if config.Database.SpillMaxMB <= 0 {
+		config.Database.SpillMaxMB = defaults.Database.SpillMaxMB
+	}
 
 	// Monitoring config validation
 	if config.Monitoring.IntervalSeconds <= 0 {
@@ -172,11 +433,213 @@ func validateAndFillDefaults(config Config) Config {
 	if config.Monitoring.RegistryCacheSeconds <= 0 {
 		config.Monitoring.RegistryCacheSeconds = defaults.Monitoring.RegistryCacheSeconds
 	}
+	if config.Monitoring.CollectionBudgetMs <= 0 {
+		config.Monitoring.CollectionBudgetMs = defaults.Monitoring.CollectionBudgetMs
+	}
+	if config.Monitoring.QueryTimeoutSeconds <= 0 {
+		config.Monitoring.QueryTimeoutSeconds = defaults.Monitoring.QueryTimeoutSeconds
+	}
+	if config.Monitoring.CacheStaleWarningSecs < 0 {
+		config.Monitoring.CacheStaleWarningSecs = defaults.Monitoring.CacheStaleWarningSecs
+	}
+	if config.Monitoring.MaxAdaptiveIntervalSecs < config.Monitoring.IntervalSeconds {
+		config.Monitoring.MaxAdaptiveIntervalSecs = defaults.Monitoring.MaxAdaptiveIntervalSecs
+	}
+	if config.Monitoring.IdleThresholdMinutes <= 0 {
+		config.Monitoring.IdleThresholdMinutes = defaults.Monitoring.IdleThresholdMinutes
+	}
+	if config.Monitoring.IdleIntervalSeconds < config.Monitoring.IntervalSeconds {
+		config.Monitoring.IdleIntervalSeconds = defaults.Monitoring.IdleIntervalSeconds
+	}
+	if config.Monitoring.BackgroundIntervalSecs < config.Monitoring.IntervalSeconds {
+		config.Monitoring.BackgroundIntervalSecs = defaults.Monitoring.BackgroundIntervalSecs
+	}
+	if config.Monitoring.RecentBufferMinutes <= 0 || config.Monitoring.RecentBufferMinutes > monitoring.MAX_RECENT_BUFFER_MINUTES {
+		config.Monitoring.RecentBufferMinutes = defaults.Monitoring.RecentBufferMinutes
+	}
+
+	// Drop unknown collectors and non-positive intervals; family aliases map to collector names
+	collectorIntervals := make(map[string]int, len(config.Monitoring.CollectorIntervalSecs))
+	for name, seconds := range config.Monitoring.CollectorIntervalSecs {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if alias, ok := collectorIntervalAliases[name]; ok {
+			name = alias
+		}
+		_, known := collectorMetricFields[name]
+		if (known || name == "gpu_processes" || name == "top_processes") && seconds > 0 {
+			collectorIntervals[name] = seconds
+		}
+	}
+	config.Monitoring.CollectorIntervalSecs = collectorIntervals
+
+	// Drop watched paths without a path and negative thresholds
+	diskPaths := make([]DiskPathConfig, 0, len(config.Monitoring.DiskPaths))
+	for _, diskPath := range config.Monitoring.DiskPaths {
+		if diskPath.Path == "" {
+			continue
+		}
+		if diskPath.MinFreePercent < 0 || diskPath.MinFreePercent > 100 {
+			diskPath.MinFreePercent = 0
+		}
+		if diskPath.MinFreeGB < 0 {
+			diskPath.MinFreeGB = 0
+		}
+		diskPaths = append(diskPaths, diskPath)
+	}
+	config.Monitoring.DiskPaths = diskPaths
+
+	// Invalid GPU process name patterns are cleared rather than rejected
+	if _, err := monitoring.CompileGPUProcessPattern(config.Monitoring.GPUProcessInclude); err != nil {
+		config.Monitoring.GPUProcessInclude = ""
+	}
+	if _, err := monitoring.CompileGPUProcessPattern(config.Monitoring.GPUProcessExclude); err != nil {
+		config.Monitoring.GPUProcessExclude = ""
+	}
 
 	// UI config validation
+	// 지역 태그는 언어만 남기고 ("ko-KR" → "ko"), 지원하지 않는 언어는 자동 선택으로
+	config.UI.Language = monitoring.NormalizeLanguage(config.UI.Language)
 	if config.UI.Theme == "" {
 		config.UI.Theme = defaults.UI.Theme
 	}
 
+	// Logging config validation
+	if _, err := monitoring.ParseLogLevel(config.Logging.Level); err != nil || config.Logging.Level == "" {
+		config.Logging.Level = defaults.Logging.Level
+	}
+	if config.Logging.Format != "text" && config.Logging.Format != "json" {
+		config.Logging.Format = defaults.Logging.Format
+	}
+	if config.Logging.MaxSizeMB <= 0 {
+		config.Logging.MaxSizeMB = defaults.Logging.MaxSizeMB
+	}
+	if config.Logging.MaxBackups < 0 {
+		config.Logging.MaxBackups = defaults.Logging.MaxBackups
+	}
+
+	// Reports config validation
+	if config.Reports.Schedule != "" && config.Reports.Schedule != ReportPeriodDaily && config.Reports.Schedule != ReportPeriodWeekly {
+		config.Reports.Schedule = defaults.Reports.Schedule
+	}
+	if config.Reports.Hour < 0 || config.Reports.Hour > 23 {
+		config.Reports.Hour = defaults.Reports.Hour
+	}
+	if config.Reports.TopCount <= 0 {
+		config.Reports.TopCount = defaults.Reports.TopCount
+	}
+
+	// Network quality config validation
+	if config.NetworkQuality.Target == "" {
+		config.NetworkQuality.Target = defaults.NetworkQuality.Target
+	}
+	if config.NetworkQuality.Method != monitoring.NetworkProbeICMP && config.NetworkQuality.Method != monitoring.NetworkProbeTCP {
+		config.NetworkQuality.Method = defaults.NetworkQuality.Method
+	}
+	if config.NetworkQuality.Port <= 0 || config.NetworkQuality.Port > 65535 {
+		config.NetworkQuality.Port = defaults.NetworkQuality.Port
+	}
+	if config.NetworkQuality.ProbeCount <= 0 {
+		config.NetworkQuality.ProbeCount = defaults.NetworkQuality.ProbeCount
+	}
+	if config.NetworkQuality.IntervalSeconds <= 0 {
+		config.NetworkQuality.IntervalSeconds = defaults.NetworkQuality.IntervalSeconds
+	}
+
+	// Stress test config validation
+	if config.StressTest.MaxDurationSeconds <= 0 || time.Duration(config.StressTest.MaxDurationSeconds)*time.Second > monitoring.STRESS_MAX_DURATION {
+		config.StressTest.MaxDurationSeconds = defaults.StressTest.MaxDurationSeconds
+	}
+
+	// GPU config validation (알 수 없는 벤더는 자동 감지로)
+	config.GPU.Vendor = strings.ToLower(strings.TrimSpace(config.GPU.Vendor))
+	if _, err := monitoring.ParseGPUVendor(config.GPU.Vendor); err != nil || config.GPU.Vendor == "auto" {
+		config.GPU.Vendor = ""
+	}
+
+	// Frame stats config validation
+	if strings.TrimSpace(config.FrameStats.Command) == "" {
+		config.FrameStats.Command = defaults.FrameStats.Command
+	}
+	if config.FrameStats.Args == nil {
+		config.FrameStats.Args = defaults.FrameStats.Args
+	}
+
+	// Units config validation (대소문자 차이는 표준 표기로 정정)
+	unitFields := []struct {
+		kind     string
+		value    *string
+		fallback string
+	}{
+		{"temperature", &config.Units.Temperature, defaults.Units.Temperature},
+		{"data_rate", &config.Units.DataRate, defaults.Units.DataRate},
+		{"memory", &config.Units.Memory, defaults.Units.Memory},
+		{"storage", &config.Units.Storage, defaults.Units.Storage},
+	}
+	for _, field := range unitFields {
+		if unit, ok := monitoring.CanonicalUnitChoice(field.kind, *field.value); ok {
+			*field.value = unit
+		} else {
+			*field.value = field.fallback
+		}
+	}
+
+	// Energy config validation (0은 요금/배출량을 계산하지 않는 설정으로 허용)
+	if config.Energy.PricePerKWh < 0 {
+		config.Energy.PricePerKWh = defaults.Energy.PricePerKWh
+	}
+	if config.Energy.CarbonGramsPerKWh < 0 {
+		config.Energy.CarbonGramsPerKWh = defaults.Energy.CarbonGramsPerKWh
+	}
+	if strings.TrimSpace(config.Energy.Currency) == "" {
+		config.Energy.Currency = defaults.Energy.Currency
+	}
+	config.Energy.Currency = strings.ToUpper(strings.TrimSpace(config.Energy.Currency))
+
+	// Fan control config validation (잘못된 곡선은 거부하지 않고 제외)
+	curves := make([]monitoring.FanCurve, 0, len(config.FanControl.Curves))
+	for _, curve := range config.FanControl.Curves {
+		if curve.Validate() == nil {
+			curves = append(curves, curve)
+		}
+	}
+	config.FanControl.Curves = curves
+
+	// Security config validation (잘못된 보호 규칙은 제외)
+	protected := make([]monitoring.ProtectedProcessRule, 0, len(config.Security.ProtectedProcesses))
+	for _, rule := range config.Security.ProtectedProcesses {
+		if rule.Validate() == nil {
+			protected = append(protected, rule)
+		}
+	}
+	config.Security.ProtectedProcesses = protected
+
+	// Notifications config validation (잘못된 방해 금지 시간은 해제)
+	config.Notifications.MinSeverity = strings.ToLower(strings.TrimSpace(config.Notifications.MinSeverity))
+	if !monitoring.ValidNotificationSeverity(config.Notifications.MinSeverity) {
+		config.Notifications.MinSeverity = defaults.Notifications.MinSeverity
+	}
+	config.Notifications.QuietMinSeverity = strings.ToLower(strings.TrimSpace(config.Notifications.QuietMinSeverity))
+	if config.Notifications.QuietMinSeverity != "" && !monitoring.ValidNotificationSeverity(config.Notifications.QuietMinSeverity) {
+		config.Notifications.QuietMinSeverity = defaults.Notifications.QuietMinSeverity
+	}
+	if config.Notifications.CooldownSeconds <= 0 {
+		config.Notifications.CooldownSeconds = defaults.Notifications.CooldownSeconds
+	}
+	config.Notifications.QuietHoursStart = strings.TrimSpace(config.Notifications.QuietHoursStart)
+	config.Notifications.QuietHoursEnd = strings.TrimSpace(config.Notifications.QuietHoursEnd)
+	if config.Notifications.Policy().Validate() != nil {
+		config.Notifications.QuietHoursStart = ""
+		config.Notifications.QuietHoursEnd = ""
+	}
+
 	return config
+}
+
+// effectiveFanControl returns the fan control settings to apply; fan PWM writes are disabled in read-only mode
+func effectiveFanControl(config *Config) FanControlConfig {
+	fanControl := config.FanControl
+	if config.Security.ReadOnly {
+		fanControl.AllowPWMControl = false
+	}
+	return fanControl
 }
\ No newline at end of file
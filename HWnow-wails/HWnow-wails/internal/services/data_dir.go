@@ -0,0 +1,211 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+const (
+	// ConfigFileName is the configuration file stored in the data directory
+	ConfigFileName = "config.json"
+
+	// portableMarkerFile next to the executable switches to portable mode (data stored beside the executable)
+	portableMarkerFile = "hwnow.portable"
+
+	// databaseDirName is the database subdirectory inside the data directory
+	databaseDirName = "data"
+)
+
+// DataDirMode describes how the data directory was chosen
+type DataDirMode string
+
+const (
+	DataDirModeOverride DataDirMode = "override" // --data-dir flag
+	DataDirModePortable DataDirMode = "portable" // --portable flag or marker file beside the executable
+	DataDirModePerUser  DataDirMode = "per-user" // OS-specific per-user location
+)
+
+// DataDirInfo describes the resolved data directory
+type DataDirInfo struct {
+	Path         string      `json:"path"`
+	Mode         DataDirMode `json:"mode"`
+	ConfigPath   string      `json:"config_path"`
+	DatabasePath string      `json:"database_path"`
+}
+
+// ResolveDataDir picks the directory for config.json and the database and creates it
+// Priority: override (--data-dir) > portable (--portable or marker file) > per-user default
+func ResolveDataDir(override string, portable bool) (*DataDirInfo, error) {
+	var dir string
+	var mode DataDirMode
+
+	switch {
+	case override != "":
+		dir, mode = override, DataDirModeOverride
+	case portable || portableMarkerExists():
+		exeDir, err := executableDir()
+		if err != nil {
+			return nil, err
+		}
+		dir, mode = exeDir, DataDirModePortable
+	default:
+		userDir, err := defaultDataDir()
+		if err != nil {
+			return nil, err
+		}
+		dir, mode = userDir, DataDirModePerUser
+	}
+
+	absolute, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data directory %q: %v", dir, err)
+	}
+	if err := os.MkdirAll(absolute, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory %q: %v", absolute, err)
+	}
+
+	return &DataDirInfo{
+		Path:         absolute,
+		Mode:         mode,
+		ConfigPath:   filepath.Join(absolute, ConfigFileName),
+		DatabasePath: filepath.Join(absolute, databaseDirName),
+	}, nil
+}
+
+// defaultDataDir returns %LOCALAPPDATA%\HWnow on Windows and ~/.config/hwnow elsewhere
+func defaultDataDir() (string, error) {
+	if runtime.GOOS == "windows" {
+		if localAppData := os.Getenv("LOCALAPPDATA"); localAppData != "" {
+			return filepath.Join(localAppData, "HWnow"), nil
+		}
+		// os.UserCacheDir는 Windows에서 %LocalAppData%를 반환
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to locate LOCALAPPDATA: %v", err)
+		}
+		return filepath.Join(cacheDir, "HWnow"), nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user config directory: %v", err)
+	}
+	return filepath.Join(configDir, "hwnow"), nil
+}
+
+// executableDir returns the directory containing the running executable
+func executableDir() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate executable: %v", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	return filepath.Dir(exe), nil
+}
+
+// portableMarkerExists reports whether the portable marker file sits next to the executable
+func portableMarkerExists() bool {
+	dir, err := executableDir()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filepath.Join(dir, portableMarkerFile))
+	return err == nil
+}
+
+// MigrateLegacyData moves config.json and the database directory from the legacy location
+// (the working directory in earlier versions) into the data directory
+// Files already present in the data directory are never overwritten
+func MigrateLegacyData(legacyDir string, info *DataDirInfo) ([]string, error) {
+	legacyAbs, err := filepath.Abs(legacyDir)
+	if err != nil {
+		return nil, err
+	}
+	if legacyAbs == info.Path {
+		return nil, nil
+	}
+
+	var migrated []string
+	moves := []struct{ from, to string }{
+		{filepath.Join(legacyAbs, ConfigFileName), info.ConfigPath},
+		{filepath.Join(legacyAbs, databaseDirName), info.DatabasePath},
+	}
+	for _, move := range moves {
+		if _, err := os.Stat(move.from); err != nil {
+			continue
+		}
+		if _, err := os.Stat(move.to); err == nil {
+			monitoring.LogInfo("Legacy data not migrated, destination already exists", "from", move.from, "to", move.to)
+			continue
+		}
+		if err := movePath(move.from, move.to); err != nil {
+			return migrated, fmt.Errorf("failed to migrate %s: %v", move.from, err)
+		}
+		migrated = append(migrated, move.from)
+		monitoring.LogInfo("Migrated legacy data", "from", move.from, "to", move.to)
+	}
+	return migrated, nil
+}
+
+// movePath renames a file or directory, copying across volumes when rename is not possible
+func movePath(from, to string) error {
+	if err := os.Rename(from, to); err == nil {
+		return nil
+	}
+
+	stat, err := os.Stat(from)
+	if err != nil {
+		return err
+	}
+	if !stat.IsDir() {
+		if err := copyFile(from, to, stat.Mode()); err != nil {
+			return err
+		}
+		return os.Remove(from)
+	}
+
+	if err := os.MkdirAll(to, stat.Mode().Perm()); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := movePath(filepath.Join(from, entry.Name()), filepath.Join(to, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return os.Remove(from)
+}
+
+// copyFile copies a single file, removing the partial copy on failure
+func copyFile(from, to string, mode os.FileMode) error {
+	source, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode.Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(target, source); err != nil {
+		target.Close()
+		os.Remove(to)
+		return err
+	}
+	if err := target.Close(); err != nil {
+		os.Remove(to)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDataDir(t *testing.T) {
+	t.Run("Override", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "nested", "hwnow")
+		info, err := ResolveDataDir(dir, true)
+		if err != nil {
+			t.Fatalf("ResolveDataDir failed: %v", err)
+		}
+		// --data-dir가 --portable보다 우선하며, 없는 디렉터리는 생성됨
+		if info.Mode != DataDirModeOverride || info.Path != dir {
+			t.Errorf("Expected override mode at %s, got %s at %s", dir, info.Mode, info.Path)
+		}
+		if stat, err := os.Stat(dir); err != nil || !stat.IsDir() {
+			t.Errorf("Expected the data directory to be created, got %v", err)
+		}
+		if info.ConfigPath != filepath.Join(dir, ConfigFileName) || info.DatabasePath != filepath.Join(dir, databaseDirName) {
+			t.Errorf("Unexpected paths: %+v", info)
+		}
+	})
+
+	t.Run("Migrate_Legacy_Data", func(t *testing.T) {
+		legacy := t.TempDir()
+		writeFile(t, filepath.Join(legacy, ConfigFileName), `{"legacy":true}`)
+		writeFile(t, filepath.Join(legacy, databaseDirName, "monitoring.db"), "legacy-db")
+		info, err := ResolveDataDir(filepath.Join(t.TempDir(), "hwnow"), false)
+		if err != nil {
+			t.Fatalf("ResolveDataDir failed: %v", err)
+		}
+
+		migrated, err := MigrateLegacyData(legacy, info)
+		if err != nil || len(migrated) != 2 {
+			t.Fatalf("Expected config and database to be migrated, got %v (err=%v)", migrated, err)
+		}
+		if content := readFile(t, info.ConfigPath); content != `{"legacy":true}` {
+			t.Errorf("Expected the migrated config, got %q", content)
+		}
+		if content := readFile(t, filepath.Join(info.DatabasePath, "monitoring.db")); content != "legacy-db" {
+			t.Errorf("Expected the migrated database, got %q", content)
+		}
+		if _, err := os.Stat(filepath.Join(legacy, ConfigFileName)); !os.IsNotExist(err) {
+			t.Error("Expected the legacy config to be moved away")
+		}
+
+		// 두 번째 실행에서는 옮길 파일이 없음
+		if migrated, err := MigrateLegacyData(legacy, info); err != nil || len(migrated) != 0 {
+			t.Errorf("Expected nothing to migrate the second time, got %v (err=%v)", migrated, err)
+		}
+	})
+
+	t.Run("Existing_Data_Not_Overwritten", func(t *testing.T) {
+		legacy := t.TempDir()
+		writeFile(t, filepath.Join(legacy, ConfigFileName), `{"legacy":true}`)
+		info, err := ResolveDataDir(t.TempDir(), false)
+		if err != nil {
+			t.Fatalf("ResolveDataDir failed: %v", err)
+		}
+		writeFile(t, info.ConfigPath, `{"current":true}`)
+
+		if migrated, err := MigrateLegacyData(legacy, info); err != nil || len(migrated) != 0 {
+			t.Errorf("Expected nothing to be migrated, got %v (err=%v)", migrated, err)
+		}
+		if content := readFile(t, info.ConfigPath); content != `{"current":true}` {
+			t.Errorf("Expected the existing config to be kept, got %q", content)
+		}
+		if _, err := os.Stat(filepath.Join(legacy, ConfigFileName)); err != nil {
+			t.Errorf("Expected the legacy config to stay in place, got %v", err)
+		}
+	})
+
+	t.Run("Same_Directory", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ConfigFileName), "{}")
+		info, err := ResolveDataDir(dir, false)
+		if err != nil {
+			t.Fatalf("ResolveDataDir failed: %v", err)
+		}
+		if migrated, err := MigrateLegacyData(dir, info); err != nil || migrated != nil {
+			t.Errorf("Expected no migration when the data directory is the legacy one, got %v (err=%v)", migrated, err)
+		}
+	})
+
+	t.Run("Copy_Fallback", func(t *testing.T) {
+		// 다른 볼륨으로 이름을 바꿀 수 없을 때 사용하는 복사 경로
+		from := t.TempDir()
+		writeFile(t, filepath.Join(from, "b.db"), "b")
+		to := t.TempDir()
+
+		if err := copyFile(filepath.Join(from, "b.db"), filepath.Join(to, "b.db"), 0644); err != nil {
+			t.Fatalf("copyFile failed: %v", err)
+		}
+		if content := readFile(t, filepath.Join(to, "b.db")); content != "b" {
+			t.Errorf("Expected the copied file, got %q", content)
+		}
+		// 대상이 이미 있으면 덮어쓰지 않음
+		if err := copyFile(filepath.Join(from, "b.db"), filepath.Join(to, "b.db"), 0644); err == nil {
+			t.Error("Expected copyFile to refuse an existing destination")
+		}
+	})
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	return string(content)
+}
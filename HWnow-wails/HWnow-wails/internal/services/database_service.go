@@ -1,10 +1,14 @@
 package services
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"HWnow-wails/internal/monitoring"
@@ -27,6 +31,25 @@ type PageResult struct {
 	ErrorCode int                      `json:"error_code,omitempty"`
 }
 
+// EventResult represents the result of event log queries
+type EventResult struct {
+	Success    bool       `json:"success"`
+	Message    string     `json:"message"`
+	Events     []db.Event `json:"events,omitempty"`
+	TotalCount int        `json:"total_count"`
+	HasMore    bool       `json:"has_more"`
+	ErrorCode  int        `json:"error_code,omitempty"`
+}
+
+// HistoryResult represents the result of resource history queries
+type HistoryResult struct {
+	Success    bool                      `json:"success"`
+	Message    string                    `json:"message"`
+	Resolution string                    `json:"resolution"`
+	Points     []db.ResourceHistoryPoint `json:"points,omitempty"`
+	ErrorCode  int                       `json:"error_code,omitempty"`
+}
+
 // DatabaseService provides database functionality
 type DatabaseService struct {
 	mutex        sync.RWMutex
@@ -34,6 +57,48 @@ type DatabaseService struct {
 	isInitialized bool
 	connectionString string
 	configCache   *Config
+	dataDir       string // 데이터 디렉터리 (데이터베이스는 하위 data 디렉터리에 저장)
+
+	// 자원 로그 일괄 기록
+	resourceLogChan   chan *monitoring.ResourceSnapshot
+	resourceLogWriter *db.ResourceLogWriter
+	resourceLogDone   chan struct{}
+	droppedSnapshots  int64
+
+	// 장기 이력 압축 작업
+	compactionStop chan struct{}
+	compactionDone chan struct{}
+
+	// 가용성 추적 (현재 실행 구간과 주기적 생존 기록)
+	appSessionID  int64
+	heartbeatStop chan struct{}
+	heartbeatDone chan struct{}
+
+	// 프로세스 목록 스냅샷 ("무엇이 바뀌었나" 비교용)
+	processSnapshotStop chan struct{}
+	processSnapshotDone chan struct{}
+}
+
+// AvailabilityResult represents the result of availability queries
+type AvailabilityResult struct {
+	Success      bool             `json:"success"`
+	Message      string           `json:"message"`
+	Availability *db.Availability `json:"availability,omitempty"`
+	ErrorCode    int              `json:"error_code,omitempty"`
+}
+
+// APP_SESSION_HEARTBEAT_INTERVAL bounds how much uptime is lost when the backend exits uncleanly
+const APP_SESSION_HEARTBEAT_INTERVAL = time.Minute
+
+// PROCESS_SNAPSHOT_INTERVAL is the interval between stored process list snapshots
+const PROCESS_SNAPSHOT_INTERVAL = 5 * time.Minute
+
+// SnapshotDiffResult represents the result of "what changed since" queries
+type SnapshotDiffResult struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Diff      *db.SnapshotDiff `json:"diff,omitempty"`
+	ErrorCode int              `json:"error_code,omitempty"`
 }
 
 // NewDatabaseService creates a new database service instance
@@ -50,6 +115,13 @@ func (ds *DatabaseService) SetConfig(config *Config) {
 	ds.configCache = config
 }
 
+// SetDataDir sets the data directory under which the database directory is created
+func (ds *DatabaseService) SetDataDir(dataDir string) {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	ds.dataDir = dataDir
+}
+
 // Initialize initializes the database connection and tables with optimized error handling
 func (ds *DatabaseService) Initialize() error {
 	ds.mutex.Lock()
@@ -61,27 +133,40 @@ func (ds *DatabaseService) Initialize() error {
 		return nil
 	}
 
-	// 데이터베이스 경로 설정
-	dbPath, dbFile := ds.getDatabasePath()
-
-	// 입력 유효성 검사
-	if err := ds.validateDatabaseConfig(dbPath, dbFile); err != nil {
-		monitoring.LogError("Invalid database configuration", "error", err)
-		return err
-	}
+	var dataSourceName string
+	var err error
+	if driver, dsn := ds.getDatabaseDriver(); driver != db.DriverSQLite {
+		// 서버 데이터베이스 (PostgreSQL/MySQL): 파일 경로 대신 접속 문자열 사용
+		conn, err := db.Open(driver, dsn)
+		if err != nil {
+			monitoring.LogError("Failed to open database", "driver", driver, "error", err)
+			return fmt.Errorf("database initialization failed: %w", err)
+		}
+		ds.db = conn
+		dataSourceName = driver
+	} else {
+		// 데이터베이스 경로 설정
+		dbPath, dbFile := ds.getDatabasePath()
+
+		// 입력 유효성 검사
+		if err := ds.validateDatabaseConfig(dbPath, dbFile); err != nil {
+			monitoring.LogError("Invalid database configuration", "error", err)
+			return err
+		}
 
-	// 데이터베이스 파일 경로 확인
-	dataSourceName, err := db.EnsureDB(dbPath, dbFile)
-	if err != nil {
-		monitoring.LogError("Failed to ensure database path", "dbPath", dbPath, "dbFile", dbFile, "error", err)
-		return fmt.Errorf("database path setup failed: %w", err)
-	}
+		// 데이터베이스 파일 경로 확인
+		dataSourceName, err = db.EnsureDB(dbPath, dbFile)
+		if err != nil {
+			monitoring.LogError("Failed to ensure database path", "dbPath", dbPath, "dbFile", dbFile, "error", err)
+			return fmt.Errorf("database path setup failed: %w", err)
+		}
 
-	// 데이터베이스 초기화
-	ds.db, err = db.InitDB(dataSourceName)
-	if err != nil {
-		monitoring.LogError("Failed to initialize database", "dataSource", dataSourceName, "error", err)
-		return fmt.Errorf("database initialization failed: %w", err)
+		// 데이터베이스 초기화
+		ds.db, err = db.InitDB(dataSourceName)
+		if err != nil {
+			monitoring.LogError("Failed to initialize database", "dataSource", dataSourceName, "error", err)
+			return fmt.Errorf("database initialization failed: %w", err)
+		}
 	}
 
 	// 연결 테스트
@@ -94,6 +179,10 @@ func (ds *DatabaseService) Initialize() error {
 
 	ds.connectionString = dataSourceName
 	ds.isInitialized = true
+	ds.startResourceLogWriter()
+	ds.startCompactionJob()
+	ds.startAppSession()
+	ds.startProcessSnapshotJob()
 	monitoring.LogInfo("Database service initialized successfully", "path", dataSourceName, "initialized", ds.isInitialized)
 	return nil
 }
@@ -103,6 +192,12 @@ func (ds *DatabaseService) Close() error {
 	ds.mutex.Lock()
 	defer ds.mutex.Unlock()
 
+	// 남은 자원 로그를 기록한 뒤 연결 종료
+	ds.stopAppSession()
+	ds.stopProcessSnapshotJob()
+	ds.stopCompactionJob()
+	ds.stopResourceLogWriter()
+
 	if ds.db != nil {
 		// 진행 중인 트랜잭션이 있다면 대기
 		monitoring.LogInfo("Closing database connection", "connectionString", ds.connectionString)
@@ -121,6 +216,284 @@ func (ds *DatabaseService) Close() error {
 	return nil
 }
 
+// startResourceLogWriter starts the batched resource_logs writer (caller holds the mutex)
+func (ds *DatabaseService) startResourceLogWriter() {
+	if ds.resourceLogWriter != nil {
+		return
+	}
+
+	writerConfig := db.DefaultResourceLogWriterConfig()
+	queueSize := 64
+	if ds.configCache != nil {
+		writerConfig.BatchSize = ds.configCache.Database.WriteBatchSize
+		writerConfig.FlushInterval = time.Duration(ds.configCache.Database.WriteFlushIntervalMs) * time.Millisecond
+		if ds.configCache.Database.WriteQueueSize > 0 {
+			queueSize = ds.configCache.Database.WriteQueueSize
+		}
+		writerConfig.MaxSpillBytes = int64(ds.configCache.Database.SpillMaxMB) * 1024 * 1024
+	}
+	dbPath, _ := ds.getDatabasePath()
+	writerConfig.SpillPath = filepath.Join(dbPath, "resource_logs.spill")
+
+	ds.resourceLogChan = make(chan *monitoring.ResourceSnapshot, queueSize)
+	ds.resourceLogWriter = db.NewResourceLogWriter(ds.db, writerConfig)
+	ds.resourceLogDone = make(chan struct{})
+
+	go func(writer *db.ResourceLogWriter, snapshots <-chan *monitoring.ResourceSnapshot, done chan<- struct{}) {
+		defer close(done)
+		writer.Run(snapshots)
+	}(ds.resourceLogWriter, ds.resourceLogChan, ds.resourceLogDone)
+}
+
+// stopResourceLogWriter closes the snapshot channel and waits for the final flush (caller holds the mutex)
+func (ds *DatabaseService) stopResourceLogWriter() {
+	if ds.resourceLogChan == nil {
+		return
+	}
+
+	close(ds.resourceLogChan)
+	<-ds.resourceLogDone
+
+	ds.resourceLogChan = nil
+	ds.resourceLogWriter = nil
+	ds.resourceLogDone = nil
+}
+
+// startCompactionJob starts the periodic downsampling of resource logs (caller holds the mutex)
+func (ds *DatabaseService) startCompactionJob() {
+	if ds.compactionStop != nil {
+		return
+	}
+
+	defaults := getDefaultConfig().Database
+	rawDays, minuteDays, intervalMinutes := defaults.RawRetentionDays, defaults.MinuteRetentionDays, defaults.CompactionMinutes
+	if ds.configCache != nil {
+		rawDays = ds.configCache.Database.RawRetentionDays
+		minuteDays = ds.configCache.Database.MinuteRetentionDays
+		intervalMinutes = ds.configCache.Database.CompactionMinutes
+	}
+
+	ds.compactionStop = make(chan struct{})
+	ds.compactionDone = make(chan struct{})
+
+	go func(database *sql.DB, stop <-chan struct{}, done chan<- struct{}) {
+		defer close(done)
+
+		ticker := time.NewTicker(time.Duration(intervalMinutes) * time.Minute)
+		defer ticker.Stop()
+
+		for {
+			now := time.Now()
+			result, err := db.CompactResourceLogs(database,
+				now.AddDate(0, 0, -rawDays), now.AddDate(0, 0, -minuteDays))
+			if err != nil {
+				monitoring.LogWarn("Resource log compaction failed", "error", err)
+			} else if result.RawRowsCompacted > 0 || result.MinuteRowsCompacted > 0 {
+				monitoring.LogInfo("Resource logs compacted",
+					"rawRows", result.RawRowsCompacted,
+					"minuteRows", result.MinuteRowsCompacted,
+					"duration", result.Duration.String())
+			}
+			if deleted, err := db.DeleteProcessSnapshotsBefore(database, now.AddDate(0, 0, -rawDays)); err != nil {
+				monitoring.LogWarn("Process snapshot cleanup failed", "error", err)
+			} else if deleted > 0 {
+				monitoring.LogInfo("Old process snapshots removed", "rows", deleted)
+			}
+
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}(ds.db, ds.compactionStop, ds.compactionDone)
+}
+
+// stopCompactionJob stops the compaction job and waits for a running compaction to finish (caller holds the mutex)
+func (ds *DatabaseService) stopCompactionJob() {
+	if ds.compactionStop == nil {
+		return
+	}
+
+	close(ds.compactionStop)
+	<-ds.compactionDone
+
+	ds.compactionStop = nil
+	ds.compactionDone = nil
+}
+
+// startAppSession records the backend start, detects reboots and starts the heartbeat (caller holds the mutex)
+func (ds *DatabaseService) startAppSession() {
+	if ds.heartbeatStop != nil {
+		return
+	}
+
+	ctx, cancel := newQueryContext(defaultQueryTimeout)
+	bootTime, err := monitoring.GetBootTime(ctx)
+	cancel()
+	if err != nil {
+		monitoring.LogWarn("Boot time unavailable, availability tracking disabled", "error", err)
+		return
+	}
+
+	now := time.Now()
+	sessionID, reboot, err := db.StartAppSession(ds.db, now, bootTime)
+	if err != nil {
+		monitoring.LogWarn("Failed to record app session", "error", err)
+		return
+	}
+	ds.appSessionID = sessionID
+
+	if reboot != nil {
+		monitoring.LogInfo("Host reboot detected", "bootTime", reboot.BootTime, "downtimeSeconds", reboot.DowntimeSeconds)
+		details, _ := json.Marshal(reboot)
+		_, err := db.InsertEvent(ds.db, db.Event{
+			Timestamp: now,
+			Category:  db.EventCategoryPower,
+			Action:    "reboot",
+			Target:    "host",
+			Success:   true,
+			Message:   fmt.Sprintf("Host rebooted at %s", reboot.BootTime.Format(time.RFC3339)),
+			Details:   string(details),
+		})
+		if err != nil {
+			monitoring.LogWarn("Failed to record reboot event", "error", err)
+		}
+	}
+
+	ds.heartbeatStop = make(chan struct{})
+	ds.heartbeatDone = make(chan struct{})
+
+	go func(database *sql.DB, sessionID int64, stop <-chan struct{}, done chan<- struct{}) {
+		defer close(done)
+
+		ticker := time.NewTicker(APP_SESSION_HEARTBEAT_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				if err := db.TouchAppSession(database, sessionID, now); err != nil {
+					monitoring.LogDebug("Failed to update app session heartbeat", "error", err)
+				}
+			}
+		}
+	}(ds.db, sessionID, ds.heartbeatStop, ds.heartbeatDone)
+}
+
+// stopAppSession stops the heartbeat and records a clean backend stop (caller holds the mutex)
+func (ds *DatabaseService) stopAppSession() {
+	if ds.heartbeatStop == nil {
+		return
+	}
+
+	close(ds.heartbeatStop)
+	<-ds.heartbeatDone
+	ds.heartbeatStop = nil
+	ds.heartbeatDone = nil
+
+	if err := db.StopAppSession(ds.db, ds.appSessionID, time.Now()); err != nil {
+		monitoring.LogWarn("Failed to record app session stop", "error", err)
+	}
+	ds.appSessionID = 0
+}
+
+// startProcessSnapshotJob periodically stores the process list aggregated by name (caller holds the mutex)
+func (ds *DatabaseService) startProcessSnapshotJob() {
+	if ds.processSnapshotStop != nil {
+		return
+	}
+
+	ds.processSnapshotStop = make(chan struct{})
+	ds.processSnapshotDone = make(chan struct{})
+
+	go func(database *sql.DB, stop <-chan struct{}, done chan<- struct{}) {
+		defer close(done)
+
+		ticker := time.NewTicker(PROCESS_SNAPSHOT_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				ctx, cancel := newQueryContext(defaultQueryTimeout)
+				response, err := monitoring.GetProcessesFiltered(ctx, monitoring.ProcessQuery{})
+				cancel()
+				if err != nil {
+					monitoring.LogDebug("Failed to collect processes for snapshot", "error", err)
+					continue
+				}
+				if err := db.InsertProcessSnapshot(database, now, processSnapshotEntries(response.Processes)); err != nil {
+					monitoring.LogWarn("Failed to store process snapshot", "error", err)
+				}
+			}
+		}
+	}(ds.db, ds.processSnapshotStop, ds.processSnapshotDone)
+}
+
+// stopProcessSnapshotJob stops the process snapshot job (caller holds the mutex)
+func (ds *DatabaseService) stopProcessSnapshotJob() {
+	if ds.processSnapshotStop == nil {
+		return
+	}
+
+	close(ds.processSnapshotStop)
+	<-ds.processSnapshotDone
+	ds.processSnapshotStop = nil
+	ds.processSnapshotDone = nil
+}
+
+// processSnapshotEntries aggregates processes by name for storage
+func processSnapshotEntries(processes []monitoring.ProcessDetail) []db.ProcessSnapshotEntry {
+	index := make(map[string]int)
+	entries := make([]db.ProcessSnapshotEntry, 0, len(processes))
+	for _, process := range processes {
+		i, exists := index[process.Name]
+		if !exists {
+			i = len(entries)
+			index[process.Name] = i
+			entries = append(entries, db.ProcessSnapshotEntry{Name: process.Name})
+		}
+		entries[i].ProcessCount++
+		entries[i].CPUPercent += process.CPUPercent
+		entries[i].MemoryRSS += process.MemoryRSS
+	}
+	return entries
+}
+
+// EnqueueResourceSnapshot queues a snapshot for batched writing without blocking the caller.
+// 큐가 가득 차면 (디스크 기록 지연) spill 파일에 보관하고, spill이 비활성이면 스냅샷을 버리고 false를 반환
+func (ds *DatabaseService) EnqueueResourceSnapshot(snapshot *monitoring.ResourceSnapshot) bool {
+	if snapshot == nil {
+		return false
+	}
+
+	ds.mutex.RLock()
+	defer ds.mutex.RUnlock()
+
+	if ds.resourceLogChan == nil {
+		return false
+	}
+
+	select {
+	case ds.resourceLogChan <- snapshot:
+		return true
+	default:
+		if ds.resourceLogWriter != nil && ds.resourceLogWriter.Spill(snapshot) {
+			return true
+		}
+		dropped := atomic.AddInt64(&ds.droppedSnapshots, 1)
+		if dropped == 1 || dropped%100 == 0 {
+			monitoring.LogWarn("Resource log queue full, dropping snapshot", "droppedTotal", dropped)
+		}
+		return false
+	}
+}
+
 // GetConnectionInfo returns database connection information for debugging
 func (ds *DatabaseService) GetConnectionInfo() map[string]interface{} {
 	ds.mutex.RLock()
@@ -132,6 +505,11 @@ func (ds *DatabaseService) GetConnectionInfo() map[string]interface{} {
 		"connected":         ds.db != nil,
 	}
 
+	if ds.resourceLogWriter != nil {
+		info["resource_log_writer"] = ds.resourceLogWriter.Stats()
+		info["dropped_snapshots"] = atomic.LoadInt64(&ds.droppedSnapshots)
+	}
+
 	if ds.db != nil {
 		// 연결 상태 확인
 		if err := ds.db.Ping(); err != nil {
@@ -144,6 +522,40 @@ func (ds *DatabaseService) GetConnectionInfo() map[string]interface{} {
 	return info
 }
 
+// DatabaseHealth describes database connectivity for health and readiness checks
+type DatabaseHealth struct {
+	Connected        bool   `json:"connected"`
+	Error            string `json:"error,omitempty"`
+	SpillBytes       int64  `json:"spill_bytes"` // 기록 실패로 디스크에 보관 중인 자원 로그 (0보다 크면 기록 지연)
+	DroppedSnapshots int64  `json:"dropped_snapshots"`
+}
+
+// CheckHealth runs a bounded query against the database without triggering initialization
+func (ds *DatabaseService) CheckHealth() DatabaseHealth {
+	ds.mutex.RLock()
+	defer ds.mutex.RUnlock()
+
+	health := DatabaseHealth{DroppedSnapshots: atomic.LoadInt64(&ds.droppedSnapshots)}
+	if !ds.isInitialized || ds.db == nil {
+		health.Error = "database not initialized"
+		return health
+	}
+	if ds.resourceLogWriter != nil {
+		health.SpillBytes = ds.resourceLogWriter.Stats().SpillBytes
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	var one int
+	if err := ds.db.QueryRowContext(ctx, "SELECT 1").Scan(&one); err != nil {
+		health.Error = err.Error()
+		return health
+	}
+	health.Connected = true
+	return health
+}
+
 // GetWidgets retrieves widgets for a specific user and page with enhanced error handling and caching
 func (ds *DatabaseService) GetWidgets(userID, pageID string) *WidgetResult {
 	if err := ds.validateUserInput(userID, pageID); err != nil {
@@ -178,6 +590,9 @@ func (ds *DatabaseService) GetWidgets(userID, pageID string) *WidgetResult {
 		}
 	}
 
+	// 손상되었거나 이전 버전으로 저장된 위젯 상태를 현재 버전으로 변환 (검증에 실패한 부분은 기본값으로 복구)
+	repairWidgetStates(widgets)
+
 	// Convert WidgetState to map for compatibility
 	widgetMaps := make([]map[string]interface{}, len(widgets))
 	for i, w := range widgets {
@@ -188,6 +603,7 @@ func (ds *DatabaseService) GetWidgets(userID, pageID string) *WidgetResult {
 			"layout":     w.Layout,
 			"user_id":    w.UserID,
 			"page_id":    w.PageID,
+			"version":    w.Version,
 		}
 	}
 
@@ -238,6 +654,15 @@ func (ds *DatabaseService) SaveWidgets(userID, pageID string, widgets []map[stri
 		}
 	}
 
+	if _, err := normalizeWidgetStates(widgetStates); err != nil {
+		monitoring.LogWarn("Rejected invalid widget state", "userID", userID, "pageID", pageID, "error", err)
+		return &WidgetResult{
+			Success:   false,
+			Message:   err.Error(),
+			ErrorCode: 400,
+		}
+	}
+
 	err := ds.executeWithRetry(func() error {
 		return db.SaveWidgets(ds.db, widgetStates)
 	})
@@ -305,6 +730,134 @@ func (ds *DatabaseService) DeleteWidget(userID, pageID, widgetID string) *Widget
 	}
 }
 
+// WidgetStateBundle holds the widget states of several pages of one user (bulk widget state API)
+type WidgetStateBundle struct {
+	UserID   string          `json:"user_id"`
+	Version  int             `json:"version"` // 위젯 상태 형식 버전 (저장 시 0 = 알 수 없음, 모든 마이그레이션 적용)
+	Pages    []db.WidgetPage `json:"pages"`
+	Warnings []string        `json:"warnings,omitempty"`
+}
+
+// normalizeWidgetStates validates widget states against their schemas and converts them to the current version in place
+func normalizeWidgetStates(widgets []db.WidgetState) ([]string, error) {
+	if len(widgets) > monitoring.MAX_WIDGETS_PER_PAGE {
+		return nil, fmt.Errorf("%w: more than %d widgets on one page", monitoring.ErrInvalidWidgetState, monitoring.MAX_WIDGETS_PER_PAGE)
+	}
+
+	var warnings []string
+	seen := make(map[string]bool, len(widgets))
+	for i := range widgets {
+		w := &widgets[i]
+		if strings.TrimSpace(w.WidgetID) == "" || len(w.WidgetID) > 100 {
+			return nil, fmt.Errorf("%w: widget id must be 1-100 characters", monitoring.ErrInvalidWidgetState)
+		}
+		if seen[w.WidgetID] {
+			return nil, fmt.Errorf("%w: duplicate widget id %s", monitoring.ErrInvalidWidgetState, w.WidgetID)
+		}
+		seen[w.WidgetID] = true
+
+		result, err := monitoring.NormalizeWidgetState(w.WidgetType, w.Config, w.Layout, w.Version)
+		if err != nil {
+			return nil, fmt.Errorf("widget %s: %w", w.WidgetID, err)
+		}
+		w.Config, w.Layout, w.Version = result.Config, result.Layout, result.Version
+		warnings = append(warnings, result.Warnings...)
+	}
+	return warnings, nil
+}
+
+// repairWidgetStates converts stored widget states to the current version, resetting invalid parts to defaults
+func repairWidgetStates(widgets []db.WidgetState) []string {
+	var warnings []string
+	for i := range widgets {
+		w := &widgets[i]
+		result := monitoring.RepairWidgetState(w.WidgetType, w.Config, w.Layout, w.Version)
+		w.Config, w.Layout, w.Version = result.Config, result.Layout, result.Version
+		for _, warning := range result.Warnings {
+			monitoring.LogWarn("Widget state repaired", "widgetID", w.WidgetID, "pageID", w.PageID, "warning", warning)
+			warnings = append(warnings, fmt.Sprintf("%s: %s", w.WidgetID, warning))
+		}
+	}
+	return warnings
+}
+
+// GetWidgetStates retrieves the widgets of several pages at once (every page of the user when pageIDs is empty)
+func (ds *DatabaseService) GetWidgetStates(userID string, pageIDs []string) (*WidgetStateBundle, error) {
+	if err := ds.validateUserID(userID); err != nil {
+		return nil, err
+	}
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var pages []db.WidgetPage
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		pages, queryErr = db.GetWidgetPages(ds.db, userID, pageIDs)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &WidgetStateBundle{UserID: userID, Version: monitoring.WIDGET_STATE_VERSION, Pages: pages}
+	for _, page := range pages {
+		bundle.Warnings = append(bundle.Warnings, repairWidgetStates(page.Widgets)...)
+	}
+	return bundle, nil
+}
+
+// SaveWidgetStates validates and replaces the widgets of every page in the bundle in one transaction
+func (ds *DatabaseService) SaveWidgetStates(bundle WidgetStateBundle) (*WidgetStateBundle, error) {
+	if err := ds.validateUserID(bundle.UserID); err != nil {
+		return nil, err
+	}
+	if len(bundle.Pages) == 0 {
+		return nil, fmt.Errorf("%w: no pages provided", monitoring.ErrInvalidWidgetState)
+	}
+
+	saved := &WidgetStateBundle{UserID: bundle.UserID, Version: monitoring.WIDGET_STATE_VERSION, Pages: make([]db.WidgetPage, 0, len(bundle.Pages))}
+	seenPages := make(map[string]bool, len(bundle.Pages))
+	for _, page := range bundle.Pages {
+		if err := ds.validateUserInput(bundle.UserID, page.PageID); err != nil {
+			return nil, fmt.Errorf("%w: %v", monitoring.ErrInvalidWidgetState, err)
+		}
+		if seenPages[page.PageID] {
+			return nil, fmt.Errorf("%w: duplicate page %s", monitoring.ErrInvalidWidgetState, page.PageID)
+		}
+		seenPages[page.PageID] = true
+
+		widgets := make([]db.WidgetState, len(page.Widgets))
+		for i, w := range page.Widgets {
+			w.UserID, w.PageID = bundle.UserID, page.PageID
+			// 일괄 저장 요청의 버전이 위젯별 버전보다 우선
+			if bundle.Version > 0 {
+				w.Version = bundle.Version
+			}
+			widgets[i] = w
+		}
+		warnings, err := normalizeWidgetStates(widgets)
+		if err != nil {
+			return nil, fmt.Errorf("page %s: %w", page.PageID, err)
+		}
+		saved.Warnings = append(saved.Warnings, warnings...)
+		saved.Pages = append(saved.Pages, db.WidgetPage{PageID: page.PageID, Widgets: widgets})
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+	err := ds.executeWithRetry(func() error {
+		return db.SaveWidgetPages(ds.db, bundle.UserID, saved.Pages)
+	})
+	if err != nil {
+		monitoring.LogError("Failed to save widget states", "userID", bundle.UserID, "pages", len(saved.Pages), "error", err)
+		return nil, err
+	}
+
+	monitoring.LogInfo("Saved widget states", "userID", bundle.UserID, "pages", len(saved.Pages))
+	return saved, nil
+}
 
 // GetPages retrieves all pages for a user
 func (ds *DatabaseService) GetPages(userID string) *PageResult {
@@ -497,6 +1050,88 @@ func (ds *DatabaseService) UpdatePageName(userID, pageID, pageName string) *Page
 	}
 }
 
+// RecordEvent stores an audit trail entry in the events table
+func (ds *DatabaseService) RecordEvent(category, action, target string, success bool, message, details string) error {
+	if strings.TrimSpace(category) == "" || strings.TrimSpace(action) == "" {
+		return fmt.Errorf("event category and action cannot be empty")
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return fmt.Errorf("database initialization failed: %w", err)
+	}
+
+	event := db.Event{
+		Timestamp: time.Now(),
+		Category:  category,
+		Action:    action,
+		Target:    target,
+		Success:   success,
+		Message:   message,
+		Details:   details,
+	}
+
+	err := ds.executeWithRetry(func() error {
+		_, insertErr := db.InsertEvent(ds.db, event)
+		return insertErr
+	})
+	if err != nil {
+		monitoring.LogError("Failed to record event", "category", category, "action", action, "target", target, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetEvents retrieves audit trail entries with filtering and pagination
+func (ds *DatabaseService) GetEvents(query db.EventQuery) *EventResult {
+	if query.MaxItems < 0 || query.Offset < 0 {
+		return &EventResult{
+			Success:   false,
+			Message:   "maxItems and offset cannot be negative",
+			ErrorCode: 400,
+		}
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return &EventResult{
+			Success:   false,
+			Message:   "until must not be earlier than since",
+			ErrorCode: 400,
+		}
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return &EventResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Database initialization failed: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	var events []db.Event
+	var totalCount int
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		events, totalCount, queryErr = db.GetEvents(ds.db, query)
+		return queryErr
+	})
+	if err != nil {
+		monitoring.LogError("Failed to get events", "category", query.Category, "error", err)
+		return &EventResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Failed to retrieve events: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	return &EventResult{
+		Success:    true,
+		Message:    fmt.Sprintf("Successfully retrieved %d events", len(events)),
+		Events:     events,
+		TotalCount: totalCount,
+		HasMore:    query.Offset+len(events) < totalCount,
+	}
+}
+
 // Helper methods
 
 // validateDatabaseConfig validates database configuration parameters
@@ -515,6 +1150,10 @@ func (ds *DatabaseService) getDatabasePath() (string, string) {
 	dbPath := "./data"
 	dbFile := "hwmonitor.db"
 
+	if ds.dataDir != "" {
+		dbPath = filepath.Join(ds.dataDir, databaseDirName)
+	}
+
 	// 설정에서 데이터베이스 경로 가져오기 (향후 확장 가능)
 	if ds.configCache != nil && ds.configCache.Database.Filename != "" {
 		dbFile = ds.configCache.Database.Filename
@@ -523,6 +1162,19 @@ func (ds *DatabaseService) getDatabasePath() (string, string) {
 	return dbPath, dbFile
 }
 
+// getDatabaseDriver returns the configured driver (normalized) and DSN
+func (ds *DatabaseService) getDatabaseDriver() (string, string) {
+	if ds.configCache == nil {
+		return db.DriverSQLite, ""
+	}
+	dialect, err := db.DialectFor(ds.configCache.Database.Driver)
+	if err != nil {
+		monitoring.LogWarn("Unknown database driver, falling back to SQLite", "driver", ds.configCache.Database.Driver)
+		return db.DriverSQLite, ""
+	}
+	return dialect.Name(), ds.configCache.Database.DSN
+}
+
 // validateUserID validates only user identifier
 func (ds *DatabaseService) validateUserID(userID string) error {
 	if strings.TrimSpace(userID) == "" {
@@ -611,4 +1263,370 @@ func getString(m map[string]interface{}, key string) string {
 		}
 	}
 	return ""
-}
\ No newline at end of file
+}
+
+// GetResourceHistory retrieves metric history, combining raw samples with downsampled aggregates
+func (ds *DatabaseService) GetResourceHistory(query db.ResourceHistoryQuery) *HistoryResult {
+	if query.Since.IsZero() {
+		return &HistoryResult{
+			Success:   false,
+			Message:   "since is required",
+			ErrorCode: 400,
+		}
+	}
+	if !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return &HistoryResult{
+			Success:   false,
+			Message:   "until must not be earlier than since",
+			ErrorCode: 400,
+		}
+	}
+	switch query.Resolution {
+	case "", db.ResolutionAuto, db.ResolutionRaw, db.ResolutionMinute, db.ResolutionHour:
+	default:
+		return &HistoryResult{
+			Success:   false,
+			Message:   fmt.Sprintf("invalid resolution: %s", query.Resolution),
+			ErrorCode: 400,
+		}
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return &HistoryResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Database initialization failed: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	var points []db.ResourceHistoryPoint
+	var resolution string
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		points, resolution, queryErr = db.GetResourceHistory(ds.db, query)
+		return queryErr
+	})
+	if err != nil {
+		monitoring.LogError("Failed to get resource history", "metricTypes", query.MetricTypes, "error", err)
+		return &HistoryResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Failed to retrieve resource history: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	return &HistoryResult{
+		Success:    true,
+		Message:    fmt.Sprintf("Successfully retrieved %d history points", len(points)),
+		Resolution: resolution,
+		Points:     points,
+	}
+}
+
+// GetResourceSummary retrieves avg/min/peak of metrics over a period (used by reports)
+func (ds *DatabaseService) GetResourceSummary(metricTypes []string, since, until time.Time) ([]db.MetricSummary, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var summaries []db.MetricSummary
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		summaries, queryErr = db.GetResourceSummary(ds.db, metricTypes, since, until)
+		return queryErr
+	})
+	return summaries, err
+}
+
+// SaveStressTestRun records a finished load generator run and returns its ID
+func (ds *DatabaseService) SaveStressTestRun(run db.StressTestRun) (int64, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err := ds.executeWithRetry(func() error {
+		var saveErr error
+		id, saveErr = db.InsertStressTestRun(ds.db, run)
+		return saveErr
+	})
+	return id, err
+}
+
+// GetStressTestRuns returns recorded load generator runs, most recent first
+func (ds *DatabaseService) GetStressTestRuns(limit int) ([]db.StressTestRun, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var runs []db.StressTestRun
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		runs, queryErr = db.GetStressTestRuns(ds.db, limit)
+		return queryErr
+	})
+	return runs, err
+}
+
+// GetStressTestRun returns a single recorded load generator run
+func (ds *DatabaseService) GetStressTestRun(id int64) (*db.StressTestRun, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var run *db.StressTestRun
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		run, queryErr = db.GetStressTestRun(ds.db, id)
+		return queryErr
+	})
+	return run, err
+}
+
+// GetThermalProfiles returns daily temperature profiles (hourly avg/min/max in local time)
+func (ds *DatabaseService) GetThermalProfiles(metricTypes []string, since, until time.Time) ([]db.ThermalProfile, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var profiles []db.ThermalProfile
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		profiles, queryErr = db.GetThermalProfiles(ds.db, metricTypes, since, until, time.Local)
+		return queryErr
+	})
+	return profiles, err
+}
+
+// GetEnergyUsage integrates a power metric (watts) into energy at one-minute resolution
+func (ds *DatabaseService) GetEnergyUsage(metricType string, since, until time.Time) (*db.EnergyUsage, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var usage *db.EnergyUsage
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		usage, queryErr = db.GetEnergyUsage(ds.db, metricType, since, until)
+		return queryErr
+	})
+	return usage, err
+}
+
+// CountEvents counts audit trail entries of a category within a period
+func (ds *DatabaseService) CountEvents(category string, since, until time.Time) (int, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return 0, err
+	}
+
+	var totalCount int
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		_, totalCount, queryErr = db.GetEvents(ds.db, db.EventQuery{Category: category, Since: since, Until: until, MaxItems: 1})
+		return queryErr
+	})
+	return totalCount, err
+}
+
+// GetAvailability computes host uptime percentage and reboot history between since and until
+func (ds *DatabaseService) GetAvailability(since, until time.Time) *AvailabilityResult {
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if since.IsZero() || !until.After(since) {
+		return &AvailabilityResult{
+			Success:   false,
+			Message:   "since is required and must be earlier than until",
+			ErrorCode: 400,
+		}
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return &AvailabilityResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Database initialization failed: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	var availability *db.Availability
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		availability, queryErr = db.GetAvailability(ds.db, since, until)
+		return queryErr
+	})
+	if err != nil {
+		monitoring.LogError("Failed to get availability", "error", err)
+		return &AvailabilityResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Failed to compute availability: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	return &AvailabilityResult{
+		Success:      true,
+		Message:      fmt.Sprintf("Availability computed with %d reboots", len(availability.Reboots)),
+		Availability: availability,
+	}
+}
+
+// GetWatchedProcesses retrieves the process watchdog list
+func (ds *DatabaseService) GetWatchedProcesses() ([]db.WatchedProcess, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var watched []db.WatchedProcess
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		watched, queryErr = db.GetWatchedProcesses(ds.db)
+		return queryErr
+	})
+	return watched, err
+}
+
+// SaveWatchedProcess inserts or updates a process watchdog entry and returns its ID
+func (ds *DatabaseService) SaveWatchedProcess(watched db.WatchedProcess) (int64, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err := ds.executeWithRetry(func() error {
+		var saveErr error
+		id, saveErr = db.SaveWatchedProcess(ds.db, watched)
+		return saveErr
+	})
+	return id, err
+}
+
+// DeleteWatchedProcess removes a process watchdog entry
+func (ds *DatabaseService) DeleteWatchedProcess(id int64) error {
+	if err := ds.ensureInitialized(); err != nil {
+		return err
+	}
+
+	return ds.executeWithRetry(func() error {
+		return db.DeleteWatchedProcess(ds.db, id)
+	})
+}
+
+// GetProtectedProcesses retrieves the user-defined protected processes
+func (ds *DatabaseService) GetProtectedProcesses() ([]db.ProtectedProcess, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	var protected []db.ProtectedProcess
+	err := ds.executeWithRetry(func() error {
+		var queryErr error
+		protected, queryErr = db.GetProtectedProcesses(ds.db)
+		return queryErr
+	})
+	return protected, err
+}
+
+// SaveProtectedProcess inserts or updates a user-defined protected process and returns its ID
+func (ds *DatabaseService) SaveProtectedProcess(protected db.ProtectedProcess) (int64, error) {
+	if err := ds.ensureInitialized(); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err := ds.executeWithRetry(func() error {
+		var saveErr error
+		id, saveErr = db.SaveProtectedProcess(ds.db, protected)
+		return saveErr
+	})
+	return id, err
+}
+
+// DeleteProtectedProcess removes a user-defined protected process
+func (ds *DatabaseService) DeleteProtectedProcess(id int64) error {
+	if err := ds.ensureInitialized(); err != nil {
+		return err
+	}
+
+	return ds.executeWithRetry(func() error {
+		return db.DeleteProtectedProcess(ds.db, id)
+	})
+}
+
+// GetSnapshotDiff compares stored process snapshots and metric averages at two points in time
+func (ds *DatabaseService) GetSnapshotDiff(query db.SnapshotDiffQuery) *SnapshotDiffResult {
+	if query.From.IsZero() || query.To.IsZero() || !query.To.After(query.From) {
+		return &SnapshotDiffResult{
+			Success:   false,
+			Message:   "from and to are required and from must be earlier than to",
+			ErrorCode: 400,
+		}
+	}
+	if query.WindowMinutes <= 0 {
+		query.WindowMinutes = 10
+	}
+	if query.ThresholdPercent <= 0 {
+		query.ThresholdPercent = 20
+	}
+
+	if err := ds.ensureInitialized(); err != nil {
+		return &SnapshotDiffResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Database initialization failed: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	diff := &db.SnapshotDiff{
+		From:             query.From,
+		To:               query.To,
+		WindowMinutes:    query.WindowMinutes,
+		ThresholdPercent: query.ThresholdPercent,
+	}
+	window := time.Duration(query.WindowMinutes) * time.Minute
+
+	err := ds.executeWithRetry(func() error {
+		// 각 시점 직전 구간의 평균을 비교
+		fromSummary, err := db.GetResourceSummary(ds.db, nil, query.From.Add(-window), query.From)
+		if err != nil {
+			return err
+		}
+		toSummary, err := db.GetResourceSummary(ds.db, nil, query.To.Add(-window), query.To)
+		if err != nil {
+			return err
+		}
+		diff.MetricChanges = db.DiffMetricSummaries(fromSummary, toSummary, query.ThresholdPercent)
+
+		// 프로세스 스냅샷이 아직 없으면 지표 변화만 보고
+		fromSnapshot, fromEntries, err := db.GetProcessSnapshotAt(ds.db, query.From)
+		if err == sql.ErrNoRows {
+			diff.Appeared, diff.Disappeared = []db.ProcessSnapshotEntry{}, []db.ProcessSnapshotEntry{}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		toSnapshot, toEntries, err := db.GetProcessSnapshotAt(ds.db, query.To)
+		if err != nil {
+			return err
+		}
+		diff.FromSnapshot, diff.ToSnapshot = fromSnapshot, toSnapshot
+		diff.Appeared, diff.Disappeared = db.DiffProcessSnapshots(fromEntries, toEntries)
+		return nil
+	})
+	if err != nil {
+		monitoring.LogError("Failed to diff snapshots", "from", query.From, "to", query.To, "error", err)
+		return &SnapshotDiffResult{
+			Success:   false,
+			Message:   fmt.Sprintf("Failed to compare snapshots: %v", err),
+			ErrorCode: 500,
+		}
+	}
+
+	return &SnapshotDiffResult{
+		Success: true,
+		Message: fmt.Sprintf("%d processes appeared, %d disappeared, %d metrics changed",
+			len(diff.Appeared), len(diff.Disappeared), len(diff.MetricChanges)),
+		Diff: diff,
+	}
+}
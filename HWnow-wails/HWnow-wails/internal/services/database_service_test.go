@@ -0,0 +1,103 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+// newTestAppService creates a service with default configuration and a database in a temporary directory
+func newTestAppService(t *testing.T) *AppService {
+	t.Helper()
+	a := NewAppService(filepath.Join(t.TempDir(), "config.json"))
+	config := getDefaultConfig()
+	a.config = &config
+	a.databaseService.SetConfig(&config)
+	if err := a.databaseService.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize the database: %v", err)
+	}
+	t.Cleanup(func() { a.databaseService.Close() })
+	return a
+}
+
+func TestWidgetStates(t *testing.T) {
+	const userID = "global-user"
+
+	t.Run("Legacy_State_Migrated_On_Save", func(t *testing.T) {
+		a := newTestAppService(t)
+		saved, err := a.SaveWidgetStates(WidgetStateBundle{
+			UserID:  userID,
+			Version: 1,
+			Pages: []db.WidgetPage{{PageID: "main-page", Widgets: []db.WidgetState{
+				{WidgetID: "ram-1", WidgetType: "ram", Layout: `{"x":1,"y":0,"w":4,"h":3}`},
+			}}},
+		})
+		if err != nil {
+			t.Fatalf("SaveWidgetStates failed: %v", err)
+		}
+		if saved.Pages[0].Widgets[0].Version != monitoring.WIDGET_STATE_VERSION {
+			t.Errorf("Expected the saved state at version %d, got %+v", monitoring.WIDGET_STATE_VERSION, saved.Pages[0].Widgets[0])
+		}
+
+		loaded, err := a.GetWidgetStates(userID, []string{"main-page"})
+		if err != nil || len(loaded.Pages) != 1 || len(loaded.Pages[0].Widgets) != 1 {
+			t.Fatalf("GetWidgetStates = %+v, %v", loaded, err)
+		}
+		widget := loaded.Pages[0].Widgets[0]
+		var layout map[string]map[string]float64
+		if err := json.Unmarshal([]byte(widget.Layout), &layout); err != nil || layout["lg"]["w"] != 4 {
+			t.Errorf("Expected the legacy layout stored under lg, got %s", widget.Layout)
+		}
+		if len(loaded.Warnings) != 0 {
+			t.Errorf("Expected no repair warnings for a migrated state, got %v", loaded.Warnings)
+		}
+	})
+
+	t.Run("Stored_Legacy_State_Repaired_On_Read", func(t *testing.T) {
+		a := newTestAppService(t)
+		// 버전 관리 이전에 저장된 행 (state_version 1)
+		err := db.SaveWidgetPages(a.databaseService.db, userID, []db.WidgetPage{{PageID: "main-page", Widgets: []db.WidgetState{
+			{WidgetID: "cpu-1", WidgetType: "cpu", Config: `{"chartType":"pie"}`, Layout: `{"x":0,"y":0,"w":6,"h":4}`, Version: 1},
+		}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		loaded, err := a.GetWidgetStates(userID, nil)
+		if err != nil {
+			t.Fatalf("GetWidgetStates failed: %v", err)
+		}
+		widget := loaded.Pages[0].Widgets[0]
+		if widget.Version != monitoring.WIDGET_STATE_VERSION || len(loaded.Warnings) == 0 {
+			t.Errorf("Expected the invalid config reset with a warning, got %+v (%v)", widget, loaded.Warnings)
+		}
+	})
+
+	t.Run("Rejects_Invalid_Bundle", func(t *testing.T) {
+		a := newTestAppService(t)
+		cases := map[string]WidgetStateBundle{
+			"Duplicate_Widget": {UserID: userID, Pages: []db.WidgetPage{{PageID: "main-page", Widgets: []db.WidgetState{
+				{WidgetID: "cpu-1", WidgetType: "cpu"}, {WidgetID: "cpu-1", WidgetType: "cpu"},
+			}}}},
+			"Duplicate_Page": {UserID: userID, Pages: []db.WidgetPage{{PageID: "main-page"}, {PageID: "main-page"}}},
+			"No_Pages":       {UserID: userID},
+			"Invalid_Config": {UserID: userID, Pages: []db.WidgetPage{{PageID: "main-page", Widgets: []db.WidgetState{
+				{WidgetID: "cpu-1", WidgetType: "cpu", Config: `{"chartType":"pie"}`},
+			}}}},
+		}
+		for name, bundle := range cases {
+			if _, err := a.SaveWidgetStates(bundle); !errors.Is(err, monitoring.ErrInvalidWidgetState) {
+				t.Errorf("%s: expected ErrInvalidWidgetState, got %v", name, err)
+			}
+		}
+
+		loaded, err := a.GetWidgetStates(userID, []string{"main-page"})
+		if err != nil || len(loaded.Pages[0].Widgets) != 0 {
+			t.Errorf("Expected nothing saved after rejected bundles, got %+v, %v", loaded, err)
+		}
+	})
+}
@@ -9,11 +9,12 @@ import (
 
 // GPUProcessControlResult represents the result of GPU process control operations
 type GPUProcessControlResult struct {
-	PID       int32  `json:"pid"`
-	Success   bool   `json:"success"`
-	Message   string `json:"message"`
-	Operation string `json:"operation"`
-	Priority  string `json:"priority,omitempty"`
+	PID             int32  `json:"pid"`
+	Success         bool   `json:"success"`
+	Message         string `json:"message"`
+	Operation       string `json:"operation"`
+	Priority        string `json:"priority,omitempty"`
+	AppliedPriority string `json:"applied_priority,omitempty"` // Priority read back from the OS after a change
 }
 
 // GPUProcessValidationResult represents GPU process validation results
@@ -57,7 +58,42 @@ func (g *GPUProcessControlService) SetProcessPriority(pid int32, priority string
 		return monitoring.SetGPUProcessPriority(pid, priority)
 	}
 
-	return g.executeProcessControl(pid, "priority", priority, priorityFunc)
+	result := g.executeProcessControl(pid, "priority", priority, priorityFunc)
+	if result.Success {
+		ctx, cancel := newQueryContext(defaultQueryTimeout)
+		defer cancel()
+		// OS가 실제로 적용한 값을 다시 읽어 UI에 전달 (권한 부족 시 요청값과 다를 수 있음)
+		if applied, err := monitoring.GetProcessPriority(ctx, pid); err == nil {
+			result.AppliedPriority = applied.Priority
+		}
+	}
+	return result
+}
+
+// PreviewControl validates a kill/suspend/resume/priority request without performing it
+func (g *GPUProcessControlService) PreviewControl(pid int32, operation, priority string) (*monitoring.ControlPreview, error) {
+	if err := g.validatePID(pid); err != nil {
+		return nil, err
+	}
+	return monitoring.PreviewProcessControl(operation, pid, priority)
+}
+
+// GetProcessPriority reads the priority currently applied to a process
+func (g *GPUProcessControlService) GetProcessPriority(pid int32) (*monitoring.ProcessPriority, error) {
+	if err := g.validatePID(pid); err != nil {
+		return nil, err
+	}
+	ctx, cancel := newQueryContext(defaultQueryTimeout)
+	defer cancel()
+	return monitoring.GetProcessPriority(ctx, pid)
+}
+
+// SetProcessLimits caps the CPU (share of total capacity) and memory of a process; 0 removes a limit
+func (g *GPUProcessControlService) SetProcessLimits(pid int32, cpuPercent, memoryMB float64) (*monitoring.ProcessLimits, error) {
+	if err := g.validatePID(pid); err != nil {
+		return nil, err
+	}
+	return monitoring.SetProcessLimits(pid, cpuPercent, memoryMB)
 }
 
 // ValidateProcess validates if a process is a valid GPU process
@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -15,29 +18,54 @@ type SystemInfo struct {
 	CPUModel     string    `json:"cpu_model"`
 	TotalMemory  float64   `json:"total_memory"`
 	BootTime     time.Time `json:"boot_time"`
+	Host         *monitoring.HostInfo `json:"host"` // OS 빌드, 가상화, BIOS, 제조사/모델
 }
 
 // RealTimeMetrics represents real-time system metrics
 type RealTimeMetrics struct {
 	CPUUsage       float64                      `json:"cpu_usage"`
 	CPUCoreUsage   []float64                    `json:"cpu_core_usage"`
+	CPUTimes       *monitoring.CPUTimeBreakdown `json:"cpu_times"`        // user/system/iowait/irq/steal 비율 (상세 모드에서만)
+	CPUTemperature float64                      `json:"cpu_temperature"`  // CPU 패키지 온도 (°C, -1 = 알 수 없음)
+	CPUPower       *monitoring.RAPLPower        `json:"cpu_power"`        // RAPL 패키지/코어/DRAM 전력 (Linux)
+	Throttle       *monitoring.ThrottleInfo     `json:"throttle"`         // CPU/GPU 열·전력 스로틀링, 코어별 클럭
+	Load           *monitoring.LoadInfo         `json:"load"`             // load average 또는 프로세서 대기열 길이
 	MemoryUsage    float64                      `json:"memory_usage"`
 	DiskUsage      *monitoring.DiskUsageInfo    `json:"disk_usage"`
 	DiskReadSpeed  float64                      `json:"disk_read_speed"`
 	DiskWriteSpeed float64                      `json:"disk_write_speed"`
+	DiskTemperatures []monitoring.DiskTemperature `json:"disk_temperatures"` // 드라이브별 온도 (60초 간격 갱신)
+	DiskPaths      []monitoring.DiskPathUsage   `json:"disk_paths"`       // 감시 경로/마운트 지점별 사용량
 	NetworkIO      []monitoring.NetworkInterface `json:"network_io"`
 	NetSentSpeed   float64                      `json:"net_sent_speed"`
 	NetRecvSpeed   float64                      `json:"net_recv_speed"`
+	NetworkErrors  []monitoring.NetworkInterfaceErrors `json:"network_errors"` // 인터페이스별 오류/드롭/충돌 카운터와 초당 증가율
 
 	// 새로 추가된 필드들 - 실제 시스템 정보만 제공
 	SystemUptime   int64                        `json:"system_uptime"`    // 시스템 업타임 (초)
 	BootTime       time.Time                    `json:"boot_time"`        // 시스템 부팅 시간
 	GPUInfo        *monitoring.GPUInfo          `json:"gpu_info"`         // GPU 정보 (실제 데이터만)
+	GPUEngines     []monitoring.GPUEngineUsage  `json:"gpu_engines"`      // 엔진 유형별 GPU 사용률 (Windows)
+	GPUAdapters    []monitoring.GPUAdapter      `json:"gpu_adapters"`     // 어댑터별 GPU 사용률 (하이브리드 그래픽 iGPU + dGPU)
+	GPUBandwidth   []monitoring.GPUBandwidth    `json:"gpu_bandwidth"`    // 메모리 컨트롤러/NVENC/NVDEC 사용률, PCIe 처리량 (NVIDIA)
+	GPUECC         []monitoring.GPUECCStatus    `json:"gpu_ecc"`          // ECC 오류 수, 폐기/재매핑 메모리 페이지 (NVIDIA)
+	NPUInfo        *monitoring.NPUInfo          `json:"npu_info"`         // NPU(AI 가속기) 정보 (감지된 경우만)
 	GPUProcesses   []monitoring.GPUProcess      `json:"gpu_processes"`    // GPU 프로세스 목록
 	TopProcesses   []monitoring.ProcessInfo     `json:"top_processes"`    // Top 프로세스 목록
 	MemoryDetails  *monitoring.MemoryDetails    `json:"memory_details"`   // 메모리 상세 정보
+	MemoryBreakdown *monitoring.MemoryBreakdown `json:"memory_breakdown"` // 캐시/대기/커밋 사용량
+	MemoryPaging   *monitoring.MemoryPaging     `json:"memory_paging"`    // 하드 폴트/스왑 입출력 속도
 	BatteryInfo    *monitoring.BatteryInfo      `json:"battery_info"`     // 배터리 정보 (실제 데이터만)
+	SystemPowerWatts float64                    `json:"system_power_watts"` // 추정 시스템 전체 전력 (W, -1 = 알 수 없음)
+	PowerInfo      *monitoring.SystemPowerInfo  `json:"power_info"`       // 전력 추정 상세 정보
 	NetworkStatus  string                       `json:"network_status"`   // 네트워크 연결 상태
+	NetworkQuality *monitoring.NetworkQuality   `json:"network_quality"`  // 지연 시간/패킷 손실/공인 IP (활성화된 경우만)
+	FrameStats     []monitoring.AppFrameStats   `json:"frame_stats"`      // 애플리케이션별 FPS/프레임 시간 (PresentMon, 활성화된 경우만)
+	Fans           []monitoring.Fan             `json:"fans"`             // 팬 회전수/PWM (Linux hwmon)
+	WiFi           []monitoring.WiFiInfo        `json:"wifi"`             // 무선 어댑터 신호/링크 속도
+	Audio          *monitoring.AudioInfo        `json:"audio"`            // 기본 오디오 장치/볼륨/세션 (활성화된 경우만)
+	Idle           *monitoring.IdleState        `json:"idle"`             // 사용자 유휴 상태 (유휴 중에는 수집 주기를 늘림)
+	CollectorErrors map[string]string           `json:"collector_errors,omitempty"` // 마지막 실행이 실패한 수집기 → 표시 문구 (값 0 대신 원인 표시용)
 
 	Timestamp      time.Time                    `json:"timestamp"`
 }
@@ -49,36 +77,168 @@ type MonitoringService struct {
 	ctx         context.Context
 	cancel      context.CancelFunc
 	config      *MonitoringConfig
+
+	// Windows 이벤트 로그 하드웨어 오류 감시
+	hardwareEventWatcher *monitoring.HardwareEventWatcher
+	hardwareEventHandler func(monitoring.HardwareEvent)
+
+	// 절전/복귀 및 세션 잠금/해제 감시
+	powerEventWatcher *monitoring.PowerEventWatcher
+	powerEventHandler func(monitoring.PowerEvent)
+
+	// GPU/디스크 핫플러그 감시 (장치 변경 시 하드웨어 캐시 무효화)
+	deviceEventWatcher *monitoring.DeviceEventWatcher
+	deviceEventHandler func(monitoring.DeviceEvent)
+
+	// 프로세스별 GPU 엔진 사용률 측정 (DxgKrnl ETW, 관리자 권한 필요)
+	gpuActivityTracer *monitoring.GPUActivityTracer
+
+	// 수집된 지표를 자원 로그로 전달 (프론트엔드 조회 시점에 기록)
+	snapshotHandler func(*monitoring.ResourceSnapshot)
+
+	// 수집 시간 예산 초과 시 비용이 큰 수집기의 간격을 늘림 (건너뛴 주기에는 직전 결과 재사용)
+	scheduler        *monitoring.AdaptiveScheduler
+	lastGPUProcesses []monitoring.GPUProcess
+	lastTopProcesses []monitoring.ProcessInfo
+
+	// 경로별 디스크 여유 공간 감시
+	diskPaths        []DiskPathConfig
+	diskSpaceMonitor *monitoring.DiskSpaceMonitor
+	diskSpaceHandler func(monitoring.DiskSpaceAlert)
+
+	// CPU/GPU 스로틀링 시작/종료 감지 및 누적 스로틀 시간
+	throttleMonitor *monitoring.ThrottleMonitor
+	throttleHandler func(monitoring.ThrottleEvent)
+
+	// GPU ECC 오류/폐기 페이지 증가 감지
+	gpuECCMonitor *monitoring.GPUECCMonitor
+	gpuECCHandler func(monitoring.GPUECCAlert)
+
+	// 네트워크 인터페이스 오류/충돌 발생 감지
+	networkErrorMonitor *monitoring.NetworkErrorMonitor
+	networkErrorHandler func(monitoring.NetworkErrorAlert)
+
+	// 네트워크 품질 측정 (nil 설정 = 비활성)
+	networkQualityConfig *monitoring.NetworkQualityConfig
+	networkQualityProbe  *monitoring.NetworkQualityProbe
+
+	// 애플리케이션별 프레임 통계 (nil 설정 = 비활성)
+	frameStatsConfig *monitoring.FrameStatsConfig
+	frameStatsSource *monitoring.FrameStatsSource
+
+	// 팬 곡선/수동 PWM 제어 (nil 설정 = PWM 변경 비활성)
+	fanControlConfig *FanControlConfig
+	fanController    *monitoring.FanController
+
+	// 클라이언트 세션별 일시정지 상태 (모든 세션이 일시정지되면 GPU/프로세스 스캔 중단)
+	sessions map[string]collectionSession
+
+	// 클라이언트별 위젯 가시성 (보이는 위젯의 수집기만 실행, 백그라운드 클라이언트는 느린 주기)
+	clientDemand *monitoring.ClientDemandTracker
+
+	// 사용자 유휴 상태 감지 (유휴 중에는 IdleIntervalSeconds 안의 호출에 직전 결과를 반환)
+	idleDetector *monitoring.IdleDetector
+	lastMetrics  *RealTimeMetrics
+
+	// 최근 N분 수집 값 (위젯 데이터, 최근 추이 조회용)
+	metricBuffer *monitoring.MetricBuffer
+}
+
+// CollectionState describes the per-session pause state of metric collection
+type CollectionState struct {
+	Paused         bool     `json:"paused"`
+	Sessions       int      `json:"sessions"`
+	PausedSessions []string `json:"paused_sessions"`
+}
+
+// 세션 ID를 지정하지 않은 호출에 사용하는 기본 세션
+const defaultCollectionSession = "default"
+
+// sessionIdleTimeout is how long a session is kept without a pause, resume or visibility report
+// (EndSession 없이 사라진 클라이언트가 일시정지 판단에 계속 남지 않도록 만료)
+const sessionIdleTimeout = 30 * time.Minute
+
+// collectionSession is the pause state of a client session and when the client last reported
+type collectionSession struct {
+	paused   bool
+	lastSeen time.Time
 }
 
 // NewMonitoringService creates a new monitoring service
 func NewMonitoringService(config *MonitoringConfig) *MonitoringService {
-	return &MonitoringService{
-		config: config,
+	service := &MonitoringService{
+		config:           config,
+		sessions:         make(map[string]collectionSession),
+		diskPaths:        config.DiskPaths,
+		diskSpaceMonitor: monitoring.NewDiskSpaceMonitor(),
+		throttleMonitor:  monitoring.NewThrottleMonitor(),
+		gpuECCMonitor:    monitoring.NewGPUECCMonitor(),
+		networkErrorMonitor: monitoring.NewNetworkErrorMonitor(),
+		idleDetector:     monitoring.NewIdleDetector(time.Duration(config.IdleThresholdMinutes) * time.Minute),
+		metricBuffer:     monitoring.NewMetricBuffer(time.Duration(config.RecentBufferMinutes) * time.Minute),
+		clientDemand: monitoring.NewClientDemandTracker(
+			time.Duration(config.IntervalSeconds)*time.Second,
+			time.Duration(config.BackgroundIntervalSecs)*time.Second,
+		),
+		scheduler: monitoring.NewAdaptiveScheduler(
+			time.Duration(config.CollectionBudgetMs)*time.Millisecond,
+			time.Duration(config.IntervalSeconds)*time.Second,
+			time.Duration(config.MaxAdaptiveIntervalSecs)*time.Second,
+			"gpu_processes", "top_processes",
+		),
+	}
+	service.SetCollectorIntervals(config.CollectorIntervalSecs)
+	return service
+}
+
+// 설정이 없는 호출(보고서, DB 스냅샷, 프로세스 제어)에 쓰는 하드웨어 조회 제한 시간
+const defaultQueryTimeout = 10 * time.Second
+
+// newQueryContext returns a context bounding one hardware query (timeout <= 0 uses defaultQueryTimeout)
+func newQueryContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = defaultQueryTimeout
 	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// queryContext bounds one hardware query by the configured query timeout
+func (s *MonitoringService) queryContext() (context.Context, context.CancelFunc) {
+	return newQueryContext(time.Duration(s.config.QueryTimeoutSeconds) * time.Second)
+}
+
+// timeQuery runs a collector under TimeCollector with its own query timeout
+func (s *MonitoringService) timeQuery(name string, collector func(ctx context.Context) error) {
+	monitoring.TimeCollector(name, func() error {
+		ctx, cancel := s.queryContext()
+		defer cancel()
+		return collector(ctx)
+	})
 }
 
 // GetSystemInfo retrieves system information
 func (s *MonitoringService) GetSystemInfo() (*SystemInfo, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
 	// Get CPU information
-	cpuCores, err := monitoring.GetCPUCores()
+	cpuCores, err := monitoring.GetCPUCores(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	cpuModel, err := monitoring.GetCPUModelName()
+	cpuModel, err := monitoring.GetCPUModelName(ctx)
 	if err != nil {
 		cpuModel = "Unknown CPU"
 	}
 
 	// Get memory information
-	totalMemory, err := monitoring.GetTotalMemory()
+	totalMemory, err := monitoring.GetTotalMemory(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get boot time
-	bootTime, err := monitoring.GetBootTime()
+	bootTime, err := monitoring.GetBootTime(ctx)
 	if err != nil {
 		bootTime = time.Now()
 	}
@@ -86,125 +246,877 @@ func (s *MonitoringService) GetSystemInfo() (*SystemInfo, error) {
 	// Get platform
 	platform := monitoring.GetCurrentPlatform()
 
+	// Host details are optional (nil when the platform sources are unavailable)
+	hostInfo, _ := monitoring.GetHostInfo(ctx)
+
 	return &SystemInfo{
 		Platform:    platform,
 		CPUCores:    cpuCores,
 		CPUModel:    cpuModel,
 		TotalMemory: totalMemory,
 		BootTime:    bootTime,
+		Host:        hostInfo,
 	}, nil
 }
 
+// collectorMetricFields copies the fields filled by each collector from the previous cycle,
+// used when a collector with its own interval is not yet due
+var collectorMetricFields = map[string]func(metrics, last *RealTimeMetrics){
+	"cpu": func(metrics, last *RealTimeMetrics) {
+		metrics.CPUUsage, metrics.CPUCoreUsage, metrics.Load, metrics.CPUTimes = last.CPUUsage, last.CPUCoreUsage, last.Load, last.CPUTimes
+	},
+	"cpu_temperature": func(metrics, last *RealTimeMetrics) { metrics.CPUTemperature = last.CPUTemperature },
+	"memory": func(metrics, last *RealTimeMetrics) {
+		metrics.MemoryUsage, metrics.MemoryDetails = last.MemoryUsage, last.MemoryDetails
+		metrics.MemoryBreakdown, metrics.MemoryPaging = last.MemoryBreakdown, last.MemoryPaging
+	},
+	"disk": func(metrics, last *RealTimeMetrics) {
+		metrics.DiskUsage, metrics.DiskReadSpeed, metrics.DiskWriteSpeed = last.DiskUsage, last.DiskReadSpeed, last.DiskWriteSpeed
+	},
+	"disk_paths":       func(metrics, last *RealTimeMetrics) { metrics.DiskPaths = last.DiskPaths },
+	"disk_temperature": func(metrics, last *RealTimeMetrics) { metrics.DiskTemperatures = last.DiskTemperatures },
+	"network": func(metrics, last *RealTimeMetrics) {
+		metrics.NetworkIO, metrics.NetSentSpeed, metrics.NetRecvSpeed = last.NetworkIO, last.NetSentSpeed, last.NetRecvSpeed
+		metrics.NetworkStatus = last.NetworkStatus
+	},
+	"network_errors": func(metrics, last *RealTimeMetrics) { metrics.NetworkErrors = last.NetworkErrors },
+	"wifi":  func(metrics, last *RealTimeMetrics) { metrics.WiFi = last.WiFi },
+	"audio": func(metrics, last *RealTimeMetrics) { metrics.Audio = last.Audio },
+	"system": func(metrics, last *RealTimeMetrics) {
+		metrics.SystemUptime, metrics.BootTime = last.SystemUptime, last.BootTime
+	},
+	"gpu_info":      func(metrics, last *RealTimeMetrics) { metrics.GPUInfo = last.GPUInfo },
+	"gpu_engines":   func(metrics, last *RealTimeMetrics) { metrics.GPUEngines = last.GPUEngines },
+	"gpu_adapters":  func(metrics, last *RealTimeMetrics) { metrics.GPUAdapters = last.GPUAdapters },
+	"rapl":          func(metrics, last *RealTimeMetrics) { metrics.CPUPower = last.CPUPower },
+	"throttle":      func(metrics, last *RealTimeMetrics) { metrics.Throttle = last.Throttle },
+	"fans":          func(metrics, last *RealTimeMetrics) { metrics.Fans = last.Fans },
+	"gpu_bandwidth": func(metrics, last *RealTimeMetrics) { metrics.GPUBandwidth = last.GPUBandwidth },
+	"gpu_ecc":       func(metrics, last *RealTimeMetrics) { metrics.GPUECC = last.GPUECC },
+	"npu":           func(metrics, last *RealTimeMetrics) { metrics.NPUInfo = last.NPUInfo },
+	"battery":       func(metrics, last *RealTimeMetrics) { metrics.BatteryInfo = last.BatteryInfo },
+	"power": func(metrics, last *RealTimeMetrics) {
+		metrics.SystemPowerWatts, metrics.PowerInfo = last.SystemPowerWatts, last.PowerInfo
+	},
+}
+
 // GetRealTimeMetrics retrieves real-time system metrics
 func (s *MonitoringService) GetRealTimeMetrics() (*RealTimeMetrics, error) {
+	cycleStart := time.Now()
+
+	// 유휴 중에는 수집 빈도를 낮춤 (입력이 생기면 다음 호출부터 즉시 전체 수집)
+	idleState := s.idleDetector.State()
+	if idleState.Idle {
+		s.mutex.RLock()
+		last := s.lastMetrics
+		s.mutex.RUnlock()
+		if last != nil && cycleStart.Sub(last.Timestamp) < time.Duration(s.config.IdleIntervalSeconds)*time.Second {
+			cached := *last
+			cached.Idle = &idleState
+			return &cached, nil
+		}
+	}
+
+	// 가시성을 보고한 클라이언트가 모두 백그라운드이면 백그라운드 간격 안의 호출에 직전 결과를 반환
+	if s.clientDemand.AllBackground() {
+		s.mutex.RLock()
+		last := s.lastMetrics
+		s.mutex.RUnlock()
+		if last != nil && cycleStart.Sub(last.Timestamp) < s.clientDemand.BackgroundInterval() {
+			cached := *last
+			cached.Idle = &idleState
+			return &cached, nil
+		}
+	}
+
 	metrics := &RealTimeMetrics{
-		Timestamp: time.Now(),
+		CPUTemperature: -1,
+		Idle:           &idleState,
+		Timestamp:      cycleStart,
+	}
+
+	// 수집기별 간격이 설정된 경우 아직 실행할 때가 아닌 수집기와 보고 있는 클라이언트가 없는 수집기는 직전 결과 재사용
+	s.mutex.RLock()
+	last := s.lastMetrics
+	s.mutex.RUnlock()
+	collect := func(name string, collector func(ctx context.Context) error) {
+		if last != nil && (!s.clientDemand.ShouldRun(name) || !s.scheduler.ShouldRun(name)) {
+			if reuse, ok := collectorMetricFields[name]; ok {
+				reuse(metrics, last)
+			}
+			return
+		}
+		s.timeQuery(name, collector)
 	}
 
 	// CPU metrics
 	if s.config.EnableCpuMonitoring {
-		if cpuUsage, err := monitoring.GetCPUUsage(); err == nil {
-			metrics.CPUUsage = cpuUsage
-		}
+		collect("cpu", func(ctx context.Context) error {
+			cpuUsage, usageErr := monitoring.GetCPUUsage(ctx)
+			if usageErr == nil {
+				metrics.CPUUsage = cpuUsage
+			}
 
-		if cpuCoreUsage, err := monitoring.GetCPUCoreUsage(); err == nil {
-			metrics.CPUCoreUsage = cpuCoreUsage
-		}
+			cpuCoreUsage, coreErr := monitoring.GetCPUCoreUsage(ctx)
+			if coreErr == nil {
+				metrics.CPUCoreUsage = cpuCoreUsage
+			}
+
+			loadInfo, loadErr := monitoring.GetLoadInfo(ctx)
+			if loadErr == nil {
+				metrics.Load = loadInfo
+			}
+
+			// 첫 수집은 기준값만 기록하므로 오류로 취급하지 않음
+			if s.config.EnableCPUTimeBreakdown {
+				if cpuTimes, timesErr := monitoring.GetCPUTimeBreakdown(ctx); timesErr == nil {
+					metrics.CPUTimes = cpuTimes
+				}
+			}
+			return errors.Join(usageErr, coreErr, loadErr)
+		})
+
+		collect("cpu_temperature", func(ctx context.Context) error {
+			temperature, err := monitoring.GetCPUTemperature(ctx)
+			if err != nil {
+				return err
+			}
+			metrics.CPUTemperature = temperature
+			return nil
+		})
 	}
 
 	// Memory metrics
 	if s.config.EnableMemoryMonitoring {
-		if memoryUsage, err := monitoring.GetMemoryUsage(); err == nil {
-			metrics.MemoryUsage = memoryUsage
-		}
+		collect("memory", func(ctx context.Context) error {
+			memoryUsage, usageErr := monitoring.GetMemoryUsage(ctx)
+			if usageErr == nil {
+				metrics.MemoryUsage = memoryUsage
+			}
 
-		if memoryDetails, err := monitoring.GetMemoryDetails(); err == nil {
-			metrics.MemoryDetails = memoryDetails
-		}
+			memoryDetails, detailsErr := monitoring.GetMemoryDetails(ctx)
+			if detailsErr == nil {
+				metrics.MemoryDetails = memoryDetails
+			}
+
+			memoryBreakdown, breakdownErr := monitoring.GetMemoryBreakdown(ctx)
+			if breakdownErr == nil {
+				metrics.MemoryBreakdown = memoryBreakdown
+			}
+
+			// 첫 수집(기준값)이나 미지원 플랫폼에서는 페이징 정보 없이 진행
+			if memoryPaging, pagingErr := monitoring.GetMemoryPaging(ctx); pagingErr == nil {
+				metrics.MemoryPaging = memoryPaging
+			}
+			return errors.Join(usageErr, detailsErr, breakdownErr)
+		})
 	}
 
 	// Disk metrics
 	if s.config.EnableDiskMonitoring {
-		if diskUsage, err := monitoring.GetDiskUsage(); err == nil {
-			metrics.DiskUsage = diskUsage
-		}
+		collect("disk", func(ctx context.Context) error {
+			diskUsage, usageErr := monitoring.GetDiskUsage(ctx)
+			if usageErr == nil {
+				metrics.DiskUsage = diskUsage
+			}
 
-		if diskReadSpeed, diskWriteSpeed, err := monitoring.GetDiskIOSpeed(); err == nil {
-			metrics.DiskReadSpeed = diskReadSpeed
-			metrics.DiskWriteSpeed = diskWriteSpeed
-		}
+			diskReadSpeed, diskWriteSpeed, ioErr := monitoring.GetDiskIOSpeed(ctx)
+			if ioErr == nil {
+				metrics.DiskReadSpeed = diskReadSpeed
+				metrics.DiskWriteSpeed = diskWriteSpeed
+			}
+			return errors.Join(usageErr, ioErr)
+		})
+
+		collect("disk_paths", func(ctx context.Context) error {
+			diskPaths, err := s.collectDiskPaths(ctx)
+			metrics.DiskPaths = diskPaths
+			return err
+		})
+
+		collect("disk_temperature", func(ctx context.Context) error {
+			diskTemperatures, err := monitoring.GetDiskTemperatures(ctx)
+			if err != nil {
+				return err
+			}
+			metrics.DiskTemperatures = diskTemperatures
+			return nil
+		})
 	}
 
 	// Network metrics
 	if s.config.EnableNetworkMonitoring {
-		if networkIO, err := monitoring.GetNetworkInterfaces(); err == nil {
-			metrics.NetworkIO = networkIO
-		}
+		collect("network", func(ctx context.Context) error {
+			networkIO, interfacesErr := monitoring.GetNetworkInterfaces(ctx)
+			if interfacesErr == nil {
+				metrics.NetworkIO = networkIO
+			}
 
-		if netSentSpeed, netRecvSpeed, err := monitoring.GetNetworkIOSpeed(); err == nil {
-			metrics.NetSentSpeed = netSentSpeed
-			metrics.NetRecvSpeed = netRecvSpeed
-		}
+			netSentSpeed, netRecvSpeed, ioErr := monitoring.GetNetworkIOSpeed(ctx)
+			if ioErr == nil {
+				metrics.NetSentSpeed = netSentSpeed
+				metrics.NetRecvSpeed = netRecvSpeed
+			}
 
-		if networkStatus, err := monitoring.GetNetworkStatus(); err == nil {
-			metrics.NetworkStatus = networkStatus
-		}
+			networkStatus, statusErr := monitoring.GetNetworkStatus(ctx)
+			if statusErr == nil {
+				metrics.NetworkStatus = networkStatus
+			}
+			return errors.Join(interfacesErr, ioErr, statusErr)
+		})
+
+		collect("network_errors", func(ctx context.Context) error {
+			networkErrors, err := s.collectNetworkErrors()
+			if err != nil {
+				return err
+			}
+			metrics.NetworkErrors = networkErrors
+			return nil
+		})
+
+		collect("wifi", func(ctx context.Context) error {
+			wifi, err := monitoring.GetWiFiInfo(ctx)
+			if err != nil {
+				return err
+			}
+			metrics.WiFi = wifi
+			return nil
+		})
 	}
 
-	// System information
-	if systemUptime, err := monitoring.GetSystemUptime(); err == nil {
-		metrics.SystemUptime = systemUptime
+	// Audio devices and sessions (선택 기능)
+	if s.config.EnableAudioMonitoring {
+		collect("audio", func(ctx context.Context) error {
+			audio, err := monitoring.GetAudioInfo(ctx)
+			if err != nil {
+				return err
+			}
+			metrics.Audio = audio
+			return nil
+		})
 	}
 
-	if bootTime, err := monitoring.GetBootTime(); err == nil {
-		metrics.BootTime = bootTime
+	// Network quality (측정은 별도 주기로 백그라운드에서 수행, 여기서는 최근 결과만 사용)
+	s.mutex.RLock()
+	if s.networkQualityProbe != nil {
+		metrics.NetworkQuality = s.networkQualityProbe.Latest()
 	}
+	if s.frameStatsSource != nil {
+		metrics.FrameStats = s.frameStatsSource.Latest()
+	}
+	s.mutex.RUnlock()
+
+	// System information
+	collect("system", func(ctx context.Context) error {
+		systemUptime, uptimeErr := monitoring.GetSystemUptime(ctx)
+		if uptimeErr == nil {
+			metrics.SystemUptime = systemUptime
+		}
+
+		bootTime, bootErr := monitoring.GetBootTime(ctx)
+		if bootErr == nil {
+			metrics.BootTime = bootTime
+		}
+		return errors.Join(uptimeErr, bootErr)
+	})
 
 	// GPU information
-	if gpuInfo, err := monitoring.GetGPUInfo(); err == nil {
+	collect("gpu_info", func(ctx context.Context) error {
+		gpuInfo, err := monitoring.GetGPUInfo(ctx)
+		if err != nil {
+			return err
+		}
 		metrics.GPUInfo = gpuInfo
-	}
+		return nil
+	})
+
+	// GPU engine utilization (3D, copy, video decode/encode, compute)
+	collect("gpu_engines", func(ctx context.Context) error {
+		gpuEngines, err := monitoring.GetGPUEngineUtilization(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.GPUEngines = gpuEngines
+		return nil
+	})
+
+	// Every hardware GPU adapter (integrated + discrete on hybrid-graphics laptops)
+	collect("gpu_adapters", func(ctx context.Context) error {
+		gpuAdapters, err := monitoring.GetGPUAdapters(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.GPUAdapters = gpuAdapters
+		return nil
+	})
+
+	// RAPL package/core/DRAM power (Linux)
+	collect("rapl", func(ctx context.Context) error {
+		power, err := monitoring.GetRAPLPower(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.CPUPower = power
+		return nil
+	})
+
+	// CPU/GPU thermal and power throttling
+	collect("throttle", func(ctx context.Context) error {
+		throttle, err := s.collectThrottle(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.Throttle = throttle
+		return nil
+	})
+
+	// Chassis/CPU fans (Linux hwmon)
+	collect("fans", func(ctx context.Context) error {
+		fans, err := monitoring.GetFans(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.Fans = fans
+		return nil
+	})
+
+	// NVIDIA memory controller, NVENC/NVDEC and PCIe activity
+	collect("gpu_bandwidth", func(ctx context.Context) error {
+		gpuBandwidth, err := monitoring.GetGPUBandwidth(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.GPUBandwidth = gpuBandwidth
+		return nil
+	})
+
+	// NVIDIA ECC errors and retired/remapped memory pages
+	collect("gpu_ecc", func(ctx context.Context) error {
+		gpuECC, err := s.collectGPUECC(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.GPUECC = gpuECC
+		return nil
+	})
+
+	// NPU / AI accelerator
+	collect("npu", func(ctx context.Context) error {
+		npuInfo, err := monitoring.GetNPUInfo(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.NPUInfo = npuInfo
+		return nil
+	})
 
-	if gpuProcesses, err := monitoring.GetGPUProcesses(); err == nil {
-		metrics.GPUProcesses = gpuProcesses
+	// 보고 있는 세션이 없거나 사용자가 자리를 비웠으면 비용이 큰 스캔을 건너뛰고 직전 결과를 재사용
+	skipScans := s.IsCollectionPaused() || idleState.Idle
+
+	if !skipScans && s.clientDemand.ShouldRun("gpu_processes") && s.scheduler.ShouldRun("gpu_processes") {
+		s.timeQuery("gpu_processes", func(ctx context.Context) error {
+			gpuProcesses, err := monitoring.GetGPUProcesses(ctx)
+			if err != nil {
+				return err
+			}
+			metrics.GPUProcesses = gpuProcesses
+			s.mutex.Lock()
+			s.lastGPUProcesses = gpuProcesses
+			s.mutex.Unlock()
+			return nil
+		})
+	} else {
+		s.mutex.RLock()
+		metrics.GPUProcesses = s.lastGPUProcesses
+		s.mutex.RUnlock()
 	}
 
 	// Top processes
-	if topProcesses, err := monitoring.GetTopProcesses(10); err == nil {
-		metrics.TopProcesses = topProcesses
+	if !skipScans && s.clientDemand.ShouldRun("top_processes") && s.scheduler.ShouldRun("top_processes") {
+		s.timeQuery("top_processes", func(ctx context.Context) error {
+			topProcesses, err := monitoring.GetTopProcesses(ctx, 10)
+			if err != nil {
+				return err
+			}
+			metrics.TopProcesses = topProcesses
+			s.mutex.Lock()
+			s.lastTopProcesses = topProcesses
+			s.mutex.Unlock()
+			return nil
+		})
+	} else {
+		s.mutex.RLock()
+		metrics.TopProcesses = s.lastTopProcesses
+		s.mutex.RUnlock()
 	}
 
 	// Battery information
-	if batteryInfo, err := monitoring.GetBatteryInfo(); err == nil {
+	collect("battery", func(ctx context.Context) error {
+		batteryInfo, err := monitoring.GetBatteryInfo(ctx)
+		if err != nil {
+			return err
+		}
 		metrics.BatteryInfo = batteryInfo
+		return nil
+	})
+
+	// System power estimation
+	metrics.SystemPowerWatts = -1
+	collect("power", func(ctx context.Context) error {
+		powerInfo, err := monitoring.GetSystemPowerInfo(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.SystemPowerWatts = powerInfo.TotalWatts
+		metrics.PowerInfo = powerInfo
+		return nil
+	})
+
+	monitoring.ObserveCollectionCycle(time.Since(cycleStart))
+	metrics.CollectorErrors = monitoring.CollectorErrorMessages(monitoring.GetCollectorStatuses(monitoring.ResolveLanguage("")))
+	s.scheduler.Adjust(monitoring.AverageCollectionCycle())
+
+	s.mutex.Lock()
+	s.lastMetrics = metrics
+	s.mutex.Unlock()
+
+	snapshot := metricsToSnapshot(metrics)
+	if selfTelemetry, err := monitoring.GetSelfTelemetry(); err == nil {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.SelfTelemetryMetrics(selfTelemetry)...)
+	}
+	monitoring.TagMetricUnits(snapshot.Metrics)
+	s.metricBuffer.Add(snapshot)
+
+	s.mutex.RLock()
+	snapshotHandler := s.snapshotHandler
+	s.mutex.RUnlock()
+	if snapshotHandler != nil {
+		snapshotHandler(snapshot)
 	}
 
 	return metrics, nil
 }
 
+// GetSelfTelemetry retrieves HWnow's own resource usage and collector timings
+func (s *MonitoringService) GetSelfTelemetry() (*monitoring.SelfTelemetry, error) {
+	return monitoring.GetSelfTelemetry()
+}
+
+// SetSessionPaused pauses or resumes collection-triggering for a client session
+func (s *MonitoringService) SetSessionPaused(sessionID string, paused bool) CollectionState {
+	if sessionID == "" {
+		sessionID = defaultCollectionSession
+	}
+
+	s.mutex.Lock()
+	if s.sessions[sessionID].paused != paused {
+		monitoring.LogInfo("Collection session state changed", "session", sessionID, "paused", paused)
+	}
+	s.sessions[sessionID] = collectionSession{paused: paused, lastSeen: time.Now()}
+	s.mutex.Unlock()
+
+	return s.GetCollectionState()
+}
+
+// IsCollectionPaused returns true when every known session has paused collection
+func (s *MonitoringService) IsCollectionPaused() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isCollectionPausedLocked(time.Now())
+}
+
+// isCollectionPausedLocked expires idle sessions and reports whether the rest are all paused (caller holds the mutex)
+func (s *MonitoringService) isCollectionPausedLocked(now time.Time) bool {
+	s.expireIdleSessionsLocked(now)
+	if len(s.sessions) == 0 {
+		return false
+	}
+	for _, session := range s.sessions {
+		if !session.paused {
+			return false
+		}
+	}
+	return true
+}
+
+// expireIdleSessionsLocked forgets sessions that have not reported within sessionIdleTimeout (caller holds the mutex)
+func (s *MonitoringService) expireIdleSessionsLocked(now time.Time) {
+	for sessionID, session := range s.sessions {
+		if now.Sub(session.lastSeen) > sessionIdleTimeout {
+			delete(s.sessions, sessionID)
+			monitoring.LogInfo("Collection session expired", "session", sessionID, "paused", session.paused,
+				"idle", now.Sub(session.lastSeen).Round(time.Second).String())
+		}
+	}
+}
+
+// GetCollectionState returns the current per-session pause state
+func (s *MonitoringService) GetCollectionState() CollectionState {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	state := CollectionState{
+		Paused:         s.isCollectionPausedLocked(time.Now()),
+		Sessions:       len(s.sessions),
+		PausedSessions: make([]string, 0),
+	}
+	for sessionID, session := range s.sessions {
+		if session.paused {
+			state.PausedSessions = append(state.PausedSessions, sessionID)
+		}
+	}
+	sort.Strings(state.PausedSessions)
+	return state
+}
+
+// SetSessionVisibility records which widgets a client session shows and whether it is in the foreground
+func (s *MonitoringService) SetSessionVisibility(sessionID string, foreground bool, widgets []string) monitoring.ClientDemandStatus {
+	if sessionID == "" {
+		sessionID = defaultCollectionSession
+	}
+	s.clientDemand.Report(monitoring.ClientVisibility{SessionID: sessionID, Foreground: foreground, Widgets: widgets})
+
+	// 가시성 보고도 세션 활동으로 취급해 일시정지 상태 만료 시간을 연장
+	s.mutex.Lock()
+	if session, known := s.sessions[sessionID]; known {
+		session.lastSeen = time.Now()
+		s.sessions[sessionID] = session
+	}
+	s.mutex.Unlock()
+	return s.clientDemand.Status()
+}
+
+// EndSession forgets a disconnected client session's pause state and visibility report
+func (s *MonitoringService) EndSession(sessionID string) monitoring.ClientDemandStatus {
+	if sessionID == "" {
+		sessionID = defaultCollectionSession
+	}
+
+	s.mutex.Lock()
+	_, paused := s.sessions[sessionID]
+	delete(s.sessions, sessionID)
+	s.mutex.Unlock()
+
+	if s.clientDemand.Remove(sessionID) || paused {
+		monitoring.LogInfo("Collection session ended", "session", sessionID)
+	}
+	return s.clientDemand.Status()
+}
+
+// GetClientDemand returns the reporting clients and the rate of every visibility-driven collector
+func (s *MonitoringService) GetClientDemand() monitoring.ClientDemandStatus {
+	return s.clientDemand.Status()
+}
+
+// SetBackgroundInterval sets the minimum time between collections for clients in the background
+func (s *MonitoringService) SetBackgroundInterval(seconds int) {
+	s.clientDemand.SetBackgroundInterval(time.Duration(seconds) * time.Second)
+}
+
+// GetCollectorSchedule returns the adaptive scheduler budget and current collector intervals
+func (s *MonitoringService) GetCollectorSchedule() monitoring.SchedulerStatus {
+	return s.scheduler.Status()
+}
+
+// GetCollectorHealth reports collectors without a successful run within three of the slowest collection intervals as unhealthy
+func (s *MonitoringService) GetCollectorHealth() []monitoring.SubsystemHealth {
+	staleAfter := 3 * time.Duration(s.config.MaxAdaptiveIntervalSecs) * time.Second
+	if staleAfter < 30*time.Second {
+		staleAfter = 30 * time.Second
+	}
+	return monitoring.GetCollectorHealth(staleAfter)
+}
+
+// GetCollectorStatuses reports the state and last error of every collector, with messages in the given language
+func (s *MonitoringService) GetCollectorStatuses(language string) []monitoring.CollectorStatus {
+	return monitoring.GetCollectorStatuses(language)
+}
+
+// recordHostInfo collects host information and passes it to the snapshot handler as info metrics
+func (s *MonitoringService) recordHostInfo() {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	hostInfo, err := monitoring.GetHostInfo(ctx)
+	if err != nil {
+		return
+	}
+
+	s.mutex.RLock()
+	snapshotHandler := s.snapshotHandler
+	s.mutex.RUnlock()
+	if snapshotHandler != nil {
+		snapshotHandler(&monitoring.ResourceSnapshot{
+			Timestamp: time.Now(),
+			Metrics:   monitoring.HostInfoMetrics(hostInfo),
+		})
+	}
+}
+
+// SetSnapshotHandler sets the callback that receives a resource snapshot for every metrics collection
+func (s *MonitoringService) SetSnapshotHandler(handler func(*monitoring.ResourceSnapshot)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.snapshotHandler = handler
+}
+
+// metricsToTypedSnapshot groups real-time metrics into the typed snapshot schema
+func metricsToTypedSnapshot(metrics *RealTimeMetrics) *monitoring.TypedSnapshot {
+	snapshot := &monitoring.TypedSnapshot{
+		Timestamp: metrics.Timestamp,
+		CPU: monitoring.CPUSnapshot{
+			Usage:       metrics.CPUUsage,
+			Cores:       metrics.CPUCoreUsage,
+			Temperature: metrics.CPUTemperature,
+			Times:       metrics.CPUTimes,
+			Load:        metrics.Load,
+			Power:       metrics.CPUPower,
+		},
+		Memory: monitoring.MemorySnapshot{
+			UsedPercent: metrics.MemoryUsage,
+			Details:     metrics.MemoryDetails,
+			Breakdown:   metrics.MemoryBreakdown,
+			Paging:      metrics.MemoryPaging,
+		},
+		DiskIO: monitoring.DiskIOSnapshot{
+			ReadBytesPerSec:  metrics.DiskReadSpeed,
+			WriteBytesPerSec: metrics.DiskWriteSpeed,
+		},
+		Disks: monitoring.NewDiskSnapshots(metrics.DiskPaths, metrics.DiskTemperatures),
+		NICs:  monitoring.NewNICSnapshots(metrics.NetworkIO, metrics.WiFi),
+		Network: monitoring.NetworkSnapshot{
+			SentBytesPerSec: metrics.NetSentSpeed,
+			RecvBytesPerSec: metrics.NetRecvSpeed,
+			Status:          metrics.NetworkStatus,
+			Quality:         metrics.NetworkQuality,
+		},
+		GPUs:      monitoring.NewGPUSnapshots(metrics.GPUInfo, metrics.GPUAdapters, metrics.GPUEngines, metrics.GPUProcesses),
+		Processes: metrics.TopProcesses,
+		Battery:   metrics.BatteryInfo,
+		Power:     metrics.PowerInfo,
+	}
+
+	// 감시 경로가 없으면 시스템 드라이브 사용량을 디스크 항목으로 사용
+	if len(metrics.DiskPaths) == 0 && metrics.DiskUsage != nil {
+		snapshot.Disks = append([]monitoring.DiskSnapshot{{
+			Path:        "/",
+			Total:       metrics.DiskUsage.Total,
+			Used:        metrics.DiskUsage.Used,
+			Free:        metrics.DiskUsage.Free,
+			UsedPercent: metrics.DiskUsage.UsedPercent,
+			Temperature: -1,
+		}}, snapshot.Disks...)
+	}
+	return snapshot
+}
+
+// metricsToSnapshot converts real-time metrics to a resource snapshot using the legacy metric type names
+func metricsToSnapshot(metrics *RealTimeMetrics) *monitoring.ResourceSnapshot {
+	snapshot := &monitoring.ResourceSnapshot{
+		Timestamp: metrics.Timestamp,
+		Metrics: []monitoring.Metric{
+			{Type: "cpu", Value: metrics.CPUUsage},
+			{Type: "ram", Value: metrics.MemoryUsage},
+			{Type: "disk_read", Value: metrics.DiskReadSpeed},
+			{Type: "disk_write", Value: metrics.DiskWriteSpeed},
+			{Type: "net_sent", Value: metrics.NetSentSpeed},
+			{Type: "net_recv", Value: metrics.NetRecvSpeed},
+		},
+	}
+
+	for i, usage := range metrics.CPUCoreUsage {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: fmt.Sprintf("cpu_core_%d", i+1), Value: usage})
+	}
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.CPUTimeMetrics(metrics.CPUTimes)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.LoadMetrics(metrics.Load)...)
+	if metrics.CPUTemperature > 0 {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: "cpu_temperature", Value: metrics.CPUTemperature})
+	}
+	if metrics.DiskUsage != nil {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: "disk_usage_percent", Value: metrics.DiskUsage.UsedPercent})
+	}
+	for _, diskPath := range metrics.DiskPaths {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: monitoring.DiskPathMetricName(diskPath.Path), Value: diskPath.UsedPercent, Info: diskPath.Path})
+	}
+	for _, diskTemp := range metrics.DiskTemperatures {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: monitoring.DiskTempMetricName(diskTemp.Device), Value: diskTemp.Temperature})
+	}
+	if metrics.GPUInfo != nil {
+		snapshot.Metrics = append(snapshot.Metrics,
+			monitoring.Metric{Type: "gpu_usage", Value: metrics.GPUInfo.Usage},
+			monitoring.Metric{Type: "gpu_memory_used", Value: metrics.GPUInfo.MemoryUsed},
+			monitoring.Metric{Type: "gpu_temperature", Value: metrics.GPUInfo.Temperature},
+			monitoring.Metric{Type: "gpu_power", Value: metrics.GPUInfo.Power},
+		)
+	}
+	for _, engine := range metrics.GPUEngines {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: monitoring.GPUEngineMetricName(engine.Engine), Value: engine.Usage})
+	}
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.GPUAdapterMetrics(metrics.GPUAdapters)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.GPUBandwidthMetrics(metrics.GPUBandwidth)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.GPUECCMetrics(metrics.GPUECC)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.RAPLMetrics(metrics.CPUPower)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.ThrottleMetrics(metrics.Throttle)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.MemoryBreakdownMetrics(metrics.MemoryBreakdown)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.MemoryPagingMetrics(metrics.MemoryPaging)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.NPUMetrics(metrics.NPUInfo)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.NetworkErrorMetrics(metrics.NetworkErrors)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.NetworkQualityMetrics(metrics.NetworkQuality)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.FrameStatsMetrics(metrics.FrameStats)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.WiFiMetrics(metrics.WiFi)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.FanMetrics(metrics.Fans)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.AudioMetrics(metrics.Audio)...)
+	snapshot.Metrics = append(snapshot.Metrics, monitoring.IdleMetrics(metrics.Idle)...)
+	if metrics.BatteryInfo != nil && metrics.BatteryInfo.Percent > 0 {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: "battery_percent", Value: metrics.BatteryInfo.Percent})
+	}
+	if metrics.SystemPowerWatts >= 0 {
+		snapshot.Metrics = append(snapshot.Metrics, monitoring.Metric{Type: "system_power_watts", Value: metrics.SystemPowerWatts})
+	}
+
+	return snapshot
+}
+
 // GetGPUInfo retrieves GPU information
 func (s *MonitoringService) GetGPUInfo() (*monitoring.GPUInfo, error) {
-	return monitoring.GetGPUInfo()
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetGPUInfo(ctx)
+}
+
+// GetGPUStaticInfo retrieves driver, VBIOS, CUDA and PCIe details (collected once at startup)
+func (s *MonitoringService) GetGPUStaticInfo() ([]monitoring.GPUStaticInfo, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetGPUStaticInfo(ctx)
 }
 
 // GetGPUProcesses retrieves GPU processes
 func (s *MonitoringService) GetGPUProcesses() ([]monitoring.GPUProcess, error) {
-	return monitoring.GetGPUProcesses()
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetGPUProcesses(ctx)
 }
 
 // GetGPUProcessesFiltered retrieves filtered GPU processes
 func (s *MonitoringService) GetGPUProcessesFiltered(query monitoring.GPUProcessQuery) (*monitoring.GPUProcessResponse, error) {
-	return monitoring.GetGPUProcessesFiltered(query)
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetGPUProcessesFiltered(ctx, query)
 }
 
 // GetGPUProcessesDelta retrieves GPU process changes
 func (s *MonitoringService) GetGPUProcessesDelta(lastUpdateID string) (*monitoring.GPUProcessDeltaResponse, error) {
-	return monitoring.GetGPUProcessesDelta(lastUpdateID)
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetGPUProcessesDelta(ctx, lastUpdateID)
 }
 
 // GetTopProcesses retrieves top processes
 func (s *MonitoringService) GetTopProcesses(count int) ([]monitoring.ProcessInfo, error) {
-	return monitoring.GetTopProcesses(count)
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetTopProcesses(ctx, count)
+}
+
+// GetTopProcessesWithDetail retrieves top processes, adding command line, executable path and start time for detail "full"
+func (s *MonitoringService) GetTopProcessesWithDetail(count int, detail string) ([]monitoring.ProcessInfo, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	processes, err := monitoring.GetTopProcesses(ctx, count)
+	if err == nil && detail == monitoring.ProcessDetailFull {
+		monitoring.AddProcessIdentities(processes)
+	}
+	return processes, err
+}
+
+// GetGPUProcessesWithDetail retrieves GPU processes, adding command line, executable path, start time and owner for detail "full"
+func (s *MonitoringService) GetGPUProcessesWithDetail(detail string) ([]monitoring.GPUProcess, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	processes, err := monitoring.GetGPUProcesses(ctx)
+	if err == nil && detail == monitoring.ProcessDetailFull {
+		monitoring.AddGPUProcessIdentities(processes)
+	}
+	return processes, err
+}
+
+// GetProcessesFiltered retrieves processes with filtering, sorting and pagination
+func (s *MonitoringService) GetProcessesFiltered(query monitoring.ProcessQuery) (*monitoring.ProcessResponse, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetProcessesFiltered(ctx, query)
+}
+
+// GetProcessProfile retrieves the full profile of one process
+func (s *MonitoringService) GetProcessProfile(pid int32) (*monitoring.ProcessProfile, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetProcessProfile(ctx, pid)
+}
+
+// SearchProcesses fuzzy-matches running processes by name and command line
+func (s *MonitoringService) SearchProcesses(query string, limit int) (*monitoring.ProcessSearchResponse, error) {
+	return monitoring.SearchProcesses(query, limit)
+}
+
+// GetConnectionsFiltered retrieves network connections with filtering, sorting and pagination
+func (s *MonitoringService) GetConnectionsFiltered(query monitoring.ConnectionQuery) (*monitoring.ConnectionResponse, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetConnectionsFiltered(ctx, query)
+}
+
+// GetUserUsage retrieves CPU, memory and GPU usage aggregated by process owner
+func (s *MonitoringService) GetUserUsage() ([]monitoring.UserUsage, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetUserUsage(ctx)
+}
+
+// GetProcessGroups retrieves CPU, memory and GPU usage aggregated by application (process tree)
+func (s *MonitoringService) GetProcessGroups() ([]monitoring.ProcessGroup, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetProcessGroups(ctx)
+}
+
+// GetTopConsumers retrieves processes ranked by CPU or GPU time accumulated today
+func (s *MonitoringService) GetTopConsumers(sortBy string, limit int) (*monitoring.TopConsumersResponse, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetTopConsumers(ctx, sortBy, limit)
+}
+
+// GetWidgetData aggregates the recently collected samples a dashboard widget needs
+func (s *MonitoringService) GetWidgetData(widgetType string, points int, units monitoring.UnitPreferences) (*monitoring.WidgetData, error) {
+	return monitoring.BuildWidgetData(s.metricBuffer, widgetType, points, units)
+}
+
+// GetRecentMetrics returns the in-memory samples of a metric for the last window (without querying SQLite)
+func (s *MonitoringService) GetRecentMetrics(metricType string, window time.Duration, units monitoring.UnitPreferences) *monitoring.RecentMetrics {
+	return s.metricBuffer.Recent(metricType, window, time.Now(), units)
+}
+
+// GetTypedSnapshot returns the most recent collection as a typed snapshot (collects once if nothing was collected yet)
+// includeLegacy adds the flat metric list used by the resource log for older clients
+func (s *MonitoringService) GetTypedSnapshot(includeLegacy bool) (*monitoring.TypedSnapshot, error) {
+	s.mutex.RLock()
+	metrics := s.lastMetrics
+	s.mutex.RUnlock()
+
+	if metrics == nil {
+		var err error
+		if metrics, err = s.GetRealTimeMetrics(); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := metricsToTypedSnapshot(metrics)
+	if includeLegacy {
+		snapshot.Metrics = metricsToSnapshot(metrics).Metrics
+		monitoring.TagMetricUnits(snapshot.Metrics)
+	}
+	return snapshot, nil
 }
 
 // Start starts the monitoring service
@@ -222,9 +1134,362 @@ func (s *MonitoringService) Start() error {
 	// Start background monitoring routines if needed
 	go s.backgroundMonitoring()
 
+	// GPU 정적 정보(드라이버, CUDA 버전 등)는 시작 시 한 번만 수집
+	go monitoring.GetGPUStaticInfo(s.ctx)
+
+	// 호스트 정보(OS 빌드, 가상화, BIOS)는 시작 시 한 번 수집해 정보 메트릭으로 기록
+	go s.recordHostInfo()
+
+	// Start hardware event log watcher (Windows only)
+	if s.hardwareEventHandler != nil {
+		s.hardwareEventWatcher = monitoring.NewHardwareEventWatcher(monitoring.HARDWARE_EVENT_POLL_INTERVAL, s.hardwareEventHandler)
+		if err := s.hardwareEventWatcher.Start(s.ctx); err != nil {
+			monitoring.LogDebug("Hardware event watcher not started", "error", err)
+			s.hardwareEventWatcher = nil
+		}
+	}
+
+	// Start suspend/resume and session lock watcher
+	s.powerEventWatcher = monitoring.NewPowerEventWatcher(s.handlePowerEvent)
+	if err := s.powerEventWatcher.Start(s.ctx); err != nil {
+		monitoring.LogDebug("Power event watcher not started", "error", err)
+		s.powerEventWatcher = nil
+	}
+
+	// Start GPU/disk hot-plug watcher
+	s.deviceEventWatcher = monitoring.NewDeviceEventWatcher(s.handleDeviceEvent)
+	if err := s.deviceEventWatcher.Start(s.ctx); err != nil {
+		monitoring.LogDebug("Device event watcher not started", "error", err)
+		s.deviceEventWatcher = nil
+	}
+
+	// Start per-process GPU usage tracing (Windows only)
+	s.gpuActivityTracer = monitoring.NewGPUActivityTracer()
+	if err := s.gpuActivityTracer.Start(s.ctx); err != nil {
+		monitoring.LogDebug("GPU activity tracer not started", "error", err)
+		s.gpuActivityTracer = nil
+	}
+
+	// Start user idle detection (display state notifications on Windows)
+	if err := s.idleDetector.Start(); err != nil {
+		monitoring.LogDebug("Idle detector not started", "error", err)
+	}
+
+	s.startNetworkQualityProbe()
+	s.startFrameStatsSource()
+	s.startFanController()
+
 	return nil
 }
 
+// ConfigureNetworkQuality applies the network quality probe configuration, restarting the probe if running
+func (s *MonitoringService) ConfigureNetworkQuality(config NetworkQualityConfig) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.networkQualityConfig = nil
+	if config.Enabled {
+		s.networkQualityConfig = &monitoring.NetworkQualityConfig{
+			Target:      config.Target,
+			Method:      config.Method,
+			Port:        config.Port,
+			ProbeCount:  config.ProbeCount,
+			Interval:    time.Duration(config.IntervalSeconds) * time.Second,
+			PublicIPURL: config.PublicIPURL,
+		}
+	}
+
+	if s.networkQualityProbe != nil {
+		s.networkQualityProbe.Stop()
+		s.networkQualityProbe = nil
+	}
+	if s.isRunning {
+		s.startNetworkQualityProbe()
+	}
+}
+
+// startNetworkQualityProbe starts the probe when enabled (caller holds the mutex)
+func (s *MonitoringService) startNetworkQualityProbe() {
+	if s.networkQualityConfig == nil {
+		return
+	}
+	s.networkQualityProbe = monitoring.NewNetworkQualityProbe(*s.networkQualityConfig)
+	s.networkQualityProbe.Start(s.ctx)
+}
+
+// ConfigureFrameStats applies the PresentMon frame source configuration, restarting the source if running
+func (s *MonitoringService) ConfigureFrameStats(config FrameStatsConfig) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.frameStatsConfig = nil
+	if config.Enabled {
+		s.frameStatsConfig = &monitoring.FrameStatsConfig{
+			Command: config.Command,
+			Args:    config.Args,
+		}
+	}
+
+	if s.frameStatsSource != nil {
+		s.frameStatsSource.Stop()
+		s.frameStatsSource = nil
+	}
+	if s.isRunning {
+		s.startFrameStatsSource()
+	}
+}
+
+// startFrameStatsSource starts PresentMon when enabled (caller holds the mutex)
+func (s *MonitoringService) startFrameStatsSource() {
+	if s.frameStatsConfig == nil {
+		return
+	}
+	source := monitoring.NewFrameStatsSource(*s.frameStatsConfig)
+	if err := source.Start(s.ctx); err != nil {
+		monitoring.LogWarn("Frame statistics source not started", "error", err)
+		return
+	}
+	s.frameStatsSource = source
+}
+
+// ConfigureFanControl applies the fan control configuration, restarting the controller if running
+// 재시작 시 이전 컨트롤러가 넘겨받은 팬은 원래 모드로 되돌린 뒤 새 곡선을 적용
+func (s *MonitoringService) ConfigureFanControl(config FanControlConfig) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.fanControlConfig = nil
+	if config.AllowPWMControl {
+		s.fanControlConfig = &config
+	}
+
+	if s.fanController != nil {
+		s.fanController.Stop()
+		s.fanController = nil
+	}
+	if s.isRunning {
+		s.startFanController()
+	}
+}
+
+// startFanController starts applying fan curves when PWM control is allowed (caller holds the mutex)
+func (s *MonitoringService) startFanController() {
+	if s.fanControlConfig == nil {
+		return
+	}
+	s.fanController = monitoring.NewFanController(s.fanControlConfig.Curves)
+	s.fanController.Start(s.ctx)
+}
+
+// GetFans retrieves fan speeds and PWM state
+func (s *MonitoringService) GetFans() ([]monitoring.Fan, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return monitoring.GetFans(ctx)
+}
+
+// SetFanPWM sets a manual duty for a fan without a curve (requires PWM control to be allowed and monitoring running)
+func (s *MonitoringService) SetFanPWM(fanID string, percent float64) (*monitoring.Fan, error) {
+	s.mutex.RLock()
+	controller := s.fanController
+	s.mutex.RUnlock()
+
+	if controller == nil {
+		return nil, fmt.Errorf("fan PWM control is not active")
+	}
+	return controller.SetPWM(fanID, percent)
+}
+
+// handlePowerEvent resets rate counters after resume and forwards the event
+func (s *MonitoringService) handlePowerEvent(event monitoring.PowerEvent) {
+	// 절전 중 누적된 디스크/네트워크 카운터로 첫 샘플이 비정상적으로 튀지 않도록 기준값 재설정
+	if event.Type == monitoring.PowerEventResume {
+		monitoring.ResetIOSpeedCounters()
+	}
+
+	s.mutex.RLock()
+	handler := s.powerEventHandler
+	s.mutex.RUnlock()
+	if handler != nil {
+		handler(event)
+	}
+}
+
+// SetPowerEventHandler sets the callback for suspend/resume and session lock/unlock events
+func (s *MonitoringService) SetPowerEventHandler(handler func(monitoring.PowerEvent)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.powerEventHandler = handler
+}
+
+// handleDeviceEvent forwards a GPU or disk hot-plug event (caches are already invalidated by the watcher)
+func (s *MonitoringService) handleDeviceEvent(event monitoring.DeviceEvent) {
+	s.mutex.RLock()
+	handler := s.deviceEventHandler
+	s.mutex.RUnlock()
+	if handler != nil {
+		handler(event)
+	}
+}
+
+// SetDeviceEventHandler sets the callback for GPU and disk hot-plug events
+func (s *MonitoringService) SetDeviceEventHandler(handler func(monitoring.DeviceEvent)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.deviceEventHandler = handler
+}
+
+// SetDiskPaths replaces the watched paths and their free space thresholds
+func (s *MonitoringService) SetDiskPaths(diskPaths []DiskPathConfig) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.diskPaths = diskPaths
+}
+
+// SetCollectorIntervals applies per-collector sampling intervals in seconds (collectors not listed follow interval_seconds)
+func (s *MonitoringService) SetCollectorIntervals(intervalSecs map[string]int) {
+	intervals := make(map[string]time.Duration, len(intervalSecs))
+	for name, seconds := range intervalSecs {
+		intervals[name] = time.Duration(seconds) * time.Second
+	}
+	s.scheduler.SetCollectorIntervals(intervals)
+}
+
+// SetDiskSpaceHandler sets the callback for paths dropping below (or recovering above) their free space threshold
+func (s *MonitoringService) SetDiskSpaceHandler(handler func(monitoring.DiskSpaceAlert)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.diskSpaceHandler = handler
+}
+
+// collectDiskPaths reads usage for the watched paths and reports threshold crossings
+func (s *MonitoringService) collectDiskPaths(ctx context.Context) ([]monitoring.DiskPathUsage, error) {
+	s.mutex.RLock()
+	diskPaths := s.diskPaths
+	handler := s.diskSpaceHandler
+	s.mutex.RUnlock()
+
+	paths := make([]string, 0, len(diskPaths))
+	thresholds := make([]monitoring.DiskSpaceThreshold, 0, len(diskPaths))
+	for _, diskPath := range diskPaths {
+		paths = append(paths, diskPath.Path)
+		thresholds = append(thresholds, monitoring.DiskSpaceThreshold{
+			Path:           diskPath.Path,
+			MinFreePercent: diskPath.MinFreePercent,
+			MinFreeGB:      diskPath.MinFreeGB,
+		})
+	}
+
+	usages, err := monitoring.GetDiskPathUsage(ctx, paths)
+	if handler != nil {
+		for _, alert := range s.diskSpaceMonitor.Check(usages, thresholds) {
+			handler(alert)
+		}
+	}
+	return usages, err
+}
+
+// SetThrottleEventHandler sets the callback for devices entering or leaving a throttled state
+func (s *MonitoringService) SetThrottleEventHandler(handler func(monitoring.ThrottleEvent)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.throttleHandler = handler
+}
+
+// GetThrottleInfo retrieves CPU/GPU throttle state with accumulated throttled time
+func (s *MonitoringService) GetThrottleInfo() (*monitoring.ThrottleInfo, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return s.collectThrottle(ctx)
+}
+
+// collectThrottle reads the throttle state, accumulates throttled time and reports start/end transitions
+func (s *MonitoringService) collectThrottle(ctx context.Context) (*monitoring.ThrottleInfo, error) {
+	s.mutex.RLock()
+	handler := s.throttleHandler
+	s.mutex.RUnlock()
+
+	info, err := monitoring.GetThrottleInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range s.throttleMonitor.Observe(info, time.Now()) {
+		if handler != nil {
+			handler(event)
+		}
+	}
+	return info, nil
+}
+
+// SetGPUECCHandler sets the callback for increasing GPU ECC error or retired page counters
+func (s *MonitoringService) SetGPUECCHandler(handler func(monitoring.GPUECCAlert)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.gpuECCHandler = handler
+}
+
+// GetGPUECCStatus retrieves ECC error counters and retired/remapped pages of NVIDIA GPUs
+func (s *MonitoringService) GetGPUECCStatus() ([]monitoring.GPUECCStatus, error) {
+	ctx, cancel := s.queryContext()
+	defer cancel()
+	return s.collectGPUECC(ctx)
+}
+
+// collectGPUECC reads ECC counters and reports counters that increased since the last read
+func (s *MonitoringService) collectGPUECC(ctx context.Context) ([]monitoring.GPUECCStatus, error) {
+	s.mutex.RLock()
+	handler := s.gpuECCHandler
+	s.mutex.RUnlock()
+
+	statuses, err := monitoring.GetGPUECCStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range s.gpuECCMonitor.Check(statuses) {
+		if handler != nil {
+			handler(alert)
+		}
+	}
+	return statuses, nil
+}
+
+// SetNetworkErrorHandler sets the callback for network interfaces that start reporting errors or collisions
+func (s *MonitoringService) SetNetworkErrorHandler(handler func(monitoring.NetworkErrorAlert)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.networkErrorHandler = handler
+}
+
+// GetNetworkErrors retrieves error/drop/collision counters and rates of every network interface
+func (s *MonitoringService) GetNetworkErrors() ([]monitoring.NetworkInterfaceErrors, error) {
+	return s.collectNetworkErrors()
+}
+
+// collectNetworkErrors samples interface counters and reports interfaces whose error rate became non-zero
+func (s *MonitoringService) collectNetworkErrors() ([]monitoring.NetworkInterfaceErrors, error) {
+	s.mutex.RLock()
+	handler := s.networkErrorHandler
+	s.mutex.RUnlock()
+
+	interfaces, alerts, err := s.networkErrorMonitor.Sample()
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		if handler != nil {
+			handler(alert)
+		}
+	}
+	return interfaces, nil
+}
+
+// SetHardwareEventHandler sets the callback for newly logged hardware events (takes effect on next Start)
+func (s *MonitoringService) SetHardwareEventHandler(handler func(monitoring.HardwareEvent)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.hardwareEventHandler = handler
+}
+
 // Stop stops the monitoring service
 func (s *MonitoringService) Stop() error {
 	s.mutex.Lock()
@@ -238,6 +1503,43 @@ func (s *MonitoringService) Stop() error {
 		s.cancel()
 	}
 
+	if s.hardwareEventWatcher != nil {
+		s.hardwareEventWatcher.Stop()
+		s.hardwareEventWatcher = nil
+	}
+
+	if s.powerEventWatcher != nil {
+		s.powerEventWatcher.Stop()
+		s.powerEventWatcher = nil
+	}
+
+	if s.gpuActivityTracer != nil {
+		s.gpuActivityTracer.Stop()
+		s.gpuActivityTracer = nil
+	}
+
+	if s.deviceEventWatcher != nil {
+		s.deviceEventWatcher.Stop()
+		s.deviceEventWatcher = nil
+	}
+
+	if s.networkQualityProbe != nil {
+		s.networkQualityProbe.Stop()
+		s.networkQualityProbe = nil
+	}
+
+	if s.frameStatsSource != nil {
+		s.frameStatsSource.Stop()
+		s.frameStatsSource = nil
+	}
+
+	if s.fanController != nil {
+		s.fanController.Stop()
+		s.fanController = nil
+	}
+
+	s.idleDetector.Stop()
+
 	s.isRunning = false
 	return nil
 }
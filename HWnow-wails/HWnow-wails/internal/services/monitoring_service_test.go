@@ -0,0 +1,83 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCollectionSessions(t *testing.T) {
+	newService := func() *MonitoringService {
+		config := getDefaultConfig()
+		return NewMonitoringService(&config.Monitoring)
+	}
+	// age moves a session's last report into the past
+	age := func(s *MonitoringService, sessionID string, idle time.Duration) {
+		s.mutex.Lock()
+		session := s.sessions[sessionID]
+		session.lastSeen = time.Now().Add(-idle)
+		s.sessions[sessionID] = session
+		s.mutex.Unlock()
+	}
+
+	t.Run("Pause_Requires_Every_Session", func(t *testing.T) {
+		s := newService()
+		if s.IsCollectionPaused() {
+			t.Fatal("Expected collection to run without sessions")
+		}
+		s.SetSessionPaused("tab-1", true)
+		state := s.SetSessionPaused("tab-2", false)
+		if state.Paused || state.Sessions != 2 || len(state.PausedSessions) != 1 {
+			t.Errorf("Expected one of two sessions paused, got %+v", state)
+		}
+
+		s.EndSession("tab-2")
+		if state := s.GetCollectionState(); !state.Paused || state.Sessions != 1 {
+			t.Errorf("Expected collection paused once the active session ended, got %+v", state)
+		}
+	})
+
+	t.Run("Idle_Sessions_Expire", func(t *testing.T) {
+		cases := []struct {
+			name           string
+			idle           time.Duration
+			expectSessions int
+			expectPaused   bool
+		}{
+			{"Recent_Session_Kept", sessionIdleTimeout - time.Minute, 2, false},
+			{"Idle_Session_Expired", sessionIdleTimeout + time.Minute, 1, true},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				s := newService()
+				s.SetSessionPaused("dashboard", true)
+				// 연결을 끊고 EndSession 없이 사라진 클라이언트
+				s.SetSessionPaused("closed-tab", false)
+				age(s, "closed-tab", c.idle)
+
+				state := s.GetCollectionState()
+				if state.Sessions != c.expectSessions || state.Paused != c.expectPaused {
+					t.Errorf("Expected %d sessions (paused %v), got %+v", c.expectSessions, c.expectPaused, state)
+				}
+			})
+		}
+	})
+
+	t.Run("Visibility_Report_Keeps_Session", func(t *testing.T) {
+		s := newService()
+		s.SetSessionPaused("dashboard", true)
+		age(s, "dashboard", sessionIdleTimeout-time.Second)
+		s.SetSessionVisibility("dashboard", false, []string{"cpu"})
+		// 일시정지를 보고하지 않은 세션의 가시성 보고는 일시정지 판단에 영향 없음
+		s.SetSessionVisibility("viewer", true, nil)
+
+		s.mutex.RLock()
+		lastSeen := s.sessions["dashboard"].lastSeen
+		s.mutex.RUnlock()
+		if time.Since(lastSeen) > time.Minute {
+			t.Errorf("Expected the visibility report to refresh the session, last seen %v ago", time.Since(lastSeen))
+		}
+		if state := s.GetCollectionState(); !state.Paused || state.Sessions != 1 {
+			t.Errorf("Expected the paused session to be kept, got %+v", state)
+		}
+	})
+}
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sync"
+	"time"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+// Report periods
+const (
+	ReportPeriodDaily  = "daily"
+	ReportPeriodWeekly = "weekly"
+)
+
+// 리포트에 포함되는 지표 (자원 로그의 기존 metric_type 이름)
+var reportMetricTypes = []string{"cpu", "ram", "gpu_temperature"}
+
+const (
+	reportScheduleCheckInterval = time.Minute
+	reportWebhookTimeout        = 10 * time.Second
+)
+
+// Report summarizes stored monitoring data over the last 24 hours or 7 days
+type Report struct {
+	Period         string                      `json:"period"`
+	Since          time.Time                   `json:"since"`
+	Until          time.Time                   `json:"until"`
+	GeneratedAt    time.Time                   `json:"generated_at"`
+	CPU            *db.MetricSummary           `json:"cpu,omitempty"`
+	Memory         *db.MetricSummary           `json:"memory,omitempty"`
+	GPUTemperature *db.MetricSummary           `json:"gpu_temperature,omitempty"`
+	TopProcesses   []monitoring.ProcessCPUTime `json:"top_processes"`
+	AlertCount     int                         `json:"alert_count"`     // 발생한 경고 (디스크 공간 부족, 스로틀링, GPU ECC, 네트워크 오류, 오래된 캐시)
+	HardwareEvents int                         `json:"hardware_events"` // 하드웨어 오류, 장치 연결/해제, 스로틀링 종료
+}
+
+// ReportService generates summary reports and delivers them on a schedule
+type ReportService struct {
+	mutex           sync.Mutex
+	databaseService *DatabaseService
+	config          ReportsConfig
+	cancel          context.CancelFunc
+	lastDelivered   time.Time
+	client          *http.Client
+}
+
+// NewReportService creates a new report service
+func NewReportService(databaseService *DatabaseService, config ReportsConfig) *ReportService {
+	return &ReportService{
+		databaseService: databaseService,
+		config:          config,
+		client:          &http.Client{Timeout: reportWebhookTimeout},
+	}
+}
+
+// ReportPeriodRange returns the time range covered by a report period ending at now
+func ReportPeriodRange(period string, now time.Time) (time.Time, time.Time, error) {
+	switch period {
+	case ReportPeriodDaily, "":
+		return now.Add(-24 * time.Hour), now, nil
+	case ReportPeriodWeekly:
+		return now.Add(-7 * 24 * time.Hour), now, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid report period: %s", period)
+	}
+}
+
+// Generate builds a report for the given period (daily = last 24h, weekly = last 7d)
+func (r *ReportService) Generate(period string) (*Report, error) {
+	if period == "" {
+		period = ReportPeriodDaily
+	}
+	since, until, err := ReportPeriodRange(period, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := r.databaseService.GetResourceSummary(reportMetricTypes, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize resource history: %v", err)
+	}
+
+	report := &Report{
+		Period:       period,
+		Since:        since,
+		Until:        until,
+		GeneratedAt:  time.Now(),
+		TopProcesses: []monitoring.ProcessCPUTime{},
+	}
+	for i := range summaries {
+		summary := summaries[i]
+		switch summary.MetricType {
+		case "cpu":
+			report.CPU = &summary
+		case "ram":
+			report.Memory = &summary
+		case "gpu_temperature":
+			report.GPUTemperature = &summary
+		}
+	}
+
+	if report.AlertCount, err = r.databaseService.CountEvents(db.EventCategoryAlert, since, until); err != nil {
+		monitoring.LogWarn("Failed to count alerts for report", "error", err)
+	}
+	if report.HardwareEvents, err = r.databaseService.CountEvents(db.EventCategoryHardware, since, until); err != nil {
+		monitoring.LogWarn("Failed to count hardware events for report", "error", err)
+	}
+
+	r.mutex.Lock()
+	topCount := r.config.TopCount
+	r.mutex.Unlock()
+	ctx, cancel := newQueryContext(defaultQueryTimeout)
+	defer cancel()
+	if topProcesses, err := monitoring.GetTopProcessesByCPUTime(ctx, topCount); err == nil {
+		report.TopProcesses = topProcesses
+	} else {
+		monitoring.LogWarn("Failed to get top processes for report", "error", err)
+	}
+
+	return report, nil
+}
+
+var reportHTMLTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"fmt1": func(v float64) string { return fmt.Sprintf("%.1f", v) },
+	"date": func(t time.Time) string { return t.Local().Format("2006-01-02 15:04") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>HWnow {{.Period}} report</title></head>
+<body style="font-family: sans-serif">
+<h1>HWnow {{.Period}} report</h1>
+<p>{{date .Since}} &ndash; {{date .Until}}</p>
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>Metric</th><th>Average</th><th>Peak</th><th>Samples</th></tr>
+{{with .CPU}}<tr><td>CPU (%)</td><td>{{fmt1 .Avg}}</td><td>{{fmt1 .Peak}}</td><td>{{.Samples}}</td></tr>{{end}}
+{{with .Memory}}<tr><td>RAM (%)</td><td>{{fmt1 .Avg}}</td><td>{{fmt1 .Peak}}</td><td>{{.Samples}}</td></tr>{{end}}
+{{with .GPUTemperature}}<tr><td>GPU temperature (&deg;C)</td><td>{{fmt1 .Avg}}</td><td>{{fmt1 .Peak}}</td><td>{{.Samples}}</td></tr>{{end}}
+</table>
+<p>Alerts: {{.AlertCount}} &middot; Hardware events: {{.HardwareEvents}}</p>
+<h2>Top processes by CPU time</h2>
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>PID</th><th>Name</th><th>CPU time (s)</th></tr>
+{{range .TopProcesses}}<tr><td>{{.PID}}</td><td>{{.Name}}</td><td>{{fmt1 .CPUSeconds}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// RenderHTML renders a report as a standalone HTML document
+func RenderHTML(report *Report) (string, error) {
+	var buf bytes.Buffer
+	if err := reportHTMLTemplate.Execute(&buf, report); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// UpdateConfig applies a new report schedule configuration
+func (r *ReportService) UpdateConfig(config ReportsConfig) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.config = config
+}
+
+// Start starts the delivery scheduler
+func (r *ReportService) Start() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go r.runScheduler(ctx)
+}
+
+// Stop stops the delivery scheduler
+func (r *ReportService) Stop() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+}
+
+func (r *ReportService) runScheduler(ctx context.Context) {
+	ticker := time.NewTicker(reportScheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.mutex.Lock()
+			config := r.config
+			due := isReportDue(config, r.lastDelivered, now)
+			if due {
+				r.lastDelivered = now
+			}
+			r.mutex.Unlock()
+
+			if due {
+				if err := r.deliver(config); err != nil {
+					monitoring.LogError("Failed to deliver scheduled report", "schedule", config.Schedule, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// isReportDue reports whether a scheduled report should go out at now
+func isReportDue(config ReportsConfig, lastDelivered, now time.Time) bool {
+	if config.Schedule == "" || config.WebhookURL == "" || now.Hour() != config.Hour {
+		return false
+	}
+	if config.Schedule == ReportPeriodWeekly && now.Weekday() != time.Monday {
+		return false
+	}
+	// 같은 날 중복 전송 방지
+	y1, m1, d1 := lastDelivered.Date()
+	y2, m2, d2 := now.Date()
+	return y1 != y2 || m1 != m2 || d1 != d2
+}
+
+// deliver generates the scheduled report and POSTs it to the configured webhook
+func (r *ReportService) deliver(config ReportsConfig) error {
+	report, err := r.Generate(config.Schedule)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.client.Post(config.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	monitoring.LogInfo("Scheduled report delivered", "schedule", config.Schedule, "status", resp.StatusCode)
+	return nil
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+func TestReportEventCounts(t *testing.T) {
+	a := newTestAppService(t)
+	reports := NewReportService(a.databaseService, a.GetConfig().Reports)
+
+	a.handleDiskSpaceAlert(monitoring.DiskSpaceAlert{Path: "C:\\", Low: true, FreeGB: 3, FreePercent: 1.5})
+	a.handleNetworkErrorAlert(monitoring.NetworkErrorAlert{Interface: "Wi-Fi", ErrorsInRate: 2})
+	// 복구/종료 이벤트는 경고 수에 포함되지 않음 (스로틀링 종료는 하드웨어 이벤트)
+	a.handleDiskSpaceAlert(monitoring.DiskSpaceAlert{Path: "C:\\", FreeGB: 30, FreePercent: 15})
+	a.handleThrottleEvent(monitoring.ThrottleEvent{Device: "gpu", Type: monitoring.ThrottleEventEnd, DurationSeconds: 5})
+
+	for _, period := range []string{ReportPeriodDaily, ReportPeriodWeekly} {
+		report, err := reports.Generate(period)
+		if err != nil {
+			t.Fatalf("Generate(%s) failed: %v", period, err)
+		}
+		if report.AlertCount != 2 || report.HardwareEvents != 1 {
+			t.Errorf("%s: expected 2 alerts and 1 hardware event, got %d and %d", period, report.AlertCount, report.HardwareEvents)
+		}
+	}
+}
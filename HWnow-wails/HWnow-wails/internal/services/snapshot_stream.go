@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// --stream-json 모드
+// UI/HTTP 서버 없이 설정된 간격마다 ResourceSnapshot을 JSON 한 줄로 stdout에 출력
+// (telegraf exec/execd 플러그인이나 사용자 파이프라인의 데이터 소스로 사용)
+// stdout은 데이터 전용이므로 로그는 stderr로 출력
+
+// SnapshotStreamLine is one JSON line written in stream mode
+type SnapshotStreamLine struct {
+	Timestamp time.Time          `json:"timestamp"`
+	Metrics   map[string]float64 `json:"metrics"`
+	Info      map[string]string  `json:"info,omitempty"`  // 정보 메트릭의 부가 문자열 (CPU 모델명, 경로 등)
+	Units     map[string]string  `json:"units,omitempty"` // 메트릭별 단위 (단위가 없는 정보 메트릭은 제외)
+}
+
+// NewSnapshotStreamLine converts a resource snapshot to its stream representation in the preferred units
+func NewSnapshotStreamLine(snapshot *monitoring.ResourceSnapshot, units monitoring.UnitPreferences) SnapshotStreamLine {
+	line := SnapshotStreamLine{
+		Timestamp: snapshot.Timestamp.UTC(),
+		Metrics:   make(map[string]float64, len(snapshot.Metrics)),
+	}
+	for _, metric := range snapshot.Metrics {
+		metric = units.NormalizeMetric(metric)
+		line.Metrics[metric.Type] = metric.Value
+		if metric.Unit != monitoring.UnitNone {
+			if line.Units == nil {
+				line.Units = make(map[string]string)
+			}
+			line.Units[metric.Type] = metric.Unit
+		}
+		if metric.Info != "" {
+			if line.Info == nil {
+				line.Info = make(map[string]string)
+			}
+			line.Info[metric.Type] = metric.Info
+		}
+	}
+	return line
+}
+
+// RunSnapshotStream collects metrics at the configured interval and writes each snapshot
+// as a JSON line to out until ctx is cancelled or writing fails (e.g. the reader closed the pipe)
+func RunSnapshotStream(ctx context.Context, configPath string, out io.Writer, logOutput io.Writer) error {
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %v", err)
+	}
+
+	// stdout은 스냅샷 전용
+	if err := initializeLogging(&config.Logging, filepath.Dir(configPath), logOutput); err != nil {
+		monitoring.LogWarn("Failed to initialize logging", "error", err)
+	}
+
+	if err := monitoring.SetGPUProcessNamePatterns(config.Monitoring.GPUProcessInclude, config.Monitoring.GPUProcessExclude); err != nil {
+		monitoring.LogWarn("Failed to apply GPU process name patterns", "error", err)
+	}
+
+	monitoringService := NewMonitoringService(&config.Monitoring)
+	monitoringService.ConfigureNetworkQuality(config.NetworkQuality)
+	monitoringService.ConfigureFrameStats(config.FrameStats)
+	monitoringService.ConfigureFanControl(effectiveFanControl(config))
+
+	var writeMutex sync.Mutex
+	var writeErr error
+	encoder := json.NewEncoder(out)
+	monitoringService.SetSnapshotHandler(func(snapshot *monitoring.ResourceSnapshot) {
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+		if writeErr == nil {
+			writeErr = encoder.Encode(NewSnapshotStreamLine(snapshot, config.Units.Preferences()))
+		}
+	})
+
+	if err := monitoringService.Start(); err != nil {
+		return fmt.Errorf("failed to start monitoring: %v", err)
+	}
+	defer monitoringService.Stop()
+
+	interval := time.Duration(config.Monitoring.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Second
+	}
+	monitoring.LogInfo("Streaming snapshots as JSON lines", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := monitoringService.GetRealTimeMetrics(); err != nil {
+			monitoring.LogWarn("Failed to collect metrics", "error", err)
+		}
+
+		writeMutex.Lock()
+		err := writeErr
+		writeMutex.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to write snapshot: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
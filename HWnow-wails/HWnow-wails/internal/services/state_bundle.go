@@ -0,0 +1,564 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+// 애플리케이션 상태 내보내기/가져오기
+// 대시보드(페이지와 위젯), 감시/보호 프로세스 규칙, 설정을 하나의 zip 아카이브로 묶어 다른 PC로 옮기거나 백업
+// 장치별 설정(server, database, logging.file)은 가져올 때 현재 값을 유지하고, 데이터베이스 접속 문자열은 내보내지 않음
+
+// STATE_BUNDLE_FORMAT_VERSION is the application state archive format written by this build
+const STATE_BUNDLE_FORMAT_VERSION = 1
+
+// MAX_STATE_BUNDLE_BYTES limits the size of an imported archive and of each file in it
+const MAX_STATE_BUNDLE_BYTES = 32 << 20
+
+// Application state parts
+const (
+	StatePartConfig     = "config"
+	StatePartDashboards = "dashboards"
+	StatePartRules      = "rules"
+)
+
+// 아카이브 안의 파일 이름
+const (
+	stateManifestEntry   = "manifest.json"
+	stateConfigEntry     = "config.json"
+	stateDashboardsEntry = "dashboards.json"
+	stateRulesEntry      = "rules.json"
+)
+
+// ErrInvalidStateBundle is returned when an imported archive cannot be read or applied
+var ErrInvalidStateBundle = errors.New("invalid state bundle")
+
+// StateBundleManifest describes an exported application state archive
+type StateBundleManifest struct {
+	FormatVersion      int       `json:"format_version"`
+	WidgetStateVersion int       `json:"widget_state_version"`
+	ExportedAt         time.Time `json:"exported_at"`
+	Hostname           string    `json:"hostname"`
+	Platform           string    `json:"platform"`
+	UserID             string    `json:"user_id"` // 대시보드를 내보낸 사용자
+	Parts              []string  `json:"parts"`
+}
+
+// StateBundlePage is a dashboard page and its widgets in an application state archive
+type StateBundlePage struct {
+	PageID    string           `json:"page_id"`
+	PageName  string           `json:"page_name"`
+	PageOrder int              `json:"page_order"`
+	Widgets   []db.WidgetState `json:"widgets"`
+}
+
+// StateBundleRules holds the stored process rules in an application state archive
+type StateBundleRules struct {
+	WatchedProcesses   []db.WatchedProcess   `json:"watched_processes"`
+	ProtectedProcesses []db.ProtectedProcess `json:"protected_processes"`
+}
+
+// AppStateBundle is the content of an application state archive
+type AppStateBundle struct {
+	Manifest StateBundleManifest `json:"manifest"`
+	Config   *Config             `json:"config,omitempty"`
+	Pages    []StateBundlePage   `json:"pages,omitempty"`
+	Rules    *StateBundleRules   `json:"rules,omitempty"`
+}
+
+// StateImportResult summarizes what an import applied
+type StateImportResult struct {
+	Manifest                StateBundleManifest `json:"manifest"`
+	Parts                   []string            `json:"parts"` // 적용된 부분
+	ConfigApplied           bool                `json:"config_applied"`
+	PagesCreated            int                 `json:"pages_created"`
+	PagesUpdated            int                 `json:"pages_updated"`
+	Widgets                 int                 `json:"widgets"`
+	WatchedProcessesAdded   int                 `json:"watched_processes_added"`
+	ProtectedProcessesAdded int                 `json:"protected_processes_added"`
+	RulesSkipped            int                 `json:"rules_skipped"` // 이미 같은 규칙이 있어 건너뜀
+	Warnings                []string            `json:"warnings,omitempty"`
+}
+
+// WriteArchive writes the bundle as a zip archive
+func (b *AppStateBundle) WriteArchive(w io.Writer) error {
+	archive := zip.NewWriter(w)
+	entries := []struct {
+		name  string
+		value interface{}
+		ok    bool
+	}{
+		{stateManifestEntry, b.Manifest, true},
+		{stateConfigEntry, b.Config, b.Config != nil},
+		{stateDashboardsEntry, b.Pages, b.Pages != nil},
+		{stateRulesEntry, b.Rules, b.Rules != nil},
+	}
+	for _, entry := range entries {
+		if !entry.ok {
+			continue
+		}
+		data, err := json.MarshalIndent(entry.value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %v", entry.name, err)
+		}
+		file, err := archive.CreateHeader(&zip.FileHeader{Name: entry.name, Method: zip.Deflate, Modified: b.Manifest.ExportedAt})
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// ReadStateArchive decodes an application state archive written by WriteArchive
+func ReadStateArchive(data []byte) (*AppStateBundle, error) {
+	if len(data) > MAX_STATE_BUNDLE_BYTES {
+		return nil, fmt.Errorf("%w: archive larger than %d bytes", ErrInvalidStateBundle, MAX_STATE_BUNDLE_BYTES)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStateBundle, err)
+	}
+
+	bundle := &AppStateBundle{}
+	found := make(map[string]bool)
+	for _, file := range archive.File {
+		var target interface{}
+		switch file.Name {
+		case stateManifestEntry:
+			target = &bundle.Manifest
+		case stateConfigEntry:
+			bundle.Config = &Config{}
+			target = bundle.Config
+		case stateDashboardsEntry:
+			target = &bundle.Pages
+		case stateRulesEntry:
+			bundle.Rules = &StateBundleRules{}
+			target = bundle.Rules
+		default:
+			// 이후 버전에서 추가된 파일은 무시
+			continue
+		}
+		if err := readStateArchiveEntry(file, target); err != nil {
+			return nil, err
+		}
+		found[file.Name] = true
+	}
+
+	if !found[stateManifestEntry] {
+		return nil, fmt.Errorf("%w: %s missing", ErrInvalidStateBundle, stateManifestEntry)
+	}
+	if bundle.Manifest.FormatVersion < 1 || bundle.Manifest.FormatVersion > STATE_BUNDLE_FORMAT_VERSION {
+		return nil, fmt.Errorf("%w: unsupported format version %d (this build reads up to %d)",
+			ErrInvalidStateBundle, bundle.Manifest.FormatVersion, STATE_BUNDLE_FORMAT_VERSION)
+	}
+	return bundle, nil
+}
+
+// readStateArchiveEntry decodes one JSON file of an archive, refusing files over the size limit
+func readStateArchiveEntry(file *zip.File, target interface{}) error {
+	if file.UncompressedSize64 > MAX_STATE_BUNDLE_BYTES {
+		return fmt.Errorf("%w: %s larger than %d bytes", ErrInvalidStateBundle, file.Name, MAX_STATE_BUNDLE_BYTES)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidStateBundle, file.Name, err)
+	}
+	defer reader.Close()
+
+	// 헤더의 크기를 신뢰하지 않고 실제로 읽는 양도 제한
+	if err := json.NewDecoder(io.LimitReader(reader, MAX_STATE_BUNDLE_BYTES)).Decode(target); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidStateBundle, file.Name, err)
+	}
+	return nil
+}
+
+// Parts returns the parts present in the bundle
+func (b *AppStateBundle) Parts() []string {
+	parts := make([]string, 0, 3)
+	if b.Config != nil {
+		parts = append(parts, StatePartConfig)
+	}
+	if b.Pages != nil {
+		parts = append(parts, StatePartDashboards)
+	}
+	if b.Rules != nil {
+		parts = append(parts, StatePartRules)
+	}
+	return parts
+}
+
+// ExportAppState collects the configuration, the dashboards of a user and the stored process rules into a bundle
+func (a *AppService) ExportAppState(userID string) (*AppStateBundle, error) {
+	if userID == "" {
+		userID = "global-user"
+	}
+
+	widgets, err := a.databaseService.GetWidgetStates(userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export widgets: %v", err)
+	}
+	pageResult := a.databaseService.GetPages(userID)
+	if !pageResult.Success {
+		return nil, fmt.Errorf("failed to export pages: %s", pageResult.Message)
+	}
+	watched, err := a.databaseService.GetWatchedProcesses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export watched processes: %v", err)
+	}
+	protected, err := a.databaseService.GetProtectedProcesses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export protected processes: %v", err)
+	}
+
+	widgetsByPage := make(map[string][]db.WidgetState, len(widgets.Pages))
+	for _, page := range widgets.Pages {
+		widgetsByPage[page.PageID] = page.Widgets
+	}
+	pages := make([]StateBundlePage, 0, len(pageResult.Pages))
+	for _, page := range pageResult.Pages {
+		pageID, _ := page["pageId"].(string)
+		pageName, _ := page["pageName"].(string)
+		pageOrder, _ := page["pageOrder"].(int)
+		pageWidgets := widgetsByPage[pageID]
+		if pageWidgets == nil {
+			pageWidgets = []db.WidgetState{}
+		}
+		pages = append(pages, StateBundlePage{PageID: pageID, PageName: pageName, PageOrder: pageOrder, Widgets: pageWidgets})
+	}
+
+	// 데이터베이스 접속 문자열에는 계정 정보가 들어 있을 수 있음
+	config := *a.GetConfig()
+	config.Database.DSN = ""
+
+	hostname, _ := os.Hostname()
+	bundle := &AppStateBundle{
+		Manifest: StateBundleManifest{
+			FormatVersion:      STATE_BUNDLE_FORMAT_VERSION,
+			WidgetStateVersion: monitoring.WIDGET_STATE_VERSION,
+			ExportedAt:         time.Now().UTC(),
+			Hostname:           hostname,
+			Platform:           runtime.GOOS,
+			UserID:             userID,
+		},
+		Config: &config,
+		Pages:  pages,
+		Rules:  &StateBundleRules{WatchedProcesses: watched, ProtectedProcesses: protected},
+	}
+	bundle.Manifest.Parts = bundle.Parts()
+
+	monitoring.LogInfo("Exported application state", "userID", userID, "pages", len(pages),
+		"watchedProcesses", len(watched), "protectedProcesses", len(protected))
+	return bundle, nil
+}
+
+// ImportAppState applies the selected parts of a bundle (every part in it when parts is empty).
+// Dashboards replace the widgets of the pages in the bundle, rules are added unless an identical rule exists,
+// and the configuration replaces the current one except for device-specific settings.
+func (a *AppService) ImportAppState(bundle *AppStateBundle, parts []string) (*StateImportResult, error) {
+	if a.IsReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	if bundle == nil {
+		return nil, fmt.Errorf("%w: empty bundle", ErrInvalidStateBundle)
+	}
+
+	selected, warnings, err := selectStateParts(bundle, parts)
+	if err != nil {
+		return nil, err
+	}
+	// 일부만 적용되지 않도록 쓰기 전에 모든 부분을 검증
+	if err := bundle.validate(selected); err != nil {
+		return nil, err
+	}
+
+	result := &StateImportResult{Manifest: bundle.Manifest, Parts: []string{}, Warnings: warnings}
+	for _, part := range selected {
+		var partErr error
+		switch part {
+		case StatePartDashboards:
+			partErr = a.importDashboards(bundle, result)
+		case StatePartRules:
+			partErr = a.importRules(bundle.Rules, result)
+		case StatePartConfig:
+			partErr = a.importConfig(bundle.Config, result)
+		}
+		if partErr != nil {
+			a.recordEvent(db.EventCategoryConfig, "state_import", part, false,
+				fmt.Sprintf("Failed to import %s: %v", part, partErr), "")
+			return nil, fmt.Errorf("failed to import %s: %w", part, partErr)
+		}
+		result.Parts = append(result.Parts, part)
+	}
+
+	details, _ := json.Marshal(result)
+	a.recordEvent(db.EventCategoryConfig, "state_import", bundle.Manifest.Hostname, true,
+		fmt.Sprintf("Imported %s from %s", strings.Join(result.Parts, ", "), bundle.Manifest.Hostname), string(details))
+	monitoring.LogInfo("Imported application state", "from", bundle.Manifest.Hostname, "parts", result.Parts,
+		"widgets", result.Widgets, "warnings", len(result.Warnings))
+	return result, nil
+}
+
+// selectStateParts resolves the requested parts against the parts present in the bundle, in import order
+func selectStateParts(bundle *AppStateBundle, parts []string) ([]string, []string, error) {
+	present := bundle.Parts()
+	if len(parts) == 0 {
+		parts = present
+	}
+
+	var selected, warnings []string
+	for _, part := range parts {
+		part = strings.ToLower(strings.TrimSpace(part))
+		switch part {
+		case StatePartConfig, StatePartDashboards, StatePartRules:
+		default:
+			return nil, nil, fmt.Errorf("%w: unknown part %q", ErrInvalidStateBundle, part)
+		}
+		if !slices.Contains(present, part) {
+			warnings = append(warnings, fmt.Sprintf("%s: not included in the archive", part))
+			continue
+		}
+		if !slices.Contains(selected, part) {
+			selected = append(selected, part)
+		}
+	}
+
+	// 대시보드와 규칙을 먼저 적용하고 설정은 마지막에 적용 (설정 적용 시 서비스가 다시 구성됨)
+	order := map[string]int{StatePartDashboards: 0, StatePartRules: 1, StatePartConfig: 2}
+	sort.Slice(selected, func(i, j int) bool { return order[selected[i]] < order[selected[j]] })
+	return selected, warnings, nil
+}
+
+// validate checks the selected parts of the bundle before anything is written
+func (b *AppStateBundle) validate(parts []string) error {
+	for _, part := range parts {
+		switch part {
+		case StatePartDashboards:
+			seen := make(map[string]bool, len(b.Pages))
+			for _, page := range b.Pages {
+				if strings.TrimSpace(page.PageID) == "" || len(page.PageID) > 100 {
+					return fmt.Errorf("%w: page id must be 1-100 characters", ErrInvalidStateBundle)
+				}
+				if seen[page.PageID] {
+					return fmt.Errorf("%w: duplicate page %s", ErrInvalidStateBundle, page.PageID)
+				}
+				seen[page.PageID] = true
+				if len(page.PageName) > 100 {
+					return fmt.Errorf("%w: page %s name too long (max 100 characters)", ErrInvalidStateBundle, page.PageID)
+				}
+			}
+
+		case StatePartRules:
+			for _, watched := range b.Rules.WatchedProcesses {
+				if strings.TrimSpace(watched.Name) == "" {
+					return fmt.Errorf("%w: watched process name cannot be empty", ErrInvalidStateBundle)
+				}
+				if watched.MaxCPUPercent < 0 || watched.MaxMemoryMB < 0 {
+					return fmt.Errorf("%w: watched process %s has negative resource limits", ErrInvalidStateBundle, watched.Name)
+				}
+			}
+			for _, protected := range b.Rules.ProtectedProcesses {
+				rule := monitoring.ProtectedProcessRule{
+					Name:    strings.TrimSpace(protected.Name),
+					Pattern: strings.TrimSpace(protected.Pattern),
+					Level:   strings.ToLower(strings.TrimSpace(protected.Level)),
+				}
+				if err := rule.Validate(); err != nil {
+					return fmt.Errorf("%w: protected process %s: %v", ErrInvalidStateBundle, protected.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// importDashboards saves the widgets of every page in the bundle, then creates missing pages and renames existing ones
+func (a *AppService) importDashboards(bundle *AppStateBundle, result *StateImportResult) error {
+	if len(bundle.Pages) == 0 {
+		return nil
+	}
+	userID := bundle.Manifest.UserID
+	if userID == "" {
+		userID = "global-user"
+	}
+
+	pages := make([]StateBundlePage, len(bundle.Pages))
+	copy(pages, bundle.Pages)
+	sort.SliceStable(pages, func(i, j int) bool { return pages[i].PageOrder < pages[j].PageOrder })
+
+	widgetPages := make([]db.WidgetPage, 0, len(pages))
+	for _, page := range pages {
+		widgetPages = append(widgetPages, db.WidgetPage{PageID: page.PageID, Widgets: page.Widgets})
+	}
+	// 위젯 상태는 스키마 검증 후 하나의 트랜잭션으로 저장 (아카이브의 위젯별 버전에 맞춰 마이그레이션)
+	saved, err := a.databaseService.SaveWidgetStates(WidgetStateBundle{UserID: userID, Pages: widgetPages})
+	if err != nil {
+		return err
+	}
+	for _, page := range saved.Pages {
+		result.Widgets += len(page.Widgets)
+	}
+	result.Warnings = append(result.Warnings, saved.Warnings...)
+
+	existing := make(map[string]string)
+	pageResult := a.databaseService.GetPages(userID)
+	if !pageResult.Success {
+		return fmt.Errorf("failed to load pages: %s", pageResult.Message)
+	}
+	for _, page := range pageResult.Pages {
+		pageID, _ := page["pageId"].(string)
+		pageName, _ := page["pageName"].(string)
+		existing[pageID] = pageName
+	}
+
+	for _, page := range pages {
+		name := strings.TrimSpace(page.PageName)
+		if name == "" {
+			name = page.PageID
+		}
+		currentName, found := existing[page.PageID]
+		if !found {
+			// 새 페이지는 기존 페이지 뒤에 아카이브 순서대로 추가
+			if created := a.databaseService.CreatePage(userID, page.PageID, name); !created.Success {
+				return fmt.Errorf("failed to create page %s: %s", page.PageID, created.Message)
+			}
+			result.PagesCreated++
+			continue
+		}
+		if currentName != name {
+			if updated := a.databaseService.UpdatePageName(userID, page.PageID, name); !updated.Success {
+				return fmt.Errorf("failed to rename page %s: %s", page.PageID, updated.Message)
+			}
+		}
+		result.PagesUpdated++
+	}
+	return nil
+}
+
+// importRules adds the watched and protected processes of the bundle that are not already stored
+func (a *AppService) importRules(rules *StateBundleRules, result *StateImportResult) error {
+	watched, err := a.databaseService.GetWatchedProcesses()
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules.WatchedProcesses {
+		duplicate := false
+		for _, current := range watched {
+			if strings.EqualFold(current.Name, strings.TrimSpace(rule.Name)) &&
+				current.RestartCommand == strings.TrimSpace(rule.RestartCommand) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			result.RulesSkipped++
+			continue
+		}
+		// ID는 가져오는 쪽 데이터베이스에서 새로 부여
+		rule.ID = 0
+		if _, err := a.SaveWatchedProcess(rule); err != nil {
+			return err
+		}
+		result.WatchedProcessesAdded++
+	}
+
+	protected, err := a.databaseService.GetProtectedProcesses()
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules.ProtectedProcesses {
+		duplicate := false
+		for _, current := range protected {
+			if strings.EqualFold(current.Name, strings.TrimSpace(rule.Name)) &&
+				current.Pattern == strings.TrimSpace(rule.Pattern) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			result.RulesSkipped++
+			continue
+		}
+		rule.ID = 0
+		if _, err := a.SaveProtectedProcess(rule); err != nil {
+			return err
+		}
+		result.ProtectedProcessesAdded++
+	}
+	return nil
+}
+
+// importConfig applies an imported configuration, keeping the device-specific settings of the current one
+func (a *AppService) importConfig(imported *Config, result *StateImportResult) error {
+	config := *imported
+	current := a.GetConfig()
+	if current != nil {
+		config.Server = current.Server
+		config.Database = current.Database
+		config.Logging.File = current.Logging.File
+	}
+	if err := a.UpdateConfig(&config); err != nil {
+		return err
+	}
+	result.ConfigApplied = true
+	return nil
+}
+
+// ExportAppStateFile writes the application state archive of a user to a file
+func (a *AppService) ExportAppStateFile(userID, path string) (*StateBundleManifest, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, fmt.Errorf("export path cannot be empty")
+	}
+	bundle, err := a.ExportAppState(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := bundle.WriteArchive(&buffer); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, buffer.Bytes(), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %v", path, err)
+	}
+
+	a.recordEvent(db.EventCategoryConfig, "state_export", path, true,
+		fmt.Sprintf("Exported application state to %s", path), "")
+	return &bundle.Manifest, nil
+}
+
+// ImportAppStateFile reads an application state archive from a file and applies the selected parts
+func (a *AppService) ImportAppStateFile(path string, parts []string) (*StateImportResult, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > MAX_STATE_BUNDLE_BYTES {
+		return nil, fmt.Errorf("%w: archive larger than %d bytes", ErrInvalidStateBundle, MAX_STATE_BUNDLE_BYTES)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := ReadStateArchive(data)
+	if err != nil {
+		return nil, err
+	}
+	return a.ImportAppState(bundle, parts)
+}
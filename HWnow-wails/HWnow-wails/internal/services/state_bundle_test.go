@@ -0,0 +1,146 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+func TestAppStateBundle(t *testing.T) {
+	const userID = "global-user"
+
+	// newSourceService prepares a service with a dashboard, process rules and a changed configuration
+	newSourceService := func(t *testing.T) *AppService {
+		t.Helper()
+		a := newTestAppService(t)
+		if result := a.CreatePage(userID, "gaming", "Gaming"); !result.Success {
+			t.Fatalf("CreatePage failed: %s", result.Message)
+		}
+		if _, err := a.SaveWidgetStates(WidgetStateBundle{UserID: userID, Pages: []db.WidgetPage{{PageID: "gaming", Widgets: []db.WidgetState{
+			{WidgetID: "gpu-1", WidgetType: "gpu", Layout: `{"lg":{"x":0,"y":0,"w":6,"h":4}}`},
+			{WidgetID: "cpu-1", WidgetType: "cpu"},
+		}}}}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.SaveWatchedProcess(db.WatchedProcess{Name: "game.exe", RestartCommand: "game.exe --safe", MaxCPUPercent: 90, Enabled: true}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.SaveProtectedProcess(db.ProtectedProcess{Name: "Backup agent", Pattern: "backupd*", Level: "high"}); err != nil {
+			t.Fatal(err)
+		}
+		config := *a.GetConfig()
+		config.Notifications.MinSeverity = monitoring.NotificationSeverityCritical
+		config.Database.DSN = "postgres://user:secret@db/hwnow"
+		if err := a.UpdateConfig(&config); err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	exportArchive := func(t *testing.T, a *AppService) []byte {
+		t.Helper()
+		bundle, err := a.ExportAppState(userID)
+		if err != nil {
+			t.Fatalf("ExportAppState failed: %v", err)
+		}
+		var buffer bytes.Buffer
+		if err := bundle.WriteArchive(&buffer); err != nil {
+			t.Fatalf("WriteArchive failed: %v", err)
+		}
+		return buffer.Bytes()
+	}
+
+	t.Run("Archive_Round_Trip", func(t *testing.T) {
+		archive := exportArchive(t, newSourceService(t))
+		bundle, err := ReadStateArchive(archive)
+		if err != nil {
+			t.Fatalf("ReadStateArchive failed: %v", err)
+		}
+		if bundle.Config.Database.DSN != "" {
+			t.Errorf("Expected the database DSN stripped from the export, got %q", bundle.Config.Database.DSN)
+		}
+		if len(bundle.Manifest.Parts) != 3 || bundle.Manifest.WidgetStateVersion != monitoring.WIDGET_STATE_VERSION {
+			t.Errorf("Unexpected manifest: %+v", bundle.Manifest)
+		}
+
+		target := newTestAppService(t)
+		result, err := target.ImportAppState(bundle, nil)
+		if err != nil {
+			t.Fatalf("ImportAppState failed: %v", err)
+		}
+		if !result.ConfigApplied || result.Widgets != 2 || result.WatchedProcessesAdded != 1 ||
+			result.ProtectedProcessesAdded != 1 || result.RulesSkipped != 0 {
+			t.Errorf("Unexpected import result: %+v", result)
+		}
+
+		widgets, err := target.GetWidgetStates(userID, []string{"gaming"})
+		if err != nil || len(widgets.Pages) != 1 || len(widgets.Pages[0].Widgets) != 2 {
+			t.Fatalf("Expected the imported dashboard, got %+v, %v", widgets, err)
+		}
+		if target.GetConfig().Notifications.MinSeverity != monitoring.NotificationSeverityCritical {
+			t.Errorf("Expected the imported notification settings, got %+v", target.GetConfig().Notifications)
+		}
+		watched, _ := target.GetWatchedProcesses()
+		if len(watched) != 1 || watched[0].RestartCommand != "game.exe --safe" {
+			t.Errorf("Unexpected watched processes after import: %+v", watched)
+		}
+	})
+
+	t.Run("Reimport_Skips_Duplicate_Rules", func(t *testing.T) {
+		bundle, err := ReadStateArchive(exportArchive(t, newSourceService(t)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		target := newTestAppService(t)
+		if _, err := target.ImportAppState(bundle, []string{StatePartRules}); err != nil {
+			t.Fatalf("First import failed: %v", err)
+		}
+		result, err := target.ImportAppState(bundle, []string{StatePartRules})
+		if err != nil || result.RulesSkipped != 2 || result.WatchedProcessesAdded != 0 || result.ConfigApplied {
+			t.Errorf("Expected every rule skipped on re-import, got %+v, %v", result, err)
+		}
+		protected, _ := target.GetProtectedProcesses()
+		if len(protected) != 1 {
+			t.Errorf("Expected a single protected process, got %d", len(protected))
+		}
+	})
+
+	t.Run("Rejected_Imports", func(t *testing.T) {
+		bundle, err := ReadStateArchive(exportArchive(t, newSourceService(t)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		readOnly := newTestAppService(t)
+		readOnly.config.Security.ReadOnly = true
+		if _, err := readOnly.ImportAppState(bundle, nil); !errors.Is(err, ErrReadOnlyMode) {
+			t.Errorf("Expected ErrReadOnlyMode, got %v", err)
+		}
+		if _, err := readOnly.SaveWatchedProcess(db.WatchedProcess{Name: "game.exe"}); !errors.Is(err, ErrReadOnlyMode) {
+			t.Errorf("Expected ErrReadOnlyMode from SaveWatchedProcess, got %v", err)
+		}
+
+		target := newTestAppService(t)
+		cases := []struct {
+			name  string
+			parts []string
+			data  []byte
+		}{
+			{"Unknown_Part", []string{"secrets"}, nil},
+			{"Not_An_Archive", nil, []byte("not a zip archive")},
+		}
+		for _, c := range cases {
+			if c.data != nil {
+				if _, err := ReadStateArchive(c.data); !errors.Is(err, ErrInvalidStateBundle) {
+					t.Errorf("%s: expected ErrInvalidStateBundle, got %v", c.name, err)
+				}
+				continue
+			}
+			if _, err := target.ImportAppState(bundle, c.parts); !errors.Is(err, ErrInvalidStateBundle) {
+				t.Errorf("%s: expected ErrInvalidStateBundle, got %v", c.name, err)
+			}
+		}
+	})
+}
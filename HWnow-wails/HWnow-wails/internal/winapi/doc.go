@@ -0,0 +1,12 @@
+// Package winapi wraps the Windows APIs the monitoring code previously reached through
+// child processes: process names (Toolhelp32 snapshot, QueryFullProcessImageName),
+// registry reads, access token queries and UAC elevation. On other platforms every call returns ErrUnsupported.
+package winapi
+
+import "errors"
+
+// ErrUnsupported is returned by every function when not running on Windows
+var ErrUnsupported = errors.New("winapi: not supported on this platform")
+
+// ErrElevationCancelled is returned by ShellExecuteRunAs when the user declines the UAC prompt
+var ErrElevationCancelled = errors.New("winapi: elevation cancelled by the user")
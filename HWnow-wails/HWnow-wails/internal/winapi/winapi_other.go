@@ -0,0 +1,43 @@
+//go:build !windows
+
+package winapi
+
+// ProcessNames returns executable names of all running processes keyed by PID
+func ProcessNames() (map[int32]string, error) {
+	return nil, ErrUnsupported
+}
+
+// ProcessName returns the executable name of a process
+func ProcessName(pid int32) (string, error) {
+	return "", ErrUnsupported
+}
+
+// RegistryString reads a string value from a key such as HKLM\SOFTWARE\...
+func RegistryString(path, name string) (string, error) {
+	return "", ErrUnsupported
+}
+
+// RegistryDWORD reads an integer value from a key such as HKLM\SOFTWARE\...
+func RegistryDWORD(path, name string) (uint64, error) {
+	return 0, ErrUnsupported
+}
+
+// RegistrySubkeyStrings reads a string value from the key and its subkeys up to depth levels
+func RegistrySubkeyStrings(path, name string, depth int) ([]string, error) {
+	return nil, ErrUnsupported
+}
+
+// IsAdmin reports whether the current process token is a member of the Administrators group
+func IsAdmin() (bool, error) {
+	return false, ErrUnsupported
+}
+
+// PrivilegeEnabled reports whether the named privilege (e.g. SeDebugPrivilege) is present and enabled
+func PrivilegeEnabled(name string) (bool, error) {
+	return false, ErrUnsupported
+}
+
+// ShellExecuteRunAs starts a program with the "runas" verb, which shows the UAC prompt
+func ShellExecuteRunAs(file, args, dir string) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,207 @@
+//go:build windows
+
+package winapi
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+// ProcessNames returns executable names of all running processes keyed by PID
+func ProcessNames() (map[int32]string, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return nil, fmt.Errorf("CreateToolhelp32Snapshot failed: %v", err)
+	}
+	defer windows.CloseHandle(snapshot)
+
+	names := make(map[int32]string)
+	var entry windows.ProcessEntry32
+	entry.Size = uint32(unsafe.Sizeof(entry))
+	for err = windows.Process32First(snapshot, &entry); err == nil; err = windows.Process32Next(snapshot, &entry) {
+		names[int32(entry.ProcessID)] = windows.UTF16ToString(entry.ExeFile[:])
+	}
+	if err != windows.ERROR_NO_MORE_FILES {
+		return names, fmt.Errorf("Process32Next failed: %v", err)
+	}
+	return names, nil
+}
+
+// ProcessName returns the executable name of a process
+func ProcessName(pid int32) (string, error) {
+	if name, err := processImageName(pid); err == nil {
+		return name, nil
+	}
+
+	// 보호된 프로세스는 핸들을 열 수 없으므로 스냅샷에서 조회
+	names, err := ProcessNames()
+	if err != nil {
+		return "", err
+	}
+	if name, ok := names[pid]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("process %d not found", pid)
+}
+
+// processImageName reads the image path with QueryFullProcessImageName and returns its base name
+func processImageName(pid int32) (string, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseHandle(handle)
+
+	buffer := make([]uint16, windows.MAX_LONG_PATH)
+	size := uint32(len(buffer))
+	if err := windows.QueryFullProcessImageName(handle, 0, &buffer[0], &size); err != nil {
+		return "", err
+	}
+	return filepath.Base(windows.UTF16ToString(buffer[:size])), nil
+}
+
+// openRegistryKey opens a key given as ROOT\sub\key (HKLM, HKCU, HKCR, HKU or the long names)
+func openRegistryKey(path string, access uint32) (registry.Key, error) {
+	rootName, subPath, _ := strings.Cut(path, `\`)
+	var root registry.Key
+	switch strings.ToUpper(rootName) {
+	case "HKLM", "HKEY_LOCAL_MACHINE":
+		root = registry.LOCAL_MACHINE
+	case "HKCU", "HKEY_CURRENT_USER":
+		root = registry.CURRENT_USER
+	case "HKCR", "HKEY_CLASSES_ROOT":
+		root = registry.CLASSES_ROOT
+	case "HKU", "HKEY_USERS":
+		root = registry.USERS
+	default:
+		return 0, fmt.Errorf("unknown registry root: %s", rootName)
+	}
+	return registry.OpenKey(root, subPath, access)
+}
+
+// RegistryString reads a string value from a key such as HKLM\SOFTWARE\...
+func RegistryString(path, name string) (string, error) {
+	key, err := openRegistryKey(path, registry.QUERY_VALUE)
+	if err != nil {
+		return "", err
+	}
+	defer key.Close()
+
+	value, _, err := key.GetStringValue(name)
+	return value, err
+}
+
+// RegistryDWORD reads an integer value from a key such as HKLM\SOFTWARE\...
+func RegistryDWORD(path, name string) (uint64, error) {
+	key, err := openRegistryKey(path, registry.QUERY_VALUE)
+	if err != nil {
+		return 0, err
+	}
+	defer key.Close()
+
+	value, _, err := key.GetIntegerValue(name)
+	return value, err
+}
+
+// RegistrySubkeyStrings reads a string value from the key and its subkeys up to depth levels
+func RegistrySubkeyStrings(path, name string, depth int) ([]string, error) {
+	key, err := openRegistryKey(path, registry.QUERY_VALUE|registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+
+	var values []string
+	if value, _, err := key.GetStringValue(name); err == nil && value != "" {
+		values = append(values, value)
+	}
+	if depth <= 0 {
+		return values, nil
+	}
+
+	subkeys, err := key.ReadSubKeyNames(-1)
+	if err != nil {
+		return values, nil
+	}
+	for _, subkey := range subkeys {
+		// 권한이 없는 하위 키(예: Properties)는 건너뜀
+		if found, err := RegistrySubkeyStrings(path+`\`+subkey, name, depth-1); err == nil {
+			values = append(values, found...)
+		}
+	}
+	return values, nil
+}
+
+// IsAdmin reports whether the current process token is a member of the Administrators group
+func IsAdmin() (bool, error) {
+	sid, err := windows.CreateWellKnownSid(windows.WinBuiltinAdministratorsSid)
+	if err != nil {
+		return false, err
+	}
+	// 토큰 0 = 현재 스레드의 가장(impersonation) 토큰 또는 프로세스 토큰 (UAC로 제한된 토큰이면 false)
+	return windows.Token(0).IsMember(sid)
+}
+
+// PrivilegeEnabled reports whether the named privilege (e.g. SeDebugPrivilege) is present and enabled
+func PrivilegeEnabled(name string) (bool, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return false, err
+	}
+	var luid windows.LUID
+	if err := windows.LookupPrivilegeValue(nil, namePtr, &luid); err != nil {
+		return false, fmt.Errorf("LookupPrivilegeValue(%s) failed: %v", name, err)
+	}
+
+	token := windows.GetCurrentProcessToken()
+	var size uint32
+	windows.GetTokenInformation(token, windows.TokenPrivileges, nil, 0, &size)
+	if size == 0 {
+		return false, fmt.Errorf("GetTokenInformation returned no privilege data")
+	}
+	buffer := make([]byte, size)
+	if err := windows.GetTokenInformation(token, windows.TokenPrivileges, &buffer[0], size, &size); err != nil {
+		return false, fmt.Errorf("GetTokenInformation failed: %v", err)
+	}
+
+	privileges := (*windows.Tokenprivileges)(unsafe.Pointer(&buffer[0]))
+	for _, privilege := range privileges.AllPrivileges() {
+		if privilege.Luid == luid {
+			return privilege.Attributes&windows.SE_PRIVILEGE_ENABLED != 0, nil
+		}
+	}
+	return false, nil
+}
+
+// ShellExecuteRunAs starts a program with the "runas" verb, which shows the UAC prompt;
+// returns ErrElevationCancelled when the user declines the prompt
+func ShellExecuteRunAs(file, args, dir string) error {
+	verbPtr, err := windows.UTF16PtrFromString("runas")
+	if err != nil {
+		return err
+	}
+	filePtr, err := windows.UTF16PtrFromString(file)
+	if err != nil {
+		return err
+	}
+	argsPtr, err := windows.UTF16PtrFromString(args)
+	if err != nil {
+		return err
+	}
+	dirPtr, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return err
+	}
+	if err := windows.ShellExecute(0, verbPtr, filePtr, argsPtr, dirPtr, windows.SW_SHOWNORMAL); err != nil {
+		if err == windows.ERROR_CANCELLED {
+			return ErrElevationCancelled
+		}
+		return fmt.Errorf("ShellExecute(runas) failed: %v", err)
+	}
+	return nil
+}
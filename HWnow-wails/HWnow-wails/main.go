@@ -1,7 +1,17 @@
 package main
 
 import (
+	"context"
 	"embed"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"HWnow-wails/internal/monitoring"
+	"HWnow-wails/internal/services"
 
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -12,8 +22,42 @@ import (
 var assets embed.FS
 
 func main() {
+	dataDirFlag := flag.String("data-dir", "", "directory for config.json and the database (default: per-user data directory)")
+	portableFlag := flag.Bool("portable", false, "store config.json and the database next to the executable")
+	streamJSONFlag := flag.Bool("stream-json", false, "print each resource snapshot as a JSON line to stdout instead of starting the UI")
+	flag.Parse()
+
+	// 스트림 모드는 stdout을 데이터 전용으로 쓰므로 시작 단계 로그도 stderr로 출력
+	if *streamJSONFlag {
+		monitoring.InitializeLogging(monitoring.LogOptions{Level: monitoring.LogLevelInfo, Console: os.Stderr})
+	}
+
+	// 데이터 디렉터리 결정 후 이전 버전이 작업 디렉터리에 만든 설정/DB를 이동
+	dataDir := "."
+	if info, err := services.ResolveDataDir(*dataDirFlag, *portableFlag); err != nil {
+		monitoring.LogWarn("Failed to resolve data directory, using working directory", "error", err)
+	} else {
+		dataDir = info.Path
+		monitoring.LogInfo("Using data directory", "path", info.Path, "mode", info.Mode)
+		if workingDir, err := os.Getwd(); err == nil {
+			if _, err := services.MigrateLegacyData(workingDir, info); err != nil {
+				monitoring.LogWarn("Failed to migrate legacy data", "error", err)
+			}
+		}
+	}
+
+	if *streamJSONFlag {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		if err := services.RunSnapshotStream(ctx, filepath.Join(dataDir, services.ConfigFileName), os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Create an instance of the app structure
-	app := NewApp()
+	app := NewAppWithDataDir(dataDir)
 
 	// Create application with options
 	err := wails.Run(&options.App{
@@ -22,7 +66,8 @@ func main() {
 		Height:           768,
 		WindowStartState: options.Normal,
 		AssetServer: &assetserver.Options{
-			Assets: assets,
+			Assets:  assets,
+			Handler: app.apiHandler(),
 		},
 		BackgroundColour: &options.RGBA{R: 27, G: 38, B: 54, A: 1},
 		OnStartup:        app.startup,
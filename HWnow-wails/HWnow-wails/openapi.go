@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// OpenAPI 3 document generated from apiRoutes; response bodies differ per handler and are left without a schema
+
+const openAPIVersion = "3.0.3"
+
+// path parameters of an OpenAPI path ({pid}, {type})
+var openAPIPathParameter = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// buildOpenAPISpec converts the route table to an OpenAPI 3 document
+func buildOpenAPISpec(routes []apiRoute) map[string]interface{} {
+	paths := make(map[string]interface{})
+	tagSet := make(map[string]bool)
+
+	for _, route := range routes {
+		path := route.openAPIPath()
+		operations, ok := paths[path].(map[string]interface{})
+		if !ok {
+			operations = make(map[string]interface{})
+			paths[path] = operations
+		}
+		for _, method := range route.Methods {
+			operations[strings.ToLower(method)] = openAPIOperation(route, method, path)
+		}
+		if route.Tag != "" {
+			tagSet[route.Tag] = true
+		}
+	}
+
+	tagNames := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tagNames = append(tagNames, tag)
+	}
+	sort.Strings(tagNames)
+	tags := make([]map[string]interface{}, 0, len(tagNames))
+	for _, tag := range tagNames {
+		tags = append(tags, map[string]interface{}{"name": tag})
+	}
+
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":       "HWnow Desktop API",
+			"version":     "1.0.0",
+			"description": "HTTP endpoints of the HWnow desktop app that are not bound through Wails.",
+		},
+		"tags":  tags,
+		"paths": paths,
+	}
+}
+
+// openAPIPath returns the documented path of the route
+func (r apiRoute) openAPIPath() string {
+	if r.Path != "" {
+		return r.Path
+	}
+	return r.Pattern
+}
+
+// openAPIOperation builds the operation object of one method of a route
+func openAPIOperation(route apiRoute, method, path string) map[string]interface{} {
+	operation := map[string]interface{}{
+		"summary":     route.Summary,
+		"operationId": strings.ToLower(method) + strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ".", "_").Replace(path),
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{"description": "Success"},
+			"400": map[string]interface{}{"description": "Invalid request"},
+			"500": map[string]interface{}{"description": "Internal error"},
+		},
+	}
+	if route.Tag != "" {
+		operation["tags"] = []string{route.Tag}
+	}
+
+	var parameters []map[string]interface{}
+	for _, match := range openAPIPathParameter.FindAllStringSubmatch(path, -1) {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	for _, name := range route.Query {
+		parameters = append(parameters, map[string]interface{}{
+			"name":   name,
+			"in":     "query",
+			"schema": map[string]interface{}{"type": "string"},
+		})
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	return operation
+}
+
+// handleOpenAPI serves GET /api/openapi.json
+func (a *App) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildOpenAPISpec(a.apiRoutes()))
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/monitoring"
+)
+
+// handleUserUsage serves GET /api/users/usage with CPU, memory and GPU totals per process owner
+func (a *App) handleUserUsage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	usage, err := a.GetUserUsage()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(usage)
+}
+
+// handleProcessGroups serves GET /api/processes/groups with CPU, memory and GPU totals per application
+func (a *App) handleProcessGroups(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	groups, err := a.GetProcessGroups()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(groups)
+}
+
+// handleTopConsumers serves GET /api/processes/top-consumers?sort=cpu|gpu&limit=10 (resource time accumulated today)
+func (a *App) handleTopConsumers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	sortBy := r.URL.Query().Get("sort")
+	if sortBy != "" && sortBy != "cpu" && sortBy != "gpu" {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "sort")
+		return
+	}
+	limit := 10
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "limit")
+			return
+		}
+	}
+
+	consumers, err := a.GetTopConsumers(sortBy, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(consumers)
+}
+
+// processDetailParam reads the detail query parameter ("" or "full")
+func processDetailParam(r *http.Request) (string, bool) {
+	detail := r.URL.Query().Get("detail")
+	return detail, detail == monitoring.ProcessDetailBasic || detail == monitoring.ProcessDetailFull
+}
+
+// handleTopProcesses serves GET /api/processes?count=10&detail=full (top processes by CPU; detail=full adds
+// command line, executable path, start time and owner)
+func (a *App) handleTopProcesses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	detail, ok := processDetailParam(r)
+	if !ok {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "detail")
+		return
+	}
+	count := 10
+	if value := r.URL.Query().Get("count"); value != "" {
+		var err error
+		if count, err = strconv.Atoi(value); err != nil || count <= 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "count")
+			return
+		}
+	}
+
+	processes, err := a.GetTopProcessesWithDetail(count, detail)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(processes)
+}
+
+// handleProcessProfile serves GET /api/processes/{pid} (full profile for the process detail pane)
+func (a *App) handleProcessProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	pid, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/processes/"), 10, 32)
+	if err != nil || pid <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	profile, err := a.GetProcessProfile(int32(pid))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, monitoring.ErrProcessNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(profile)
+}
+
+// handleProcessControlPreview serves GET /api/processes/preview?pid=1234&operation=kill|suspend|resume|priority&priority=high
+// (dry run of a process control request)
+func (a *App) handleProcessControlPreview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	pid, err := strconv.ParseInt(query.Get("pid"), 10, 32)
+	if err != nil || pid <= 0 {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "pid")
+		return
+	}
+	operation := query.Get("operation")
+	if operation == "" {
+		writeAPIError(w, r, http.StatusBadRequest, "api.missing_parameter", "operation")
+		return
+	}
+
+	preview, err := a.PreviewGPUProcessControl(int32(pid), operation, query.Get("priority"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(preview)
+}
+
+// handleProcessProtection serves GET /api/processes/protection?limit=50 (protection rules and recent control decisions)
+func (a *App) handleProcessProtection(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "limit")
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(a.GetProcessProtectionAudit(limit))
+}
+
+// handleProtectedProcesses serves GET/POST /api/processes/protection/rules and DELETE ?id=
+// (user-defined protected processes stored in the database)
+func (a *App) handleProtectedProcesses(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		protected, err := a.GetProtectedProcesses()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(protected)
+
+	case http.MethodPost:
+		var protected db.ProtectedProcess
+		if err := json.NewDecoder(r.Body).Decode(&protected); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		saved, err := a.SaveProtectedProcess(protected)
+		if err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, sql.ErrNoRows) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(saved)
+
+	case http.MethodDelete:
+		id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+		if err != nil || id <= 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "id")
+			return
+		}
+		if err := a.RemoveProtectedProcess(id); err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, sql.ErrNoRows) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+	}
+}
+
+// handleProcessSearch serves GET /api/processes/search?q=chro&limit=20 (fuzzy match on name and command line)
+func (a *App) handleProcessSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		writeAPIError(w, r, http.StatusBadRequest, "api.missing_parameter", "q")
+		return
+	}
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "limit")
+			return
+		}
+	}
+
+	results, err := a.SearchProcesses(query, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(results)
+}
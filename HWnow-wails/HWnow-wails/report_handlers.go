@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "HWnow-wails/internal/database"
+	"HWnow-wails/internal/services"
+)
+
+// handleReports serves GET /api/reports?period=daily|weekly&format=json|html
+func (a *App) handleReports(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	report, err := a.GetReport(r.URL.Query().Get("period"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(report)
+	case "html":
+		page, err := services.RenderHTML(report)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(page))
+	default:
+		writeAPIError(w, r, http.StatusBadRequest, "api.unsupported_format")
+	}
+}
+
+// handleEvents serves GET /api/events?category=process&action=kill&target=&since=&until=&limit=50&offset=0
+// since/until: RFC 3339 또는 Unix 초
+func (a *App) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	values := r.URL.Query()
+	query := db.EventQuery{
+		Category: values.Get("category"),
+		Action:   values.Get("action"),
+		Target:   values.Get("target"),
+	}
+	var err error
+	if query.Since, err = parseTimeParameter(values.Get("since")); err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "since")
+		return
+	}
+	if query.Until, err = parseTimeParameter(values.Get("until")); err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "until")
+		return
+	}
+	if value := values.Get("limit"); value != "" {
+		if query.MaxItems, err = strconv.Atoi(value); err != nil || query.MaxItems < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "limit")
+			return
+		}
+	}
+	if value := values.Get("offset"); value != "" {
+		if query.Offset, err = strconv.Atoi(value); err != nil || query.Offset < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "offset")
+			return
+		}
+	}
+
+	result := a.appService.GetEvents(query)
+	w.Header().Set("Content-Type", "application/json")
+	if !result.Success {
+		status := http.StatusInternalServerError
+		if result.ErrorCode == http.StatusBadRequest {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+// parseTimeParameter parses an RFC 3339 time or Unix seconds (empty = zero time)
+func parseTimeParameter(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// handleThermalProfiles serves GET /api/thermals?days=7 (daily temperature profiles for the heatmap)
+func (a *App) handleThermalProfiles(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	days := 7
+	if value := r.URL.Query().Get("days"); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil || days <= 0 || days > services.MAX_THERMAL_PROFILE_DAYS {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "days")
+			return
+		}
+	}
+
+	profiles, err := a.GetThermalProfiles(days)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(profiles)
+}
+
+// handleEnergySummary serves GET /api/energy/summary?window=24h (estimated energy, cost and CO2)
+func (a *App) handleEnergySummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	window := r.URL.Query().Get("window")
+	if window == "" {
+		window = "24h"
+	}
+	if _, ok := services.ENERGY_WINDOWS[window]; !ok {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "window")
+		return
+	}
+
+	summary, err := a.GetEnergySummary(window)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+// handleSnapshotDiff serves GET /api/diff?from=<RFC3339>&to=<RFC3339>&window_minutes=10&threshold_percent=20
+func (a *App) handleSnapshotDiff(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	params := r.URL.Query()
+	var query db.SnapshotDiffQuery
+	var err error
+	if query.From, err = time.Parse(time.RFC3339, params.Get("from")); err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_timestamp", "from")
+		return
+	}
+	if query.To, err = time.Parse(time.RFC3339, params.Get("to")); err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_timestamp", "to")
+		return
+	}
+	if value := params.Get("window_minutes"); value != "" {
+		if query.WindowMinutes, err = strconv.Atoi(value); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "window_minutes")
+			return
+		}
+	}
+	if value := params.Get("threshold_percent"); value != "" {
+		if query.ThresholdPercent, err = strconv.ParseFloat(value, 64); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "threshold_percent")
+			return
+		}
+	}
+
+	result := a.appService.GetSnapshotDiff(query)
+	w.Header().Set("Content-Type", "application/json")
+	if !result.Success {
+		w.WriteHeader(result.ErrorCode)
+	}
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeParameter(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected time.Time
+		invalid  bool
+	}{
+		{value: "", expected: time.Time{}},
+		{value: "1700000000", expected: time.Unix(1700000000, 0)},
+		{value: "2024-05-01T09:30:00+09:00", expected: time.Date(2024, 5, 1, 0, 30, 0, 0, time.UTC)},
+		{value: "yesterday", invalid: true},
+	}
+	for _, c := range cases {
+		got, err := parseTimeParameter(c.value)
+		if c.invalid {
+			if err == nil {
+				t.Errorf("parseTimeParameter(%q) expected an error", c.value)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(c.expected) {
+			t.Errorf("parseTimeParameter(%q) = %v, %v; expected %v", c.value, got, err, c.expected)
+		}
+	}
+}
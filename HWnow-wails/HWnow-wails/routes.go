@@ -0,0 +1,178 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+
+	"HWnow-wails/internal/monitoring"
+)
+
+// readOnlyAllowedRoutes lists the non-GET methods still accepted in read-only mode (security.read_only).
+// 읽기 전용 모드에서는 이 목록에 없는 GET/HEAD/OPTIONS 외 요청을 모두 거부 (새 엔드포인트는 기본적으로 차단)
+var readOnlyAllowedRoutes = map[string][]string{
+	// 보고 있는 위젯에 따라 수집 주기만 조정하며 상태를 바꾸지 않음
+	"/api/clients/visibility": {http.MethodPost, http.MethodDelete},
+}
+
+// apiRoute describes one endpoint of apiHandler. The same table registers the mux and
+// builds the OpenAPI document (openapi.go), so the served routes and the document cannot drift apart
+type apiRoute struct {
+	Pattern string   // http.ServeMux pattern (a trailing slash matches the whole subtree)
+	Path    string   // OpenAPI path when it differs from Pattern (e.g. /api/processes/{pid})
+	Methods []string // Methods the handler accepts
+	Tag     string
+	Summary string
+	Query   []string // Query parameter names
+	Handler http.HandlerFunc
+}
+
+// apiRoutes lists every endpoint served by apiHandler
+func (a *App) apiRoutes() []apiRoute {
+	get := []string{http.MethodGet}
+	post := []string{http.MethodPost}
+
+	return []apiRoute{
+		{Pattern: "/api/reports", Methods: get, Tag: "reports", Summary: "Daily or weekly usage report (JSON or HTML)",
+			Query: []string{"period", "format"}, Handler: a.handleReports},
+		{Pattern: "/api/events", Methods: get, Tag: "reports", Summary: "Recorded events (alerts, process control, hardware)",
+			Query: []string{"category", "action", "target", "since", "until", "limit", "offset"}, Handler: a.handleEvents},
+		{Pattern: "/api/thermals", Methods: get, Tag: "reports", Summary: "Daily temperature profiles for the heatmap",
+			Query: []string{"days"}, Handler: a.handleThermalProfiles},
+		{Pattern: "/api/energy/summary", Methods: get, Tag: "reports", Summary: "Estimated energy, cost and CO2",
+			Query: []string{"window"}, Handler: a.handleEnergySummary},
+		{Pattern: "/api/diff", Methods: get, Tag: "reports", Summary: "Differences between two points in the metric history",
+			Query: []string{"from", "to", "window_minutes", "threshold_percent"}, Handler: a.handleSnapshotDiff},
+
+		{Pattern: "/api/network/speedtest", Methods: post, Tag: "diagnostics", Summary: "Start a network speed test",
+			Handler: a.handleSpeedtest},
+		{Pattern: "/api/disk/scan", Methods: post, Tag: "diagnostics", Summary: "Start a disk usage scan",
+			Handler: a.handleDiskScan},
+		{Pattern: "/api/stress", Methods: []string{http.MethodGet, http.MethodPost, http.MethodDelete}, Tag: "diagnostics",
+			Summary: "List recorded stress test runs, start or stop a stress test", Query: []string{"limit"}, Handler: a.handleStressTest},
+		{Pattern: "/api/stress/compare", Methods: get, Tag: "diagnostics", Summary: "Compare two stress test runs",
+			Query: []string{"before", "after"}, Handler: a.handleStressTestCompare},
+
+		{Pattern: "/api/users/usage", Methods: get, Tag: "processes", Summary: "CPU, memory and GPU totals per process owner",
+			Handler: a.handleUserUsage},
+		{Pattern: "/api/processes", Methods: get, Tag: "processes", Summary: "Top processes by CPU",
+			Query: []string{"count", "detail"}, Handler: a.handleTopProcesses},
+		{Pattern: "/api/processes/", Path: "/api/processes/{pid}", Methods: get, Tag: "processes", Summary: "Full profile of a process",
+			Handler: a.handleProcessProfile},
+		{Pattern: "/api/processes/top-consumers", Methods: get, Tag: "processes", Summary: "Resource time accumulated today per process",
+			Query: []string{"sort", "limit"}, Handler: a.handleTopConsumers},
+		{Pattern: "/api/processes/search", Methods: get, Tag: "processes", Summary: "Fuzzy search on process name and command line",
+			Query: []string{"q", "limit"}, Handler: a.handleProcessSearch},
+		{Pattern: "/api/processes/groups", Methods: get, Tag: "processes", Summary: "CPU, memory and GPU totals per application",
+			Handler: a.handleProcessGroups},
+		{Pattern: "/api/processes/preview", Methods: get, Tag: "processes", Summary: "Dry run of a process control request",
+			Query: []string{"pid", "operation", "priority"}, Handler: a.handleProcessControlPreview},
+		{Pattern: "/api/processes/protection", Methods: get, Tag: "processes", Summary: "Protection rules and recent control decisions",
+			Query: []string{"limit"}, Handler: a.handleProcessProtection},
+		{Pattern: "/api/processes/protection/rules", Methods: []string{http.MethodGet, http.MethodPost, http.MethodDelete}, Tag: "processes",
+			Summary: "User-defined protected processes", Query: []string{"id"}, Handler: a.handleProtectedProcesses},
+
+		{Pattern: "/api/gpu/processes", Methods: get, Tag: "gpu", Summary: "Processes using the GPU",
+			Query: []string{"detail", "refresh"}, Handler: a.handleGPUProcesses},
+		{Pattern: "/api/gpu/info", Methods: get, Tag: "gpu", Summary: "Usage, memory, temperature and power of the GPU",
+			Query: []string{"refresh"}, Handler: a.handleGPUInfo},
+		{Pattern: "/api/gpu/ecc", Methods: get, Tag: "gpu", Summary: "ECC error counts and retired/remapped pages per NVIDIA GPU",
+			Handler: a.handleGPUECC},
+		{Pattern: "/api/gpu/redetect", Methods: post, Tag: "gpu", Summary: "Detect the GPU vendor again",
+			Handler: a.handleGPURedetect},
+
+		{Pattern: "/api/devices", Methods: get, Tag: "hardware", Summary: "USB devices, printers and recent connect/disconnect events",
+			Handler: a.handleDeviceInventory},
+		{Pattern: "/api/throttle", Methods: get, Tag: "hardware", Summary: "CPU/GPU throttle state, per-core clocks and throttled time",
+			Handler: a.handleThrottle},
+		{Pattern: "/api/network/errors", Methods: get, Tag: "hardware", Summary: "Error, drop and collision counters per interface",
+			Handler: a.handleNetworkErrors},
+		{Pattern: "/api/fans", Methods: []string{http.MethodGet, http.MethodPost}, Tag: "hardware", Summary: "Fan speeds and PWM state, or set a fan duty",
+			Handler: a.handleFans},
+		{Pattern: "/api/fans/curves", Methods: []string{http.MethodGet, http.MethodPut}, Tag: "hardware", Summary: "Fan curves",
+			Handler: a.handleFanCurves},
+
+		{Pattern: "/api/snapshot", Methods: get, Tag: "widgets", Summary: "Latest typed snapshot",
+			Query: []string{"legacy"}, Handler: a.handleSnapshot},
+		{Pattern: "/api/widgets/", Path: "/api/widgets/{type}/data", Methods: get, Tag: "widgets",
+			Summary: "Current value, sparkline and secondary values of a widget", Query: []string{"points"}, Handler: a.handleWidgetData},
+		{Pattern: "/api/widgets/state", Methods: []string{http.MethodGet, http.MethodPut, http.MethodPost}, Tag: "widgets",
+			Summary: "Widget state bundle of the dashboard pages", Query: []string{"user", "page"}, Handler: a.handleWidgetStates},
+		{Pattern: "/api/widgets/schema", Methods: get, Tag: "widgets", Summary: "Widget configuration schemas",
+			Handler: a.handleWidgetSchemas},
+		{Pattern: "/api/metrics/recent", Methods: get, Tag: "widgets", Summary: "Recent samples from the in-memory buffer",
+			Query: []string{"metric", "seconds"}, Handler: a.handleRecentMetrics},
+		{Pattern: "/api/clients/visibility", Methods: []string{http.MethodGet, http.MethodPost, http.MethodDelete}, Tag: "widgets",
+			Summary: "Widgets visible in each client session", Query: []string{"session"}, Handler: a.handleClientVisibility},
+
+		{Pattern: "/api/state/export", Methods: get, Tag: "state", Summary: "Zip archive of dashboards, process rules and configuration",
+			Query: []string{"user"}, Handler: a.handleStateExport},
+		{Pattern: "/api/state/import", Methods: post, Tag: "state", Summary: "Import an archive from /api/state/export",
+			Query: []string{"part"}, Handler: a.handleStateImport},
+
+		{Pattern: "/api/notifications", Methods: get, Tag: "notifications", Summary: "Desktop notification settings",
+			Handler: a.handleNotifications},
+		{Pattern: "/api/notifications/test", Methods: post, Tag: "notifications", Summary: "Show a test notification",
+			Handler: a.handleTestNotification},
+
+		{Pattern: "/api/status", Methods: get, Tag: "system", Summary: "State and last error of every collector",
+			Handler: a.handleStatus},
+		{Pattern: "/api/security", Methods: get, Tag: "system", Summary: "UAC/sudo state, privileges and recommendations",
+			Handler: a.handleSecurityContext},
+		{Pattern: "/api/security/elevate", Methods: post, Tag: "system", Summary: "Relaunch as administrator",
+			Handler: a.handleRequestElevation},
+		{Pattern: "/healthz", Methods: []string{http.MethodGet, http.MethodHead}, Tag: "system", Summary: "Liveness of the database and collectors",
+			Handler: a.handleHealthz},
+		{Pattern: "/readyz", Methods: []string{http.MethodGet, http.MethodHead}, Tag: "system", Summary: "Readiness (database connected and metrics collected)",
+			Handler: a.handleReadyz},
+
+		{Pattern: "/api/openapi.json", Methods: get, Tag: "docs", Summary: "OpenAPI 3 document for this API",
+			Handler: a.handleOpenAPI},
+	}
+}
+
+// apiHandler serves HTTP endpoints that are not bound through Wails
+// (the asset server falls back to it for paths not found in the embedded assets)
+func (a *App) apiHandler() http.Handler {
+	mux := http.NewServeMux()
+	for _, route := range a.apiRoutes() {
+		mux.HandleFunc(route.Pattern, route.Handler)
+	}
+	return a.readOnlyGuard(mux)
+}
+
+// readOnlyGuard rejects non-GET requests with 403 in read-only mode and advertises the mode in X-Read-Only
+func (a *App) readOnlyGuard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.appService.IsReadOnly() {
+			w.Header().Set("X-Read-Only", "true")
+			if !readOnlyAllowed(r) {
+				writeAPIError(w, r, http.StatusForbidden, "api.read_only")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// readOnlyAllowed reports whether the request may run in read-only mode
+func readOnlyAllowed(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return slices.Contains(readOnlyAllowedRoutes[r.URL.Path], r.Method)
+}
+
+// requestLanguage picks the message language of a request (ui.language in the configuration, then Accept-Language)
+func requestLanguage(r *http.Request) string {
+	return monitoring.ResolveLanguage(r.Header.Get("Accept-Language"))
+}
+
+// writeAPIError writes a catalogued error message in the request language;
+// the message key is sent in X-Message-Key so clients can translate it themselves
+func writeAPIError(w http.ResponseWriter, r *http.Request, status int, key string, params ...string) {
+	language := requestLanguage(r)
+	w.Header().Set("X-Message-Key", key)
+	w.Header().Set("Content-Language", language)
+	http.Error(w, monitoring.Translate(language, key, params...), status)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyAllowed(t *testing.T) {
+	cases := []struct {
+		method  string
+		path    string
+		allowed bool
+	}{
+		{http.MethodGet, "/api/fans", true},
+		{http.MethodHead, "/api/status", true},
+		{http.MethodOptions, "/api/stress", true},
+		{http.MethodPost, "/api/clients/visibility", true},
+		{http.MethodDelete, "/api/clients/visibility", true},
+		{http.MethodPost, "/api/gpu/redetect", false},
+		{http.MethodPost, "/api/security/elevate", false},
+		{http.MethodPost, "/api/network/speedtest", false},
+		{http.MethodPost, "/api/disk/scan", false},
+		{http.MethodPost, "/api/notifications/test", false},
+		{http.MethodPut, "/api/widgets/state", false},
+		{http.MethodPost, "/api/state/import", false},
+		{http.MethodPatch, "/api/not-registered-yet", false},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest(c.method, c.path, nil)
+		if got := readOnlyAllowed(r); got != c.allowed {
+			t.Errorf("readOnlyAllowed(%s %s) = %v; expected %v", c.method, c.path, got, c.allowed)
+		}
+	}
+}
+
+// 문서에 적힌 경로가 실제로 같은 핸들러 패턴으로 라우팅되고, 모든 메서드가 OpenAPI 문서에 있어야 함
+func TestOpenAPIRoutes(t *testing.T) {
+	app := NewAppWithDataDir(t.TempDir())
+	routes := app.apiRoutes()
+	paths := buildOpenAPISpec(routes)["paths"].(map[string]interface{})
+
+	mux := http.NewServeMux()
+	for _, route := range routes {
+		mux.HandleFunc(route.Pattern, route.Handler)
+	}
+	pathValues := strings.NewReplacer("{pid}", "1234", "{type}", "cpu")
+
+	for _, route := range routes {
+		path := route.openAPIPath()
+		if len(route.Methods) == 0 {
+			t.Errorf("%s: expected at least one method", path)
+		}
+		for _, method := range route.Methods {
+			operations, ok := paths[path].(map[string]interface{})
+			if !ok || operations[strings.ToLower(method)] == nil {
+				t.Errorf("Expected %s %s in the OpenAPI document", method, path)
+			}
+			r := httptest.NewRequest(method, pathValues.Replace(path), nil)
+			if _, pattern := mux.Handler(r); pattern != route.Pattern {
+				t.Errorf("%s %s: expected to be served by %q, got %q", method, path, route.Pattern, pattern)
+			}
+		}
+	}
+
+	// 보고서, 이벤트, 상태 점검처럼 외부 도구가 호출하는 엔드포인트
+	for _, path := range []string{"/api/reports", "/api/events", "/api/clients/visibility", "/healthz", "/readyz"} {
+		if _, ok := paths[path]; !ok {
+			t.Errorf("Expected %s in the OpenAPI document", path)
+		}
+	}
+	// 루프백 프로브 리스너도 같은 경로를 제공
+	probe := app.healthHandler().(*http.ServeMux)
+	for _, path := range []string{"/healthz", "/readyz"} {
+		if _, pattern := probe.Handler(httptest.NewRequest(http.MethodGet, path, nil)); pattern != path {
+			t.Errorf("Expected the health listener to serve %s, got %q", path, pattern)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"HWnow-wails/internal/monitoring"
+	"HWnow-wails/internal/services"
+)
+
+// handleStateExport serves GET /api/state/export?user=<id> as a zip archive of the dashboards, process rules and configuration
+func (a *App) handleStateExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	bundle, err := a.appService.ExportAppState(r.URL.Query().Get("user"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// 헤더를 보내기 전에 아카이브를 완성해야 실패 시 오류 응답을 보낼 수 있음
+	var archive bytes.Buffer
+	if err := bundle.WriteArchive(&archive); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	filename := fmt.Sprintf("hwnow-state-%s.zip", bundle.Manifest.ExportedAt.Local().Format("20060102-150405"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(archive.Len()))
+	w.Write(archive.Bytes())
+}
+
+// handleStateImport serves POST /api/state/import?part=config&part=dashboards&part=rules (every part when none is given)
+// with an archive from /api/state/export as the request body
+func (a *App) handleStateImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, services.MAX_STATE_BUNDLE_BYTES))
+	if err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+		return
+	}
+	bundle, err := services.ReadStateArchive(data)
+	if err != nil {
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_state_bundle", err.Error())
+		return
+	}
+
+	result, err := a.appService.ImportAppState(bundle, r.URL.Query()["part"])
+	switch {
+	case errors.Is(err, services.ErrInvalidStateBundle):
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_state_bundle", err.Error())
+		return
+	case errors.Is(err, monitoring.ErrInvalidWidgetState):
+		writeAPIError(w, r, http.StatusBadRequest, "api.invalid_widget_state", err.Error())
+		return
+	case errors.Is(err, services.ErrReadOnlyMode):
+		writeAPIError(w, r, http.StatusForbidden, "api.read_only")
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"HWnow-wails/internal/monitoring"
+	"HWnow-wails/internal/services"
+)
+
+// handleSnapshot serves GET /api/snapshot?legacy=1 (typed snapshot, legacy adds the flat metric list)
+func (a *App) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	includeLegacy := false
+	if value := r.URL.Query().Get("legacy"); value != "" {
+		var err error
+		if includeLegacy, err = strconv.ParseBool(value); err != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "legacy")
+			return
+		}
+	}
+
+	snapshot, err := a.GetSnapshot(includeLegacy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(snapshot)
+}
+
+// handleWidgetData serves GET /api/widgets/{type}/data?points=60 (current value, sparkline and secondary values)
+func (a *App) handleWidgetData(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	widgetType, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/api/widgets/"), "/data")
+	if !ok || widgetType == "" || strings.Contains(widgetType, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	points := 0
+	if value := r.URL.Query().Get("points"); value != "" {
+		var err error
+		if points, err = strconv.Atoi(value); err != nil || points < 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "points")
+			return
+		}
+	}
+
+	data, err := a.GetWidgetData(widgetType, points)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, monitoring.ErrUnsupportedWidget) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+}
+
+// handleRecentMetrics serves GET /api/metrics/recent?metric=cpu&seconds=120 from the in-memory buffer
+func (a *App) handleRecentMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	metricType := r.URL.Query().Get("metric")
+	if metricType == "" {
+		writeAPIError(w, r, http.StatusBadRequest, "api.missing_parameter", "metric")
+		return
+	}
+	seconds := 120
+	if value := r.URL.Query().Get("seconds"); value != "" {
+		var err error
+		if seconds, err = strconv.Atoi(value); err != nil || seconds <= 0 {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "seconds")
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(a.GetRecentMetrics(metricType, seconds))
+}
+
+// 위젯 상태 일괄 저장 요청 본문 상한
+const maxWidgetStateBodyBytes = 8 << 20
+
+// handleWidgetStates serves GET /api/widgets/state?user=<id>&page=<id>... (every page when no page is given)
+// and PUT/POST with a widget state bundle replacing the widgets of the pages it contains
+func (a *App) handleWidgetStates(w http.ResponseWriter, r *http.Request) {
+	var bundle *services.WidgetStateBundle
+	var err error
+
+	switch r.Method {
+	case http.MethodGet:
+		userID := r.URL.Query().Get("user")
+		if userID == "" {
+			writeAPIError(w, r, http.StatusBadRequest, "api.missing_parameter", "user")
+			return
+		}
+		bundle, err = a.GetWidgetStates(userID, r.URL.Query()["page"])
+
+	case http.MethodPut, http.MethodPost:
+		var request services.WidgetStateBundle
+		if decodeErr := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWidgetStateBodyBytes)).Decode(&request); decodeErr != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		bundle, err = a.SaveWidgetStates(request)
+		if errors.Is(err, monitoring.ErrInvalidWidgetState) {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_widget_state", err.Error())
+			return
+		}
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(bundle)
+}
+
+// handleWidgetSchemas serves GET /api/widgets/schema
+func (a *App) handleWidgetSchemas(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	schemas, err := a.GetWidgetSchemas()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(schemas)
+}
+
+// handleClientVisibility serves GET, POST and DELETE /api/clients/visibility?session=<id>
+// POST body: {"session_id": "tab-1", "foreground": true, "widgets": ["cpu", "gpu_process"]}
+func (a *App) handleClientVisibility(w http.ResponseWriter, r *http.Request) {
+	var status *monitoring.ClientDemandStatus
+	var err error
+
+	switch r.Method {
+	case http.MethodGet:
+		status, err = a.GetClientDemand()
+
+	case http.MethodPost:
+		var visibility monitoring.ClientVisibility
+		if decodeErr := json.NewDecoder(r.Body).Decode(&visibility); decodeErr != nil {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_request_body")
+			return
+		}
+		status, err = a.ReportVisibility(visibility.SessionID, visibility.Foreground, visibility.Widgets)
+
+	case http.MethodDelete:
+		sessionID := r.URL.Query().Get("session")
+		if sessionID == "" {
+			writeAPIError(w, r, http.StatusBadRequest, "api.invalid_parameter", "session")
+			return
+		}
+		status, err = a.EndSession(sessionID)
+
+	default:
+		writeAPIError(w, r, http.StatusMethodNotAllowed, "api.method_not_allowed")
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"bufio"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// 원격 모니터링 링크의 대역폭을 줄이기 위한 응답 압축
+// - /api 경로의 JSON/텍스트 응답을 gzip으로 압축 (Accept-Encoding: gzip 요청만, MinSizeBytes 미만은 그대로 전송)
+// - WebSocket(/ws)은 Hijack이 필요하므로 이 미들웨어를 거치지 않고 permessage-deflate로 압축 (websockets.ServerOptions)
+
+// CompressionConfig는 HTTP/WebSocket 응답 압축 설정입니다.
+type CompressionConfig struct {
+	Enabled      bool `json:"enabled"`        // /api JSON 응답 gzip 압축
+	WebSocket    bool `json:"websocket"`      // WebSocket permessage-deflate
+	Level        int  `json:"level"`          // 압축 수준 (-1 = 기본값, 1 = 가장 빠름 ~ 9 = 가장 작음)
+	MinSizeBytes int  `json:"min_size_bytes"` // 이보다 작은 응답은 압축하지 않음
+}
+
+// DefaultCompressionConfig는 기본 압축 설정을 반환합니다.
+func DefaultCompressionConfig() CompressionConfig {
+	return CompressionConfig{
+		Enabled:      true,
+		WebSocket:    true,
+		Level:        gzip.DefaultCompression,
+		MinSizeBytes: 1024,
+	}
+}
+
+// CompressionMiddleware는 /api 응답을 gzip으로 압축합니다.
+type CompressionMiddleware struct {
+	config CompressionConfig
+}
+
+// NewCompressionMiddleware는 설정으로 미들웨어를 생성합니다. 잘못된 압축 수준은 기본값으로 바꿉니다.
+func NewCompressionMiddleware(config CompressionConfig) *CompressionMiddleware {
+	if config.Level < gzip.HuffmanOnly || config.Level > gzip.BestCompression {
+		config.Level = gzip.DefaultCompression
+	}
+	if config.MinSizeBytes < 0 {
+		config.MinSizeBytes = 0
+	}
+	return &CompressionMiddleware{config: config}
+}
+
+// Wrap은 next의 /api 응답을 압축하는 핸들러를 반환합니다.
+func (m *CompressionMiddleware) Wrap(next http.Handler) http.Handler {
+	if !m.config.Enabled {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/") || !acceptsGzip(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		gw := &gzipResponseWriter{ResponseWriter: w, config: m.config, status: http.StatusOK}
+		defer gw.Close()
+		next.ServeHTTP(gw, r)
+	})
+}
+
+// acceptsGzip은 요청이 gzip 응답을 받을 수 있는지 반환합니다.
+func acceptsGzip(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.EqualFold(strings.TrimSpace(encoding), "gzip") {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
+
+// compressibleContentType은 압축 효과가 있는 응답 형식인지 반환합니다.
+func compressibleContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	return mediaType == "application/json" || strings.HasPrefix(mediaType, "text/")
+}
+
+// gzipResponseWriter는 MinSizeBytes까지 응답을 모은 뒤 압축 여부를 결정합니다.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	config   CompressionConfig
+	status   int
+	buffer   []byte
+	decided  bool
+	gzWriter *gzip.Writer
+}
+
+func (w *gzipResponseWriter) WriteHeader(status int) {
+	if w.decided {
+		return
+	}
+	w.status = status
+	// 본문이 없는 응답은 바로 전송
+	if status == http.StatusNoContent || status == http.StatusNotModified || status < http.StatusOK {
+		w.decide(false)
+	}
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if !w.decided {
+		w.buffer = append(w.buffer, p...)
+		if len(w.buffer) < w.config.MinSizeBytes {
+			return len(p), nil
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if w.gzWriter != nil {
+		return w.gzWriter.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// decide는 헤더를 전송하고 모아 둔 본문을 (압축하거나 그대로) 씁니다.
+func (w *gzipResponseWriter) decide(largeEnough bool) error {
+	w.decided = true
+	header := w.Header()
+	if header.Get("Content-Type") == "" && len(w.buffer) > 0 {
+		header.Set("Content-Type", http.DetectContentType(w.buffer))
+	}
+
+	if largeEnough && header.Get("Content-Encoding") == "" && compressibleContentType(header.Get("Content-Type")) {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		gzWriter, err := gzip.NewWriterLevel(w.ResponseWriter, w.config.Level)
+		if err != nil {
+			return err
+		}
+		w.gzWriter = gzWriter
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(w.buffer) == 0 {
+		return nil
+	}
+	buffer := w.buffer
+	w.buffer = nil
+	if w.gzWriter != nil {
+		_, err := w.gzWriter.Write(buffer)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buffer)
+	return err
+}
+
+// Close는 남은 본문을 전송하고 gzip 스트림을 닫습니다.
+func (w *gzipResponseWriter) Close() error {
+	if !w.decided {
+		if err := w.decide(false); err != nil {
+			return err
+		}
+	}
+	if w.gzWriter != nil {
+		return w.gzWriter.Close()
+	}
+	return nil
+}
+
+// Flush는 스트리밍 응답을 위해 지금까지의 본문을 전송합니다.
+func (w *gzipResponseWriter) Flush() {
+	if !w.decided {
+		w.decide(len(w.buffer) >= w.config.MinSizeBytes)
+	}
+	if w.gzWriter != nil {
+		w.gzWriter.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack은 압축하지 않은 원래 연결을 넘겨줍니다 (/api 경로에서 WebSocket을 쓰는 경우 대비).
+func (w *gzipResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	w.decided = true
+	return hijacker.Hijack()
+}
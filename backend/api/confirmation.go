@@ -0,0 +1,128 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"monitoring-app/monitoring"
+)
+
+// 파괴적 프로세스 제어(종료, 일시정지)를 위한 2단계 확인 토큰
+// 1단계: 토큰 없이 요청하면 428과 함께 확인 토큰을 발급
+// 2단계: X-Confirmation-Token 헤더에 토큰을 담아 같은 요청을 다시 보내면 실행
+
+const (
+	confirmationTokenHeader = "X-Confirmation-Token"
+	confirmationTokenTTL    = 30 * time.Second
+)
+
+// pendingConfirmation은 발급된 확인 토큰이 허용하는 작업입니다.
+type pendingConfirmation struct {
+	pid       int32
+	action    string
+	expiresAt time.Time
+}
+
+// ConfirmationStore는 발급된 확인 토큰을 관리합니다. 토큰은 한 번만 사용할 수 있습니다.
+type ConfirmationStore struct {
+	mu      sync.Mutex
+	pending map[string]pendingConfirmation
+	ttl     time.Duration
+}
+
+// NewConfirmationStore는 지정된 유효 시간을 갖는 토큰 저장소를 생성합니다.
+func NewConfirmationStore(ttl time.Duration) *ConfirmationStore {
+	return &ConfirmationStore{
+		pending: make(map[string]pendingConfirmation),
+		ttl:     ttl,
+	}
+}
+
+// Issue는 PID와 작업에 묶인 새 토큰을 발급합니다.
+func (s *ConfirmationStore) Issue(pid int32, action string) (string, time.Time, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", time.Time{}, err
+	}
+	token := hex.EncodeToString(buf)
+	expiresAt := time.Now().Add(s.ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 만료된 토큰 정리
+	now := time.Now()
+	for t, p := range s.pending {
+		if now.After(p.expiresAt) {
+			delete(s.pending, t)
+		}
+	}
+
+	s.pending[token] = pendingConfirmation{pid: pid, action: action, expiresAt: expiresAt}
+	return token, expiresAt, nil
+}
+
+// Consume은 토큰이 PID와 작업에 유효한지 확인하고 사용 처리합니다.
+func (s *ConfirmationStore) Consume(token string, pid int32, action string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.pending[token]
+	if !ok {
+		return false
+	}
+	delete(s.pending, token)
+
+	return p.pid == pid && p.action == action && time.Now().Before(p.expiresAt)
+}
+
+// requireConfirmation은 파괴적 작업 핸들러를 확인 토큰 검증으로 감쌉니다.
+func (h *Handler) requireConfirmation(action string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pid, err := strconv.ParseInt(mux.Vars(r)["pid"], 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid PID format", http.StatusBadRequest)
+			return
+		}
+
+		token := r.Header.Get(confirmationTokenHeader)
+		if token == "" {
+			token, expiresAt, err := h.confirmations.Issue(int32(pid), action)
+			if err != nil {
+				log.Printf("Failed to issue confirmation token: %v", err)
+				http.Error(w, "Failed to issue confirmation token", http.StatusInternalServerError)
+				return
+			}
+
+			log.Printf("Issued confirmation token for %s of process %d", action, pid)
+			response := map[string]interface{}{
+				"confirmationRequired": true,
+				"confirmationToken":    token,
+				"confirmationHeader":   confirmationTokenHeader,
+				"expiresAt":            expiresAt,
+				"action":               action,
+				"pid":                  pid,
+				"processName":          monitoring.GetProcessName(int32(pid)),
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusPreconditionRequired)
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		if !h.confirmations.Consume(token, int32(pid), action) {
+			log.Printf("Rejected invalid or expired confirmation token for %s of process %d", action, pid)
+			http.Error(w, "Invalid or expired confirmation token", http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+func TestRequireConfirmation(t *testing.T) {
+	pid := strconv.Itoa(os.Getpid())
+
+	// newConfirmedHandler는 확인을 통과했을 때 실행 횟수를 세는 kill 핸들러를 만듦
+	newConfirmedHandler := func(ttl time.Duration) (*Handler, http.HandlerFunc, *int) {
+		h := &Handler{confirmations: NewConfirmationStore(ttl)}
+		executed := 0
+		wrapped := h.requireConfirmation("kill", func(w http.ResponseWriter, r *http.Request) {
+			executed++
+			w.WriteHeader(http.StatusOK)
+		})
+		return h, wrapped, &executed
+	}
+	send := func(handler http.HandlerFunc, pid, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/gpu/processes/"+pid+"/kill", nil)
+		req = mux.SetURLVars(req, map[string]string{"pid": pid})
+		if token != "" {
+			req.Header.Set(confirmationTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	issue := func(t *testing.T, handler http.HandlerFunc) string {
+		rec := send(handler, pid, "")
+		if rec.Code != http.StatusPreconditionRequired {
+			t.Fatalf("Expected status %d, got %d", http.StatusPreconditionRequired, rec.Code)
+		}
+		var challenge struct {
+			ConfirmationRequired bool   `json:"confirmationRequired"`
+			ConfirmationToken    string `json:"confirmationToken"`
+			ConfirmationHeader   string `json:"confirmationHeader"`
+			Action               string `json:"action"`
+			PID                  int    `json:"pid"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&challenge); err != nil {
+			t.Fatalf("Failed to decode challenge: %v", err)
+		}
+		if !challenge.ConfirmationRequired || challenge.ConfirmationToken == "" ||
+			challenge.ConfirmationHeader != confirmationTokenHeader || challenge.Action != "kill" || challenge.PID != os.Getpid() {
+			t.Fatalf("Unexpected challenge: %+v", challenge)
+		}
+		return challenge.ConfirmationToken
+	}
+
+	t.Run("Issue_Then_Confirm", func(t *testing.T) {
+		_, handler, executed := newConfirmedHandler(confirmationTokenTTL)
+		token := issue(t, handler)
+		if *executed != 0 {
+			t.Fatal("Expected the action not to run before confirmation")
+		}
+
+		if rec := send(handler, pid, token); rec.Code != http.StatusOK || *executed != 1 {
+			t.Errorf("Expected the confirmed action to run once, got status %d and %d runs", rec.Code, *executed)
+		}
+	})
+
+	t.Run("Token_Reuse", func(t *testing.T) {
+		_, handler, executed := newConfirmedHandler(confirmationTokenTTL)
+		token := issue(t, handler)
+		send(handler, pid, token)
+
+		if rec := send(handler, pid, token); rec.Code != http.StatusForbidden || *executed != 1 {
+			t.Errorf("Expected a reused token to be rejected, got status %d and %d runs", rec.Code, *executed)
+		}
+	})
+
+	t.Run("Expired_Token", func(t *testing.T) {
+		_, handler, executed := newConfirmedHandler(10 * time.Millisecond)
+		token := issue(t, handler)
+		time.Sleep(20 * time.Millisecond)
+
+		if rec := send(handler, pid, token); rec.Code != http.StatusForbidden || *executed != 0 {
+			t.Errorf("Expected an expired token to be rejected, got status %d and %d runs", rec.Code, *executed)
+		}
+	})
+
+	t.Run("Token_Bound_To_Request", func(t *testing.T) {
+		cases := []struct {
+			name   string
+			pid    string
+			action string
+		}{
+			{"Different_PID", strconv.Itoa(os.Getpid() + 1), "kill"},
+			{"Different_Action", pid, "suspend"},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				h, handler, executed := newConfirmedHandler(confirmationTokenTTL)
+				token := issue(t, handler)
+				other := h.requireConfirmation(c.action, func(w http.ResponseWriter, r *http.Request) {
+					*executed++
+					w.WriteHeader(http.StatusOK)
+				})
+
+				if rec := send(other, c.pid, token); rec.Code != http.StatusForbidden || *executed != 0 {
+					t.Errorf("Expected the token to be rejected, got status %d and %d runs", rec.Code, *executed)
+				}
+				// 잘못 사용된 토큰은 폐기되어 원래 요청에도 다시 쓸 수 없음
+				if rec := send(handler, pid, token); rec.Code != http.StatusForbidden {
+					t.Errorf("Expected the misused token to be discarded, got status %d", rec.Code)
+				}
+			})
+		}
+	})
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -13,8 +14,16 @@ import (
 	"monitoring-app/monitoring"
 )
 
+var errDemoMode = errors.New("process control is disabled in demo mode")
+
 // Security validation middleware
 func (h *Handler) validateSecurity(w http.ResponseWriter) error {
+	// 데모 모드의 프로세스는 가짜이므로 실제 프로세스를 제어하지 않도록 거부
+	if monitoring.IsDemoMode() {
+		http.Error(w, "Process control is disabled in demo mode", http.StatusForbidden)
+		return errDemoMode
+	}
+
 	// 보안 컨텍스트 검증
 	err := monitoring.ValidateSecurityContext()
 	if err != nil {
@@ -290,6 +299,28 @@ func (h *Handler) SetGPUProcessPriorityHandler(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode(response)
 }
 
+// GetGPUInfoHandler는 현재 GPU 상태와 드라이버/CUDA 버전 등 정적 환경 정보를 반환합니다.
+func (h *Handler) GetGPUInfoHandler(w http.ResponseWriter, r *http.Request) {
+	response := map[string]interface{}{}
+
+	if gpuInfo, err := monitoring.GetCurrentGPUInfo(); err != nil {
+		log.Printf("Failed to get GPU info: %v", err)
+	} else {
+		response["gpu"] = gpuInfo
+	}
+
+	staticInfo, err := monitoring.GetGPUStaticInfo()
+	if err != nil {
+		response["static"] = []monitoring.GPUStaticInfo{}
+		response["staticError"] = err.Error()
+	} else {
+		response["static"] = staticInfo
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
 // CheckPrivilegesHandler는 현재 프로세스의 관리자 권한을 확인합니다.
 func (h *Handler) CheckPrivilegesHandler(w http.ResponseWriter, r *http.Request) {
 	log.Printf("Received request to check admin privileges")
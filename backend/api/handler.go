@@ -4,34 +4,28 @@ import (
 	"database/sql"
 
 	"github.com/gorilla/mux"
+	"monitoring-app/websockets"
 )
 
 // Handler는 API 요청 흐름을 관리합니다.
 type Handler struct {
-	DB *sql.DB
+	DB            *sql.DB
+	WebSockets    *websockets.Hub // 연결된 클라이언트 관리 API용 (nil이면 503)
+	confirmations *ConfirmationStore
 }
 
 // NewHandler는 공유 DB 커넥션으로 초기화된 Handler를 반환합니다.
 func NewHandler(db *sql.DB) *Handler {
-	return &Handler{DB: db}
+	return &Handler{
+		DB:            db,
+		confirmations: NewConfirmationStore(confirmationTokenTTL),
+	}
 }
 
 // RegisterRoutes는 API 엔드포인트와 핸들러 매핑을 등록합니다.
+// 엔드포인트 목록과 문서화 메타데이터는 routes.go의 Routes에서 관리합니다.
 func RegisterRoutes(r *mux.Router, h *Handler) {
-	r.HandleFunc("/api/widgets", h.GetWidgetsHandler).Methods("GET")
-	r.HandleFunc("/api/widgets", h.SaveWidgetsHandler).Methods("POST")
-	r.HandleFunc("/api/widgets", h.DeleteWidgetHandler).Methods("DELETE")
-
-	r.HandleFunc("/api/pages", h.GetPagesHandler).Methods("GET")
-	r.HandleFunc("/api/pages", h.CreatePageHandler).Methods("POST")
-	r.HandleFunc("/api/pages", h.DeletePageHandler).Methods("DELETE")
-	r.HandleFunc("/api/pages/name", h.UpdatePageNameHandler).Methods("PUT")
-
-	r.HandleFunc("/api/gpu/process/{pid}/kill", h.KillGPUProcessHandler).Methods("POST")
-	r.HandleFunc("/api/gpu/process/{pid}/suspend", h.SuspendGPUProcessHandler).Methods("POST")
-	r.HandleFunc("/api/gpu/process/{pid}/resume", h.ResumeGPUProcessHandler).Methods("POST")
-	r.HandleFunc("/api/gpu/process/{pid}/priority", h.SetGPUProcessPriorityHandler).Methods("POST")
-	r.HandleFunc("/api/gpu/processes/privileges", h.CheckPrivilegesHandler).Methods("GET")
-	r.HandleFunc("/api/gpu/processes/request-elevation", h.RequestElevationHandler).Methods("POST")
-	r.HandleFunc("/api/gpu/processes/critical-processes", h.GetCriticalProcessesHandler).Methods("GET")
+	for _, route := range h.Routes() {
+		r.HandleFunc(route.Path, route.Handler).Methods(route.Method)
+	}
 }
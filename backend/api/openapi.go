@@ -0,0 +1,209 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Routes 목록으로부터 OpenAPI 3 문서를 생성하고 Swagger UI 페이지를 제공합니다.
+// 응답 본문은 핸들러마다 형태가 달라 (배열/객체) 스키마를 지정하지 않습니다.
+
+const openAPIVersion = "3.0.3"
+
+// mux 경로 변수: {name} 또는 {name:pattern}
+var muxPathVariable = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)(?::([^}]+))?\}`)
+
+// BuildOpenAPISpec은 라우트 목록을 OpenAPI 3 문서로 변환합니다.
+func BuildOpenAPISpec(routes []Route) map[string]interface{} {
+	paths := make(map[string]interface{})
+	tagSet := make(map[string]bool)
+
+	for _, route := range routes {
+		path, pathParams := openAPIPath(route.Path)
+		operations, ok := paths[path].(map[string]interface{})
+		if !ok {
+			operations = make(map[string]interface{})
+			paths[path] = operations
+		}
+		operations[strings.ToLower(route.Method)] = openAPIOperation(route, pathParams)
+		if route.Tag != "" {
+			tagSet[route.Tag] = true
+		}
+	}
+
+	tagNames := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tagNames = append(tagNames, tag)
+	}
+	sort.Strings(tagNames)
+	tags := make([]map[string]interface{}, 0, len(tagNames))
+	for _, tag := range tagNames {
+		tags = append(tags, map[string]interface{}{"name": tag})
+	}
+
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":       "HWnow API",
+			"version":     "1.0.0",
+			"description": "Dashboard layout and GPU process control endpoints of the HWnow monitoring server.",
+		},
+		"tags":  tags,
+		"paths": paths,
+	}
+}
+
+// openAPIPath는 mux 경로를 OpenAPI 경로로 바꾸고 경로 파라미터를 반환합니다.
+func openAPIPath(muxPath string) (string, []map[string]interface{}) {
+	var params []map[string]interface{}
+	path := muxPathVariable.ReplaceAllStringFunc(muxPath, func(variable string) string {
+		match := muxPathVariable.FindStringSubmatch(variable)
+		schema := map[string]interface{}{"type": "string"}
+		switch match[2] {
+		case "":
+		case "[0-9]+":
+			schema = map[string]interface{}{"type": "integer"}
+		default:
+			schema["pattern"] = "^" + match[2] + "$"
+		}
+		params = append(params, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   schema,
+		})
+		return "{" + match[1] + "}"
+	})
+	return path, params
+}
+
+// openAPIOperation은 라우트 하나의 operation 객체를 생성합니다.
+func openAPIOperation(route Route, pathParams []map[string]interface{}) map[string]interface{} {
+	operation := map[string]interface{}{
+		"summary":     route.Summary,
+		"operationId": operationID(route),
+		"responses": map[string]interface{}{
+			"200": jsonResponse("Success"),
+			"400": map[string]interface{}{"description": "Invalid request"},
+			"500": map[string]interface{}{"description": "Internal error"},
+		},
+	}
+	if route.Tag != "" {
+		operation["tags"] = []string{route.Tag}
+	}
+
+	parameters := append([]map[string]interface{}{}, pathParams...)
+	for _, param := range route.Query {
+		parameters = append(parameters, openAPIParameter(param, "query"))
+	}
+	if route.Confirmation {
+		parameters = append(parameters, openAPIParameter(Param{
+			Name:        confirmationTokenHeader,
+			Type:        "string",
+			Description: "첫 요청의 428 응답으로 받은 확인 토큰 (같은 요청을 토큰과 함께 다시 보내면 실행)",
+		}, "header"))
+		operation["responses"].(map[string]interface{})["428"] = jsonResponse("Confirmation token issued; repeat the request with the token header")
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	if len(route.Body) > 0 {
+		schema := openAPIObjectSchema(route.Body)
+		if route.BodyArray {
+			schema = map[string]interface{}{"type": "array", "items": schema}
+		}
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": schema},
+			},
+		}
+	}
+	return operation
+}
+
+func openAPIParameter(param Param, in string) map[string]interface{} {
+	parameter := map[string]interface{}{
+		"name":     param.Name,
+		"in":       in,
+		"required": param.Required,
+		"schema":   map[string]interface{}{"type": param.Type},
+	}
+	if param.Description != "" {
+		parameter["description"] = param.Description
+	}
+	return parameter
+}
+
+func openAPIObjectSchema(fields []Param) map[string]interface{} {
+	properties := make(map[string]interface{}, len(fields))
+	var required []string
+	for _, field := range fields {
+		property := map[string]interface{}{"type": field.Type}
+		if field.Description != "" {
+			property["description"] = field.Description
+		}
+		properties[field.Name] = property
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func jsonResponse(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": map[string]interface{}{}},
+		},
+	}
+}
+
+// operationID는 메서드와 경로로 고유한 operationId를 만듭니다. (예: post_api_gpu_processes_pid_kill)
+func operationID(route Route) string {
+	path, _ := openAPIPath(route.Path)
+	replacer := strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ".", "_")
+	return strings.ToLower(route.Method) + replacer.Replace(path)
+}
+
+// OpenAPIHandler는 등록된 라우트의 OpenAPI 3 문서를 JSON으로 반환합니다.
+func (h *Handler) OpenAPIHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(BuildOpenAPISpec(h.Routes()))
+}
+
+// SwaggerUIHandler는 /api/openapi.json을 불러오는 Swagger UI 페이지를 반환합니다.
+func (h *Handler) SwaggerUIHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(swaggerUIPage))
+}
+
+// Swagger UI 정적 파일은 CDN에서 불러옴 (바이너리 크기 증가 방지)
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>HWnow API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: "/api/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+// 라우터에 등록된 모든 경로와 메서드가 OpenAPI 문서에 있고, 문서에는 등록되지 않은 경로가 없어야 함
+func TestOpenAPISpecMatchesRouter(t *testing.T) {
+	h := NewHandler(nil)
+	router := mux.NewRouter()
+	RegisterRoutes(router, h)
+	paths := BuildOpenAPISpec(h.Routes())["paths"].(map[string]interface{})
+
+	registered := make(map[string]bool)
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			t.Errorf("Route %s has no method restriction", template)
+			return nil
+		}
+		path, _ := openAPIPath(template)
+		for _, method := range methods {
+			operation := strings.ToLower(method) + " " + path
+			registered[operation] = true
+			operations, ok := paths[path].(map[string]interface{})
+			if !ok || operations[strings.ToLower(method)] == nil {
+				t.Errorf("Expected %s %s in the OpenAPI document", method, path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk failed: %v", err)
+	}
+
+	for path, operations := range paths {
+		for method := range operations.(map[string]interface{}) {
+			if !registered[method+" "+path] {
+				t.Errorf("Documented %s %s is not registered on the router", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gorilla/mux"
+	"monitoring-app/websockets"
+)
+
+// 스냅샷 녹화/재생 API (녹화 파일 형식과 재생 방식은 websockets/recording.go 참고)
+// 관리 API이므로 /api/ws/clients와 같은 WebSocket 토큰이 필요합니다.
+
+type startRecordingRequest struct {
+	Name            string `json:"name"`
+	DurationSeconds int    `json:"duration_seconds"`
+}
+
+type startReplayRequest struct {
+	Name  string  `json:"name"`
+	Speed float64 `json:"speed"`
+	Loop  bool    `json:"loop"`
+}
+
+// writeRecordingResult는 녹화/재생 상태를 JSON으로 응답하고, 오류가 있으면 종류에 맞는 상태 코드를 씁니다.
+func writeRecordingResult(w http.ResponseWriter, status websockets.RecordingStatus, err error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, websockets.ErrInvalidRecordingName):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, os.ErrNotExist):
+			http.Error(w, "recording not found", http.StatusNotFound)
+		case errors.Is(err, os.ErrExist):
+			http.Error(w, "a recording with this name already exists", http.StatusConflict)
+		case errors.Is(err, websockets.ErrRecordingActive), errors.Is(err, websockets.ErrNoRecording), errors.Is(err, websockets.ErrNoReplay):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			log.Printf("Recording request failed: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(status)
+}
+
+// GetRecordingsHandler는 녹화/재생 상태와 저장된 녹화 파일 목록을 반환합니다.
+func (h *Handler) GetRecordingsHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+
+	recordings, err := hub.Recordings()
+	if err != nil {
+		log.Printf("Error listing recordings: %v", err)
+		http.Error(w, "Failed to list recordings", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":     hub.RecordingStatus(),
+		"recordings": recordings,
+	})
+}
+
+// StartRecordingHandler는 지정한 시간 동안 실시간 스냅샷 녹화를 시작합니다.
+func (h *Handler) StartRecordingHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+
+	var req startRecordingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.DurationSeconds < 0 {
+		http.Error(w, "duration_seconds must not be negative", http.StatusBadRequest)
+		return
+	}
+	status, err := hub.StartRecording(req.Name, time.Duration(req.DurationSeconds)*time.Second)
+	writeRecordingResult(w, status, err)
+}
+
+// StopRecordingHandler는 진행 중인 녹화를 끝냅니다.
+func (h *Handler) StopRecordingHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+	status, err := hub.StopRecording()
+	writeRecordingResult(w, status, err)
+}
+
+// DownloadRecordingHandler는 녹화 파일을 내려받습니다 (버그 리포트 첨부용).
+func (h *Handler) DownloadRecordingHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+	path, err := hub.RecordingPath(name)
+	if err != nil {
+		writeRecordingResult(w, websockets.RecordingStatus{}, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`.jsonl"`)
+	http.ServeFile(w, r, path)
+}
+
+// DeleteRecordingHandler는 녹화 파일을 삭제합니다.
+func (h *Handler) DeleteRecordingHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+	if err := hub.DeleteRecording(mux.Vars(r)["name"]); err != nil {
+		writeRecordingResult(w, websockets.RecordingStatus{}, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// StartReplayHandler는 녹화 파일을 WebSocket 클라이언트에 원래 속도 또는 배속으로 재생합니다.
+func (h *Handler) StartReplayHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+
+	var req startReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Speed < 0 {
+		http.Error(w, "speed must not be negative", http.StatusBadRequest)
+		return
+	}
+	status, err := hub.StartReplay(req.Name, req.Speed, req.Loop)
+	writeRecordingResult(w, status, err)
+}
+
+// StopReplayHandler는 재생을 멈추고 실시간 스냅샷 전송으로 돌아갑니다.
+func (h *Handler) StopReplayHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+	status, err := hub.StopReplay()
+	writeRecordingResult(w, status, err)
+}
+
+// GetLatestSnapshotHandler는 WebSocket으로 마지막에 전송한 스냅샷을 반환합니다 (재생 중이면 녹화된 스냅샷).
+func (h *Handler) GetLatestSnapshotHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+	snapshot := hub.LatestSnapshot()
+	if snapshot == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"snapshot":  snapshot,
+		"replaying": hub.RecordingStatus().Replaying,
+	})
+}
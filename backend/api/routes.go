@@ -0,0 +1,125 @@
+package api
+
+import "net/http"
+
+// Route는 API 엔드포인트 하나의 등록 정보와 문서화용 메타데이터입니다.
+// 같은 목록으로 라우터 등록과 OpenAPI 문서 생성을 수행하므로 두 결과가 어긋나지 않습니다.
+type Route struct {
+	Method       string
+	Path         string // gorilla/mux 경로 ({pid:[0-9]+} 형식의 정규식 변수 허용)
+	Tag          string
+	Summary      string
+	Query        []Param
+	Body         []Param // JSON 요청 본문 필드 (BodyArray면 배열 요소의 필드)
+	BodyArray    bool
+	Confirmation bool // X-Confirmation-Token 확인 절차 필요
+	Handler      http.HandlerFunc
+}
+
+// Param은 쿼리 파라미터 또는 요청 본문 필드를 설명합니다.
+type Param struct {
+	Name        string
+	Type        string // OpenAPI 기본 타입 (string, integer, number, boolean, object)
+	Required    bool
+	Description string
+}
+
+// Routes는 등록되는 모든 API 엔드포인트를 반환합니다.
+func (h *Handler) Routes() []Route {
+	userID := Param{Name: "userId", Type: "string", Required: true, Description: "사용자 ID"}
+	pageID := Param{Name: "pageId", Type: "string", Description: "페이지 ID (생략 시 main-page)"}
+	pageBody := []Param{
+		{Name: "userId", Type: "string", Required: true},
+		{Name: "pageId", Type: "string", Required: true},
+		{Name: "pageName", Type: "string", Required: true},
+	}
+	wsToken := Param{Name: "token", Type: "string", Description: "WebSocket 토큰 (security.websocket_token 설정 시, Authorization: Bearer 헤더로도 가능)"}
+	recordingName := Param{Name: "name", Type: "string", Required: true, Description: "녹화 이름 (영문, 숫자, -, _)"}
+	priorityBody := []Param{
+		{Name: "priority", Type: "string", Required: true, Description: "realtime, high, above_normal, normal, below_normal, low"},
+	}
+
+	return []Route{
+		{Method: "GET", Path: "/api/widgets", Tag: "widgets", Summary: "Get widget layout of a page",
+			Query: []Param{userID, pageID}, Handler: h.GetWidgetsHandler},
+		{Method: "POST", Path: "/api/widgets", Tag: "widgets", Summary: "Save widget layout",
+			Body: []Param{
+				{Name: "userId", Type: "string", Required: true},
+				{Name: "pageId", Type: "string"},
+				{Name: "widgetId", Type: "string", Required: true},
+				{Name: "widgetType", Type: "string", Required: true},
+				{Name: "config", Type: "string", Description: "JSON 문자열"},
+				{Name: "layout", Type: "string", Description: "JSON 문자열"},
+			}, BodyArray: true, Handler: h.SaveWidgetsHandler},
+		{Method: "DELETE", Path: "/api/widgets", Tag: "widgets", Summary: "Delete a widget",
+			Query: []Param{userID, pageID, {Name: "widgetId", Type: "string", Required: true}}, Handler: h.DeleteWidgetHandler},
+
+		{Method: "GET", Path: "/api/pages", Tag: "pages", Summary: "List dashboard pages",
+			Query: []Param{userID}, Handler: h.GetPagesHandler},
+		{Method: "POST", Path: "/api/pages", Tag: "pages", Summary: "Create a dashboard page",
+			Body: pageBody, Handler: h.CreatePageHandler},
+		{Method: "DELETE", Path: "/api/pages", Tag: "pages", Summary: "Delete a dashboard page and its widgets",
+			Query: []Param{userID, {Name: "pageId", Type: "string", Required: true}}, Handler: h.DeletePageHandler},
+		{Method: "PUT", Path: "/api/pages/name", Tag: "pages", Summary: "Rename a dashboard page",
+			Body: pageBody, Handler: h.UpdatePageNameHandler},
+
+		{Method: "GET", Path: "/api/gpu/info", Tag: "gpu", Summary: "Get GPU hardware information", Handler: h.GetGPUInfoHandler},
+
+		// 이전 경로도 종료/일시정지는 확인 토큰 필요 (확인 없이 실행되는 우회 경로가 되지 않도록)
+		{Method: "POST", Path: "/api/gpu/process/{pid}/kill", Tag: "gpu-process", Summary: "Terminate a GPU process (legacy path)",
+			Confirmation: true, Handler: h.requireConfirmation("kill", h.KillGPUProcessHandler)},
+		{Method: "POST", Path: "/api/gpu/process/{pid}/suspend", Tag: "gpu-process", Summary: "Suspend a GPU process (legacy path)",
+			Confirmation: true, Handler: h.requireConfirmation("suspend", h.SuspendGPUProcessHandler)},
+		{Method: "POST", Path: "/api/gpu/process/{pid}/resume", Tag: "gpu-process", Summary: "Resume a suspended GPU process (legacy path)",
+			Handler: h.ResumeGPUProcessHandler},
+		{Method: "POST", Path: "/api/gpu/process/{pid}/priority", Tag: "gpu-process", Summary: "Set GPU process priority (legacy path)",
+			Body: priorityBody, Handler: h.SetGPUProcessPriorityHandler},
+		// Wails 바인딩과 동일한 프로세스 제어 (종료/일시정지는 확인 토큰 필요)
+		{Method: "POST", Path: "/api/gpu/processes/{pid:[0-9]+}/kill", Tag: "gpu-process", Summary: "Terminate a GPU process",
+			Confirmation: true, Handler: h.requireConfirmation("kill", h.KillGPUProcessHandler)},
+		{Method: "POST", Path: "/api/gpu/processes/{pid:[0-9]+}/suspend", Tag: "gpu-process", Summary: "Suspend a GPU process",
+			Confirmation: true, Handler: h.requireConfirmation("suspend", h.SuspendGPUProcessHandler)},
+		{Method: "POST", Path: "/api/gpu/processes/{pid:[0-9]+}/resume", Tag: "gpu-process", Summary: "Resume a suspended GPU process",
+			Handler: h.ResumeGPUProcessHandler},
+		{Method: "POST", Path: "/api/gpu/processes/{pid:[0-9]+}/priority", Tag: "gpu-process", Summary: "Set GPU process priority",
+			Body: priorityBody, Handler: h.SetGPUProcessPriorityHandler},
+		{Method: "GET", Path: "/api/gpu/processes/privileges", Tag: "gpu-process", Summary: "Check process control privileges",
+			Handler: h.CheckPrivilegesHandler},
+		{Method: "POST", Path: "/api/gpu/processes/request-elevation", Tag: "gpu-process", Summary: "Request UAC elevation",
+			Handler: h.RequestElevationHandler},
+		{Method: "GET", Path: "/api/gpu/processes/critical-processes", Tag: "gpu-process", Summary: "List protected system processes",
+			Handler: h.GetCriticalProcessesHandler},
+
+		{Method: "GET", Path: "/api/ws/clients", Tag: "websocket", Summary: "List connected WebSocket clients with topics and message rates",
+			Query: []Param{wsToken}, Handler: h.GetWebSocketClientsHandler},
+		{Method: "GET", Path: "/api/snapshot", Tag: "websocket", Summary: "Latest snapshot sent to WebSocket clients (recorded snapshot while replaying)",
+			Query: []Param{wsToken}, Handler: h.GetLatestSnapshotHandler},
+
+		{Method: "GET", Path: "/api/recordings", Tag: "recording", Summary: "Recording/replay status and saved recordings",
+			Query: []Param{wsToken}, Handler: h.GetRecordingsHandler},
+		{Method: "POST", Path: "/api/recordings", Tag: "recording", Summary: "Start recording live snapshots to a file",
+			Query: []Param{wsToken},
+			Body: []Param{
+				{Name: "name", Type: "string", Description: "녹화 이름 (생략 시 시작 시각)"},
+				{Name: "duration_seconds", Type: "integer", Description: "녹화 시간 (생략 시 최대 길이)"},
+			}, Handler: h.StartRecordingHandler},
+		{Method: "POST", Path: "/api/recordings/stop", Tag: "recording", Summary: "Stop the current recording",
+			Query: []Param{wsToken}, Handler: h.StopRecordingHandler},
+		{Method: "GET", Path: "/api/recordings/{name:[A-Za-z0-9_-]+}", Tag: "recording", Summary: "Download a recording file",
+			Query: []Param{wsToken}, Handler: h.DownloadRecordingHandler},
+		{Method: "DELETE", Path: "/api/recordings/{name:[A-Za-z0-9_-]+}", Tag: "recording", Summary: "Delete a recording file",
+			Query: []Param{wsToken}, Handler: h.DeleteRecordingHandler},
+		{Method: "POST", Path: "/api/replay", Tag: "recording", Summary: "Replay a recording to WebSocket clients instead of live snapshots",
+			Query: []Param{wsToken},
+			Body: []Param{
+				recordingName,
+				{Name: "speed", Type: "number", Description: "배속 (생략 시 1, 0.1 ~ 100)"},
+				{Name: "loop", Type: "boolean", Description: "끝나면 처음부터 반복"},
+			}, Handler: h.StartReplayHandler},
+		{Method: "POST", Path: "/api/replay/stop", Tag: "recording", Summary: "Stop replay and resume live snapshots",
+			Query: []Param{wsToken}, Handler: h.StopReplayHandler},
+
+		{Method: "GET", Path: "/api/openapi.json", Tag: "docs", Summary: "OpenAPI 3 document for this API", Handler: h.OpenAPIHandler},
+		{Method: "GET", Path: "/api/docs", Tag: "docs", Summary: "Swagger UI", Handler: h.SwaggerUIHandler},
+	}
+}
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"monitoring-app/websockets"
+)
+
+// LAN에 노출할 때를 대비한 요청 제한
+// - IP별 토큰 버킷 속도 제한 (/api, /ws 경로만, 정적 파일은 제외)
+// - 요청 본문 크기 제한
+// - CORS 허용 출처 설정 (비어 있으면 CORS 헤더를 보내지 않아 같은 출처에서만 호출 가능)
+// - WebSocket 토큰과 연결 수 제한 (Origin 허용 목록은 CORS 설정을 같이 사용)
+// - 읽기 전용 모드: GET/HEAD/OPTIONS 외의 /api 요청(프로세스 종료/일시정지/우선순위 변경 등)을 모두 거부
+
+const idleBucketTTL = 10 * time.Minute
+
+// SecurityConfig는 API 요청 제한 설정입니다.
+type SecurityConfig struct {
+	RateLimitPerMinute int      `json:"rate_limit_per_minute"` // IP별 분당 허용 요청 수 (0 = 제한 없음)
+	RateLimitBurst     int      `json:"rate_limit_burst"`      // 순간적으로 허용할 최대 요청 수
+	MaxBodyBytes       int64    `json:"max_body_bytes"`        // 요청 본문 상한 (0 = 제한 없음)
+	AllowedOrigins     []string `json:"allowed_origins"`       // CORS 허용 출처 ("*" = 모든 출처)
+	TrustProxyHeaders  bool     `json:"trust_proxy_headers"`   // 역방향 프록시 뒤에서 X-Forwarded-For로 IP 판별
+	ReadOnly           bool     `json:"read_only"`             // 조회만 허용 (변경 요청은 403)
+
+	WebSocketToken           string `json:"websocket_token"`              // /ws 및 /api/ws/clients 인증 토큰 (빈 값 = 인증 없음)
+	MaxWebSocketClients      int    `json:"max_websocket_clients"`        // 전체 WebSocket 연결 상한 (0 = 제한 없음)
+	MaxWebSocketClientsPerIP int    `json:"max_websocket_clients_per_ip"` // IP별 WebSocket 연결 상한 (0 = 제한 없음)
+}
+
+// DefaultSecurityConfig는 대시보드 폴링에는 충분하고 무차별 호출은 막는 기본값을 반환합니다.
+func DefaultSecurityConfig() SecurityConfig {
+	return SecurityConfig{
+		RateLimitPerMinute: 600,
+		RateLimitBurst:     100,
+		MaxBodyBytes:       1 << 20,
+
+		MaxWebSocketClients:      64,
+		MaxWebSocketClientsPerIP: 8,
+	}
+}
+
+// WebSocketOptions는 WebSocket 허브에 적용할 연결 허용 조건을 반환합니다.
+func (c SecurityConfig) WebSocketOptions() websockets.ServerOptions {
+	return websockets.ServerOptions{
+		AllowedOrigins:    c.AllowedOrigins,
+		Token:             c.WebSocketToken,
+		MaxClients:        c.MaxWebSocketClients,
+		MaxClientsPerIP:   c.MaxWebSocketClientsPerIP,
+		TrustProxyHeaders: c.TrustProxyHeaders,
+	}
+}
+
+// tokenBucket은 IP 하나의 남은 요청 허용량입니다.
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// SecurityMiddleware는 라우터 전체를 감싸 속도 제한, 본문 크기 제한, CORS를 적용합니다.
+type SecurityMiddleware struct {
+	config  SecurityConfig
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	pruned  time.Time
+}
+
+// NewSecurityMiddleware는 설정으로 미들웨어를 생성합니다.
+func NewSecurityMiddleware(config SecurityConfig) *SecurityMiddleware {
+	if config.RateLimitBurst <= 0 {
+		config.RateLimitBurst = config.RateLimitPerMinute
+	}
+	return &SecurityMiddleware{
+		config:  config,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Wrap은 next 앞에서 요청 제한을 적용하는 핸들러를 반환합니다.
+// mux 미들웨어(r.Use)는 일치하는 라우트에만 실행되어 CORS preflight(OPTIONS)를 처리할 수 없으므로 라우터 바깥에서 감쌉니다.
+func (m *SecurityMiddleware) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limited := strings.HasPrefix(r.URL.Path, "/api/") || r.URL.Path == "/ws"
+
+		if origin := r.Header.Get("Origin"); origin != "" && limited {
+			if !m.applyCORS(w, origin) {
+				if r.Method == http.MethodOptions {
+					http.Error(w, "origin not allowed", http.StatusForbidden)
+					return
+				}
+			} else if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+
+		if limited && m.config.RateLimitPerMinute > 0 {
+			if retryAfter, ok := m.allow(m.clientIP(r), time.Now()); !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				return
+			}
+		}
+
+		if m.config.ReadOnly && strings.HasPrefix(r.URL.Path, "/api/") {
+			w.Header().Set("X-Read-Only", "true")
+			if !readOnlyMethod(r.Method) {
+				http.Error(w, "read-only mode: changes are disabled (security.read_only)", http.StatusForbidden)
+				return
+			}
+		}
+
+		if m.config.MaxBodyBytes > 0 && r.Body != nil {
+			if r.ContentLength > m.config.MaxBodyBytes {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, m.config.MaxBodyBytes)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// readOnlyMethod는 읽기 전용 모드에서도 허용되는 조회 메서드인지 반환합니다.
+func readOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// applyCORS는 허용된 출처이면 CORS 헤더를 설정하고 true를 반환합니다.
+func (m *SecurityMiddleware) applyCORS(w http.ResponseWriter, origin string) bool {
+	allowed := false
+	for _, candidate := range m.config.AllowedOrigins {
+		if candidate == "*" || strings.EqualFold(strings.TrimRight(candidate, "/"), origin) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return false
+	}
+
+	header := w.Header()
+	header.Set("Access-Control-Allow-Origin", origin)
+	header.Add("Vary", "Origin")
+	header.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	header.Set("Access-Control-Allow-Headers", "Content-Type, Authorization, "+confirmationTokenHeader)
+	header.Set("Access-Control-Max-Age", "600")
+	return true
+}
+
+// allow는 IP의 토큰을 하나 소비하고, 남은 토큰이 없으면 다음 토큰까지의 대기 시간을 반환합니다.
+func (m *SecurityMiddleware) allow(ip string, now time.Time) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// 오래 요청이 없던 IP 정리
+	if now.Sub(m.pruned) > time.Minute {
+		for key, bucket := range m.buckets {
+			if now.Sub(bucket.lastSeen) > idleBucketTTL {
+				delete(m.buckets, key)
+			}
+		}
+		m.pruned = now
+	}
+
+	burst := float64(m.config.RateLimitBurst)
+	perSecond := float64(m.config.RateLimitPerMinute) / 60
+
+	bucket, exists := m.buckets[ip]
+	if !exists {
+		bucket = &tokenBucket{tokens: burst, lastSeen: now}
+		m.buckets[ip] = bucket
+	}
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.lastSeen).Seconds()*perSecond)
+	bucket.lastSeen = now
+
+	if bucket.tokens < 1 {
+		return time.Duration((1 - bucket.tokens) / perSecond * float64(time.Second)), false
+	}
+	bucket.tokens--
+	return 0, true
+}
+
+// clientIP는 요청자 IP를 반환합니다. 프록시 헤더는 설정으로 허용한 경우에만 신뢰합니다.
+func (m *SecurityMiddleware) clientIP(r *http.Request) string {
+	if m.config.TrustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSecurityMiddlewareCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	handler := NewSecurityMiddleware(SecurityConfig{AllowedOrigins: []string{"http://dashboard.lan"}}).Wrap(next)
+
+	cases := []struct {
+		name           string
+		origin         string
+		expectedStatus int
+		allowed        bool
+	}{
+		{"Allowed_Origin", "http://dashboard.lan", http.StatusNoContent, true},
+		{"Other_Origin", "http://evil.example", http.StatusForbidden, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/api/ws/clients", nil)
+			req.Header.Set("Origin", c.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+			req.Header.Set("Access-Control-Request-Headers", "authorization")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != c.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", c.expectedStatus, rec.Code)
+			}
+			allowHeaders := strings.ToLower(rec.Header().Get("Access-Control-Allow-Headers"))
+			if !c.allowed {
+				if allowHeaders != "" {
+					t.Errorf("Expected no CORS headers for a disallowed origin, got %q", allowHeaders)
+				}
+				return
+			}
+			// 토큰 인증(Authorization: Bearer)과 확인 토큰 헤더를 브라우저가 보낼 수 있어야 함
+			for _, name := range []string{"content-type", "authorization", strings.ToLower(confirmationTokenHeader)} {
+				if !strings.Contains(allowHeaders, name) {
+					t.Errorf("Expected %s in Access-Control-Allow-Headers, got %q", name, allowHeaders)
+				}
+			}
+		})
+	}
+}
+
+func TestSecurityMiddlewareRateLimit(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	t.Run("Per_IP_Bucket", func(t *testing.T) {
+		handler := NewSecurityMiddleware(SecurityConfig{RateLimitPerMinute: 60, RateLimitBurst: 2}).Wrap(next)
+		send := func(path, remoteAddr string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.RemoteAddr = remoteAddr
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec
+		}
+
+		for i := 0; i < 2; i++ {
+			if rec := send("/api/widgets", "10.0.0.1:5000"); rec.Code != http.StatusOK {
+				t.Fatalf("Request %d within the burst: expected 200, got %d", i+1, rec.Code)
+			}
+		}
+		rec := send("/api/widgets", "10.0.0.1:5001")
+		if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "1" {
+			t.Errorf("Expected 429 with Retry-After 1 once the burst is used, got %d (Retry-After %q)", rec.Code, rec.Header().Get("Retry-After"))
+		}
+		// 다른 IP와 정적 파일은 영향을 받지 않음
+		if rec := send("/api/widgets", "10.0.0.2:5000"); rec.Code != http.StatusOK {
+			t.Errorf("Expected another IP to have its own bucket, got %d", rec.Code)
+		}
+		if rec := send("/assets/index.js", "10.0.0.1:5002"); rec.Code != http.StatusOK {
+			t.Errorf("Expected static files to be exempt, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Refill", func(t *testing.T) {
+		m := NewSecurityMiddleware(SecurityConfig{RateLimitPerMinute: 60, RateLimitBurst: 1})
+		now := time.Now()
+		if _, ok := m.allow("10.0.0.1", now); !ok {
+			t.Fatal("Expected the first request to be allowed")
+		}
+		if wait, ok := m.allow("10.0.0.1", now.Add(500*time.Millisecond)); ok || wait != 500*time.Millisecond {
+			t.Errorf("Expected to wait 500ms for the next token, got %v (allowed %v)", wait, ok)
+		}
+		if _, ok := m.allow("10.0.0.1", now.Add(time.Second)); !ok {
+			t.Error("Expected a token to be refilled after one second")
+		}
+	})
+}
+
+func TestSecurityMiddlewareReadOnly(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	handler := NewSecurityMiddleware(SecurityConfig{ReadOnly: true}).Wrap(next)
+
+	cases := []struct {
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{http.MethodGet, "/api/widgets", http.StatusOK},
+		{http.MethodHead, "/api/gpu/info", http.StatusOK},
+		{http.MethodPost, "/api/widgets", http.StatusForbidden},
+		{http.MethodPost, "/api/gpu/processes/1234/kill", http.StatusForbidden},
+		{http.MethodDelete, "/api/pages", http.StatusForbidden},
+		{http.MethodPut, "/api/pages/name", http.StatusForbidden},
+		// /api 밖의 경로(프론트엔드 등)는 읽기 전용 검사 대상이 아님
+		{http.MethodPost, "/index.html", http.StatusOK},
+	}
+	for _, c := range cases {
+		t.Run(c.method+"_"+c.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(c.method, c.path, nil))
+			if rec.Code != c.expectedStatus {
+				t.Errorf("Expected status %d, got %d", c.expectedStatus, rec.Code)
+			}
+			if strings.HasPrefix(c.path, "/api/") && rec.Header().Get("X-Read-Only") != "true" {
+				t.Error("Expected X-Read-Only header on /api responses")
+			}
+		})
+	}
+}
+
+func TestSecurityMiddlewareBodyLimit(t *testing.T) {
+	// 본문을 끝까지 읽고, 상한을 넘으면 413으로 응답하는 핸들러
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := NewSecurityMiddleware(SecurityConfig{MaxBodyBytes: 16}).Wrap(next)
+
+	cases := []struct {
+		name           string
+		body           string
+		unknownLength  bool
+		expectedStatus int
+	}{
+		{"Within_Limit", strings.Repeat("x", 16), false, http.StatusOK},
+		{"Declared_Length_Over_Limit", strings.Repeat("x", 17), false, http.StatusRequestEntityTooLarge},
+		// Content-Length 없이 보낸 본문은 읽는 도중 상한에서 끊김
+		{"Streamed_Body_Over_Limit", strings.Repeat("x", 64), true, http.StatusRequestEntityTooLarge},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/widgets", strings.NewReader(c.body))
+			if c.unknownLength {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != c.expectedStatus {
+				t.Errorf("Expected status %d, got %d", c.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"monitoring-app/websockets"
+)
+
+// authorizedHub는 WebSocket 허브와 토큰을 확인합니다. 실패하면 응답을 쓰고 nil을 반환합니다.
+// WebSocket 토큰을 설정한 경우 같은 토큰(Authorization: Bearer 또는 ?token=)이 필요합니다.
+func (h *Handler) authorizedHub(w http.ResponseWriter, r *http.Request) *websockets.Hub {
+	if h.WebSockets == nil {
+		http.Error(w, "WebSocket hub not available", http.StatusServiceUnavailable)
+		return nil
+	}
+	if !h.WebSockets.Authorized(r) {
+		http.Error(w, "invalid or missing WebSocket token", http.StatusUnauthorized)
+		return nil
+	}
+	return h.WebSockets
+}
+
+// GetWebSocketClientsHandler는 연결된 WebSocket 클라이언트 목록(구독 토픽, 전송률 포함)을 반환합니다.
+func (h *Handler) GetWebSocketClientsHandler(w http.ResponseWriter, r *http.Request) {
+	hub := h.authorizedHub(w, r)
+	if hub == nil {
+		return
+	}
+
+	clients := hub.Clients()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"count":   len(clients),
+		"clients": clients,
+	})
+}
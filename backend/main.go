@@ -3,6 +3,7 @@ package main
 import (
 	"embed"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"io/fs"
@@ -14,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	_ "modernc.org/sqlite" // SQLite 드라이버를 modernc.org/sqlite로 변경
@@ -42,6 +44,12 @@ type Config struct {
 		AutoOpenBrowser bool   `json:"auto_open_browser"`
 		Theme           string `json:"theme"`
 	} `json:"ui"`
+	Security    api.SecurityConfig    `json:"security"`
+	Compression api.CompressionConfig `json:"compression"`
+	Recording   struct {
+		Directory          string `json:"directory"`
+		MaxDurationSeconds int    `json:"max_duration_seconds"`
+	} `json:"recording"`
 }
 
 // Default configuration
@@ -79,6 +87,15 @@ func getDefaultConfig() Config {
 			AutoOpenBrowser: false,
 			Theme:           "system",
 		},
+		Security:    api.DefaultSecurityConfig(),
+		Compression: api.DefaultCompressionConfig(),
+		Recording: struct {
+			Directory          string `json:"directory"`
+			MaxDurationSeconds int    `json:"max_duration_seconds"`
+		}{
+			Directory:          "recordings",
+			MaxDurationSeconds: 3600,
+		},
 	}
 }
 
@@ -113,7 +130,8 @@ func loadConfig() Config {
 		return getDefaultConfig()
 	}
 
-	var config Config
+	// 설정 파일에 없는 항목(예: 이전 버전 파일의 security)은 기본값 유지
+	config := getDefaultConfig()
 	err = json.Unmarshal(configData, &config)
 	if err != nil {
 		log.Printf("Error parsing config file: %v", err)
@@ -125,6 +143,9 @@ func loadConfig() Config {
 }
 
 func main() {
+	demo := flag.Bool("demo", false, "실제 하드웨어 대신 합성 메트릭을 생성 (프론트엔드 개발, 스크린샷, GPU 없는 CI용)")
+	flag.Parse()
+
 	// Load configuration
 	config := loadConfig()
 
@@ -145,17 +166,30 @@ func main() {
 	log.Println("Database connection successful.")
 
 	// --- WebSocket and Monitoring Setup ---
-	hub := websockets.NewHub()
+	wsOptions := config.Security.WebSocketOptions()
+	wsOptions.Compression = config.Compression.WebSocket
+	wsOptions.CompressionLevel = config.Compression.Level
+	wsOptions.RecordingDir = config.Recording.Directory
+	wsOptions.MaxRecordingDuration = time.Duration(config.Recording.MaxDurationSeconds) * time.Second
+	hub := websockets.NewHub(wsOptions)
 
 	// 채널 생성
 	wsChan := make(chan *monitoring.ResourceSnapshot)
 	dbChan := make(chan *monitoring.ResourceSnapshot)
-	_ = wsChan
 	_ = dbChan
 
+	// Hub는 스냅샷이나 연결 요청이 없으면 대기만 하므로 CPU를 쓰지 않음 (/ws 연결과 녹화 재생에 필요)
+	go hub.Run(wsChan)
+
+	// 데모 모드: 하드웨어를 조회하지 않는 합성 메트릭 생성기만 실행
+	// 모든 클라이언트가 일시정지하고 녹화 중이 아니면 생성을 건너뜀
+	if *demo {
+		monitoring.EnableDemoMode()
+		go monitoring.StartDemo(wsChan, time.Duration(config.Monitoring.IntervalSeconds)*time.Second, hub.NeedsSnapshots)
+	}
+
 	// CPU 최적화 Phase 5.1: 백그라운드 고루틴 완전 비활성화
-	// 허브 및 모니터링 시작 - 비활성화됨
-	// go hub.Run(wsChan)                           // CPU 소모 방지: WebSocket Hub 비활성화
+	// 모니터링 시작 - 비활성화됨
 	// go monitoring.Start(wsChan, dbChan)          // CPU 소모 방지: 2초마다 모니터링 비활성화
 
 	// DB로 데이터 전송 - 비활성화됨
@@ -163,11 +197,17 @@ func main() {
 
 	log.Println("CPU 최적화: 모든 백그라운드 모니터링 프로세스 비활성화됨")
 
+	// GPU 정적 정보(드라이버, CUDA 버전 등)는 시작 시 한 번만 수집 (데모 모드는 합성 정보 사용)
+	if !*demo {
+		go monitoring.GetGPUStaticInfo()
+	}
+
 	// --- HTTP Server Setup ---
 	r := mux.NewRouter()
 
 	// API 핸들러에 DB 의존성 주입
 	apiHandler := api.NewHandler(database)
+	apiHandler.WebSockets = hub
 
 	r.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		websockets.ServeWs(hub, w, r)
@@ -183,7 +223,14 @@ func main() {
 	log.Printf("HTTP server starting on %s", serverAddr)
 	log.Println("Frontend files embedded in binary - no external dependencies required")
 	log.Printf("Configuration: Port=%d, Database=%s", config.Server.Port, config.Database.Filename)
-	if err := http.ListenAndServe(serverAddr, r); err != nil {
+	log.Printf("Request limits: %d req/min per IP, body %d bytes, CORS origins %v, read-only %v",
+		config.Security.RateLimitPerMinute, config.Security.MaxBodyBytes, config.Security.AllowedOrigins, config.Security.ReadOnly)
+	log.Printf("WebSocket limits: %d clients, %d per IP, token required: %v",
+		config.Security.MaxWebSocketClients, config.Security.MaxWebSocketClientsPerIP, config.Security.WebSocketToken != "")
+	log.Printf("Compression: gzip %v (min %d bytes), WebSocket deflate %v",
+		config.Compression.Enabled, config.Compression.MinSizeBytes, config.Compression.WebSocket)
+	handler := api.NewCompressionMiddleware(config.Compression).Wrap(r)
+	if err := http.ListenAndServe(serverAddr, api.NewSecurityMiddleware(config.Security).Wrap(handler)); err != nil {
 		log.Fatalf("could not start server: %v\n", err)
 	}
 }
@@ -0,0 +1,275 @@
+package monitoring
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 데모 모드 (--demo)
+// 실제 하드웨어를 조회하지 않고 그럴듯한 합성 메트릭을 생성
+// - CPU: 주기 1분의 사인파 + 잡음, 코어별 위상 차이
+// - GPU: 낮은 기본 사용률에 무작위 스파이크 (메모리/온도/전력이 사용률을 따라감)
+// - 가짜 프로세스와 GPU 프로세스 (프로세스 제어 API는 데모 모드에서 거부)
+// 프론트엔드 개발, 스크린샷, GPU가 없는 CI 환경에서 사용
+
+const (
+	demoCoreCount      = 8
+	demoGPUMemoryTotal = 8192.0 // MB
+	demoDiskTotal      = 512 * 1024 * 1024 * 1024.0
+	demoBaseUptime     = 3 * 24 * 3600.0 // 3일째 실행 중인 것처럼 표시
+)
+
+var (
+	demoMode      atomic.Bool
+	demoMu        sync.Mutex
+	demoGenerator *DemoGenerator
+)
+
+// 데모 프로세스 목록 (PID는 실제 프로세스와 겹치지 않도록 큰 값 사용)
+var demoProcesses = []struct {
+	name string
+	pid  int32
+	cpu  float64
+	mem  float64
+}{
+	{"chrome.exe", 900101, 12, 8.5},
+	{"code.exe", 900102, 7, 6.2},
+	{"blender.exe", 900103, 18, 11.4},
+	{"python.exe", 900104, 9, 4.1},
+	{"obs64.exe", 900105, 5, 3.3},
+}
+
+var demoGPUProcesses = []struct {
+	name    string
+	pid     int32
+	memory  float64
+	kind    string
+	command string
+}{
+	{"blender.exe", 900103, 2100, "C+G", "blender.exe --render scene.blend"},
+	{"python.exe", 900104, 1600, "C", "python.exe train.py --epochs 10"},
+	{"obs64.exe", 900105, 420, "G", "obs64.exe"},
+}
+
+// EnableDemoMode는 데모 모드를 켭니다. 이후 GPU 정보 조회는 합성 데이터를 반환합니다.
+func EnableDemoMode() {
+	demoMu.Lock()
+	defer demoMu.Unlock()
+	if demoGenerator == nil {
+		demoGenerator = NewDemoGenerator(time.Now().UnixNano())
+	}
+	demoMode.Store(true)
+}
+
+// IsDemoMode는 데모 모드 여부를 반환합니다.
+func IsDemoMode() bool {
+	return demoMode.Load()
+}
+
+// DemoGenerator는 합성 메트릭 생성기입니다. 같은 시드는 같은 데이터를 만듭니다.
+type DemoGenerator struct {
+	rng       *rand.Rand
+	startedAt time.Time
+	tick      int
+
+	gpuSpikeTicks  int     // 남은 스파이크 길이
+	gpuSpikeLevel  float64 // 스파이크 중 사용률
+	gpuTemperature float64
+	battery        float64
+	lastGPU        GPUInfo
+}
+
+// NewDemoGenerator는 시드로 생성기를 만듭니다.
+func NewDemoGenerator(seed int64) *DemoGenerator {
+	return &DemoGenerator{
+		rng:            rand.New(rand.NewSource(seed)),
+		startedAt:      time.Now(),
+		gpuTemperature: 42,
+		battery:        86,
+	}
+}
+
+// noise는 -amplitude ~ amplitude 범위의 잡음을 반환합니다.
+func (g *DemoGenerator) noise(amplitude float64) float64 {
+	return (g.rng.Float64()*2 - 1) * amplitude
+}
+
+// clampPercent는 값을 0 ~ 100으로 제한합니다.
+func clampPercent(value float64) float64 {
+	return math.Max(0, math.Min(100, value))
+}
+
+// nextGPU는 스파이크 상태를 진행하고 GPU 사용량을 계산합니다.
+func (g *DemoGenerator) nextGPU() GPUInfo {
+	if g.gpuSpikeTicks > 0 {
+		g.gpuSpikeTicks--
+	} else if g.rng.Float64() < 0.08 {
+		g.gpuSpikeTicks = 3 + g.rng.Intn(6)
+		g.gpuSpikeLevel = 80 + g.rng.Float64()*19
+	}
+
+	usage := 8 + g.rng.Float64()*10
+	if g.gpuSpikeTicks > 0 {
+		usage = g.gpuSpikeLevel + g.noise(3)
+	}
+	usage = clampPercent(usage)
+
+	// 온도는 목표값을 천천히 따라감
+	target := 38 + usage*0.45
+	g.gpuTemperature += (target - g.gpuTemperature) * 0.3
+
+	g.lastGPU = GPUInfo{
+		Name:        "HWnow Demo GPU 8GB",
+		Usage:       usage,
+		MemoryUsed:  math.Round(900 + usage/100*5200 + g.noise(60)),
+		MemoryTotal: demoGPUMemoryTotal,
+		Temperature: math.Round(g.gpuTemperature*10) / 10,
+		Power:       math.Round((25+usage*1.8+g.noise(4))*10) / 10,
+	}
+	return g.lastGPU
+}
+
+// Next는 수집기와 같은 메트릭 타입으로 구성한 다음 스냅샷을 생성합니다.
+func (g *DemoGenerator) Next(now time.Time) *ResourceSnapshot {
+	g.tick++
+	elapsed := now.Sub(g.startedAt).Seconds()
+	var metrics []Metric
+
+	// CPU 정보 (수집기와 같이 처음 10회 + 이후 15회마다)
+	sendInfo := g.tick <= 10 || g.tick%15 == 0
+	if sendInfo {
+		metrics = append(metrics, Metric{Type: "cpu_info", Value: demoCoreCount, Info: "HWnow Demo CPU @ 3.60GHz"})
+	}
+
+	cpu := clampPercent(35 + 25*math.Sin(2*math.Pi*elapsed/60) + g.noise(5))
+	metrics = append(metrics, Metric{Type: "cpu", Value: cpu})
+	for core := 1; core <= demoCoreCount; core++ {
+		phase := float64(core) * math.Pi / demoCoreCount
+		metrics = append(metrics, Metric{
+			Type:  fmt.Sprintf("cpu_core_%d", core),
+			Value: clampPercent(cpu + 15*math.Sin(2*math.Pi*elapsed/20+phase) + g.noise(8)),
+		})
+	}
+
+	ram := clampPercent(55 + 10*math.Sin(2*math.Pi*elapsed/300) + g.noise(1))
+	metrics = append(metrics,
+		Metric{Type: "ram", Value: ram},
+		Metric{Type: "disk_read", Value: g.burst(2e6, 80e6)},
+		Metric{Type: "disk_write", Value: g.burst(1e6, 40e6)},
+		Metric{Type: "net_sent", Value: g.burst(50e3, 5e6)},
+		Metric{Type: "net_recv", Value: g.burst(200e3, 20e6)},
+		Metric{Type: "system_uptime", Value: math.Floor(demoBaseUptime + elapsed)},
+		Metric{Type: "disk_total", Value: demoDiskTotal},
+		Metric{Type: "disk_used", Value: demoDiskTotal * 0.62},
+		Metric{Type: "disk_free", Value: demoDiskTotal * 0.38},
+		Metric{Type: "disk_usage_percent", Value: 62},
+		Metric{Type: "memory_physical", Value: ram},
+		Metric{Type: "memory_virtual", Value: ram},
+		Metric{Type: "memory_swap", Value: clampPercent(12 + g.noise(2))},
+		Metric{Type: "network_Ethernet_status", Value: 1, Info: "192.168.0.42"},
+	)
+
+	// 프로세스 목록 (수집기와 같이 5회마다)
+	if g.tick%5 == 0 {
+		for i, proc := range demoProcesses {
+			metrics = append(metrics, Metric{
+				Type:  fmt.Sprintf("process_%d", i),
+				Value: math.Max(0, proc.cpu+g.noise(proc.cpu/2)),
+				Info:  fmt.Sprintf("%s|%d|%.1f", proc.name, proc.pid, proc.mem),
+			})
+		}
+	}
+
+	gpu := g.nextGPU()
+	if g.tick%5 == 0 {
+		for i, proc := range demoGPUProcesses {
+			share := gpu.Usage * float64(len(demoGPUProcesses)-i) / 6
+			metrics = append(metrics, Metric{
+				Type:  fmt.Sprintf("gpu_process_%d", i),
+				Value: math.Round(share*10) / 10,
+				Info:  fmt.Sprintf("%s|%d|%.1f|%s|%s|%s", proc.name, proc.pid, proc.memory, proc.kind, proc.command, "running"),
+			})
+		}
+	}
+
+	// 배터리는 천천히 방전되다가 20%에서 다시 충전된 것으로 표시
+	if g.battery -= 0.05; g.battery < 20 {
+		g.battery = 100
+	}
+	metrics = append(metrics,
+		Metric{Type: "battery_percent", Value: math.Round(g.battery)},
+		Metric{Type: "battery_plugged", Value: 0},
+		Metric{Type: "gpu_usage", Value: gpu.Usage},
+		Metric{Type: "gpu_memory_used", Value: gpu.MemoryUsed},
+		Metric{Type: "gpu_memory_total", Value: gpu.MemoryTotal},
+		Metric{Type: "gpu_temperature", Value: gpu.Temperature},
+		Metric{Type: "gpu_power", Value: gpu.Power},
+	)
+	if sendInfo {
+		metrics = append(metrics, Metric{Type: "gpu_info", Value: 1.0, Info: gpu.Name})
+	}
+
+	return &ResourceSnapshot{Timestamp: now, Metrics: metrics}
+}
+
+// burst는 평소에는 base 근처, 가끔 peak까지 치솟는 처리량(bytes/s)을 반환합니다.
+func (g *DemoGenerator) burst(base, peak float64) float64 {
+	if g.rng.Float64() < 0.1 {
+		return math.Round(peak * (0.5 + g.rng.Float64()*0.5))
+	}
+	return math.Round(base * (0.5 + g.rng.Float64()))
+}
+
+// StartDemo는 interval마다 합성 스냅샷을 wsChan으로 보냅니다 (실제 하드웨어는 조회하지 않음).
+// shouldGenerate가 false를 반환하는 동안(예: 모든 클라이언트가 일시정지)에는 스냅샷을 만들지 않습니다. nil이면 항상 생성합니다.
+func StartDemo(wsChan chan<- *ResourceSnapshot, interval time.Duration, shouldGenerate func() bool) {
+	EnableDemoMode()
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	log.Printf("Demo mode: generating synthetic metrics every %v", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		if shouldGenerate != nil && !shouldGenerate() {
+			continue
+		}
+		demoMu.Lock()
+		snapshot := demoGenerator.Next(now)
+		demoMu.Unlock()
+		wsChan <- snapshot
+	}
+}
+
+// demoGPUInfo는 데모 생성기의 마지막 GPU 상태를 반환합니다.
+func demoGPUInfo() *GPUInfo {
+	demoMu.Lock()
+	defer demoMu.Unlock()
+	if demoGenerator.lastGPU.Name == "" {
+		demoGenerator.nextGPU()
+	}
+	info := demoGenerator.lastGPU
+	return &info
+}
+
+// demoGPUStaticInfo는 데모 GPU의 정적 정보를 반환합니다.
+func demoGPUStaticInfo() []GPUStaticInfo {
+	return []GPUStaticInfo{{
+		Index:             0,
+		Name:              "HWnow Demo GPU 8GB",
+		Vendor:            "Demo",
+		UUID:              "GPU-00000000-0000-0000-0000-000000000000",
+		DriverVersion:     "555.55",
+		CUDAVersion:       "12.5",
+		PCIeGeneration:    4,
+		PCIeWidth:         16,
+		PCIeGenerationMax: 4,
+		PCIeWidthMax:      16,
+	}}
+}
@@ -0,0 +1,29 @@
+package monitoring
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStartDemoSkipsWhenNotNeeded(t *testing.T) {
+	var needed atomic.Bool
+	snapshots := make(chan *ResourceSnapshot)
+	go StartDemo(snapshots, 5*time.Millisecond, needed.Load)
+
+	select {
+	case <-snapshots:
+		t.Fatal("Expected no snapshots while nobody needs them")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	needed.Store(true)
+	select {
+	case snapshot := <-snapshots:
+		if len(snapshot.Metrics) == 0 {
+			t.Error("Expected a snapshot with metrics")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a snapshot once it is needed")
+	}
+}
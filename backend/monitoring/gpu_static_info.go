@@ -0,0 +1,119 @@
+package monitoring
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GPUStaticInfo는 실행 중 변하지 않는 GPU 환경 정보(드라이버, VBIOS, CUDA, PCIe 링크, UUID)입니다.
+type GPUStaticInfo struct {
+	Index             int    `json:"index"`
+	Name              string `json:"name"`
+	Vendor            string `json:"vendor"`
+	UUID              string `json:"uuid,omitempty"`
+	DriverVersion     string `json:"driver_version,omitempty"`
+	VBIOSVersion      string `json:"vbios_version,omitempty"`
+	CUDAVersion       string `json:"cuda_version,omitempty"`
+	PCIeGeneration    int    `json:"pcie_generation,omitempty"` // 현재 링크 세대
+	PCIeWidth         int    `json:"pcie_width,omitempty"`      // 현재 링크 폭 (x16 = 16)
+	PCIeGenerationMax int    `json:"pcie_generation_max,omitempty"`
+	PCIeWidthMax      int    `json:"pcie_width_max,omitempty"`
+}
+
+var (
+	gpuStaticOnce sync.Once
+	gpuStaticInfo []GPUStaticInfo
+	gpuStaticErr  error
+
+	cudaVersionPattern = regexp.MustCompile(`CUDA Version:\s*([0-9.]+)`)
+)
+
+// GetGPUStaticInfo는 GPU 정적 정보를 반환합니다. 최초 호출 시 한 번만 수집합니다.
+func GetGPUStaticInfo() ([]GPUStaticInfo, error) {
+	if IsDemoMode() {
+		return demoGPUStaticInfo(), nil
+	}
+	gpuStaticOnce.Do(func() {
+		gpuStaticInfo, gpuStaticErr = getNVIDIAStaticInfo()
+		if gpuStaticErr != nil {
+			log.Printf("GPU static info not available: %v", gpuStaticErr)
+		} else {
+			log.Printf("GPU static info collected for %d GPU(s)", len(gpuStaticInfo))
+		}
+	})
+
+	if gpuStaticErr != nil {
+		return nil, gpuStaticErr
+	}
+	return append([]GPUStaticInfo(nil), gpuStaticInfo...), nil
+}
+
+// GetCurrentGPUInfo는 현재 GPU 사용량 정보를 반환합니다.
+func GetCurrentGPUInfo() (*GPUInfo, error) {
+	if IsDemoMode() {
+		return demoGPUInfo(), nil
+	}
+	return getGPUInfo()
+}
+
+func getNVIDIAStaticInfo() ([]GPUStaticInfo, error) {
+	cmd := exec.Command("nvidia-smi",
+		"--query-gpu=index,name,uuid,driver_version,vbios_version,pcie.link.gen.current,pcie.link.width.current,pcie.link.gen.max,pcie.link.width.max",
+		"--format=csv,noheader,nounits")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("nvidia-smi not available: %v", err)
+	}
+
+	var info []GPUStaticInfo
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) < 9 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+			// 지원되지 않는 필드는 "[N/A]" 또는 "[Not Supported]"로 출력됨
+			if strings.HasPrefix(fields[i], "[") {
+				fields[i] = ""
+			}
+		}
+
+		index, _ := strconv.Atoi(fields[0])
+		pcieGen, _ := strconv.Atoi(fields[5])
+		pcieWidth, _ := strconv.Atoi(fields[6])
+		pcieGenMax, _ := strconv.Atoi(fields[7])
+		pcieWidthMax, _ := strconv.Atoi(fields[8])
+		info = append(info, GPUStaticInfo{
+			Index:             index,
+			Name:              fields[1],
+			Vendor:            "NVIDIA",
+			UUID:              fields[2],
+			DriverVersion:     fields[3],
+			VBIOSVersion:      fields[4],
+			PCIeGeneration:    pcieGen,
+			PCIeWidth:         pcieWidth,
+			PCIeGenerationMax: pcieGenMax,
+			PCIeWidthMax:      pcieWidthMax,
+		})
+	}
+	if len(info) == 0 {
+		return nil, fmt.Errorf("unexpected nvidia-smi output format")
+	}
+
+	// CUDA 버전은 --query-gpu 필드로 제공되지 않아 기본 출력 헤더에서 추출
+	if header, err := exec.Command("nvidia-smi").Output(); err == nil {
+		if match := cudaVersionPattern.FindStringSubmatch(string(header)); len(match) == 2 {
+			for i := range info {
+				info[i].CUDAVersion = match[1]
+			}
+		}
+	}
+
+	return info, nil
+}
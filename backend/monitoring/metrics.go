@@ -6,15 +6,16 @@ import (
 
 // Metric은 단일 모니터링 지표를 나타냅니다.
 type Metric struct {
-	Type  string
-	Value float64
-	Info  string // CPU 모델명 등 추가 정보
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+	Info  string  `json:"info,omitempty"` // CPU 모델명 등 추가 정보
 }
 
 // ResourceSnapshot은 특정 시점의 모든 자원 사용량 스냅샷입니다.
+// JSON 형식은 스냅샷 녹화 파일과 /api/snapshot 응답에 사용됩니다.
 type ResourceSnapshot struct {
-	Timestamp time.Time
-	Metrics   []Metric
+	Timestamp time.Time `json:"timestamp"`
+	Metrics   []Metric  `json:"metrics"`
 }
 
 // 추가된 데이터 구조들
@@ -8,6 +8,11 @@ import (
 	"strings"
 )
 
+// GetProcessName은 PID로부터 프로세스 이름을 반환합니다 (API 응답용).
+func GetProcessName(pid int32) string {
+	return getProcessName(pid)
+}
+
 // getProcessName은 PID로부터 프로세스 이름을 가져옵니다.
 func getProcessName(pid int32) string {
 	if runtime.GOOS == "windows" {
@@ -1,8 +1,11 @@
 package websockets
 
 import (
+	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -15,22 +18,37 @@ const (
 	maxMessageSize = 512
 )
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		// 개발 중에는 모든 Origin을 허용합니다.
-		return true
-	},
-}
-
 // Client는 Hub와 WebSocket 연결 사이의 중개자 역할을 합니다.
 type Client struct {
-	hub  *Hub
-	conn *websocket.Conn
-	send chan []byte
+	hub      *Hub
+	conn     *websocket.Conn
+	send     chan []byte
+	encoding string   // EncodingJSON 또는 EncodingMsgPack
+	topics   []string // 구독한 메트릭 타입 접두사 (비어 있으면 전체)
+	paused   atomic.Bool
+
+	// 관리 API에 표시되는 연결 정보와 전송 통계
+	id           uint64
+	remoteAddr   string
+	origin       string
+	userAgent    string
+	connectedAt  time.Time
+	messagesSent atomic.Uint64
+	bytesSent    atomic.Uint64
 }
 
+// controlMessage는 클라이언트가 보내는 세션 제어 메시지입니다.
+// 예: {"type": "control", "action": "pause"} / {"type": "control", "action": "resume"}
+type controlMessage struct {
+	Type   string `json:"type"`
+	Action string `json:"action"`
+}
+
+const (
+	controlActionPause  = "pause"
+	controlActionResume = "resume"
+)
+
 // writePump는 Hub로부터 받은 메시지를 WebSocket 연결로 전송합니다.
 func (c *Client) writePump() {
 	ticker := time.NewTicker(pingPeriod)
@@ -47,7 +65,11 @@ func (c *Client) writePump() {
 				return
 			}
 
-			w, err := c.conn.NextWriter(websocket.TextMessage)
+			messageType := websocket.TextMessage
+			if c.encoding == EncodingMsgPack {
+				messageType = websocket.BinaryMessage
+			}
+			w, err := c.conn.NextWriter(messageType)
 			if err != nil {
 				return
 			}
@@ -65,9 +87,10 @@ func (c *Client) writePump() {
 	}
 }
 
-// readPump는 WebSocket 연결로부터 메시지를 읽어 Hub로 전달합니다 (현재는 사용하지 않음).
+// readPump는 WebSocket 연결로부터 제어 메시지(일시정지/재개)를 읽어 Hub로 전달합니다.
 func (c *Client) readPump() {
 	defer func() {
+		c.hub.release(c)
 		c.hub.unregister <- c
 		c.conn.Close()
 	}()
@@ -75,24 +98,73 @@ func (c *Client) readPump() {
 	c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.conn.SetPongHandler(func(string) error { c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
 	for {
-		_, _, err := c.conn.ReadMessage()
+		_, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("error: %v", err)
 			}
 			break
 		}
+
+		var control controlMessage
+		if err := json.Unmarshal(message, &control); err != nil || control.Type != "control" {
+			continue
+		}
+		switch control.Action {
+		case controlActionPause, controlActionResume:
+			c.hub.control <- clientControl{client: c, paused: control.Action == controlActionPause}
+		default:
+			log.Printf("Unknown WebSocket control action: %s", control.Action)
+		}
 	}
 }
 
 // ServeWs는 HTTP 연결을 WebSocket 연결로 업그레이드하고 클라이언트를 처리합니다.
+// 쿼리 파라미터 encoding=msgpack 으로 바이너리 스냅샷 프레임을, topics=cpu,gpu 로 받을 메트릭을 선택할 수 있습니다.
+// Origin, 토큰, 연결 수 제한은 Hub의 ServerOptions를 따릅니다.
 func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request) {
-	conn, err := upgrader.Upgrade(w, r, nil)
+	encoding, err := negotiateEncoding(r)
 	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !hub.Authorized(r) {
+		http.Error(w, "invalid or missing WebSocket token", http.StatusUnauthorized)
+		return
+	}
+
+	client := &Client{
+		hub:         hub,
+		send:        make(chan []byte, 256),
+		encoding:    encoding,
+		topics:      parseTopics(r),
+		remoteAddr:  hub.clientIP(r),
+		origin:      r.Header.Get("Origin"),
+		userAgent:   r.UserAgent(),
+		connectedAt: time.Now(),
+	}
+	if err := hub.reserve(client); err != nil {
+		status := http.StatusServiceUnavailable
+		if errors.Is(err, errTooManyClientsPerIP) {
+			status = http.StatusTooManyRequests
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	// Origin이 허용되지 않으면 Upgrade가 403으로 응답
+	conn, err := hub.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		hub.release(client)
 		log.Println(err)
 		return
 	}
-	client := &Client{hub: hub, conn: conn, send: make(chan []byte, 256)}
+	if hub.options.Compression && hub.options.CompressionLevel != 0 {
+		if err := conn.SetCompressionLevel(hub.options.CompressionLevel); err != nil {
+			log.Printf("Invalid WebSocket compression level %d: %v", hub.options.CompressionLevel, err)
+		}
+	}
+	client.conn = conn
 	client.hub.register <- client
 
 	go client.writePump()
@@ -0,0 +1,193 @@
+package websockets
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WebSocket 연결 허용 조건과 연결된 클라이언트 목록
+// - Origin: 같은 호스트 또는 AllowedOrigins에 있는 출처만 허용 (Origin 헤더가 없는 비브라우저 클라이언트는 허용)
+// - 토큰: Token을 설정하면 ?token= 또는 Authorization: Bearer 헤더가 일치해야 연결/관리 API 사용 가능
+// - 연결 수 제한: 전체 및 IP별 최대 연결 수
+// - 토픽: ?topics=cpu,gpu 처럼 메트릭 타입 접두사로 받을 메트릭을 제한 (생략 시 전체)
+// - 압축: Compression을 설정하면 permessage-deflate 확장을 협상
+
+const (
+	tokenQueryParam  = "token"
+	topicsQueryParam = "topics"
+)
+
+var (
+	errTooManyClients      = errors.New("too many WebSocket clients")
+	errTooManyClientsPerIP = errors.New("too many WebSocket clients from this address")
+)
+
+// ServerOptions는 WebSocket 연결 허용 조건입니다.
+type ServerOptions struct {
+	AllowedOrigins    []string // 같은 호스트 외에 허용할 출처 ("*" = 모든 출처)
+	Token             string   // 비어 있으면 인증 없음
+	MaxClients        int      // 전체 최대 연결 수 (0 = 제한 없음)
+	MaxClientsPerIP   int      // IP별 최대 연결 수 (0 = 제한 없음)
+	TrustProxyHeaders bool     // 역방향 프록시 뒤에서 X-Forwarded-For로 IP 판별
+	Compression       bool     // permessage-deflate 협상 (클라이언트가 지원하는 경우에만 적용)
+	CompressionLevel  int      // flate 압축 수준 (-2 ~ 9, 0이면 라이브러리 기본값 유지)
+
+	RecordingDir         string        // 스냅샷 녹화 파일 디렉터리 (빈 값 = recordings)
+	MaxRecordingDuration time.Duration // 녹화 한 번의 최대 길이 (0 = 1시간)
+}
+
+// ClientInfo는 관리 API에 표시되는 연결된 클라이언트 정보입니다.
+type ClientInfo struct {
+	ID                uint64    `json:"id"`
+	RemoteAddr        string    `json:"remote_addr"`
+	Origin            string    `json:"origin,omitempty"`
+	UserAgent         string    `json:"user_agent,omitempty"`
+	Encoding          string    `json:"encoding"`
+	Topics            []string  `json:"topics"` // 빈 배열 = 모든 메트릭
+	Paused            bool      `json:"paused"`
+	ConnectedAt       time.Time `json:"connected_at"`
+	MessagesSent      uint64    `json:"messages_sent"`
+	BytesSent         uint64    `json:"bytes_sent"`
+	MessagesPerSecond float64   `json:"messages_per_second"` // 연결 이후 평균
+	BytesPerSecond    float64   `json:"bytes_per_second"`
+	QueuedMessages    int       `json:"queued_messages"` // 송신 버퍼에 대기 중인 메시지
+}
+
+// Authorized는 토큰이 설정되지 않았거나 요청의 토큰이 일치하면 true를 반환합니다.
+func (h *Hub) Authorized(r *http.Request) bool {
+	if h.options.Token == "" {
+		return true
+	}
+	token := r.URL.Query().Get(tokenQueryParam)
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		token = strings.TrimSpace(bearer)
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.options.Token)) == 1
+}
+
+// checkOrigin은 같은 호스트이거나 허용 목록에 있는 출처인지 확인합니다.
+func (h *Hub) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, candidate := range h.options.AllowedOrigins {
+		if candidate == "*" || strings.EqualFold(strings.TrimRight(candidate, "/"), origin) {
+			return true
+		}
+	}
+	parsed, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(parsed.Host, r.Host)
+}
+
+// clientIP는 요청자 IP를 반환합니다. 프록시 헤더는 설정으로 허용한 경우에만 신뢰합니다.
+func (h *Hub) clientIP(r *http.Request) string {
+	if h.options.TrustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// reserve는 연결 수 제한을 확인하고 클라이언트를 연결 목록에 추가합니다.
+func (h *Hub) reserve(client *Client) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.options.MaxClients > 0 && len(h.connected) >= h.options.MaxClients {
+		return errTooManyClients
+	}
+	if h.options.MaxClientsPerIP > 0 && h.perIP[client.remoteAddr] >= h.options.MaxClientsPerIP {
+		return errTooManyClientsPerIP
+	}
+	h.nextClientID++
+	client.id = h.nextClientID
+	h.connected[client] = struct{}{}
+	h.perIP[client.remoteAddr]++
+	return nil
+}
+
+// release는 클라이언트를 연결 목록에서 제거합니다.
+func (h *Hub) release(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.connected[client]; !ok {
+		return
+	}
+	delete(h.connected, client)
+	if h.perIP[client.remoteAddr]--; h.perIP[client.remoteAddr] <= 0 {
+		delete(h.perIP, client.remoteAddr)
+	}
+}
+
+// Clients는 연결된 클라이언트 목록을 연결 순서대로 반환합니다.
+func (h *Hub) Clients() []ClientInfo {
+	h.mu.Lock()
+	clients := make([]*Client, 0, len(h.connected))
+	for client := range h.connected {
+		clients = append(clients, client)
+	}
+	h.mu.Unlock()
+
+	now := time.Now()
+	infos := make([]ClientInfo, 0, len(clients))
+	for _, client := range clients {
+		info := ClientInfo{
+			ID:             client.id,
+			RemoteAddr:     client.remoteAddr,
+			Origin:         client.origin,
+			UserAgent:      client.userAgent,
+			Encoding:       client.encoding,
+			Topics:         append([]string{}, client.topics...),
+			Paused:         client.paused.Load(),
+			ConnectedAt:    client.connectedAt,
+			MessagesSent:   client.messagesSent.Load(),
+			BytesSent:      client.bytesSent.Load(),
+			QueuedMessages: len(client.send),
+		}
+		if elapsed := now.Sub(client.connectedAt).Seconds(); elapsed > 0 {
+			info.MessagesPerSecond = float64(info.MessagesSent) / elapsed
+			info.BytesPerSecond = float64(info.BytesSent) / elapsed
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// parseTopics는 topics 쿼리 파라미터를 메트릭 타입 접두사 목록으로 변환합니다.
+func parseTopics(r *http.Request) []string {
+	var topics []string
+	for _, topic := range strings.Split(r.URL.Query().Get(topicsQueryParam), ",") {
+		if topic = strings.ToLower(strings.TrimSpace(topic)); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+// subscribes는 클라이언트가 해당 타입의 메트릭을 받는지 반환합니다.
+// 토픽은 메트릭 타입 자체이거나 "_"로 이어지는 접두사 (예: cpu → cpu, cpu_core_1)
+func (c *Client) subscribes(metricType string) bool {
+	if len(c.topics) == 0 {
+		return true
+	}
+	for _, topic := range c.topics {
+		if metricType == topic || strings.HasPrefix(metricType, topic+"_") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package websockets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"monitoring-app/monitoring"
+)
+
+func TestClientLimits(t *testing.T) {
+	// startServer는 Hub를 실행하고 /ws를 제공하는 테스트 서버를 시작함
+	startServer := func(t *testing.T, options ServerOptions) (*Hub, func(forwardedFor string) (*websocket.Conn, int)) {
+		hub := NewHub(options)
+		go hub.Run(make(chan *monitoring.ResourceSnapshot))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ServeWs(hub, w, r)
+		}))
+		t.Cleanup(server.Close)
+
+		dial := func(forwardedFor string) (*websocket.Conn, int) {
+			header := http.Header{}
+			if forwardedFor != "" {
+				header.Set("X-Forwarded-For", forwardedFor)
+			}
+			conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), header)
+			if err != nil {
+				if resp == nil {
+					t.Fatalf("Dial failed: %v", err)
+				}
+				return nil, resp.StatusCode
+			}
+			t.Cleanup(func() { conn.Close() })
+			return conn, resp.StatusCode
+		}
+		return hub, dial
+	}
+
+	t.Run("Max_Clients", func(t *testing.T) {
+		hub, dial := startServer(t, ServerOptions{MaxClients: 2})
+		dial("")
+		dial("")
+		if _, status := dial(""); status != http.StatusServiceUnavailable {
+			t.Errorf("Expected status %d over the limit, got %d", http.StatusServiceUnavailable, status)
+		}
+		if clients := hub.Clients(); len(clients) != 2 {
+			t.Errorf("Expected 2 listed clients, got %d", len(clients))
+		}
+	})
+
+	t.Run("Max_Clients_Per_IP", func(t *testing.T) {
+		_, dial := startServer(t, ServerOptions{MaxClientsPerIP: 1, TrustProxyHeaders: true})
+		dial("10.0.0.1")
+		if _, status := dial("10.0.0.1"); status != http.StatusTooManyRequests {
+			t.Errorf("Expected status %d for a second connection from the same IP, got %d", http.StatusTooManyRequests, status)
+		}
+		if conn, _ := dial("10.0.0.2"); conn == nil {
+			t.Error("Expected a connection from another IP to be accepted")
+		}
+	})
+
+	t.Run("Unregister_Updates_List", func(t *testing.T) {
+		hub, dial := startServer(t, ServerOptions{MaxClients: 1})
+		conn, _ := dial("")
+		clients := hub.Clients()
+		if len(clients) != 1 || clients[0].ID != 1 || clients[0].RemoteAddr != "127.0.0.1" {
+			t.Fatalf("Expected the connected client in the list, got %+v", clients)
+		}
+
+		conn.Close()
+		waitFor(t, func() bool { return len(hub.Clients()) == 0 }, "the client to leave the list")
+		// 해제된 자리는 새 연결이 사용할 수 있음
+		if conn, _ := dial(""); conn == nil {
+			t.Fatal("Expected a new connection once the previous client left")
+		}
+		if clients := hub.Clients(); len(clients) != 1 || clients[0].ID != 2 {
+			t.Errorf("Expected only the new client in the list, got %+v", clients)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package websockets
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+
+	"monitoring-app/monitoring"
+)
+
+// 스냅샷 프레임 인코딩 협상
+// /ws?encoding=msgpack 으로 연결하면 스냅샷 전체를 하나의 MessagePack 바이너리 프레임으로 전송합니다.
+// 기본값(json)은 기존과 동일하게 메트릭마다 JSON 텍스트 메시지를 전송합니다.
+
+const (
+	EncodingJSON    = "json"
+	EncodingMsgPack = "msgpack"
+
+	encodingQueryParam = "encoding"
+)
+
+// negotiateEncoding은 요청의 encoding 쿼리 파라미터에서 프레임 인코딩을 결정합니다.
+func negotiateEncoding(r *http.Request) (string, error) {
+	switch strings.ToLower(r.URL.Query().Get(encodingQueryParam)) {
+	case "", EncodingJSON:
+		return EncodingJSON, nil
+	case EncodingMsgPack, "messagepack":
+		return EncodingMsgPack, nil
+	default:
+		return "", fmt.Errorf("unsupported encoding: %s", r.URL.Query().Get(encodingQueryParam))
+	}
+}
+
+// metricMessage는 메트릭 하나의 JSON 메시지입니다 (토픽 필터링에 메트릭 타입 사용).
+type metricMessage struct {
+	metricType string
+	payload    []byte
+}
+
+// encodeJSONMessages는 스냅샷을 메트릭별 JSON 메시지로 변환합니다 (기존 프로토콜).
+func encodeJSONMessages(snapshot *monitoring.ResourceSnapshot) []metricMessage {
+	messages := make([]metricMessage, 0, len(snapshot.Metrics))
+	for _, metric := range snapshot.Metrics {
+		message, err := json.Marshal(WebSocketMessage{
+			Type: metric.Type,
+			Data: metricData{
+				Value: metric.Value,
+				Info:  metric.Info,
+			},
+		})
+		if err != nil {
+			log.Printf("Error marshalling metric data: %v", err)
+			continue
+		}
+		messages = append(messages, metricMessage{metricType: metric.Type, payload: message})
+	}
+	return messages
+}
+
+// encodeMsgPackSnapshot은 스냅샷 전체를 하나의 MessagePack 프레임으로 인코딩합니다.
+// 형식: {"type": "snapshot", "timestamp": <unix ms>, "data": [{"type", "value", "info"?}, ...]}
+func encodeMsgPackSnapshot(snapshot *monitoring.ResourceSnapshot) []byte {
+	buf := make([]byte, 0, 64+len(snapshot.Metrics)*48)
+
+	buf = appendMsgPackMapHeader(buf, 3)
+	buf = appendMsgPackString(buf, "type")
+	buf = appendMsgPackString(buf, "snapshot")
+	buf = appendMsgPackString(buf, "timestamp")
+	buf = appendMsgPackInt(buf, snapshot.Timestamp.UnixMilli())
+	buf = appendMsgPackString(buf, "data")
+	buf = appendMsgPackArrayHeader(buf, len(snapshot.Metrics))
+
+	for _, metric := range snapshot.Metrics {
+		fields := 2
+		if metric.Info != "" {
+			fields = 3
+		}
+		buf = appendMsgPackMapHeader(buf, fields)
+		buf = appendMsgPackString(buf, "type")
+		buf = appendMsgPackString(buf, metric.Type)
+		buf = appendMsgPackString(buf, "value")
+		buf = appendMsgPackFloat(buf, metric.Value)
+		if metric.Info != "" {
+			buf = appendMsgPackString(buf, "info")
+			buf = appendMsgPackString(buf, metric.Info)
+		}
+	}
+
+	return buf
+}
+
+// 최소한의 MessagePack 인코더 (스냅샷 프레임에 필요한 타입만 지원)
+
+func appendMsgPackMapHeader(buf []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(buf, 0x80|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buf, 0xde), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(buf, 0xdf), uint32(n))
+	}
+}
+
+func appendMsgPackArrayHeader(buf []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(buf, 0x90|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buf, 0xdc), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(buf, 0xdd), uint32(n))
+	}
+}
+
+func appendMsgPackString(buf []byte, s string) []byte {
+	n := len(s)
+	switch {
+	case n < 32:
+		buf = append(buf, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		buf = append(buf, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		buf = binary.BigEndian.AppendUint16(append(buf, 0xda), uint16(n))
+	default:
+		buf = binary.BigEndian.AppendUint32(append(buf, 0xdb), uint32(n))
+	}
+	return append(buf, s...)
+}
+
+func appendMsgPackInt(buf []byte, v int64) []byte {
+	if v >= 0 && v < 128 {
+		return append(buf, byte(v))
+	}
+	return binary.BigEndian.AppendUint64(append(buf, 0xd3), uint64(v))
+}
+
+func appendMsgPackFloat(buf []byte, v float64) []byte {
+	return binary.BigEndian.AppendUint64(append(buf, 0xcb), math.Float64bits(v))
+}
@@ -0,0 +1,168 @@
+package websockets
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"monitoring-app/monitoring"
+)
+
+// 인코더 출력을 참조 디코더(vmihailenco/msgpack)로 다시 읽어 형식 경계를 확인합니다.
+
+func TestMsgPackEncoding(t *testing.T) {
+	t.Run("String_Formats", func(t *testing.T) {
+		cases := []struct {
+			length int
+			prefix byte
+		}{
+			{0, 0xa0}, {31, 0xbf}, // fixstr
+			{32, 0xd9}, {255, 0xd9}, // str8
+			{256, 0xda}, {math.MaxUint16, 0xda}, // str16
+			{math.MaxUint16 + 1, 0xdb}, // str32
+		}
+		for _, c := range cases {
+			value := strings.Repeat("가", c.length/3) + strings.Repeat("x", c.length%3)
+			encoded := appendMsgPackString(nil, value)
+			if encoded[0] != c.prefix {
+				t.Errorf("Length %d: expected prefix 0x%02x, got 0x%02x", c.length, c.prefix, encoded[0])
+			}
+			var decoded string
+			if err := msgpack.Unmarshal(encoded, &decoded); err != nil || decoded != value {
+				t.Errorf("Length %d: round trip failed (len %d, err %v)", c.length, len(decoded), err)
+			}
+		}
+	})
+
+	t.Run("Map_Headers", func(t *testing.T) {
+		cases := []struct {
+			entries int
+			prefix  byte
+		}{
+			{0, 0x80}, {15, 0x8f}, // fixmap
+			{16, 0xde}, {math.MaxUint16, 0xde}, // map16
+			{math.MaxUint16 + 1, 0xdf}, // map32
+		}
+		for _, c := range cases {
+			encoded := appendMsgPackMapHeader(nil, c.entries)
+			if encoded[0] != c.prefix {
+				t.Errorf("%d entries: expected prefix 0x%02x, got 0x%02x", c.entries, c.prefix, encoded[0])
+			}
+			for i := 0; i < c.entries; i++ {
+				encoded = appendMsgPackString(encoded, fmt.Sprintf("k%d", i))
+				encoded = appendMsgPackInt(encoded, int64(i))
+			}
+			var decoded map[string]int64
+			if err := msgpack.Unmarshal(encoded, &decoded); err != nil || len(decoded) != c.entries {
+				t.Errorf("%d entries: expected a map of the same size, got %d (err %v)", c.entries, len(decoded), err)
+				continue
+			}
+			if last := c.entries - 1; last >= 0 && decoded[fmt.Sprintf("k%d", last)] != int64(last) {
+				t.Errorf("%d entries: unexpected last value %d", c.entries, decoded[fmt.Sprintf("k%d", last)])
+			}
+		}
+	})
+
+	t.Run("Array_Headers", func(t *testing.T) {
+		cases := []struct {
+			items  int
+			prefix byte
+		}{
+			{0, 0x90}, {15, 0x9f}, // fixarray
+			{16, 0xdc}, {math.MaxUint16, 0xdc}, // array16
+			{math.MaxUint16 + 1, 0xdd}, // array32
+		}
+		for _, c := range cases {
+			encoded := appendMsgPackArrayHeader(nil, c.items)
+			if encoded[0] != c.prefix {
+				t.Errorf("%d items: expected prefix 0x%02x, got 0x%02x", c.items, c.prefix, encoded[0])
+			}
+			for i := 0; i < c.items; i++ {
+				encoded = appendMsgPackInt(encoded, int64(i))
+			}
+			var decoded []int64
+			if err := msgpack.Unmarshal(encoded, &decoded); err != nil || len(decoded) != c.items {
+				t.Errorf("%d items: expected an array of the same size, got %d (err %v)", c.items, len(decoded), err)
+			}
+		}
+	})
+
+	t.Run("Integers", func(t *testing.T) {
+		values := []int64{0, 1, 127, 128, 255, 256, -1, -32, -33, -128, -129,
+			math.MaxInt32, math.MinInt32, 1700000000000, math.MaxInt64, math.MinInt64}
+		for _, value := range values {
+			var decoded int64
+			if err := msgpack.Unmarshal(appendMsgPackInt(nil, value), &decoded); err != nil || decoded != value {
+				t.Errorf("Expected %d, got %d (err %v)", value, decoded, err)
+			}
+		}
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		values := []float64{0, -1.5, 42.125, math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(1), math.Inf(-1), math.NaN()}
+		for _, value := range values {
+			var decoded float64
+			if err := msgpack.Unmarshal(appendMsgPackFloat(nil, value), &decoded); err != nil {
+				t.Errorf("Failed to decode %v: %v", value, err)
+				continue
+			}
+			if decoded != value && !(math.IsNaN(value) && math.IsNaN(decoded)) {
+				t.Errorf("Expected %v, got %v", value, decoded)
+			}
+		}
+	})
+
+	t.Run("Snapshot_Frame", func(t *testing.T) {
+		type decodedMetric struct {
+			Type  string  `msgpack:"type"`
+			Value float64 `msgpack:"value"`
+			Info  string  `msgpack:"info"`
+		}
+		type decodedFrame struct {
+			Type      string          `msgpack:"type"`
+			Timestamp int64           `msgpack:"timestamp"`
+			Data      []decodedMetric `msgpack:"data"`
+		}
+
+		cases := []struct {
+			name    string
+			metrics []monitoring.Metric
+		}{
+			{"Empty", nil},
+			{"With_Info", []monitoring.Metric{
+				{Type: "cpu", Value: 12.5, Info: "AMD Ryzen 7 5800X 8-Core Processor"},
+				{Type: "ram", Value: 63.25},
+			}},
+			{"Many_Metrics", func() []monitoring.Metric {
+				metrics := make([]monitoring.Metric, 40)
+				for i := range metrics {
+					metrics[i] = monitoring.Metric{Type: fmt.Sprintf("cpu_core_%d", i), Value: float64(i) / 3}
+				}
+				return metrics
+			}()},
+		}
+		timestamp := time.Date(2024, 3, 1, 12, 0, 0, 123000000, time.UTC)
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				snapshot := &monitoring.ResourceSnapshot{Timestamp: timestamp, Metrics: c.metrics}
+				var frame decodedFrame
+				if err := msgpack.Unmarshal(encodeMsgPackSnapshot(snapshot), &frame); err != nil {
+					t.Fatalf("Failed to decode the snapshot frame: %v", err)
+				}
+				if frame.Type != "snapshot" || frame.Timestamp != timestamp.UnixMilli() || len(frame.Data) != len(c.metrics) {
+					t.Fatalf("Unexpected frame: %+v", frame)
+				}
+				for i, metric := range c.metrics {
+					got := frame.Data[i]
+					if got.Type != metric.Type || got.Value != metric.Value || got.Info != metric.Info {
+						t.Errorf("Metric %d: expected %+v, got %+v", i, metric, got)
+					}
+				}
+			})
+		}
+	})
+}
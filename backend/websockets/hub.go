@@ -1,8 +1,12 @@
 package websockets
 
 import (
-	"encoding/json"
 	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
 
 	"monitoring-app/monitoring"
 )
@@ -24,16 +28,53 @@ type Hub struct {
 	broadcast  chan []byte
 	register   chan *Client
 	unregister chan *Client
+	control    chan clientControl
+
+	// 일시정지하지 않은 클라이언트 수 (수집기가 스캔 여부를 판단하는 데 사용)
+	activeClients atomic.Int32
+
+	// 스냅샷 녹화/재생 (recording.go)과 마지막으로 전송한 스냅샷
+	recorder *recorder
+	replay   chan *monitoring.ResourceSnapshot
+	latest   atomic.Pointer[monitoring.ResourceSnapshot]
+
+	options  ServerOptions
+	upgrader websocket.Upgrader
+
+	// 연결 수 제한과 관리 API용 연결 목록 (HTTP 고루틴에서도 접근하므로 mu로 보호)
+	mu           sync.Mutex
+	connected    map[*Client]struct{}
+	perIP        map[string]int
+	nextClientID uint64
+}
+
+// clientControl은 클라이언트 세션의 일시정지/재개 요청입니다.
+type clientControl struct {
+	client *Client
+	paused bool
 }
 
-// NewHub는 새로운 Hub 인스턴스를 생성하고 반환합니다.
-func NewHub() *Hub {
-	return &Hub{
+// NewHub는 연결 허용 조건을 적용한 새로운 Hub 인스턴스를 생성하고 반환합니다.
+func NewHub(options ServerOptions) *Hub {
+	h := &Hub{
 		broadcast:  make(chan []byte),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		control:    make(chan clientControl),
 		clients:    make(map[*Client]bool),
+		options:    options,
+		connected:  make(map[*Client]struct{}),
+		perIP:      make(map[string]int),
+		recorder:   newRecorder(options.RecordingDir, options.MaxRecordingDuration),
+		replay:     make(chan *monitoring.ResourceSnapshot),
+	}
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:    1024,
+		WriteBufferSize:   1024,
+		CheckOrigin:       h.checkOrigin,
+		EnableCompression: options.Compression,
 	}
+	return h
 }
 
 // Run은 Hub의 메인 루프를 실행하여 클라이언트 연결 및 메시지 전송을 처리합니다.
@@ -42,41 +83,138 @@ func (h *Hub) Run(snapshotChan <-chan *monitoring.ResourceSnapshot) {
 		select {
 		case client := <-h.register:
 			h.clients[client] = true
+			h.updateActiveClients()
 			log.Println("새로운 클라이언트가 연결되었습니다.")
 		case client := <-h.unregister:
 			if _, ok := h.clients[client]; ok {
 				delete(h.clients, client)
 				close(client.send)
+				h.updateActiveClients()
 				log.Println("클라이언트 연결이 해제되었습니다.")
+				// 재생을 보던 마지막 클라이언트가 떠나면 재생을 멈추고 실시간 전송으로 돌아감
+				if len(h.clients) == 0 && h.recorder.replaying() {
+					h.StopReplay()
+				}
+			}
+		case control := <-h.control:
+			if _, ok := h.clients[control.client]; ok && control.client.paused.Load() != control.paused {
+				control.client.paused.Store(control.paused)
+				h.updateActiveClients()
+				log.Printf("클라이언트 세션 일시정지 상태 변경: paused=%v", control.paused)
 			}
 		case snapshot := <-snapshotChan:
 			if snapshot == nil {
 				continue
 			}
-			for _, metric := range snapshot.Metrics {
-				// 각 메트릭을 별도의 WebSocket 메시지로 변환
-				message, err := json.Marshal(WebSocketMessage{
-					Type: metric.Type,
-					Data: metricData{
-						Value: metric.Value,
-						Info:  metric.Info,
-					},
-				})
-				if err != nil {
-					log.Printf("Error marshalling metric data: %v", err)
-					continue
-				}
+			h.recorder.capture(snapshot)
+			// 재생 중에는 실시간 스냅샷 대신 녹화된 스냅샷만 전송
+			if !h.recorder.replaying() {
+				h.broadcastSnapshot(snapshot)
+			}
+		case snapshot := <-h.replay:
+			h.broadcastSnapshot(snapshot)
+		}
+	}
+}
+
+// broadcastSnapshot은 스냅샷을 각 클라이언트의 인코딩과 구독 토픽에 맞춰 전송합니다 (Hub 고루틴에서만 호출).
+func (h *Hub) broadcastSnapshot(snapshot *monitoring.ResourceSnapshot) {
+	h.latest.Store(snapshot)
 
-				// 모든 클라이언트에게 브로드캐스트
-				for client := range h.clients {
-					select {
-					case client.send <- message:
-					default:
-						close(client.send)
-						delete(h.clients, client)
-					}
+	// 인코딩(및 토픽 조합)별로 한 번만 직렬화하여 모든 클라이언트에 재사용
+	var jsonMessages []metricMessage
+	msgpackFrames := make(map[string][]byte)
+	for client := range h.clients {
+		if client.paused.Load() {
+			continue
+		}
+
+		var messages [][]byte
+		if client.encoding == EncodingMsgPack {
+			key := strings.Join(client.topics, ",")
+			frame, ok := msgpackFrames[key]
+			if !ok {
+				frame = encodeMsgPackSnapshot(client.filterSnapshot(snapshot))
+				msgpackFrames[key] = frame
+			}
+			messages = [][]byte{frame}
+		} else {
+			if jsonMessages == nil {
+				// 각 메트릭을 별도의 WebSocket 메시지로 변환
+				jsonMessages = encodeJSONMessages(snapshot)
+			}
+			for _, message := range jsonMessages {
+				if client.subscribes(message.metricType) {
+					messages = append(messages, message.payload)
 				}
 			}
 		}
+
+		if !client.enqueue(messages) {
+			close(client.send)
+			delete(h.clients, client)
+		}
+	}
+	h.updateActiveClients()
+}
+
+// LatestSnapshot은 마지막으로 전송한 스냅샷(재생 중이면 녹화된 스냅샷)을 반환합니다. 아직 없으면 nil입니다.
+func (h *Hub) LatestSnapshot() *monitoring.ResourceSnapshot {
+	return h.latest.Load()
+}
+
+// HasActiveClients는 일시정지하지 않은 클라이언트가 하나 이상 연결되어 있는지 반환합니다.
+// 모든 세션이 일시정지되면 GPU/프로세스 스캔처럼 비용이 큰 수집을 건너뛸 수 있습니다.
+func (h *Hub) HasActiveClients() bool {
+	return h.activeClients.Load() > 0
+}
+
+// NeedsSnapshots는 스냅샷 생산자가 다음 스냅샷을 만들어야 하는지 반환합니다.
+// 일시정지하지 않은 클라이언트가 있거나 녹화 중이면 true입니다.
+func (h *Hub) NeedsSnapshots() bool {
+	if h.HasActiveClients() {
+		return true
+	}
+	h.recorder.mu.Lock()
+	defer h.recorder.mu.Unlock()
+	return h.recorder.file != nil
+}
+
+// updateActiveClients는 활성 클라이언트 수를 다시 계산합니다 (Hub 고루틴에서만 호출).
+func (h *Hub) updateActiveClients() {
+	var active int32
+	for client := range h.clients {
+		if !client.paused.Load() {
+			active++
+		}
+	}
+	h.activeClients.Store(active)
+}
+
+// enqueue는 메시지를 클라이언트 송신 버퍼에 넣습니다. 버퍼가 가득 차면 false를 반환합니다.
+func (c *Client) enqueue(messages [][]byte) bool {
+	for _, message := range messages {
+		select {
+		case c.send <- message:
+			c.messagesSent.Add(1)
+			c.bytesSent.Add(uint64(len(message)))
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// filterSnapshot은 클라이언트가 구독한 메트릭만 남긴 스냅샷을 반환합니다.
+func (c *Client) filterSnapshot(snapshot *monitoring.ResourceSnapshot) *monitoring.ResourceSnapshot {
+	if len(c.topics) == 0 {
+		return snapshot
+	}
+	filtered := &monitoring.ResourceSnapshot{Timestamp: snapshot.Timestamp}
+	for _, metric := range snapshot.Metrics {
+		if c.subscribes(metric.Type) {
+			filtered.Metrics = append(filtered.Metrics, metric)
+		}
 	}
+	return filtered
 }
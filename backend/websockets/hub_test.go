@@ -0,0 +1,51 @@
+package websockets
+
+import (
+	"testing"
+
+	"monitoring-app/monitoring"
+)
+
+func TestHubActiveClients(t *testing.T) {
+	h := NewHub(ServerOptions{RecordingDir: t.TempDir()})
+	snapshots := make(chan *monitoring.ResourceSnapshot)
+	go h.Run(snapshots)
+	// Hub는 빈 스냅샷을 건너뛰므로, 보내고 나면 앞서 보낸 요청의 처리가 끝났음을 보장
+	settle := func() { snapshots <- nil }
+
+	client := &Client{hub: h, send: make(chan []byte, 16)}
+	h.register <- client
+	settle()
+	if !h.HasActiveClients() || !h.NeedsSnapshots() {
+		t.Fatal("Expected a connected client to need snapshots")
+	}
+
+	h.control <- clientControl{client: client, paused: true}
+	settle()
+	if h.HasActiveClients() || h.NeedsSnapshots() {
+		t.Error("Expected no snapshots to be needed while every client is paused")
+	}
+
+	// 녹화 중에는 일시정지된 클라이언트만 있어도 스냅샷이 필요
+	if _, err := h.StartRecording("paused", 0); err != nil {
+		t.Fatalf("StartRecording failed: %v", err)
+	}
+	if !h.NeedsSnapshots() {
+		t.Error("Expected snapshots to be needed while recording")
+	}
+	if _, err := h.StopRecording(); err != nil {
+		t.Fatalf("StopRecording failed: %v", err)
+	}
+
+	h.control <- clientControl{client: client, paused: false}
+	settle()
+	if !h.HasActiveClients() {
+		t.Error("Expected the resumed client to be active")
+	}
+
+	h.unregister <- client
+	settle()
+	if h.HasActiveClients() || h.NeedsSnapshots() {
+		t.Error("Expected no snapshots to be needed after the client disconnected")
+	}
+}
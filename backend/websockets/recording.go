@@ -0,0 +1,450 @@
+package websockets
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"monitoring-app/monitoring"
+)
+
+// 스냅샷 녹화와 재생 (버그 리포트용 재현 데이터 공유, 데모)
+// - 녹화: Hub로 들어오는 실시간 스냅샷을 지정한 시간 동안 JSON Lines 파일로 저장 (첫 줄은 헤더, 이후 한 줄에 스냅샷 하나)
+// - 재생: 녹화 파일의 스냅샷을 원래 간격 또는 배속으로 일반 WebSocket 클라이언트에 전송
+//   재생 중에는 실시간 스냅샷을 보내지 않으며, 타임스탬프는 전송 시각으로 바꿔 차트가 실시간처럼 그려지도록 함
+
+const (
+	recordingFormat             = "hwnow-recording"
+	recordingFormatVersion      = 1
+	recordingExtension          = ".jsonl"
+	defaultRecordingDir         = "recordings"
+	defaultMaxRecordingDuration = time.Hour
+	maxRecordingLineBytes       = 4 << 20
+
+	minReplaySpeed = 0.1
+	maxReplaySpeed = 100
+)
+
+var recordingNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+var (
+	ErrRecordingActive      = errors.New("a recording is already in progress")
+	ErrNoRecording          = errors.New("no recording in progress")
+	ErrNoReplay             = errors.New("no replay in progress")
+	ErrInvalidRecordingName = errors.New("recording name may only contain letters, digits, '-' and '_' (max 64)")
+)
+
+// RecordingHeader는 녹화 파일의 첫 줄입니다.
+type RecordingHeader struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	Name      string    `json:"name"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// RecordingInfo는 저장된 녹화 파일 정보입니다.
+type RecordingInfo struct {
+	Name       string    `json:"name"`
+	SizeBytes  int64     `json:"size_bytes"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// RecordingStatus는 현재 녹화/재생 상태입니다.
+type RecordingStatus struct {
+	Recording          bool       `json:"recording"`
+	RecordingName      string     `json:"recording_name,omitempty"`
+	RecordingStartedAt *time.Time `json:"recording_started_at,omitempty"`
+	RecordingEndsAt    *time.Time `json:"recording_ends_at,omitempty"`
+	RecordedSnapshots  int        `json:"recorded_snapshots"`
+
+	Replaying      bool    `json:"replaying"`
+	ReplayName     string  `json:"replay_name,omitempty"`
+	ReplaySpeed    float64 `json:"replay_speed,omitempty"`
+	ReplayLoop     bool    `json:"replay_loop"`
+	ReplayPosition int     `json:"replay_position"` // 현재 반복에서 전송한 스냅샷 수
+	ReplayTotal    int     `json:"replay_total"`
+}
+
+// recorder는 Hub의 녹화/재생 상태입니다 (Hub 고루틴과 HTTP 고루틴에서 접근하므로 mu로 보호).
+type recorder struct {
+	mu          sync.Mutex
+	dir         string
+	maxDuration time.Duration
+
+	file      *os.File
+	writer    *bufio.Writer
+	name      string
+	startedAt time.Time
+	endsAt    time.Time
+	snapshots int
+	timer     *time.Timer
+
+	replayStop     chan struct{}
+	replayName     string
+	replaySpeed    float64
+	replayLoop     bool
+	replayPosition int
+	replayTotal    int
+}
+
+func newRecorder(dir string, maxDuration time.Duration) *recorder {
+	if dir == "" {
+		dir = defaultRecordingDir
+	}
+	if maxDuration <= 0 {
+		maxDuration = defaultMaxRecordingDuration
+	}
+	return &recorder{dir: dir, maxDuration: maxDuration}
+}
+
+// recordingPath는 녹화 이름을 검증하고 파일 경로를 반환합니다.
+func (r *recorder) recordingPath(name string) (string, error) {
+	if !recordingNamePattern.MatchString(name) {
+		return "", ErrInvalidRecordingName
+	}
+	return filepath.Join(r.dir, name+recordingExtension), nil
+}
+
+// StartRecording은 실시간 스냅샷 녹화를 시작합니다.
+// 이름을 비우면 시작 시각으로 만들고, duration이 0 이하이거나 상한을 넘으면 상한(MaxRecordingDuration)만큼 녹화합니다.
+func (h *Hub) StartRecording(name string, duration time.Duration) (RecordingStatus, error) {
+	r := h.recorder
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file != nil {
+		return r.statusLocked(), ErrRecordingActive
+	}
+	now := time.Now()
+	if name = strings.TrimSpace(name); name == "" {
+		name = "recording-" + now.Format("20060102-150405")
+	}
+	path, err := r.recordingPath(name)
+	if err != nil {
+		return r.statusLocked(), err
+	}
+	if duration <= 0 || duration > r.maxDuration {
+		duration = r.maxDuration
+	}
+
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return r.statusLocked(), fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return r.statusLocked(), err
+	}
+	writer := bufio.NewWriter(file)
+	header := RecordingHeader{Format: recordingFormat, Version: recordingFormatVersion, Name: name, StartedAt: now}
+	if err := json.NewEncoder(writer).Encode(header); err != nil {
+		file.Close()
+		os.Remove(path)
+		return r.statusLocked(), fmt.Errorf("failed to write recording header: %w", err)
+	}
+
+	r.file, r.writer, r.name = file, writer, name
+	r.startedAt, r.endsAt, r.snapshots = now, now.Add(duration), 0
+	r.timer = time.AfterFunc(duration, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.file == file {
+			r.finishRecordingLocked()
+		}
+	})
+	log.Printf("Snapshot recording started: %s (%v)", name, duration)
+	return r.statusLocked(), nil
+}
+
+// StopRecording은 진행 중인 녹화를 끝내고 파일을 닫습니다.
+func (h *Hub) StopRecording() (RecordingStatus, error) {
+	r := h.recorder
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return r.statusLocked(), ErrNoRecording
+	}
+	r.finishRecordingLocked()
+	return r.statusLocked(), nil
+}
+
+// finishRecordingLocked는 버퍼를 비우고 녹화 파일을 닫습니다 (mu를 잡은 상태에서 호출).
+func (r *recorder) finishRecordingLocked() {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	if err := r.writer.Flush(); err != nil {
+		log.Printf("Failed to flush recording %s: %v", r.name, err)
+	}
+	if err := r.file.Close(); err != nil {
+		log.Printf("Failed to close recording %s: %v", r.name, err)
+	}
+	log.Printf("Snapshot recording finished: %s (%d snapshots)", r.name, r.snapshots)
+	r.file, r.writer, r.timer = nil, nil, nil
+}
+
+// capture는 녹화 중이면 실시간 스냅샷을 파일에 추가합니다 (Hub 고루틴에서 호출).
+func (r *recorder) capture(snapshot *monitoring.ResourceSnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return
+	}
+	if time.Now().After(r.endsAt) {
+		r.finishRecordingLocked()
+		return
+	}
+	line, err := json.Marshal(snapshot)
+	if err == nil {
+		_, err = r.writer.Write(append(line, '\n'))
+	}
+	if err != nil {
+		log.Printf("Failed to write recording %s, stopping: %v", r.name, err)
+		r.finishRecordingLocked()
+		return
+	}
+	r.snapshots++
+}
+
+// Recordings는 저장된 녹화 파일 목록을 최근 순으로 반환합니다.
+func (h *Hub) Recordings() ([]RecordingInfo, error) {
+	entries, err := os.ReadDir(h.recorder.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []RecordingInfo{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recordings := make([]RecordingInfo, 0, len(entries))
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), recordingExtension)
+		if !ok || entry.IsDir() || !recordingNamePattern.MatchString(name) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		recordings = append(recordings, RecordingInfo{Name: name, SizeBytes: info.Size(), ModifiedAt: info.ModTime()})
+	}
+	sort.Slice(recordings, func(i, j int) bool { return recordings[i].ModifiedAt.After(recordings[j].ModifiedAt) })
+	return recordings, nil
+}
+
+// RecordingPath는 다운로드할 녹화 파일 경로를 반환합니다. 파일이 없으면 os.ErrNotExist를 반환합니다.
+func (h *Hub) RecordingPath(name string) (string, error) {
+	path, err := h.recorder.recordingPath(name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// DeleteRecording은 녹화 파일을 삭제합니다. 녹화 중인 파일은 삭제할 수 없습니다.
+func (h *Hub) DeleteRecording(name string) error {
+	r := h.recorder
+	path, err := r.recordingPath(name)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil && r.name == name {
+		return ErrRecordingActive
+	}
+	return os.Remove(path)
+}
+
+// readRecording은 녹화 파일의 헤더와 스냅샷을 읽습니다.
+func readRecording(path string) (RecordingHeader, []*monitoring.ResourceSnapshot, error) {
+	var header RecordingHeader
+	file, err := os.Open(path)
+	if err != nil {
+		return header, nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordingLineBytes)
+	if !scanner.Scan() {
+		return header, nil, errors.New("recording file is empty")
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.Format != recordingFormat {
+		return header, nil, errors.New("not a recording file")
+	}
+	if header.Version > recordingFormatVersion {
+		return header, nil, fmt.Errorf("unsupported recording version %d", header.Version)
+	}
+
+	var snapshots []*monitoring.ResourceSnapshot
+	for scanner.Scan() {
+		snapshot := &monitoring.ResourceSnapshot{}
+		if err := json.Unmarshal(scanner.Bytes(), snapshot); err != nil {
+			// 녹화 도중 종료되어 잘린 마지막 줄은 건너뛰고, 중간의 손상된 줄은 오류로 처리
+			if !scanner.Scan() && scanner.Err() == nil {
+				log.Printf("Recording %s ends with a truncated snapshot, replaying %d snapshots", header.Name, len(snapshots))
+				break
+			}
+			return header, nil, fmt.Errorf("invalid snapshot on line %d: %w", len(snapshots)+2, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := scanner.Err(); err != nil {
+		// 녹화 도중 종료되어 마지막 줄이 잘린 경우 읽은 부분까지만 재생
+		log.Printf("Recording %s truncated: %v", header.Name, err)
+	}
+	if len(snapshots) == 0 {
+		return header, nil, errors.New("recording contains no snapshots")
+	}
+	return header, snapshots, nil
+}
+
+// StartReplay는 녹화 파일을 WebSocket 클라이언트에 재생합니다. 진행 중인 재생은 새 재생으로 바뀝니다.
+// speed는 배속 (0 이하 = 원래 속도, 0.1 ~ 100), loop이면 끝난 뒤 처음부터 반복합니다.
+func (h *Hub) StartReplay(name string, speed float64, loop bool) (RecordingStatus, error) {
+	r := h.recorder
+	path, err := r.recordingPath(name)
+	if err != nil {
+		return h.RecordingStatus(), err
+	}
+	_, snapshots, err := readRecording(path)
+	if err != nil {
+		return h.RecordingStatus(), err
+	}
+	if speed <= 0 {
+		speed = 1
+	}
+	speed = min(max(speed, minReplaySpeed), maxReplaySpeed)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.replayStop != nil {
+		close(r.replayStop)
+	}
+	stop := make(chan struct{})
+	r.replayStop, r.replayName, r.replaySpeed, r.replayLoop = stop, name, speed, loop
+	r.replayPosition, r.replayTotal = 0, len(snapshots)
+	go h.runReplay(snapshots, speed, loop, stop)
+
+	log.Printf("Snapshot replay started: %s (%d snapshots, x%.1f, loop=%v)", name, len(snapshots), speed, loop)
+	return r.statusLocked(), nil
+}
+
+// StopReplay는 재생을 멈추고 실시간 스냅샷 전송으로 돌아갑니다.
+func (h *Hub) StopReplay() (RecordingStatus, error) {
+	r := h.recorder
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.replayStop == nil {
+		return r.statusLocked(), ErrNoReplay
+	}
+	close(r.replayStop)
+	r.replayStop = nil
+	log.Printf("Snapshot replay stopped: %s", r.replayName)
+	return r.statusLocked(), nil
+}
+
+// runReplay는 녹화된 간격을 배속으로 나눈 만큼 기다리며 스냅샷을 Hub로 보냅니다.
+func (h *Hub) runReplay(snapshots []*monitoring.ResourceSnapshot, speed float64, loop bool, stop chan struct{}) {
+	r := h.recorder
+	defer func() {
+		r.mu.Lock()
+		if r.replayStop == stop {
+			r.replayStop = nil
+			log.Printf("Snapshot replay finished: %s", r.replayName)
+		}
+		r.mu.Unlock()
+	}()
+
+	// 반복 재생 시 마지막 스냅샷과 첫 스냅샷 사이 간격 (첫 두 스냅샷 간격, 없으면 1초)
+	loopGap := time.Second
+	if len(snapshots) > 1 {
+		if gap := snapshots[1].Timestamp.Sub(snapshots[0].Timestamp); gap > 0 {
+			loopGap = gap
+		}
+	}
+
+	for pass := 0; ; pass++ {
+		for i, snapshot := range snapshots {
+			var gap time.Duration
+			if i > 0 {
+				gap = snapshot.Timestamp.Sub(snapshots[i-1].Timestamp)
+			} else if pass > 0 {
+				gap = loopGap
+			}
+			if gap > 0 {
+				select {
+				case <-time.After(time.Duration(float64(gap) / speed)):
+				case <-stop:
+					return
+				}
+			}
+
+			replayed := &monitoring.ResourceSnapshot{Timestamp: time.Now(), Metrics: snapshot.Metrics}
+			select {
+			case h.replay <- replayed:
+			case <-stop:
+				return
+			}
+
+			r.mu.Lock()
+			if r.replayStop == stop {
+				r.replayPosition = i + 1
+			}
+			r.mu.Unlock()
+		}
+		if !loop {
+			return
+		}
+	}
+}
+
+// replaying은 재생 중인지 반환합니다 (재생 중에는 실시간 스냅샷을 보내지 않음).
+func (r *recorder) replaying() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.replayStop != nil
+}
+
+// RecordingStatus는 현재 녹화/재생 상태를 반환합니다.
+func (h *Hub) RecordingStatus() RecordingStatus {
+	h.recorder.mu.Lock()
+	defer h.recorder.mu.Unlock()
+	return h.recorder.statusLocked()
+}
+
+func (r *recorder) statusLocked() RecordingStatus {
+	status := RecordingStatus{}
+	if r.file != nil {
+		startedAt, endsAt := r.startedAt, r.endsAt
+		status.Recording = true
+		status.RecordingName = r.name
+		status.RecordingStartedAt = &startedAt
+		status.RecordingEndsAt = &endsAt
+		status.RecordedSnapshots = r.snapshots
+	}
+	if r.replayStop != nil {
+		status.Replaying = true
+		status.ReplayName = r.replayName
+		status.ReplaySpeed = r.replaySpeed
+		status.ReplayLoop = r.replayLoop
+		status.ReplayPosition = r.replayPosition
+		status.ReplayTotal = r.replayTotal
+	}
+	return status
+}
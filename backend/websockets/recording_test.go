@@ -0,0 +1,157 @@
+package websockets
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"monitoring-app/monitoring"
+)
+
+func TestRecording(t *testing.T) {
+	// 녹화 간격 40ms로 세 개의 스냅샷을 만듦
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	recorded := make([]*monitoring.ResourceSnapshot, 3)
+	for i := range recorded {
+		recorded[i] = &monitoring.ResourceSnapshot{
+			Timestamp: start.Add(time.Duration(i) * 40 * time.Millisecond),
+			Metrics:   []monitoring.Metric{{Type: "cpu", Value: float64(10 * (i + 1))}},
+		}
+	}
+	record := func(t *testing.T, h *Hub, name string) {
+		if _, err := h.StartRecording(name, 0); err != nil {
+			t.Fatalf("StartRecording failed: %v", err)
+		}
+		for _, snapshot := range recorded {
+			h.recorder.capture(snapshot)
+		}
+		if status, err := h.StopRecording(); err != nil || status.Recording {
+			t.Fatalf("StopRecording failed: %v (%+v)", err, status)
+		}
+	}
+
+	t.Run("Round_Trip", func(t *testing.T) {
+		h := NewHub(ServerOptions{RecordingDir: t.TempDir()})
+		record(t, h, "round-trip")
+
+		path, _ := h.RecordingPath("round-trip")
+		header, snapshots, err := readRecording(path)
+		if err != nil {
+			t.Fatalf("readRecording failed: %v", err)
+		}
+		if header.Name != "round-trip" || len(snapshots) != len(recorded) {
+			t.Fatalf("Expected %d snapshots of round-trip, got %d of %s", len(recorded), len(snapshots), header.Name)
+		}
+		for i, snapshot := range snapshots {
+			if !snapshot.Timestamp.Equal(recorded[i].Timestamp) || snapshot.Metrics[0].Value != recorded[i].Metrics[0].Value {
+				t.Errorf("Snapshot %d: expected %v at %v, got %v at %v", i,
+					recorded[i].Metrics[0].Value, recorded[i].Timestamp, snapshot.Metrics[0].Value, snapshot.Timestamp)
+			}
+		}
+	})
+
+	t.Run("Replay_Order_And_Timing", func(t *testing.T) {
+		h := NewHub(ServerOptions{RecordingDir: t.TempDir()})
+		record(t, h, "timing")
+
+		// Hub를 실행하지 않고 재생 채널을 직접 읽음 (2배속: 40ms 간격 -> 20ms)
+		if _, err := h.StartReplay("timing", 2, false); err != nil {
+			t.Fatalf("StartReplay failed: %v", err)
+		}
+		var previous time.Time
+		for i := range recorded {
+			select {
+			case snapshot := <-h.replay:
+				received := time.Now()
+				if snapshot.Metrics[0].Value != recorded[i].Metrics[0].Value {
+					t.Errorf("Snapshot %d: expected value %v, got %v", i, recorded[i].Metrics[0].Value, snapshot.Metrics[0].Value)
+				}
+				if time.Since(snapshot.Timestamp) > time.Second {
+					t.Errorf("Snapshot %d: expected the timestamp to be the send time, got %v", i, snapshot.Timestamp)
+				}
+				if i > 0 && received.Sub(previous) < 15*time.Millisecond {
+					t.Errorf("Snapshot %d: expected about 20ms after the previous one, got %v", i, received.Sub(previous))
+				}
+				previous = received
+			case <-time.After(time.Second):
+				t.Fatalf("Timed out waiting for snapshot %d", i)
+			}
+		}
+		waitFor(t, func() bool { return !h.RecordingStatus().Replaying }, "replay to finish")
+	})
+
+	t.Run("Damaged_Files", func(t *testing.T) {
+		header := `{"format":"hwnow-recording","version":1,"name":"damaged","started_at":"2024-05-01T09:00:00Z"}`
+		snapshot := `{"timestamp":"2024-05-01T09:00:00Z","metrics":[{"type":"cpu","value":10}]}`
+		cases := []struct {
+			name      string
+			content   string
+			snapshots int
+			err       string
+		}{
+			{"Empty", "", 0, "empty"},
+			{"Not_A_Recording", `{"format":"other"}` + "\n" + snapshot + "\n", 0, "not a recording"},
+			{"Newer_Version", strings.Replace(header, `"version":1`, `"version":99`, 1) + "\n" + snapshot + "\n", 0, "unsupported"},
+			{"Header_Only", header + "\n", 0, "no snapshots"},
+			{"Corrupt_Middle_Line", header + "\n" + snapshot + "\n{garbage\n" + snapshot + "\n", 0, "line 3"},
+			// 녹화 도중 종료되어 마지막 줄이 잘린 경우 완전한 스냅샷까지만 재생
+			{"Truncated_Last_Line", header + "\n" + snapshot + "\n" + snapshot + "\n" + snapshot[:20], 2, ""},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "damaged"+recordingExtension)
+				if err := os.WriteFile(path, []byte(c.content), 0644); err != nil {
+					t.Fatalf("Failed to write recording: %v", err)
+				}
+				_, snapshots, err := readRecording(path)
+				if c.err != "" {
+					if err == nil || !strings.Contains(err.Error(), c.err) {
+						t.Errorf("Expected an error containing %q, got %v", c.err, err)
+					}
+					return
+				}
+				if err != nil || len(snapshots) != c.snapshots {
+					t.Errorf("Expected %d snapshots, got %d (err=%v)", c.snapshots, len(snapshots), err)
+				}
+			})
+		}
+	})
+
+	t.Run("Replay_Stops_When_Client_Disconnects", func(t *testing.T) {
+		h := NewHub(ServerOptions{RecordingDir: t.TempDir()})
+		record(t, h, "disconnect")
+		snapshots := make(chan *monitoring.ResourceSnapshot)
+		go h.Run(snapshots)
+
+		client := &Client{hub: h, send: make(chan []byte, 256)}
+		h.register <- client
+		if _, err := h.StartReplay("disconnect", 100, true); err != nil {
+			t.Fatalf("StartReplay failed: %v", err)
+		}
+		select {
+		case <-client.send:
+		case <-time.After(time.Second):
+			t.Fatal("Expected the client to receive replayed snapshots")
+		}
+
+		h.unregister <- client
+		waitFor(t, func() bool { return !h.RecordingStatus().Replaying }, "replay to stop")
+		// 연결이 끊긴 클라이언트의 송신 채널은 닫히고 더 이상 전송되지 않음
+		for range client.send {
+		}
+	})
+}
+
+// waitFor는 조건이 참이 될 때까지 최대 1초 기다립니다.
+func waitFor(t *testing.T, condition func() bool, what string) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -5,7 +5,7 @@ import { SettingsModal } from '../common/SettingsModal';
 import { useConfirmDialog } from '../common/ConfirmDialog';
 import { useToast } from '../../contexts/ToastContext';
 import { ButtonSpinner, InlineLoader } from '../common/LoadingSpinner';
-import { killGPUProcess, suspendGPUProcess, resumeGPUProcess, setGPUProcessPriority } from '../../services/apiService';
+import { killGPUProcess, suspendGPUProcess, resumeGPUProcess, setGPUProcessPriority, requestGPUProcessConfirmation, type GPUProcessConfirmationChallenge } from '../../services/apiService';
 import { onConnectionStatusChange, getWebSocketStatus, flushGPUProcessBatch } from '../../services/websocketService';
 import { GPU_PROCESS_PRESETS, type GPUProcessPresetType } from '../../utils/gpuProcessWidgetDefaults';
 import { formatProcessName, getRelativeTimeString, formatTime, getProcessTypeIcon, getGpuUsageClass, getMemoryUsageClass, getProcessTypeClass, getConnectionStatusClass, getProcessStatusWithPattern } from './gpu-process/processFormatters';
@@ -373,11 +373,38 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
     setSelectedProcesses(newSelected);
   };
 
+  // 종료/일시정지는 서버가 발급한 확인 토큰이 있어야 실행됨: 토큰을 먼저 받아 확인 창을 띄우고,
+  // 사용자가 확인한 경우에만 토큰을 담아 실행 (gpuRequireConfirmation 설정과 무관하게 항상 확인)
+  const confirmDestructiveAction = async (
+    action: 'kill' | 'suspend',
+    pids: number[],
+    run: (tokens: Map<number, string>) => Promise<void>
+  ) => {
+    let challenges: GPUProcessConfirmationChallenge[];
+    try {
+      challenges = await Promise.all(pids.map(pid => requestGPUProcessConfirmation(pid, action)));
+    } catch (error: any) {
+      showProcessError(pids.length === 1 ? `PID ${pids[0]}` : `${pids.length}개 프로세스`, action, error.message, pids.length === 1 ? pids[0] : undefined);
+      return;
+    }
+
+    const targets = challenges.map(challenge => `${challenge.processName || 'Unknown'} (PID: ${challenge.pid})`).join('\n');
+    showConfirm({
+      title: action === 'kill' ? 'Process Termination' : 'Process Suspension',
+      message: action === 'kill'
+        ? `다음 프로세스를 종료하시겠습니까?\n\n${targets}\n\n이 작업은 되돌릴 수 없습니다.`
+        : `다음 프로세스를 일시정지하시겠습니까?\n\n${targets}`,
+      type: action === 'kill' ? 'danger' : 'warning',
+      icon: action === 'kill' ? '[X]' : '[PAUSE]',
+      onConfirm: () => run(new Map(challenges.map(challenge => [challenge.pid, challenge.confirmationToken])))
+    });
+  };
+
   // ?보???비게이?을 ?한 ?로?스 ?션 ?들??
   const handleProcessAction = async (action: 'kill' | 'suspend' | 'resume' | 'priority', pids: number[], processNames: string[], priority?: string) => {
     if (isControlInProgress) return;
 
-    const executeAction = async () => {
+    const executeAction = async (tokens?: Map<number, string>) => {
       setIsControlInProgress(true);
       
       try {
@@ -386,10 +413,10 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
             const processName = processNames[index];
             switch (action) {
               case 'kill':
-                await killGPUProcess(pid);
+                await killGPUProcess(pid, tokens?.get(pid) ?? '');
                 return { pid, processName, success: true };
               case 'suspend':
-                await suspendGPUProcess(pid);
+                await suspendGPUProcess(pid, tokens?.get(pid) ?? '');
                 return { pid, processName, success: true };
               case 'resume':
                 await resumeGPUProcess(pid);
@@ -428,30 +455,17 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
       }
     };
 
+    if (action === 'kill' || action === 'suspend') {
+      await confirmDestructiveAction(action, pids, executeAction);
+      return;
+    }
+
     // Show confirmation dialog if required
     if (config.gpuRequireConfirmation !== false && pids.length === 1) {
       const processName = processNames[0];
       const pid = pids[0];
       
       switch (action) {
-        case 'kill':
-          showConfirm({
-            title: 'Process Termination',
-            message: `?로?스 "${processName}" (PID: ${pid})??? 종료?시겠습?까?\n\n???업? ?돌????습?다.`,
-            type: 'danger',
-            icon: '[X]',
-            onConfirm: executeAction
-          });
-          break;
-        case 'suspend':
-          showConfirm({
-            title: 'Process Suspension',
-            message: `?로?스 "${processName}" (PID: ${pid})??? ?시???시겠습?까?`,
-            type: 'warning',
-            icon: '[PAUSE]',
-            onConfirm: executeAction
-          });
-          break;
         case 'resume':
           showConfirm({
             title: 'Process Resume',
@@ -599,17 +613,17 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
     const { pid, processName } = contextMenu;
     hideContextMenu();
     
-    const executeAction = async () => {
+    const executeAction = async (tokens?: Map<number, string>) => {
       setIsControlInProgress(true);
       
       try {
         switch (action) {
           case 'kill':
-            await killGPUProcess(pid);
+            await killGPUProcess(pid, tokens?.get(pid) ?? '');
             showProcessSuccess(processName, action, pid);
             break;
           case 'suspend':
-            await suspendGPUProcess(pid);
+            await suspendGPUProcess(pid, tokens?.get(pid) ?? '');
             showProcessSuccess(processName, action, pid);
             break;
           case 'resume':
@@ -628,27 +642,14 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
       }
     };
     
+    if (action === 'kill' || action === 'suspend') {
+      await confirmDestructiveAction(action, [pid], executeAction);
+      return;
+    }
+
     // Show confirmation dialog
     if (config.gpuRequireConfirmation !== false) { // default true
       switch (action) {
-        case 'kill':
-          showConfirm({
-            title: 'Process Termination',
-            message: `?로?스 "${processName}" (PID: ${pid})??? 종료?시겠습?까?\n\n???업? ?돌????습?다.`,
-            type: 'danger',
-            icon: '[X]',
-            onConfirm: executeAction
-          });
-          break;
-        case 'suspend':
-          showConfirm({
-            title: 'Process Suspension',
-            message: `?로?스 "${processName}" (PID: ${pid})??? ?시???시겠습?까?`,
-            type: 'warning',
-            icon: '[PAUSE]',
-            onConfirm: executeAction
-          });
-          break;
         case 'resume':
           showConfirm({
             title: 'Process Resume',
@@ -785,12 +786,12 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
     const processesToKill = Array.from(selectedProcesses);
     if (processesToKill.length === 0) return;
     
-    const executeKill = async () => {
+    const executeKill = async (tokens: Map<number, string>) => {
       setIsControlInProgress(true);
       
       try {
         const results = await Promise.allSettled(
-          processesToKill.map(pid => killGPUProcess(pid))
+          processesToKill.map(pid => killGPUProcess(pid, tokens.get(pid) ?? ''))
         );
         
         let successCount = 0;
@@ -820,22 +821,7 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
       }
     };
     
-    // Show confirmation dialog
-    if (config.gpuRequireConfirmation !== false) { // default true
-      const message = processesToKill.length === 1 
-        ? `?로?스 ${processesToKill[0]}??? 종료?시겠습?까?\n\n???업? ?돌????습?다.`
-        : `?택??${processesToKill.length}??로?스?모두 종료?시겠습?까?\n\n???업? ?돌????습?다.`;
-        
-      showConfirm({
-        title: 'Kill Selected Processes',
-        message,
-        type: 'danger',
-        icon: '[X]',
-        onConfirm: executeKill
-      });
-    } else {
-      executeKill();
-    }
+    await confirmDestructiveAction('kill', processesToKill, executeKill);
   };
   
   const handleSuspendSelected = async () => {
@@ -844,12 +830,12 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
     const processesToSuspend = Array.from(selectedProcesses);
     if (processesToSuspend.length === 0) return;
     
-    const executeSuspend = async () => {
+    const executeSuspend = async (tokens: Map<number, string>) => {
       setIsControlInProgress(true);
       
       try {
         const results = await Promise.allSettled(
-          processesToSuspend.map(pid => suspendGPUProcess(pid))
+          processesToSuspend.map(pid => suspendGPUProcess(pid, tokens.get(pid) ?? ''))
         );
         
         let successCount = 0;
@@ -879,22 +865,7 @@ const GpuProcessWidget: React.FC<WidgetProps> = ({ widgetId, onRemove, isExpande
       }
     };
     
-    // Show confirmation dialog
-    if (config.gpuRequireConfirmation !== false) { // default true
-      const message = processesToSuspend.length === 1 
-        ? `?로?스 ${processesToSuspend[0]}??? ?시???시겠습?까?`
-        : `?택??${processesToSuspend.length}??로?스?모두 ?시???시겠습?까?`;
-        
-      showConfirm({
-        title: 'Suspend Selected Processes',
-        message,
-        type: 'warning',
-        icon: '[PAUSE]',
-        onConfirm: executeSuspend
-      });
-    } else {
-      executeSuspend();
-    }
+    await confirmDestructiveAction('suspend', processesToSuspend, executeSuspend);
   };
   
   const handleResumeSelected = async () => {
@@ -194,16 +194,57 @@ export interface GPUProcessControlError {
   pid: number;
 }
 
+// 종료/일시정지는 2단계 확인이 필요: 토큰 없이 보낸 첫 요청은 428과 확인 토큰을 반환하고,
+// 사용자가 UI에서 확인한 뒤에만 토큰 헤더를 담아 실제 요청을 보냄 (토큰은 30초 동안 한 번만 유효)
+export interface GPUProcessConfirmationChallenge {
+  confirmationRequired: boolean;
+  confirmationToken: string;
+  confirmationHeader: string;
+  expiresAt: string;
+  action: 'kill' | 'suspend';
+  pid: number;
+  processName?: string;
+}
+
+/**
+ * GPU 프로세스 종료/일시정지를 위한 확인 토큰을 요청합니다
+ * @param pid 대상 프로세스의 PID
+ * @param action 수행할 작업
+ * @returns 사용자에게 보여줄 확인 요청 (토큰 포함)
+ */
+export const requestGPUProcessConfirmation = async (
+  pid: number,
+  action: 'kill' | 'suspend'
+): Promise<GPUProcessConfirmationChallenge> => {
+  try {
+    await apiClient.post(`/gpu/processes/${pid}/${action}`);
+  } catch (error) {
+    const challenge = (error as AxiosError<GPUProcessConfirmationChallenge>).response;
+    if (challenge?.status === 428 && challenge.data?.confirmationToken) {
+      return challenge.data;
+    }
+    handleApiError(error, `Request ${action} confirmation for GPU Process ${pid}`);
+  }
+  throw new Error(`Server did not request confirmation for ${action} of process ${pid}`);
+};
+
+async function postConfirmed<T>(url: string, confirmationToken: string): Promise<T> {
+  const response = await apiClient.post<T>(url, undefined, {
+    headers: { 'X-Confirmation-Token': confirmationToken },
+  });
+  return response.data;
+}
+
 /**
  * GPU 프로세스를 종료합니다
  * @param pid 종료할 프로세스의 PID
+ * @param confirmationToken 사용자가 확인한 requestGPUProcessConfirmation의 토큰
  * @returns 성공시 성공 메시지, 실패시 에러 발생
  */
-export const killGPUProcess = async (pid: number): Promise<GPUProcessControlResponse> => {
+export const killGPUProcess = async (pid: number, confirmationToken: string): Promise<GPUProcessControlResponse> => {
   try {
     return await apiRequestWithRetry(async () => {
-      const response = await apiClient.post<GPUProcessControlResponse>(`/gpu/process/${pid}/kill`);
-      return response.data;
+      return await postConfirmed<GPUProcessControlResponse>(`/gpu/processes/${pid}/kill`, confirmationToken);
     }, {
       retries: 2, // GPU 프로세스 제어는 재시도 횟수를 줄임
       retryDelay: 500,
@@ -221,13 +262,13 @@ export const killGPUProcess = async (pid: number): Promise<GPUProcessControlResp
 /**
  * GPU 프로세스를 일시정지합니다
  * @param pid 일시정지할 프로세스의 PID
+ * @param confirmationToken 사용자가 확인한 requestGPUProcessConfirmation의 토큰
  * @returns 성공시 성공 메시지, 실패시 에러 발생
  */
-export const suspendGPUProcess = async (pid: number): Promise<GPUProcessControlResponse> => {
+export const suspendGPUProcess = async (pid: number, confirmationToken: string): Promise<GPUProcessControlResponse> => {
   try {
     return await apiRequestWithRetry(async () => {
-      const response = await apiClient.post<GPUProcessControlResponse>(`/gpu/process/${pid}/suspend`);
-      return response.data;
+      return await postConfirmed<GPUProcessControlResponse>(`/gpu/processes/${pid}/suspend`, confirmationToken);
     }, {
       retries: 2,
       retryDelay: 500,